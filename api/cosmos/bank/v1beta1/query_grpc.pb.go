@@ -32,6 +32,10 @@ const (
 	Query_DenomOwners_FullMethodName                = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_DenomOwnersByQuery_FullMethodName         = "/cosmos.bank.v1beta1.Query/DenomOwnersByQuery"
 	Query_SendEnabled_FullMethodName                = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_TransferPolicy_FullMethodName             = "/cosmos.bank.v1beta1.Query/TransferPolicy"
+	Query_TransferPolicies_FullMethodName           = "/cosmos.bank.v1beta1.Query/TransferPolicies"
+	Query_TransferList_FullMethodName               = "/cosmos.bank.v1beta1.Query/TransferList"
+	Query_BalancesByAddresses_FullMethodName        = "/cosmos.bank.v1beta1.Query/BalancesByAddresses"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +96,20 @@ type QueryClient interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// TransferPolicy queries the transfer policy of a denom.
+	TransferPolicy(ctx context.Context, in *QueryTransferPolicyRequest, opts ...grpc.CallOption) (*QueryTransferPolicyResponse, error)
+	// TransferPolicies queries the transfer policies of all the restricted denoms.
+	TransferPolicies(ctx context.Context, in *QueryTransferPoliciesRequest, opts ...grpc.CallOption) (*QueryTransferPoliciesResponse, error)
+	// TransferList queries the addresses listed in the transfer list of a denom.
+	TransferList(ctx context.Context, in *QueryTransferListRequest, opts ...grpc.CallOption) (*QueryTransferListResponse, error)
+	// BalancesByAddresses queries the balances of a set of accounts in one call.
+	//
+	// Combined with the x-cosmos-block-height gRPC header, it returns the balances
+	// at a past height, as long as that height has not been pruned by the node.
+	// The balances are not proven, as gRPC responses cannot carry store proofs:
+	// the proven balances are read with ABCI store queries, see the --prove flag
+	// of the balances-by-addresses command.
+	BalancesByAddresses(ctx context.Context, in *QueryBalancesByAddressesRequest, opts ...grpc.CallOption) (*QueryBalancesByAddressesResponse, error)
 }
 
 type queryClient struct {
@@ -232,6 +250,46 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) TransferPolicy(ctx context.Context, in *QueryTransferPolicyRequest, opts ...grpc.CallOption) (*QueryTransferPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_TransferPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferPolicies(ctx context.Context, in *QueryTransferPoliciesRequest, opts ...grpc.CallOption) (*QueryTransferPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTransferPoliciesResponse)
+	err := c.cc.Invoke(ctx, Query_TransferPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferList(ctx context.Context, in *QueryTransferListRequest, opts ...grpc.CallOption) (*QueryTransferListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTransferListResponse)
+	err := c.cc.Invoke(ctx, Query_TransferList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalancesByAddresses(ctx context.Context, in *QueryBalancesByAddressesRequest, opts ...grpc.CallOption) (*QueryBalancesByAddressesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBalancesByAddressesResponse)
+	err := c.cc.Invoke(ctx, Query_BalancesByAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -290,6 +348,20 @@ type QueryServer interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// TransferPolicy queries the transfer policy of a denom.
+	TransferPolicy(context.Context, *QueryTransferPolicyRequest) (*QueryTransferPolicyResponse, error)
+	// TransferPolicies queries the transfer policies of all the restricted denoms.
+	TransferPolicies(context.Context, *QueryTransferPoliciesRequest) (*QueryTransferPoliciesResponse, error)
+	// TransferList queries the addresses listed in the transfer list of a denom.
+	TransferList(context.Context, *QueryTransferListRequest) (*QueryTransferListResponse, error)
+	// BalancesByAddresses queries the balances of a set of accounts in one call.
+	//
+	// Combined with the x-cosmos-block-height gRPC header, it returns the balances
+	// at a past height, as long as that height has not been pruned by the node.
+	// The balances are not proven, as gRPC responses cannot carry store proofs:
+	// the proven balances are read with ABCI store queries, see the --prove flag
+	// of the balances-by-addresses command.
+	BalancesByAddresses(context.Context, *QueryBalancesByAddressesRequest) (*QueryBalancesByAddressesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -339,6 +411,18 @@ func (UnimplementedQueryServer) DenomOwnersByQuery(context.Context, *QueryDenomO
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) TransferPolicy(context.Context, *QueryTransferPolicyRequest) (*QueryTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPolicy not implemented")
+}
+func (UnimplementedQueryServer) TransferPolicies(context.Context, *QueryTransferPoliciesRequest) (*QueryTransferPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPolicies not implemented")
+}
+func (UnimplementedQueryServer) TransferList(context.Context, *QueryTransferListRequest) (*QueryTransferListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferList not implemented")
+}
+func (UnimplementedQueryServer) BalancesByAddresses(context.Context, *QueryBalancesByAddressesRequest) (*QueryBalancesByAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalancesByAddresses not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -594,6 +678,78 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferPolicy(ctx, req.(*QueryTransferPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferPolicies(ctx, req.(*QueryTransferPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferList(ctx, req.(*QueryTransferListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BalancesByAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalancesByAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalancesByAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BalancesByAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalancesByAddresses(ctx, req.(*QueryBalancesByAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -653,6 +809,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "TransferPolicy",
+			Handler:    _Query_TransferPolicy_Handler,
+		},
+		{
+			MethodName: "TransferPolicies",
+			Handler:    _Query_TransferPolicies_Handler,
+		},
+		{
+			MethodName: "TransferList",
+			Handler:    _Query_TransferList_Handler,
+		},
+		{
+			MethodName: "BalancesByAddresses",
+			Handler:    _Query_BalancesByAddresses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
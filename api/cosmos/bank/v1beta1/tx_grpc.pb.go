@@ -19,11 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_Burn_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Burn"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName               = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName          = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_Burn_FullMethodName               = "/cosmos.bank.v1beta1.Msg/Burn"
+	Msg_UpdateParams_FullMethodName       = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName     = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_SetTransferPolicy_FullMethodName  = "/cosmos.bank.v1beta1.Msg/SetTransferPolicy"
+	Msg_UpdateTransferList_FullMethodName = "/cosmos.bank.v1beta1.Msg/UpdateTransferList"
 )
 
 // MsgClient is the client API for Msg service.
@@ -46,6 +48,12 @@ type MsgClient interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// SetTransferPolicy is a governance operation setting the transfer list mode and manager
+	// of a denom. An unspecified mode removes the transfer policy and the transfer list of the denom.
+	SetTransferPolicy(ctx context.Context, in *MsgSetTransferPolicy, opts ...grpc.CallOption) (*MsgSetTransferPolicyResponse, error)
+	// UpdateTransferList adds and removes addresses from the transfer list of a denom. It can be
+	// executed by the module authority or by the manager of the transfer policy of the denom.
+	UpdateTransferList(ctx context.Context, in *MsgUpdateTransferList, opts ...grpc.CallOption) (*MsgUpdateTransferListResponse, error)
 }
 
 type msgClient struct {
@@ -106,6 +114,26 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) SetTransferPolicy(ctx context.Context, in *MsgSetTransferPolicy, opts ...grpc.CallOption) (*MsgSetTransferPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTransferPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTransferList(ctx context.Context, in *MsgUpdateTransferList, opts ...grpc.CallOption) (*MsgUpdateTransferListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateTransferListResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTransferList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -126,6 +154,12 @@ type MsgServer interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// SetTransferPolicy is a governance operation setting the transfer list mode and manager
+	// of a denom. An unspecified mode removes the transfer policy and the transfer list of the denom.
+	SetTransferPolicy(context.Context, *MsgSetTransferPolicy) (*MsgSetTransferPolicyResponse, error)
+	// UpdateTransferList adds and removes addresses from the transfer list of a denom. It can be
+	// executed by the module authority or by the manager of the transfer policy of the denom.
+	UpdateTransferList(context.Context, *MsgUpdateTransferList) (*MsgUpdateTransferListResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -151,6 +185,12 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) SetTransferPolicy(context.Context, *MsgSetTransferPolicy) (*MsgSetTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferPolicy not implemented")
+}
+func (UnimplementedMsgServer) UpdateTransferList(context.Context, *MsgUpdateTransferList) (*MsgUpdateTransferListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransferList not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -262,6 +302,42 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetTransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTransferPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTransferPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTransferPolicy(ctx, req.(*MsgSetTransferPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTransferList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTransferList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTransferList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTransferList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTransferList(ctx, req.(*MsgUpdateTransferList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +365,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "SetTransferPolicy",
+			Handler:    _Msg_SetTransferPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateTransferList",
+			Handler:    _Msg_UpdateTransferList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Evidence_FullMethodName    = "/cosmos.evidence.v1beta1.Query/Evidence"
-	Query_AllEvidence_FullMethodName = "/cosmos.evidence.v1beta1.Query/AllEvidence"
+	Query_Evidence_FullMethodName              = "/cosmos.evidence.v1beta1.Query/Evidence"
+	Query_AllEvidence_FullMethodName           = "/cosmos.evidence.v1beta1.Query/AllEvidence"
+	Query_EquivocationDecision_FullMethodName  = "/cosmos.evidence.v1beta1.Query/EquivocationDecision"
+	Query_EquivocationDecisions_FullMethodName = "/cosmos.evidence.v1beta1.Query/EquivocationDecisions"
 )
 
 // QueryClient is the client API for Query service.
@@ -33,6 +35,10 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// EquivocationDecision queries the equivocation decision of an evidence based on evidence hash.
+	EquivocationDecision(ctx context.Context, in *QueryEquivocationDecisionRequest, opts ...grpc.CallOption) (*QueryEquivocationDecisionResponse, error)
+	// EquivocationDecisions queries all equivocation decisions.
+	EquivocationDecisions(ctx context.Context, in *QueryEquivocationDecisionsRequest, opts ...grpc.CallOption) (*QueryEquivocationDecisionsResponse, error)
 }
 
 type queryClient struct {
@@ -63,6 +69,26 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) EquivocationDecision(ctx context.Context, in *QueryEquivocationDecisionRequest, opts ...grpc.CallOption) (*QueryEquivocationDecisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEquivocationDecisionResponse)
+	err := c.cc.Invoke(ctx, Query_EquivocationDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EquivocationDecisions(ctx context.Context, in *QueryEquivocationDecisionsRequest, opts ...grpc.CallOption) (*QueryEquivocationDecisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEquivocationDecisionsResponse)
+	err := c.cc.Invoke(ctx, Query_EquivocationDecisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -73,6 +99,10 @@ type QueryServer interface {
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// EquivocationDecision queries the equivocation decision of an evidence based on evidence hash.
+	EquivocationDecision(context.Context, *QueryEquivocationDecisionRequest) (*QueryEquivocationDecisionResponse, error)
+	// EquivocationDecisions queries all equivocation decisions.
+	EquivocationDecisions(context.Context, *QueryEquivocationDecisionsRequest) (*QueryEquivocationDecisionsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -89,6 +119,12 @@ func (UnimplementedQueryServer) Evidence(context.Context, *QueryEvidenceRequest)
 func (UnimplementedQueryServer) AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (UnimplementedQueryServer) EquivocationDecision(context.Context, *QueryEquivocationDecisionRequest) (*QueryEquivocationDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EquivocationDecision not implemented")
+}
+func (UnimplementedQueryServer) EquivocationDecisions(context.Context, *QueryEquivocationDecisionsRequest) (*QueryEquivocationDecisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EquivocationDecisions not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -146,6 +182,42 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EquivocationDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEquivocationDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EquivocationDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EquivocationDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EquivocationDecision(ctx, req.(*QueryEquivocationDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EquivocationDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEquivocationDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EquivocationDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EquivocationDecisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EquivocationDecisions(ctx, req.(*QueryEquivocationDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +233,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "EquivocationDecision",
+			Handler:    _Query_EquivocationDecision_Handler,
+		},
+		{
+			MethodName: "EquivocationDecisions",
+			Handler:    _Query_EquivocationDecisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
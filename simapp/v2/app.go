@@ -22,6 +22,9 @@ import (
 	basedepinject "cosmossdk.io/x/accounts/defaults/base/depinject"
 	lockupdepinject "cosmossdk.io/x/accounts/defaults/lockup/depinject"
 	multisigdepinject "cosmossdk.io/x/accounts/defaults/multisig/depinject"
+	recoverydepinject "cosmossdk.io/x/accounts/defaults/recovery/depinject"
+	sessionkeydepinject "cosmossdk.io/x/accounts/defaults/sessionkey/depinject"
+	timelockdepinject "cosmossdk.io/x/accounts/defaults/timelock/depinject"
 	stakingkeeper "cosmossdk.io/x/staking/keeper"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
@@ -61,6 +64,9 @@ func AppConfig() depinject.Config {
 			multisigdepinject.ProvideAccount,
 			basedepinject.ProvideAccount,
 			lockupdepinject.ProvideAllLockupAccounts,
+			sessionkeydepinject.ProvideAccount,
+			recoverydepinject.ProvideAccount,
+			timelockdepinject.ProvideAccount,
 
 			// provide base account options
 			basedepinject.ProvideSecp256K1PubKey,
@@ -18,6 +18,9 @@ require (
 	cosmossdk.io/x/accounts v0.0.0-20240913065641-0064ccbce64e
 	cosmossdk.io/x/accounts/defaults/base v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/accounts/defaults/multisig v0.0.0-00010101000000-000000000000
+	cosmossdk.io/x/accounts/defaults/recovery v0.0.0-00010101000000-000000000000
+	cosmossdk.io/x/accounts/defaults/sessionkey v0.0.0-00010101000000-000000000000
+	cosmossdk.io/x/accounts/defaults/timelock v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/authz v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/bank v0.0.0-20240226161501-23359a0b6d91
 	cosmossdk.io/x/circuit v0.0.0-20230613133644-0a778132a60f
@@ -267,6 +270,9 @@ replace (
 	cosmossdk.io/x/accounts/defaults/base => ../../x/accounts/defaults/base
 	cosmossdk.io/x/accounts/defaults/lockup => ../../x/accounts/defaults/lockup
 	cosmossdk.io/x/accounts/defaults/multisig => ../../x/accounts/defaults/multisig
+	cosmossdk.io/x/accounts/defaults/recovery => ../../x/accounts/defaults/recovery
+	cosmossdk.io/x/accounts/defaults/sessionkey => ../../x/accounts/defaults/sessionkey
+	cosmossdk.io/x/accounts/defaults/timelock => ../../x/accounts/defaults/timelock
 	cosmossdk.io/x/authz => ../../x/authz
 	cosmossdk.io/x/bank => ../../x/bank
 	cosmossdk.io/x/circuit => ../../x/circuit
@@ -11,16 +11,17 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"cosmossdk.io/runtime/v2"
-	"cosmossdk.io/runtime/v2/services"
-	"cosmossdk.io/server/v2/stf"
 	"cosmossdk.io/x/accounts"
 	basedepinject "cosmossdk.io/x/accounts/defaults/base/depinject"
+	lockupdepinject "cosmossdk.io/x/accounts/defaults/lockup/depinject"
 	accountsv1 "cosmossdk.io/x/accounts/v1"
 	_ "cosmossdk.io/x/bank" // import as blank for app wiring
 	bankkeeper "cosmossdk.io/x/bank/keeper"
 	banktypes "cosmossdk.io/x/bank/types"
 	_ "cosmossdk.io/x/consensus" // import as blank for app wiring
 	_ "cosmossdk.io/x/staking"   // import as blank for app wirings
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/tests/integration/v2"
 	"github.com/cosmos/cosmos-sdk/testutil/configurator"
@@ -39,6 +40,7 @@ type suite struct {
 	authKeeper     authkeeper.AccountKeeper
 	accountsKeeper accounts.Keeper
 	bankKeeper     bankkeeper.Keeper
+	stakingKeeper  *stakingkeeper.Keeper
 }
 
 func (s suite) mustAddr(address []byte) string {
@@ -79,7 +81,7 @@ func createTestSuite(t *testing.T) *suite {
 
 	startupCfg.BranchService = &integration.BranchService{}
 	startupCfg.RouterServiceBuilder = serviceBuilder
-	startupCfg.HeaderService = services.NewGenesisHeaderService(stf.HeaderService{})
+	startupCfg.HeaderService = &integration.HeaderService{}
 
 	res.app, err = integration.NewApp(
 		depinject.Configs(configurator.NewAppV2Config(moduleConfigs...), depinject.Provide(
@@ -89,13 +91,16 @@ func createTestSuite(t *testing.T) *suite {
 			// provide base account options
 			basedepinject.ProvideSecp256K1PubKey,
 
+			// lockup accounts are the targets of vesting accounts migrations
+			lockupdepinject.ProvideAllLockupAccounts,
+
 			// provide extra accounts
 			ProvideMockRetroCompatAccountValid,
 			ProvideMockRetroCompatAccountNoInfo,
 			ProvideMockRetroCompatAccountNoImplement,
 		), depinject.Supply(log.NewNopLogger())),
 		startupCfg,
-		&res.bankKeeper, &res.accountsKeeper, &res.authKeeper)
+		&res.bankKeeper, &res.accountsKeeper, &res.authKeeper, &res.stakingKeeper)
 	require.NoError(t, err)
 
 	res.ctx = res.app.StateLatestContext(t)
@@ -130,5 +135,16 @@ func (s *suite) registerQueryRouterService(router *integration.RouterService) {
 		return resp, err
 	}
 
+	stakingParamsQueryHandler := func(ctx context.Context, msg transaction.Msg) (transaction.Msg, error) {
+		req, ok := msg.(*stakingtypes.QueryParamsRequest)
+		if !ok {
+			return nil, integration.ErrInvalidMsgType
+		}
+		qs := stakingkeeper.NewQuerier(s.stakingKeeper)
+		resp, err := qs.Params(ctx, req)
+		return resp, err
+	}
+
 	router.RegisterHandler(queryHandler, "cosmos.accounts.v1.AccountNumberRequest")
+	router.RegisterHandler(stakingParamsQueryHandler, "cosmos.staking.v1beta1.QueryParamsRequest")
 }
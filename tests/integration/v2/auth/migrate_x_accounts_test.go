@@ -2,17 +2,23 @@ package auth
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	lockupv1 "cosmossdk.io/x/accounts/defaults/lockup/v1"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 func TestMigrateToAccounts(t *testing.T) {
@@ -58,7 +64,7 @@ func TestMigrateToAccounts(t *testing.T) {
 			AccountInitMsg: nil,
 		})
 		require.Nil(t, resp)
-		require.ErrorContains(t, err, "only BaseAccount can be migrated")
+		require.ErrorContains(t, err, "only BaseAccount and vesting accounts can be migrated")
 	})
 
 	t.Run("success", func(t *testing.T) {
@@ -97,4 +103,276 @@ func TestMigrateToAccounts(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, migrateMsg.PubKey, pkResp.(*basev1.QueryPubKeyResponse).PubKey)
 	})
+
+	t.Run("success without init msg", func(t *testing.T) {
+		privKey := secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(privKey.PubKey().Address())
+
+		acc := f.authKeeper.NewAccountWithAddress(f.ctx, addr)
+		require.NoError(t, acc.SetPubKey(privKey.PubKey()))
+		require.NoError(t, acc.SetSequence(7))
+		f.authKeeper.SetAccount(f.ctx, acc)
+
+		_, err := msgSrv.MigrateAccount(f.ctx, &authtypes.MsgMigrateAccount{
+			Signer:      f.mustAddr(addr),
+			AccountType: "multisig",
+		})
+		require.ErrorContains(t, err, "account must be migrated to a base account")
+
+		_, err = msgSrv.MigrateAccount(f.ctx, &authtypes.MsgMigrateAccount{
+			Signer:      f.mustAddr(addr),
+			AccountType: "base",
+		})
+		require.NoError(t, err)
+		require.Nil(t, f.authKeeper.GetAccount(f.ctx, addr))
+
+		// the pubkey and the sequence are kept
+		seq, err := f.accountsKeeper.Query(f.ctx, addr, &basev1.QuerySequence{})
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), seq.(*basev1.QuerySequenceResponse).Sequence)
+
+		pkResp, err := f.accountsKeeper.Query(f.ctx, addr, &basev1.QueryPubKey{})
+		require.NoError(t, err)
+		pk, err := codectypes.NewAnyWithValue(privKey.PubKey())
+		require.NoError(t, err)
+		require.Equal(t, pk, pkResp.(*basev1.QueryPubKeyResponse).PubKey)
+	})
+}
+
+func TestMigrateVestingToAccounts(t *testing.T) {
+	f := createTestSuite(t)
+	msgSrv := authkeeper.NewMsgServerImpl(f.authKeeper)
+
+	owner := f.mustAddr([]byte("owner"))
+	originalVesting := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(365 * 24 * time.Hour)
+
+	newBaseAccount := func() *authtypes.BaseAccount {
+		privKey := secp256k1.GenPrivKey()
+		acc := f.authKeeper.NewAccountWithAddress(f.ctx, sdk.AccAddress(privKey.PubKey().Address()))
+		require.NoError(t, acc.SetPubKey(privKey.PubKey()))
+		return acc.(*authtypes.BaseAccount)
+	}
+
+	ownerMsg, err := codectypes.NewAnyWithValue(&lockupv1.MsgInitLockupAccount{Owner: owner})
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name        string
+		makeAccount func(*authtypes.BaseAccount) (sdk.AccountI, error)
+		accountType string
+		startTime   time.Time
+		endTime     time.Time
+		expErr      string
+	}{
+		{
+			name: "continuous vesting account",
+			makeAccount: func(base *authtypes.BaseAccount) (sdk.AccountI, error) {
+				return vestingtypes.NewContinuousVestingAccount(base, originalVesting, start.Unix(), end.Unix())
+			},
+			accountType: "continuous-locking-account",
+			startTime:   start,
+			endTime:     end,
+		},
+		{
+			name: "delayed vesting account",
+			makeAccount: func(base *authtypes.BaseAccount) (sdk.AccountI, error) {
+				return vestingtypes.NewDelayedVestingAccount(base, originalVesting, end.Unix())
+			},
+			accountType: "delayed-locking-account",
+			endTime:     end,
+		},
+		{
+			name: "periodic vesting account",
+			makeAccount: func(base *authtypes.BaseAccount) (sdk.AccountI, error) {
+				return vestingtypes.NewPeriodicVestingAccount(base, originalVesting, start.Unix(), vestingtypes.Periods{
+					{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 400))},
+					{Length: 7200, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 600))},
+				})
+			},
+			accountType: "periodic-locking-account",
+			startTime:   start,
+			endTime:     start.Add(3 * time.Hour),
+		},
+		{
+			name: "permanent locked account",
+			makeAccount: func(base *authtypes.BaseAccount) (sdk.AccountI, error) {
+				return vestingtypes.NewPermanentLockedAccount(base, originalVesting)
+			},
+			accountType: "permanent-locking-account",
+		},
+		{
+			name: "vesting account with delegations",
+			makeAccount: func(base *authtypes.BaseAccount) (sdk.AccountI, error) {
+				acc, err := vestingtypes.NewContinuousVestingAccount(base, originalVesting, start.Unix(), end.Unix())
+				if err != nil {
+					return nil, err
+				}
+				acc.TrackDelegation(start, originalVesting, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+				return acc, nil
+			},
+			accountType: "continuous-locking-account",
+			expErr:      "vesting account with delegations cannot be migrated",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			acc, err := tc.makeAccount(newBaseAccount())
+			require.NoError(t, err)
+			f.authKeeper.SetAccount(f.ctx, acc)
+			addr := acc.GetAddress()
+
+			_, err = msgSrv.MigrateAccount(f.ctx, &authtypes.MsgMigrateAccount{
+				Signer:         f.mustAddr(addr),
+				AccountType:    "base",
+				AccountInitMsg: ownerMsg,
+			})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.ErrorContains(t, err, "account must be migrated to a "+tc.accountType+" account")
+
+			// the account cannot own itself, as it can no longer sign once migrated
+			selfOwnerMsg, err := codectypes.NewAnyWithValue(&lockupv1.MsgInitLockupAccount{Owner: f.mustAddr(addr)})
+			require.NoError(t, err)
+			_, err = msgSrv.MigrateAccount(f.ctx, &authtypes.MsgMigrateAccount{
+				Signer:         f.mustAddr(addr),
+				AccountType:    tc.accountType,
+				AccountInitMsg: selfOwnerMsg,
+			})
+			require.ErrorContains(t, err, "the owner of a lockup account cannot be the account itself")
+
+			_, err = msgSrv.MigrateAccount(f.ctx, &authtypes.MsgMigrateAccount{
+				Signer:         f.mustAddr(addr),
+				AccountType:    tc.accountType,
+				AccountInitMsg: ownerMsg,
+			})
+			require.NoError(t, err)
+
+			// check the account was removed from x/auth and added to x/accounts, with the same account number
+			require.Nil(t, f.authKeeper.GetAccount(f.ctx, addr))
+			accNum, err := f.accountsKeeper.AccountByNumber.Get(f.ctx, addr)
+			require.NoError(t, err)
+			require.Equal(t, acc.GetAccountNumber(), accNum)
+
+			// check the vesting schedule was kept
+			resp, err := f.accountsKeeper.Query(f.ctx, addr, &lockupv1.QueryLockupAccountInfoRequest{})
+			require.NoError(t, err)
+			info := resp.(*lockupv1.QueryLockupAccountInfoResponse)
+			require.Equal(t, owner, info.Owner)
+			require.Equal(t, originalVesting, info.OriginalLocking)
+			if !tc.startTime.IsZero() {
+				require.True(t, tc.startTime.Equal(*info.StartTime))
+			}
+			if !tc.endTime.IsZero() {
+				require.True(t, tc.endTime.Equal(*info.EndTime))
+			}
+		})
+	}
+}
+
+func TestMigrateVestingToAccountsWithKeyOwner(t *testing.T) {
+	f := createTestSuite(t)
+	msgSrv := authkeeper.NewMsgServerImpl(f.authKeeper)
+
+	privKey := secp256k1.GenPrivKey()
+	base := f.authKeeper.NewAccountWithAddress(f.ctx, sdk.AccAddress(privKey.PubKey().Address()))
+	require.NoError(t, base.SetPubKey(privKey.PubKey()))
+	require.NoError(t, base.SetSequence(7))
+	acc, err := vestingtypes.NewDelayedVestingAccount(base.(*authtypes.BaseAccount), sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), time.Now().Add(time.Hour).Unix())
+	require.NoError(t, err)
+	f.authKeeper.SetAccount(f.ctx, acc)
+	addr := acc.GetAddress()
+
+	// without owner, the lockup account is owned by a new base account holding the key of the vesting account
+	_, err = msgSrv.MigrateAccount(f.ctx, &authtypes.MsgMigrateAccount{
+		Signer:      f.mustAddr(addr),
+		AccountType: "delayed-locking-account",
+	})
+	require.NoError(t, err)
+	require.Nil(t, f.authKeeper.GetAccount(f.ctx, addr))
+
+	resp, err := f.accountsKeeper.Query(f.ctx, addr, &lockupv1.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	owner, err := f.authKeeper.AddressCodec().StringToBytes(resp.(*lockupv1.QueryLockupAccountInfoResponse).Owner)
+	require.NoError(t, err)
+	require.NotEqual(t, addr, sdk.AccAddress(owner))
+
+	pkResp, err := f.accountsKeeper.Query(f.ctx, owner, &basev1.QueryPubKey{})
+	require.NoError(t, err)
+	pk, err := codectypes.NewAnyWithValue(privKey.PubKey())
+	require.NoError(t, err)
+	require.Equal(t, pk, pkResp.(*basev1.QueryPubKeyResponse).PubKey)
+
+	seq, err := f.accountsKeeper.Query(f.ctx, owner, &basev1.QuerySequence{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), seq.(*basev1.QuerySequenceResponse).Sequence)
+}
+
+func TestMigrateLegacyAccounts(t *testing.T) {
+	f := createTestSuite(t)
+
+	owner := f.mustAddr([]byte("owner"))
+	originalVesting := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	// migrate the genesis accounts first
+	_, err := f.authKeeper.MigrateLegacyAccounts(f.ctx, nil)
+	require.NoError(t, err)
+
+	newBaseAccount := func(withPubKey bool) *authtypes.BaseAccount {
+		privKey := secp256k1.GenPrivKey()
+		acc := f.authKeeper.NewAccountWithAddress(f.ctx, sdk.AccAddress(privKey.PubKey().Address()))
+		if withPubKey {
+			require.NoError(t, acc.SetPubKey(privKey.PubKey()))
+		}
+		return acc.(*authtypes.BaseAccount)
+	}
+
+	withPubKey := newBaseAccount(true)
+	f.authKeeper.SetAccount(f.ctx, withPubKey)
+	withoutPubKey := newBaseAccount(false)
+	f.authKeeper.SetAccount(f.ctx, withoutPubKey)
+
+	// the base account of the test app only supports secp256k1 pubkeys
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()})
+	withMultisig := f.authKeeper.NewAccountWithAddress(f.ctx, sdk.AccAddress(multisigPubKey.Address()))
+	require.NoError(t, withMultisig.SetPubKey(multisigPubKey))
+	f.authKeeper.SetAccount(f.ctx, withMultisig)
+
+	owned, err := vestingtypes.NewDelayedVestingAccount(newBaseAccount(true), originalVesting, time.Now().Add(time.Hour).Unix())
+	require.NoError(t, err)
+	f.authKeeper.SetAccount(f.ctx, owned)
+	keyOwned, err := vestingtypes.NewPermanentLockedAccount(newBaseAccount(true), originalVesting)
+	require.NoError(t, err)
+	f.authKeeper.SetAccount(f.ctx, keyOwned)
+	notOwned, err := vestingtypes.NewPermanentLockedAccount(newBaseAccount(false), originalVesting)
+	require.NoError(t, err)
+	f.authKeeper.SetAccount(f.ctx, notOwned)
+
+	migrated, err := f.authKeeper.MigrateLegacyAccounts(f.ctx, func(acc vestingexported.VestingAccount) (string, bool) {
+		return owner, acc.GetAddress().Equals(owned.GetAddress())
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, migrated)
+
+	for _, acc := range []sdk.AccountI{withPubKey, owned, keyOwned} {
+		require.Nil(t, f.authKeeper.GetAccount(f.ctx, acc.GetAddress()))
+		require.True(t, f.accountsKeeper.IsAccountsModuleAccount(f.ctx, acc.GetAddress()))
+	}
+
+	// the vesting account without owner is owned by its key
+	resp, err := f.accountsKeeper.Query(f.ctx, keyOwned.GetAddress(), &lockupv1.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	keyOwner, err := f.authKeeper.AddressCodec().StringToBytes(resp.(*lockupv1.QueryLockupAccountInfoResponse).Owner)
+	require.NoError(t, err)
+	require.True(t, f.accountsKeeper.IsAccountsModuleAccount(f.ctx, keyOwner))
+
+	// accounts that cannot be migrated are kept in x/auth
+	for _, acc := range []sdk.AccountI{withoutPubKey, withMultisig, notOwned} {
+		require.NotNil(t, f.authKeeper.GetAccount(f.ctx, acc.GetAddress()))
+		require.False(t, f.accountsKeeper.IsAccountsModuleAccount(f.ctx, acc.GetAddress()))
+	}
 }
@@ -0,0 +1,145 @@
+package distribution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	distrkeeper "cosmossdk.io/x/distribution/keeper"
+	distrtypes "cosmossdk.io/x/distribution/types"
+	stakingtestutil "cosmossdk.io/x/staking/testutil"
+
+	"github.com/cosmos/cosmos-sdk/tests/integration/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAutoCompound(t *testing.T) {
+	t.Parallel()
+	f := createTestFixture(t)
+
+	params := distrtypes.DefaultParams()
+	params.AutoCompoundEpochIdentifier = "day"
+	params.AutoCompoundMaxPerBlock = 1
+	require.NoError(t, f.distrKeeper.Params.Set(f.ctx, params))
+
+	setupValidatorWithCommission(t, f, f.valAddr, 1_000_000)
+	msgServer := distrkeeper.NewMsgServerImpl(f.distrKeeper)
+
+	// the delegators opt in to auto-compounding
+	delAddrs := []sdk.AccAddress{sdk.AccAddress(PKS[1].Address()), sdk.AccAddress(PKS[2].Address())}
+	delTokens := math.NewInt(1_000_000)
+	tstaking := stakingtestutil.NewHelper(t, f.ctx, f.stakingKeeper)
+	for _, delAddr := range delAddrs {
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+		require.NoError(t, f.bankKeeper.MintCoins(f.ctx, distrtypes.ModuleName, coins))
+		require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.ctx, distrtypes.ModuleName, delAddr, coins))
+		f.authKeeper.SetAccount(f.ctx, f.authKeeper.NewAccountWithAddress(f.ctx, delAddr))
+		tstaking.Delegate(delAddr.String(), f.valAddr.String(), delTokens)
+
+		_, err := msgServer.SetAutoCompound(f.ctx, distrtypes.NewMsgSetAutoCompound(delAddr.String(), f.valAddr.String(), true))
+		require.NoError(t, err)
+	}
+
+	res, err := f.queryClient.DelegatorAutoCompound(f.ctx, &distrtypes.QueryDelegatorAutoCompoundRequest{DelegatorAddress: delAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{f.valAddr.String()}, res.Validators)
+
+	// allocate rewards to the validator in a later block
+	f.ctx = integration.SetHeaderInfo(f.ctx, header.Info{Height: integration.HeaderInfoFromContext(f.ctx).Height + 1})
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(30_000)))
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, distrtypes.ModuleName, rewards))
+	val, err := f.stakingKeeper.Validator(f.ctx, f.valAddr)
+	require.NoError(t, err)
+	require.NoError(t, f.distrKeeper.AllocateTokensToValidator(f.ctx, val, sdk.NewDecCoinsFromCoins(rewards...)))
+
+	sharesOf := func(delAddr sdk.AccAddress) math.LegacyDec {
+		t.Helper()
+		del, err := f.stakingKeeper.Delegations.Get(f.ctx, collections.Join(delAddr, f.valAddr))
+		require.NoError(t, err)
+		return del.Shares
+	}
+	initialShares := []math.LegacyDec{sharesOf(delAddrs[0]), sharesOf(delAddrs[1])}
+
+	// nothing is compounded before the end of the auto-compound epoch
+	require.NoError(t, f.distrKeeper.StartAutoCompound(f.ctx, "week", 1))
+	require.NoError(t, f.distrKeeper.CompoundDelegations(f.ctx))
+	require.Equal(t, initialShares[0], sharesOf(delAddrs[0]))
+
+	// a single delegation is compounded per block
+	require.NoError(t, f.distrKeeper.StartAutoCompound(f.ctx, "day", 1))
+	require.NoError(t, f.distrKeeper.CompoundDelegations(f.ctx))
+	compounded := 0
+	for i, delAddr := range delAddrs {
+		if sharesOf(delAddr).GT(initialShares[i]) {
+			compounded++
+		}
+		// no reward is left in the bond denom on the delegator account
+		require.True(t, f.bankKeeper.GetBalance(f.ctx, delAddr, sdk.DefaultBondDenom).IsZero())
+	}
+	require.Equal(t, 1, compounded)
+
+	progress, err := f.queryClient.AutoCompoundProgress(f.ctx, &distrtypes.QueryAutoCompoundProgressRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, progress.Progress)
+	require.Equal(t, int64(1), progress.Progress.EpochNumber)
+	require.Less(t, progress.Progress.GasRemaining, params.AutoCompoundGasBudget)
+	compoundGas := params.AutoCompoundGasBudget - progress.Progress.GasRemaining
+
+	require.NoError(t, f.distrKeeper.CompoundDelegations(f.ctx))
+	for i, delAddr := range delAddrs {
+		require.True(t, sharesOf(delAddr).GT(initialShares[i]))
+	}
+
+	progress, err = f.queryClient.AutoCompoundProgress(f.ctx, &distrtypes.QueryAutoCompoundProgressRequest{})
+	require.NoError(t, err)
+	require.Nil(t, progress.Progress)
+
+	// the compounding stops once the gas budget is spent and resumes in the next epoch
+	params.AutoCompoundGasBudget = compoundGas * 3 / 2
+	params.AutoCompoundMaxPerBlock = 2
+	require.NoError(t, f.distrKeeper.Params.Set(f.ctx, params))
+	f.ctx = integration.SetHeaderInfo(f.ctx, header.Info{Height: integration.HeaderInfoFromContext(f.ctx).Height + 1})
+	require.NoError(t, f.distrKeeper.AllocateTokensToValidator(f.ctx, val, sdk.NewDecCoinsFromCoins(rewards...)))
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, distrtypes.ModuleName, rewards))
+	require.NoError(t, f.distrKeeper.StartAutoCompound(f.ctx, "day", 2))
+	require.NoError(t, f.distrKeeper.CompoundDelegations(f.ctx))
+
+	budgetSpent, err := f.distrKeeper.AutoCompoundProgress.Get(f.ctx)
+	require.NoError(t, err)
+	require.Zero(t, budgetSpent.GasRemaining)
+	require.Equal(t, delAddrs[1].String(), budgetSpent.NextDelegatorAddress)
+
+	params.AutoCompoundGasBudget = distrtypes.DefaultAutoCompoundGasBudget
+	require.NoError(t, f.distrKeeper.Params.Set(f.ctx, params))
+	require.NoError(t, f.distrKeeper.StartAutoCompound(f.ctx, "day", 3))
+	resumed, err := f.distrKeeper.AutoCompoundProgress.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, budgetSpent.NextDelegatorAddress, resumed.NextDelegatorAddress)
+	require.Equal(t, params.AutoCompoundGasBudget, resumed.GasRemaining)
+
+	// a delegation which cannot be compounded with the whole gas budget is dropped, instead of
+	// blocking the compounding of the next delegations every epoch
+	params.AutoCompoundGasBudget = 1
+	require.NoError(t, f.distrKeeper.Params.Set(f.ctx, params))
+	require.NoError(t, f.distrKeeper.AutoCompoundProgress.Remove(f.ctx))
+	require.NoError(t, f.distrKeeper.StartAutoCompound(f.ctx, "day", 4))
+	require.NoError(t, f.distrKeeper.CompoundDelegations(f.ctx))
+
+	has, err := f.distrKeeper.AutoCompoundDelegations.Has(f.ctx, collections.Join(delAddrs[0], f.valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	skipped, err := f.distrKeeper.AutoCompoundProgress.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, delAddrs[1].String(), skipped.NextDelegatorAddress)
+
+	// opting out stops the compounding of a delegation
+	_, err = msgServer.SetAutoCompound(f.ctx, distrtypes.NewMsgSetAutoCompound(delAddrs[1].String(), f.valAddr.String(), false))
+	require.NoError(t, err)
+	has, err = f.distrKeeper.AutoCompoundDelegations.Has(f.ctx, collections.Join(delAddrs[1], f.valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+}
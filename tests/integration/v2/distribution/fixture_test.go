@@ -25,6 +25,7 @@ import (
 	poolkeeper "cosmossdk.io/x/protocolpool/keeper"
 	_ "cosmossdk.io/x/staking" // import as blank for app wiring
 	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/tests/integration/v2"
@@ -152,6 +153,18 @@ func (s *fixture) registerMsgRouterService(router *integration.RouterService) {
 	}
 
 	router.RegisterHandler(bankSendHandler, "cosmos.bank.v1beta1.MsgSend")
+
+	stakingDelegateHandler := func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+		msg, ok := req.(*stakingtypes.MsgDelegate)
+		if !ok {
+			return nil, integration.ErrInvalidMsgType
+		}
+		msgServer := stakingkeeper.NewMsgServerImpl(s.stakingKeeper)
+		resp, err := msgServer.Delegate(ctx, msg)
+		return resp, err
+	}
+
+	router.RegisterHandler(stakingDelegateHandler, "cosmos.staking.v1beta1.MsgDelegate")
 }
 
 func (s *fixture) registerQueryRouterService(router *integration.RouterService) {
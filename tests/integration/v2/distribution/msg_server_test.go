@@ -626,10 +626,12 @@ func TestMsgUpdateParams(t *testing.T) {
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyZeroDec(),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityTax:            communityTax,
+					BaseProposerReward:      math.LegacyZeroDec(),
+					BonusProposerReward:     math.LegacyZeroDec(),
+					WithdrawAddrEnabled:     withdrawAddrEnabled,
+					AutoCompoundMaxPerBlock: distrtypes.DefaultAutoCompoundMaxPerBlock,
+					AutoCompoundGasBudget:   distrtypes.DefaultAutoCompoundGasBudget,
 				},
 			},
 			expErr: false,
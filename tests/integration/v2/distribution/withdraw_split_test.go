@@ -0,0 +1,78 @@
+package distribution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	distrkeeper "cosmossdk.io/x/distribution/keeper"
+	distrtypes "cosmossdk.io/x/distribution/types"
+	stakingtestutil "cosmossdk.io/x/staking/testutil"
+
+	"github.com/cosmos/cosmos-sdk/tests/integration/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWithdrawSplit(t *testing.T) {
+	t.Parallel()
+	f := createTestFixture(t)
+
+	setupValidatorWithCommission(t, f, f.valAddr, 1_000_000)
+	msgServer := distrkeeper.NewMsgServerImpl(f.distrKeeper)
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	delTokens := math.NewInt(1_000_000)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, distrtypes.ModuleName, coins))
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.ctx, distrtypes.ModuleName, delAddr, coins))
+	f.authKeeper.SetAccount(f.ctx, f.authKeeper.NewAccountWithAddress(f.ctx, delAddr))
+	stakingtestutil.NewHelper(t, f.ctx, f.stakingKeeper).Delegate(delAddr.String(), f.valAddr.String(), delTokens)
+
+	// the delegator splits its rewards between two addresses
+	recipientAddrs := []sdk.AccAddress{sdk.AccAddress("split_recipient_1___"), sdk.AccAddress("split_recipient_2___")}
+	recipients := []distrtypes.WithdrawRecipient{
+		{Address: recipientAddrs[0].String(), Fraction: math.LegacyNewDecWithPrec(25, 2)},
+		{Address: recipientAddrs[1].String(), Fraction: math.LegacyNewDecWithPrec(75, 2)},
+	}
+	_, err := msgServer.SetWithdrawSplit(f.ctx, distrtypes.NewMsgSetWithdrawSplit(delAddr.String(), recipients))
+	require.NoError(t, err)
+
+	res, err := f.queryClient.DelegatorWithdrawSplit(f.ctx, &distrtypes.QueryDelegatorWithdrawSplitRequest{DelegatorAddress: delAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, recipients, res.Recipients)
+
+	// allocate rewards to the validator in a later block
+	f.ctx = integration.SetHeaderInfo(f.ctx, header.Info{Height: integration.HeaderInfoFromContext(f.ctx).Height + 1})
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(30_001)))
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, distrtypes.ModuleName, rewards))
+	val, err := f.stakingKeeper.Validator(f.ctx, f.valAddr)
+	require.NoError(t, err)
+	require.NoError(t, f.distrKeeper.AllocateTokensToValidator(f.ctx, val, sdk.NewDecCoinsFromCoins(rewards...)))
+
+	withdrawn, err := msgServer.WithdrawDelegatorReward(f.ctx, distrtypes.NewMsgWithdrawDelegatorReward(delAddr.String(), f.valAddr.String()))
+	require.NoError(t, err)
+	total := withdrawn.Amount.AmountOf(sdk.DefaultBondDenom)
+	require.True(t, total.IsPositive())
+
+	// the first recipient receives its truncated share, the last one the remainder
+	first := math.LegacyNewDecFromInt(total).Mul(recipients[0].Fraction).TruncateInt()
+	require.Equal(t, first, f.bankKeeper.GetBalance(f.ctx, recipientAddrs[0], sdk.DefaultBondDenom).Amount)
+	require.Equal(t, total.Sub(first), f.bankKeeper.GetBalance(f.ctx, recipientAddrs[1], sdk.DefaultBondDenom).Amount)
+	require.True(t, f.bankKeeper.GetBalance(f.ctx, delAddr, sdk.DefaultBondDenom).IsZero())
+
+	// removing the split sends the rewards to the withdraw address again
+	_, err = msgServer.SetWithdrawSplit(f.ctx, distrtypes.NewMsgSetWithdrawSplit(delAddr.String(), nil))
+	require.NoError(t, err)
+
+	res, err = f.queryClient.DelegatorWithdrawSplit(f.ctx, &distrtypes.QueryDelegatorWithdrawSplitRequest{DelegatorAddress: delAddr.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Recipients)
+
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, distrtypes.ModuleName, rewards))
+	require.NoError(t, f.distrKeeper.AllocateTokensToValidator(f.ctx, val, sdk.NewDecCoinsFromCoins(rewards...)))
+	withdrawn, err = msgServer.WithdrawDelegatorReward(f.ctx, distrtypes.NewMsgWithdrawDelegatorReward(delAddr.String(), f.valAddr.String()))
+	require.NoError(t, err)
+	require.Equal(t, withdrawn.Amount.AmountOf(sdk.DefaultBondDenom), f.bankKeeper.GetBalance(f.ctx, delAddr, sdk.DefaultBondDenom).Amount)
+}
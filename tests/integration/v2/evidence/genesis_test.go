@@ -2,18 +2,24 @@ package evidence
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/evidence"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/keeper"
 	"cosmossdk.io/x/evidence/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type GenesisTestSuite struct {
@@ -152,6 +158,78 @@ func (suite *GenesisTestSuite) TestExportGenesis() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestExportImportGenesis() {
+	pk := ed25519.GenPrivKey()
+	consAddr := sdk.ConsAddress(pk.PubKey().Address())
+	consStr, err := address.NewBech32Codec("cosmosvalcons").BytesToString(consAddr)
+	suite.Require().NoError(err)
+	valStr, err := address.NewBech32Codec("cosmosvaloper").BytesToString(consAddr)
+	suite.Require().NoError(err)
+
+	equivocation := &types.Equivocation{
+		Height:           1,
+		Power:            100,
+		Time:             time.Now().UTC(),
+		ConsensusAddress: consStr,
+	}
+	attack := &types.LightClientAttack{
+		Height:           2,
+		Power:            100,
+		Time:             time.Now().UTC(),
+		ConsensusAddress: consStr,
+		TotalPower:       300,
+	}
+	decisions := []types.EquivocationDecision{
+		{
+			EvidenceHash:     strings.ToUpper(hex.EncodeToString(equivocation.Hash())),
+			ConsensusAddress: consStr,
+			ValidatorAddress: valStr,
+			Height:           1,
+			Outcome:          types.EQUIVOCATION_OUTCOME_ROTATION_GRACE,
+			SlashFraction:    math.LegacyNewDecWithPrec(1, 2),
+			JailedUntil:      time.Unix(100, 0).UTC(),
+			RotationHeight:   1,
+		},
+		{
+			EvidenceHash:     strings.ToUpper(hex.EncodeToString(attack.Hash())),
+			ConsensusAddress: consStr,
+			ValidatorAddress: valStr,
+			Height:           2,
+			Outcome:          types.EQUIVOCATION_OUTCOME_TOMBSTONED,
+			SlashFraction:    math.LegacyNewDecWithPrec(5, 2),
+			JailedUntil:      types.DoubleSignJailEndTime.UTC(),
+		},
+	}
+
+	genesisState := types.NewGenesisState([]exported.Evidence{equivocation, attack})
+	genesisState.Decisions = decisions
+	suite.Require().NoError(evidence.InitGenesis(suite.ctx, suite.keeper, genesisState))
+
+	// the graced rotation is rebuilt from the decisions
+	graced, err := suite.keeper.GracedRotations.Has(suite.ctx, collections.Join([]byte(consAddr), uint64(1)))
+	suite.Require().NoError(err)
+	suite.Require().True(graced)
+
+	exportedGenesis, err := evidence.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().NoError(err)
+	suite.Require().Len(exportedGenesis.Evidence, 2)
+	suite.Require().ElementsMatch(decisions, exportedGenesis.Decisions)
+
+	// the exported genesis can be imported again
+	suite.SetupTest()
+	suite.Require().NoError(evidence.InitGenesis(suite.ctx, suite.keeper, exportedGenesis))
+
+	for _, e := range []exported.Evidence{equivocation, attack} {
+		stored, err := suite.keeper.Evidences.Get(suite.ctx, e.Hash())
+		suite.Require().NoError(err)
+		suite.Require().Equal(e.Hash(), stored.Hash())
+		suite.Require().Equal(e.Route(), stored.Route())
+	}
+	reexportedGenesis, err := evidence.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().NoError(err)
+	suite.Require().Equal(exportedGenesis, reexportedGenesis)
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
@@ -24,6 +24,7 @@ import (
 	_ "cosmossdk.io/x/evidence" // import as blank for app wiring
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/keeper"
+	evidencetypes "cosmossdk.io/x/evidence/types"
 	minttypes "cosmossdk.io/x/mint/types"
 	_ "cosmossdk.io/x/slashing" // import as blank for app wiring
 	slashingkeeper "cosmossdk.io/x/slashing/keeper"
@@ -212,6 +213,65 @@ func TestHandleDoubleSign(t *testing.T) {
 	assert.Assert(t, len(values) == 1)
 }
 
+func TestHandleLightClientAttack(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.ctx
+	populateValidators(t, f)
+
+	power := int64(100)
+	operatorAddr, valpubkey := valAddresses[0], pubkeys[0]
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	f.accountKeeper.SetAccount(f.ctx, f.accountKeeper.NewAccountWithAddress(f.ctx, sdk.AccAddress(operatorAddr)))
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, valpubkey, power, true)
+
+	_, err := f.stakingKeeper.EndBlocker(f.ctx)
+	assert.NilError(t, err)
+
+	// handle a signature to set signing info
+	err = f.slashingKeeper.HandleValidatorSignature(ctx, valpubkey.Address(), selfDelegation.Int64(), comet.BlockIDFlagCommit)
+	assert.NilError(t, err)
+
+	val, err := f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	oldTokens := val.GetTokens()
+
+	nci := comet.Info{
+		Evidence: []comet.Evidence{{
+			Validator:        comet.Validator{Address: valpubkey.Address(), Power: power},
+			Type:             comet.LightClientAttack,
+			Time:             time.Now().UTC(),
+			Height:           1,
+			TotalVotingPower: 3 * power,
+		}},
+	}
+
+	ctx = integration.SetCometInfo(ctx, nci)
+	assert.NilError(t, f.evidenceKeeper.BeginBlocker(ctx, cometInfoService))
+
+	// should be slashed, jailed and tombstoned as for a duplicate vote
+	val, err = f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, val.IsJailed())
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(valpubkey.Address())))
+	assert.Assert(t, val.GetTokens().LT(oldTokens))
+
+	// the evidence is stored as a light client attack
+	iter, err := f.evidenceKeeper.Evidences.Iterate(ctx, nil)
+	assert.NilError(t, err)
+	values, err := iter.Values()
+	assert.NilError(t, err)
+	assert.Equal(t, len(values), 1)
+	attack, ok := values[0].(*evidencetypes.LightClientAttack)
+	assert.Assert(t, ok)
+	assert.Equal(t, attack.TotalPower, 3*power)
+
+	decision, err := f.evidenceKeeper.Decisions.Get(ctx, attack.Hash())
+	assert.NilError(t, err)
+	assert.Equal(t, decision.Outcome, evidencetypes.EQUIVOCATION_OUTCOME_TOMBSTONED)
+}
+
 func TestHandleDoubleSign_TooOld(t *testing.T) {
 	t.Parallel()
 	f := initFixture(t)
@@ -390,6 +450,167 @@ func TestHandleDoubleSignAfterRotation(t *testing.T) {
 	assert.Assert(t, len(evidences) == 1)
 }
 
+func TestHandleDoubleSignAfterRotationGrace(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := integration.SetHeaderInfo(f.ctx, header.Info{Time: time.Now()})
+	populateValidators(t, f)
+
+	slashingParams, err := f.slashingKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+	slashingParams.DoubleSignRotationGraceBlocks = 10
+	assert.NilError(t, f.slashingKeeper.Params.Set(ctx, slashingParams))
+
+	power := int64(100)
+	operatorAddr, valpubkey := valAddresses[0], pubkeys[0]
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	f.accountKeeper.SetAccount(f.ctx, f.accountKeeper.NewAccountWithAddress(f.ctx, sdk.AccAddress(operatorAddr)))
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, valpubkey, power, true)
+
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+
+	newConsPubkey := newPubKey("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AFB53")
+	msgServer := stakingkeeper.NewMsgServerImpl(f.stakingKeeper)
+	msg, err := stakingtypes.NewMsgRotateConsPubKey(operatorAddr.String(), newConsPubkey)
+	assert.NilError(t, err)
+	_, err = msgServer.RotateConsPubKey(ctx, msg)
+	assert.NilError(t, err)
+
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+
+	// handle a signature to set signing info
+	err = f.slashingKeeper.HandleValidatorSignature(ctx, newConsPubkey.Address().Bytes(), selfDelegation.Int64(), comet.BlockIDFlagCommit)
+	assert.NilError(t, err)
+
+	valInfo, err := f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	oldTokens := valInfo.GetTokens()
+
+	// double sign with the old key within the grace window of the rotation
+	nci := comet.Info{
+		Evidence: []comet.Evidence{{
+			Validator: comet.Validator{Address: valpubkey.Address(), Power: power},
+			Type:      comet.DuplicateVote,
+			Time:      time.Unix(0, 0),
+			Height:    0,
+		}},
+	}
+	err = f.evidenceKeeper.BeginBlocker(integration.SetCometInfo(ctx, nci), cometInfoService)
+	assert.NilError(t, err)
+
+	// should be jailed and slashed with the rotation fraction, but not tombstoned
+	valInfo, err = f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, valInfo.IsJailed())
+	assert.Assert(t, !f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(newConsPubkey.Address())))
+	newTokens := valInfo.GetTokens()
+	assert.Assert(t, newTokens.Equal(oldTokens.Sub(slashingParams.SlashFractionDoubleSignRotation.MulInt(oldTokens).TruncateInt())))
+
+	signingInfo, err := f.slashingKeeper.ValidatorSigningInfo.Get(ctx, sdk.ConsAddress(newConsPubkey.Address()))
+	assert.NilError(t, err)
+	headerInfo := integration.HeaderInfoFromContext(ctx)
+	assert.Assert(t, signingInfo.JailedUntil.Equal(headerInfo.Time.Add(slashingParams.DoubleSignRotationJailDuration)))
+
+	// the decision is recorded
+	var decisions []evidencetypes.EquivocationDecision
+	assert.NilError(t, f.evidenceKeeper.Decisions.Walk(ctx, nil, func(_ []byte, decision evidencetypes.EquivocationDecision) (bool, error) {
+		decisions = append(decisions, decision)
+		return false, nil
+	}))
+	assert.Equal(t, len(decisions), 1)
+	assert.Equal(t, decisions[0].Outcome, evidencetypes.EQUIVOCATION_OUTCOME_ROTATION_GRACE)
+	assert.Equal(t, decisions[0].RotationHeight, uint64(0))
+	assert.Assert(t, decisions[0].SlashFraction.Equal(slashingParams.SlashFractionDoubleSignRotation))
+
+	// a further equivocation in the same grace window is slashed, jailed forever and tombstoned
+	nci.Evidence[0].Height = 1
+	err = f.evidenceKeeper.BeginBlocker(integration.SetCometInfo(ctx, nci), cometInfoService)
+	assert.NilError(t, err)
+
+	valInfo, err = f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, valInfo.IsJailed())
+	assert.Assert(t, valInfo.GetTokens().Equal(newTokens.Sub(slashingParams.SlashFractionDoubleSign.MulInt(oldTokens).TruncateInt())))
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(newConsPubkey.Address())))
+
+	signingInfo, err = f.slashingKeeper.ValidatorSigningInfo.Get(ctx, sdk.ConsAddress(newConsPubkey.Address()))
+	assert.NilError(t, err)
+	assert.Assert(t, signingInfo.JailedUntil.Equal(evidencetypes.DoubleSignJailEndTime))
+
+	decisions = nil
+	assert.NilError(t, f.evidenceKeeper.Decisions.Walk(ctx, nil, func(_ []byte, decision evidencetypes.EquivocationDecision) (bool, error) {
+		decisions = append(decisions, decision)
+		return false, nil
+	}))
+	assert.Equal(t, len(decisions), 2)
+	for _, decision := range decisions {
+		if decision.Height == 1 {
+			assert.Equal(t, decision.Outcome, evidencetypes.EQUIVOCATION_OUTCOME_ROTATION_GRACE_REPEATED)
+			assert.Assert(t, decision.SlashFraction.Equal(slashingParams.SlashFractionDoubleSign))
+			assert.Assert(t, decision.JailedUntil.Equal(evidencetypes.DoubleSignJailEndTime))
+		}
+	}
+
+	// the validator is not unjailed by further evidence once tombstoned
+	ctx = integration.SetHeaderInfo(ctx, header.Info{Height: 20, Time: headerInfo.Time})
+	nci.Evidence[0].Height = 11
+	err = f.evidenceKeeper.BeginBlocker(integration.SetCometInfo(ctx, nci), cometInfoService)
+	assert.NilError(t, err)
+	assert.Error(t, f.slashingKeeper.Unjail(ctx, operatorAddr), slashingtypes.ErrValidatorJailed.Error())
+}
+
+func TestHandleDoubleSignPastRotationGrace(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := integration.SetHeaderInfo(f.ctx, header.Info{Time: time.Now()})
+	populateValidators(t, f)
+
+	slashingParams, err := f.slashingKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+	slashingParams.DoubleSignRotationGraceBlocks = 10
+	assert.NilError(t, f.slashingKeeper.Params.Set(ctx, slashingParams))
+
+	power := int64(100)
+	operatorAddr, valpubkey := valAddresses[0], pubkeys[0]
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	f.accountKeeper.SetAccount(f.ctx, f.accountKeeper.NewAccountWithAddress(f.ctx, sdk.AccAddress(operatorAddr)))
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, valpubkey, power, true)
+
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+
+	newConsPubkey := newPubKey("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AFB53")
+	msgServer := stakingkeeper.NewMsgServerImpl(f.stakingKeeper)
+	msg, err := stakingtypes.NewMsgRotateConsPubKey(operatorAddr.String(), newConsPubkey)
+	assert.NilError(t, err)
+	_, err = msgServer.RotateConsPubKey(ctx, msg)
+	assert.NilError(t, err)
+
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+
+	err = f.slashingKeeper.HandleValidatorSignature(ctx, newConsPubkey.Address().Bytes(), selfDelegation.Int64(), comet.BlockIDFlagCommit)
+	assert.NilError(t, err)
+
+	// an equivocation past the grace window tombstones the validator
+	ctx = integration.SetHeaderInfo(ctx, header.Info{Height: 20, Time: integration.HeaderInfoFromContext(ctx).Time})
+	nci := comet.Info{
+		Evidence: []comet.Evidence{{
+			Validator: comet.Validator{Address: valpubkey.Address(), Power: power},
+			Type:      comet.DuplicateVote,
+			Time:      time.Unix(0, 0),
+			Height:    11,
+		}},
+	}
+	err = f.evidenceKeeper.BeginBlocker(integration.SetCometInfo(ctx, nci), cometInfoService)
+	assert.NilError(t, err)
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(newConsPubkey.Address())))
+}
+
 func populateValidators(t assert.TestingT, f *fixture) {
 	// add accounts and set total supply
 	totalSupplyAmt := initAmt.MulRaw(int64(len(valAddresses)))
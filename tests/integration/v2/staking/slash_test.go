@@ -186,6 +186,43 @@ func TestSlashRedelegation(t *testing.T) {
 	assert.DeepEqual(t, balances.Sub(burnedCoins...), f.bankKeeper.GetAllBalances(f.ctx, bondedPool.GetAddress()))
 }
 
+// tests that a redelegated delegation cannot be tokenized to escape the slashing of the
+// source validator
+func TestSlashRedelegationOfTokenizedShares(t *testing.T) {
+	f, addrDels, addrVals := bootstrapSlashTest(t, 10)
+	fraction := math.LegacyNewDecWithPrec(5, 1)
+	delAddr, owner := addrDels[5], addrDels[6]
+	createAccount(t, f.ctx, f.accountKeeper, delAddr)
+
+	srcValidator, err := f.stakingKeeper.GetValidator(f.ctx, addrVals[0])
+	assert.NilError(t, err)
+	shares, err := f.stakingKeeper.Delegate(f.ctx, delAddr, math.NewInt(1000), types.Unbonded, srcValidator, true)
+	assert.NilError(t, err)
+
+	_, err = f.stakingKeeper.BeginRedelegation(f.ctx, delAddr, addrVals[0], addrVals[1], shares)
+	assert.NilError(t, err)
+
+	del, err := f.stakingKeeper.Delegations.Get(f.ctx, collections.Join(delAddr, addrVals[1]))
+	assert.NilError(t, err)
+
+	// the shares received by the redelegation cannot be tokenized while it is in progress
+	_, _, err = f.stakingKeeper.TokenizeShares(f.ctx, delAddr, addrVals[1], del.Shares, owner)
+	assert.ErrorIs(t, err, types.ErrRedelegationInProgress)
+
+	// so that the redelegation is still slashed from the delegation for an infraction of the source validator
+	rd, err := f.stakingKeeper.Redelegations.Get(f.ctx, collections.Join3(delAddr.Bytes(), addrVals[0].Bytes(), addrVals[1].Bytes()))
+	assert.NilError(t, err)
+	srcValidator, err = f.stakingKeeper.GetValidator(f.ctx, addrVals[0])
+	assert.NilError(t, err)
+	slashAmount, err := f.stakingKeeper.SlashRedelegation(f.ctx, srcValidator, rd, 0, fraction)
+	assert.NilError(t, err)
+	assert.Assert(t, slashAmount.Equal(math.NewInt(500)))
+
+	slashedDel, err := f.stakingKeeper.Delegations.Get(f.ctx, collections.Join(delAddr, addrVals[1]))
+	assert.NilError(t, err)
+	assert.Assert(t, slashedDel.Shares.LT(del.Shares))
+}
+
 // test slash at a negative height
 // this just represents pre-genesis and should have the same effect as slashing at height 0
 func TestSlashAtNegativeHeight(t *testing.T) {
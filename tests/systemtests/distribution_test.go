@@ -132,7 +132,7 @@ func TestDistrValidatorGRPCQueries(t *testing.T) {
 			Name:    "gRPC request params",
 			Url:     paramsURL,
 			ExpCode: http.StatusOK,
-			ExpOut:  `{"params":{"community_tax":"0.020000000000000000","base_proposer_reward":"0.000000000000000000","bonus_proposer_reward":"0.000000000000000000","withdraw_addr_enabled":true}}`,
+			ExpOut:  `{"params":{"community_tax":"0.020000000000000000","base_proposer_reward":"0.000000000000000000","bonus_proposer_reward":"0.000000000000000000","withdraw_addr_enabled":true,"auto_compound_epoch_identifier":"","auto_compound_max_per_block":50,"auto_compound_gas_budget":"100000000"}}`,
 		},
 	}
 	systest.RunRestQueries(t, paramsTestCases...)
@@ -101,6 +101,10 @@ func SenderIsAccountsModule(ctx context.Context) bool {
 	return bytes.Equal(Sender(ctx), accountsModuleAddress)
 }
 
+// IsMigration reports whether the account is being initialized by the migration of a legacy
+// account, e.g. an x/auth vesting account, rather than created by a sender.
+func IsMigration(ctx context.Context) bool { return implementation.IsMigration(ctx) }
+
 // Funds returns if any funds were sent during the execute or init request. In queries this
 // returns nil.
 func Funds(ctx context.Context) sdk.Coins { return implementation.Funds(ctx) }
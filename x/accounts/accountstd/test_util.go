@@ -29,3 +29,7 @@ func NewMockContext(
 func SetSender(ctx context.Context, sender []byte) context.Context {
 	return implementation.SetSender(ctx, sender)
 }
+
+func MarkMigration(ctx context.Context) context.Context {
+	return implementation.MarkMigration(ctx)
+}
@@ -81,7 +81,7 @@ func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse
 			return nil, err
 		}
 	}
-	return &v1.MsgInitResponse{}, a.savePubKey(ctx, msg.PubKey)
+	return &v1.MsgInitResponse{}, a.SetPubKey(ctx, msg.PubKey)
 }
 
 func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.MsgSwapPubKeyResponse, error) {
@@ -89,7 +89,7 @@ func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.Msg
 		return nil, errors.New("unauthorized")
 	}
 
-	return &v1.MsgSwapPubKeyResponse{}, a.savePubKey(ctx, msg.NewPubKey)
+	return &v1.MsgSwapPubKeyResponse{}, a.SetPubKey(ctx, msg.NewPubKey)
 }
 
 // Authenticate implements the authentication flow of an abstracted base account.
@@ -98,14 +98,27 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
 	}
 
-	pubKey, signerData, err := a.computeSignerData(ctx)
+	pubKey, err := a.loadPubKey(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to compute signer data: %w", err)
 	}
 
+	return &aa_interface_v1.MsgAuthenticateResponse{}, a.AuthenticateWithPubKey(ctx, msg, pubKey)
+}
+
+// AuthenticateWithPubKey checks the sequence and verifies the signature of the tx signer
+// at the index provided by msg against the given pubkey, increasing the account sequence.
+// It allows accounts extending the base account to authenticate txs signed by other keys
+// than the account pubkey.
+func (a Account) AuthenticateWithPubKey(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate, pubKey PubKey) error {
+	signerData, err := a.computeSignerData(ctx, pubKey)
+	if err != nil {
+		return fmt.Errorf("unable to compute signer data: %w", err)
+	}
+
 	txData, err := a.getTxData(msg)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get tx data: %w", err)
+		return fmt.Errorf("unable to get tx data: %w", err)
 	}
 
 	gotSeq := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].Sequence
@@ -113,29 +126,29 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 	execMode := a.ts.ExecMode(ctx)
 	if execMode == transaction.ExecModeCheck {
 		if gotSeq < signerData.Sequence {
-			return nil, fmt.Errorf("sequence number must be higher than: %d, got: %d", signerData.Sequence, gotSeq)
+			return fmt.Errorf("sequence number must be higher than: %d, got: %d", signerData.Sequence, gotSeq)
 		}
 	} else if gotSeq != signerData.Sequence {
-		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+		return fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
 	}
 
 	signMode, err := parseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+		return fmt.Errorf("unable to parse sign mode: %w", err)
 	}
 
 	signature := msg.Tx.Signatures[msg.SignerIndex]
 
 	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get sign bytes: %w", err)
+		return fmt.Errorf("unable to get sign bytes: %w", err)
 	}
 
 	if !pubKey.VerifySignature(signBytes, signature) {
-		return nil, errors.New("signature verification failed")
+		return errors.New("signature verification failed")
 	}
 
-	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+	return nil
 }
 
 func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
@@ -146,35 +159,30 @@ func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
 	return signingv1beta1.SignMode(single.Single.Mode), nil
 }
 
-// computeSignerData will populate signer data and also increase the sequence.
-func (a Account) computeSignerData(ctx context.Context) (PubKey, signing.SignerData, error) {
+// computeSignerData will populate signer data of the given pubkey and also increase the sequence.
+func (a Account) computeSignerData(ctx context.Context, pk PubKey) (signing.SignerData, error) {
 	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
 	if err != nil {
-		return nil, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 	chainID := a.hs.HeaderInfo(ctx).ChainID
 
 	wantSequence, err := a.Sequence.Next(ctx)
 	if err != nil {
-		return nil, signing.SignerData{}, err
-	}
-
-	pk, err := a.loadPubKey(ctx)
-	if err != nil {
-		return nil, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 
 	pkAny, err := codectypes.NewAnyWithValue(pk)
 	if err != nil {
-		return nil, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 
 	accNum, err := a.getNumber(ctx, addrStr)
 	if err != nil {
-		return nil, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 
-	return pk, signing.SignerData{
+	return signing.SignerData{
 		Address:       addrStr,
 		ChainID:       chainID,
 		AccountNumber: accNum,
@@ -243,28 +251,42 @@ func (a Account) loadPubKey(ctx context.Context) (PubKey, error) {
 	return pubKey, nil
 }
 
-func (a Account) savePubKey(ctx context.Context, anyPk *codectypes.Any) error {
+// SetPubKey validates and saves the account pubkey, without checking the message sender.
+func (a Account) SetPubKey(ctx context.Context, anyPk *codectypes.Any) error {
+	if _, err := a.DecodePubKey(anyPk); err != nil {
+		return err
+	}
+
+	// save into state
+	err := a.PubKey.Set(ctx, anyPk.Value)
+	if err != nil {
+		return fmt.Errorf("unable to save pubkey: %w", err)
+	}
+	return a.PubKeyType.Set(ctx, nameFromTypeURL(anyPk.TypeUrl))
+}
+
+// DecodePubKey decodes and validates a pubkey of one of the types supported by the account.
+func (a Account) DecodePubKey(anyPk *codectypes.Any) (PubKey, error) {
+	if anyPk == nil {
+		return nil, errors.New("empty pubkey")
+	}
+
 	// check if known
 	name := nameFromTypeURL(anyPk.TypeUrl)
 	impl, exists := a.supportedPubKeys[name]
 	if !exists {
-		return fmt.Errorf("unknown pubkey type %s", name)
+		return nil, fmt.Errorf("unknown pubkey type %s", name)
 	}
 	pk, err := impl.decode(anyPk.Value)
 	if err != nil {
-		return fmt.Errorf("unable to decode pubkey: %w", err)
+		return nil, fmt.Errorf("unable to decode pubkey: %w", err)
 	}
 	err = impl.validate(pk)
 	if err != nil {
-		return fmt.Errorf("unable to validate pubkey: %w", err)
+		return nil, fmt.Errorf("unable to validate pubkey: %w", err)
 	}
 
-	// save into state
-	err = a.PubKey.Set(ctx, anyPk.Value)
-	if err != nil {
-		return fmt.Errorf("unable to save pubkey: %w", err)
-	}
-	return a.PubKeyType.Set(ctx, name)
+	return pk, nil
 }
 
 func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
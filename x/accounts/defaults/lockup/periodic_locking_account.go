@@ -46,7 +46,8 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 
 	hs := pva.headerService.HeaderInfo(ctx)
 
-	if msg.StartTime.Before(hs.Time) {
+	// accounts migrated from x/auth periodic vesting accounts keep their original start time
+	if msg.StartTime.Before(hs.Time) && !accountstd.IsMigration(ctx) {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("start time %s should be after block time")
 	}
 
@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -217,3 +218,40 @@ func TestPeriodicAccountGetLockCoinInfo(t *testing.T) {
 	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(10)))
 	require.True(t, locked.AmountOf("test").Equal(math.ZeroInt()))
 }
+
+func TestPeriodicAccountPastStartTime(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	now := time.Now()
+	msg := &lockuptypes.MsgInitPeriodicLockingAccount{
+		Owner:     "owner",
+		StartTime: now.Add(-time.Hour),
+		LockingPeriods: []lockuptypes.Period{
+			{
+				Amount: TestFunds,
+				Length: 2 * time.Hour,
+			},
+		},
+	}
+
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: now,
+	})
+	acc, err := NewPeriodicLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(sdkCtx, msg)
+	require.ErrorContains(t, err, "should be after block time")
+
+	// an account initialized by itself cannot start in the past either
+	selfCtx := sdkCtx.WithContext(accountstd.SetSender(ctx, []byte("lockup_account")))
+	_, err = acc.Init(selfCtx, msg)
+	require.ErrorContains(t, err, "should be after block time")
+
+	// accounts migrated from x/auth keep their start time
+	migrationCtx := sdkCtx.WithContext(accountstd.MarkMigration(ctx))
+	_, err = acc.Init(migrationCtx, msg)
+	require.NoError(t, err)
+
+	_, locked, err := acc.GetLockCoinsInfo(migrationCtx, now)
+	require.NoError(t, err)
+	require.Equal(t, TestFunds, locked)
+}
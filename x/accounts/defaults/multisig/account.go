@@ -14,6 +14,7 @@ import (
 	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var (
@@ -158,7 +159,25 @@ func (a Account) Vote(ctx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResponse
 		return nil, err
 	}
 
-	return &v1.MsgVoteResponse{}, a.Votes.Set(ctx, collections.Join(msg.ProposalId, sender), int32(msg.Vote))
+	if err = a.Votes.Set(ctx, collections.Join(msg.ProposalId, sender), int32(msg.Vote)); err != nil {
+		return nil, err
+	}
+
+	// if auto execution is enabled, the vote making the proposal pass executes it
+	if cfg.AutoExecute {
+		tally, err := a.tally(ctx, msg.ProposalId)
+		if err != nil {
+			return nil, err
+		}
+
+		if checkTally(cfg, tally) == nil {
+			if _, err := a.finalizeProposal(ctx, msg.ProposalId, prop, tally, nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &v1.MsgVoteResponse{}, nil
 }
 
 // CreateProposal creates a new proposal. If the proposal contains a voting
@@ -192,6 +211,7 @@ func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal)
 		Summary:  msg.Proposal.Summary,
 		Messages: msg.Proposal.Messages,
 		Status:   v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD,
+		Id:       seq,
 	}
 
 	// set the voting period, if not specified, use the default
@@ -213,6 +233,7 @@ func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal)
 	if err = a.eventService.EventManager(ctx).EmitKV("proposal_created",
 		event.NewAttribute("proposal_id", fmt.Sprint(seq)),
 		event.NewAttribute("proposer", addr),
+		event.NewAttribute("voting_period_end", fmt.Sprint(proposal.VotingPeriodEnd)),
 	); err != nil {
 		return nil, err
 	}
@@ -246,17 +267,45 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 	}
 
 	// check if voting period is still active and early execution is disabled
-	votingPeriodEnded := a.headerService.HeaderInfo(ctx).Time.Unix() > prop.VotingPeriodEnd
+	now := a.headerService.HeaderInfo(ctx).Time
+	votingPeriodEnded := now.Unix() > prop.VotingPeriodEnd
 	if !votingPeriodEnded && !config.EarlyExecution {
 		return nil, errors.New("voting period has not ended yet, and early execution is not enabled")
 	}
 
-	// perform tally
-	rng := collections.NewPrefixedPairRange[uint64, []byte](msg.ProposalId)
-	yesVotes := uint64(0)
-	noVotes := uint64(0)
-	abstainVotes := uint64(0)
-	err = a.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, []byte], vote int32) (stop bool, err error) {
+	if prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD {
+		return nil, errors.New("proposal has already been executed")
+	}
+
+	if isExpired(config, prop, now) {
+		return nil, errors.New("proposal has expired")
+	}
+
+	tally, err := a.tally(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	rejectErr := checkTally(config, tally)
+
+	// if early execution is enabled, we return early if the proposal has NOT passed
+	if config.EarlyExecution && rejectErr != nil {
+		return nil, errors.New("early execution attempted and proposal has not passed")
+	}
+
+	return a.finalizeProposal(ctx, msg.ProposalId, prop, tally, rejectErr)
+}
+
+// tallyResult holds the weights of the votes cast on a proposal.
+type tallyResult struct {
+	yes, no, abstain uint64
+}
+
+// tally sums the weights of the votes cast on a proposal by the current members.
+func (a Account) tally(ctx context.Context, proposalID uint64) (tallyResult, error) {
+	var res tallyResult
+	rng := collections.NewPrefixedPairRange[uint64, []byte](proposalID)
+	err := a.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, []byte], vote int32) (stop bool, err error) {
 		weight, err := a.Members.Get(ctx, key.K2())
 		if errors.Is(err, collections.ErrNotFound) {
 			// edge case: if a member has been removed after voting, we should ignore their vote
@@ -267,32 +316,44 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 
 		switch v1.VoteOption(vote) {
 		case v1.VoteOption_VOTE_OPTION_YES:
-			yesVotes += weight
+			res.yes += weight
 		case v1.VoteOption_VOTE_OPTION_NO:
-			noVotes += weight
+			res.no += weight
 		case v1.VoteOption_VOTE_OPTION_ABSTAIN:
-			abstainVotes += weight
+			res.abstain += weight
 		}
 		return false, nil
 	})
-	if err != nil {
-		return nil, err
+	return res, err
+}
+
+// checkTally returns the reason why a proposal with the given tally does not pass, or nil if it passes.
+func checkTally(config v1.Config, tally tallyResult) error {
+	totalWeight := tally.yes + tally.no + tally.abstain
+	if totalWeight < uint64(config.Quorum) {
+		return errors.New("quorum not reached")
 	}
+	if tally.yes < uint64(config.Threshold) {
+		return errors.New("threshold not reached")
+	}
+	return nil
+}
 
-	totalWeight := yesVotes + noVotes + abstainVotes
+// isExpired returns true if the proposal can no longer be executed.
+func isExpired(config v1.Config, prop v1.Proposal, now time.Time) bool {
+	return config.ProposalExpiry > 0 && now.Unix() > prop.VotingPeriodEnd+config.ProposalExpiry
+}
 
+// finalizeProposal executes the proposal messages if it passed, updates its status and prunes its votes.
+func (a Account) finalizeProposal(ctx context.Context, proposalID uint64, prop v1.Proposal, tally tallyResult, rejectErr error) (*v1.MsgExecuteProposalResponse, error) {
 	var (
-		rejectErr error
-		execErr   error
+		err     error
+		execErr error
 	)
 
 	resp := &v1.MsgExecuteProposalResponse{}
 
-	if totalWeight < uint64(config.Quorum) {
-		rejectErr = errors.New("quorum not reached")
-		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_REJECTED
-	} else if yesVotes < uint64(config.Threshold) {
-		rejectErr = errors.New("threshold not reached")
+	if rejectErr != nil {
 		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_REJECTED
 	} else {
 		// we have quorum and threshold, execute the proposal
@@ -300,20 +361,15 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 		resp.Responses, execErr = accountstd.ExecModuleAnys(ctx, prop.Messages) // do not return this error, just emit the event
 	}
 
-	// if early execution is enabled, we return early if the proposal has NOT passed
-	if config.EarlyExecution && prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_PASSED {
-		return nil, errors.New("early execution attempted and proposal has not passed")
-	}
-
-	if err = a.deleteProposalAndVotes(ctx, msg.ProposalId); err != nil {
+	if err = a.deleteProposalAndVotes(ctx, proposalID); err != nil {
 		return nil, err
 	}
 
 	if err = a.eventService.EventManager(ctx).EmitKV("proposal_tally",
-		event.NewAttribute("proposal_id", fmt.Sprint(msg.ProposalId)),
-		event.NewAttribute("yes_votes", fmt.Sprint(yesVotes)),
-		event.NewAttribute("no_votes", fmt.Sprint(noVotes)),
-		event.NewAttribute("abstain_votes", fmt.Sprint(abstainVotes)),
+		event.NewAttribute("proposal_id", fmt.Sprint(proposalID)),
+		event.NewAttribute("yes_votes", fmt.Sprint(tally.yes)),
+		event.NewAttribute("no_votes", fmt.Sprint(tally.no)),
+		event.NewAttribute("abstain_votes", fmt.Sprint(tally.abstain)),
 		event.NewAttribute("status", prop.Status.String()),
 		event.NewAttribute("reject_err", fmt.Sprint(rejectErr)),
 		event.NewAttribute("exec_err", fmt.Sprint(execErr)),
@@ -321,7 +377,7 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 		return nil, err
 	}
 
-	err = a.Proposals.Set(ctx, msg.ProposalId, prop)
+	err = a.Proposals.Set(ctx, proposalID, prop)
 	if err != nil {
 		return nil, err
 	}
@@ -329,6 +385,46 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 	return resp, nil
 }
 
+// PruneProposals removes the executed and expired proposals, along with their votes. The sender must be a member.
+func (a Account) PruneProposals(ctx context.Context, _ *v1.MsgPruneProposals) (*v1.MsgPruneProposalsResponse, error) {
+	// check if the sender is a member
+	_, err := a.Members.Get(ctx, accountstd.Sender(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := a.headerService.HeaderInfo(ctx).Time
+	var pruned []uint64
+	err = a.Proposals.Walk(ctx, nil, func(id uint64, prop v1.Proposal) (stop bool, err error) {
+		if prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD || isExpired(config, prop, now) {
+			pruned = append(pruned, id)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range pruned {
+		if err := a.deleteProposalAndVotes(ctx, id); err != nil {
+			return nil, err
+		}
+
+		if err := a.eventService.EventManager(ctx).EmitKV("proposal_pruned",
+			event.NewAttribute("proposal_id", fmt.Sprint(id)),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.MsgPruneProposalsResponse{PrunedProposalIds: pruned}, nil
+}
+
 // QuerySequence returns the current sequence number, used for proposal IDs.
 func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
 	seq, err := a.Sequence.Peek(ctx)
@@ -347,6 +443,42 @@ func (a Account) QueryProposal(ctx context.Context, q *v1.QueryProposal) (*v1.Qu
 	return &v1.QueryProposalResponse{Proposal: &proposal}, nil
 }
 
+// QueryProposals returns the proposals that haven't been pruned yet, optionally filtered by status.
+func (a Account) QueryProposals(ctx context.Context, q *v1.QueryProposals) (*v1.QueryProposalsResponse, error) {
+	proposals, pageRes, err := query.CollectionFilteredPaginate(ctx, a.Proposals, q.Pagination,
+		func(_ uint64, prop v1.Proposal) (bool, error) {
+			return q.Status == v1.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED || prop.Status == q.Status, nil
+		},
+		func(_ uint64, prop v1.Proposal) (*v1.Proposal, error) {
+			return &prop, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// QueryVotes returns the votes cast on a proposal, until it is executed.
+func (a Account) QueryVotes(ctx context.Context, q *v1.QueryVotes) (*v1.QueryVotesResponse, error) {
+	votes := []*v1.Vote{}
+	rng := collections.NewPrefixedPairRange[uint64, []byte](q.ProposalId)
+	err := a.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, []byte], vote int32) (stop bool, err error) {
+		voter, err := a.addrCodec.BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+		votes = append(votes, &v1.Vote{Voter: voter, Option: v1.VoteOption(vote)})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryVotesResponse{Votes: votes}, nil
+}
+
 // QueryConfig returns the current multisig configuration.
 func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
 	cfg, err := a.Config.Get(ctx)
@@ -376,6 +508,7 @@ func (a *Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, a.CreateProposal)
 	accountstd.RegisterExecuteHandler(builder, a.ExecuteProposal)
 	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.PruneProposals)
 }
 
 // RegisterInitHandler implements implementation.Account.
@@ -388,6 +521,8 @@ func (a *Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
 	accountstd.RegisterQueryHandler(builder, a.QueryProposal)
 	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryProposals)
+	accountstd.RegisterQueryHandler(builder, a.QueryVotes)
 }
 
 func safeAdd(nums ...uint64) (uint64, error) {
@@ -14,6 +14,8 @@ import (
 	"cosmossdk.io/x/accounts/accountstd"
 	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
 	accountsv1 "cosmossdk.io/x/accounts/v1"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func setup(t *testing.T, _ context.Context, ss store.KVStoreService, timefn func() time.Time) *Account {
@@ -710,3 +712,151 @@ func TestWeightOverflow(t *testing.T) {
 	_, err = acc.Init(ctx, startAcc)
 	require.ErrorContains(t, err, "overflow")
 }
+
+func TestProposalAutoExecute(t *testing.T) {
+	var executed []transaction.Msg
+	ctx, ss := accountstd.NewMockContext(
+		0, []byte("multisig_acc"), []byte("addr1"), TestFunds,
+		func(ctx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error) {
+			executed = append(executed, msg)
+			return &v1.MsgUpdateConfigResponse{}, nil
+		}, func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+			return nil, nil
+		},
+	)
+
+	currentTime := time.Now()
+	acc := setup(t, ctx, ss, func() time.Time {
+		return currentTime
+	})
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:    2000,
+			Quorum:       2000,
+			VotingPeriod: 60,
+			AutoExecute:  true,
+		},
+		Members: []*v1.Member{
+			{Address: "addr1", Weight: 1000},
+			{Address: "addr2", Weight: 1000},
+			{Address: "addr3", Weight: 1000},
+		},
+	})
+	require.NoError(t, err)
+
+	anymsg, err := accountstd.PackAny(&v1.MsgUpdateConfig{})
+	require.NoError(t, err)
+
+	createRes, err := acc.CreateProposal(ctx, &v1.MsgCreateProposal{
+		Proposal: &v1.Proposal{
+			Title:    "test",
+			Messages: []*types.Any{anymsg},
+		},
+	})
+	require.NoError(t, err)
+	propId := createRes.ProposalId
+
+	// the first vote does not reach the threshold
+	_, err = acc.Vote(ctx, &v1.MsgVote{ProposalId: propId, Vote: v1.VoteOption_VOTE_OPTION_YES})
+	require.NoError(t, err)
+	require.Empty(t, executed)
+
+	votes, err := acc.QueryVotes(ctx, &v1.QueryVotes{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, []*v1.Vote{{Voter: "addr1", Option: v1.VoteOption_VOTE_OPTION_YES}}, votes.Votes)
+
+	// the second vote reaches the threshold and executes the proposal
+	_, err = acc.Vote(accountstd.SetSender(ctx, []byte("addr2")), &v1.MsgVote{ProposalId: propId, Vote: v1.VoteOption_VOTE_OPTION_YES})
+	require.NoError(t, err)
+	require.Len(t, executed, 1)
+
+	prop, err := acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_PASSED, prop.Proposal.Status)
+
+	// the proposal cannot be voted on or executed again
+	_, err = acc.Vote(accountstd.SetSender(ctx, []byte("addr3")), &v1.MsgVote{ProposalId: propId, Vote: v1.VoteOption_VOTE_OPTION_YES})
+	require.ErrorContains(t, err, "voting period has ended")
+
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "proposal has already been executed")
+	require.Len(t, executed, 1)
+}
+
+func TestQueryAndPruneProposals(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	ctx = accountstd.SetSender(ctx, []byte("addr1"))
+
+	currentTime := time.Now()
+	acc := setup(t, ctx, ss, func() time.Time {
+		return currentTime
+	})
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:      1000,
+			Quorum:         1000,
+			VotingPeriod:   60,
+			ProposalExpiry: 60,
+		},
+		Members: []*v1.Member{
+			{Address: "addr1", Weight: 1000},
+			{Address: "addr2", Weight: 1000},
+		},
+	})
+	require.NoError(t, err)
+
+	// create three proposals, the first one passes and the second one is not executed in time
+	for i := 0; i < 3; i++ {
+		res, err := acc.CreateProposal(ctx, &v1.MsgCreateProposal{Proposal: &v1.Proposal{Title: "test"}})
+		require.NoError(t, err)
+		require.Equal(t, uint64(i), res.ProposalId)
+	}
+
+	_, err = acc.Vote(ctx, &v1.MsgVote{ProposalId: 0, Vote: v1.VoteOption_VOTE_OPTION_YES})
+	require.NoError(t, err)
+
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: 0})
+	require.NoError(t, err)
+
+	_, err = acc.CreateProposal(ctx, &v1.MsgCreateProposal{Proposal: &v1.Proposal{Title: "test"}})
+	require.NoError(t, err)
+
+	// list the proposals
+	res, err := acc.QueryProposals(ctx, &v1.QueryProposals{})
+	require.NoError(t, err)
+	require.Len(t, res.Proposals, 4)
+	for i, prop := range res.Proposals {
+		require.Equal(t, uint64(i), prop.Id)
+	}
+
+	res, err = acc.QueryProposals(ctx, &v1.QueryProposals{Status: v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD, Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, res.Proposals, 2)
+	require.Equal(t, uint64(1), res.Proposals[0].Id)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = acc.QueryProposals(ctx, &v1.QueryProposals{Status: v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD, Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, res.Proposals, 1)
+	require.Equal(t, uint64(3), res.Proposals[0].Id)
+
+	// expire the proposals created before the execution
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: 1})
+	require.ErrorContains(t, err, "proposal has expired")
+
+	// only members can prune proposals
+	_, err = acc.PruneProposals(accountstd.SetSender(ctx, []byte("addr3")), &v1.MsgPruneProposals{})
+	require.Error(t, err)
+
+	pruneRes, err := acc.PruneProposals(ctx, &v1.MsgPruneProposals{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1, 2}, pruneRes.PrunedProposalIds)
+
+	res, err = acc.QueryProposals(ctx, &v1.QueryProposals{})
+	require.NoError(t, err)
+	require.Len(t, res.Proposals, 1)
+	require.Equal(t, uint64(3), res.Proposals[0].Id)
+}
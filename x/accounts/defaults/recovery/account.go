@@ -0,0 +1,318 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// prefixes 0 to 2 are used by the base account
+var (
+	ConfigPrefix           = collections.NewPrefix(3)
+	RecoverySequencePrefix = collections.NewPrefix(4)
+	RecoveriesPrefix       = collections.NewPrefix(5)
+)
+
+// Compile-time type assertions
+var (
+	_ accountstd.Interface = Account{}
+)
+
+// NewAccount returns a new recovery account creator function.
+func NewAccount(name string, handlerMap *signing.HandlerMap, options ...base.Option) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, baseAcc, err := base.NewAccount(name, handlerMap, options...)(deps)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return name, Account{
+			Account:          baseAcc.(base.Account),
+			Config:           collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+			RecoverySequence: collections.NewSequence(deps.SchemaBuilder, RecoverySequencePrefix, "recovery_sequence"),
+			Recoveries:       collections.NewMap(deps.SchemaBuilder, RecoveriesPrefix, "recoveries", collections.Uint64Key, codec.CollValue[v1.Recovery](deps.LegacyStateCodec)),
+			addrCodec:        deps.AddressCodec,
+			headerService:    deps.Environment.HeaderService,
+			eventService:     deps.Environment.EventService,
+		}, nil
+	}
+}
+
+// Account implements a base account which can be recovered by a set of guardians.
+// A recovery approved by the guardian threshold swaps the account pubkey after a
+// delay, during which the owner can cancel it.
+type Account struct {
+	base.Account
+
+	Config           collections.Item[v1.Config]
+	RecoverySequence collections.Sequence
+	Recoveries       collections.Map[uint64, v1.Recovery]
+
+	addrCodec     address.Codec
+	headerService header.Service
+	eventService  event.Service
+}
+
+// Init initializes the account with the owner pubkey and the guardian set.
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if err := a.setConfig(ctx, msg.Config); err != nil {
+		return nil, err
+	}
+
+	_, err := a.Account.Init(ctx, &basev1.MsgInit{PubKey: msg.PubKey, InitSequence: msg.InitSequence})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitResponse{}, nil
+}
+
+// UpdateConfig updates the guardian set, cancelling the pending recoveries.
+func (a Account) UpdateConfig(ctx context.Context, msg *v1.MsgUpdateConfig) (*v1.MsgUpdateConfigResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if err := a.setConfig(ctx, msg.Config); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateConfigResponse{}, a.Recoveries.Clear(ctx, nil)
+}
+
+func (a Account) setConfig(ctx context.Context, cfg *v1.Config) error {
+	if cfg == nil {
+		return errors.New("config must be specified")
+	}
+	if len(cfg.Guardians) == 0 {
+		return errors.New("guardians must be specified")
+	}
+
+	self, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return err
+	}
+
+	guardians := make(map[string]struct{}, len(cfg.Guardians)) // to check for duplicates
+	for _, guardian := range cfg.Guardians {
+		if _, err := a.addrCodec.StringToBytes(guardian); err != nil {
+			return fmt.Errorf("invalid guardian address %s: %w", guardian, err)
+		}
+		if guardian == self {
+			return errors.New("account cannot be its own guardian")
+		}
+		if _, ok := guardians[guardian]; ok {
+			return errors.New("duplicate guardian address found")
+		}
+		guardians[guardian] = struct{}{}
+	}
+
+	if cfg.Threshold == 0 || int(cfg.Threshold) > len(cfg.Guardians) {
+		return errors.New("threshold must be greater than zero and lower or equal to the number of guardians")
+	}
+	if cfg.RecoveryDelay <= 0 {
+		return errors.New("recovery delay must be positive")
+	}
+
+	return a.Config.Set(ctx, *cfg)
+}
+
+// InitiateRecovery creates a recovery of the account to a new pubkey, approved by the sending guardian.
+func (a Account) InitiateRecovery(ctx context.Context, msg *v1.MsgInitiateRecovery) (*v1.MsgInitiateRecoveryResponse, error) {
+	cfg, guardian, err := a.senderGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := a.DecodePubKey(msg.NewPubKey); err != nil {
+		return nil, fmt.Errorf("invalid new pubkey: %w", err)
+	}
+
+	id, err := a.RecoverySequence.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_initiated",
+		event.NewAttribute("recovery_id", fmt.Sprint(id)),
+		event.NewAttribute("guardian", guardian),
+	); err != nil {
+		return nil, err
+	}
+
+	recovery := v1.Recovery{Id: id, NewPubKey: msg.NewPubKey}
+	if err := a.approveRecovery(ctx, cfg, &recovery, guardian); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitiateRecoveryResponse{RecoveryId: id}, nil
+}
+
+// ApproveRecovery approves a pending recovery on behalf of the sending guardian.
+func (a Account) ApproveRecovery(ctx context.Context, msg *v1.MsgApproveRecovery) (*v1.MsgApproveRecoveryResponse, error) {
+	cfg, guardian, err := a.senderGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recovery, err := a.Recoveries.Get(ctx, msg.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(recovery.Approvals, guardian) {
+		return nil, errors.New("guardian has already approved the recovery")
+	}
+
+	return &v1.MsgApproveRecoveryResponse{}, a.approveRecovery(ctx, cfg, &recovery, guardian)
+}
+
+// approveRecovery adds the approval of guardian to the recovery, starting the
+// recovery delay once the threshold is reached.
+func (a Account) approveRecovery(ctx context.Context, cfg v1.Config, recovery *v1.Recovery, guardian string) error {
+	recovery.Approvals = append(recovery.Approvals, guardian)
+	if recovery.ExecutableAfter == nil && len(recovery.Approvals) >= int(cfg.Threshold) {
+		executableAfter := a.headerService.HeaderInfo(ctx).Time.Add(cfg.RecoveryDelay)
+		recovery.ExecutableAfter = &executableAfter
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_approved",
+		event.NewAttribute("recovery_id", fmt.Sprint(recovery.Id)),
+		event.NewAttribute("guardian", guardian),
+		event.NewAttribute("approvals", fmt.Sprint(len(recovery.Approvals))),
+	); err != nil {
+		return err
+	}
+
+	return a.Recoveries.Set(ctx, recovery.Id, *recovery)
+}
+
+// ExecuteRecovery swaps the account pubkey to the pubkey of a recovery approved
+// by the guardian threshold once its delay has elapsed. The pending recoveries are
+// cancelled.
+func (a Account) ExecuteRecovery(ctx context.Context, msg *v1.MsgExecuteRecovery) (*v1.MsgExecuteRecoveryResponse, error) {
+	recovery, err := a.Recoveries.Get(ctx, msg.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	if recovery.ExecutableAfter == nil {
+		return nil, errors.New("recovery has not reached the threshold")
+	}
+	if a.headerService.HeaderInfo(ctx).Time.Before(*recovery.ExecutableAfter) {
+		return nil, fmt.Errorf("recovery cannot be executed before %s", recovery.ExecutableAfter)
+	}
+
+	if err := a.SetPubKey(ctx, recovery.NewPubKey); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_executed",
+		event.NewAttribute("recovery_id", fmt.Sprint(recovery.Id)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgExecuteRecoveryResponse{}, a.Recoveries.Clear(ctx, nil)
+}
+
+// CancelRecovery cancels a pending recovery.
+func (a Account) CancelRecovery(ctx context.Context, msg *v1.MsgCancelRecovery) (*v1.MsgCancelRecoveryResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	has, err := a.Recoveries.Has(ctx, msg.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("recovery %d not found", msg.RecoveryId)
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_cancelled",
+		event.NewAttribute("recovery_id", fmt.Sprint(msg.RecoveryId)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelRecoveryResponse{}, a.Recoveries.Remove(ctx, msg.RecoveryId)
+}
+
+// senderGuardian returns the account config and the address of the sender, which must be a guardian.
+func (a Account) senderGuardian(ctx context.Context) (v1.Config, string, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return v1.Config{}, "", err
+	}
+
+	sender, err := a.addrCodec.BytesToString(accountstd.Sender(ctx))
+	if err != nil {
+		return v1.Config{}, "", err
+	}
+
+	if !slices.Contains(cfg.Guardians, sender) {
+		return v1.Config{}, "", errors.New("unauthorized: sender is not a guardian")
+	}
+
+	return cfg, sender, nil
+}
+
+// QueryConfig returns the guardian set of the account.
+func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryConfigResponse{Config: &cfg}, nil
+}
+
+// QueryRecoveries returns the pending recoveries of the account.
+func (a Account) QueryRecoveries(ctx context.Context, _ *v1.QueryRecoveries) (*v1.QueryRecoveriesResponse, error) {
+	var recoveries []v1.Recovery
+	err := a.Recoveries.Walk(ctx, nil, func(_ uint64, recovery v1.Recovery) (stop bool, err error) {
+		recoveries = append(recoveries, recovery)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryRecoveriesResponse{Recoveries: recoveries}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.InitiateRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ApproveRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.CancelRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QueryPubKey)
+	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryRecoveries)
+	accountstd.RegisterQueryHandler(builder, a.AuthRetroCompatibility)
+}
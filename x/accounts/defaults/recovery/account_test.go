@@ -0,0 +1,226 @@
+package recovery
+
+import (
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+const accAddr = "mock_recovery_account"
+
+func setupRecoveryAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+	handler := directHandler{}
+
+	createAccFn := NewAccount("recovery", signing.NewHandlerMap(handler), base.WithPubKeyWithValidationFunc(func(pt *secp256k1.PubKey) error {
+		_, err := dcrd_secp256k1.ParsePubKey(pt.Key)
+		return err
+	}))
+	_, acc, err := createAccFn(deps)
+	require.NoError(t, err)
+
+	return acc.(Account)
+}
+
+func newConfig() *v1.Config {
+	return &v1.Config{
+		Guardians:     []string{"guardian1", "guardian2", "guardian3"},
+		Threshold:     2,
+		RecoveryDelay: time.Hour,
+	}
+}
+
+func TestInit(t *testing.T) {
+	testcases := []struct {
+		name   string
+		config func(cfg *v1.Config)
+		expErr string
+	}{
+		{
+			name: "valid config",
+		},
+		{
+			name:   "no guardians",
+			config: func(cfg *v1.Config) { cfg.Guardians = nil },
+			expErr: "guardians must be specified",
+		},
+		{
+			name:   "duplicate guardian",
+			config: func(cfg *v1.Config) { cfg.Guardians = append(cfg.Guardians, "guardian1") },
+			expErr: "duplicate guardian address found",
+		},
+		{
+			name:   "self guardian",
+			config: func(cfg *v1.Config) { cfg.Guardians = append(cfg.Guardians, accAddr) },
+			expErr: "account cannot be its own guardian",
+		},
+		{
+			name:   "zero threshold",
+			config: func(cfg *v1.Config) { cfg.Threshold = 0 },
+			expErr: "threshold must be greater than zero",
+		},
+		{
+			name:   "threshold above guardians",
+			config: func(cfg *v1.Config) { cfg.Threshold = 4 },
+			expErr: "threshold must be greater than zero",
+		},
+		{
+			name:   "negative delay",
+			config: func(cfg *v1.Config) { cfg.RecoveryDelay = -time.Second },
+			expErr: "recovery delay must be positive",
+		},
+		{
+			name:   "zero delay",
+			config: func(cfg *v1.Config) { cfg.RecoveryDelay = 0 },
+			expErr: "recovery delay must be positive",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setupRecoveryAccount(t, ss)
+
+			cfg := newConfig()
+			if tc.config != nil {
+				tc.config(cfg)
+			}
+
+			_, err := acc.Init(ctx, &v1.MsgInit{
+				PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+				Config: cfg,
+			})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			res, err := acc.QueryConfig(ctx, &v1.QueryConfig{})
+			require.NoError(t, err)
+			require.Equal(t, cfg, res.Config)
+		})
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupRecoveryAccount(t, ss)
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		Config: newConfig(),
+	})
+	require.NoError(t, err)
+
+	newPubKey := toAnyPb(t, secp256k1.GenPrivKey().PubKey())
+	guardian1 := accountstd.SetSender(ctx, []byte("guardian1"))
+	guardian2 := accountstd.SetSender(ctx, []byte("guardian2"))
+
+	// only guardians can initiate and approve recoveries
+	_, err = acc.InitiateRecovery(ctx, &v1.MsgInitiateRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "sender is not a guardian")
+
+	_, err = acc.InitiateRecovery(guardian1, &v1.MsgInitiateRecovery{NewPubKey: toAnyPb(t, &secp256k1.PubKey{Key: []byte("invalid")})})
+	require.ErrorContains(t, err, "invalid new pubkey")
+
+	res, err := acc.InitiateRecovery(guardian1, &v1.MsgInitiateRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+	id := res.RecoveryId
+
+	_, err = acc.ApproveRecovery(guardian1, &v1.MsgApproveRecovery{RecoveryId: id})
+	require.ErrorContains(t, err, "guardian has already approved the recovery")
+
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{RecoveryId: id})
+	require.ErrorContains(t, err, "recovery has not reached the threshold")
+
+	_, err = acc.ApproveRecovery(guardian2, &v1.MsgApproveRecovery{RecoveryId: id})
+	require.NoError(t, err)
+
+	recoveries, err := acc.QueryRecoveries(ctx, &v1.QueryRecoveries{})
+	require.NoError(t, err)
+	require.Len(t, recoveries.Recoveries, 1)
+	require.Equal(t, []string{"guardian1", "guardian2"}, recoveries.Recoveries[0].Approvals)
+	require.Equal(t, blockTime.Add(time.Hour), *recoveries.Recoveries[0].ExecutableAfter)
+
+	// the recovery cannot be executed during the delay
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{RecoveryId: id})
+	require.ErrorContains(t, err, "recovery cannot be executed before")
+
+	afterDelay := withBlockTime(ctx, blockTime.Add(time.Hour))
+	_, err = acc.ExecuteRecovery(afterDelay, &v1.MsgExecuteRecovery{RecoveryId: id})
+	require.NoError(t, err)
+
+	pubKey, err := acc.QueryPubKey(ctx, &basev1.QueryPubKey{})
+	require.NoError(t, err)
+	require.Equal(t, newPubKey.Value, pubKey.PubKey.Value)
+
+	recoveries, err = acc.QueryRecoveries(ctx, &v1.QueryRecoveries{})
+	require.NoError(t, err)
+	require.Empty(t, recoveries.Recoveries)
+}
+
+func TestCancelRecovery(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupRecoveryAccount(t, ss)
+	ownerPubKey := toAnyPb(t, secp256k1.GenPrivKey().PubKey())
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: ownerPubKey, Config: newConfig()})
+	require.NoError(t, err)
+
+	res, err := acc.InitiateRecovery(accountstd.SetSender(ctx, []byte("guardian1")), &v1.MsgInitiateRecovery{NewPubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())})
+	require.NoError(t, err)
+	_, err = acc.ApproveRecovery(accountstd.SetSender(ctx, []byte("guardian2")), &v1.MsgApproveRecovery{RecoveryId: res.RecoveryId})
+	require.NoError(t, err)
+
+	// only the owner can cancel a recovery
+	_, err = acc.CancelRecovery(accountstd.SetSender(ctx, []byte("guardian3")), &v1.MsgCancelRecovery{RecoveryId: res.RecoveryId})
+	require.ErrorContains(t, err, "unauthorized")
+
+	self := accountstd.SetSender(ctx, []byte(accAddr))
+	_, err = acc.CancelRecovery(self, &v1.MsgCancelRecovery{RecoveryId: res.RecoveryId})
+	require.NoError(t, err)
+
+	_, err = acc.CancelRecovery(self, &v1.MsgCancelRecovery{RecoveryId: res.RecoveryId})
+	require.ErrorContains(t, err, "not found")
+
+	_, err = acc.ExecuteRecovery(withBlockTime(ctx, blockTime.Add(time.Hour)), &v1.MsgExecuteRecovery{RecoveryId: res.RecoveryId})
+	require.Error(t, err)
+
+	pubKey, err := acc.QueryPubKey(ctx, &basev1.QueryPubKey{})
+	require.NoError(t, err)
+	require.Equal(t, ownerPubKey.Value, pubKey.PubKey.Value)
+
+	// updating the guardian set cancels the pending recoveries
+	_, err = acc.InitiateRecovery(accountstd.SetSender(ctx, []byte("guardian1")), &v1.MsgInitiateRecovery{NewPubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())})
+	require.NoError(t, err)
+
+	_, err = acc.UpdateConfig(ctx, &v1.MsgUpdateConfig{Config: newConfig()})
+	require.ErrorContains(t, err, "unauthorized")
+
+	_, err = acc.UpdateConfig(self, &v1.MsgUpdateConfig{Config: &v1.Config{Guardians: []string{"guardian4"}, Threshold: 1, RecoveryDelay: time.Hour}})
+	require.NoError(t, err)
+
+	recoveries, err := acc.QueryRecoveries(ctx, &v1.QueryRecoveries{})
+	require.NoError(t, err)
+	require.Empty(t, recoveries.Recoveries)
+}
+
+func toAnyPb(t *testing.T, pm gogoproto.Message) *codectypes.Any {
+	t.Helper()
+	pb, err := codectypes.NewAnyWithValue(pm)
+	require.NoError(t, err)
+	return pb
+}
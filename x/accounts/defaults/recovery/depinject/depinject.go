@@ -0,0 +1,21 @@
+package recoverydepinject
+
+import (
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	"cosmossdk.io/x/accounts/defaults/recovery"
+	"cosmossdk.io/x/tx/signing"
+)
+
+type Inputs struct {
+	depinject.In
+
+	SignHandlersMap *signing.HandlerMap
+	Options         []base.Option
+}
+
+// ProvideAccount provides the recovery account, supporting the pubkey types plugged in the base account options.
+func ProvideAccount(in Inputs) accountstd.DepinjectAccount {
+	return accountstd.DepinjectAccount{MakeAccount: recovery.NewAccount("recovery", in.SignHandlersMap, in.Options...)}
+}
@@ -0,0 +1,324 @@
+package sessionkey
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/sessionkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var SessionKeysPrefix = collections.NewPrefix(3) // prefixes 0 to 2 are used by the base account
+
+var (
+	msgSendTypeURL    = "/" + string((&bankv1beta1.MsgSend{}).ProtoReflect().Descriptor().FullName())
+	msgExecuteTypeURL = "/" + gogoproto.MessageName(&accountsv1.MsgExecute{})
+)
+
+// Compile-time type assertions
+var (
+	_ accountstd.Interface = Account{}
+)
+
+// NewAccount returns a new session key account creator function.
+// The options define the pubkey types supported for both the owner and the session keys.
+func NewAccount(name string, handlerMap *signing.HandlerMap, options ...base.Option) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, baseAcc, err := base.NewAccount(name, handlerMap, options...)(deps)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return name, Account{
+			Account:     baseAcc.(base.Account),
+			SessionKeys: collections.NewMap(deps.SchemaBuilder, SessionKeysPrefix, "session_keys", collections.BytesKey, codec.CollValue[v1.SessionKey](deps.LegacyStateCodec)),
+			addrCodec:   deps.AddressCodec,
+			hs:          deps.Environment.HeaderService,
+		}, nil
+	}
+}
+
+// Account implements a base account whose owner can register session keys,
+// allowed to sign transactions containing some message types only, up to a
+// spend limit and until they expire.
+type Account struct {
+	base.Account
+
+	// SessionKeys maps the pubkey bytes of a session key to the session key.
+	SessionKeys collections.Map[[]byte, v1.SessionKey]
+
+	addrCodec address.Codec
+	hs        header.Service
+}
+
+// Init initializes the account with the owner pubkey and the given session keys.
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	_, err := a.Account.Init(ctx, &basev1.MsgInit{PubKey: msg.PubKey, InitSequence: msg.InitSequence})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sessionKey := range msg.SessionKeys {
+		if err := a.saveSessionKey(ctx, sessionKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.MsgInitResponse{}, nil
+}
+
+// AddSessionKey registers a session key, replacing an existing one with the same pubkey.
+func (a Account) AddSessionKey(ctx context.Context, msg *v1.MsgAddSessionKey) (*v1.MsgAddSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	return &v1.MsgAddSessionKeyResponse{}, a.saveSessionKey(ctx, msg.SessionKey)
+}
+
+// RemoveSessionKey revokes a session key.
+func (a Account) RemoveSessionKey(ctx context.Context, msg *v1.MsgRemoveSessionKey) (*v1.MsgRemoveSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	if msg.PubKey == nil {
+		return nil, errors.New("empty pubkey")
+	}
+
+	has, err := a.SessionKeys.Has(ctx, msg.PubKey.Value)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errors.New("session key not found")
+	}
+
+	return &v1.MsgRemoveSessionKeyResponse{}, a.SessionKeys.Remove(ctx, msg.PubKey.Value)
+}
+
+func (a Account) saveSessionKey(ctx context.Context, sessionKey *v1.SessionKey) error {
+	if sessionKey == nil {
+		return errors.New("empty session key")
+	}
+	if _, err := a.DecodePubKey(sessionKey.PubKey); err != nil {
+		return fmt.Errorf("invalid session key pubkey: %w", err)
+	}
+
+	ownerPubKey, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(ownerPubKey, sessionKey.PubKey.Value) {
+		return errors.New("session key cannot be the account pubkey")
+	}
+
+	if len(sessionKey.AllowedMsgs) == 0 {
+		return errors.New("session key must allow at least one message type")
+	}
+	for _, typeURL := range sessionKey.AllowedMsgs {
+		if typeURL == "" {
+			return errors.New("empty allowed message type URL")
+		}
+	}
+
+	if err := sessionKey.SpendLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid spend limit: %w", err)
+	}
+	// the spend limit could be bypassed by the messages whose spending is not metered, and it cannot
+	// be checked at authentication only, as an exhausted spend limit is empty
+	if !sessionKey.SpendLimit.Empty() {
+		for _, typeURL := range sessionKey.AllowedMsgs {
+			if !isMeteredMsg(typeURL) {
+				return fmt.Errorf("message %s cannot be allowed by a session key with a spend limit, as its spending is not metered", typeURL)
+			}
+		}
+	}
+
+	if !a.hs.HeaderInfo(ctx).Time.Before(sessionKey.Expiration) {
+		return errors.New("session key expiration must be in the future")
+	}
+
+	return a.SessionKeys.Set(ctx, sessionKey.PubKey.Value, *sessionKey)
+}
+
+// Authenticate implements the authentication flow of an abstracted session key account.
+// Txs signed by the account pubkey are authenticated like for a base account, txs signed by
+// a session key must be within the scope of the session key.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	signerPubKey := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].PublicKey
+	if signerPubKey == nil {
+		return a.Account.Authenticate(ctx, msg)
+	}
+
+	ownerPubKey, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(ownerPubKey, signerPubKey.Value) {
+		return a.Account.Authenticate(ctx, msg)
+	}
+
+	sessionKey, err := a.SessionKeys.Get(ctx, signerPubKey.Value)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errors.New("unknown session key")
+		}
+		return nil, err
+	}
+
+	if !a.hs.HeaderInfo(ctx).Time.Before(sessionKey.Expiration) {
+		return nil, errors.New("session key expired")
+	}
+
+	if err := a.spendSessionKey(ctx, &sessionKey, msg); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := a.DecodePubKey(sessionKey.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.AuthenticateWithPubKey(ctx, msg, pubKey); err != nil {
+		return nil, err
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, a.SessionKeys.Set(ctx, signerPubKey.Value, sessionKey)
+}
+
+// spendSessionKey checks that the tx messages are allowed by the session key and deducts
+// the coins spent by the account from the spend limit of the session key.
+func (a Account) spendSessionKey(ctx context.Context, sessionKey *v1.SessionKey, msg *aa_interface_v1.MsgAuthenticate) error {
+	addr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return err
+	}
+
+	var spent sdk.Coins
+	for _, anyMsg := range msg.Tx.Body.Messages {
+		if !slices.Contains(sessionKey.AllowedMsgs, anyMsg.TypeUrl) {
+			return fmt.Errorf("message %s is not allowed by the session key", anyMsg.TypeUrl)
+		}
+
+		coins, err := msgSpending(addr, anyMsg)
+		if err != nil {
+			return err
+		}
+		spent = spent.Add(coins...)
+	}
+
+	// the fees are paid by the account when it is the fee payer, by default the first signer
+	if fee := msg.Tx.AuthInfo.Fee; fee != nil && fee.Granter == "" && (fee.Payer == addr || (fee.Payer == "" && msg.SignerIndex == 0)) {
+		spent = spent.Add(fee.Amount...)
+	}
+
+	remaining, hasNeg := sessionKey.SpendLimit.SafeSub(spent...)
+	if hasNeg {
+		return fmt.Errorf("session key spend limit exceeded: spending %s, remaining %s", spent, sessionKey.SpendLimit)
+	}
+	sessionKey.SpendLimit = remaining
+
+	return nil
+}
+
+// isMeteredMsg returns whether the coins spent by the account when executing a message of the
+// given type are metered by msgSpending.
+func isMeteredMsg(typeURL string) bool {
+	return typeURL == msgSendTypeURL || typeURL == msgExecuteTypeURL
+}
+
+// msgSpending returns the coins spent by the account addr when executing msg.
+func msgSpending(addr string, msg *codectypes.Any) (sdk.Coins, error) {
+	switch msg.TypeUrl {
+	case msgSendTypeURL:
+		send := new(bankv1beta1.MsgSend)
+		if err := proto.Unmarshal(msg.Value, send); err != nil {
+			return nil, err
+		}
+		if send.FromAddress != addr {
+			return nil, nil
+		}
+
+		coins := make(sdk.Coins, 0, len(send.Amount))
+		for _, coin := range send.Amount {
+			amount, ok := math.NewIntFromString(coin.Amount)
+			if !ok {
+				return nil, fmt.Errorf("invalid amount %s", coin.Amount)
+			}
+			coins = coins.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+		return coins, nil
+
+	case msgExecuteTypeURL:
+		execute := new(accountsv1.MsgExecute)
+		if err := gogoproto.Unmarshal(msg.Value, execute); err != nil {
+			return nil, err
+		}
+		// prevent session keys from managing the account, e.g. registering new session keys
+		if execute.Target == addr {
+			return nil, errors.New("session key cannot execute messages on its own account")
+		}
+		if execute.Sender != addr {
+			return nil, nil
+		}
+		return execute.Funds, nil
+
+	default:
+		return nil, nil
+	}
+}
+
+// QuerySessionKeys returns the session keys of the account.
+func (a Account) QuerySessionKeys(ctx context.Context, _ *v1.QuerySessionKeys) (*v1.QuerySessionKeysResponse, error) {
+	var sessionKeys []v1.SessionKey
+	err := a.SessionKeys.Walk(ctx, nil, func(_ []byte, sessionKey v1.SessionKey) (stop bool, err error) {
+		sessionKeys = append(sessionKeys, sessionKey)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QuerySessionKeysResponse{SessionKeys: sessionKeys}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.AddSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.RemoveSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QueryPubKey)
+	accountstd.RegisterQueryHandler(builder, a.QuerySessionKeys)
+	accountstd.RegisterQueryHandler(builder, a.AuthRetroCompatibility)
+}
@@ -0,0 +1,288 @@
+package sessionkey
+
+import (
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	v1 "cosmossdk.io/x/accounts/defaults/sessionkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const accAddr = "mock_session_key_account"
+
+func setupSessionKeyAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+	handler := directHandler{}
+
+	createAccFn := NewAccount("session-key", signing.NewHandlerMap(handler), base.WithPubKeyWithValidationFunc(func(pt *secp256k1.PubKey) error {
+		_, err := dcrd_secp256k1.ParsePubKey(pt.Key)
+		return err
+	}))
+	_, acc, err := createAccFn(deps)
+	require.NoError(t, err)
+
+	return acc.(Account)
+}
+
+func newSessionKey(t *testing.T, pubKey gogoproto.Message, allowedMsgs ...string) *v1.SessionKey {
+	t.Helper()
+	return &v1.SessionKey{
+		PubKey:      toAnyPb(t, pubKey),
+		AllowedMsgs: allowedMsgs,
+		SpendLimit:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		Expiration:  blockTime.Add(time.Hour),
+	}
+}
+
+func TestInit(t *testing.T) {
+	ownerPubKey := secp256k1.GenPrivKey().PubKey()
+
+	testcases := []struct {
+		name       string
+		sessionKey func(sk *v1.SessionKey)
+		expErr     string
+	}{
+		{
+			name: "valid session key",
+		},
+		{
+			name:       "invalid pubkey",
+			sessionKey: func(sk *v1.SessionKey) { sk.PubKey = toAnyPb(t, &secp256k1.PubKey{Key: []byte("invalid")}) },
+			expErr:     "invalid session key pubkey",
+		},
+		{
+			name:       "owner pubkey",
+			sessionKey: func(sk *v1.SessionKey) { sk.PubKey = toAnyPb(t, ownerPubKey) },
+			expErr:     "session key cannot be the account pubkey",
+		},
+		{
+			name:       "no allowed messages",
+			sessionKey: func(sk *v1.SessionKey) { sk.AllowedMsgs = nil },
+			expErr:     "session key must allow at least one message type",
+		},
+		{
+			name:       "invalid spend limit",
+			sessionKey: func(sk *v1.SessionKey) { sk.SpendLimit = sdk.Coins{{Denom: "stake", Amount: math.ZeroInt()}} },
+			expErr:     "invalid spend limit",
+		},
+		{
+			name:       "unmetered message with spend limit",
+			sessionKey: func(sk *v1.SessionKey) { sk.AllowedMsgs = append(sk.AllowedMsgs, "/cosmos.bank.v1beta1.MsgMultiSend") },
+			expErr:     "cannot be allowed by a session key with a spend limit",
+		},
+		{
+			name: "unmetered message without spend limit",
+			sessionKey: func(sk *v1.SessionKey) {
+				sk.AllowedMsgs = append(sk.AllowedMsgs, "/cosmos.bank.v1beta1.MsgMultiSend")
+				sk.SpendLimit = nil
+			},
+		},
+		{
+			name:       "expired",
+			sessionKey: func(sk *v1.SessionKey) { sk.Expiration = blockTime },
+			expErr:     "session key expiration must be in the future",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setupSessionKeyAccount(t, ss)
+
+			sessionKey := newSessionKey(t, secp256k1.GenPrivKey().PubKey(), msgSendTypeURL)
+			if tc.sessionKey != nil {
+				tc.sessionKey(sessionKey)
+			}
+
+			_, err := acc.Init(ctx, &v1.MsgInit{
+				PubKey:      toAnyPb(t, ownerPubKey),
+				SessionKeys: []*v1.SessionKey{sessionKey},
+			})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			res, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+			require.NoError(t, err)
+			require.Len(t, res.SessionKeys, 1)
+			require.Equal(t, sessionKey.PubKey.Value, res.SessionKeys[0].PubKey.Value)
+		})
+	}
+}
+
+func TestAddRemoveSessionKey(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupSessionKeyAccount(t, ss)
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())})
+	require.NoError(t, err)
+
+	sessionKey := newSessionKey(t, secp256k1.GenPrivKey().PubKey(), msgSendTypeURL)
+
+	_, err = acc.AddSessionKey(accountstd.SetSender(ctx, []byte("sender")), &v1.MsgAddSessionKey{SessionKey: sessionKey})
+	require.ErrorContains(t, err, "unauthorized")
+
+	ctx = accountstd.SetSender(ctx, []byte(accAddr))
+	_, err = acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{SessionKey: sessionKey})
+	require.NoError(t, err)
+
+	res, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Len(t, res.SessionKeys, 1)
+
+	_, err = acc.RemoveSessionKey(accountstd.SetSender(ctx, []byte("sender")), &v1.MsgRemoveSessionKey{PubKey: sessionKey.PubKey})
+	require.ErrorContains(t, err, "unauthorized")
+
+	_, err = acc.RemoveSessionKey(ctx, &v1.MsgRemoveSessionKey{PubKey: sessionKey.PubKey})
+	require.NoError(t, err)
+
+	_, err = acc.RemoveSessionKey(ctx, &v1.MsgRemoveSessionKey{PubKey: sessionKey.PubKey})
+	require.ErrorContains(t, err, "session key not found")
+
+	res, err = acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Empty(t, res.SessionKeys)
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupSessionKeyAccount(t, ss)
+
+	ownerKey := secp256k1.GenPrivKey()
+	sessionPrivKey := secp256k1.GenPrivKey()
+	sessionKey := newSessionKey(t, sessionPrivKey.PubKey(), msgSendTypeURL, msgExecuteTypeURL)
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey:      toAnyPb(t, ownerKey.PubKey()),
+		SessionKeys: []*v1.SessionKey{sessionKey},
+	})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+	send := func(amount string) *codectypes.Any {
+		bz, err := proto.Marshal(&bankv1beta1.MsgSend{
+			FromAddress: accAddr,
+			ToAddress:   "recipient",
+			Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: amount}},
+		})
+		require.NoError(t, err)
+		return &codectypes.Any{TypeUrl: msgSendTypeURL, Value: bz}
+	}
+	execute := func(target string) *codectypes.Any {
+		return toAnyPb(t, &accountsv1.MsgExecute{Sender: accAddr, Target: target})
+	}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	// the owner key is not restricted
+	_, err = acc.Authenticate(ctx, signTx(t, ownerKey, 0, nil, execute(accAddr)))
+	require.NoError(t, err)
+
+	// the session key is restricted to its allowed messages
+	_, err = acc.Authenticate(ctx, signTx(t, sessionPrivKey, 1, fee, toAnyPb(t, &v1.MsgAddSessionKey{})))
+	require.ErrorContains(t, err, "is not allowed by the session key")
+
+	// the session key cannot manage the account
+	_, err = acc.Authenticate(ctx, signTx(t, sessionPrivKey, 1, fee, execute(accAddr)))
+	require.ErrorContains(t, err, "session key cannot execute messages on its own account")
+
+	// the session key is restricted to its spend limit, including fees
+	_, err = acc.Authenticate(ctx, signTx(t, sessionPrivKey, 1, fee, send("91")))
+	require.ErrorContains(t, err, "session key spend limit exceeded")
+
+	_, err = acc.Authenticate(ctx, signTx(t, sessionPrivKey, 1, fee, send("50"), execute("other")))
+	require.NoError(t, err)
+
+	res, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), res.SessionKeys[0].SpendLimit)
+
+	// an unknown key is rejected
+	_, err = acc.Authenticate(ctx, signTx(t, secp256k1.GenPrivKey(), 2, nil, send("1")))
+	require.ErrorContains(t, err, "unknown session key")
+
+	// an expired session key is rejected
+	sessionKey.Expiration = blockTime
+	require.NoError(t, acc.SessionKeys.Set(ctx, sessionKey.PubKey.Value, *sessionKey))
+	_, err = acc.Authenticate(ctx, signTx(t, sessionPrivKey, 2, nil, send("1")))
+	require.ErrorContains(t, err, "session key expired")
+}
+
+// signTx returns the authentication request of a tx containing msgs signed by privKey.
+func signTx(t *testing.T, privKey *secp256k1.PrivKey, sequence uint64, fee sdk.Coins, msgs ...*codectypes.Any) *aa_interface_v1.MsgAuthenticate {
+	t.Helper()
+	transaction := tx.Tx{
+		Body: &tx.TxBody{Messages: msgs},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{
+				{
+					PublicKey: toAnyPb(t, privKey.PubKey()),
+					ModeInfo: &tx.ModeInfo{
+						Sum: &tx.ModeInfo_Single_{
+							Single: &tx.ModeInfo_Single{
+								Mode: 1,
+							},
+						},
+					},
+					Sequence: sequence,
+				},
+			},
+			Fee: &tx.Fee{Amount: fee},
+		},
+	}
+
+	bodyByte, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authByte, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+
+	txDoc := tx.SignDoc{
+		BodyBytes:     bodyByte,
+		AuthInfoBytes: authByte,
+		ChainId:       "test",
+		AccountNumber: 1,
+	}
+	signBytes, err := txDoc.Marshal()
+	require.NoError(t, err)
+
+	sig, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	transaction.Signatures = [][]byte{sig}
+
+	return &aa_interface_v1.MsgAuthenticate{
+		RawTx: &tx.TxRaw{
+			BodyBytes:     bodyByte,
+			AuthInfoBytes: authByte,
+			Signatures:    transaction.Signatures,
+		},
+		Tx:          &transaction,
+		SignerIndex: 0,
+	}
+}
+
+func toAnyPb(t *testing.T, pm gogoproto.Message) *codectypes.Any {
+	t.Helper()
+	pb, err := codectypes.NewAnyWithValue(pm)
+	require.NoError(t, err)
+	return pb
+}
@@ -0,0 +1,305 @@
+package timelock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/timelock/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var (
+	ConfigPrefix     = collections.NewPrefix(0)
+	SequencePrefix   = collections.NewPrefix(1)
+	OperationsPrefix = collections.NewPrefix(2)
+)
+
+// Compile-time type assertions
+var (
+	_ accountstd.Interface = (*Account)(nil)
+)
+
+type Account struct {
+	Config     collections.Item[v1.Config]
+	Sequence   collections.Sequence
+	Operations collections.Map[uint64, v1.Operation]
+
+	addrCodec     address.Codec
+	headerService header.Service
+	eventService  event.Service
+}
+
+// NewAccount returns a new timelock account creator function.
+func NewAccount(deps accountstd.Dependencies) (*Account, error) {
+	return &Account{
+		Config:        collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+		Sequence:      collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+		Operations:    collections.NewMap(deps.SchemaBuilder, OperationsPrefix, "operations", collections.Uint64Key, codec.CollValue[v1.Operation](deps.LegacyStateCodec)),
+		addrCodec:     deps.AddressCodec,
+		headerService: deps.Environment.HeaderService,
+		eventService:  deps.Environment.EventService,
+	}, nil
+}
+
+// Init initializes the timelock account with the given roles and delay.
+func (a *Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if err := a.setConfig(ctx, msg.Config); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitResponse{}, nil
+}
+
+// UpdateConfig updates the roles and the delay of the timelock account. Queued
+// operations keep their eta.
+func (a Account) UpdateConfig(ctx context.Context, msg *v1.MsgUpdateConfig) (*v1.MsgUpdateConfigResponse, error) {
+	// this function can only be executed by the account itself
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("only the account itself can update the config (through a queued operation)")
+	}
+
+	if err := a.setConfig(ctx, msg.Config); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateConfigResponse{}, nil
+}
+
+// setConfig validates and sets the config of the account.
+func (a Account) setConfig(ctx context.Context, cfg *v1.Config) error {
+	if cfg == nil {
+		return errors.New("config must be specified")
+	}
+
+	if len(cfg.Proposers) == 0 {
+		return errors.New("proposers must be specified")
+	}
+
+	if err := a.validateAddresses("proposer", cfg.Proposers); err != nil {
+		return err
+	}
+	if err := a.validateAddresses("executor", cfg.Executors); err != nil {
+		return err
+	}
+	if err := a.validateAddresses("canceller", cfg.Cancellers); err != nil {
+		return err
+	}
+
+	if cfg.MinDelay < 0 {
+		return errors.New("min delay cannot be negative")
+	}
+
+	return a.Config.Set(ctx, *cfg)
+}
+
+// validateAddresses checks that addrs are valid distinct addresses.
+func (a Account) validateAddresses(role string, addrs []string) error {
+	seen := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate %s address found: %s", role, addr)
+		}
+		seen[addr] = struct{}{}
+
+		if _, err := a.addrCodec.StringToBytes(addr); err != nil {
+			return fmt.Errorf("invalid %s address %s: %w", role, addr, err)
+		}
+	}
+
+	return nil
+}
+
+// QueueMessages queues messages for execution after a delay. The sender must be a proposer.
+func (a Account) QueueMessages(ctx context.Context, msg *v1.MsgQueueMessages) (*v1.MsgQueueMessagesResponse, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposer, err := a.senderWithRole(ctx, "proposer", cfg.Proposers)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.Messages) == 0 {
+		return nil, errors.New("messages must be specified")
+	}
+
+	minEta := a.headerService.HeaderInfo(ctx).Time.Add(cfg.MinDelay)
+	eta := minEta
+	if msg.Eta != nil {
+		if msg.Eta.Before(minEta) {
+			return nil, fmt.Errorf("eta %s is before the minimum delay ends at %s", msg.Eta, minEta)
+		}
+		eta = *msg.Eta
+	}
+
+	id, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.Operations.Set(ctx, id, v1.Operation{
+		Id:       id,
+		Messages: msg.Messages,
+		Eta:      eta,
+		Proposer: proposer,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("operation_queued",
+		event.NewAttribute("operation_id", fmt.Sprint(id)),
+		event.NewAttribute("proposer", proposer),
+		event.NewAttribute("eta", eta.Format(time.RFC3339)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgQueueMessagesResponse{OperationId: id}, nil
+}
+
+// ExecuteMessages executes a ready operation and removes it from the queue. The sender must be an
+// executor, unless no executors are configured. If a message fails, the operation stays queued.
+func (a Account) ExecuteMessages(ctx context.Context, msg *v1.MsgExecuteMessages) (*v1.MsgExecuteMessagesResponse, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	executor, err := a.addrCodec.BytesToString(accountstd.Sender(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.Executors) != 0 && !slices.Contains(cfg.Executors, executor) {
+		return nil, errors.New("unauthorized: sender is not an executor")
+	}
+
+	op, err := a.Operations.Get(ctx, msg.OperationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if now := a.headerService.HeaderInfo(ctx).Time; now.Before(op.Eta) {
+		return nil, fmt.Errorf("operation cannot be executed before %s", op.Eta)
+	}
+
+	// remove the operation first so that it cannot be executed again by its own messages
+	if err := a.Operations.Remove(ctx, msg.OperationId); err != nil {
+		return nil, err
+	}
+
+	responses, err := accountstd.ExecModuleAnys(ctx, op.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("operation_executed",
+		event.NewAttribute("operation_id", fmt.Sprint(msg.OperationId)),
+		event.NewAttribute("executor", executor),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgExecuteMessagesResponse{Responses: responses}, nil
+}
+
+// CancelMessages removes a queued operation. The sender must be a canceller.
+func (a Account) CancelMessages(ctx context.Context, msg *v1.MsgCancelMessages) (*v1.MsgCancelMessagesResponse, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	canceller, err := a.senderWithRole(ctx, "canceller", cfg.Cancellers)
+	if err != nil {
+		return nil, err
+	}
+
+	has, err := a.Operations.Has(ctx, msg.OperationId)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("operation %d not found", msg.OperationId)
+	}
+
+	if err := a.Operations.Remove(ctx, msg.OperationId); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("operation_cancelled",
+		event.NewAttribute("operation_id", fmt.Sprint(msg.OperationId)),
+		event.NewAttribute("canceller", canceller),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelMessagesResponse{}, nil
+}
+
+// senderWithRole returns the address of the sender, which must be one of addrs.
+func (a Account) senderWithRole(ctx context.Context, role string, addrs []string) (string, error) {
+	sender, err := a.addrCodec.BytesToString(accountstd.Sender(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	if !slices.Contains(addrs, sender) {
+		return "", fmt.Errorf("unauthorized: sender is not a %s", role)
+	}
+
+	return sender, nil
+}
+
+// QueryConfig returns the current timelock configuration.
+func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryConfigResponse{Config: &cfg}, nil
+}
+
+// QueryQueue returns the queued operations, ordered by id.
+func (a Account) QueryQueue(ctx context.Context, _ *v1.QueryQueue) (*v1.QueryQueueResponse, error) {
+	var operations []v1.Operation
+	err := a.Operations.Walk(ctx, nil, func(_ uint64, op v1.Operation) (stop bool, err error) {
+		operations = append(operations, op)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryQueueResponse{Operations: operations}, nil
+}
+
+// RegisterExecuteHandlers implements implementation.Account.
+func (a *Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.QueueMessages)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteMessages)
+	accountstd.RegisterExecuteHandler(builder, a.CancelMessages)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+}
+
+// RegisterInitHandler implements implementation.Account.
+func (a *Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+// RegisterQueryHandlers implements implementation.Account.
+func (a *Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryQueue)
+}
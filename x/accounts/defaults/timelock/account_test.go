@@ -0,0 +1,262 @@
+package timelock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/timelock/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const accAddr = "timelock_acc"
+
+func setup(t *testing.T, ctx context.Context, ss store.KVStoreService, timefn func() time.Time) *Account {
+	t.Helper()
+	deps := makeMockDependencies(ss, timefn)
+
+	acc, err := NewAccount(deps)
+	require.NoError(t, err)
+
+	return acc
+}
+
+func newConfig() *v1.Config {
+	return &v1.Config{
+		Proposers:  []string{"proposer"},
+		Executors:  []string{"executor"},
+		Cancellers: []string{"canceller"},
+		MinDelay:   time.Hour,
+	}
+}
+
+func TestInit(t *testing.T) {
+	testcases := []struct {
+		name   string
+		config func(cfg *v1.Config)
+		expErr string
+	}{
+		{
+			name: "valid config",
+		},
+		{
+			name:   "no proposers",
+			config: func(cfg *v1.Config) { cfg.Proposers = nil },
+			expErr: "proposers must be specified",
+		},
+		{
+			name:   "duplicate executor",
+			config: func(cfg *v1.Config) { cfg.Executors = append(cfg.Executors, "executor") },
+			expErr: "duplicate executor address found",
+		},
+		{
+			name:   "no executors",
+			config: func(cfg *v1.Config) { cfg.Executors = nil },
+		},
+		{
+			name:   "negative delay",
+			config: func(cfg *v1.Config) { cfg.MinDelay = -time.Second },
+			expErr: "min delay cannot be negative",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setup(t, ctx, ss, time.Now)
+
+			cfg := newConfig()
+			if tc.config != nil {
+				tc.config(cfg)
+			}
+
+			_, err := acc.Init(ctx, &v1.MsgInit{Config: cfg})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			res, err := acc.QueryConfig(ctx, &v1.QueryConfig{})
+			require.NoError(t, err)
+			require.Equal(t, cfg, res.Config)
+		})
+	}
+}
+
+func TestQueueExecute(t *testing.T) {
+	var executed []transaction.Msg
+	ctx, ss := accountstd.NewMockContext(
+		0, []byte(accAddr), []byte("proposer"), TestFunds,
+		func(ctx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error) {
+			executed = append(executed, msg)
+			return &banktypes.MsgSendResponse{}, nil
+		}, func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+			return nil, nil
+		},
+	)
+
+	currentTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	acc := setup(t, ctx, ss, func() time.Time {
+		return currentTime
+	})
+	_, err := acc.Init(ctx, &v1.MsgInit{Config: newConfig()})
+	require.NoError(t, err)
+
+	send, err := accountstd.PackAny(&banktypes.MsgSend{
+		FromAddress: accAddr,
+		ToAddress:   "recipient",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("test", 1)),
+	})
+	require.NoError(t, err)
+	msgs := []*codectypes.Any{send}
+
+	// only proposers can queue operations
+	_, err = acc.QueueMessages(accountstd.SetSender(ctx, []byte("executor")), &v1.MsgQueueMessages{Messages: msgs})
+	require.ErrorContains(t, err, "sender is not a proposer")
+
+	_, err = acc.QueueMessages(ctx, &v1.MsgQueueMessages{})
+	require.ErrorContains(t, err, "messages must be specified")
+
+	// the eta cannot be before the minimum delay
+	tooEarly := currentTime.Add(time.Minute)
+	_, err = acc.QueueMessages(ctx, &v1.MsgQueueMessages{Messages: msgs, Eta: &tooEarly})
+	require.ErrorContains(t, err, "is before the minimum delay ends")
+
+	res, err := acc.QueueMessages(ctx, &v1.MsgQueueMessages{Messages: msgs})
+	require.NoError(t, err)
+
+	later := currentTime.Add(2 * time.Hour)
+	_, err = acc.QueueMessages(ctx, &v1.MsgQueueMessages{Messages: msgs, Eta: &later})
+	require.NoError(t, err)
+
+	queue, err := acc.QueryQueue(ctx, &v1.QueryQueue{})
+	require.NoError(t, err)
+	require.Len(t, queue.Operations, 2)
+	require.Equal(t, res.OperationId, queue.Operations[0].Id)
+	require.Equal(t, currentTime.Add(time.Hour), queue.Operations[0].Eta)
+	require.Equal(t, later, queue.Operations[1].Eta)
+	require.Equal(t, "proposer", queue.Operations[0].Proposer)
+
+	executor := accountstd.SetSender(ctx, []byte("executor"))
+
+	// the operation cannot be executed before its eta
+	_, err = acc.ExecuteMessages(executor, &v1.MsgExecuteMessages{OperationId: res.OperationId})
+	require.ErrorContains(t, err, "operation cannot be executed before")
+
+	currentTime = currentTime.Add(time.Hour)
+
+	// only executors can execute operations
+	_, err = acc.ExecuteMessages(ctx, &v1.MsgExecuteMessages{OperationId: res.OperationId})
+	require.ErrorContains(t, err, "sender is not an executor")
+
+	execRes, err := acc.ExecuteMessages(executor, &v1.MsgExecuteMessages{OperationId: res.OperationId})
+	require.NoError(t, err)
+	require.Len(t, execRes.Responses, 1)
+	require.Len(t, executed, 1)
+
+	_, err = acc.ExecuteMessages(executor, &v1.MsgExecuteMessages{OperationId: res.OperationId})
+	require.ErrorContains(t, err, "not found")
+
+	queue, err = acc.QueryQueue(ctx, &v1.QueryQueue{})
+	require.NoError(t, err)
+	require.Len(t, queue.Operations, 1)
+}
+
+func TestCancel(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setup(t, ctx, ss, time.Now)
+	_, err := acc.Init(ctx, &v1.MsgInit{Config: newConfig()})
+	require.NoError(t, err)
+
+	update, err := accountstd.PackAny(&v1.MsgUpdateConfig{Config: newConfig()})
+	require.NoError(t, err)
+
+	res, err := acc.QueueMessages(accountstd.SetSender(ctx, []byte("proposer")), &v1.MsgQueueMessages{Messages: []*codectypes.Any{update}})
+	require.NoError(t, err)
+
+	// only cancellers can cancel operations
+	_, err = acc.CancelMessages(accountstd.SetSender(ctx, []byte("proposer")), &v1.MsgCancelMessages{OperationId: res.OperationId})
+	require.ErrorContains(t, err, "sender is not a canceller")
+
+	canceller := accountstd.SetSender(ctx, []byte("canceller"))
+	_, err = acc.CancelMessages(canceller, &v1.MsgCancelMessages{OperationId: res.OperationId})
+	require.NoError(t, err)
+
+	_, err = acc.CancelMessages(canceller, &v1.MsgCancelMessages{OperationId: res.OperationId})
+	require.ErrorContains(t, err, "not found")
+
+	queue, err := acc.QueryQueue(ctx, &v1.QueryQueue{})
+	require.NoError(t, err)
+	require.Empty(t, queue.Operations)
+}
+
+func TestUpdateConfig(t *testing.T) {
+	var acc *Account
+	var ctx context.Context
+	var ss store.KVStoreService
+	ctx, ss = accountstd.NewMockContext(
+		0, []byte(accAddr), []byte("proposer"), TestFunds,
+		func(ictx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error) {
+			if execmsg, ok := msg.(*accountsv1.MsgExecute); ok {
+				updateCfg, err := accountstd.UnpackAny[v1.MsgUpdateConfig](execmsg.GetMessage())
+				if err != nil {
+					return nil, err
+				}
+
+				return acc.UpdateConfig(accountstd.SetSender(ctx, []byte(accAddr)), updateCfg)
+			}
+			return nil, nil
+		}, func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+			return nil, nil
+		},
+	)
+
+	currentTime := time.Now()
+	acc = setup(t, ctx, ss, func() time.Time {
+		return currentTime
+	})
+	_, err := acc.Init(ctx, &v1.MsgInit{Config: newConfig()})
+	require.NoError(t, err)
+
+	newCfg := newConfig()
+	newCfg.Executors = nil
+	newCfg.MinDelay = 2 * time.Hour
+
+	// the config cannot be updated directly
+	_, err = acc.UpdateConfig(ctx, &v1.MsgUpdateConfig{Config: newCfg})
+	require.ErrorContains(t, err, "only the account itself can update the config")
+
+	update, err := accountstd.PackAny(&v1.MsgUpdateConfig{Config: newCfg})
+	require.NoError(t, err)
+	execMsg, err := accountstd.PackAny(&accountsv1.MsgExecute{Sender: accAddr, Target: accAddr, Message: update})
+	require.NoError(t, err)
+
+	res, err := acc.QueueMessages(ctx, &v1.MsgQueueMessages{Messages: []*codectypes.Any{execMsg}})
+	require.NoError(t, err)
+
+	currentTime = currentTime.Add(time.Hour)
+	_, err = acc.ExecuteMessages(accountstd.SetSender(ctx, []byte("executor")), &v1.MsgExecuteMessages{OperationId: res.OperationId})
+	require.NoError(t, err)
+
+	cfg, err := acc.QueryConfig(ctx, &v1.QueryConfig{})
+	require.NoError(t, err)
+	require.Equal(t, newCfg, cfg.Config)
+
+	// anyone can execute ready operations once the executors are removed
+	res, err = acc.QueueMessages(ctx, &v1.MsgQueueMessages{Messages: []*codectypes.Any{execMsg}})
+	require.NoError(t, err)
+
+	currentTime = currentTime.Add(2 * time.Hour)
+	_, err = acc.ExecuteMessages(accountstd.SetSender(ctx, []byte("anyone")), &v1.MsgExecuteMessages{OperationId: res.OperationId})
+	require.NoError(t, err)
+}
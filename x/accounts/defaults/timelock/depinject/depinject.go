@@ -0,0 +1,10 @@
+package timelockdepinject
+
+import (
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/timelock"
+)
+
+func ProvideAccount() accountstd.DepinjectAccount {
+	return accountstd.DIAccount("timelock", timelock.NewAccount)
+}
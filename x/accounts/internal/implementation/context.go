@@ -29,6 +29,7 @@ type contextValue struct {
 	parentContext context.Context // parentContext that was used to build the account context.
 	moduleExec    ModuleExecFunc  // moduleExec is a function that executes a module message, when the resp type is unknown.
 	moduleQuery   ModuleQueryFunc // moduleQuery is a function that queries a module.
+	migration     bool            // migration reports whether the account is initialized by the migration of a legacy account.
 }
 
 func addCtx(ctx context.Context, value contextValue) context.Context {
@@ -73,6 +74,13 @@ func SetSender(ctx context.Context, sender []byte) context.Context {
 	return addCtx(v.parentContext, v)
 }
 
+// MarkMigration flags the account context as the initialization of an account migrated from a legacy account.
+func MarkMigration(ctx context.Context) context.Context {
+	v := getCtx(ctx)
+	v.migration = true
+	return addCtx(v.parentContext, v)
+}
+
 // makeAccountStore creates the prefixed store for the account.
 // It uses the number of the account, this gives constant size
 // bytes prefixes for the account state.
@@ -122,3 +130,6 @@ func Whoami(ctx context.Context) []byte {
 
 // Funds returns the funds associated with the execution context.
 func Funds(ctx context.Context) sdk.Coins { return getCtx(ctx).funds }
+
+// IsMigration reports whether the account is initialized by the migration of a legacy account.
+func IsMigration(ctx context.Context) bool { return getCtx(ctx).migration }
@@ -165,7 +165,7 @@ func (k Keeper) Init(
 	if err != nil {
 		return nil, nil, err
 	}
-	initResp, err := k.init(ctx, accountType, creator, num, accountAddr, initRequest, funds)
+	initResp, err := k.init(ctx, accountType, creator, num, accountAddr, initRequest, funds, false)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -190,7 +190,9 @@ func (k Keeper) initFromMsg(ctx context.Context, initMsg *v1.MsgInit) (transacti
 }
 
 // init initializes the account, given the type, the creator the newly created account number, its address and the
-// initialization message.
+// initialization message. If migration is true, the account is migrated from a legacy account: the funds are
+// exposed to the account initialization but not transferred, as they are expected to be owned by the account
+// already, and the initialization is flagged as a migration, see accountstd.IsMigration.
 func (k Keeper) init(
 	ctx context.Context,
 	accountType string,
@@ -199,6 +201,7 @@ func (k Keeper) init(
 	accountAddr []byte,
 	initRequest transaction.Msg,
 	funds sdk.Coins,
+	migration bool,
 ) (transaction.Msg, error) {
 	impl, ok := k.accounts[accountType]
 	if !ok {
@@ -215,12 +218,17 @@ func (k Keeper) init(
 	}
 
 	// send funds, if provided
-	err = k.maybeSendFunds(ctx, creator, accountAddr, funds)
-	if err != nil {
-		return nil, fmt.Errorf("unable to transfer funds: %w", err)
+	if !migration {
+		err = k.maybeSendFunds(ctx, creator, accountAddr, funds)
+		if err != nil {
+			return nil, fmt.Errorf("unable to transfer funds: %w", err)
+		}
 	}
 	// make the context and init the account
 	ctx = k.makeAccountContext(ctx, accountNum, accountAddr, creator, funds, false)
+	if migration {
+		ctx = implementation.MarkMigration(ctx)
+	}
 	resp, err := impl.Init(ctx, initRequest)
 	if err != nil {
 		return nil, err
@@ -241,6 +249,9 @@ func (k Keeper) init(
 // Concretely speaking this works like Init, but with a custom account number provided,
 // Where the creator is the account itself. This can be used by the x/auth module to
 // gradually migrate base accounts to x/accounts.
+// The provided funds are not transferred, as they are already owned by the account, but they
+// are exposed to the account initialization, which lets vesting accounts be migrated to lockup
+// accounts locking their original vesting coins.
 // NOTE: this assumes the calling module checks for account overrides.
 func (k Keeper) MigrateLegacyAccount(
 	ctx context.Context,
@@ -248,8 +259,9 @@ func (k Keeper) MigrateLegacyAccount(
 	accNum uint64, // The current account number
 	accType string, // The account type to migrate to
 	msg transaction.Msg, // The init msg of the account type we're migrating to
+	funds sdk.Coins, // The coins of the account exposed to the account initialization
 ) (transaction.Msg, error) {
-	return k.init(ctx, accType, addr, accNum, addr, msg, nil)
+	return k.init(ctx, accType, addr, accNum, addr, msg, funds, true)
 }
 
 // Execute executes a state transition on the given account.
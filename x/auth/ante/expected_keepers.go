@@ -20,6 +20,8 @@ type AccountKeeper interface {
 	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	AddressCodec() address.Codec
 	GetEnvironment() appmodule.Environment
+	GetBaseFee(ctx context.Context) (sdk.DecCoin, error)
+	AddBlockGasWanted(ctx context.Context, gas uint64) error
 }
 
 // FeegrantKeeper defines the expected feegrant keeper.
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/transaction"
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -20,6 +21,11 @@ type TxFeeChecker func(ctx context.Context, tx transaction.Tx) (sdk.Coins, int64
 
 // DeductFeeDecorator deducts fees from the fee payer. The fee payer is the fee granter (if specified) or first signer of the tx.
 // If the fee payer does not have the funds to pay for the fees, return an InsufficientFunds error.
+// If the consensus base fee is enabled, the fee must cover the base fee for the gas limit of the tx. The base fee part
+// of the fee is burned or sent to the base fee recipient module, and the rest goes to the fee collector.
+// The base fee can also be paid in the fee denoms allowed by the params, at the price given by the FeeDenomPricer.
+// The base fee paid in allowed fee denoms is forwarded to the fee denom recipient module if any, or swapped into the
+// base fee denom if the FeeDenomPricer is a FeeDenomSwapper.
 // Call next AnteHandler if fees are successfully deducted.
 // CONTRACT: The Tx must implement the FeeTx interface to use DeductFeeDecorator.
 type DeductFeeDecorator struct {
@@ -27,6 +33,7 @@ type DeductFeeDecorator struct {
 	bankKeeper     types.BankKeeper
 	feegrantKeeper FeegrantKeeper
 	txFeeChecker   TxFeeChecker
+	feeDenomPricer FeeDenomPricer
 	minGasPrices   sdk.DecCoins
 }
 
@@ -36,6 +43,7 @@ func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKee
 		bankKeeper:     bk,
 		feegrantKeeper: fk,
 		txFeeChecker:   tfc,
+		feeDenomPricer: NewStaticFeeDenomPricer(ak),
 		minGasPrices:   sdk.NewDecCoins(),
 	}
 
@@ -51,6 +59,12 @@ func (dfd *DeductFeeDecorator) SetMinGasPrices(minGasPrices sdk.DecCoins) {
 	dfd.minGasPrices = minGasPrices
 }
 
+// SetFeeDenomPricer sets the FeeDenomPricer pricing the allowed fee denoms, in place of
+// the default StaticFeeDenomPricer.
+func (dfd *DeductFeeDecorator) SetFeeDenomPricer(pricer FeeDenomPricer) {
+	dfd.feeDenomPricer = pricer
+}
+
 // AnteHandle implements an AnteHandler decorator for the DeductFeeDecorator
 func (dfd *DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, next sdk.AnteHandler) (sdk.Context, error) {
 	dfd.minGasPrices = ctx.MinGasPrices()
@@ -84,13 +98,128 @@ func (dfd *DeductFeeDecorator) innerValidateTx(ctx context.Context, tx transacti
 		}
 	}
 
-	if err := dfd.checkDeductFee(ctx, feeTx, fee); err != nil {
+	// the base fee is not enforced in simulation, which is used to estimate the fees,
+	// and the gas prices of the base fee in the allowed fee denoms are reported instead
+	baseFee := sdk.NewCoins()
+	if execMode != transaction.ExecModeSimulate {
+		baseFee, err = dfd.checkBaseFee(ctx, feeTx, fee)
+		if err != nil {
+			return 0, err
+		}
+	} else if err := dfd.emitGasPrices(ctx); err != nil {
+		return 0, err
+	}
+
+	if err := dfd.checkDeductFee(ctx, feeTx, fee, baseFee); err != nil {
 		return 0, err
 	}
 
+	if execMode == transaction.ExecModeFinalize && !baseFee.IsZero() {
+		if err := dfd.accountKeeper.AddBlockGasWanted(ctx, feeTx.GetGas()); err != nil {
+			return 0, err
+		}
+	}
+
 	return priority, nil
 }
 
+// checkBaseFee returns the base fee of the tx, the base fee per unit of gas times its gas limit,
+// or an InsufficientFee error if the fee does not cover it. It is empty if the base fee is disabled.
+// The base fee is paid in the base fee denom first, and then in the allowed fee denoms of the fee,
+// in their order, so the returned base fee may contain several denoms.
+func (dfd *DeductFeeDecorator) checkBaseFee(ctx context.Context, feeTx sdk.FeeTx, fee sdk.Coins) (sdk.Coins, error) {
+	gasPrice, err := dfd.accountKeeper.GetBaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if gasPrice.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	// base fee = ceil(baseFeePerGas * gasLimit)
+	required := gasPrice.Amount.MulInt(sdkmath.NewIntFromUint64(feeTx.GetGas())).Ceil().TruncateInt()
+
+	paid := sdkmath.MinInt(fee.AmountOf(gasPrice.Denom), required)
+	baseFee := sdk.NewCoins(sdk.NewCoin(gasPrice.Denom, paid))
+	remaining := required.Sub(paid)
+
+	params := dfd.accountKeeper.GetParams(ctx)
+	for _, coin := range fee {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		if !params.IsFeeDenom(coin.Denom) {
+			continue
+		}
+
+		price, err := dfd.feeDenomPrice(ctx, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		// the amount of fee denom worth the remaining base fee, rounded up
+		needed := sdkmath.LegacyNewDecFromInt(remaining).Quo(price).Ceil().TruncateInt()
+		if coin.Amount.GTE(needed) {
+			baseFee = baseFee.Add(sdk.NewCoin(coin.Denom, needed))
+			remaining = sdkmath.ZeroInt()
+			break
+		}
+
+		baseFee = baseFee.Add(coin)
+		remaining = remaining.Sub(sdkmath.MinInt(sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(price).TruncateInt(), remaining))
+	}
+
+	if remaining.IsPositive() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required base fee: %s", fee, sdk.NewCoin(gasPrice.Denom, required))
+	}
+
+	return baseFee, nil
+}
+
+// feeDenomPrice returns the price of an allowed fee denom in the base fee denom.
+func (dfd *DeductFeeDecorator) feeDenomPrice(ctx context.Context, denom string) (sdkmath.LegacyDec, error) {
+	price, err := dfd.feeDenomPricer.FeeDenomPrice(ctx, denom)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	if price.IsNil() || !price.IsPositive() {
+		return sdkmath.LegacyDec{}, fmt.Errorf("invalid price of fee denom %s: %s", denom, price)
+	}
+
+	return price, nil
+}
+
+// emitGasPrices emits the base fee per unit of gas in the base fee denom and in the allowed
+// fee denoms, from which the fees of a simulated tx can be estimated.
+func (dfd *DeductFeeDecorator) emitGasPrices(ctx context.Context) error {
+	gasPrice, err := dfd.accountKeeper.GetBaseFee(ctx)
+	if err != nil {
+		return err
+	}
+
+	if gasPrice.IsZero() {
+		return nil
+	}
+
+	gasPrices := sdk.NewDecCoins(gasPrice)
+	for _, feeDenom := range dfd.accountKeeper.GetParams(ctx).FeeDenoms {
+		price, err := dfd.feeDenomPrice(ctx, feeDenom.Denom)
+		if err != nil {
+			return err
+		}
+
+		gasPrices = gasPrices.Add(sdk.NewDecCoinFromDec(feeDenom.Denom, gasPrice.Amount.Quo(price)))
+	}
+
+	return dfd.accountKeeper.GetEnvironment().EventService.EventManager(ctx).EmitKV(
+		sdk.EventTypeTx,
+		event.NewAttribute(types.AttributeKeyGasPrices, gasPrices.String()),
+	)
+}
+
 // ValidateTx implements an TxValidator for DeductFeeDecorator
 // Note: This method is applicable only for transactions that implement the sdk.FeeTx interface.
 func (dfd *DeductFeeDecorator) ValidateTx(ctx context.Context, tx transaction.Tx) error {
@@ -98,7 +227,7 @@ func (dfd *DeductFeeDecorator) ValidateTx(ctx context.Context, tx transaction.Tx
 	return err
 }
 
-func (dfd *DeductFeeDecorator) checkDeductFee(ctx context.Context, feeTx sdk.FeeTx, fee sdk.Coins) error {
+func (dfd *DeductFeeDecorator) checkDeductFee(ctx context.Context, feeTx sdk.FeeTx, fee, baseFee sdk.Coins) error {
 	addr := dfd.accountKeeper.GetModuleAddress(types.FeeCollectorName)
 	if len(addr) == 0 {
 		return fmt.Errorf("fee collector module account (%s) has not been set", types.FeeCollectorName)
@@ -132,22 +261,91 @@ func (dfd *DeductFeeDecorator) checkDeductFee(ctx context.Context, feeTx sdk.Fee
 
 	// deduct the fees
 	if !fee.IsZero() {
-		if err := DeductFees(dfd.bankKeeper, ctx, deductFeesFrom, fee); err != nil {
+		if err := dfd.deductFees(ctx, deductFeesFrom, fee, baseFee); err != nil {
 			return err
 		}
 	}
 
-	if err := dfd.accountKeeper.GetEnvironment().EventService.EventManager(ctx).EmitKV(
-		sdk.EventTypeTx,
+	attrs := []event.Attribute{
 		event.NewAttribute(sdk.AttributeKeyFee, fee.String()),
 		event.NewAttribute(sdk.AttributeKeyFeePayer, sdk.AccAddress(deductFeesFrom).String()),
-	); err != nil {
+	}
+	if !baseFee.IsZero() {
+		attrs = append(attrs, event.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()))
+	}
+
+	if err := dfd.accountKeeper.GetEnvironment().EventService.EventManager(ctx).EmitKV(sdk.EventTypeTx, attrs...); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// deductFees deducts the fees of a tx. The base fee part of the fees is sent to the base fee
+// recipient module, or burned from the fee collector if there is none, and the rest is sent
+// to the fee collector. The base fee paid in allowed fee denoms is first forwarded to the fee
+// denom recipient module, or swapped into the base fee denom.
+func (dfd *DeductFeeDecorator) deductFees(ctx context.Context, acc []byte, fee, baseFee sdk.Coins) error {
+	if baseFee.IsZero() {
+		return DeductFees(dfd.bankKeeper, ctx, acc, fee)
+	}
+
+	params := dfd.accountKeeper.GetParams(ctx)
+	nativeBaseFee := sdk.NewCoins(sdk.NewCoin(params.BaseFeeDenom, baseFee.AmountOf(params.BaseFeeDenom)))
+	if feeDenomBaseFee := baseFee.Sub(nativeBaseFee...); !feeDenomBaseFee.IsZero() {
+		swapper, canSwap := dfd.feeDenomPricer.(FeeDenomSwapper)
+		switch {
+		case params.FeeDenomRecipient != "":
+			if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc, params.FeeDenomRecipient, feeDenomBaseFee); err != nil {
+				return fmt.Errorf("failed to forward base fee: %w", err)
+			}
+
+			fee = fee.Sub(feeDenomBaseFee...)
+			baseFee = nativeBaseFee
+		case canSwap:
+			swapped, err := swapper.SwapFees(ctx, acc, feeDenomBaseFee, params.BaseFeeDenom)
+			if err != nil {
+				return fmt.Errorf("failed to swap base fee: %w", err)
+			}
+
+			fee = fee.Sub(feeDenomBaseFee...).Add(swapped)
+			baseFee = nativeBaseFee.Add(swapped)
+		}
+
+		if baseFee.IsZero() {
+			if fee.IsZero() {
+				return nil
+			}
+			return DeductFees(dfd.bankKeeper, ctx, acc, fee)
+		}
+	}
+
+	recipient := params.BaseFeeRecipient
+	if recipient == "" {
+		if err := DeductFees(dfd.bankKeeper, ctx, acc, fee); err != nil {
+			return err
+		}
+
+		if err := dfd.bankKeeper.BurnCoins(ctx, types.NewModuleAddress(types.FeeCollectorName), baseFee); err != nil {
+			return fmt.Errorf("failed to burn base fee: %w", err)
+		}
+
+		return nil
+	}
+
+	if tip := fee.Sub(baseFee...); !tip.IsZero() {
+		if err := DeductFees(dfd.bankKeeper, ctx, acc, tip); err != nil {
+			return err
+		}
+	}
+
+	if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc, recipient, baseFee); err != nil {
+		return fmt.Errorf("failed to deduct base fee: %w", err)
+	}
+
+	return nil
+}
+
 // DeductFees deducts fees from the given account.
 func DeductFees(bankKeeper types.BankKeeper, ctx context.Context, acc []byte, fees sdk.Coins) error {
 	if !fees.IsValid() {
@@ -0,0 +1,52 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeDenomPricer prices the fee denoms allowed by the auth params in the base fee denom,
+// for instance from an on-chain price oracle.
+type FeeDenomPricer interface {
+	// FeeDenomPrice returns the amount of base fee denom that one unit of the given allowed
+	// fee denom is worth.
+	FeeDenomPrice(ctx context.Context, denom string) (sdkmath.LegacyDec, error)
+}
+
+// FeeDenomSwapper can be implemented by a FeeDenomPricer to swap the base fees paid in
+// allowed fee denoms into the base fee denom before they are deducted, when the auth
+// params have no fee denom recipient.
+type FeeDenomSwapper interface {
+	// SwapFees swaps the given fees, held by the fee payer, into the base fee denom and
+	// returns the swapped coin, which is then deducted from the fee payer.
+	SwapFees(ctx context.Context, feePayer sdk.AccAddress, fees sdk.Coins, baseFeeDenom string) (sdk.Coin, error)
+}
+
+// StaticFeeDenomPricer is the default FeeDenomPricer, which prices the allowed fee denoms
+// at the static prices set in the auth params.
+type StaticFeeDenomPricer struct {
+	accountKeeper AccountKeeper
+}
+
+var _ FeeDenomPricer = StaticFeeDenomPricer{}
+
+// NewStaticFeeDenomPricer returns a FeeDenomPricer reading the prices of the fee denoms
+// from the auth params.
+func NewStaticFeeDenomPricer(ak AccountKeeper) StaticFeeDenomPricer {
+	return StaticFeeDenomPricer{accountKeeper: ak}
+}
+
+// FeeDenomPrice implements FeeDenomPricer.
+func (p StaticFeeDenomPricer) FeeDenomPrice(ctx context.Context, denom string) (sdkmath.LegacyDec, error) {
+	feeDenom, found := p.accountKeeper.GetParams(ctx).GetFeeDenom(denom)
+	if !found {
+		return sdkmath.LegacyDec{}, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "%s is not an allowed fee denom", denom)
+	}
+
+	return feeDenom.Price, nil
+}
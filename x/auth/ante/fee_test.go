@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -142,3 +143,153 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+func TestDeductFeeDecorator_BaseFee(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+	s.ctx = s.ctx.WithExecMode(sdk.ExecModeFinalize)
+
+	dfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, nil, nil)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	accs := s.CreateTestAccounts(1)
+	feeAmount := testdata.NewTestFeeAmount()
+	require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(200)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	params := authtypes.DefaultParams()
+	params.BaseFeeDenom = "atom"
+	params.MinBaseFee = math.LegacyOneDec()
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	// the fee of 150atom does not cover the base fee of 200 gas at 1atom
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// the base fee is not enforced in simulation
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil)
+	_, err = antehandler(s.ctx.WithExecMode(sdk.ExecModeSimulate), tx, true)
+	require.NoError(t, err)
+
+	// without recipient, the base fee of 100atom is burned from the fee collector
+	params.MinBaseFee = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+	baseFee := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), authtypes.NewModuleAddress(authtypes.FeeCollectorName).Bytes(), baseFee).Return(nil)
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+
+	// with a recipient, the base fee is sent to it and the rest to the fee collector
+	params.BaseFeeRecipient = "mint"
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount.Sub(baseFee...)).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), "mint", baseFee).Return(nil)
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+
+	// the gas wanted by the delivered txs is recorded for the base fee update
+	gasWanted, err := s.accountKeeper.BlockGasWanted.Get(s.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(400), gasWanted)
+}
+
+// swappingFeeDenomPricer is a FeeDenomPricer with a fixed price, swapping fee denoms into a fixed coin.
+type swappingFeeDenomPricer struct {
+	price   math.LegacyDec
+	swapped sdk.Coin
+}
+
+func (p swappingFeeDenomPricer) FeeDenomPrice(context.Context, string) (math.LegacyDec, error) {
+	return p.price, nil
+}
+
+func (p swappingFeeDenomPricer) SwapFees(context.Context, sdk.AccAddress, sdk.Coins, string) (sdk.Coin, error) {
+	return p.swapped, nil
+}
+
+func TestDeductFeeDecorator_FeeDenoms(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+	s.ctx = s.ctx.WithExecMode(sdk.ExecModeFinalize)
+
+	dfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, nil, nil)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	accs := s.CreateTestAccounts(1)
+	feeAmount := testdata.NewTestFeeAmount()
+	require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(200)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	params := authtypes.DefaultParams()
+	params.BaseFeeDenom = "stake"
+	params.MinBaseFee = math.LegacyOneDec()
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	// the fee of 150atom cannot pay the base fee of 200stake while atom is not allowed
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// at the static price of 2stake, the base fee is paid with 100atom, which are burned
+	params.FeeDenoms = []authtypes.FeeDenom{{Denom: "atom", Price: math.LegacyNewDec(2)}}
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+	baseFee := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), authtypes.NewModuleAddress(authtypes.FeeCollectorName).Bytes(), baseFee).Return(nil)
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+
+	// with a fee denom recipient, the base fee paid in atom is forwarded to it
+	params.FeeDenomRecipient = "mint"
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), "mint", baseFee).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount.Sub(baseFee...)).Return(nil)
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+
+	// a swapping pricer at 4stake swaps the 50atom of base fee, and the swapped coins are burned
+	params.FeeDenomRecipient = ""
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+	swapped := sdk.NewInt64Coin("stake", 210)
+	dfd.SetFeeDenomPricer(swappingFeeDenomPricer{price: math.LegacyNewDec(4), swapped: swapped})
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("atom", 100), swapped)).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), authtypes.NewModuleAddress(authtypes.FeeCollectorName).Bytes(), sdk.NewCoins(swapped)).Return(nil)
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+
+	// simulation reports the base fee per unit of gas in every allowed denom
+	ctx := s.ctx.WithExecMode(sdk.ExecModeSimulate).WithEventManager(sdk.NewEventManager())
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil)
+	_, err = antehandler(ctx, tx, true)
+	require.NoError(t, err)
+
+	var gasPrices string
+	for _, e := range ctx.EventManager().Events() {
+		if attr, ok := e.GetAttribute(authtypes.AttributeKeyGasPrices); ok {
+			gasPrices = attr.Value
+		}
+	}
+	require.Equal(t, "0.250000000000000000atom,1.000000000000000000stake", gasPrices)
+
+	// the local min gas prices in the base fee denom are met by the value of the allowed fee denoms
+	dfd.SetFeeDenomPricer(ante.NewStaticFeeDenomPricer(s.accountKeeper))
+	ctx = s.ctx.WithExecMode(sdk.ExecModeCheck).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyNewDec(2))))
+	_, err = antehandler(ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	ctx = ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(15, 1))))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), authtypes.NewModuleAddress(authtypes.FeeCollectorName).Bytes(), baseFee).Return(nil)
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+}
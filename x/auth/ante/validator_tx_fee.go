@@ -39,7 +39,13 @@ func (dfd *DeductFeeDecorator) checkTxFeeWithValidatorMinGasPrices(ctx context.C
 				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
 			}
 
-			if !feeCoins.IsAnyGTE(requiredFees) {
+			// the allowed fee denoms also count at their value in the base fee denom
+			feeValue, err := dfd.feeValue(ctx, feeCoins)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			if !feeValue.IsAnyGTE(requiredFees) {
 				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
 			}
 		}
@@ -49,6 +55,30 @@ func (dfd *DeductFeeDecorator) checkTxFeeWithValidatorMinGasPrices(ctx context.C
 	return feeCoins, priority, nil
 }
 
+// feeValue returns the given fees, plus the value of their allowed fee denoms in the base fee denom.
+func (dfd *DeductFeeDecorator) feeValue(ctx context.Context, fee sdk.Coins) (sdk.Coins, error) {
+	params := dfd.accountKeeper.GetParams(ctx)
+	if len(params.FeeDenoms) == 0 {
+		return fee, nil
+	}
+
+	value := fee
+	for _, coin := range fee {
+		if !params.IsFeeDenom(coin.Denom) {
+			continue
+		}
+
+		price, err := dfd.feeDenomPrice(ctx, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		value = value.Add(sdk.NewCoin(params.BaseFeeDenom, sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(price).TruncateInt()))
+	}
+
+	return value, nil
+}
+
 // getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
 // provided in a transaction.
 // NOTE: This implementation should be used with a great consideration as it opens potential attack vectors
@@ -74,6 +74,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current auth parameters",
 				},
+				{
+					RpcMethod: "BaseFee",
+					Use:       "base-fee",
+					Short:     "Query the base fee per unit of gas of the transactions of the next block",
+					Long:      "Query the consensus base fee per unit of gas. The fee of a transaction must cover the base fee times its gas limit.",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetBaseFee returns the base fee per unit of gas that the transactions of the current
+// block must pay. It is zero, without denom, if the base fee is disabled.
+func (ak AccountKeeper) GetBaseFee(ctx context.Context) (sdk.DecCoin, error) {
+	params := ak.GetParams(ctx)
+	if !params.BaseFeeEnabled() {
+		return sdk.DecCoin{Amount: math.LegacyZeroDec()}, nil
+	}
+
+	baseFee, err := ak.BaseFee.Get(ctx)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return sdk.DecCoin{}, err
+		}
+		baseFee = params.MinBaseFee
+	}
+
+	// the min base fee may have been raised since the base fee was last updated
+	return sdk.NewDecCoinFromDec(params.BaseFeeDenom, math.LegacyMaxDec(baseFee, params.MinBaseFee)), nil
+}
+
+// AddBlockGasWanted adds the gas wanted by a transaction to the gas wanted by the
+// current block, from which the base fee of the next block is computed.
+func (ak AccountKeeper) AddBlockGasWanted(ctx context.Context, gas uint64) error {
+	gasWanted, err := ak.BlockGasWanted.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	// saturate instead of overflowing, the base fee change is bounded anyway
+	if gasWanted > ^uint64(0)-gas {
+		gasWanted = ^uint64(0)
+	} else {
+		gasWanted += gas
+	}
+
+	return ak.BlockGasWanted.Set(ctx, gasWanted)
+}
+
+// UpdateBaseFee adjusts the base fee from the gas wanted by the transactions of the
+// previous block, in the manner of EIP-1559. The base fee increases when the gas wanted
+// is above the target block gas and decreases when it is below, proportionally to the
+// distance to the target. The change is at most 1/BaseFeeChangeDenominator of the base
+// fee, and the base fee never goes below the min base fee.
+func (ak AccountKeeper) UpdateBaseFee(ctx context.Context) error {
+	params := ak.GetParams(ctx)
+	if !params.BaseFeeEnabled() {
+		if err := ak.BlockGasWanted.Remove(ctx); err != nil {
+			return err
+		}
+		return ak.BaseFee.Remove(ctx)
+	}
+
+	baseFee, err := ak.GetBaseFee(ctx)
+	if err != nil {
+		return err
+	}
+
+	gasWanted, err := ak.BlockGasWanted.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	target := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.TargetBlockGas))
+	gasDelta := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasWanted)).Sub(target)
+	if gasDelta.GT(target) {
+		gasDelta = target
+	}
+
+	change := baseFee.Amount.Mul(gasDelta).Quo(target).QuoInt(math.NewIntFromUint64(params.BaseFeeChangeDenominator))
+	newBaseFee := math.LegacyMaxDec(baseFee.Amount.Add(change), params.MinBaseFee)
+
+	if err := ak.BaseFee.Set(ctx, newBaseFee); err != nil {
+		return err
+	}
+
+	if err := ak.BlockGasWanted.Remove(ctx); err != nil {
+		return err
+	}
+
+	return ak.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeBaseFee,
+		event.NewAttribute(types.AttributeKeyBaseFee, sdk.NewDecCoinFromDec(params.BaseFeeDenom, newBaseFee).String()),
+		event.NewAttribute(types.AttributeKeyBlockGasWanted, strconv.FormatUint(gasWanted, 10)),
+	)
+}
+
+// validateFeeRecipients checks that the base fee can be deducted with the given params:
+// the base fee and fee denom recipients must be module accounts, and the fee collector
+// must be able to burn the base fee when there is no recipient. Otherwise every
+// transaction would fail.
+func (ak AccountKeeper) validateFeeRecipients(params types.Params) error {
+	if !params.BaseFeeEnabled() {
+		return nil
+	}
+
+	if len(params.FeeDenoms) > 0 && params.FeeDenomRecipient != "" {
+		if _, ok := ak.permAddrs[params.FeeDenomRecipient]; !ok {
+			return fmt.Errorf("fee denom recipient %s is not a module account", params.FeeDenomRecipient)
+		}
+	}
+
+	if params.BaseFeeRecipient != "" {
+		if _, ok := ak.permAddrs[params.BaseFeeRecipient]; !ok {
+			return fmt.Errorf("base fee recipient %s is not a module account", params.BaseFeeRecipient)
+		}
+		return nil
+	}
+
+	if feeCollector, ok := ak.permAddrs[types.FeeCollectorName]; !ok || !feeCollector.HasPermission(types.Burner) {
+		return fmt.Errorf("the %s module account must have the %s permission to burn the base fee", types.FeeCollectorName, types.Burner)
+	}
+
+	return nil
+}
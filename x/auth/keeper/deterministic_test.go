@@ -237,7 +237,7 @@ func (suite *DeterministicTestSuite) TestGRPCQueryParameters() {
 	suite.Require().NoError(err)
 
 	req := &types.QueryParamsRequest{}
-	testdata.DeterministicIterations(suite.T(), suite.ctx, req, suite.queryClient.Params, 1042, false)
+	testdata.DeterministicIterations(suite.T(), suite.ctx, req, suite.queryClient.Params, 1072, false)
 }
 
 func (suite *DeterministicTestSuite) TestGRPCQueryAccountInfo() {
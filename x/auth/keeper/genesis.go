@@ -13,6 +13,10 @@ import (
 // CONTRACT: old coins from the FeeCollectionKeeper need to be transferred through
 // a genesis port script to the new fee collector account
 func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState) error {
+	if err := ak.validateFeeRecipients(data.Params); err != nil {
+		return err
+	}
+
 	if err := ak.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
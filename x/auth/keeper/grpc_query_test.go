@@ -239,6 +239,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			"success",
 			func() {
 				req = &types.QueryParamsRequest{}
+				suite.Require().NoError(suite.accountKeeper.Params.Set(suite.ctx, types.DefaultParams()))
 				expParams = suite.accountKeeper.GetParams(suite.ctx)
 			},
 			true,
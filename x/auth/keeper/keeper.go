@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -108,6 +109,10 @@ type AccountKeeper struct {
 	accountNumber collections.Sequence
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// BaseFee is the base fee per unit of gas of the next block
+	BaseFee collections.Item[math.LegacyDec]
+	// BlockGasWanted is the gas wanted by the transactions of the current block
+	BlockGasWanted collections.Item[uint64]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -141,6 +146,8 @@ func NewAccountKeeper(
 		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		accountNumber:     collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
 		Accounts:          collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		BaseFee:           collections.NewItem(sb, types.BaseFeeKey, "base_fee", sdk.LegacyDecValue),
+		BlockGasWanted:    collections.NewItem(sb, types.BlockGasWantedKey, "block_gas_wanted", collections.Uint64Value),
 	}
 	schema, err := sb.Build()
 	if err != nil {
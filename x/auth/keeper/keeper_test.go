@@ -11,6 +11,7 @@ import (
 
 	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -138,6 +139,31 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(genState.Params.SigVerifyCostED25519, params.SigVerifyCostED25519, "SigVerifyCostED25519")
 	suite.Require().Equal(genState.Params.SigVerifyCostSecp256k1, params.SigVerifyCostSecp256k1, "SigVerifyCostSecp256k1")
 
+	// the base fee cannot be sent to an unknown module account, nor burned by the fee collector
+	// without the burner permission, and the fee denoms cannot be forwarded to an unknown module
+	// account
+	suite.SetupTest() // reset
+	genState = types.GenesisState{Params: types.DefaultParams()}
+	genState.Params.BaseFeeDenom = "stake"
+	genState.Params.MinBaseFee = math.LegacyNewDecWithPrec(1, 3)
+	genState.Params.BaseFeeRecipient = "unknown"
+	err = suite.accountKeeper.InitGenesis(suite.ctx, genState)
+	suite.Require().ErrorContains(err, "base fee recipient unknown is not a module account")
+
+	genState.Params.BaseFeeRecipient = ""
+	err = suite.accountKeeper.InitGenesis(suite.ctx, genState)
+	suite.Require().ErrorContains(err, "the fee_collector module account must have the burner permission")
+
+	genState.Params.BaseFeeRecipient = "mint"
+	genState.Params.FeeDenoms = []types.FeeDenom{{Denom: "atom", Price: math.LegacyNewDecWithPrec(25, 1)}}
+	genState.Params.FeeDenomRecipient = "unknown"
+	err = suite.accountKeeper.InitGenesis(suite.ctx, genState)
+	suite.Require().ErrorContains(err, "fee denom recipient unknown is not a module account")
+
+	genState.Params.FeeDenomRecipient = "bonded_tokens_pool"
+	suite.Require().NoError(suite.accountKeeper.InitGenesis(suite.ctx, genState))
+	suite.Require().Equal("mint", suite.accountKeeper.GetParams(suite.ctx).BaseFeeRecipient)
+
 	suite.SetupTest() // reset
 	ctx = suite.ctx
 	// Fix duplicate account numbers
@@ -252,6 +278,60 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(2, int(nextNum))
 }
 
+func (suite *KeeperTestSuite) TestUpdateBaseFee() {
+	suite.SetupTest() // reset
+
+	baseFee := func() math.LegacyDec {
+		res, err := suite.queryClient.BaseFee(suite.ctx, &types.QueryBaseFeeRequest{})
+		suite.Require().NoError(err)
+		return res.BaseFee.Amount
+	}
+
+	// the base fee is disabled by default
+	suite.Require().NoError(suite.accountKeeper.Params.Set(suite.ctx, types.DefaultParams()))
+	suite.Require().True(baseFee().IsZero())
+
+	params := types.DefaultParams()
+	params.BaseFeeDenom = "atom"
+	params.MinBaseFee = math.LegacyOneDec()
+	params.TargetBlockGas = 100
+	suite.Require().NoError(suite.accountKeeper.Params.Set(suite.ctx, params))
+	suite.Require().Equal(math.LegacyOneDec(), baseFee())
+
+	// a block wanting twice the target gas increases the base fee by 1/8
+	suite.Require().NoError(suite.accountKeeper.AddBlockGasWanted(suite.ctx, 120))
+	suite.Require().NoError(suite.accountKeeper.AddBlockGasWanted(suite.ctx, 80))
+	suite.Require().NoError(suite.accountKeeper.UpdateBaseFee(suite.ctx))
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("1.125"), baseFee())
+
+	// the gas wanted is reset and the increase is bounded
+	has, err := suite.accountKeeper.BlockGasWanted.Has(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	suite.Require().NoError(suite.accountKeeper.AddBlockGasWanted(suite.ctx, 1000))
+	suite.Require().NoError(suite.accountKeeper.UpdateBaseFee(suite.ctx))
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("1.265625"), baseFee())
+
+	// a block at the target keeps the base fee
+	suite.Require().NoError(suite.accountKeeper.AddBlockGasWanted(suite.ctx, 100))
+	suite.Require().NoError(suite.accountKeeper.UpdateBaseFee(suite.ctx))
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("1.265625"), baseFee())
+
+	// empty blocks decrease the base fee by 1/8, down to the min base fee
+	suite.Require().NoError(suite.accountKeeper.UpdateBaseFee(suite.ctx))
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("1.107421875"), baseFee())
+	suite.Require().NoError(suite.accountKeeper.UpdateBaseFee(suite.ctx))
+	suite.Require().Equal(math.LegacyOneDec(), baseFee())
+
+	// disabling the base fee clears it
+	suite.Require().NoError(suite.accountKeeper.Params.Set(suite.ctx, types.DefaultParams()))
+	suite.Require().NoError(suite.accountKeeper.UpdateBaseFee(suite.ctx))
+	suite.Require().True(baseFee().IsZero())
+	has, err = suite.accountKeeper.BaseFee.Has(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
 func (suite *KeeperTestSuite) TestMigrateAccountNumberUnsafe() {
 	suite.SetupTest() // reset
 
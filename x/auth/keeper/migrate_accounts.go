@@ -0,0 +1,308 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	basev1 "cosmossdk.io/api/cosmos/accounts/defaults/base/v1"
+	lockupv1 "cosmossdk.io/api/cosmos/accounts/defaults/lockup/v1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/core/transaction"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// x/accounts account types matching the legacy x/auth accounts, as registered by the
+// x/accounts/defaults/base and x/accounts/defaults/lockup modules.
+const (
+	baseAccountType              = "base"
+	continuousLockingAccountType = "continuous-locking-account"
+	delayedLockingAccountType    = "delayed-locking-account"
+	periodicLockingAccountType   = "periodic-locking-account"
+	permanentLockingAccountType  = "permanent-locking-account"
+)
+
+// MigrateLegacyAccounts migrates the legacy accounts to their matching x/accounts account type,
+// keeping their address and account number, and returns the number of migrated accounts.
+// BaseAccounts with a pubkey are migrated to base accounts. Vesting accounts without delegations
+// are migrated to lockup accounts, owned by the address returned by ownerOf, or by a new base account
+// holding their pubkey and sequence when ownerOf is nil or returns false, see newLockupKeyOwner.
+// Vesting accounts without pubkey nor owner, and other accounts, such as module accounts, are kept in
+// x/auth.
+//
+// Each account is migrated in a branch of the state, so that the accounts rejected by x/accounts, e.g.
+// BaseAccounts with a multisig or ed25519 pubkey while the base account only supports secp256k1 pubkeys,
+// are kept in x/auth instead of failing the whole migration.
+//
+// Should only be used in an upgrade handler.
+func (ak AccountKeeper) MigrateLegacyAccounts(ctx context.Context, ownerOf func(vestingexported.VestingAccount) (string, bool)) (int, error) {
+	// collect the accounts first, as they are removed from the store while migrating
+	var accounts []sdk.AccountI
+	err := ak.Accounts.Walk(ctx, nil, func(_ sdk.AccAddress, acc sdk.AccountI) (stop bool, err error) {
+		accounts = append(accounts, acc)
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	migrated := 0
+	for _, acc := range accounts {
+		var owner string
+		switch acc := acc.(type) {
+		case *types.BaseAccount:
+			if acc.GetPubKey() == nil {
+				continue
+			}
+		case vestingexported.VestingAccount:
+			if hasDelegations(acc) {
+				continue
+			}
+			if ownerOf != nil {
+				if accOwner, ok := ownerOf(acc); ok {
+					if err := ak.checkLockupOwner(acc, accOwner); err != nil {
+						return migrated, fmt.Errorf("failed to migrate account %s: %w", acc.GetAddress(), err)
+					}
+					owner = accOwner
+				}
+			}
+			if owner == "" && acc.GetPubKey() == nil {
+				continue
+			}
+		default:
+			continue
+		}
+
+		err := ak.BranchService.Execute(ctx, func(ctx context.Context) error {
+			if _, ok := acc.(vestingexported.VestingAccount); ok && owner == "" {
+				var err error
+				if owner, err = ak.newLockupKeyOwner(ctx, acc); err != nil {
+					return err
+				}
+			}
+
+			accountType, initMsg, funds, err := legacyAccountInitMsg(acc, owner)
+			if err != nil {
+				return err
+			}
+
+			_, err = ak.migrateLegacyAccount(ctx, acc, accountType, initMsg, funds)
+			return err
+		})
+		if err != nil {
+			ak.Logger.Info("legacy account kept in x/auth, as it cannot be migrated", "address", acc.GetAddress(), "err", err)
+			continue
+		}
+		migrated++
+	}
+
+	return migrated, nil
+}
+
+// migrateLegacyAccount initializes the x/accounts account of the given type at the address of acc,
+// and removes acc from x/auth.
+func (ak AccountKeeper) migrateLegacyAccount(
+	ctx context.Context,
+	acc sdk.AccountI,
+	accountType string,
+	initMsg transaction.Msg,
+	funds sdk.Coins,
+) (transaction.Msg, error) {
+	initResp, err := ak.AccountsModKeeper.MigrateLegacyAccount(ctx, acc.GetAddress(), acc.GetAccountNumber(), accountType, initMsg, funds)
+	if err != nil {
+		return nil, err
+	}
+
+	// account is then removed from state
+	ak.RemoveAccount(ctx, acc)
+
+	return initResp, nil
+}
+
+// newLockupKeyOwner initializes a base account holding the pubkey and sequence of the vesting account acc,
+// and returns its address, to own the lockup account acc is migrated to. As lockup accounts cannot sign,
+// the key of the vesting account keeps control of its coins by signing for this owner account instead.
+func (ak AccountKeeper) newLockupKeyOwner(ctx context.Context, acc sdk.AccountI) (string, error) {
+	if acc.GetPubKey() == nil {
+		return "", errors.New("a vesting account without pubkey must be migrated with an owner")
+	}
+	_, initMsg, _, err := baseAccountInitMsg(acc)
+	if err != nil {
+		return "", err
+	}
+
+	_, ownerAddr, err := ak.AccountsModKeeper.Init(ctx, baseAccountType, acc.GetAddress(), initMsg, nil, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create the owner of the lockup account: %w", err)
+	}
+
+	return ak.AddressCodec().BytesToString(ownerAddr)
+}
+
+// legacyAccountInitMsg returns the x/accounts account type and init message matching the given legacy
+// account, along with the coins exposed to the account initialization.
+// BaseAccounts are migrated to base accounts, keeping their pubkey and sequence.
+// Vesting accounts are migrated to the lockup account matching their schedule, locking their original
+// vesting coins. Lockup accounts cannot authenticate transactions: all their messages are authorized by
+// their owner, which is what enforces the lockup, as the account cannot move its locked coins by itself.
+// The pubkey and sequence of a vesting account are therefore kept by its owner, see newLockupKeyOwner,
+// unless the holder of the vesting account chose another owner.
+func legacyAccountInitMsg(acc sdk.AccountI, owner string) (string, transaction.Msg, sdk.Coins, error) {
+	var (
+		accountType string
+		msg         protov2.Message
+	)
+
+	switch acc := acc.(type) {
+	case *types.BaseAccount:
+		return baseAccountInitMsg(acc)
+
+	case *vestingtypes.ContinuousVestingAccount:
+		accountType = continuousLockingAccountType
+		msg = &lockupv1.MsgInitLockupAccount{
+			Owner:     owner,
+			StartTime: unixToTimestamp(acc.StartTime),
+			EndTime:   unixToTimestamp(acc.EndTime),
+		}
+
+	case *vestingtypes.DelayedVestingAccount:
+		accountType = delayedLockingAccountType
+		msg = &lockupv1.MsgInitLockupAccount{
+			Owner:   owner,
+			EndTime: unixToTimestamp(acc.EndTime),
+		}
+
+	case *vestingtypes.PeriodicVestingAccount:
+		periods := make([]*lockupv1.Period, len(acc.VestingPeriods))
+		for i, period := range acc.VestingPeriods {
+			periods[i] = &lockupv1.Period{
+				Length: durationpb.New(time.Duration(period.Length) * time.Second),
+				Amount: toV1Beta1Coins(period.Amount),
+			}
+		}
+
+		accountType = periodicLockingAccountType
+		msg = &lockupv1.MsgInitPeriodicLockingAccount{
+			Owner:          owner,
+			StartTime:      unixToTimestamp(acc.StartTime),
+			LockingPeriods: periods,
+		}
+
+	case *vestingtypes.PermanentLockedAccount:
+		accountType = permanentLockingAccountType
+		msg = &lockupv1.MsgInitLockupAccount{Owner: owner}
+
+	default:
+		return "", nil, nil, fmt.Errorf("account of type %T cannot be migrated", acc)
+	}
+
+	vacc := acc.(vestingexported.VestingAccount)
+	if hasDelegations(vacc) {
+		return "", nil, nil, errors.New("vesting account with delegations cannot be migrated, its coins must be undelegated first")
+	}
+
+	return toInitMsg(accountType, msg, vacc.GetOriginalVesting())
+}
+
+// baseAccountInitMsg returns the init message of a base account keeping the pubkey and sequence of acc.
+func baseAccountInitMsg(acc sdk.AccountI) (string, transaction.Msg, sdk.Coins, error) {
+	pk := acc.GetPubKey()
+	if pk == nil {
+		return "", nil, nil, errors.New("account without pubkey cannot be migrated")
+	}
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	return toInitMsg(baseAccountType, &basev1.MsgInit{
+		PubKey:       &anypb.Any{TypeUrl: pkAny.TypeUrl, Value: pkAny.Value},
+		InitSequence: acc.GetSequence(),
+	}, nil)
+}
+
+// lockupOwner returns the owner set in the given lockup init message.
+func lockupOwner(initMsg *codectypes.Any) (string, error) {
+	if initMsg == nil {
+		return "", errors.New("a lockup account init msg setting the owner is required to migrate a vesting account")
+	}
+
+	var msg interface {
+		protov2.Message
+		GetOwner() string
+	}
+	switch initMsg.TypeUrl {
+	case "/" + string((&lockupv1.MsgInitLockupAccount{}).ProtoReflect().Descriptor().FullName()):
+		msg = &lockupv1.MsgInitLockupAccount{}
+	case "/" + string((&lockupv1.MsgInitPeriodicLockingAccount{}).ProtoReflect().Descriptor().FullName()):
+		msg = &lockupv1.MsgInitPeriodicLockingAccount{}
+	default:
+		return "", fmt.Errorf("expected a lockup account init msg, got %s", initMsg.TypeUrl)
+	}
+
+	if err := protov2.Unmarshal(initMsg.Value, msg); err != nil {
+		return "", err
+	}
+
+	return msg.GetOwner(), nil
+}
+
+// checkLockupOwner checks the owner of the lockup account a vesting account is migrated to. The owner
+// cannot be the account itself, as the coins of a lockup account that cannot sign would be stuck.
+func (ak AccountKeeper) checkLockupOwner(acc sdk.AccountI, owner string) error {
+	ownerAddr, err := ak.AddressCodec().StringToBytes(owner)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid lockup account owner: %s", err)
+	}
+	if acc.GetAddress().Equals(sdk.AccAddress(ownerAddr)) {
+		return errors.New("the owner of a lockup account cannot be the account itself, as it cannot sign transactions once migrated")
+	}
+
+	return nil
+}
+
+func hasDelegations(acc vestingexported.VestingAccount) bool {
+	return !acc.GetDelegatedFree().IsZero() || !acc.GetDelegatedVesting().IsZero()
+}
+
+// toInitMsg converts msg to the init message type registered by the x/accounts account implementation.
+func toInitMsg(accountType string, msg protov2.Message, funds sdk.Coins) (string, transaction.Msg, sdk.Coins, error) {
+	bz, err := protov2.Marshal(msg)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	initMsg, err := unpackAnyRaw(&codectypes.Any{
+		TypeUrl: "/" + string(msg.ProtoReflect().Descriptor().FullName()),
+		Value:   bz,
+	})
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	return accountType, initMsg, funds, nil
+}
+
+func unixToTimestamp(t int64) *timestamppb.Timestamp {
+	return timestamppb.New(time.Unix(t, 0))
+}
+
+func toV1Beta1Coins(coins sdk.Coins) []*basev1beta1.Coin {
+	res := make([]*basev1beta1.Coin, len(coins))
+	for i, coin := range coins {
+		res[i] = &basev1beta1.Coin{Denom: coin.Denom, Amount: coin.Amount.String()}
+	}
+	return res
+}
@@ -11,9 +11,13 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/core/transaction"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 )
 
 var _ types.MsgServer = msgServer{}
@@ -69,6 +73,10 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 		return nil, err
 	}
 
+	if err := ms.ak.validateFeeRecipients(msg.Params); err != nil {
+		return nil, err
+	}
+
 	if err := ms.ak.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
 	}
@@ -87,26 +95,55 @@ func (ms msgServer) MigrateAccount(ctx context.Context, msg *types.MsgMigrateAcc
 		return nil, sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", signer)
 	}
 
-	// check if account type is valid or not
-	_, isBaseAccount := (acc).(*types.BaseAccount)
-	if !isBaseAccount {
-		return nil, status.Error(codes.InvalidArgument, "only BaseAccount can be migrated")
+	var (
+		accountType string
+		initMsg     transaction.Msg
+		funds       sdk.Coins
+	)
+	switch acc.(type) {
+	case *types.BaseAccount:
+		if msg.AccountInitMsg != nil {
+			// unwrap any msg
+			accountType = msg.AccountType
+			initMsg, err = unpackAnyRaw(msg.AccountInitMsg)
+		} else {
+			accountType, initMsg, funds, err = legacyAccountInitMsg(acc, "")
+		}
+	case vestingexported.VestingAccount:
+		// lockup accounts cannot authenticate transactions, so they are owned by a new account holding
+		// the key of the vesting account, unless the owner is provided
+		var owner string
+		if msg.AccountInitMsg != nil {
+			owner, err = lockupOwner(msg.AccountInitMsg)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			if err := ms.ak.checkLockupOwner(acc, owner); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		} else {
+			owner, err = ms.ak.newLockupKeyOwner(ctx, acc)
+			if err != nil {
+				return nil, err
+			}
+		}
+		accountType, initMsg, funds, err = legacyAccountInitMsg(acc, owner)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "only BaseAccount and vesting accounts can be migrated")
 	}
-
-	// unwrap any msg
-	initMsg, err := unpackAnyRaw(msg.AccountInitMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	initResp, err := ms.ak.AccountsModKeeper.MigrateLegacyAccount(ctx, signer, acc.GetAccountNumber(), msg.AccountType, initMsg)
+	if msg.AccountType != "" && msg.AccountType != accountType {
+		return nil, status.Errorf(codes.InvalidArgument, "account must be migrated to a %s account, got %s", accountType, msg.AccountType)
+	}
+
+	initResp, err := ms.ak.migrateLegacyAccount(ctx, acc, accountType, initMsg, funds)
 	if err != nil {
 		return nil, err
 	}
 
-	// account is then removed from state
-	ms.ak.RemoveAccount(ctx, acc)
-
 	initRespAny, err := codectypes.NewAnyWithValue(initResp)
 	if err != nil {
 		return nil, err
@@ -8,12 +8,28 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/runtime/protoiface"
 
+	"cosmossdk.io/math"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func (s *KeeperTestSuite) TestUpdateParams() {
+	withBaseFee := func(recipient string) types.Params {
+		params := types.DefaultParams()
+		params.BaseFeeDenom = "stake"
+		params.MinBaseFee = math.LegacyNewDecWithPrec(1, 3)
+		params.BaseFeeRecipient = recipient
+		return params
+	}
+	withFeeDenom := func(recipient string) types.Params {
+		params := withBaseFee("mint")
+		params.FeeDenoms = []types.FeeDenom{{Denom: "atom", Price: math.LegacyNewDecWithPrec(25, 1)}}
+		params.FeeDenomRecipient = recipient
+		return params
+	}
+
 	testCases := []struct {
 		name      string
 		req       *types.MsgUpdateParams
@@ -103,6 +119,49 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expectErr: true,
 			expErrMsg: "invalid SECK256k1 signature verification cost",
 		},
+		{
+			name: "set unknown base fee recipient",
+			req: &types.MsgUpdateParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params:    withBaseFee("unknown"),
+			},
+			expectErr: true,
+			expErrMsg: "base fee recipient unknown is not a module account",
+		},
+		{
+			name: "burn base fee without burner permission",
+			req: &types.MsgUpdateParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params:    withBaseFee(""),
+			},
+			expectErr: true,
+			expErrMsg: "the fee_collector module account must have the burner permission",
+		},
+		{
+			name: "set unknown fee denom recipient",
+			req: &types.MsgUpdateParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params:    withFeeDenom("unknown"),
+			},
+			expectErr: true,
+			expErrMsg: "fee denom recipient unknown is not a module account",
+		},
+		{
+			name: "valid fee denom recipient",
+			req: &types.MsgUpdateParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params:    withFeeDenom("bonded_tokens_pool"),
+			},
+			expectErr: false,
+		},
+		{
+			name: "valid base fee recipient",
+			req: &types.MsgUpdateParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params:    withBaseFee("mint"),
+			},
+			expectErr: false,
+		},
 		{
 			name: "valid transaction",
 			req: &types.MsgUpdateParams{
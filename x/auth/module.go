@@ -39,6 +39,8 @@ var (
 	_ appmodulev2.HasGenesis    = AppModule{}
 	_ appmodulev2.AppModule     = AppModule{}
 	_ appmodulev2.HasMigrations = AppModule{}
+
+	_ appmodulev2.HasBeginBlocker = AppModule{}
 )
 
 // AppModule implements an application module for the auth module.
@@ -155,6 +157,11 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return am.cdc.MarshalJSON(gs)
 }
 
+// BeginBlock updates the base fee from the gas wanted by the previous block.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.accountKeeper.UpdateBaseFee(ctx)
+}
+
 // TxValidator implements appmodulev2.HasTxValidator.
 // It replaces auth ante handlers for server/v2
 func (am AppModule) TxValidator(ctx context.Context, tx transaction.Tx) error {
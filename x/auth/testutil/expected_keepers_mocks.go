@@ -42,6 +42,20 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// BurnCoins mocks base method.
+func (m *MockBankKeeper) BurnCoins(ctx context.Context, address []byte, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnCoins", ctx, address, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnCoins indicates an expected call of BurnCoins.
+func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, address, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, address, amt)
+}
+
 // IsSendEnabledCoins mocks base method.
 func (m *MockBankKeeper) IsSendEnabledCoins(ctx context.Context, coins ...types.Coin) error {
 	m.ctrl.T.Helper()
@@ -113,6 +127,22 @@ func (m *MockAccountsModKeeper) EXPECT() *MockAccountsModKeeperMockRecorder {
 	return m.recorder
 }
 
+// Init mocks base method.
+func (m *MockAccountsModKeeper) Init(ctx context.Context, accountType string, creator []byte, initRequest transaction.Msg, funds types.Coins, addressSeed []byte) (transaction.Msg, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Init", ctx, accountType, creator, initRequest, funds, addressSeed)
+	ret0, _ := ret[0].(transaction.Msg)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Init indicates an expected call of Init.
+func (mr *MockAccountsModKeeperMockRecorder) Init(ctx, accountType, creator, initRequest, funds, addressSeed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockAccountsModKeeper)(nil).Init), ctx, accountType, creator, initRequest, funds, addressSeed)
+}
+
 // InitAccountNumberSeqUnsafe mocks base method.
 func (m *MockAccountsModKeeper) InitAccountNumberSeqUnsafe(ctx context.Context, currentAccNum uint64) error {
 	m.ctrl.T.Helper()
@@ -142,18 +172,18 @@ func (mr *MockAccountsModKeeperMockRecorder) IsAccountsModuleAccount(ctx, accoun
 }
 
 // MigrateLegacyAccount mocks base method.
-func (m *MockAccountsModKeeper) MigrateLegacyAccount(ctx context.Context, addr []byte, accNum uint64, accType string, msg transaction.Msg) (transaction.Msg, error) {
+func (m *MockAccountsModKeeper) MigrateLegacyAccount(ctx context.Context, addr []byte, accNum uint64, accType string, msg transaction.Msg, funds types.Coins) (transaction.Msg, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MigrateLegacyAccount", ctx, addr, accNum, accType, msg)
+	ret := m.ctrl.Call(m, "MigrateLegacyAccount", ctx, addr, accNum, accType, msg, funds)
 	ret0, _ := ret[0].(transaction.Msg)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MigrateLegacyAccount indicates an expected call of MigrateLegacyAccount.
-func (mr *MockAccountsModKeeperMockRecorder) MigrateLegacyAccount(ctx, addr, accNum, accType, msg any) *gomock.Call {
+func (mr *MockAccountsModKeeperMockRecorder) MigrateLegacyAccount(ctx, addr, accNum, accType, msg, funds any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateLegacyAccount", reflect.TypeOf((*MockAccountsModKeeper)(nil).MigrateLegacyAccount), ctx, addr, accNum, accType, msg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateLegacyAccount", reflect.TypeOf((*MockAccountsModKeeper)(nil).MigrateLegacyAccount), ctx, addr, accNum, accType, msg, funds)
 }
 
 // NextAccountNumber mocks base method.
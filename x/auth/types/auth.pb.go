@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -172,6 +173,31 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// base_fee_denom is the denom of the consensus base fee, the minimum gas price
+	// every transaction must pay. An empty denom disables the base fee.
+	BaseFeeDenom string `protobuf:"bytes,6,opt,name=base_fee_denom,json=baseFeeDenom,proto3" json:"base_fee_denom,omitempty"`
+	// min_base_fee is the lower bound of the base fee, per unit of gas.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee"`
+	// target_block_gas is the gas wanted per block targeted by the base fee. The
+	// base fee increases after blocks above the target and decreases after blocks
+	// below it.
+	TargetBlockGas uint64 `protobuf:"varint,8,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// base_fee_change_denominator bounds the change of the base fee between two
+	// blocks, to 1/base_fee_change_denominator of the base fee when a block is
+	// empty or wants twice the target gas.
+	BaseFeeChangeDenominator uint64 `protobuf:"varint,9,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// base_fee_recipient is the name of the module account receiving the base fees
+	// paid by transactions. An empty name burns the base fees from the fee
+	// collector, which then requires the burner permission.
+	BaseFeeRecipient string `protobuf:"bytes,10,opt,name=base_fee_recipient,json=baseFeeRecipient,proto3" json:"base_fee_recipient,omitempty"`
+	// fee_denoms is the allowlist of denoms that transactions can pay their fees
+	// with in place of the base fee denom, at the price given by the fee denom
+	// pricer of the application. It requires the base fee to be enabled.
+	FeeDenoms []FeeDenom `protobuf:"bytes,11,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms"`
+	// fee_denom_recipient is the name of the module account receiving the base
+	// fees paid in allowed fee denoms, which can for instance swap them. An empty
+	// name handles them like the base fees paid in the base fee denom.
+	FeeDenomRecipient string `protobuf:"bytes,12,opt,name=fee_denom_recipient,json=feeDenomRecipient,proto3" json:"fee_denom_recipient,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -242,64 +268,171 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetBaseFeeDenom() string {
+	if m != nil {
+		return m.BaseFeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint64 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeRecipient() string {
+	if m != nil {
+		return m.BaseFeeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetFeeDenomRecipient() string {
+	if m != nil {
+		return m.FeeDenomRecipient
+	}
+	return ""
+}
+
+// FeeDenom is a denom allowed to pay the fees of transactions in place of the
+// base fee denom.
+type FeeDenom struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the amount of base fee denom that one unit of denom is worth, used
+	// by the default static fee denom pricer.
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*FeeDenom)(nil), "cosmos.auth.v1beta1.FeeDenom")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0xd0, 0xa5, 0x93, 0x6e, 0x97, 0x7a, 0x43, 0xf1, 0x46, 0x28, 0xf6, 0x46, 0x42,
-	0x1b, 0x2a, 0x62, 0x6f, 0x02, 0x05, 0x35, 0xb7, 0x26, 0x20, 0x54, 0x95, 0x96, 0xca, 0x11, 0x3d,
-	0xf4, 0x62, 0x8d, 0x9d, 0xa9, 0x3b, 0x6a, 0xc6, 0x63, 0x3c, 0xe3, 0x2a, 0xee, 0x2f, 0xa8, 0x38,
-	0x21, 0x2e, 0x5c, 0x0b, 0xbf, 0xa0, 0x87, 0xfe, 0x08, 0xc4, 0xa9, 0xea, 0x05, 0x4e, 0x11, 0x4a,
-	0x0f, 0xad, 0x10, 0x3f, 0x02, 0x79, 0xc6, 0x69, 0x92, 0x6e, 0x2e, 0x91, 0xdf, 0xf7, 0x7d, 0xef,
-	0xbd, 0xef, 0xbd, 0x3c, 0x1b, 0x54, 0x3d, 0xca, 0x08, 0x65, 0x16, 0x8c, 0xf9, 0x89, 0x75, 0xd6,
-	0x74, 0x11, 0x87, 0x4d, 0x11, 0x98, 0x61, 0x44, 0x39, 0x55, 0x5f, 0x4a, 0xde, 0x14, 0x50, 0xc6,
-	0x57, 0xd6, 0x20, 0xc1, 0x01, 0xb5, 0xc4, 0xaf, 0xd4, 0x55, 0x5e, 0x49, 0x9d, 0x23, 0x22, 0x2b,
-	0x4b, 0x92, 0x54, 0xd9, 0xa7, 0x3e, 0x95, 0x78, 0xfa, 0x34, 0x49, 0xf0, 0x29, 0xf5, 0x07, 0xc8,
-	0x12, 0x91, 0x1b, 0x1f, 0x5b, 0x30, 0x48, 0x24, 0x55, 0xfb, 0x2d, 0x0f, 0x4a, 0x1d, 0xc8, 0xd0,
-	0xb6, 0xe7, 0xd1, 0x38, 0xe0, 0x6a, 0x0b, 0x3c, 0x83, 0xfd, 0x7e, 0x84, 0x18, 0xd3, 0x14, 0x43,
-	0xa9, 0x2f, 0x77, 0xb4, 0xdb, 0xeb, 0x46, 0x39, 0xeb, 0xb1, 0x2d, 0x99, 0x1e, 0x8f, 0x70, 0xe0,
-	0xdb, 0x13, 0xa1, 0x7a, 0x08, 0x9e, 0x85, 0xb1, 0xeb, 0x9c, 0xa2, 0x44, 0xcb, 0x1b, 0x4a, 0xbd,
-	0xd4, 0x2a, 0x9b, 0xb2, 0xa1, 0x39, 0x69, 0x68, 0x6e, 0x07, 0x49, 0xe7, 0xcd, 0xbf, 0x23, 0xbd,
-	0x1c, 0xc6, 0xee, 0x00, 0x7b, 0xa9, 0xf6, 0x33, 0x4a, 0x30, 0x47, 0x24, 0xe4, 0xc9, 0xef, 0xf7,
-	0x57, 0x1b, 0x60, 0x4a, 0xd8, 0x4b, 0x61, 0xec, 0xee, 0xa2, 0x44, 0xfd, 0x04, 0xac, 0x42, 0x69,
-	0xcb, 0x09, 0x62, 0xe2, 0xa2, 0x48, 0x2b, 0x18, 0x4a, 0xbd, 0x68, 0x3f, 0xcf, 0xd0, 0x7d, 0x01,
-	0xaa, 0x15, 0xf0, 0x3e, 0x43, 0x3f, 0xc6, 0x28, 0xf0, 0x90, 0x56, 0x14, 0x82, 0xc7, 0xb8, 0xdd,
-	0xbd, 0xb8, 0xd4, 0x73, 0x0f, 0x97, 0x7a, 0xee, 0xcf, 0xeb, 0xc6, 0xc7, 0x0b, 0xd6, 0x6b, 0x66,
-	0x73, 0xef, 0xfc, 0x74, 0x7f, 0xb5, 0xb1, 0x2e, 0x05, 0x0d, 0xd6, 0x3f, 0xb5, 0x66, 0x76, 0x52,
-	0xfb, 0x4f, 0x01, 0xcf, 0xf7, 0x68, 0x3f, 0x1e, 0x3c, 0x6e, 0x69, 0x07, 0xac, 0xb8, 0x90, 0x21,
-	0x27, 0x33, 0x22, 0x56, 0x55, 0x6a, 0x19, 0xe6, 0xa2, 0x0e, 0x33, 0x95, 0x3a, 0xc5, 0x9b, 0x91,
-	0xae, 0xd8, 0x25, 0x77, 0x66, 0xe1, 0x2a, 0x28, 0x06, 0x90, 0x20, 0xb1, 0xb9, 0x65, 0x5b, 0x3c,
-	0xab, 0x06, 0x28, 0x85, 0x28, 0x22, 0x98, 0x31, 0x4c, 0x03, 0xa6, 0x15, 0x8c, 0x42, 0x7d, 0xd9,
-	0x9e, 0x85, 0xda, 0x47, 0x17, 0x72, 0xa6, 0xda, 0xa2, 0x8e, 0x73, 0x5e, 0xc5, 0x64, 0xda, 0xcc,
-	0x64, 0x73, 0xec, 0x2f, 0xf7, 0x57, 0x1b, 0xab, 0x44, 0x20, 0x93, 0x61, 0x6a, 0xbf, 0x2a, 0xe0,
-	0x03, 0x29, 0xea, 0x46, 0xa8, 0x8f, 0x02, 0x8e, 0xe1, 0x40, 0xd5, 0x41, 0x29, 0x93, 0x09, 0xb7,
-	0xe2, 0x36, 0x6c, 0x20, 0xa1, 0xfd, 0xd4, 0xf3, 0x1b, 0xf0, 0xa2, 0x8f, 0x22, 0x7c, 0x06, 0x39,
-	0xa6, 0x41, 0xfa, 0x37, 0x32, 0x2d, 0x6f, 0x14, 0xea, 0x2b, 0xf6, 0xea, 0x14, 0xde, 0x45, 0x09,
-	0x6b, 0x6f, 0xdd, 0x5e, 0x37, 0x5e, 0x4c, 0xfd, 0x18, 0x6f, 0xcd, 0x2f, 0xbe, 0x4a, 0x3d, 0xbe,
-	0x9e, 0xf1, 0xf8, 0x6d, 0x44, 0xe3, 0x30, 0xb3, 0x38, 0x35, 0x51, 0xfb, 0x2b, 0x0f, 0x96, 0x0e,
-	0x60, 0x04, 0x09, 0x53, 0x4d, 0xf0, 0x92, 0xc0, 0xa1, 0x43, 0x10, 0xa1, 0x8e, 0x77, 0x02, 0x23,
-	0xe8, 0x71, 0x14, 0xc9, 0x9b, 0x2d, 0xda, 0x6b, 0x04, 0x0e, 0xf7, 0x10, 0xa1, 0xdd, 0x47, 0x42,
-	0x35, 0xc0, 0x0a, 0x1f, 0x3a, 0x0c, 0xfb, 0xce, 0x00, 0x13, 0xcc, 0xc5, 0xba, 0x8b, 0x36, 0xe0,
-	0xc3, 0x1e, 0xf6, 0xbf, 0x4b, 0x11, 0xf5, 0x2d, 0xf8, 0x50, 0x28, 0xce, 0x91, 0xe3, 0x51, 0xc6,
-	0x9d, 0x10, 0x45, 0x8e, 0x9b, 0x70, 0x94, 0x1d, 0xdd, 0x5a, 0x2a, 0x3d, 0x47, 0x5d, 0xca, 0xf8,
-	0x01, 0x8a, 0x3a, 0x09, 0x47, 0xea, 0xf7, 0xe0, 0xa3, 0xb4, 0xe0, 0x19, 0x8a, 0xf0, 0x71, 0x22,
-	0x93, 0x50, 0xbf, 0xb5, 0xb9, 0xd9, 0xdc, 0x92, 0x77, 0xd8, 0xd1, 0xc6, 0x23, 0xbd, 0xdc, 0xc3,
-	0xfe, 0xa1, 0x50, 0xa4, 0xa9, 0xdf, 0x7c, 0x2d, 0x78, 0xbb, 0xcc, 0xe6, 0x50, 0x99, 0xa5, 0xfe,
-	0x00, 0x5e, 0x3d, 0x2d, 0xc8, 0x90, 0x17, 0xb6, 0x36, 0xbf, 0x3c, 0x6d, 0x6a, 0xef, 0x89, 0x92,
-	0x95, 0xf1, 0x48, 0x5f, 0x9f, 0x2b, 0xd9, 0x9b, 0x28, 0xec, 0x75, 0xb6, 0x10, 0x6f, 0xbf, 0x7e,
-	0xb8, 0xd4, 0x95, 0xa7, 0x67, 0x30, 0x94, 0x9f, 0x21, 0xb9, 0xce, 0x4e, 0xf7, 0x8f, 0x71, 0x55,
-	0xb9, 0x19, 0x57, 0x95, 0x7f, 0xc6, 0x55, 0xe5, 0xe7, 0xbb, 0x6a, 0xee, 0xe6, 0xae, 0x9a, 0xfb,
-	0xfb, 0xae, 0x9a, 0x3b, 0xfa, 0xd4, 0xc7, 0xfc, 0x24, 0x76, 0x4d, 0x8f, 0x92, 0xec, 0x53, 0x63,
-	0xbd, 0x5b, 0x85, 0x27, 0x21, 0x62, 0xee, 0x92, 0x78, 0xdd, 0x3f, 0xff, 0x3f, 0x00, 0x00, 0xff,
-	0xff, 0x8c, 0x05, 0x77, 0x9c, 0xe8, 0x04, 0x00, 0x00,
+	// 977 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x4f, 0xdc, 0x46,
+	0x14, 0x5f, 0xc3, 0xf2, 0x67, 0x67, 0x09, 0x81, 0xc9, 0x96, 0x3a, 0xa4, 0xdd, 0xdd, 0xac, 0x5a,
+	0x65, 0x8b, 0x82, 0x37, 0x6c, 0x4b, 0xaa, 0x20, 0xf5, 0x80, 0x97, 0x16, 0x45, 0x21, 0x69, 0x64,
+	0xd4, 0xa8, 0xca, 0xc5, 0x1a, 0x7b, 0x1f, 0x66, 0xc4, 0x8e, 0xc7, 0xf5, 0x8c, 0x11, 0xce, 0x27,
+	0x88, 0x7a, 0xaa, 0x7a, 0xe9, 0x95, 0xf6, 0xd4, 0x23, 0x07, 0x3e, 0x44, 0xd4, 0x13, 0xe2, 0x54,
+	0xf5, 0xb0, 0xaa, 0xe0, 0x40, 0x54, 0xf5, 0x43, 0x54, 0x9e, 0xf1, 0x2e, 0x4b, 0xca, 0x29, 0x17,
+	0xcb, 0xf3, 0x7b, 0xbf, 0xf7, 0xde, 0xef, 0xfd, 0x19, 0x1b, 0x55, 0x7d, 0x2e, 0x18, 0x17, 0x2d,
+	0x92, 0xc8, 0xdd, 0xd6, 0xfe, 0x8a, 0x07, 0x92, 0xac, 0xa8, 0x83, 0x15, 0xc5, 0x5c, 0x72, 0x7c,
+	0x4b, 0xdb, 0x2d, 0x05, 0xe5, 0xf6, 0xc5, 0x79, 0xc2, 0x68, 0xc8, 0x5b, 0xea, 0xa9, 0x79, 0x8b,
+	0xb7, 0x35, 0xcf, 0x55, 0xa7, 0x56, 0xee, 0xa4, 0x4d, 0x95, 0x80, 0x07, 0x5c, 0xe3, 0xd9, 0xdb,
+	0xc0, 0x21, 0xe0, 0x3c, 0xe8, 0x41, 0x4b, 0x9d, 0xbc, 0x64, 0xa7, 0x45, 0xc2, 0x54, 0x9b, 0x1a,
+	0xbf, 0x8e, 0xa1, 0xb2, 0x4d, 0x04, 0xac, 0xfb, 0x3e, 0x4f, 0x42, 0x89, 0xdb, 0x68, 0x8a, 0x74,
+	0xbb, 0x31, 0x08, 0x61, 0x1a, 0x75, 0xa3, 0x59, 0xb2, 0xcd, 0xd3, 0xe3, 0xe5, 0x4a, 0x9e, 0x63,
+	0x5d, 0x5b, 0xb6, 0x65, 0x4c, 0xc3, 0xc0, 0x19, 0x10, 0xf1, 0x0b, 0x34, 0x15, 0x25, 0x9e, 0xbb,
+	0x07, 0xa9, 0x39, 0x56, 0x37, 0x9a, 0xe5, 0x76, 0xc5, 0xd2, 0x09, 0xad, 0x41, 0x42, 0x6b, 0x3d,
+	0x4c, 0xed, 0x7b, 0xff, 0xf4, 0x6b, 0x95, 0x28, 0xf1, 0x7a, 0xd4, 0xcf, 0xb8, 0xf7, 0x39, 0xa3,
+	0x12, 0x58, 0x24, 0xd3, 0xdf, 0x2e, 0x8e, 0x96, 0xd0, 0xa5, 0xc1, 0x99, 0x8c, 0x12, 0xef, 0x09,
+	0xa4, 0xf8, 0x53, 0x34, 0x4b, 0xb4, 0x2c, 0x37, 0x4c, 0x98, 0x07, 0xb1, 0x39, 0x5e, 0x37, 0x9a,
+	0x45, 0xe7, 0x46, 0x8e, 0x3e, 0x53, 0x20, 0x5e, 0x44, 0xd3, 0x02, 0x7e, 0x48, 0x20, 0xf4, 0xc1,
+	0x2c, 0x2a, 0xc2, 0xf0, 0xbc, 0xd6, 0x79, 0x7d, 0x58, 0x2b, 0xbc, 0x3d, 0xac, 0x15, 0xfe, 0x38,
+	0x5e, 0xfe, 0xe8, 0x9a, 0xf6, 0x5a, 0x79, 0xdd, 0x8f, 0x7f, 0xbc, 0x38, 0x5a, 0x5a, 0xd0, 0x84,
+	0x65, 0xd1, 0xdd, 0x6b, 0x8d, 0xf4, 0xa4, 0xf1, 0xaf, 0x81, 0x6e, 0x3c, 0xe5, 0xdd, 0xa4, 0x37,
+	0xec, 0xd2, 0x63, 0x34, 0xe3, 0x11, 0x01, 0x6e, 0x2e, 0x44, 0xb5, 0xaa, 0xdc, 0xae, 0x5b, 0xd7,
+	0x65, 0x18, 0x89, 0x64, 0x17, 0x4f, 0xfa, 0x35, 0xc3, 0x29, 0x7b, 0x23, 0x0d, 0xc7, 0xa8, 0x18,
+	0x12, 0x06, 0xaa, 0x73, 0x25, 0x47, 0xbd, 0xe3, 0x3a, 0x2a, 0x47, 0x10, 0x33, 0x2a, 0x04, 0xe5,
+	0xa1, 0x30, 0xc7, 0xeb, 0xe3, 0xcd, 0x92, 0x33, 0x0a, 0xad, 0xbd, 0x7c, 0xad, 0x6b, 0x6a, 0x5c,
+	0x97, 0xf1, 0x8a, 0x56, 0x55, 0x99, 0x39, 0x52, 0xd9, 0x15, 0xeb, 0xcf, 0x17, 0x47, 0x4b, 0xb3,
+	0x4c, 0x21, 0x83, 0x62, 0x1a, 0xbf, 0x18, 0x68, 0x4e, 0x93, 0x3a, 0x31, 0x74, 0x21, 0x94, 0x94,
+	0xf4, 0x70, 0x0d, 0x95, 0x73, 0x9a, 0x52, 0xab, 0x76, 0xc3, 0x41, 0x1a, 0x7a, 0x96, 0x69, 0xbe,
+	0x87, 0x6e, 0x76, 0x21, 0xa6, 0xfb, 0x44, 0x52, 0x1e, 0x66, 0x63, 0x14, 0xe6, 0x58, 0x7d, 0xbc,
+	0x39, 0xe3, 0xcc, 0x5e, 0xc2, 0x4f, 0x20, 0x15, 0x6b, 0x8f, 0x4e, 0x8f, 0x97, 0x6f, 0x5e, 0xea,
+	0xa9, 0x3f, 0xb0, 0xbe, 0xf8, 0x32, 0xd3, 0x78, 0x77, 0x44, 0xe3, 0x66, 0xcc, 0x93, 0x28, 0x97,
+	0x78, 0x29, 0xa2, 0x71, 0x31, 0x81, 0x26, 0x9f, 0x93, 0x98, 0x30, 0x81, 0x2d, 0x74, 0x8b, 0x91,
+	0x03, 0x97, 0x01, 0xe3, 0xae, 0xbf, 0x4b, 0x62, 0xe2, 0x4b, 0x88, 0xf5, 0xce, 0x16, 0x9d, 0x79,
+	0x46, 0x0e, 0x9e, 0x02, 0xe3, 0x9d, 0xa1, 0x01, 0xd7, 0xd1, 0x8c, 0x3c, 0x70, 0x05, 0x0d, 0xdc,
+	0x1e, 0x65, 0x54, 0xaa, 0x76, 0x17, 0x1d, 0x24, 0x0f, 0xb6, 0x69, 0xb0, 0x95, 0x21, 0xf8, 0x01,
+	0xfa, 0x40, 0x31, 0x5e, 0x81, 0xeb, 0x73, 0x21, 0xdd, 0x08, 0x62, 0xd7, 0x4b, 0x25, 0xe4, 0x4b,
+	0x37, 0x9f, 0x51, 0x5f, 0x41, 0x87, 0x0b, 0xf9, 0x1c, 0x62, 0x3b, 0x95, 0x80, 0xbf, 0x45, 0x1f,
+	0x66, 0x01, 0xf7, 0x21, 0xa6, 0x3b, 0xa9, 0x76, 0x82, 0x6e, 0x7b, 0x75, 0x75, 0xe5, 0x91, 0xde,
+	0x43, 0xdb, 0x3c, 0xeb, 0xd7, 0x2a, 0xdb, 0x34, 0x78, 0xa1, 0x18, 0x99, 0xeb, 0xd7, 0x1b, 0xca,
+	0xee, 0x54, 0xc4, 0x15, 0x54, 0x7b, 0xe1, 0xef, 0xd0, 0xed, 0x77, 0x03, 0x0a, 0xf0, 0xa3, 0xf6,
+	0xea, 0xc3, 0xbd, 0x15, 0x73, 0x42, 0x85, 0x5c, 0x3c, 0xeb, 0xd7, 0x16, 0xae, 0x84, 0xdc, 0x1e,
+	0x30, 0x9c, 0x05, 0x71, 0x2d, 0x8e, 0x3f, 0x41, 0xb3, 0x6a, 0x5b, 0x77, 0x00, 0xdc, 0x2e, 0x84,
+	0x9c, 0x99, 0x93, 0x6a, 0x7c, 0x6a, 0x87, 0xbf, 0x01, 0xd8, 0xc8, 0x30, 0xfc, 0x3d, 0x9a, 0x61,
+	0x34, 0x74, 0x07, 0x4c, 0x73, 0x4a, 0x5d, 0xff, 0x87, 0x6f, 0xfa, 0xb5, 0xc2, 0x5f, 0xfd, 0xda,
+	0x1d, 0x3d, 0x1d, 0xd1, 0xdd, 0xb3, 0x28, 0x6f, 0x31, 0x22, 0x77, 0xad, 0x2d, 0x08, 0x88, 0x9f,
+	0x6e, 0x80, 0x7f, 0x7a, 0xbc, 0x8c, 0xf2, 0x3d, 0xdc, 0x00, 0xff, 0xf7, 0x8b, 0xa3, 0x25, 0xc3,
+	0x41, 0x8c, 0x86, 0xb6, 0x0e, 0x8f, 0x9b, 0x68, 0x4e, 0x92, 0x38, 0x00, 0xe9, 0x7a, 0x3d, 0xee,
+	0xef, 0xb9, 0x01, 0x11, 0xe6, 0xb4, 0x6a, 0xea, 0xac, 0xc6, 0xed, 0x0c, 0xde, 0x24, 0x02, 0x7f,
+	0x85, 0xee, 0x0c, 0x95, 0xfa, 0xbb, 0x24, 0x0c, 0x72, 0xc1, 0x34, 0x24, 0x92, 0xc7, 0x66, 0x49,
+	0x39, 0x99, 0xb9, 0xec, 0x8e, 0x22, 0x6c, 0x5c, 0xda, 0xf1, 0x7d, 0x84, 0x87, 0xee, 0x31, 0xf8,
+	0x34, 0xa2, 0x10, 0x4a, 0x13, 0xa9, 0x62, 0xe7, 0x72, 0x2f, 0x67, 0x80, 0xe3, 0x4d, 0x84, 0x86,
+	0x1d, 0x11, 0x66, 0xb9, 0x3e, 0xde, 0x2c, 0xb7, 0x3f, 0xbe, 0xf6, 0x0a, 0x0f, 0x7a, 0x64, 0x97,
+	0xb2, 0x6e, 0xe8, 0x02, 0x4b, 0x3b, 0x39, 0xa8, 0x76, 0x71, 0x18, 0x68, 0x24, 0xef, 0x8c, 0xca,
+	0x3b, 0x3f, 0xe0, 0x0d, 0x13, 0xaf, 0xdd, 0x7d, 0x7b, 0x58, 0x33, 0xde, 0xbd, 0x96, 0x07, 0xfa,
+	0xb7, 0xa0, 0xd7, 0xbb, 0x21, 0xd1, 0xf4, 0x70, 0x30, 0x15, 0x34, 0xa1, 0xa7, 0xa6, 0x2f, 0x9d,
+	0x3e, 0xe0, 0x2d, 0x34, 0x11, 0xc5, 0xd4, 0xcf, 0x3f, 0x1c, 0xef, 0x3d, 0x27, 0x1d, 0x64, 0xad,
+	0x98, 0x49, 0xb2, 0x3b, 0x6f, 0xce, 0xaa, 0xc6, 0xc9, 0x59, 0xd5, 0xf8, 0xfb, 0xac, 0x6a, 0xfc,
+	0x74, 0x5e, 0x2d, 0x9c, 0x9c, 0x57, 0x0b, 0x7f, 0x9e, 0x57, 0x0b, 0x2f, 0x3f, 0x0b, 0xa8, 0xdc,
+	0x4d, 0x3c, 0xcb, 0xe7, 0x2c, 0xff, 0xe1, 0xb4, 0xfe, 0xaf, 0x5d, 0xa6, 0x11, 0x08, 0x6f, 0x52,
+	0x7d, 0xf4, 0x3f, 0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x13, 0xa3, 0xd2, 0x4d, 0xee, 0x06, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -336,6 +469,59 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.BaseFeeDenom != that1.BaseFeeDenom {
+		return false
+	}
+	if !this.MinBaseFee.Equal(that1.MinBaseFee) {
+		return false
+	}
+	if this.TargetBlockGas != that1.TargetBlockGas {
+		return false
+	}
+	if this.BaseFeeChangeDenominator != that1.BaseFeeChangeDenominator {
+		return false
+	}
+	if this.BaseFeeRecipient != that1.BaseFeeRecipient {
+		return false
+	}
+	if len(this.FeeDenoms) != len(that1.FeeDenoms) {
+		return false
+	}
+	for i := range this.FeeDenoms {
+		if !this.FeeDenoms[i].Equal(&that1.FeeDenoms[i]) {
+			return false
+		}
+	}
+	if this.FeeDenomRecipient != that1.FeeDenomRecipient {
+		return false
+	}
+	return true
+}
+func (this *FeeDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeDenom)
+	if !ok {
+		that2, ok := that.(FeeDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -500,6 +686,61 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeDenomRecipient) > 0 {
+		i -= len(m.FeeDenomRecipient)
+		copy(dAtA[i:], m.FeeDenomRecipient)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.FeeDenomRecipient)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.BaseFeeRecipient) > 0 {
+		i -= len(m.BaseFeeRecipient)
+		copy(dAtA[i:], m.BaseFeeRecipient)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.BaseFeeRecipient)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BaseFeeDenom) > 0 {
+		i -= len(m.BaseFeeDenom)
+		copy(dAtA[i:], m.BaseFeeDenom)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.BaseFeeDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -528,6 +769,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -625,6 +906,47 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	l = len(m.BaseFeeDenom)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovAuth(uint64(m.TargetBlockGas))
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovAuth(uint64(m.BaseFeeChangeDenominator))
+	}
+	l = len(m.BaseFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	l = len(m.FeeDenomRecipient)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovAuth(uint64(l))
 	return n
 }
 
@@ -1178,6 +1500,324 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenomRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -0,0 +1,10 @@
+package types
+
+// auth module event types
+const (
+	EventTypeBaseFee = "base_fee"
+
+	AttributeKeyBaseFee        = "base_fee"
+	AttributeKeyBlockGasWanted = "block_gas_wanted"
+	AttributeKeyGasPrices      = "gas_prices"
+)
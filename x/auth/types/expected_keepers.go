@@ -13,6 +13,7 @@ type BankKeeper interface {
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 	SendCoins(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, address []byte, amt sdk.Coins) error
 }
 
 // AccountsModKeeper defines the contract for x/accounts APIs
@@ -28,6 +29,16 @@ type AccountsModKeeper interface {
 		queryRequest transaction.Msg,
 	) (transaction.Msg, error)
 
+	// Init creates a new account of the given type, whose address is derived from the creator.
+	Init(
+		ctx context.Context,
+		accountType string,
+		creator []byte,
+		initRequest transaction.Msg,
+		funds sdk.Coins,
+		addressSeed []byte,
+	) (transaction.Msg, []byte, error)
+
 	// InitAccountNumberSeqUnsafe is use to set accounts module account number with value
 	// of auth module current account number
 	InitAccountNumberSeqUnsafe(ctx context.Context, currentAccNum uint64) error
@@ -39,5 +50,6 @@ type AccountsModKeeper interface {
 		accNum uint64, // The current account number
 		accType string, // The account type to migrate to
 		msg transaction.Msg, // The init msg of the account type we're migrating to
+		funds sdk.Coins, // The coins of the account exposed to the account initialization
 	) (transaction.Msg, error)
 }
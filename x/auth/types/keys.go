@@ -26,6 +26,12 @@ var (
 	// account number is stored.
 	GlobalAccountNumberKey = collections.NewPrefix(2)
 
+	// BaseFeeKey is the key of the base fee of the next block
+	BaseFeeKey = collections.NewPrefix(3)
+
+	// BlockGasWantedKey is the key of the gas wanted by the transactions of the current block
+	BlockGasWantedKey = collections.NewPrefix(4)
+
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
 )
@@ -2,6 +2,10 @@ package types
 
 import (
 	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Default parameter values
@@ -11,16 +15,26 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+
+	DefaultTargetBlockGas           uint64 = 15_000_000
+	DefaultBaseFeeChangeDenominator uint64 = 8
 )
 
-// NewParams creates a new Params object
+// DefaultMinBaseFee is the default lower bound of the base fee. It must be set
+// to a positive value when enabling the base fee.
+var DefaultMinBaseFee = math.LegacyZeroDec()
+
+// NewParams creates a new Params object, with the base fee disabled
 func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64) Params {
 	return Params{
-		MaxMemoCharacters:      maxMemoCharacters,
-		TxSigLimit:             txSigLimit,
-		TxSizeCostPerByte:      txSizeCostPerByte,
-		SigVerifyCostED25519:   sigVerifyCostED25519,
-		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		MaxMemoCharacters:        maxMemoCharacters,
+		TxSigLimit:               txSigLimit,
+		TxSizeCostPerByte:        txSizeCostPerByte,
+		SigVerifyCostED25519:     sigVerifyCostED25519,
+		SigVerifyCostSecp256k1:   sigVerifyCostSecp256k1,
+		MinBaseFee:               DefaultMinBaseFee,
+		TargetBlockGas:           DefaultTargetBlockGas,
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
 	}
 }
 
@@ -32,7 +46,34 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+
+		MinBaseFee:               DefaultMinBaseFee,
+		TargetBlockGas:           DefaultTargetBlockGas,
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
+	}
+}
+
+// BaseFeeEnabled returns true if transactions must pay the consensus base fee.
+func (p Params) BaseFeeEnabled() bool {
+	return p.BaseFeeDenom != ""
+}
+
+// IsFeeDenom returns true if transactions can pay their fees with the given denom in
+// place of the base fee denom.
+func (p Params) IsFeeDenom(denom string) bool {
+	_, found := p.GetFeeDenom(denom)
+	return found
+}
+
+// GetFeeDenom returns the allowed fee denom with the given denom, if any.
+func (p Params) GetFeeDenom(denom string) (FeeDenom, bool) {
+	for _, feeDenom := range p.FeeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom, true
+		}
 	}
+
+	return FeeDenom{}, false
 }
 
 // SigVerifyCostSecp256r1 returns gas fee of secp256r1 signature verification.
@@ -112,6 +153,100 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a zero min base fee would let the base fee get stuck at zero
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("invalid min base fee: %s", v)
+	}
+
+	return nil
+}
+
+func validateTargetBlockGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid target block gas: %d", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeChangeDenominator(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid base fee change denominator: %d", v)
+	}
+
+	return nil
+}
+
+// validateBaseFee checks the base fee parameters, which are only used when the base fee
+// is enabled.
+func validateBaseFee(p Params) error {
+	if !p.BaseFeeEnabled() {
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(p.BaseFeeDenom); err != nil {
+		return fmt.Errorf("invalid base fee denom: %w", err)
+	}
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+	if err := validateTargetBlockGas(p.TargetBlockGas); err != nil {
+		return err
+	}
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFeeDenoms checks the allowed fee denoms, which are priced in the base fee denom
+// and therefore require the base fee to be enabled.
+func validateFeeDenoms(p Params) error {
+	if len(p.FeeDenoms) == 0 {
+		return nil
+	}
+
+	if !p.BaseFeeEnabled() {
+		return fmt.Errorf("fee denoms require the base fee to be enabled")
+	}
+
+	seen := make(map[string]bool, len(p.FeeDenoms))
+	for _, feeDenom := range p.FeeDenoms {
+		if err := sdk.ValidateDenom(feeDenom.Denom); err != nil {
+			return fmt.Errorf("invalid fee denom: %w", err)
+		}
+		if feeDenom.Denom == p.BaseFeeDenom {
+			return fmt.Errorf("fee denom %s is the base fee denom", feeDenom.Denom)
+		}
+		if seen[feeDenom.Denom] {
+			return fmt.Errorf("duplicate fee denom: %s", feeDenom.Denom)
+		}
+		if feeDenom.Price.IsNil() || !feeDenom.Price.IsPositive() {
+			return fmt.Errorf("invalid price of fee denom %s: %s", feeDenom.Denom, feeDenom.Price)
+		}
+		seen[feeDenom.Denom] = true
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -129,6 +264,12 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validateBaseFee(p); err != nil {
+		return err
+	}
+	if err := validateFeeDenoms(p); err != nil {
+		return err
+	}
 
 	return nil
 }
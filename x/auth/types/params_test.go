@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -49,3 +51,77 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ValidateBaseFee(t *testing.T) {
+	withBaseFee := func(f func(p *types.Params)) types.Params {
+		p := types.DefaultParams()
+		p.BaseFeeDenom = "stake"
+		p.MinBaseFee = math.LegacyNewDecWithPrec(1, 3)
+		f(&p)
+		return p
+	}
+
+	tests := []struct {
+		name    string
+		params  types.Params
+		wantErr bool
+	}{
+		{"disabled base fee", withBaseFee(func(p *types.Params) { p.BaseFeeDenom = ""; p.MinBaseFee = math.LegacyDec{} }), false},
+		{"enabled base fee", withBaseFee(func(p *types.Params) {}), false},
+		{"base fee with recipient", withBaseFee(func(p *types.Params) { p.BaseFeeRecipient = "distribution" }), false},
+		{"invalid denom", withBaseFee(func(p *types.Params) { p.BaseFeeDenom = "1stake" }), true},
+		{"nil min base fee", withBaseFee(func(p *types.Params) { p.MinBaseFee = math.LegacyDec{} }), true},
+		{"zero min base fee", withBaseFee(func(p *types.Params) { p.MinBaseFee = math.LegacyZeroDec() }), true},
+		{"zero target block gas", withBaseFee(func(p *types.Params) { p.TargetBlockGas = 0 }), true},
+		{"zero change denominator", withBaseFee(func(p *types.Params) { p.BaseFeeChangeDenominator = 0 }), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_ValidateFeeDenoms(t *testing.T) {
+	withFeeDenoms := func(feeDenoms ...types.FeeDenom) types.Params {
+		p := types.DefaultParams()
+		p.BaseFeeDenom = "stake"
+		p.MinBaseFee = math.LegacyNewDecWithPrec(1, 3)
+		p.FeeDenoms = feeDenoms
+		return p
+	}
+	atom := types.FeeDenom{Denom: "atom", Price: math.LegacyNewDecWithPrec(25, 1)}
+
+	disabled := withFeeDenoms(atom)
+	disabled.BaseFeeDenom = ""
+
+	tests := []struct {
+		name    string
+		params  types.Params
+		wantErr bool
+	}{
+		{"no fee denoms", withFeeDenoms(), false},
+		{"fee denoms", withFeeDenoms(atom, types.FeeDenom{Denom: "photon", Price: math.LegacyOneDec()}), false},
+		{"disabled base fee", disabled, true},
+		{"invalid denom", withFeeDenoms(types.FeeDenom{Denom: "1atom", Price: math.LegacyOneDec()}), true},
+		{"base fee denom", withFeeDenoms(types.FeeDenom{Denom: "stake", Price: math.LegacyOneDec()}), true},
+		{"duplicate denom", withFeeDenoms(atom, atom), true},
+		{"nil price", withFeeDenoms(types.FeeDenom{Denom: "atom"}), true},
+		{"zero price", withFeeDenoms(types.FeeDenom{Denom: "atom", Price: math.LegacyZeroDec()}), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
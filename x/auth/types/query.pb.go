@@ -7,7 +7,9 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -932,6 +934,90 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
+// QueryBaseFeeRequest is the request type for the Query/BaseFee RPC method.
+type QueryBaseFeeRequest struct {
+}
+
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{20}
+}
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBaseFeeResponse is the response type for the Query/BaseFee RPC method.
+type QueryBaseFeeResponse struct {
+	// base_fee is the base fee per unit of gas. It is empty if the base fee is
+	// disabled.
+	BaseFee types.DecCoin `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3" json:"base_fee"`
+}
+
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{21}
+}
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeResponse) GetBaseFee() types.DecCoin {
+	if m != nil {
+		return m.BaseFee
+	}
+	return types.DecCoin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -953,84 +1039,92 @@ func init() {
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
 	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
 	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "cosmos.auth.v1beta1.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.auth.v1beta1.QueryBaseFeeResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1148 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xa6, 0xa1, 0x49, 0x5f, 0xd2, 0x54, 0x8c, 0x5d, 0x11, 0xd6, 0xa9, 0x6d, 0x6d, 0x21,
-	0x71, 0x42, 0xbd, 0x5b, 0x3b, 0x6e, 0x0a, 0x91, 0x38, 0x64, 0x41, 0xad, 0x22, 0x04, 0x72, 0xb7,
-	0x15, 0x94, 0x5e, 0xac, 0xb5, 0xbd, 0x76, 0x56, 0xd4, 0xbb, 0xae, 0x77, 0x8d, 0x6a, 0x22, 0x0b,
-	0x84, 0x84, 0xd4, 0x03, 0x07, 0x24, 0xb8, 0x71, 0xc9, 0x9f, 0xc0, 0xc1, 0x12, 0x07, 0xb8, 0xc1,
-	0xa1, 0xe4, 0x54, 0x85, 0x0b, 0xe2, 0x80, 0x50, 0x82, 0x04, 0x7f, 0x06, 0xf2, 0xcc, 0xdb, 0x1f,
-	0x8e, 0xc7, 0xf6, 0xa6, 0xbd, 0xed, 0xce, 0xbc, 0xf7, 0xbd, 0xef, 0x7b, 0xf3, 0xe6, 0xbd, 0x81,
-	0x74, 0xd5, 0x76, 0x9a, 0xb6, 0xa3, 0xe8, 0x1d, 0x77, 0x4f, 0xf9, 0x34, 0x5f, 0x31, 0x5c, 0x3d,
-	0xaf, 0x3c, 0xea, 0x18, 0xed, 0xae, 0xdc, 0x6a, 0xdb, 0xae, 0x4d, 0xe2, 0xcc, 0x40, 0x1e, 0x18,
-	0xc8, 0x68, 0x20, 0x6e, 0xa0, 0x57, 0x45, 0x77, 0x0c, 0x66, 0xed, 0xfb, 0xb6, 0xf4, 0x86, 0x69,
-	0xe9, 0xae, 0x69, 0x5b, 0x0c, 0x40, 0x4c, 0x34, 0xec, 0x86, 0x4d, 0x3f, 0x95, 0xc1, 0x17, 0xae,
-	0xbe, 0xda, 0xb0, 0xed, 0xc6, 0x43, 0x43, 0xa1, 0x7f, 0x95, 0x4e, 0x5d, 0xd1, 0x2d, 0x8c, 0x28,
-	0xae, 0xe0, 0x96, 0xde, 0x32, 0x15, 0xdd, 0xb2, 0x6c, 0x97, 0xa2, 0x39, 0xb8, 0x9b, 0xe2, 0x11,
-	0xa6, 0xe4, 0x10, 0x98, 0xed, 0x97, 0x59, 0x44, 0x24, 0xcf, 0xb6, 0x92, 0xe8, 0xea, 0x11, 0x0e,
-	0xeb, 0x94, 0x1c, 0x48, 0xdc, 0x19, 0xfc, 0xee, 0x54, 0xab, 0x76, 0xc7, 0x72, 0x1d, 0xcd, 0x78,
-	0xd4, 0x31, 0x1c, 0x97, 0xdc, 0x02, 0x08, 0x24, 0x2d, 0x0b, 0x19, 0x21, 0xbb, 0x50, 0x58, 0x95,
-	0x11, 0x77, 0xa0, 0x5f, 0x66, 0x28, 0x48, 0x45, 0x2e, 0xe9, 0x0d, 0x03, 0x7d, 0xb5, 0x90, 0xe7,
-	0x76, 0xfc, 0xa8, 0x9f, 0xbb, 0xc4, 0xdc, 0x72, 0x4e, 0xed, 0x93, 0xcc, 0x75, 0xb9, 0xb8, 0x29,
-	0xfd, 0x26, 0xc0, 0xe5, 0x53, 0x51, 0x9d, 0x96, 0x6d, 0x39, 0x06, 0xd1, 0x60, 0x5e, 0xc7, 0xb5,
-	0x65, 0x21, 0x73, 0x2e, 0xbb, 0x50, 0x48, 0xc8, 0x2c, 0x2f, 0xb2, 0x97, 0x32, 0x79, 0xc7, 0xea,
-	0xaa, 0x99, 0xc3, 0x7e, 0x6e, 0x85, 0x73, 0x44, 0x32, 0x22, 0xee, 0x6a, 0x3e, 0x0e, 0xb9, 0x3d,
-	0x24, 0x65, 0x86, 0x4a, 0x59, 0x9b, 0x2a, 0x85, 0x11, 0x9a, 0xa6, 0xe5, 0xa6, 0x74, 0x17, 0xe2,
-	0x61, 0x29, 0x5e, 0xfe, 0x0a, 0x30, 0xa7, 0xd7, 0x6a, 0x6d, 0xc3, 0x71, 0x68, 0xf2, 0x2e, 0xa8,
-	0xcb, 0x47, 0xfd, 0x5c, 0x02, 0x83, 0xee, 0xb0, 0x9d, 0xbb, 0x6e, 0xdb, 0xb4, 0x1a, 0x9a, 0x67,
-	0xb8, 0x3d, 0xff, 0xe4, 0x20, 0x1d, 0xfb, 0xef, 0x20, 0x1d, 0x93, 0xf6, 0x86, 0x4f, 0xc5, 0x4f,
-	0x4f, 0x09, 0xe6, 0x50, 0x16, 0x1e, 0xc9, 0xf3, 0x66, 0xc7, 0x83, 0x91, 0x12, 0x40, 0x68, 0xa4,
-	0x92, 0xde, 0xd6, 0x9b, 0xde, 0xe9, 0x4b, 0x25, 0x14, 0xe5, 0xad, 0x62, 0xf8, 0xb7, 0xe0, 0x7c,
-	0x8b, 0xae, 0x60, 0xf4, 0xa4, 0xcc, 0x0b, 0xc2, 0x9c, 0xd4, 0xd9, 0xa7, 0x7f, 0xa5, 0x63, 0x1a,
-	0x3a, 0x48, 0x79, 0x10, 0x29, 0xe2, 0xfb, 0x76, 0xad, 0xf3, 0xd0, 0x38, 0x55, 0x6d, 0xbc, 0xcc,
-	0x6e, 0x49, 0x5f, 0x0b, 0x90, 0xe4, 0xfa, 0x20, 0x9b, 0xfb, 0x11, 0x6b, 0x65, 0xf5, 0xb0, 0x9f,
-	0x93, 0x78, 0x44, 0x87, 0x70, 0x43, 0x15, 0xc3, 0xa7, 0x73, 0x03, 0xd2, 0xa3, 0x6c, 0xd4, 0xee,
-	0x07, 0x7a, 0xd3, 0x2b, 0x7c, 0x42, 0x60, 0xd6, 0xd2, 0x9b, 0x06, 0x3b, 0x71, 0x8d, 0x7e, 0x4b,
-	0x9f, 0x41, 0x66, 0xbc, 0x1b, 0x2a, 0xf9, 0x30, 0xda, 0xb1, 0x46, 0x15, 0xe2, 0x1f, 0xee, 0x06,
-	0xc4, 0x55, 0xa3, 0xba, 0xb7, 0x59, 0x28, 0xb5, 0x8d, 0xba, 0xf9, 0x78, 0x62, 0xb6, 0x4b, 0x90,
-	0x18, 0xb6, 0x45, 0x6e, 0x57, 0xe1, 0x62, 0x85, 0xae, 0x97, 0x5b, 0x74, 0x03, 0xc5, 0x2d, 0x56,
-	0x42, 0xc6, 0x7c, 0xc4, 0x8f, 0x20, 0x89, 0x85, 0xae, 0x76, 0x5d, 0xc3, 0xb9, 0x67, 0x63, 0xbd,
-	0x63, 0xb2, 0xae, 0xc2, 0x45, 0x2c, 0xfc, 0x72, 0x65, 0xb0, 0x4f, 0x81, 0x17, 0xb5, 0x45, 0x3d,
-	0xe4, 0xc3, 0x07, 0x7e, 0x00, 0x2b, 0x7c, 0x60, 0xa4, 0xfc, 0x3a, 0x2c, 0x79, 0xc8, 0x0e, 0xdd,
-	0x41, 0xce, 0x5e, 0x3c, 0x66, 0xce, 0xc7, 0xfe, 0xd8, 0x27, 0xcd, 0xac, 0xee, 0xd9, 0x34, 0x86,
-	0x47, 0xfa, 0x45, 0xa0, 0xef, 0xfb, 0xb4, 0x4f, 0x41, 0x07, 0x99, 0x7e, 0xce, 0x84, 0x7c, 0x0e,
-	0xa9, 0x70, 0xbb, 0xf0, 0x93, 0xb3, 0xfb, 0x6e, 0x50, 0x99, 0x33, 0x66, 0x8d, 0x02, 0x9e, 0x53,
-	0x67, 0x96, 0x05, 0x6d, 0xc6, 0xac, 0x91, 0x02, 0x00, 0x16, 0x4a, 0xd9, 0xac, 0xd1, 0xbe, 0x38,
-	0xab, 0xc6, 0xff, 0x1c, 0x6d, 0x71, 0xda, 0x05, 0x34, 0xdb, 0xad, 0x6d, 0x5f, 0x3e, 0xea, 0xe7,
-	0x5e, 0x3e, 0x15, 0x5e, 0x2e, 0x48, 0xfb, 0x78, 0x37, 0x78, 0x04, 0x50, 0xdd, 0x0e, 0x5c, 0xf2,
-	0xa2, 0x45, 0x6d, 0x8c, 0x4b, 0xfa, 0x10, 0xdc, 0xb8, 0xe0, 0x15, 0x78, 0x25, 0x1c, 0x7c, 0xd7,
-	0xaa, 0xdb, 0x2f, 0xd2, 0x85, 0xb9, 0x5d, 0xde, 0x80, 0xe5, 0xd1, 0x18, 0xa8, 0xac, 0x08, 0xb3,
-	0xa6, 0x55, 0xb7, 0xf1, 0xea, 0x66, 0xb8, 0x3d, 0x51, 0xd5, 0x1d, 0xef, 0x7e, 0x6a, 0xd4, 0x9a,
-	0x1b, 0xa6, 0xf0, 0xc5, 0x12, 0xbc, 0x44, 0xe3, 0x90, 0x03, 0x01, 0xe6, 0xbd, 0x8e, 0x47, 0xd6,
-	0xb9, 0x98, 0xbc, 0xb9, 0x2d, 0x6e, 0x44, 0x31, 0x65, 0xc4, 0xa5, 0xb7, 0x0f, 0x47, 0x67, 0xf3,
-	0x93, 0x7f, 0x7f, 0xd8, 0x10, 0xbe, 0xfc, 0xfd, 0x9f, 0x6f, 0x67, 0xd2, 0xe4, 0x8a, 0xc2, 0x7d,
-	0x74, 0x78, 0xac, 0xbe, 0x13, 0x60, 0x0e, 0x31, 0x49, 0x76, 0x6a, 0x58, 0x8f, 0xe0, 0x7a, 0x04,
-	0x4b, 0xe4, 0x57, 0x0c, 0xc8, 0xac, 0x93, 0xb5, 0x89, 0x64, 0x94, 0x7d, 0x3c, 0xbe, 0x1e, 0x39,
-	0x12, 0x80, 0x8c, 0xd6, 0x21, 0xd9, 0x9c, 0x1a, 0x77, 0xf4, 0xda, 0x88, 0xc5, 0xb3, 0x39, 0x21,
-	0xef, 0x3b, 0x87, 0xbc, 0x3a, 0x0d, 0xc4, 0xe4, 0x89, 0xc2, 0x17, 0xe3, 0xdf, 0xfe, 0xb2, 0x59,
-	0x53, 0xf6, 0x83, 0xcb, 0xd9, 0x23, 0x5f, 0x09, 0x70, 0x9e, 0xcd, 0x55, 0xb2, 0x36, 0x9e, 0xd3,
-	0xd0, 0x10, 0x17, 0xb3, 0xd3, 0x0d, 0x91, 0x70, 0x36, 0xe0, 0x76, 0x85, 0x24, 0xb9, 0xdc, 0xd8,
-	0x18, 0x27, 0x3f, 0x09, 0xb0, 0x34, 0x3c, 0x8e, 0x89, 0x32, 0x3e, 0x0c, 0x77, 0xd8, 0x8b, 0xd7,
-	0xa3, 0x3b, 0x20, 0xbf, 0x5b, 0x53, 0x12, 0xba, 0x4a, 0x5e, 0xe3, 0x92, 0x6e, 0x52, 0xb8, 0xb2,
-	0x5f, 0xb1, 0x3f, 0x0b, 0x10, 0xe7, 0xcc, 0x61, 0x52, 0x8c, 0xc8, 0x68, 0x68, 0xda, 0x8b, 0x37,
-	0xce, 0xe8, 0x85, 0x62, 0xde, 0x0c, 0x78, 0xe7, 0xc8, 0x1b, 0x51, 0x78, 0x2b, 0xfb, 0x83, 0x97,
-	0x44, 0x8f, 0x7c, 0x2f, 0xc0, 0x62, 0x78, 0x46, 0x8f, 0xb9, 0x75, 0x9c, 0x91, 0x3f, 0xe6, 0xd6,
-	0xf1, 0x06, 0xbe, 0xb4, 0x35, 0xda, 0x15, 0xb6, 0x26, 0x96, 0x06, 0x7b, 0x09, 0x90, 0x5f, 0x04,
-	0x48, 0xf0, 0xc6, 0x32, 0xe1, 0x9f, 0xf7, 0x84, 0xa7, 0x81, 0x98, 0x3f, 0x83, 0x47, 0xa8, 0x44,
-	0xb8, 0xac, 0xc7, 0xe5, 0x98, 0xb1, 0xf6, 0xfb, 0x06, 0x1b, 0xb9, 0x3d, 0xf2, 0x6b, 0xa0, 0x62,
-	0x68, 0x4a, 0x4f, 0x56, 0xc1, 0x7b, 0x2b, 0x4c, 0x56, 0xc1, 0x7d, 0x02, 0x48, 0xb7, 0xc7, 0xa9,
-	0x90, 0xc9, 0xb5, 0x48, 0x2a, 0xd8, 0xa3, 0xa4, 0x47, 0x7e, 0x14, 0x60, 0x21, 0x34, 0xab, 0xc8,
-	0xb5, 0xa9, 0x8d, 0x2c, 0x34, 0x36, 0xc5, 0x5c, 0x44, 0x6b, 0x64, 0xfd, 0xde, 0x28, 0xeb, 0x9b,
-	0xd3, 0x8b, 0xdc, 0x6f, 0x6f, 0x56, 0xdd, 0x0e, 0xda, 0xb7, 0xfa, 0xce, 0xd3, 0xe3, 0x94, 0xf0,
-	0xec, 0x38, 0x25, 0xfc, 0x7d, 0x9c, 0x12, 0xbe, 0x39, 0x49, 0xc5, 0x9e, 0x9d, 0xa4, 0x62, 0x7f,
-	0x9c, 0xa4, 0x62, 0x0f, 0xd6, 0x1b, 0xa6, 0xbb, 0xd7, 0xa9, 0xc8, 0x55, 0xbb, 0xe9, 0x01, 0x06,
-	0x41, 0x95, 0xc7, 0x0c, 0xdd, 0xed, 0xb6, 0x0c, 0xa7, 0x72, 0x9e, 0xbe, 0x9b, 0x37, 0xff, 0x0f,
-	0x00, 0x00, 0xff, 0xff, 0xa8, 0x40, 0x30, 0xd4, 0xe7, 0x0f, 0x00, 0x00,
+	// 1247 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xa6, 0xf9, 0xe6, 0xc7, 0x24, 0x4d, 0xd4, 0xb1, 0xa3, 0x6f, 0xd8, 0xa4, 0xb6, 0xb5,
+	0x85, 0xfc, 0xa2, 0xde, 0x6d, 0x9c, 0x34, 0x85, 0x48, 0x1c, 0xb2, 0xad, 0x52, 0x45, 0x08, 0xe4,
+	0x6e, 0x2b, 0x28, 0xb9, 0x58, 0x6b, 0x7b, 0xed, 0xac, 0xa8, 0x77, 0x5c, 0xef, 0x1a, 0x35, 0x44,
+	0x11, 0x12, 0x12, 0x52, 0x85, 0x40, 0x42, 0x82, 0x1b, 0x97, 0x1c, 0x39, 0x72, 0x88, 0xc4, 0x01,
+	0x6e, 0x70, 0x28, 0x39, 0x55, 0xe1, 0x82, 0x38, 0x20, 0x94, 0x20, 0xc1, 0x9f, 0x81, 0x3c, 0xf3,
+	0x66, 0x7f, 0xd8, 0x63, 0x7b, 0xd3, 0x5e, 0x2a, 0x77, 0xe7, 0xbd, 0xcf, 0xfb, 0x7c, 0xde, 0xbc,
+	0x79, 0xef, 0x05, 0x65, 0xca, 0xc4, 0xad, 0x13, 0x57, 0x33, 0x5b, 0xde, 0x9e, 0xf6, 0xd1, 0x6a,
+	0xc9, 0xf2, 0xcc, 0x55, 0xed, 0x71, 0xcb, 0x6a, 0xee, 0xab, 0x8d, 0x26, 0xf1, 0x08, 0x4e, 0x32,
+	0x03, 0xb5, 0x6d, 0xa0, 0x82, 0x81, 0xbc, 0x02, 0x5e, 0x25, 0xd3, 0xb5, 0x98, 0xb5, 0xef, 0xdb,
+	0x30, 0x6b, 0xb6, 0x63, 0x7a, 0x36, 0x71, 0x18, 0x80, 0x9c, 0xaa, 0x91, 0x1a, 0xa1, 0x3f, 0xb5,
+	0xf6, 0x2f, 0xf8, 0xfa, 0x4a, 0x8d, 0x90, 0xda, 0x23, 0x4b, 0xa3, 0xff, 0x2b, 0xb5, 0xaa, 0x9a,
+	0xe9, 0x40, 0x44, 0x79, 0x1e, 0x8e, 0xcc, 0x86, 0xad, 0x99, 0x8e, 0x43, 0x3c, 0x8a, 0xe6, 0xc2,
+	0x69, 0x5a, 0x44, 0x98, 0x92, 0x03, 0x60, 0x76, 0x5e, 0x64, 0x11, 0x81, 0x3c, 0x3b, 0x9a, 0x03,
+	0x57, 0x4e, 0x38, 0xac, 0xd3, 0xc7, 0xa5, 0x92, 0x38, 0x6e, 0x99, 0xd8, 0x5c, 0xc6, 0x15, 0xb3,
+	0x6e, 0x3b, 0x44, 0xa3, 0xff, 0xb2, 0x4f, 0x8a, 0x8b, 0x52, 0xf7, 0xda, 0x08, 0x5b, 0xe5, 0x32,
+	0x69, 0x39, 0x9e, 0x6b, 0x58, 0x8f, 0x5b, 0x96, 0xeb, 0xe1, 0x6d, 0x84, 0x82, 0x2c, 0xcc, 0x4a,
+	0x59, 0x69, 0x69, 0x22, 0xbf, 0xa0, 0x02, 0x95, 0x36, 0xbe, 0xca, 0x02, 0x43, 0x14, 0xb5, 0x60,
+	0xd6, 0x2c, 0xf0, 0x35, 0x42, 0x9e, 0x9b, 0xc9, 0xd3, 0xe3, 0xdc, 0x34, 0x73, 0xcb, 0xb9, 0x95,
+	0x0f, 0xb3, 0x37, 0xd4, 0xf5, 0x35, 0xe5, 0x57, 0x09, 0xcd, 0x74, 0x44, 0x75, 0x1b, 0xc4, 0x71,
+	0x2d, 0x6c, 0xa0, 0x31, 0x13, 0xbe, 0xcd, 0x4a, 0xd9, 0x4b, 0x4b, 0x13, 0xf9, 0x94, 0xca, 0x52,
+	0xa9, 0xf2, 0x2c, 0xab, 0x5b, 0xce, 0xbe, 0x9e, 0x3d, 0x39, 0xce, 0xcd, 0x0b, 0x6e, 0x55, 0x05,
+	0xc4, 0x1d, 0xc3, 0xc7, 0xc1, 0x77, 0x23, 0x52, 0x86, 0xa8, 0x94, 0xc5, 0x81, 0x52, 0x18, 0xa1,
+	0x41, 0x5a, 0x6e, 0x29, 0xf7, 0x51, 0x32, 0x2c, 0x85, 0xe7, 0x2f, 0x8f, 0x46, 0xcd, 0x4a, 0xa5,
+	0x69, 0xb9, 0x2e, 0x4d, 0xde, 0xb8, 0x3e, 0x7b, 0x7a, 0x9c, 0x4b, 0x41, 0xd0, 0x2d, 0x76, 0x72,
+	0xdf, 0x6b, 0xda, 0x4e, 0xcd, 0xe0, 0x86, 0x9b, 0x63, 0x4f, 0x8f, 0x32, 0x89, 0x7f, 0x8f, 0x32,
+	0x09, 0x65, 0x2f, 0x7a, 0x2b, 0x7e, 0x7a, 0x0a, 0x68, 0x14, 0x64, 0xc1, 0x95, 0xbc, 0x68, 0x76,
+	0x38, 0x8c, 0x92, 0x42, 0x98, 0x46, 0x2a, 0x98, 0x4d, 0xb3, 0xce, 0x6f, 0x5f, 0x29, 0x80, 0x28,
+	0xfe, 0x15, 0xc2, 0xbf, 0x89, 0x46, 0x1a, 0xf4, 0x0b, 0x44, 0x9f, 0x53, 0x45, 0x41, 0x98, 0x93,
+	0x3e, 0xfc, 0xec, 0xcf, 0x4c, 0xc2, 0x00, 0x07, 0x65, 0x15, 0xc9, 0x14, 0xf1, 0x1d, 0x52, 0x69,
+	0x3d, 0xb2, 0x3a, 0xaa, 0x4d, 0x94, 0xd9, 0x0d, 0xe5, 0x0b, 0x09, 0xcd, 0x09, 0x7d, 0x80, 0xcd,
+	0xc3, 0x98, 0xb5, 0xb2, 0x70, 0x72, 0x9c, 0x53, 0x44, 0x44, 0x23, 0xb8, 0xa1, 0x8a, 0x11, 0xd3,
+	0xb9, 0x89, 0x32, 0xdd, 0x6c, 0xf4, 0xfd, 0x77, 0xcd, 0x3a, 0x2f, 0x7c, 0x8c, 0xd1, 0xb0, 0x63,
+	0xd6, 0x2d, 0x76, 0xe3, 0x06, 0xfd, 0xad, 0x7c, 0x8c, 0xb2, 0xbd, 0xdd, 0x40, 0xc9, 0x7b, 0xf1,
+	0xae, 0x35, 0xae, 0x10, 0xff, 0x72, 0x57, 0x50, 0x52, 0xb7, 0xca, 0x7b, 0x6b, 0xf9, 0x42, 0xd3,
+	0xaa, 0xda, 0x4f, 0xfa, 0x66, 0xbb, 0x80, 0x52, 0x51, 0x5b, 0xe0, 0x76, 0x0d, 0x5d, 0x2e, 0xd1,
+	0xef, 0xc5, 0x06, 0x3d, 0x00, 0x71, 0x93, 0xa5, 0x90, 0xb1, 0x18, 0xf1, 0x7d, 0x34, 0x07, 0x85,
+	0xae, 0xef, 0x7b, 0x96, 0xfb, 0x80, 0x40, 0xbd, 0x43, 0xb2, 0xae, 0xa1, 0xcb, 0x50, 0xf8, 0xc5,
+	0x52, 0xfb, 0x9c, 0x02, 0x4f, 0x1a, 0x93, 0x66, 0xc8, 0x47, 0x0c, 0xbc, 0x8b, 0xe6, 0xc5, 0xc0,
+	0x40, 0xf9, 0x35, 0x34, 0xc5, 0x91, 0x5d, 0x7a, 0x02, 0x9c, 0x79, 0x3c, 0x66, 0x2e, 0xc6, 0xfe,
+	0xc0, 0x27, 0xcd, 0xac, 0x1e, 0x10, 0x1a, 0x83, 0x93, 0x7e, 0x19, 0xe8, 0x87, 0x3e, 0xed, 0x0e,
+	0xe8, 0x20, 0xd3, 0x2f, 0x98, 0x90, 0x4f, 0x50, 0x3a, 0xdc, 0x2e, 0xfc, 0xe4, 0xec, 0xdc, 0x09,
+	0x2a, 0x73, 0xc8, 0xae, 0x50, 0xc0, 0x4b, 0xfa, 0xd0, 0xac, 0x64, 0x0c, 0xd9, 0x15, 0x9c, 0x47,
+	0x08, 0x0a, 0xa5, 0x68, 0x57, 0x68, 0x5f, 0x1c, 0xd6, 0x93, 0x7f, 0x74, 0xb7, 0x38, 0x63, 0x1c,
+	0xcc, 0x76, 0x2a, 0x9b, 0x33, 0xa7, 0xc7, 0xb9, 0x2b, 0x1d, 0xe1, 0xd5, 0xbc, 0x72, 0x00, 0x6f,
+	0x43, 0x44, 0x00, 0xd4, 0x6d, 0xa1, 0x69, 0x1e, 0x2d, 0x6e, 0x63, 0x9c, 0x32, 0x23, 0x70, 0xbd,
+	0x82, 0x97, 0xd0, 0xff, 0xc3, 0xc1, 0x77, 0x9c, 0x2a, 0x79, 0x99, 0x2e, 0x2c, 0xec, 0xf2, 0x16,
+	0x9a, 0xed, 0x8e, 0x01, 0xca, 0xd6, 0xd1, 0xb0, 0xed, 0x54, 0x09, 0x3c, 0xdd, 0xac, 0xb0, 0x27,
+	0xea, 0xa6, 0xcb, 0xdf, 0xa7, 0x41, 0xad, 0xc5, 0x61, 0x66, 0xa0, 0xef, 0xb6, 0xcd, 0xb7, 0x2d,
+	0xde, 0x57, 0x94, 0x5d, 0x18, 0x07, 0xfe, 0x67, 0x88, 0xac, 0xa3, 0xb1, 0xf6, 0xfc, 0x2a, 0x56,
+	0x2d, 0x0b, 0xa2, 0xcf, 0x47, 0xe6, 0x1a, 0x8f, 0x7e, 0xc7, 0x2a, 0xdf, 0x26, 0xb6, 0xa3, 0x8f,
+	0xb7, 0x5b, 0xf2, 0x77, 0xff, 0x7c, 0xbf, 0x22, 0x19, 0xa3, 0x25, 0x86, 0x95, 0xff, 0x72, 0x1a,
+	0xfd, 0x8f, 0x82, 0xe3, 0x23, 0x09, 0x8d, 0xf1, 0x26, 0x8b, 0x97, 0x85, 0x32, 0x44, 0xab, 0x82,
+	0xbc, 0x12, 0xc7, 0x94, 0x31, 0x56, 0xde, 0x3a, 0xe9, 0x5e, 0x07, 0x9e, 0xb6, 0x09, 0x7d, 0xfa,
+	0xdb, 0xdf, 0x5f, 0x0f, 0x65, 0xf0, 0x55, 0x4d, 0xb8, 0x1a, 0x71, 0x56, 0xdf, 0x48, 0x68, 0x14,
+	0x30, 0xf1, 0xd2, 0xc0, 0xb0, 0x9c, 0xe0, 0x72, 0x0c, 0x4b, 0xe0, 0xb7, 0x1e, 0x90, 0x59, 0xc6,
+	0x8b, 0x7d, 0xc9, 0x68, 0x07, 0x50, 0x31, 0x87, 0xf8, 0x54, 0x42, 0xb8, 0xbb, 0xf4, 0xf1, 0xda,
+	0xc0, 0xb8, 0xdd, 0x2f, 0x55, 0x5e, 0xbf, 0x98, 0x13, 0xf0, 0xbe, 0x77, 0x22, 0x7a, 0x1a, 0x81,
+	0x98, 0x55, 0xac, 0x89, 0xc5, 0xf8, 0x0d, 0xa7, 0x68, 0x57, 0xb4, 0x83, 0xa0, 0x1f, 0x1c, 0xe2,
+	0xcf, 0x24, 0x34, 0xc2, 0x46, 0x39, 0x5e, 0xec, 0xcd, 0x29, 0xb2, 0x37, 0xc8, 0x4b, 0x83, 0x0d,
+	0x81, 0xf0, 0x52, 0xc0, 0xed, 0x2a, 0x9e, 0x13, 0x72, 0x63, 0x9b, 0x03, 0xfe, 0x51, 0x42, 0x53,
+	0xd1, 0x0d, 0x00, 0x6b, 0xbd, 0xc3, 0x08, 0xf7, 0x0b, 0xf9, 0x46, 0x7c, 0x07, 0xe0, 0xb7, 0x3d,
+	0x20, 0xa1, 0x0b, 0xf8, 0x55, 0x21, 0xe9, 0x3a, 0x85, 0x2b, 0xfa, 0x15, 0xfb, 0x93, 0x84, 0x92,
+	0x82, 0xd1, 0x8f, 0xd7, 0x63, 0x32, 0x8a, 0x2c, 0x18, 0xf2, 0xcd, 0x0b, 0x7a, 0x81, 0x98, 0x37,
+	0x02, 0xde, 0x39, 0xfc, 0x7a, 0x1c, 0xde, 0xda, 0x41, 0x7b, 0x79, 0x39, 0xc4, 0xdf, 0x4a, 0x68,
+	0x32, 0xbc, 0x16, 0xf4, 0x78, 0x75, 0x82, 0x2d, 0xa3, 0xc7, 0xab, 0x13, 0xed, 0x18, 0xca, 0x46,
+	0x77, 0x57, 0xd8, 0xe8, 0x5b, 0x1a, 0x6c, 0xf9, 0xc0, 0x3f, 0x4b, 0x28, 0x25, 0xda, 0x04, 0xb0,
+	0xf8, 0xbe, 0xfb, 0x6c, 0x23, 0xf2, 0xea, 0x05, 0x3c, 0x42, 0x25, 0x22, 0x64, 0xdd, 0x2b, 0xc7,
+	0x8c, 0xb5, 0xdf, 0x37, 0xd8, 0x94, 0x3f, 0xc4, 0xbf, 0x04, 0x2a, 0x22, 0x8b, 0x41, 0x7f, 0x15,
+	0xa2, 0xf5, 0xa4, 0xbf, 0x0a, 0xe1, 0xd6, 0xa1, 0xdc, 0xed, 0xa5, 0x42, 0xc5, 0xd7, 0x63, 0xa9,
+	0x60, 0x7b, 0xd0, 0x21, 0xfe, 0x41, 0x42, 0x13, 0xa1, 0xf1, 0x88, 0xaf, 0x0f, 0x6c, 0x64, 0xa1,
+	0x49, 0x2d, 0xe7, 0x62, 0x5a, 0x03, 0xeb, 0xb7, 0xbb, 0x59, 0xdf, 0x1a, 0x5c, 0xe4, 0x7e, 0x7b,
+	0x73, 0xaa, 0x24, 0xd4, 0xbe, 0x3f, 0x97, 0xd0, 0x28, 0x8c, 0xd6, 0x7e, 0x53, 0x25, 0x3a, 0x94,
+	0xfb, 0x4d, 0x95, 0x8e, 0x39, 0xad, 0xac, 0x0c, 0x1e, 0x71, 0x7c, 0x8e, 0xeb, 0xb7, 0x9f, 0x9d,
+	0xa5, 0xa5, 0xe7, 0x67, 0x69, 0xe9, 0xaf, 0xb3, 0xb4, 0xf4, 0xd5, 0x79, 0x3a, 0xf1, 0xfc, 0x3c,
+	0x9d, 0xf8, 0xfd, 0x3c, 0x9d, 0xd8, 0x5d, 0xae, 0xd9, 0xde, 0x5e, 0xab, 0xa4, 0x96, 0x49, 0x9d,
+	0x43, 0x04, 0x19, 0xd0, 0x9e, 0x30, 0x3c, 0x6f, 0xbf, 0x61, 0xb9, 0xa5, 0x11, 0xfa, 0x77, 0xc3,
+	0xda, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x60, 0xe5, 0x02, 0xc6, 0x1a, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1067,6 +1161,9 @@ type QueryClient interface {
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// BaseFee queries the consensus base fee, the minimum gas price of the
+	// transactions of the next block.
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -1167,6 +1264,15 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts.
@@ -1191,6 +1297,9 @@ type QueryServer interface {
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// BaseFee queries the consensus base fee, the minimum gas price of the
+	// transactions of the next block.
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1227,6 +1336,9 @@ func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *
 func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1412,6 +1524,24 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
@@ -1457,6 +1587,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -2093,6 +2227,62 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BaseFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2360,6 +2550,26 @@ func (m *QueryAccountInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3977,6 +4187,139 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
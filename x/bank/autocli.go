@@ -87,7 +87,29 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms", Varargs: true}},
 				},
+				{
+					RpcMethod:      "TransferPolicy",
+					Use:            "transfer-policy <denom>",
+					Short:          "Query the transfer policy of a given coin denomination",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "TransferPolicies",
+					Use:       "transfer-policies",
+					Short:     "Query the transfer policies of all the restricted coin denominations",
+				},
+				{
+					RpcMethod:      "TransferList",
+					Use:            "transfer-list <denom>",
+					Short:          "Query the addresses in the transfer list of a given coin denomination",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "BalancesByAddresses",
+					Skip:      true, // skipped because of the custom command supporting proofs
+				},
 			},
+			EnhanceCustomCommand: true,
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service:              bankv1beta1.Msg_ServiceDesc.ServiceName,
@@ -128,6 +150,21 @@ Note: multiple coins can be send by space separated.`,
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "SetTransferPolicy",
+					Use:            "set-transfer-policy-proposal <policy>",
+					Short:          "Submit a proposal to set/update/delete the transfer policy of a denom",
+					Example:        fmt.Sprintf(`%s tx bank set-transfer-policy-proposal '{"denom":"stake","mode":"TRANSFER_LIST_MODE_ALLOWLIST","manager":"cosmos1..."}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "policy"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UpdateTransferList",
+					Use:            "update-transfer-list <sender> <denom>",
+					Short:          "Add and remove addresses from the transfer list of a denom.",
+					Long:           "Add and remove addresses from the transfer list of a denom. The sender must be the manager of the transfer policy of the denom or the module authority.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}, {ProtoField: "denom"}},
+				},
 			},
 		},
 	}
@@ -0,0 +1,226 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtcrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+var (
+	FlagDenom = "denom"
+	FlagProve = "prove"
+)
+
+// BalanceProof is the balance of an address at a given height, along with the ICS-23 proof
+// of the balance against the app hash of that height.
+type BalanceProof struct {
+	Address string              `json:"address"`
+	Balance sdk.Coin            `json:"balance"`
+	Height  int64               `json:"height"`
+	Proof   *cmtcrypto.ProofOps `json:"proof"`
+}
+
+// NewQueryCmd returns a root CLI command handler for all x/bank query commands.
+func NewQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the bank module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		NewBalancesByAddressesCmd(),
+	)
+
+	return queryCmd
+}
+
+// NewBalancesByAddressesCmd returns a CLI command handler querying the balances of several addresses,
+// optionally at a past height and with the verified ICS-23 proofs of the balances.
+func NewBalancesByAddressesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances-by-addresses <address_1> <address_2>...",
+		Short: "Query the balances of several accounts in one call, optionally with their proofs.",
+		Long: `Query the balances of several accounts in one call, optionally with their proofs.
+Use the --height flag to query the balances at a past height, and the --denom flag to only query
+the balances of a denom.
+
+With the --prove flag, the balances of --denom at --height are read from the bank store of the
+queried node along with their ICS-23 proofs, which are verified against the app hash committed in
+the header of the block <height>+1 before being printed. gRPC queries cannot return store proofs,
+so each proven balance is a separate ABCI store query.
+
+The node must still have the state of the queried height: pruned heights can only be queried from
+an archival node.`,
+		Example: fmt.Sprintf("%s query bank balances-by-addresses cosmos1... cosmos1... --denom stake --height 1000 --prove", version.AppName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			prove, err := cmd.Flags().GetBool(FlagProve)
+			if err != nil {
+				return err
+			}
+
+			if !prove {
+				queryClient := types.NewQueryClient(clientCtx)
+				res, err := queryClient.BalancesByAddresses(cmd.Context(), &types.QueryBalancesByAddressesRequest{
+					Addresses: args,
+					Denom:     denom,
+				})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			if clientCtx.Height <= 0 {
+				return fmt.Errorf("--%s requires a positive --%s", FlagProve, flags.FlagHeight)
+			}
+			if denom == "" {
+				return fmt.Errorf("--%s requires --%s", FlagProve, FlagDenom)
+			}
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return err
+			}
+			if len(args) > types.MaxBalancesByAddresses {
+				return fmt.Errorf("too many addresses: %d > %d", len(args), types.MaxBalancesByAddresses)
+			}
+
+			appHash, err := queryAppHash(clientCtx, cmd, clientCtx.Height)
+			if err != nil {
+				return err
+			}
+
+			proofs := make([]BalanceProof, len(args))
+			for i, addrStr := range args {
+				addr, err := clientCtx.AddressCodec.StringToBytes(addrStr)
+				if err != nil {
+					return fmt.Errorf("invalid address %s: %w", addrStr, err)
+				}
+
+				proofs[i], err = queryBalanceProof(clientCtx, clientCtx.Height, appHash, addrStr, addr, denom)
+				if err != nil {
+					return err
+				}
+			}
+
+			bz, err := json.Marshal(proofs)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "Only query the balances of this denom")
+	cmd.Flags().Bool(FlagProve, false, "Query the balances of --denom at --height with their verified ICS-23 proofs")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// queryAppHash returns the app hash of the state at height, which is committed in the header of the
+// block height+1 returned by the node.
+func queryAppHash(clientCtx client.Context, cmd *cobra.Command, height int64) ([]byte, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	headerHeight := height + 1
+	commit, err := node.Commit(cmd.Context(), &headerHeight)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the header of block %d: %w", headerHeight, err)
+	}
+	if commit.SignedHeader.Header == nil {
+		return nil, fmt.Errorf("no header for block %d", headerHeight)
+	}
+
+	return commit.SignedHeader.Header.AppHash, nil
+}
+
+// queryBalanceProof queries the balance of denom for addr at height through an ABCI store query, and
+// verifies its proof against appHash.
+func queryBalanceProof(clientCtx client.Context, height int64, appHash []byte, addrStr string, addr sdk.AccAddress, denom string) (BalanceProof, error) {
+	key, err := types.BalanceStoreKey(addr, denom)
+	if err != nil {
+		return BalanceProof{}, err
+	}
+
+	res, err := clientCtx.QueryABCI(abci.QueryRequest{
+		Path:   fmt.Sprintf("/store/%s/key", types.StoreKey),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return BalanceProof{}, fmt.Errorf("failed to query the balance of %s at height %d: %w", addrStr, height, err)
+	}
+
+	if err := verifyBalanceProof(res, key, appHash); err != nil {
+		return BalanceProof{}, fmt.Errorf("invalid proof of the balance of %s at height %d: %w", addrStr, height, err)
+	}
+
+	// a missing balance is a zero balance, proven by a proof of absence
+	amount := math.ZeroInt()
+	if len(res.Value) > 0 {
+		amount, err = types.BalanceValueCodec.Decode(res.Value)
+		if err != nil {
+			return BalanceProof{}, err
+		}
+	}
+
+	return BalanceProof{
+		Address: addrStr,
+		Balance: sdk.NewCoin(denom, amount),
+		Height:  res.Height,
+		Proof:   res.ProofOps,
+	}, nil
+}
+
+// verifyBalanceProof verifies the proof of the value, or of the absence, of key in the bank store
+// against appHash.
+func verifyBalanceProof(res abci.QueryResponse, key, appHash []byte) error {
+	if res.ProofOps == nil {
+		return errors.New("no proof returned by the node")
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(types.StoreKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+
+	prt := rootmulti.DefaultProofRuntime()
+	if len(res.Value) == 0 {
+		return prt.VerifyAbsence(res.ProofOps, appHash, keyPath)
+	}
+
+	return prt.VerifyValue(res.ProofOps, appHash, keyPath, res.Value)
+}
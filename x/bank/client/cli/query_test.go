@@ -0,0 +1,219 @@
+package cli_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	coretesting "cosmossdk.io/core/testing"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/bank/client/cli"
+	"cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// storeCometRPC is a mock CometBFT RPC serving ABCI store queries from a multistore, and the
+// headers of the blocks from the app hashes of its commits.
+type storeCometRPC struct {
+	clitestutil.MockCometRPC
+
+	store     *rootmulti.Store
+	appHashes map[int64][]byte
+}
+
+func (m storeCometRPC) ABCIQueryWithOptions(
+	_ context.Context,
+	path string,
+	data cmtbytes.HexBytes,
+	opts rpcclient.ABCIQueryOptions,
+) (*coretypes.ResultABCIQuery, error) {
+	res, err := m.store.Query(&storetypes.RequestQuery{
+		Path:   strings.TrimPrefix(path, "/store"),
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &coretypes.ResultABCIQuery{Response: abci.QueryResponse{
+		Key:      res.Key,
+		Value:    res.Value,
+		ProofOps: res.ProofOps,
+		Height:   res.Height,
+	}}, nil
+}
+
+func (m storeCometRPC) Commit(_ context.Context, height *int64) (*coretypes.ResultCommit, error) {
+	// the app hash of a height is committed in the header of the next block
+	appHash, ok := m.appHashes[*height-1]
+	if !ok {
+		return nil, fmt.Errorf("no block at height %d", *height)
+	}
+
+	return &coretypes.ResultCommit{SignedHeader: cmttypes.SignedHeader{Header: &cmttypes.Header{AppHash: appHash}}}, nil
+}
+
+func (s *CLITestSuite) TestBalancesByAddressesCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+	accountStr := make([]string, len(accounts))
+	for i, acc := range accounts {
+		addrStr, err := s.baseCtx.AddressCodec.BytesToString(acc.Address)
+		s.Require().NoError(err)
+		accountStr[i] = addrStr
+	}
+
+	// the first account holds 42stake at height 1 and 10stake at height 2, the second one holds nothing
+	store := rootmulti.NewStore(coretesting.NewMemDB(), coretesting.NewNopLogger(), metrics.NewNoOpMetrics())
+	store.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	store.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	s.Require().NoError(store.LoadLatestVersion())
+
+	key, err := types.BalanceStoreKey(accounts[0].Address, "stake")
+	s.Require().NoError(err)
+	appHashes := make(map[int64][]byte)
+	for _, amount := range []int64{42, 10} {
+		value, err := types.BalanceValueCodec.Encode(sdkmath.NewInt(amount))
+		s.Require().NoError(err)
+		store.GetKVStore(storeKey).Set(key, value)
+		commitID := store.Commit()
+		appHashes[commitID.Version] = commitID.Hash
+	}
+
+	res := &types.QueryBalancesByAddressesResponse{
+		Balances: []types.Balance{{Address: accountStr[0], Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}},
+		Height:   2,
+	}
+	resBz, err := s.encCfg.Codec.Marshal(res)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		ctxGen       func() client.Context
+		args         []string
+		expectErrMsg string
+		expBalances  []sdk.Coin
+	}{
+		{
+			"balances without proofs",
+			func() client.Context {
+				return s.baseCtx.WithClient(clitestutil.NewMockCometRPCWithResponseQueryValue(resBz))
+			},
+			[]string{accountStr[0]},
+			"",
+			nil,
+		},
+		{
+			"proofs without height",
+			func() client.Context {
+				return s.baseCtx
+			},
+			[]string{accountStr[0], "--denom=stake", "--prove"},
+			"--prove requires a positive --height",
+			nil,
+		},
+		{
+			"proofs without denom",
+			func() client.Context {
+				return s.baseCtx
+			},
+			[]string{accountStr[0], "--height=1", "--prove"},
+			"--prove requires --denom",
+			nil,
+		},
+		{
+			"invalid denom",
+			func() client.Context {
+				return s.baseCtx
+			},
+			[]string{accountStr[0], "--denom=0000", "--height=1", "--prove"},
+			"invalid denom",
+			nil,
+		},
+		{
+			"invalid address",
+			func() client.Context {
+				return s.baseCtx.WithClient(storeCometRPC{store: store, appHashes: appHashes})
+			},
+			[]string{"foo", "--denom=stake", "--height=1", "--prove"},
+			"invalid address foo",
+			nil,
+		},
+		{
+			"height not committed yet",
+			func() client.Context {
+				return s.baseCtx.WithClient(storeCometRPC{store: store, appHashes: appHashes})
+			},
+			[]string{accountStr[0], "--denom=stake", "--height=3", "--prove"},
+			"failed to query the header of block 4",
+			nil,
+		},
+		{
+			"proof against another app hash",
+			func() client.Context {
+				return s.baseCtx.WithClient(storeCometRPC{store: store, appHashes: map[int64][]byte{1: appHashes[2]}})
+			},
+			[]string{accountStr[0], "--denom=stake", "--height=1", "--prove"},
+			"invalid proof of the balance",
+			nil,
+		},
+		{
+			"balances with proofs",
+			func() client.Context {
+				return s.baseCtx.WithClient(storeCometRPC{store: store, appHashes: appHashes})
+			},
+			[]string{accountStr[0], accountStr[1], "--denom=stake", "--height=1", "--prove"},
+			"",
+			[]sdk.Coin{sdk.NewInt64Coin("stake", 42), sdk.NewInt64Coin("stake", 0)},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.NewBalancesByAddressesCmd()
+
+			args := append(tc.args, fmt.Sprintf("--%s=json", flags.FlagOutput))
+			out, err := clitestutil.ExecTestCLICmd(tc.ctxGen(), cmd, args)
+			if tc.expectErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expectErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			if tc.expBalances == nil {
+				var balances types.QueryBalancesByAddressesResponse
+				s.Require().NoError(s.encCfg.Codec.UnmarshalJSON(out.Bytes(), &balances), out.String())
+				s.Require().Equal(res, &balances)
+				return
+			}
+
+			var proofs []cli.BalanceProof
+			s.Require().NoError(json.Unmarshal(out.Bytes(), &proofs), out.String())
+			s.Require().Len(proofs, len(tc.expBalances))
+			for i, proof := range proofs {
+				s.Require().Equal(accountStr[i], proof.Address)
+				s.Require().Equal(tc.expBalances[i], proof.Balance)
+				s.Require().Equal(int64(1), proof.Height)
+				s.Require().NotNil(proof.Proof)
+			}
+		})
+	}
+}
@@ -57,6 +57,26 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, policy := range genState.TransferPolicies {
+		if err := k.SetTransferPolicy(ctx, policy); err != nil {
+			return err
+		}
+	}
+
+	for _, list := range genState.TransferLists {
+		addrs := make([]sdk.AccAddress, len(list.Addresses))
+		for i, addr := range list.Addresses {
+			if addrs[i], err = k.addrCdc.StringToBytes(addr); err != nil {
+				return err
+			}
+		}
+
+		if err := k.UpdateTransferList(ctx, list.Denom, addrs, nil); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -74,5 +94,16 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState, err
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+
+	rv.TransferPolicies, err = k.GetAllTransferPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch transfer policies %w", err)
+	}
+
+	rv.TransferLists, err = k.GetAllTransferLists(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch transfer lists %w", err)
+	}
+
 	return rv, nil
 }
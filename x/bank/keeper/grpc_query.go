@@ -356,3 +356,111 @@ func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDeno
 
 	return &types.QueryDenomOwnersByQueryResponse{DenomOwners: resp.DenomOwners, Pagination: resp.Pagination}, nil
 }
+
+// TransferPolicy implements the Query/TransferPolicy gRPC method
+func (k BaseKeeper) TransferPolicy(ctx context.Context, req *types.QueryTransferPolicyRequest) (*types.QueryTransferPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	policy, found := k.GetTransferPolicy(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "transfer policy for denom %s", req.Denom)
+	}
+
+	return &types.QueryTransferPolicyResponse{Policy: policy}, nil
+}
+
+// TransferPolicies implements the Query/TransferPolicies gRPC method
+func (k BaseKeeper) TransferPolicies(ctx context.Context, req *types.QueryTransferPoliciesRequest) (*types.QueryTransferPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	policies, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.BaseViewKeeper.TransferPolicies,
+		req.Pagination,
+		func(_ string, policy types.TransferPolicy) (types.TransferPolicy, error) {
+			return policy, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTransferPoliciesResponse{Policies: policies, Pagination: pageRes}, nil
+}
+
+// TransferList implements the Query/TransferList gRPC method
+func (k BaseKeeper) TransferList(ctx context.Context, req *types.QueryTransferListRequest) (*types.QueryTransferListResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addresses, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.BaseViewKeeper.TransferLists,
+		req.Pagination,
+		func(key collections.Pair[string, sdk.AccAddress], _ collections.NoValue) (string, error) {
+			return k.addrCdc.BytesToString(key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, sdk.AccAddress](req.Denom),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTransferListResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+// BalancesByAddresses implements the Query/BalancesByAddresses gRPC method
+func (k BaseKeeper) BalancesByAddresses(ctx context.Context, req *types.QueryBalancesByAddressesRequest) (*types.QueryBalancesByAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Addresses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no address to query")
+	}
+
+	if len(req.Addresses) > types.MaxBalancesByAddresses {
+		return nil, status.Errorf(codes.InvalidArgument, "too many addresses: %d > %d", len(req.Addresses), types.MaxBalancesByAddresses)
+	}
+
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	balances := make([]types.Balance, len(req.Addresses))
+	for i, addrStr := range req.Addresses {
+		addr, err := k.addrCdc.StringToBytes(addrStr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %s", addrStr, err.Error())
+		}
+
+		var coins sdk.Coins
+		if req.Denom != "" {
+			coins = sdk.NewCoins(k.GetBalance(ctx, addr, req.Denom))
+		} else {
+			coins = k.GetAllBalances(ctx, addr)
+		}
+
+		balances[i] = types.Balance{Address: addrStr, Coins: coins}
+	}
+
+	return &types.QueryBalancesByAddressesResponse{
+		Balances: balances,
+		Height:   k.HeaderService.HeaderInfo(ctx).Height,
+	}, nil
+}
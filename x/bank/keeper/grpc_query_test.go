@@ -1039,3 +1039,95 @@ func (suite *KeeperTestSuite) TestGRPCDenomOwnersByQuery() {
 
 	suite.Require().True(true)
 }
+
+func (suite *KeeperTestSuite) TestQueryBalancesByAddresses() {
+	ctx := suite.ctx
+	queryClient := suite.mockQueryClient(sdk.UnwrapSDKContext(ctx).WithHeaderInfo(header.Info{Height: 10}))
+
+	addrStrs := make([]string, 3)
+	for i := range addrStrs {
+		addrStr, err := suite.addrCdc.BytesToString(accAddrs[i])
+		suite.Require().NoError(err)
+		addrStrs[i] = addrStr
+	}
+
+	suite.mockFundAccount(accAddrs[0])
+	suite.Require().NoError(testutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(10), newBarCoin(20))))
+	suite.mockFundAccount(accAddrs[1])
+	suite.Require().NoError(testutil.FundAccount(ctx, suite.bankKeeper, accAddrs[1], sdk.NewCoins(newBarCoin(30))))
+
+	tooManyAddrs := make([]string, types.MaxBalancesByAddresses+1)
+	for i := range tooManyAddrs {
+		tooManyAddrs[i] = addrStrs[0]
+	}
+
+	testCases := []struct {
+		name         string
+		req          *types.QueryBalancesByAddressesRequest
+		expectErrMsg string
+		expBalances  []types.Balance
+	}{
+		{
+			"no address",
+			&types.QueryBalancesByAddressesRequest{},
+			"no address to query",
+			nil,
+		},
+		{
+			"too many addresses",
+			&types.QueryBalancesByAddressesRequest{Addresses: tooManyAddrs},
+			"too many addresses",
+			nil,
+		},
+		{
+			"invalid address",
+			&types.QueryBalancesByAddressesRequest{Addresses: []string{addrStrs[0], "foo"}},
+			"invalid address foo",
+			nil,
+		},
+		{
+			"invalid denom",
+			&types.QueryBalancesByAddressesRequest{Addresses: addrStrs, Denom: "0000"},
+			"invalid denom",
+			nil,
+		},
+		{
+			"all balances",
+			&types.QueryBalancesByAddressesRequest{Addresses: addrStrs},
+			"",
+			[]types.Balance{
+				{Address: addrStrs[0], Coins: sdk.NewCoins(newFooCoin(10), newBarCoin(20))},
+				{Address: addrStrs[1], Coins: sdk.NewCoins(newBarCoin(30))},
+				{Address: addrStrs[2], Coins: sdk.Coins{}},
+			},
+		},
+		{
+			"balances of a denom",
+			&types.QueryBalancesByAddressesRequest{Addresses: addrStrs, Denom: fooDenom},
+			"",
+			[]types.Balance{
+				{Address: addrStrs[0], Coins: sdk.NewCoins(newFooCoin(10))},
+				{Address: addrStrs[1], Coins: sdk.Coins{}},
+				{Address: addrStrs[2], Coins: sdk.Coins{}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := queryClient.BalancesByAddresses(gocontext.Background(), tc.req)
+			if tc.expectErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expectErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(int64(10), res.Height)
+			suite.Require().Len(res.Balances, len(tc.expBalances))
+			for i, balance := range res.Balances {
+				suite.Require().Equal(tc.expBalances[i].Address, balance.Address)
+				suite.Require().True(tc.expBalances[i].Coins.Equal(balance.Coins), "expected %s, got %s", tc.expBalances[i].Coins, balance.Coins)
+			}
+		})
+	}
+}
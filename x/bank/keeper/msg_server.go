@@ -197,3 +197,59 @@ func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurn
 
 	return &types.MsgBurnResponse{}, nil
 }
+
+func (k msgServer) SetTransferPolicy(ctx context.Context, msg *types.MsgSetTransferPolicy) (*types.MsgSetTransferPolicyResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := k.Keeper.SetTransferPolicy(ctx, msg.Policy); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid transfer policy: %s", err)
+	}
+
+	return &types.MsgSetTransferPolicyResponse{}, nil
+}
+
+func (k msgServer) UpdateTransferList(ctx context.Context, msg *types.MsgUpdateTransferList) (*types.MsgUpdateTransferListResponse, error) {
+	policy, found := k.GetTransferPolicy(ctx, msg.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrNoTransferPolicy, "denom %s", msg.Denom)
+	}
+
+	if msg.Sender != k.GetAuthority() && (policy.Manager == "" || msg.Sender != policy.Manager) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "%s is neither the authority nor the manager of the transfer list of %s", msg.Sender, msg.Denom)
+	}
+
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	toAddresses := func(addrs []string) ([]sdk.AccAddress, error) {
+		res := make([]sdk.AccAddress, len(addrs))
+		for i, addr := range addrs {
+			bz, err := base.addrCdc.StringToBytes(addr)
+			if err != nil {
+				return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address %s: %s", addr, err)
+			}
+			res[i] = bz
+		}
+		return res, nil
+	}
+
+	add, err := toAddresses(msg.Add)
+	if err != nil {
+		return nil, err
+	}
+
+	remove, err := toAddresses(msg.Remove)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.UpdateTransferList(ctx, msg.Denom, add, remove); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateTransferListResponse{}, nil
+}
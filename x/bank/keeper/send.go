@@ -45,6 +45,12 @@ type SendKeeper interface {
 	BlockedAddr(addr sdk.AccAddress) bool
 	GetBlockedAddresses() map[string]bool
 
+	GetTransferPolicy(ctx context.Context, denom string) (types.TransferPolicy, bool)
+	SetTransferPolicy(ctx context.Context, policy types.TransferPolicy) error
+	UpdateTransferList(ctx context.Context, denom string, add, remove []sdk.AccAddress) error
+	IsTransferListed(ctx context.Context, denom string, addr sdk.AccAddress) bool
+	TransferListSendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error)
+
 	GetAuthority() string
 }
 
@@ -166,6 +172,11 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 			return err
 		}
 
+		outAddress, err = k.TransferListSendRestriction(ctx, inAddress, outAddress, out.Coins)
+		if err != nil {
+			return err
+		}
+
 		sending = append(sending, toSend{
 			Address:    outAddress,
 			AddressStr: out.Address,
@@ -208,6 +219,11 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		return err
 	}
 
+	toAddr, err = k.TransferListSendRestriction(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
 	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
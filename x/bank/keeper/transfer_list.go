@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.SendRestrictionFn = BaseSendKeeper{}.TransferListSendRestriction
+
+// TransferListSendRestriction is a SendRestrictionFn enforcing the transfer lists of the denoms of amt.
+// With an allowlist, both the sender and the receiver must be listed. With a denylist, neither of them
+// can be listed. Module accounts are never restricted, so that the denoms can still be escrowed, staked
+// or burnt by modules.
+//
+// It is always applied by SendCoins and InputOutputCoins after the configured send restrictions, so
+// that it cannot be cleared with ClearSendRestriction.
+func (k BaseSendKeeper) TransferListSendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	for _, coin := range amt {
+		policy, found := k.GetTransferPolicy(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+
+		for _, addr := range []sdk.AccAddress{fromAddr, toAddr} {
+			if k.isModuleAccount(ctx, addr) {
+				continue
+			}
+
+			if !policy.Mode.IsAllowed(k.IsTransferListed(ctx, coin.Denom, addr)) {
+				addrStr, err := k.addrCdc.BytesToString(addr)
+				if err != nil {
+					return nil, err
+				}
+
+				return nil, errorsmod.Wrapf(types.ErrTransferRestricted, "%s cannot send or receive %s", addrStr, coin.Denom)
+			}
+		}
+	}
+
+	return toAddr, nil
+}
+
+// isModuleAccount returns whether the account at addr is a module account.
+func (k BaseSendKeeper) isModuleAccount(ctx context.Context, addr sdk.AccAddress) bool {
+	_, ok := k.ak.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return ok
+}
+
+// GetTransferPolicy returns the transfer policy of a denom and true if the denom has a transfer policy,
+// false otherwise.
+func (k BaseSendKeeper) GetTransferPolicy(ctx context.Context, denom string) (types.TransferPolicy, bool) {
+	policy, err := k.TransferPolicies.Get(ctx, denom)
+	if err != nil {
+		return types.TransferPolicy{}, false
+	}
+
+	return policy, true
+}
+
+// SetTransferPolicy validates and sets the transfer policy of its denom. A policy with an unspecified
+// mode removes the transfer policy and the transfer list of the denom.
+func (k BaseSendKeeper) SetTransferPolicy(ctx context.Context, policy types.TransferPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	if policy.Mode == types.TransferListModeUnspecified {
+		if err := k.TransferPolicies.Remove(ctx, policy.Denom); err != nil {
+			return err
+		}
+
+		return k.TransferLists.Clear(ctx, collections.NewPrefixedPairRange[string, sdk.AccAddress](policy.Denom))
+	}
+
+	if policy.Manager != "" {
+		if _, err := k.addrCdc.StringToBytes(policy.Manager); err != nil {
+			return errorsmod.Wrapf(err, "invalid manager address %s", policy.Manager)
+		}
+	}
+
+	return k.TransferPolicies.Set(ctx, policy.Denom, policy)
+}
+
+// UpdateTransferList adds and removes addresses from the transfer list of a denom. The denom must have a
+// transfer policy.
+func (k BaseSendKeeper) UpdateTransferList(ctx context.Context, denom string, add, remove []sdk.AccAddress) error {
+	has, err := k.TransferPolicies.Has(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errorsmod.Wrapf(types.ErrNoTransferPolicy, "denom %s", denom)
+	}
+
+	for _, addr := range add {
+		if err := k.TransferLists.Set(ctx, collections.Join(denom, addr)); err != nil {
+			return err
+		}
+	}
+
+	for _, addr := range remove {
+		if err := k.TransferLists.Remove(ctx, collections.Join(denom, addr)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsTransferListed returns whether addr is listed in the transfer list of denom.
+func (k BaseSendKeeper) IsTransferListed(ctx context.Context, denom string, addr sdk.AccAddress) bool {
+	has, err := k.TransferLists.Has(ctx, collections.Join(denom, addr))
+	return err == nil && has
+}
+
+// GetAllTransferPolicies returns the transfer policies of all the restricted denoms.
+func (k BaseSendKeeper) GetAllTransferPolicies(ctx context.Context) ([]types.TransferPolicy, error) {
+	var policies []types.TransferPolicy
+	err := k.TransferPolicies.Walk(ctx, nil, func(_ string, policy types.TransferPolicy) (stop bool, err error) {
+		policies = append(policies, policy)
+		return false, nil
+	})
+	return policies, err
+}
+
+// GetAllTransferLists returns the transfer lists of all the denoms, ordered by denom.
+func (k BaseSendKeeper) GetAllTransferLists(ctx context.Context) ([]types.TransferList, error) {
+	var lists []types.TransferList
+	err := k.TransferLists.Walk(ctx, nil, func(key collections.Pair[string, sdk.AccAddress]) (stop bool, err error) {
+		addr, err := k.addrCdc.BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		if n := len(lists); n > 0 && lists[n-1].Denom == key.K1() {
+			lists[n-1].Addresses = append(lists[n-1].Addresses, addr)
+		} else {
+			lists = append(lists, types.TransferList{Denom: key.K1(), Addresses: []string{addr}})
+		}
+		return false, nil
+	})
+	return lists, err
+}
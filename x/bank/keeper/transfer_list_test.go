@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"context"
+
+	"go.uber.org/mock/gomock"
+
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// mockTransferListAccounts makes the account keeper return holderAcc for its address and a base account
+// for any other address.
+func (suite *KeeperTestSuite) mockTransferListAccounts() {
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+		if addr.Equals(holderAcc.GetAddress()) {
+			return holderAcc
+		}
+		return authtypes.NewBaseAccountWithAddress(addr)
+	}).AnyTimes()
+}
+
+func (suite *KeeperTestSuite) TestTransferListDenylist() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(100))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+	suite.mockTransferListAccounts()
+
+	acc0Str, err := suite.addrCdc.BytesToString(accAddrs[0])
+	require.NoError(err)
+	manager, err := suite.addrCdc.BytesToString(accAddrs[4])
+	require.NoError(err)
+	acc2Str, err := suite.addrCdc.BytesToString(accAddrs[2])
+	require.NoError(err)
+
+	_, err = suite.msgServer.SetTransferPolicy(ctx, banktypes.NewMsgSetTransferPolicy(
+		suite.bankKeeper.GetAuthority(),
+		banktypes.NewTransferPolicy(fooDenom, banktypes.TransferListModeDenylist, manager),
+	))
+	require.NoError(err)
+
+	_, err = suite.msgServer.UpdateTransferList(ctx, banktypes.NewMsgUpdateTransferList(manager, fooDenom, []string{acc2Str}, nil))
+	require.NoError(err)
+	require.True(suite.bankKeeper.IsTransferListed(ctx, fooDenom, accAddrs[2]))
+
+	// unlisted addresses can transfer the denom
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+
+	// listed addresses can neither receive nor send the denom
+	err = suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, banktypes.ErrTransferRestricted)
+
+	err = suite.bankKeeper.InputOutputCoins(ctx,
+		banktypes.Input{Address: acc0Str, Coins: sdk.NewCoins(newFooCoin(20))},
+		[]banktypes.Output{
+			{Address: manager, Coins: sdk.NewCoins(newFooCoin(10))},
+			{Address: acc2Str, Coins: sdk.NewCoins(newFooCoin(10))},
+		},
+	)
+	require.ErrorIs(err, banktypes.ErrTransferRestricted)
+
+	// other denoms are not restricted
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newBarCoin(10))))
+
+	_, err = suite.msgServer.UpdateTransferList(ctx, banktypes.NewMsgUpdateTransferList(manager, fooDenom, nil, []string{acc2Str}))
+	require.NoError(err)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(10))))
+
+	require.Equal(sdk.NewCoins(newFooCoin(80), newBarCoin(90)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+}
+
+func (suite *KeeperTestSuite) TestTransferListAllowlist() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+	suite.mockTransferListAccounts()
+
+	acc0Str, err := suite.addrCdc.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addrCdc.BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	_, err = suite.msgServer.SetTransferPolicy(ctx, banktypes.NewMsgSetTransferPolicy(
+		suite.bankKeeper.GetAuthority(),
+		banktypes.NewTransferPolicy(fooDenom, banktypes.TransferListModeAllowlist, ""),
+	))
+	require.NoError(err)
+
+	// without a manager, only the authority can update the transfer list
+	_, err = suite.msgServer.UpdateTransferList(ctx, banktypes.NewMsgUpdateTransferList(acc0Str, fooDenom, []string{acc0Str, acc1Str}, nil))
+	require.ErrorIs(err, banktypes.ErrInvalidSigner)
+
+	_, err = suite.msgServer.UpdateTransferList(ctx, banktypes.NewMsgUpdateTransferList(suite.bankKeeper.GetAuthority(), fooDenom, []string{acc0Str, acc1Str}, nil))
+	require.NoError(err)
+
+	// only listed addresses can transfer the denom
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+
+	err = suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, banktypes.ErrTransferRestricted)
+
+	// module accounts are not restricted
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, holderAcc.Name).Return(holderAcc)
+	require.NoError(suite.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddrs[0], holderAcc.Name, sdk.NewCoins(newFooCoin(10))))
+
+	res, err := suite.queryClient.TransferList(ctx, &banktypes.QueryTransferListRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.ElementsMatch([]string{acc0Str, acc1Str}, res.Addresses)
+
+	// removing the policy removes the transfer list
+	_, err = suite.msgServer.SetTransferPolicy(ctx, banktypes.NewMsgSetTransferPolicy(
+		suite.bankKeeper.GetAuthority(),
+		banktypes.NewTransferPolicy(fooDenom, banktypes.TransferListModeUnspecified, ""),
+	))
+	require.NoError(err)
+	require.False(suite.bankKeeper.IsTransferListed(ctx, fooDenom, accAddrs[0]))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(10))))
+
+	_, err = suite.queryClient.TransferPolicy(ctx, &banktypes.QueryTransferPolicyRequest{Denom: fooDenom})
+	require.ErrorContains(err, "NotFound")
+
+	_, err = suite.msgServer.UpdateTransferList(ctx, banktypes.NewMsgUpdateTransferList(suite.bankKeeper.GetAuthority(), fooDenom, []string{acc0Str}, nil))
+	require.ErrorIs(err, banktypes.ErrNoTransferPolicy)
+}
+
+func (suite *KeeperTestSuite) TestTransferListGenesis() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	acc0Str, err := suite.addrCdc.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addrCdc.BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	genState := banktypes.DefaultGenesisState()
+	genState.TransferPolicies = []banktypes.TransferPolicy{
+		banktypes.NewTransferPolicy(barDenom, banktypes.TransferListModeDenylist, acc0Str),
+		banktypes.NewTransferPolicy(fooDenom, banktypes.TransferListModeAllowlist, ""),
+	}
+	genState.TransferLists = []banktypes.TransferList{
+		{Denom: fooDenom, Addresses: []string{acc0Str, acc1Str}},
+	}
+	require.NoError(genState.Validate())
+	require.NoError(suite.bankKeeper.InitGenesis(ctx, genState))
+
+	exported, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Equal(genState.TransferPolicies, exported.TransferPolicies)
+	require.Len(exported.TransferLists, 1)
+	require.Equal(fooDenom, exported.TransferLists[0].Denom)
+	require.ElementsMatch(genState.TransferLists[0].Addresses, exported.TransferLists[0].Addresses)
+
+	// a transfer list requires a transfer policy
+	genState.TransferLists = append(genState.TransferLists, banktypes.TransferList{Denom: "baz", Addresses: []string{acc0Str}})
+	require.Error(genState.Validate())
+}
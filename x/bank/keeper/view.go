@@ -68,6 +68,9 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+
+	TransferPolicies collections.Map[string, types.TransferPolicy]
+	TransferLists    collections.KeySet[collections.Pair[string, sdk.AccAddress]]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
@@ -83,6 +86,9 @@ func NewBaseViewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak type
 		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey.WithName("denom"), codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
 		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.NamedPairKeyCodec("address", sdk.AccAddressKey, "denom", collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+
+		TransferPolicies: collections.NewMap(sb, types.TransferPoliciesPrefix, "transfer_policies", collections.StringKey.WithName("denom"), codec.CollValue[types.TransferPolicy](cdc)),
+		TransferLists:    collections.NewKeySet(sb, types.TransferListsPrefix, "transfer_lists", collections.NamedPairKeyCodec("denom", collections.StringKey, "address", sdk.AccAddressKey)),
 	}
 
 	schema, err := sb.Build()
@@ -28,6 +28,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TransferListMode defines how the transfer list of a denom restricts the transfers of the denom.
+type TransferListMode int32
+
+const (
+	// TRANSFER_LIST_MODE_UNSPECIFIED defines a denom without transfer list.
+	TransferListModeUnspecified TransferListMode = 0
+	// TRANSFER_LIST_MODE_ALLOWLIST only allows transfers between the listed addresses.
+	TransferListModeAllowlist TransferListMode = 1
+	// TRANSFER_LIST_MODE_DENYLIST blocks the transfers from and to the listed addresses.
+	TransferListModeDenylist TransferListMode = 2
+)
+
+var TransferListMode_name = map[int32]string{
+	0: "TRANSFER_LIST_MODE_UNSPECIFIED",
+	1: "TRANSFER_LIST_MODE_ALLOWLIST",
+	2: "TRANSFER_LIST_MODE_DENYLIST",
+}
+
+var TransferListMode_value = map[string]int32{
+	"TRANSFER_LIST_MODE_UNSPECIFIED": 0,
+	"TRANSFER_LIST_MODE_ALLOWLIST":   1,
+	"TRANSFER_LIST_MODE_DENYLIST":    2,
+}
+
+func (x TransferListMode) String() string {
+	return proto.EnumName(TransferListMode_name, int32(x))
+}
+
+func (TransferListMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{0}
+}
+
 // Params defines the parameters for the bank module.
 type Params struct {
 	// Deprecated: Use of SendEnabled in params is deprecated.
@@ -443,7 +475,126 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// TransferPolicy defines the transfer list restricting the transfers of a denom.
+type TransferPolicy struct {
+	// denom is the denom restricted by the transfer list.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// mode defines whether the transfer list is an allowlist or a denylist.
+	Mode TransferListMode `protobuf:"varint,2,opt,name=mode,proto3,enum=cosmos.bank.v1beta1.TransferListMode" json:"mode,omitempty"`
+	// manager is an optional address, such as the issuer of the denom, allowed to update the
+	// transfer list of the denom in addition to the module authority.
+	Manager string `protobuf:"bytes,3,opt,name=manager,proto3" json:"manager,omitempty"`
+}
+
+func (m *TransferPolicy) Reset()         { *m = TransferPolicy{} }
+func (m *TransferPolicy) String() string { return proto.CompactTextString(m) }
+func (*TransferPolicy) ProtoMessage()    {}
+func (*TransferPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *TransferPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferPolicy.Merge(m, src)
+}
+func (m *TransferPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferPolicy proto.InternalMessageInfo
+
+func (m *TransferPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TransferPolicy) GetMode() TransferListMode {
+	if m != nil {
+		return m.Mode
+	}
+	return TransferListModeUnspecified
+}
+
+func (m *TransferPolicy) GetManager() string {
+	if m != nil {
+		return m.Manager
+	}
+	return ""
+}
+
+// TransferList defines the addresses listed in the transfer list of a denom.
+type TransferList struct {
+	Denom     string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *TransferList) Reset()         { *m = TransferList{} }
+func (m *TransferList) String() string { return proto.CompactTextString(m) }
+func (*TransferList) ProtoMessage()    {}
+func (*TransferList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{8}
+}
+func (m *TransferList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferList.Merge(m, src)
+}
+func (m *TransferList) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferList) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferList proto.InternalMessageInfo
+
+func (m *TransferList) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TransferList) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.bank.v1beta1.TransferListMode", TransferListMode_name, TransferListMode_value)
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
 	proto.RegisterType((*Input)(nil), "cosmos.bank.v1beta1.Input")
@@ -451,57 +602,71 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*TransferPolicy)(nil), "cosmos.bank.v1beta1.TransferPolicy")
+	proto.RegisterType((*TransferList)(nil), "cosmos.bank.v1beta1.TransferList")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x6f, 0x13, 0x4b,
-	0x10, 0xf7, 0xfa, 0x3b, 0xeb, 0x3c, 0x3d, 0xbd, 0x8d, 0xa5, 0x77, 0xf1, 0xd3, 0x3b, 0x5b, 0x6e,
-	0x30, 0x06, 0xdb, 0xf9, 0x40, 0x48, 0xb8, 0x41, 0x38, 0x7c, 0xb9, 0x40, 0xa0, 0x8b, 0x22, 0x24,
-	0x1a, 0x6b, 0xed, 0x5b, 0xec, 0x55, 0xee, 0x76, 0x4f, 0xb7, 0x7b, 0x21, 0x6e, 0xa9, 0x10, 0x15,
-	0x35, 0x55, 0x44, 0x85, 0x10, 0x85, 0x8b, 0xf4, 0xb4, 0x51, 0xaa, 0x88, 0x0a, 0xa5, 0x08, 0xc8,
-	0x29, 0x9c, 0x3f, 0x03, 0xdd, 0xde, 0x9d, 0xed, 0x08, 0xa7, 0x45, 0xa2, 0xb1, 0x77, 0xe6, 0xf7,
-	0x9b, 0x99, 0xdf, 0xcc, 0xce, 0x2d, 0xd4, 0x7b, 0x5c, 0xd8, 0x5c, 0x34, 0xba, 0x98, 0xed, 0x36,
-	0xf6, 0xd6, 0xbb, 0x44, 0xe2, 0x75, 0x65, 0xd4, 0x1d, 0x97, 0x4b, 0x8e, 0x56, 0x02, 0xbc, 0xae,
-	0x5c, 0x21, 0x5e, 0xc8, 0xf7, 0x79, 0x9f, 0x2b, 0xbc, 0xe1, 0x9f, 0x02, 0x6a, 0x61, 0x35, 0xa0,
-	0x76, 0x02, 0x20, 0x8c, 0x0b, 0xa0, 0x59, 0x15, 0x41, 0xa6, 0x55, 0x7a, 0x9c, 0xb2, 0x10, 0xff,
-	0x37, 0xc4, 0x6d, 0xd1, 0x6f, 0xec, 0xad, 0xfb, 0x7f, 0x21, 0xf0, 0x0f, 0xb6, 0x29, 0xe3, 0x0d,
-	0xf5, 0x1b, 0xb8, 0xca, 0x1f, 0x00, 0x4c, 0x3f, 0xc3, 0x2e, 0xb6, 0x05, 0x7a, 0x04, 0x97, 0x05,
-	0x61, 0x66, 0x87, 0x30, 0xdc, 0xb5, 0x88, 0xa9, 0x81, 0x52, 0xa2, 0x92, 0xdb, 0x28, 0xd5, 0x17,
-	0x68, 0xae, 0x6f, 0x13, 0x66, 0x3e, 0x08, 0x78, 0xad, 0xb8, 0x06, 0x8c, 0x9c, 0x98, 0x39, 0xd0,
-	0x1a, 0xcc, 0x9b, 0xe4, 0x25, 0xf6, 0x2c, 0xd9, 0xb9, 0x94, 0x30, 0x5e, 0x02, 0x95, 0xac, 0x81,
-	0x42, 0x6c, 0x2e, 0x45, 0xf3, 0xff, 0xb7, 0x93, 0x51, 0x55, 0x0b, 0x0a, 0xd5, 0x84, 0xb9, 0xdb,
-	0xd8, 0x0f, 0x46, 0x18, 0x28, 0x2b, 0x6f, 0xc1, 0xdc, 0x1c, 0x1b, 0xe5, 0x61, 0xca, 0x24, 0x8c,
-	0xdb, 0x1a, 0x28, 0x81, 0xca, 0x92, 0x11, 0x18, 0x48, 0x83, 0x99, 0xcb, 0x85, 0x22, 0xb3, 0x99,
-	0xbc, 0x38, 0x28, 0x82, 0xf2, 0x31, 0x80, 0xa9, 0x36, 0x73, 0x3c, 0x89, 0x36, 0x60, 0x06, 0x9b,
-	0xa6, 0x4b, 0x84, 0x08, 0x32, 0xb4, 0xb4, 0xaf, 0x87, 0xb5, 0x7c, 0xd8, 0xe6, 0xbd, 0x00, 0xd9,
-	0x96, 0x2e, 0x65, 0x7d, 0x23, 0x22, 0xa2, 0x57, 0x30, 0xe5, 0x4f, 0x58, 0x68, 0x71, 0x35, 0x95,
-	0xd5, 0xd9, 0x54, 0x04, 0x99, 0x4e, 0x65, 0x8b, 0x53, 0xd6, 0x7a, 0x78, 0x74, 0x56, 0x8c, 0x7d,
-	0xfa, 0x5e, 0xac, 0xf4, 0xa9, 0x1c, 0x78, 0xdd, 0x7a, 0x8f, 0xdb, 0xe1, 0xf5, 0x35, 0xe6, 0x1a,
-	0x94, 0x43, 0x87, 0x08, 0x15, 0x20, 0xde, 0x4f, 0x46, 0xd5, 0x65, 0x8b, 0xf4, 0x71, 0x6f, 0xd8,
-	0x51, 0x35, 0x3e, 0x4e, 0x46, 0x55, 0x60, 0x04, 0xf5, 0x9a, 0xf9, 0x37, 0x07, 0xc5, 0xd8, 0xc5,
-	0x41, 0x31, 0xf6, 0x7a, 0x32, 0xaa, 0x46, 0x72, 0xca, 0x5f, 0x00, 0x4c, 0x3f, 0xf5, 0xe4, 0x1f,
-	0xd7, 0x4d, 0x36, 0xea, 0xa6, 0xfc, 0x19, 0xc0, 0xf4, 0xb6, 0xe7, 0x38, 0xd6, 0xd0, 0x57, 0x23,
-	0xb9, 0xc4, 0x56, 0xb8, 0x71, 0xbf, 0x43, 0x8d, 0xaa, 0xd7, 0xbc, 0x1e, 0xaa, 0x01, 0xc7, 0x87,
-	0xb5, 0xff, 0x16, 0xae, 0xb9, 0x12, 0xd8, 0xd6, 0x40, 0xf9, 0x39, 0x5c, 0xba, 0xef, 0xaf, 0xd9,
-	0x0e, 0xa3, 0xf2, 0x8a, 0x05, 0x2c, 0xc0, 0x2c, 0xd9, 0x77, 0x38, 0x23, 0x4c, 0xaa, 0x0d, 0xfc,
-	0xcb, 0x98, 0xda, 0xfe, 0x72, 0x62, 0x8b, 0x62, 0x41, 0x84, 0x96, 0x28, 0x25, 0x2a, 0x4b, 0x46,
-	0x64, 0x96, 0x4f, 0xe3, 0x30, 0xfb, 0x84, 0x48, 0x6c, 0x62, 0x89, 0x51, 0x09, 0xe6, 0x4c, 0x22,
-	0x7a, 0x2e, 0x75, 0x24, 0xe5, 0x2c, 0x4c, 0x3f, 0xef, 0x42, 0x77, 0x7d, 0x06, 0xe3, 0x76, 0xc7,
-	0x63, 0x54, 0x46, 0xf7, 0xa7, 0x2f, 0xfc, 0x46, 0xa7, 0x7a, 0x0d, 0x68, 0x46, 0x47, 0x81, 0x10,
-	0x4c, 0xfa, 0x73, 0xd5, 0x12, 0x2a, 0xb7, 0x3a, 0xfb, 0xea, 0x4c, 0x2a, 0x1c, 0x0b, 0x0f, 0xb5,
-	0xa4, 0x72, 0x47, 0x26, 0xba, 0x06, 0x93, 0x0c, 0xdb, 0x44, 0x4b, 0xa9, 0xcd, 0x5a, 0x39, 0x3d,
-	0xac, 0xfd, 0x3d, 0x1b, 0x74, 0x69, 0xad, 0x7e, 0x6b, 0xd3, 0x50, 0x04, 0x74, 0x03, 0xa6, 0xc5,
-	0xd0, 0xee, 0x72, 0x4b, 0x4b, 0x5f, 0x4d, 0x0d, 0x29, 0xe8, 0x26, 0x4c, 0x78, 0x2e, 0xd5, 0x32,
-	0x8a, 0x59, 0x18, 0x9f, 0x15, 0x13, 0x3b, 0x46, 0xfb, 0xd7, 0x80, 0xdb, 0x86, 0x4f, 0x43, 0x77,
-	0x60, 0xd6, 0x73, 0x69, 0x67, 0x80, 0xc5, 0x40, 0xcb, 0xaa, 0x10, 0x7d, 0x7c, 0x56, 0xcc, 0xec,
-	0x18, 0xed, 0xc7, 0x58, 0x0c, 0x16, 0x85, 0x65, 0x3c, 0x97, 0xfa, 0x58, 0x6b, 0xf3, 0x68, 0xac,
-	0x83, 0x93, 0xb1, 0x0e, 0x7e, 0x8c, 0x75, 0xf0, 0xee, 0x5c, 0x8f, 0x9d, 0x9c, 0xeb, 0xb1, 0x6f,
-	0xe7, 0x7a, 0xec, 0x45, 0xf8, 0xbc, 0x0a, 0x73, 0xb7, 0x4e, 0x79, 0xf4, 0xdc, 0xa8, 0xc5, 0xe9,
-	0xa6, 0xd5, 0xcb, 0xb8, 0xf9, 0x33, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x68, 0x6d, 0x04, 0xcd, 0x05,
-	0x00, 0x00,
+	// 912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x6b, 0x1b, 0x47,
+	0x14, 0xd6, 0x58, 0xb2, 0x24, 0x8f, 0xdd, 0xd4, 0x9d, 0x08, 0xba, 0x96, 0x93, 0xd5, 0xb2, 0x50,
+	0xaa, 0xaa, 0xb5, 0x14, 0xdb, 0x25, 0x10, 0x43, 0x09, 0xb6, 0x25, 0xb7, 0x02, 0xff, 0x62, 0x65,
+	0x11, 0x5a, 0x0a, 0xcb, 0x48, 0x3b, 0x96, 0x07, 0xef, 0xce, 0x2c, 0x3b, 0xbb, 0x49, 0x74, 0xed,
+	0x29, 0xf8, 0xd4, 0x4b, 0x2f, 0x05, 0x83, 0x69, 0x2f, 0xa5, 0xf4, 0xe0, 0x83, 0xef, 0xbd, 0x86,
+	0x9c, 0x42, 0x4f, 0x25, 0x50, 0xb7, 0xc8, 0x07, 0xe7, 0xcf, 0x28, 0x3b, 0xbb, 0x6b, 0x29, 0xae,
+	0x9c, 0x63, 0x21, 0x17, 0x7b, 0xdf, 0x7c, 0xdf, 0xfb, 0xde, 0x37, 0x6f, 0xde, 0x68, 0xa0, 0xda,
+	0xe5, 0xc2, 0xe1, 0xa2, 0xd6, 0xc1, 0xec, 0xb0, 0xf6, 0x78, 0xb1, 0x43, 0x7c, 0xbc, 0x28, 0x83,
+	0xaa, 0xeb, 0x71, 0x9f, 0xa3, 0xdb, 0x11, 0x5e, 0x95, 0x4b, 0x31, 0x5e, 0x2c, 0xf4, 0x78, 0x8f,
+	0x4b, 0xbc, 0x16, 0x7e, 0x45, 0xd4, 0xe2, 0x5c, 0x44, 0x35, 0x23, 0x20, 0xce, 0x8b, 0xa0, 0x61,
+	0x15, 0x41, 0xae, 0xaa, 0x74, 0x39, 0x65, 0x31, 0xfe, 0x61, 0x8c, 0x3b, 0xa2, 0x57, 0x7b, 0xbc,
+	0x18, 0xfe, 0x8b, 0x81, 0x0f, 0xb0, 0x43, 0x19, 0xaf, 0xc9, 0xbf, 0xd1, 0x92, 0xfe, 0x13, 0x80,
+	0xd9, 0x5d, 0xec, 0x61, 0x47, 0xa0, 0x2f, 0xe1, 0x8c, 0x20, 0xcc, 0x32, 0x09, 0xc3, 0x1d, 0x9b,
+	0x58, 0x0a, 0xd0, 0xd2, 0xe5, 0xe9, 0x25, 0xad, 0x3a, 0xc6, 0x73, 0xb5, 0x45, 0x98, 0xd5, 0x88,
+	0x78, 0x6b, 0x13, 0x0a, 0x30, 0xa6, 0xc5, 0x70, 0x01, 0xdd, 0x83, 0x05, 0x8b, 0xec, 0xe3, 0xc0,
+	0xf6, 0xcd, 0x37, 0x04, 0x27, 0x34, 0x50, 0xce, 0x1b, 0x28, 0xc6, 0x46, 0x24, 0x56, 0xee, 0x1e,
+	0x5d, 0x9e, 0x56, 0x94, 0xa8, 0xd0, 0x82, 0xb0, 0x0e, 0x6b, 0x4f, 0xa3, 0x16, 0x46, 0xce, 0xf4,
+	0x75, 0x38, 0x3d, 0xc2, 0x46, 0x05, 0x38, 0x69, 0x11, 0xc6, 0x1d, 0x05, 0x68, 0xa0, 0x3c, 0x65,
+	0x44, 0x01, 0x52, 0x60, 0xee, 0xcd, 0x42, 0x49, 0xb8, 0x92, 0x79, 0x7d, 0x52, 0x02, 0xfa, 0x0b,
+	0x00, 0x27, 0x9b, 0xcc, 0x0d, 0x7c, 0xb4, 0x04, 0x73, 0xd8, 0xb2, 0x3c, 0x22, 0x44, 0xa4, 0xb0,
+	0xa6, 0xfc, 0x71, 0xb6, 0x50, 0x88, 0xb7, 0xb9, 0x1a, 0x21, 0x2d, 0xdf, 0xa3, 0xac, 0x67, 0x24,
+	0x44, 0xf4, 0x04, 0x4e, 0x86, 0x1d, 0x16, 0xca, 0x84, 0xec, 0xca, 0xdc, 0xb0, 0x2b, 0x82, 0x5c,
+	0x75, 0x65, 0x9d, 0x53, 0xb6, 0xb6, 0xf1, 0xfc, 0xbc, 0x94, 0xfa, 0xf5, 0xef, 0x52, 0xb9, 0x47,
+	0xfd, 0x83, 0xa0, 0x53, 0xed, 0x72, 0x27, 0x3e, 0xbe, 0xda, 0xc8, 0x06, 0xfd, 0xbe, 0x4b, 0x84,
+	0x4c, 0x10, 0x3f, 0x5e, 0x9e, 0x56, 0x66, 0x6c, 0xd2, 0xc3, 0xdd, 0xbe, 0x29, 0x6b, 0xfc, 0x72,
+	0x79, 0x5a, 0x01, 0x46, 0x54, 0x6f, 0xa5, 0xf0, 0xec, 0xa4, 0x94, 0x7a, 0x7d, 0x52, 0x4a, 0x7d,
+	0x77, 0x79, 0x5a, 0x49, 0xec, 0xe8, 0xbf, 0x03, 0x98, 0xdd, 0x09, 0xfc, 0x77, 0x6e, 0x37, 0xf9,
+	0x64, 0x37, 0xfa, 0x6f, 0x00, 0x66, 0x5b, 0x81, 0xeb, 0xda, 0xfd, 0xd0, 0x8d, 0xcf, 0x7d, 0x6c,
+	0xc7, 0x13, 0xf7, 0x7f, 0xb8, 0x91, 0xf5, 0x56, 0x3e, 0x89, 0xdd, 0x80, 0x17, 0x67, 0x0b, 0xf3,
+	0x63, 0xc7, 0x5c, 0x1a, 0x6c, 0x2a, 0x40, 0x7f, 0x04, 0xa7, 0xea, 0xe1, 0x98, 0xb5, 0x19, 0xf5,
+	0x6f, 0x18, 0xc0, 0x22, 0xcc, 0x93, 0xa7, 0x2e, 0x67, 0x84, 0xf9, 0x72, 0x02, 0xdf, 0x33, 0xae,
+	0xe2, 0x70, 0x38, 0xb1, 0x4d, 0xb1, 0x20, 0x42, 0x49, 0x6b, 0xe9, 0xf2, 0x94, 0x91, 0x84, 0xfa,
+	0xab, 0x09, 0x98, 0xdf, 0x22, 0x3e, 0xb6, 0xb0, 0x8f, 0x91, 0x06, 0xa7, 0x2d, 0x22, 0xba, 0x1e,
+	0x75, 0x7d, 0xca, 0x59, 0x2c, 0x3f, 0xba, 0x84, 0x1e, 0x86, 0x0c, 0xc6, 0x1d, 0x33, 0x60, 0xd4,
+	0x4f, 0xce, 0x4f, 0x1d, 0x7b, 0x47, 0xaf, 0xfc, 0x1a, 0xd0, 0x4a, 0x3e, 0x05, 0x42, 0x30, 0x13,
+	0xf6, 0x55, 0x49, 0x4b, 0x6d, 0xf9, 0x1d, 0xba, 0xb3, 0xa8, 0x70, 0x6d, 0xdc, 0x57, 0x32, 0x72,
+	0x39, 0x09, 0xd1, 0xc7, 0x30, 0xc3, 0xb0, 0x43, 0x94, 0x49, 0x39, 0x59, 0xb7, 0x5f, 0x9d, 0x2d,
+	0xbc, 0x3f, 0x6c, 0xb4, 0x76, 0xaf, 0xfa, 0xf9, 0xb2, 0x21, 0x09, 0xe8, 0x53, 0x98, 0x15, 0x7d,
+	0xa7, 0xc3, 0x6d, 0x25, 0x7b, 0x33, 0x35, 0xa6, 0xa0, 0xcf, 0x60, 0x3a, 0xf0, 0xa8, 0x92, 0x93,
+	0xcc, 0xe2, 0xe0, 0xbc, 0x94, 0x6e, 0x1b, 0xcd, 0xff, 0x26, 0xdc, 0x37, 0x42, 0x1a, 0x7a, 0x00,
+	0xf3, 0x81, 0x47, 0xcd, 0x03, 0x2c, 0x0e, 0x94, 0xbc, 0x4c, 0x51, 0x07, 0xe7, 0xa5, 0x5c, 0xdb,
+	0x68, 0x7e, 0x85, 0xc5, 0xc1, 0xb8, 0xb4, 0x5c, 0xe0, 0xd1, 0x10, 0xd3, 0x7f, 0x00, 0xf0, 0xd6,
+	0x9e, 0x87, 0x99, 0xd8, 0x27, 0xde, 0x2e, 0xb7, 0x69, 0xb7, 0x7f, 0xc3, 0xd9, 0x3d, 0x80, 0x19,
+	0x87, 0x5b, 0x44, 0x9e, 0xdb, 0xad, 0xa5, 0x8f, 0xc6, 0xf6, 0x33, 0x11, 0xda, 0xa4, 0xc2, 0xdf,
+	0xe2, 0x16, 0x31, 0x64, 0x4a, 0x78, 0xff, 0x1c, 0xcc, 0x70, 0x8f, 0x78, 0x51, 0x4f, 0xdf, 0x76,
+	0xff, 0x62, 0xa2, 0xfe, 0x2d, 0x9c, 0x19, 0x55, 0xbb, 0xc1, 0xd4, 0x7d, 0x38, 0x15, 0x5f, 0x58,
+	0x12, 0x9d, 0xf4, 0xdb, 0xb4, 0x87, 0xd4, 0xca, 0x5f, 0x00, 0xce, 0x5e, 0x37, 0x8b, 0xd6, 0xa1,
+	0xba, 0x67, 0xac, 0x6e, 0xb7, 0x36, 0x1a, 0x86, 0xb9, 0xd9, 0x6c, 0xed, 0x99, 0x5b, 0x3b, 0xf5,
+	0x86, 0xd9, 0xde, 0x6e, 0xed, 0x36, 0xd6, 0x9b, 0x1b, 0xcd, 0x46, 0x7d, 0x36, 0x55, 0x2c, 0x1d,
+	0x1d, 0x6b, 0xf3, 0xd7, 0x33, 0xdb, 0x4c, 0xb8, 0xa4, 0x4b, 0xf7, 0x29, 0xb1, 0xd0, 0x43, 0x78,
+	0x67, 0x8c, 0xc8, 0xea, 0xe6, 0xe6, 0xce, 0xa3, 0x30, 0x9c, 0x05, 0xc5, 0xbb, 0x47, 0xc7, 0xda,
+	0xdc, 0x75, 0x89, 0x55, 0xdb, 0xe6, 0x4f, 0xec, 0x70, 0xa3, 0x5f, 0xc0, 0xf9, 0x31, 0x02, 0xf5,
+	0xc6, 0xf6, 0xd7, 0x32, 0x7f, 0xa2, 0x78, 0xe7, 0xe8, 0x58, 0x53, 0xae, 0xe7, 0xd7, 0x09, 0xeb,
+	0x87, 0xe9, 0xc5, 0xcc, 0xb3, 0x9f, 0xd5, 0xd4, 0xda, 0xf2, 0xf3, 0x81, 0x0a, 0x5e, 0x0e, 0x54,
+	0xf0, 0xcf, 0x40, 0x05, 0xdf, 0x5f, 0xa8, 0xa9, 0x97, 0x17, 0x6a, 0xea, 0xcf, 0x0b, 0x35, 0xf5,
+	0x4d, 0xfc, 0x68, 0x0a, 0xeb, 0xb0, 0x4a, 0x79, 0xf2, 0x88, 0xc8, 0x9f, 0x83, 0x4e, 0x56, 0xbe,
+	0x77, 0xcb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x1e, 0xf2, 0xf8, 0xa3, 0xa3, 0x07, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -902,6 +1067,87 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TransferPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Mode != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -1065,6 +1311,45 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *TransferPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovBank(uint64(m.Mode))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2034,6 +2319,253 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TransferPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= TransferListMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,6 +15,8 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(registrar, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(registrar, &MsgSetTransferPolicy{}, "cosmos-sdk/x/bank/MsgSetTransferPolicy")
+	legacy.RegisterAminoMsg(registrar, &MsgUpdateTransferList{}, "cosmos-sdk/x/bank/MsgUpdateTransferList")
 
 	registrar.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization")
 	registrar.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params")
@@ -27,6 +29,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgBurn{},
 		&MsgSetSendEnabled{},
+		&MsgSetTransferPolicy{},
+		&MsgUpdateTransferList{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -13,4 +13,6 @@ var (
 	ErrDuplicateEntry        = errors.Register(ModuleName, 8, "duplicate entry")
 	ErrMultipleSenders       = errors.Register(ModuleName, 9, "multiple senders not allowed")
 	ErrInvalidSigner         = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
+	ErrTransferRestricted    = errors.Register(ModuleName, 11, "transfer restricted by the transfer list of the denom")
+	ErrNoTransferPolicy      = errors.Register(ModuleName, 12, "transfer policy not found")
 )
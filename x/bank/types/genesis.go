@@ -63,6 +63,36 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenPolicies := make(map[string]bool)
+	for _, policy := range gs.TransferPolicies {
+		if seenPolicies[policy.Denom] {
+			return fmt.Errorf("duplicate transfer policy for denom %s", policy.Denom)
+		}
+
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+
+		if policy.Mode == TransferListModeUnspecified {
+			return fmt.Errorf("transfer policy of denom %s must specify a transfer list mode", policy.Denom)
+		}
+
+		seenPolicies[policy.Denom] = true
+	}
+
+	seenLists := make(map[string]bool)
+	for _, list := range gs.TransferLists {
+		if seenLists[list.Denom] {
+			return fmt.Errorf("duplicate transfer list for denom %s", list.Denom)
+		}
+
+		if !seenPolicies[list.Denom] {
+			return fmt.Errorf("transfer list of denom %s has no transfer policy", list.Denom)
+		}
+
+		seenLists[list.Denom] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -40,6 +40,10 @@ type GenesisState struct {
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
 	// send_enabled defines the denoms where send is enabled or disabled.
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// transfer_policies defines the denoms restricted by a transfer list.
+	TransferPolicies []TransferPolicy `protobuf:"bytes,6,rep,name=transfer_policies,json=transferPolicies,proto3" json:"transfer_policies"`
+	// transfer_lists defines the addresses listed in the transfer lists of the denoms.
+	TransferLists []TransferList `protobuf:"bytes,7,rep,name=transfer_lists,json=transferLists,proto3" json:"transfer_lists"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +114,20 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetTransferPolicies() []TransferPolicy {
+	if m != nil {
+		return m.TransferPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferLists() []TransferList {
+	if m != nil {
+		return m.TransferLists
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -160,37 +178,42 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x40, 0x98, 0x0e, 0x6e, 0x29, 0x4e, 0xe8, 0x14, 0x55,
-	0xca, 0x99, 0xa6, 0x48, 0x48, 0x0c, 0x48, 0xb8, 0x02, 0x26, 0x04, 0x6a, 0x36, 0x96, 0xe8, 0xec,
-	0x3b, 0x19, 0x2b, 0xf1, 0x9d, 0x95, 0x77, 0x05, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a, 0x22, 0xa6,
-	0x0e, 0xfd, 0x00, 0x88, 0xa9, 0x63, 0xc5, 0x84, 0x18, 0x00, 0x25, 0x43, 0xf9, 0x18, 0xc8, 0xf7,
-	0xae, 0x49, 0x24, 0x3c, 0xb3, 0xd8, 0x96, 0xff, 0xff, 0xf7, 0xfb, 0xbf, 0xf7, 0xee, 0xc8, 0xdd,
-	0x44, 0x41, 0xae, 0x20, 0x8c, 0x99, 0x1c, 0x86, 0x6f, 0xf6, 0x62, 0xa1, 0xd9, 0x5e, 0x98, 0x0a,
-	0x29, 0x20, 0x03, 0x5a, 0x8c, 0x95, 0x56, 0xde, 0x2d, 0xb4, 0xd0, 0xd2, 0x42, 0xad, 0x65, 0x6b,
-	0x23, 0x55, 0xa9, 0x32, 0x7a, 0x58, 0x7e, 0xa1, 0x75, 0x2b, 0x98, 0xd3, 0x40, 0xcc, 0x69, 0x89,
-	0xca, 0xe4, 0x3f, 0xfa, 0x52, 0x9a, 0xe1, 0xa2, 0xbe, 0x89, 0xfa, 0x00, 0xc1, 0x36, 0x17, 0xa5,
-	0x9b, 0x2c, 0xcf, 0xa4, 0x0a, 0xcd, 0x13, 0x7f, 0xed, 0x7c, 0xad, 0x91, 0xe6, 0x33, 0x6c, 0xb5,
-	0xaf, 0x99, 0x16, 0xde, 0x23, 0x52, 0x2f, 0xd8, 0x98, 0xe5, 0xe0, 0xbb, 0x6d, 0xb7, 0xd3, 0xe8,
-	0xdd, 0xa6, 0x15, 0xad, 0xd3, 0x97, 0xc6, 0x12, 0xad, 0x9f, 0xfd, 0x6c, 0x39, 0x9f, 0x2e, 0x4e,
-	0x76, 0xdd, 0x43, 0x5b, 0xe5, 0x1d, 0x90, 0xb5, 0x98, 0x8d, 0x98, 0x4c, 0x04, 0xf8, 0x57, 0xda,
-	0xb5, 0x4e, 0xa3, 0xb7, 0x5d, 0x49, 0x88, 0xd0, 0xb4, 0x8c, 0x98, 0x17, 0x7a, 0x13, 0x52, 0x87,
-	0xa3, 0xa2, 0x18, 0x4d, 0xfc, 0x9a, 0x41, 0x6c, 0x2e, 0x10, 0x20, 0xe6, 0x88, 0x03, 0x95, 0xc9,
-	0xe8, 0x69, 0x59, 0xff, 0xf9, 0x57, 0xab, 0x93, 0x66, 0xfa, 0xf5, 0x51, 0x4c, 0x13, 0x95, 0xdb,
-	0xa1, 0xed, 0xab, 0x0b, 0x7c, 0x18, 0xea, 0x49, 0x21, 0xc0, 0x14, 0xc0, 0xc7, 0x8b, 0x93, 0xdd,
-	0xe6, 0x48, 0xa4, 0x2c, 0x99, 0x0c, 0xca, 0xb5, 0x82, 0xed, 0x1f, 0x03, 0xbd, 0x17, 0xe4, 0x3a,
-	0x17, 0x52, 0xe5, 0x83, 0x5c, 0x68, 0xc6, 0x99, 0x66, 0xfe, 0x55, 0xd3, 0xc2, 0x9d, 0xca, 0x29,
-	0x9e, 0x5b, 0xd3, 0xf2, 0x18, 0xd7, 0x4c, 0xfd, 0xa5, 0xe2, 0x31, 0xd2, 0x04, 0x21, 0xf9, 0x40,
-	0x48, 0x16, 0x8f, 0x04, 0xf7, 0x57, 0x0c, 0xae, 0x5d, 0x89, 0xeb, 0x0b, 0xc9, 0x9f, 0xa0, 0x2f,
-	0xda, 0x2e, 0x89, 0x3f, 0x4e, 0xbb, 0x37, 0x16, 0x63, 0xb4, 0xef, 0xd1, 0xfb, 0x0f, 0x30, 0xa4,
-	0x01, 0x0b, 0xeb, 0xce, 0x17, 0x97, 0xac, 0xda, 0x7d, 0x7a, 0x3d, 0xb2, 0xca, 0x38, 0x1f, 0x0b,
-	0xc0, 0x03, 0x5c, 0x8f, 0xfc, 0x6f, 0xa7, 0xdd, 0x0d, 0x1b, 0xf6, 0x18, 0x95, 0xbe, 0x1e, 0x67,
-	0x32, 0x3d, 0xbc, 0x34, 0x7a, 0x6f, 0xc9, 0x8a, 0xd9, 0x84, 0x3d, 0xb0, 0xff, 0xb0, 0x6d, 0xcc,
-	0x7b, 0xb8, 0xf6, 0xfe, 0xb8, 0xe5, 0xfc, 0x39, 0x6e, 0x39, 0xd1, 0xfe, 0xd9, 0x34, 0x70, 0xcf,
-	0xa7, 0x81, 0xfb, 0x7b, 0x1a, 0xb8, 0x1f, 0x66, 0x81, 0x73, 0x3e, 0x0b, 0x9c, 0xef, 0xb3, 0xc0,
-	0x79, 0x65, 0xef, 0x33, 0xf0, 0x21, 0xcd, 0x54, 0xf8, 0x0e, 0xef, 0xbd, 0x49, 0x88, 0xeb, 0xe6,
-	0x0e, 0xef, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xe3, 0x0e, 0x29, 0x81, 0x03, 0x00, 0x00,
+	// 546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x6d, 0xda, 0x26, 0xed, 0x25, 0x2d, 0xd4, 0x74, 0x70, 0x4b, 0x71, 0xd2, 0xb2, 0x44,
+	0x95, 0x62, 0xd3, 0x14, 0x09, 0x89, 0x01, 0x09, 0x57, 0xc0, 0xc2, 0x9f, 0x2a, 0x41, 0x0c, 0x2c,
+	0xd1, 0xd9, 0x3e, 0xcc, 0x29, 0xf6, 0x9d, 0xe5, 0xf7, 0x0a, 0xe4, 0x1b, 0x30, 0x32, 0x33, 0x75,
+	0x44, 0x4c, 0x1d, 0xfa, 0x01, 0x18, 0x3b, 0x56, 0x4c, 0x88, 0x01, 0x50, 0x32, 0x14, 0xf1, 0x29,
+	0x90, 0xef, 0xae, 0x49, 0x24, 0x2c, 0x46, 0x16, 0xdb, 0xba, 0xe7, 0x79, 0x7f, 0xcf, 0xfb, 0xfa,
+	0xee, 0xd0, 0x56, 0xc8, 0x21, 0xe5, 0xe0, 0x05, 0x98, 0x0d, 0xbc, 0xd7, 0xbb, 0x01, 0x11, 0x78,
+	0xd7, 0x8b, 0x09, 0x23, 0x40, 0xc1, 0xcd, 0x72, 0x2e, 0xb8, 0x75, 0x55, 0x59, 0xdc, 0xc2, 0xe2,
+	0x6a, 0xcb, 0xc6, 0x5a, 0xcc, 0x63, 0x2e, 0x75, 0xaf, 0xf8, 0x52, 0xd6, 0x0d, 0x67, 0x42, 0x03,
+	0x32, 0xa1, 0x85, 0x9c, 0xb2, 0xbf, 0xf4, 0x99, 0x34, 0xc9, 0x55, 0xfa, 0xba, 0xd2, 0xfb, 0x0a,
+	0xac, 0x73, 0x95, 0xb4, 0x8a, 0x53, 0xca, 0xb8, 0x27, 0x9f, 0x6a, 0x69, 0xfb, 0xf7, 0x3c, 0xaa,
+	0x3f, 0x54, 0xad, 0xf6, 0x04, 0x16, 0xc4, 0xba, 0x8b, 0x2a, 0x19, 0xce, 0x71, 0x0a, 0xb6, 0xd9,
+	0x34, 0x5b, 0xb5, 0xce, 0x35, 0xb7, 0xa4, 0x75, 0xf7, 0x40, 0x5a, 0xfc, 0xa5, 0xd3, 0xef, 0x0d,
+	0xe3, 0xe3, 0xf9, 0xf1, 0x8e, 0xd9, 0xd5, 0x55, 0xd6, 0x3e, 0x5a, 0x0c, 0x70, 0x82, 0x59, 0x48,
+	0xc0, 0xbe, 0xd4, 0x9c, 0x6b, 0xd5, 0x3a, 0x9b, 0xa5, 0x04, 0x5f, 0x99, 0x66, 0x11, 0x93, 0x42,
+	0x6b, 0x88, 0x2a, 0x70, 0x98, 0x65, 0xc9, 0xd0, 0x9e, 0x93, 0x88, 0xf5, 0x29, 0x02, 0xc8, 0x04,
+	0xb1, 0xcf, 0x29, 0xf3, 0x1f, 0x14, 0xf5, 0x9f, 0x7e, 0x34, 0x5a, 0x31, 0x15, 0xaf, 0x0e, 0x03,
+	0x37, 0xe4, 0xa9, 0x1e, 0x5a, 0xbf, 0xda, 0x10, 0x0d, 0x3c, 0x31, 0xcc, 0x08, 0xc8, 0x02, 0xf8,
+	0x70, 0x7e, 0xbc, 0x53, 0x4f, 0x48, 0x8c, 0xc3, 0x61, 0xbf, 0xf8, 0xad, 0xa0, 0xfb, 0x57, 0x81,
+	0xd6, 0x53, 0xb4, 0x12, 0x11, 0xc6, 0xd3, 0x7e, 0x4a, 0x04, 0x8e, 0xb0, 0xc0, 0xf6, 0xbc, 0x6c,
+	0xe1, 0x7a, 0xe9, 0x14, 0x8f, 0xb5, 0x69, 0x76, 0x8c, 0x65, 0x59, 0x7f, 0xa1, 0x58, 0x18, 0xd5,
+	0x81, 0xb0, 0xa8, 0x4f, 0x18, 0x0e, 0x12, 0x12, 0xd9, 0x0b, 0x12, 0xd7, 0x2c, 0xc5, 0xf5, 0x08,
+	0x8b, 0xee, 0x2b, 0x9f, 0xbf, 0x59, 0x10, 0xbf, 0x9d, 0xb4, 0x2f, 0x4f, 0xc7, 0x68, 0xde, 0x74,
+	0x6f, 0xdd, 0x56, 0x21, 0x35, 0x98, 0x5a, 0xad, 0xe7, 0x68, 0x55, 0xe4, 0x98, 0xc1, 0x4b, 0x92,
+	0xf7, 0x33, 0x9e, 0xd0, 0x90, 0x12, 0xb0, 0x2b, 0x32, 0xe7, 0x46, 0x69, 0xce, 0x33, 0xed, 0x3e,
+	0x28, 0xcc, 0x43, 0x7f, 0xbe, 0x88, 0xea, 0x5e, 0x11, 0xb3, 0xab, 0x94, 0x80, 0xf5, 0x04, 0xad,
+	0x4c, 0xb8, 0x09, 0x05, 0x01, 0x76, 0x55, 0x42, 0xb7, 0xfe, 0x09, 0x7d, 0x44, 0x41, 0x68, 0xe4,
+	0xb2, 0x98, 0x59, 0x83, 0xed, 0xcf, 0x26, 0xaa, 0xea, 0x7d, 0xb7, 0x3a, 0xa8, 0x8a, 0xa3, 0x28,
+	0x27, 0xa0, 0x0e, 0xda, 0x92, 0x6f, 0x7f, 0x39, 0x69, 0xaf, 0x69, 0xee, 0x3d, 0xa5, 0xf4, 0x44,
+	0x4e, 0x59, 0xdc, 0xbd, 0x30, 0x5a, 0x6f, 0xd0, 0x82, 0xdc, 0x31, 0x7d, 0xb0, 0xfe, 0xc3, 0xa9,
+	0x50, 0x79, 0x77, 0x16, 0xdf, 0x1d, 0x35, 0x8c, 0x5f, 0x47, 0x0d, 0xc3, 0xdf, 0x3b, 0x1d, 0x39,
+	0xe6, 0xd9, 0xc8, 0x31, 0x7f, 0x8e, 0x1c, 0xf3, 0xfd, 0xd8, 0x31, 0xce, 0xc6, 0x8e, 0xf1, 0x75,
+	0xec, 0x18, 0x2f, 0xf4, 0xbd, 0x83, 0x68, 0xe0, 0x52, 0xee, 0xbd, 0x55, 0xf7, 0x53, 0x26, 0x04,
+	0x15, 0x79, 0xd7, 0xf6, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x34, 0x4d, 0x02, 0x7e, 0x29, 0x04,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +236,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TransferLists) > 0 {
+		for iNdEx := len(m.TransferLists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferLists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TransferPolicies) > 0 {
+		for iNdEx := len(m.TransferPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -369,6 +420,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TransferPolicies) > 0 {
+		for _, e := range m.TransferPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferLists) > 0 {
+		for _, e := range m.TransferLists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -595,6 +658,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferPolicies = append(m.TransferPolicies, TransferPolicy{})
+			if err := m.TransferPolicies[len(m.TransferPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferLists = append(m.TransferLists, TransferList{})
+			if err := m.TransferLists[len(m.TransferLists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
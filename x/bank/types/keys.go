@@ -24,6 +24,9 @@ const (
 	// It should be synced with the mint module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/0e34478eb7420b69869ed50f129fc274a97a9b06/x/mint/types/keys.go#L13
 	MintModuleName = "mint"
+
+	// MaxBalancesByAddresses is the maximum number of addresses of a Query/BalancesByAddresses request.
+	MaxBalancesByAddresses = 100
 )
 
 // KVStore keys
@@ -39,6 +42,11 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// TransferPoliciesPrefix is the prefix for the transfer policies of the denoms.
+	TransferPoliciesPrefix = collections.NewPrefix(6)
+	// TransferListsPrefix is the prefix for the addresses listed in the transfer lists of the denoms.
+	TransferListsPrefix = collections.NewPrefix(7)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
@@ -51,3 +59,9 @@ var BalanceValueCodec = collcodec.NewAltValueCodec(sdk.IntValue, func(bytes []by
 	}
 	return c.Amount, nil
 })
+
+// BalanceStoreKey returns the key of the balance of denom for addr in the bank store. It can be used to
+// query a balance along with its ICS-23 proof through an ABCI store query.
+func BalanceStoreKey(addr sdk.AccAddress, denom string) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(BalancesPrefix.Bytes(), collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), collections.Join(addr, denom))
+}
@@ -25,3 +25,13 @@ func TestBalanceValueCodec(t *testing.T) {
 		require.Equal(t, coin.Amount, amt)
 	})
 }
+
+func TestBalanceStoreKey(t *testing.T) {
+	addr := sdk.AccAddress("addr1_______________")
+	key, err := BalanceStoreKey(addr, "stake")
+	require.NoError(t, err)
+
+	expected := append([]byte{2, byte(len(addr))}, addr...)
+	expected = append(expected, "stake"...)
+	require.Equal(t, expected, key)
+}
@@ -10,6 +10,8 @@ var (
 	_ coretransaction.Msg = &MsgSend{}
 	_ coretransaction.Msg = &MsgMultiSend{}
 	_ coretransaction.Msg = &MsgUpdateParams{}
+	_ coretransaction.Msg = &MsgSetTransferPolicy{}
+	_ coretransaction.Msg = &MsgUpdateTransferList{}
 )
 
 // NewMsgSend constructs a msg to send coins from one account to another.
@@ -30,3 +32,13 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgSetTransferPolicy creates a new MsgSetTransferPolicy instance.
+func NewMsgSetTransferPolicy(authority string, policy TransferPolicy) *MsgSetTransferPolicy {
+	return &MsgSetTransferPolicy{Authority: authority, Policy: policy}
+}
+
+// NewMsgUpdateTransferList creates a new MsgUpdateTransferList instance.
+func NewMsgUpdateTransferList(sender, denom string, add, remove []string) *MsgUpdateTransferList {
+	return &MsgUpdateTransferList{Sender: sender, Denom: denom, Add: add, Remove: remove}
+}
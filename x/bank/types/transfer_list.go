@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTransferPolicy creates a new TransferPolicy instance.
+func NewTransferPolicy(denom string, mode TransferListMode, manager string) TransferPolicy {
+	return TransferPolicy{
+		Denom:   denom,
+		Mode:    mode,
+		Manager: manager,
+	}
+}
+
+// Validate performs a stateless validation of the transfer policy.
+// The manager address is validated by the keeper.
+func (p TransferPolicy) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+
+	if _, ok := TransferListMode_name[int32(p.Mode)]; !ok {
+		return fmt.Errorf("invalid transfer list mode %d for denom %s", p.Mode, p.Denom)
+	}
+
+	return nil
+}
+
+// IsAllowed returns whether an address, given whether it is listed, is allowed to send or receive the denom.
+func (m TransferListMode) IsAllowed(listed bool) bool {
+	switch m {
+	case TransferListModeAllowlist:
+		return listed
+	case TransferListModeDenylist:
+		return !listed
+	default:
+		return true
+	}
+}
@@ -14,7 +14,8 @@ import (
 )
 
 const (
-	FlagDenom = "denom"
+	FlagDenom        = "denom"
+	FlagResolveDenom = "resolve-denom"
 )
 
 // GetQueryCmd returns the parent command for all x/bank CLi query commands. The
@@ -31,6 +32,11 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalanceCmd(),
+		GetBalancesCmd(),
+		GetTotalSupplyCmd(),
+		GetDenomMetadataCmd(),
+		GetDenomAuthorityMetadataCmd(),
+		GetDenomsFromCreatorCmd(),
 	)
 
 	return cmd
@@ -77,3 +83,166 @@ func GetBalanceCmd() *cobra.Command {
 
 	return cmd
 }
+
+func GetBalancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances [address]",
+		Short: "Query all the balances of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			resolveDenom, err := cmd.Flags().GetBool(FlagResolveDenom)
+			if err != nil {
+				return err
+			}
+
+			req := types.NewQueryAllBalancesRequest(addr.String(), pageReq, resolveDenom)
+			out := new(types.QueryAllBalancesResponse)
+
+			err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QueryAllBalancesRequest{}), req, out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(out)
+		},
+	}
+
+	cmd.Flags().Bool(FlagResolveDenom, false, "Resolve the denoms to their display denom from the denom metadata")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all balances")
+
+	return cmd
+}
+
+func GetTotalSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-supply",
+		Short: "Query the total supply of coins of the chain",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTotalSupplyRequest{Pagination: pageReq}
+			out := new(types.QueryTotalSupplyResponse)
+
+			err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QueryTotalSupplyRequest{}), req, out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "total supply")
+
+	return cmd
+}
+
+func GetDenomMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-metadata [denom]",
+		Short: "Query the client metadata of a coin denomination",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomMetadataRequest{Denom: args[0]}
+			out := new(types.QueryDenomMetadataResponse)
+
+			err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QueryDenomMetadataRequest{}), req, out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetDenomAuthorityMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-authority-metadata [denom]",
+		Short: "Query the admin and the send restriction of a token factory denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomAuthorityMetadataRequest{Denom: args[0]}
+			out := new(types.QueryDenomAuthorityMetadataResponse)
+
+			err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QueryDenomAuthorityMetadataRequest{}), req, out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetDenomsFromCreatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denoms-from-creator [creator]",
+		Short: "Query the token factory denoms created by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomsFromCreatorRequest{Creator: args[0]}
+			out := new(types.QueryDenomsFromCreatorResponse)
+
+			err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QueryDenomsFromCreatorRequest{}), req, out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
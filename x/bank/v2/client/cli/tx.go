@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"errors"
+
 	"github.com/spf13/cobra"
 
 	"cosmossdk.io/x/bank/v2/types"
@@ -24,6 +26,11 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewSendTxCmd(),
+		NewMultiSendTxCmd(),
+		NewBurnTxCmd(),
+		NewCreateDenomTxCmd(),
+		NewChangeDenomAdminTxCmd(),
+		NewSetDenomSendRestrictionTxCmd(),
 	)
 
 	return txCmd
@@ -63,3 +70,162 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.
 
 	return cmd
 }
+
+// NewMultiSendTxCmd returns a CLI command handler for creating a MsgMultiSend transaction.
+func NewMultiSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-send [from_key_or_address] [to_address_1] [to_address_2] ... [amount]",
+		Short: "Send funds from one account to two or more accounts.",
+		Long: `Send funds from one account to two or more accounts, each receiving the given amount.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
+When using '--dry-run' a key name cannot be used, only a bech32 address.
+`,
+		Args: cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[len(args)-1])
+			if err != nil {
+				return err
+			}
+
+			if coins.IsZero() {
+				return errors.New("must send positive amount")
+			}
+
+			var (
+				outputs    []types.Output
+				totalCoins sdk.Coins
+			)
+			for _, arg := range args[1 : len(args)-1] {
+				outputs = append(outputs, types.NewOutput(arg, coins))
+				totalCoins = totalCoins.Add(coins...)
+			}
+
+			msg := types.NewMsgMultiSend(types.NewInput(clientCtx.GetFromAddress().String(), totalCoins), outputs)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewBurnTxCmd returns a CLI command handler for creating a MsgBurn transaction.
+func NewBurnTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [from_key_or_address] [amount]",
+		Short: "Burn funds from an account.",
+		Long: `Burn funds from an account, removing them from the supply.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress().String(), coins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCreateDenomTxCmd returns a CLI command handler for creating a MsgCreateDenom transaction.
+func NewCreateDenomTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom [subdenom]",
+		Short: "Create the token factory denom factory/{sender}/{subdenom}, administered by the sender.",
+		Long: `Create the token factory denom factory/{sender}/{subdenom}, administered by the sender.
+The denom creation fee is burnt from the sender.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDenom(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewChangeDenomAdminTxCmd returns a CLI command handler for creating a MsgChangeDenomAdmin transaction.
+func NewChangeDenomAdminTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-denom-admin [denom] [new_admin]",
+		Short: "Change the admin of a token factory denom.",
+		Long: `Change the admin of a token factory denom. An empty new admin ("") renounces the administration of the denom.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangeDenomAdmin(clientCtx.GetFromAddress().String(), args[0], args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetDenomSendRestrictionTxCmd returns a CLI command handler for creating a MsgSetDenomSendRestriction transaction.
+func NewSetDenomSendRestrictionTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-send-restriction [denom] [send_restriction]",
+		Short: "Attach a registered send restriction to a token factory denom.",
+		Long: `Attach a registered send restriction to a token factory denom. An empty send restriction ("") removes
+the send restriction of the denom.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomSendRestriction(clientCtx.GetFromAddress().String(), args[0], args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
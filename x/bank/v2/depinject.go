@@ -28,6 +28,7 @@ func init() {
 		&moduletypes.Module{},
 		appconfig.Provide(ProvideModule),
 		appconfig.Invoke(InvokeSetSendRestrictions),
+		appconfig.Invoke(InvokeSetDenomSendRestrictions),
 	)
 }
 
@@ -103,3 +104,14 @@ func InvokeSetSendRestrictions(
 
 	return nil
 }
+
+// InvokeSetDenomSendRestrictions registers the denom send restrictions provided by the modules, under
+// their module name, so that token factory denom admins can attach them to their denoms.
+func InvokeSetDenomSendRestrictions(
+	keeper *keeper.Keeper,
+	restrictions map[string]types.DenomSendRestrictionFn,
+) {
+	for module, restriction := range restrictions {
+		keeper.RegisterDenomSendRestriction(module, restriction)
+	}
+}
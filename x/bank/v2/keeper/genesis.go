@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/bank/v2/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -44,6 +45,30 @@ func (k *Keeper) InitGenesis(ctx context.Context, state *types.GenesisState) err
 		k.setSupply(ctx, supply)
 	}
 
+	for _, se := range state.SendEnabled {
+		if err := k.SetSendEnabled(ctx, se.Denom, se.Enabled); err != nil {
+			return err
+		}
+	}
+
+	for _, meta := range state.DenomMetadata {
+		if err := k.SetDenomMetaData(ctx, meta); err != nil {
+			return err
+		}
+	}
+
+	for _, denom := range state.FactoryDenoms {
+		if admin := denom.AuthorityMetadata.Admin; admin != "" {
+			if _, err := k.addressCodec.StringToBytes(admin); err != nil {
+				return fmt.Errorf("invalid admin of denom %s: %w", denom.Denom, err)
+			}
+		}
+
+		if err := k.factoryDenoms.Set(ctx, denom.Denom, denom.AuthorityMetadata); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -53,5 +78,49 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, fmt.Errorf("failed to get params: %w", err)
 	}
 
-	return types.NewGenesisState(params), nil
+	var balances []types.Balance
+	err = k.balances.Walk(ctx, nil, func(key collections.Pair[[]byte, string], amt math.Int) (stop bool, err error) {
+		addr, err := k.addressCodec.BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		// balances are ordered by address, so the coins of an address are contiguous
+		coin := sdk.NewCoin(key.K2(), amt)
+		if n := len(balances); n > 0 && balances[n-1].Address == addr {
+			balances[n-1].Coins = balances[n-1].Coins.Add(coin)
+		} else {
+			balances = append(balances, types.Balance{Address: addr, Coins: sdk.NewCoins(coin)})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balances: %w", err)
+	}
+
+	supply := sdk.NewCoins()
+	err = k.supply.Walk(ctx, nil, func(denom string, amt math.Int) (stop bool, err error) {
+		supply = supply.Add(sdk.NewCoin(denom, amt))
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get supply: %w", err)
+	}
+
+	metadata, err := k.GetAllDenomMetaData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get denoms metadata: %w", err)
+	}
+
+	sendEnabled, err := k.GetAllSendEnabledEntries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get send enabled entries: %w", err)
+	}
+
+	factoryDenoms, err := k.GetAllFactoryDenoms(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token factory denoms: %w", err)
+	}
+
+	return types.NewGenesisState(params, balances, supply, metadata, sendEnabled, factoryDenoms), nil
 }
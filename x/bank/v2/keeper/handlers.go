@@ -9,11 +9,14 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/bank/v2/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 type handlers struct {
@@ -27,20 +30,10 @@ func NewHandlers(k *Keeper) handlers {
 
 // UpdateParams updates the parameters of the bank/v2 module.
 func (h handlers) MsgUpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
-	authorityBytes, err := h.addressCodec.StringToBytes(msg.Authority)
-	if err != nil {
+	if err := h.validateAuthority(msg.Authority); err != nil {
 		return nil, err
 	}
 
-	if !bytes.Equal(h.authority, authorityBytes) {
-		expectedAuthority, err := h.addressCodec.BytesToString(h.authority)
-		if err != nil {
-			return nil, err
-		}
-
-		return nil, fmt.Errorf("invalid authority; expected %s, got %s", expectedAuthority, msg.Authority)
-	}
-
 	if err := msg.Params.Validate(); err != nil {
 		return nil, err
 	}
@@ -76,7 +69,9 @@ func (h handlers) MsgSend(ctx context.Context, msg *types.MsgSend) (*types.MsgSe
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
 	}
 
-	// TODO: Check denom enable
+	if err := h.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
 
 	err = h.SendCoins(ctx, from, to, msg.Amount)
 	if err != nil {
@@ -87,18 +82,17 @@ func (h handlers) MsgSend(ctx context.Context, msg *types.MsgSend) (*types.MsgSe
 }
 
 func (h handlers) MsgMint(ctx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
-	authorityBytes, err := h.addressCodec.StringToBytes(msg.Authority)
-	if err != nil {
-		return nil, err
-	}
-
-	if !bytes.Equal(h.authority, authorityBytes) {
-		expectedAuthority, err := h.addressCodec.BytesToString(h.authority)
-		if err != nil {
-			return nil, err
+	// the module authority can mint any denom, token factory denoms can also be minted by their admin
+	if err := h.validateAuthority(msg.Authority); err != nil {
+		for _, coin := range msg.Amount {
+			if !types.IsFactoryDenom(coin.Denom) {
+				return nil, err
+			}
+
+			if err := h.validateDenomAdmin(ctx, msg.Authority, coin.Denom); err != nil {
+				return nil, err
+			}
 		}
-
-		return nil, fmt.Errorf("invalid authority; expected %s, got %s", expectedAuthority, msg.Authority)
 	}
 
 	to, err := h.addressCodec.StringToBytes(msg.ToAddress)
@@ -123,6 +117,188 @@ func (h handlers) MsgMint(ctx context.Context, msg *types.MsgMint) (*types.MsgMi
 	return &types.MsgMintResponse{}, nil
 }
 
+func (h handlers) MsgBurn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	from, err := h.addressCodec.StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if !msg.Amount.IsAllPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	err = h.BurnCoins(ctx, from, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+func (h handlers) MsgMultiSend(ctx context.Context, msg *types.MsgMultiSend) (*types.MsgMultiSendResponse, error) {
+	if len(msg.Inputs) == 0 {
+		return nil, types.ErrNoInputs
+	}
+
+	if len(msg.Inputs) != 1 {
+		return nil, types.ErrMultipleSenders
+	}
+
+	if len(msg.Outputs) == 0 {
+		return nil, types.ErrNoOutputs
+	}
+
+	// NOTE: totalIn == totalOut should already have been checked
+	if err := h.IsSendEnabledCoins(ctx, msg.Inputs[0].Coins...); err != nil {
+		return nil, err
+	}
+
+	err := h.InputOutputCoins(ctx, msg.Inputs[0], msg.Outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMultiSendResponse{}, nil
+}
+
+func (h handlers) MsgSetSendEnabled(ctx context.Context, msg *types.MsgSetSendEnabled) (*types.MsgSetSendEnabledResponse, error) {
+	if err := h.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	for _, se := range msg.SendEnabled {
+		if _, alreadySeen := seen[se.Denom]; alreadySeen {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom entries found for %q", se.Denom)
+		}
+
+		seen[se.Denom] = true
+
+		if err := sdk.ValidateDenom(se.Denom); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid SendEnabled denom %q: %s", se.Denom, err)
+		}
+	}
+
+	for _, denom := range msg.UseDefaultFor {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid UseDefaultFor denom %q: %s", denom, err)
+		}
+	}
+
+	for _, se := range msg.SendEnabled {
+		if err := h.SetSendEnabled(ctx, se.Denom, se.Enabled); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.DeleteSendEnabled(ctx, msg.UseDefaultFor...); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSendEnabledResponse{}, nil
+}
+
+func (h handlers) MsgSetDenomMetadata(ctx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	// the metadata of token factory denoms can also be set by their admin
+	if err := h.validateAuthority(msg.Authority); err != nil {
+		if !types.IsFactoryDenom(msg.Metadata.Base) {
+			return nil, err
+		}
+
+		if err := h.validateDenomAdmin(ctx, msg.Authority, msg.Metadata.Base); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.SetDenomMetaData(ctx, msg.Metadata); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid metadata: %s", err)
+	}
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+func (h handlers) MsgCreateDenom(ctx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	sender, err := h.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	denom, err := h.CreateDenom(ctx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+func (h handlers) MsgChangeDenomAdmin(ctx context.Context, msg *types.MsgChangeDenomAdmin) (*types.MsgChangeDenomAdminResponse, error) {
+	if err := h.validateDenomAdmin(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if err := h.ChangeDenomAdmin(ctx, msg.Denom, msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeDenomAdminResponse{}, nil
+}
+
+func (h handlers) MsgSetDenomSendRestriction(ctx context.Context, msg *types.MsgSetDenomSendRestriction) (*types.MsgSetDenomSendRestrictionResponse, error) {
+	if err := h.validateDenomAdmin(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if err := h.SetDenomSendRestriction(ctx, msg.Denom, msg.SendRestriction); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomSendRestrictionResponse{}, nil
+}
+
+// validateDenomAdmin checks that admin is the admin of the given token factory denom.
+func (h handlers) validateDenomAdmin(ctx context.Context, admin, denom string) error {
+	if _, err := h.addressCodec.StringToBytes(admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	metadata, found, err := h.GetDenomAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errorsmod.Wrapf(types.ErrDenomNotFound, "denom %s", denom)
+	}
+
+	if metadata.Admin == "" || metadata.Admin != admin {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the admin of denom %s", admin, denom)
+	}
+
+	return nil
+}
+
+// validateAuthority checks that authority is the authority of the module.
+func (h handlers) validateAuthority(authority string) error {
+	authorityBytes, err := h.addressCodec.StringToBytes(authority)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(h.authority, authorityBytes) {
+		expectedAuthority, err := h.addressCodec.BytesToString(h.authority)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("invalid authority; expected %s, got %s", expectedAuthority, authority)
+	}
+
+	return nil
+}
+
 // QueryParams queries the parameters of the bank/v2 module.
 func (h handlers) QueryParams(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -137,7 +313,7 @@ func (h handlers) QueryParams(ctx context.Context, req *types.QueryParamsRequest
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
-// QueryBalance queries the parameters of the bank/v2 module.
+// QueryBalance queries the balance of a single coin for a single account.
 func (h handlers) QueryBalance(ctx context.Context, req *types.QueryBalanceRequest) (*types.QueryBalanceResponse, error) {
 	if req == nil {
 		return nil, errors.New("empty request")
@@ -156,3 +332,220 @@ func (h handlers) QueryBalance(ctx context.Context, req *types.QueryBalanceReque
 
 	return &types.QueryBalanceResponse{Balance: &balance}, nil
 }
+
+// QueryAllBalances queries the balances of all coins for a single account.
+func (h handlers) QueryAllBalances(ctx context.Context, req *types.QueryAllBalancesRequest) (*types.QueryAllBalancesResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	addr, err := h.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	balances, pageRes, err := h.paginateBalances(ctx, addr, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ResolveDenom {
+		for i, balance := range balances {
+			if metadata, found, err := h.GetDenomMetaData(ctx, balance.Denom); err == nil && found && metadata.Display != "" {
+				balances[i].Denom = metadata.Display
+			}
+		}
+	}
+
+	return &types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
+// QuerySpendableBalances queries the spendable balances of all coins for a single account.
+// As x/bank/v2 does not lock coins, the spendable balances are the balances of the account.
+func (h handlers) QuerySpendableBalances(ctx context.Context, req *types.QuerySpendableBalancesRequest) (*types.QuerySpendableBalancesResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	addr, err := h.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	balances, pageRes, err := h.paginateBalances(ctx, addr, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySpendableBalancesResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
+// paginateBalances returns a page of the balances of the given account.
+func (h handlers) paginateBalances(ctx context.Context, addr []byte, pageReq *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	balances, pageRes, err := query.CollectionPaginate(
+		ctx,
+		h.balances,
+		pageReq,
+		func(key collections.Pair[[]byte, string], value math.Int) (sdk.Coin, error) {
+			return sdk.NewCoin(key.K2(), value), nil
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, string](addr),
+	)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return balances, pageRes, nil
+}
+
+// QueryTotalSupply queries the total supply of all coins.
+func (h handlers) QueryTotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	supply, pageRes, err := query.CollectionPaginate(
+		ctx,
+		h.supply,
+		req.Pagination,
+		func(denom string, value math.Int) (sdk.Coin, error) {
+			return sdk.NewCoin(denom, value), nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTotalSupplyResponse{Supply: supply, Pagination: pageRes}, nil
+}
+
+// QuerySupplyOf queries the supply of a single coin.
+func (h handlers) QuerySupplyOf(ctx context.Context, req *types.QuerySupplyOfRequest) (*types.QuerySupplyOfResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	supply := h.GetSupply(ctx, req.Denom)
+
+	return &types.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply.Amount)}, nil
+}
+
+// QueryDenomMetadata queries the client metadata of a given coin denomination.
+func (h handlers) QueryDenomMetadata(ctx context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	metadata, found, err := h.GetDenomMetaData(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "client metadata for denom %s", req.Denom)
+	}
+
+	return &types.QueryDenomMetadataResponse{Metadata: metadata}, nil
+}
+
+// QueryDenomsMetadata queries the client metadata of all registered coin denominations.
+func (h handlers) QueryDenomsMetadata(ctx context.Context, req *types.QueryDenomsMetadataRequest) (*types.QueryDenomsMetadataResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	metadatas, pageRes, err := query.CollectionPaginate(
+		ctx,
+		h.denomMetadata,
+		req.Pagination,
+		func(_ string, metadata types.Metadata) (types.Metadata, error) {
+			return metadata, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryDenomsMetadataResponse{Metadatas: metadatas, Pagination: pageRes}, nil
+}
+
+// QuerySendEnabled queries the SendEnabled entries of the given denoms, or all
+// the entries if no denoms are provided.
+func (h handlers) QuerySendEnabled(ctx context.Context, req *types.QuerySendEnabledRequest) (*types.QuerySendEnabledResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	resp := &types.QuerySendEnabledResponse{}
+	if len(req.Denoms) > 0 {
+		for _, denom := range req.Denoms {
+			entry, found, err := h.GetSendEnabledEntry(ctx, denom)
+			if err != nil {
+				return nil, err
+			}
+			if found {
+				resp.SendEnabled = append(resp.SendEnabled, &entry)
+			}
+		}
+		return resp, nil
+	}
+
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		h.sendEnabled,
+		req.Pagination,
+		func(denom string, enabled bool) (*types.SendEnabled, error) {
+			return &types.SendEnabled{Denom: denom, Enabled: enabled}, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp.SendEnabled = results
+	resp.Pagination = pageRes
+
+	return resp, nil
+}
+
+// QueryDenomAuthorityMetadata queries the authority metadata of a token factory denom.
+func (h handlers) QueryDenomAuthorityMetadata(ctx context.Context, req *types.QueryDenomAuthorityMetadataRequest) (*types.QueryDenomAuthorityMetadataResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	metadata, found, err := h.GetDenomAuthorityMetadata(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token factory denom %s", req.Denom)
+	}
+
+	return &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: metadata}, nil
+}
+
+// QueryDenomsFromCreator queries the token factory denoms created by an account.
+func (h handlers) QueryDenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	if _, err := h.addressCodec.StringToBytes(req.Creator); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	denoms, err := h.GetDenomsFromCreator(ctx, req.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms}, nil
+}
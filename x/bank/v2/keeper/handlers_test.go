@@ -0,0 +1,232 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/bank/v2/keeper"
+	banktestutil "cosmossdk.io/x/bank/v2/testutil"
+	banktypes "cosmossdk.io/x/bank/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func newFooMetadata() banktypes.Metadata {
+	return banktypes.Metadata{
+		Name:        "Foo",
+		Symbol:      "FOO",
+		Description: "The foo coin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: fooDenom, Exponent: 0},
+			{Denom: "mfoo", Exponent: 3},
+			{Denom: "kfoo", Exponent: 6, Aliases: []string{"kilofoo"}},
+		},
+		Base:    fooDenom,
+		Display: "kfoo",
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	authority, err := suite.addressCodec.BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(err)
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	// only the authority can set send enabled entries
+	_, err = handlers.MsgSetSendEnabled(ctx, banktypes.NewMsgSetSendEnabled(acc0Str, nil, nil))
+	require.ErrorContains(err, "invalid authority")
+
+	_, err = handlers.MsgSetSendEnabled(ctx, banktypes.NewMsgSetSendEnabled(authority, []*banktypes.SendEnabled{
+		{Denom: fooDenom, Enabled: false},
+		{Denom: fooDenom, Enabled: true},
+	}, nil))
+	require.ErrorContains(err, "duplicate denom entries")
+
+	_, err = handlers.MsgSetSendEnabled(ctx, banktypes.NewMsgSetSendEnabled(authority, []*banktypes.SendEnabled{
+		{Denom: fooDenom, Enabled: false},
+	}, nil))
+	require.NoError(err)
+
+	// sending a disabled denom fails, other denoms use the default
+	_, err = handlers.MsgSend(ctx, banktypes.NewMsgSend(acc0Str, acc1Str, sdk.NewCoins(newFooCoin(10))))
+	require.ErrorIs(err, banktypes.ErrSendDisabled)
+
+	_, err = handlers.MsgMultiSend(ctx, banktypes.NewMsgMultiSend(
+		banktypes.NewInput(acc0Str, sdk.NewCoins(newFooCoin(10))),
+		[]banktypes.Output{banktypes.NewOutput(acc1Str, sdk.NewCoins(newFooCoin(10)))},
+	))
+	require.ErrorIs(err, banktypes.ErrSendDisabled)
+
+	_, err = handlers.MsgSend(ctx, banktypes.NewMsgSend(acc0Str, acc1Str, sdk.NewCoins(newBarCoin(10))))
+	require.NoError(err)
+
+	res, err := handlers.QuerySendEnabled(ctx, &banktypes.QuerySendEnabledRequest{})
+	require.NoError(err)
+	require.Equal([]*banktypes.SendEnabled{{Denom: fooDenom, Enabled: false}}, res.SendEnabled)
+
+	// reset foo to the default
+	_, err = handlers.MsgSetSendEnabled(ctx, banktypes.NewMsgSetSendEnabled(authority, nil, []string{fooDenom}))
+	require.NoError(err)
+
+	_, err = handlers.MsgSend(ctx, banktypes.NewMsgSend(acc0Str, acc1Str, sdk.NewCoins(newFooCoin(10))))
+	require.NoError(err)
+
+	res, err = handlers.QuerySendEnabled(ctx, &banktypes.QuerySendEnabledRequest{Denoms: []string{fooDenom}})
+	require.NoError(err)
+	require.Empty(res.SendEnabled)
+}
+
+func (suite *KeeperTestSuite) TestMsgBurnAndMultiSend() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+	acc2Str, err := suite.addressCodec.BytesToString(accAddrs[2])
+	require.NoError(err)
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	_, err = handlers.MsgBurn(ctx, banktypes.NewMsgBurn(acc0Str, sdk.Coins{newFooCoin(0)}))
+	require.Error(err)
+
+	_, err = handlers.MsgBurn(ctx, banktypes.NewMsgBurn(acc0Str, sdk.NewCoins(newFooCoin(40))))
+	require.NoError(err)
+
+	supply, err := handlers.QuerySupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Equal(newFooCoin(60), supply.Amount)
+
+	// only one input is allowed
+	_, err = handlers.MsgMultiSend(ctx, &banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{
+			banktypes.NewInput(acc0Str, sdk.NewCoins(newFooCoin(10))),
+			banktypes.NewInput(acc1Str, sdk.NewCoins(newFooCoin(10))),
+		},
+		Outputs: []banktypes.Output{banktypes.NewOutput(acc2Str, sdk.NewCoins(newFooCoin(20)))},
+	})
+	require.ErrorIs(err, banktypes.ErrMultipleSenders)
+
+	_, err = handlers.MsgMultiSend(ctx, banktypes.NewMsgMultiSend(
+		banktypes.NewInput(acc0Str, sdk.NewCoins(newFooCoin(30))),
+		[]banktypes.Output{
+			banktypes.NewOutput(acc1Str, sdk.NewCoins(newFooCoin(10))),
+			banktypes.NewOutput(acc2Str, sdk.NewCoins(newFooCoin(20))),
+		},
+	))
+	require.NoError(err)
+
+	balances, err := handlers.QueryAllBalances(ctx, banktypes.NewQueryAllBalancesRequest(acc2Str, nil, false))
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(20)), balances.Balances)
+
+	spendable, err := handlers.QuerySpendableBalances(ctx, banktypes.NewQuerySpendableBalancesRequest(acc0Str, nil))
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(30)), spendable.Balances)
+}
+
+func (suite *KeeperTestSuite) TestDenomMetadata() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	authority, err := suite.addressCodec.BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(err)
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+
+	invalid := newFooMetadata()
+	invalid.Display = "gfoo"
+	_, err = handlers.MsgSetDenomMetadata(ctx, &banktypes.MsgSetDenomMetadata{Authority: authority, Metadata: invalid})
+	require.ErrorContains(err, "metadata must contain a denomination unit with display denom")
+
+	_, err = handlers.MsgSetDenomMetadata(ctx, &banktypes.MsgSetDenomMetadata{Authority: acc0Str, Metadata: newFooMetadata()})
+	require.ErrorContains(err, "invalid authority")
+
+	_, err = handlers.MsgSetDenomMetadata(ctx, &banktypes.MsgSetDenomMetadata{Authority: authority, Metadata: newFooMetadata()})
+	require.NoError(err)
+
+	res, err := handlers.QueryDenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Equal(newFooMetadata(), res.Metadata)
+
+	_, err = handlers.QueryDenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: barDenom})
+	require.ErrorContains(err, "client metadata for denom bar")
+
+	all, err := handlers.QueryDenomsMetadata(ctx, &banktypes.QueryDenomsMetadataRequest{})
+	require.NoError(err)
+	require.Equal([]banktypes.Metadata{newFooMetadata()}, all.Metadatas)
+
+	// the balances can be resolved to their display denom
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+	balances, err := handlers.QueryAllBalances(ctx, banktypes.NewQueryAllBalancesRequest(acc0Str, nil, true))
+	require.NoError(err)
+	require.Equal(sdk.Coins{newBarCoin(50), sdk.NewInt64Coin("kfoo", 100)}, balances.Balances)
+}
+
+func (suite *KeeperTestSuite) TestQueryPagination() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+
+	balances, err := handlers.QueryAllBalances(ctx, banktypes.NewQueryAllBalancesRequest(acc0Str, &query.PageRequest{Limit: 1, CountTotal: true}, false))
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newBarCoin(50)), balances.Balances)
+	require.Equal(uint64(2), balances.Pagination.Total)
+
+	balances, err = handlers.QueryAllBalances(ctx, banktypes.NewQueryAllBalancesRequest(acc0Str, &query.PageRequest{Key: balances.Pagination.NextKey}, false))
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(100)), balances.Balances)
+
+	supply, err := handlers.QueryTotalSupply(ctx, &banktypes.QueryTotalSupplyRequest{})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(110), newBarCoin(50)), supply.Supply)
+}
+
+func (suite *KeeperTestSuite) TestExportGenesis() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	genesis := banktypes.NewGenesisState(
+		banktypes.DefaultParams(),
+		[]banktypes.Balance{
+			{Address: acc0Str, Coins: sdk.NewCoins(newFooCoin(100), newBarCoin(50))},
+			{Address: acc1Str, Coins: sdk.NewCoins(newFooCoin(10))},
+		},
+		sdk.NewCoins(newFooCoin(110), newBarCoin(50)),
+		[]banktypes.Metadata{newFooMetadata()},
+		[]banktypes.SendEnabled{{Denom: barDenom, Enabled: false}},
+		[]banktypes.GenesisDenom{{Denom: "factory/" + acc0Str + "/foo", AuthorityMetadata: banktypes.DenomAuthorityMetadata{Admin: acc1Str}}},
+	)
+	require.NoError(genesis.Validate())
+	require.NoError(suite.bankKeeper.InitGenesis(ctx, genesis))
+
+	exported, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.ElementsMatch(genesis.Balances, exported.Balances)
+	require.Equal(genesis.Supply, exported.Supply)
+	require.Equal(genesis.DenomMetadata, exported.DenomMetadata)
+	require.Equal(genesis.SendEnabled, exported.SendEnabled)
+	require.Equal(genesis.FactoryDenoms, exported.FactoryDenoms)
+}
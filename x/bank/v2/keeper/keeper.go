@@ -22,14 +22,18 @@ import (
 type Keeper struct {
 	appmodulev2.Environment
 
-	authority    []byte
-	addressCodec address.Codec
-	schema       collections.Schema
-	params       collections.Item[types.Params]
-	balances     *collections.IndexedMap[collections.Pair[[]byte, string], math.Int, BalancesIndexes]
-	supply       collections.Map[string, math.Int]
-
-	sendRestriction *sendRestriction
+	authority     []byte
+	addressCodec  address.Codec
+	schema        collections.Schema
+	params        collections.Item[types.Params]
+	balances      *collections.IndexedMap[collections.Pair[[]byte, string], math.Int, BalancesIndexes]
+	supply        collections.Map[string, math.Int]
+	sendEnabled   collections.Map[string, bool]
+	denomMetadata collections.Map[string, types.Metadata]
+	factoryDenoms collections.Map[string, types.DenomAuthorityMetadata]
+
+	sendRestriction       *sendRestriction
+	denomSendRestrictions map[string]types.DenomSendRestrictionFn
 }
 
 func NewKeeper(authority []byte, addressCodec address.Codec, env appmodulev2.Environment, cdc codec.BinaryCodec) *Keeper {
@@ -42,7 +46,12 @@ func NewKeeper(authority []byte, addressCodec address.Codec, env appmodulev2.Env
 		params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		balances:        collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(collections.BytesKey, collections.StringKey), sdk.IntValue, newBalancesIndexes(sb)),
 		supply:          collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
+		sendEnabled:     collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue),
+		denomMetadata:   collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
+		factoryDenoms:   collections.NewMap(sb, types.FactoryDenomsPrefix, "factory_denoms", collections.StringKey, codec.CollValue[types.DenomAuthorityMetadata](cdc)),
 		sendRestriction: newSendRestriction(),
+
+		denomSendRestrictions: make(map[string]types.DenomSendRestrictionFn),
 	}
 
 	schema, err := sb.Build()
@@ -87,6 +96,103 @@ func (k Keeper) MintCoins(ctx context.Context, addr []byte, amounts sdk.Coins) e
 	)
 }
 
+// BurnCoins burns coins from the given account, deleting them from the balance and the supply.
+func (k Keeper) BurnCoins(ctx context.Context, addr []byte, amounts sdk.Coins) error {
+	if !amounts.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amounts.String())
+	}
+
+	err := k.subUnlockedCoins(ctx, addr, amounts)
+	if err != nil {
+		return err
+	}
+
+	for _, amount := range amounts {
+		supply := k.GetSupply(ctx, amount.GetDenom())
+		supply = supply.Sub(amount)
+		k.setSupply(ctx, supply)
+	}
+
+	addrStr, err := k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	// emit burn event
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCoinBurn,
+		event.NewAttribute(types.AttributeKeyBurner, addrStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, amounts.String()),
+	)
+}
+
+// InputOutputCoins performs multi-send functionality. It accepts an
+// input that corresponds to a series of outputs. It returns an error if the
+// input and the outputs are not equal or if the send restrictions reject an output.
+func (k Keeper) InputOutputCoins(ctx context.Context, input types.Input, outputs []types.Output) error {
+	// Safety check ensuring that when sending coins the keeper must maintain the
+	// Check supply invariant and validity of Coins.
+	if err := types.ValidateInputOutputs(input, outputs); err != nil {
+		return err
+	}
+
+	inAddress, err := k.addressCodec.StringToBytes(input.Address)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid input address: %s", err)
+	}
+
+	// ensure all coins can be sent
+	type toSend struct {
+		address []byte
+		coins   sdk.Coins
+	}
+	sending := make([]toSend, 0, len(outputs))
+	for _, out := range outputs {
+		outAddress, err := k.addressCodec.StringToBytes(out.Address)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid output address: %s", err)
+		}
+
+		outAddress, err = k.sendRestriction.apply(ctx, inAddress, outAddress, out.Coins)
+		if err != nil {
+			return err
+		}
+
+		outAddress, err = k.applyDenomSendRestrictions(ctx, inAddress, outAddress, out.Coins)
+		if err != nil {
+			return err
+		}
+
+		sending = append(sending, toSend{address: outAddress, coins: out.Coins})
+	}
+
+	if err := k.subUnlockedCoins(ctx, inAddress, input.Coins); err != nil {
+		return err
+	}
+
+	for _, out := range sending {
+		if err := k.addCoins(ctx, out.address, out.coins); err != nil {
+			return err
+		}
+
+		outAddrString, err := k.addressCodec.BytesToString(out.address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeTransfer,
+			event.NewAttribute(types.AttributeKeyRecipient, outAddrString),
+			event.NewAttribute(types.AttributeKeySender, input.Address),
+			event.NewAttribute(sdk.AttributeKeyAmount, out.coins.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // Function take sender & recipient as []byte.
 // They can be sdk address or module name.
@@ -102,6 +208,11 @@ func (k Keeper) SendCoins(ctx context.Context, from, to []byte, amt sdk.Coins) e
 		return err
 	}
 
+	to, err = k.applyDenomSendRestrictions(ctx, from, to, amt)
+	if err != nil {
+		return err
+	}
+
 	err = k.subUnlockedCoins(ctx, from, amt)
 	if err != nil {
 		return err
@@ -148,6 +259,19 @@ func (k Keeper) GetBalance(ctx context.Context, addr []byte, denom string) sdk.C
 	return sdk.NewCoin(denom, amt)
 }
 
+// GetAllBalances returns all the balances of the given account, sorted by denom.
+func (k Keeper) GetAllBalances(ctx context.Context, addr []byte) sdk.Coins {
+	balances := sdk.NewCoins()
+	err := k.balances.Walk(ctx, collections.NewPrefixedPairRange[[]byte, string](addr), func(key collections.Pair[[]byte, string], amt math.Int) (stop bool, err error) {
+		balances = balances.Add(sdk.NewCoin(key.K2(), amt))
+		return false, nil
+	})
+	if err != nil {
+		return sdk.NewCoins()
+	}
+	return balances
+}
+
 // subUnlockedCoins removes the unlocked amt coins of the given account.
 // An error is returned if the resulting balance is negative.
 //
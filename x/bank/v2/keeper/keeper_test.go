@@ -236,3 +236,64 @@ func (suite *KeeperTestSuite) TestSendCoins_WithRestriction() {
 	acc1BarBalance := suite.bankKeeper.GetBalance(ctx, accAddrs[1], barDenom)
 	require.Equal(acc1BarBalance.Amount, math.ZeroInt())
 }
+
+func (suite *KeeperTestSuite) TestBurnCoins() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	// Try burn more than the balance
+	err := suite.bankKeeper.BurnCoins(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(101)))
+	require.Error(err)
+
+	require.NoError(suite.bankKeeper.BurnCoins(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(30), newBarCoin(50))))
+
+	// Check balances and supply
+	require.Equal(sdk.NewCoins(newFooCoin(70)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(newFooCoin(70), suite.bankKeeper.GetSupply(ctx, fooDenom))
+	require.Equal(newBarCoin(0), suite.bankKeeper.GetSupply(ctx, barDenom))
+}
+
+func (suite *KeeperTestSuite) TestInputOutputCoins() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+	acc2Str, err := suite.addressCodec.BytesToString(accAddrs[2])
+	require.NoError(err)
+
+	input := banktypes.NewInput(acc0Str, sdk.NewCoins(newFooCoin(30), newBarCoin(10)))
+	outputs := []banktypes.Output{
+		banktypes.NewOutput(acc1Str, sdk.NewCoins(newFooCoin(20))),
+		banktypes.NewOutput(acc2Str, sdk.NewCoins(newFooCoin(10), newBarCoin(10))),
+	}
+
+	// Try with mismatching inputs and outputs
+	err = suite.bankKeeper.InputOutputCoins(ctx, input, outputs[:1])
+	require.ErrorIs(err, banktypes.ErrInputOutputMismatch)
+
+	// The send restrictions apply to each output
+	suite.bankKeeper.AppendGlobalSendRestriction(func(ctx context.Context, from, to []byte, amount sdk.Coins) ([]byte, error) {
+		if bytes.Equal(to, accAddrs[2]) {
+			return accAddrs[3], nil
+		}
+		return to, nil
+	})
+	defer suite.bankKeeper.ClearGlobalSendRestriction()
+
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, input, outputs))
+
+	// Check balances
+	require.Equal(sdk.NewCoins(newFooCoin(70), newBarCoin(40)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(20)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]).IsZero())
+	require.Equal(sdk.NewCoins(newFooCoin(10), newBarCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[3]))
+}
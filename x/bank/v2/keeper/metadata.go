@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/bank/v2/types"
+)
+
+// GetDenomMetaData retrieves the denomination metadata. returns the metadata and true if the denom exists,
+// false otherwise.
+func (k Keeper) GetDenomMetaData(ctx context.Context, denom string) (types.Metadata, bool, error) {
+	metadata, err := k.denomMetadata.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Metadata{}, false, nil
+	} else if err != nil {
+		return types.Metadata{}, false, err
+	}
+
+	return metadata, true, nil
+}
+
+// SetDenomMetaData validates and sets the denominations metadata, keyed by its base denom.
+func (k Keeper) SetDenomMetaData(ctx context.Context, denomMetaData types.Metadata) error {
+	if err := denomMetaData.Validate(); err != nil {
+		return err
+	}
+
+	return k.denomMetadata.Set(ctx, denomMetaData.Base, denomMetaData)
+}
+
+// GetAllDenomMetaData retrieves all the denominations metadata.
+func (k Keeper) GetAllDenomMetaData(ctx context.Context) ([]types.Metadata, error) {
+	var metadatas []types.Metadata
+	err := k.denomMetadata.Walk(ctx, nil, func(_ string, metadata types.Metadata) (stop bool, err error) {
+		metadatas = append(metadatas, metadata)
+		return false, nil
+	})
+	return metadatas, err
+}
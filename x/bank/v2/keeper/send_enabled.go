@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/bank/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the parameters of the bank/v2 module, or the default
+// parameters if they were never set.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+	return params, err
+}
+
+// IsSendEnabledCoins checks the coins provided and returns an ErrSendDisabled
+// if any of the coins are not configured for sending. Returns nil if sending is
+// enabled for all provided coins.
+func (k Keeper) IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error {
+	if len(coins) == 0 {
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range coins {
+		enabled, err := k.getSendEnabledOrDefault(ctx, coin.Denom, params.DefaultSendEnabled)
+		if err != nil {
+			return err
+		}
+		if !enabled {
+			return types.ErrSendDisabled.Wrapf("%s transfers are currently disabled", coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// IsSendEnabledDenom returns the current SendEnabled status of the provided denom.
+func (k Keeper) IsSendEnabledDenom(ctx context.Context, denom string) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return k.getSendEnabledOrDefault(ctx, denom, params.DefaultSendEnabled)
+}
+
+// GetSendEnabledEntry gets a SendEnabled entry for the given denom.
+// The second return argument is true iff a specific entry exists for the given denom.
+func (k Keeper) GetSendEnabledEntry(ctx context.Context, denom string) (types.SendEnabled, bool, error) {
+	enabled, err := k.sendEnabled.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.SendEnabled{}, false, nil
+	} else if err != nil {
+		return types.SendEnabled{}, false, err
+	}
+
+	return types.SendEnabled{Denom: denom, Enabled: enabled}, true, nil
+}
+
+// SetSendEnabled sets the SendEnabled flag for a denom to the provided value.
+func (k Keeper) SetSendEnabled(ctx context.Context, denom string, value bool) error {
+	return k.sendEnabled.Set(ctx, denom, value)
+}
+
+// DeleteSendEnabled deletes the SendEnabled flags for one or more denoms.
+// If a denom is provided that doesn't have a SendEnabled entry, it is ignored.
+func (k Keeper) DeleteSendEnabled(ctx context.Context, denoms ...string) error {
+	for _, denom := range denoms {
+		if err := k.sendEnabled.Remove(ctx, denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAllSendEnabledEntries gets all the SendEnabled entries that are stored.
+// Any denominations not returned use the default value (set in Params).
+func (k Keeper) GetAllSendEnabledEntries(ctx context.Context) ([]types.SendEnabled, error) {
+	var entries []types.SendEnabled
+	err := k.sendEnabled.Walk(ctx, nil, func(denom string, enabled bool) (stop bool, err error) {
+		entries = append(entries, types.SendEnabled{Denom: denom, Enabled: enabled})
+		return false, nil
+	})
+	return entries, err
+}
+
+// getSendEnabledOrDefault gets the SendEnabled value for a denom. If it's not
+// in the store, this will return defaultVal.
+func (k Keeper) getSendEnabledOrDefault(ctx context.Context, denom string, defaultVal bool) (bool, error) {
+	entry, found, err := k.GetSendEnabledEntry(ctx, denom)
+	if err != nil || !found {
+		return defaultVal, err
+	}
+
+	return entry.Enabled, nil
+}
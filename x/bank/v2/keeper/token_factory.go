@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateDenom creates the token factory denom factory/{creator}/{subdenom}, administered by the creator,
+// and burns the denom creation fee from the creator.
+func (k Keeper) CreateDenom(ctx context.Context, creator []byte, subdenom string) (string, error) {
+	creatorStr, err := k.addressCodec.BytesToString(creator)
+	if err != nil {
+		return "", err
+	}
+
+	denom, err := types.GetTokenDenom(creatorStr, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	has, err := k.factoryDenoms.Has(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+	// a denom already in circulation cannot be taken over, even if it is not a token factory denom yet
+	if has || k.GetSupply(ctx, denom).IsPositive() {
+		return "", errorsmod.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if !params.DenomCreationFee.IsZero() {
+		if err := k.BurnCoins(ctx, creator, params.DenomCreationFee); err != nil {
+			return "", errorsmod.Wrap(err, "failed to pay the denom creation fee")
+		}
+	}
+
+	if err := k.factoryDenoms.Set(ctx, denom, types.DenomAuthorityMetadata{Admin: creatorStr}); err != nil {
+		return "", err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateDenom,
+		event.NewAttribute(types.AttributeKeyCreator, creatorStr),
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+	); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// GetDenomAuthorityMetadata returns the authority metadata of a token factory denom and true if the denom exists,
+// false otherwise.
+func (k Keeper) GetDenomAuthorityMetadata(ctx context.Context, denom string) (types.DenomAuthorityMetadata, bool, error) {
+	metadata, err := k.factoryDenoms.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DenomAuthorityMetadata{}, false, nil
+	} else if err != nil {
+		return types.DenomAuthorityMetadata{}, false, err
+	}
+
+	return metadata, true, nil
+}
+
+// ChangeDenomAdmin sets the admin of a token factory denom. An empty admin removes the admin of the denom.
+func (k Keeper) ChangeDenomAdmin(ctx context.Context, denom, newAdmin string) error {
+	metadata, err := k.factoryDenoms.Get(ctx, denom)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrDenomNotFound, "denom %s", denom)
+	}
+
+	if newAdmin != "" {
+		if _, err := k.addressCodec.StringToBytes(newAdmin); err != nil {
+			return errorsmod.Wrapf(err, "invalid new admin address %s", newAdmin)
+		}
+	}
+
+	metadata.Admin = newAdmin
+	if err := k.factoryDenoms.Set(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeChangeDenomAdmin,
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+		event.NewAttribute(types.AttributeKeyNewAdmin, newAdmin),
+	)
+}
+
+// SetDenomSendRestriction attaches the denom send restriction registered under the given name to a token
+// factory denom. An empty name removes the send restriction of the denom.
+func (k Keeper) SetDenomSendRestriction(ctx context.Context, denom, name string) error {
+	metadata, err := k.factoryDenoms.Get(ctx, denom)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrDenomNotFound, "denom %s", denom)
+	}
+
+	if _, ok := k.denomSendRestrictions[name]; name != "" && !ok {
+		return errorsmod.Wrapf(types.ErrUnknownRestriction, "%s", name)
+	}
+
+	metadata.SendRestriction = name
+	if err := k.factoryDenoms.Set(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetDenomSendRestriction,
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+		event.NewAttribute(types.AttributeKeySendRestriction, name),
+	)
+}
+
+// GetDenomsFromCreator returns the token factory denoms created by the given creator, sorted by denom.
+func (k Keeper) GetDenomsFromCreator(ctx context.Context, creator string) ([]string, error) {
+	// token factory denoms are prefixed by their creator, so they can be ranged over
+	rng := new(collections.Range[string]).Prefix(types.FactoryDenomPrefix + "/" + creator + "/")
+
+	var denoms []string
+	err := k.factoryDenoms.Walk(ctx, rng, func(denom string, _ types.DenomAuthorityMetadata) (stop bool, err error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	})
+	return denoms, err
+}
+
+// GetAllFactoryDenoms returns all the token factory denoms and their authority metadata.
+func (k Keeper) GetAllFactoryDenoms(ctx context.Context) ([]types.GenesisDenom, error) {
+	var denoms []types.GenesisDenom
+	err := k.factoryDenoms.Walk(ctx, nil, func(denom string, metadata types.DenomAuthorityMetadata) (stop bool, err error) {
+		denoms = append(denoms, types.GenesisDenom{Denom: denom, AuthorityMetadata: metadata})
+		return false, nil
+	})
+	return denoms, err
+}
+
+// RegisterDenomSendRestriction registers a send restriction that token factory denom admins can attach
+// to their denoms under the given name.
+func (k Keeper) RegisterDenomSendRestriction(name string, restriction types.DenomSendRestrictionFn) {
+	k.denomSendRestrictions[name] = restriction
+}
+
+// applyDenomSendRestrictions applies the send restrictions attached to the token factory denoms of amt,
+// each receiving the coins of its denom. A restriction providing a new receiver address redirects all of amt.
+func (k Keeper) applyDenomSendRestrictions(ctx context.Context, fromAddr, toAddr []byte, amt sdk.Coins) ([]byte, error) {
+	for _, coin := range amt {
+		if !types.IsFactoryDenom(coin.Denom) {
+			continue
+		}
+
+		metadata, found, err := k.GetDenomAuthorityMetadata(ctx, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+		if !found || metadata.SendRestriction == "" {
+			continue
+		}
+
+		restriction, ok := k.denomSendRestrictions[metadata.SendRestriction]
+		if !ok {
+			return nil, errorsmod.Wrapf(types.ErrUnknownRestriction, "%s for denom %s", metadata.SendRestriction, coin.Denom)
+		}
+
+		toAddr, err = restriction(ctx, fromAddr, toAddr, sdk.Coins{coin})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return toAddr, nil
+}
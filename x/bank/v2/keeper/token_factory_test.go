@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/x/bank/v2/keeper"
+	banktestutil "cosmossdk.io/x/bank/v2/testutil"
+	banktypes "cosmossdk.io/x/bank/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateDenom() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	authority, err := suite.addressCodec.BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(err)
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+
+	_, err = handlers.MsgUpdateParams(ctx, &banktypes.MsgUpdateParams{
+		Authority: authority,
+		Params:    banktypes.NewParams(true, sdk.NewCoins(newFooCoin(10))),
+	})
+	require.NoError(err)
+
+	// the creation fee must be paid
+	_, err = handlers.MsgCreateDenom(ctx, banktypes.NewMsgCreateDenom(acc0Str, "token"))
+	require.ErrorContains(err, "failed to pay the denom creation fee")
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(25))))
+
+	res, err := handlers.MsgCreateDenom(ctx, banktypes.NewMsgCreateDenom(acc0Str, "token"))
+	require.NoError(err)
+	require.Equal("factory/"+acc0Str+"/token", res.NewTokenDenom)
+
+	// the fee is burnt
+	require.Equal(newFooCoin(15), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom))
+	require.Equal(newFooCoin(15), suite.bankKeeper.GetSupply(ctx, fooDenom))
+
+	_, err = handlers.MsgCreateDenom(ctx, banktypes.NewMsgCreateDenom(acc0Str, "token"))
+	require.ErrorIs(err, banktypes.ErrDenomExists)
+
+	_, err = handlers.MsgCreateDenom(ctx, banktypes.NewMsgCreateDenom(acc0Str, "this-subdenom-is-way-too-long-to-be-accepted-as-a-subdenom"))
+	require.ErrorContains(err, "subdenom too long")
+
+	_, err = handlers.MsgCreateDenom(ctx, banktypes.NewMsgCreateDenom(acc0Str, "other"))
+	require.NoError(err)
+
+	denoms, err := handlers.QueryDenomsFromCreator(ctx, &banktypes.QueryDenomsFromCreatorRequest{Creator: acc0Str})
+	require.NoError(err)
+	require.Equal([]string{"factory/" + acc0Str + "/other", "factory/" + acc0Str + "/token"}, denoms.Denoms)
+
+	metadata, err := handlers.QueryDenomAuthorityMetadata(ctx, &banktypes.QueryDenomAuthorityMetadataRequest{Denom: res.NewTokenDenom})
+	require.NoError(err)
+	require.Equal(banktypes.DenomAuthorityMetadata{Admin: acc0Str}, metadata.AuthorityMetadata)
+}
+
+func (suite *KeeperTestSuite) TestFactoryDenomAdmin() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	res, err := handlers.MsgCreateDenom(ctx, banktypes.NewMsgCreateDenom(acc0Str, "token"))
+	require.NoError(err)
+	denom := res.NewTokenDenom
+
+	// the admin can mint its denom, but no other denom
+	_, err = handlers.MsgMint(ctx, &banktypes.MsgMint{Authority: acc0Str, ToAddress: acc1Str, Amount: sdk.NewCoins(sdk.NewInt64Coin(denom, 100))})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 100), suite.bankKeeper.GetBalance(ctx, accAddrs[1], denom))
+
+	_, err = handlers.MsgMint(ctx, &banktypes.MsgMint{Authority: acc0Str, ToAddress: acc1Str, Amount: sdk.NewCoins(newFooCoin(100))})
+	require.ErrorContains(err, "invalid authority")
+
+	_, err = handlers.MsgMint(ctx, &banktypes.MsgMint{Authority: acc1Str, ToAddress: acc1Str, Amount: sdk.NewCoins(sdk.NewInt64Coin(denom, 100))})
+	require.ErrorIs(err, banktypes.ErrUnauthorized)
+
+	// the admin can set the metadata of its denom
+	tokenMetadata := banktypes.Metadata{
+		Name:       "Token",
+		Symbol:     "TOKEN",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+	}
+	_, err = handlers.MsgSetDenomMetadata(ctx, &banktypes.MsgSetDenomMetadata{Authority: acc1Str, Metadata: tokenMetadata})
+	require.ErrorIs(err, banktypes.ErrUnauthorized)
+
+	_, err = handlers.MsgSetDenomMetadata(ctx, &banktypes.MsgSetDenomMetadata{Authority: acc0Str, Metadata: tokenMetadata})
+	require.NoError(err)
+
+	// the holders can burn the denom
+	_, err = handlers.MsgBurn(ctx, banktypes.NewMsgBurn(acc1Str, sdk.NewCoins(sdk.NewInt64Coin(denom, 40))))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 60), suite.bankKeeper.GetSupply(ctx, denom))
+
+	// only the admin can transfer the administration of the denom
+	_, err = handlers.MsgChangeDenomAdmin(ctx, banktypes.NewMsgChangeDenomAdmin(acc1Str, denom, acc1Str))
+	require.ErrorIs(err, banktypes.ErrUnauthorized)
+
+	_, err = handlers.MsgChangeDenomAdmin(ctx, banktypes.NewMsgChangeDenomAdmin(acc0Str, denom, acc1Str))
+	require.NoError(err)
+
+	_, err = handlers.MsgMint(ctx, &banktypes.MsgMint{Authority: acc0Str, ToAddress: acc0Str, Amount: sdk.NewCoins(sdk.NewInt64Coin(denom, 100))})
+	require.ErrorIs(err, banktypes.ErrUnauthorized)
+
+	// renouncing the administration leaves the denom without admin
+	_, err = handlers.MsgChangeDenomAdmin(ctx, banktypes.NewMsgChangeDenomAdmin(acc1Str, denom, ""))
+	require.NoError(err)
+
+	_, err = handlers.MsgMint(ctx, &banktypes.MsgMint{Authority: acc1Str, ToAddress: acc1Str, Amount: sdk.NewCoins(sdk.NewInt64Coin(denom, 100))})
+	require.ErrorIs(err, banktypes.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestDenomSendRestriction() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+	acc2Str, err := suite.addressCodec.BytesToString(accAddrs[2])
+	require.NoError(err)
+
+	var restricted sdk.Coins
+	suite.bankKeeper.RegisterDenomSendRestriction("blocklist", func(_ context.Context, _, toAddr []byte, amt sdk.Coins) ([]byte, error) {
+		restricted = amt
+		if bytes.Equal(toAddr, accAddrs[2]) {
+			return nil, errors.New("receiver is blocked")
+		}
+		return toAddr, nil
+	})
+
+	res, err := handlers.MsgCreateDenom(ctx, banktypes.NewMsgCreateDenom(acc0Str, "token"))
+	require.NoError(err)
+	denom := res.NewTokenDenom
+
+	_, err = handlers.MsgSetDenomSendRestriction(ctx, banktypes.NewMsgSetDenomSendRestriction(acc0Str, denom, "unknown"))
+	require.ErrorIs(err, banktypes.ErrUnknownRestriction)
+
+	_, err = handlers.MsgSetDenomSendRestriction(ctx, banktypes.NewMsgSetDenomSendRestriction(acc1Str, denom, "blocklist"))
+	require.ErrorIs(err, banktypes.ErrUnauthorized)
+
+	_, err = handlers.MsgSetDenomSendRestriction(ctx, banktypes.NewMsgSetDenomSendRestriction(acc0Str, denom, "blocklist"))
+	require.NoError(err)
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	_, err = handlers.MsgMint(ctx, &banktypes.MsgMint{Authority: acc0Str, ToAddress: acc0Str, Amount: sdk.NewCoins(sdk.NewInt64Coin(denom, 100))})
+	require.NoError(err)
+
+	// the restriction only applies to the coins of the denom
+	_, err = handlers.MsgSend(ctx, banktypes.NewMsgSend(acc0Str, acc2Str, sdk.NewCoins(newFooCoin(10))))
+	require.NoError(err)
+	require.Nil(restricted)
+
+	_, err = handlers.MsgSend(ctx, banktypes.NewMsgSend(acc0Str, acc2Str, sdk.NewCoins(newFooCoin(10), sdk.NewInt64Coin(denom, 10))))
+	require.ErrorContains(err, "receiver is blocked")
+
+	_, err = handlers.MsgMultiSend(ctx, banktypes.NewMsgMultiSend(
+		banktypes.NewInput(acc0Str, sdk.NewCoins(sdk.NewInt64Coin(denom, 20))),
+		[]banktypes.Output{
+			banktypes.NewOutput(acc1Str, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))),
+			banktypes.NewOutput(acc2Str, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))),
+		},
+	))
+	require.ErrorContains(err, "receiver is blocked")
+
+	_, err = handlers.MsgSend(ctx, banktypes.NewMsgSend(acc0Str, acc1Str, sdk.NewCoins(newFooCoin(10), sdk.NewInt64Coin(denom, 10))))
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 10)), restricted)
+
+	// removing the restriction
+	_, err = handlers.MsgSetDenomSendRestriction(ctx, banktypes.NewMsgSetDenomSendRestriction(acc0Str, denom, ""))
+	require.NoError(err)
+
+	_, err = handlers.MsgSend(ctx, banktypes.NewMsgSend(acc0Str, acc2Str, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	require.NoError(err)
+}
@@ -101,6 +101,13 @@ func (am AppModule) RegisterMsgHandlers(router appmodulev2.MsgRouter) {
 	appmodulev2.RegisterMsgHandler(router, handlers.MsgUpdateParams)
 	appmodulev2.RegisterMsgHandler(router, handlers.MsgSend)
 	appmodulev2.RegisterMsgHandler(router, handlers.MsgMint)
+	appmodulev2.RegisterMsgHandler(router, handlers.MsgBurn)
+	appmodulev2.RegisterMsgHandler(router, handlers.MsgMultiSend)
+	appmodulev2.RegisterMsgHandler(router, handlers.MsgSetSendEnabled)
+	appmodulev2.RegisterMsgHandler(router, handlers.MsgSetDenomMetadata)
+	appmodulev2.RegisterMsgHandler(router, handlers.MsgCreateDenom)
+	appmodulev2.RegisterMsgHandler(router, handlers.MsgChangeDenomAdmin)
+	appmodulev2.RegisterMsgHandler(router, handlers.MsgSetDenomSendRestriction)
 }
 
 // RegisterQueryHandlers registers the query handlers for the bank module.
@@ -109,6 +116,15 @@ func (am AppModule) RegisterQueryHandlers(router appmodulev2.QueryRouter) {
 
 	appmodulev2.RegisterMsgHandler(router, handlers.QueryParams)
 	appmodulev2.RegisterMsgHandler(router, handlers.QueryBalance)
+	appmodulev2.RegisterMsgHandler(router, handlers.QueryAllBalances)
+	appmodulev2.RegisterMsgHandler(router, handlers.QuerySpendableBalances)
+	appmodulev2.RegisterMsgHandler(router, handlers.QueryTotalSupply)
+	appmodulev2.RegisterMsgHandler(router, handlers.QuerySupplyOf)
+	appmodulev2.RegisterMsgHandler(router, handlers.QueryDenomMetadata)
+	appmodulev2.RegisterMsgHandler(router, handlers.QueryDenomsMetadata)
+	appmodulev2.RegisterMsgHandler(router, handlers.QuerySendEnabled)
+	appmodulev2.RegisterMsgHandler(router, handlers.QueryDenomAuthorityMetadata)
+	appmodulev2.RegisterMsgHandler(router, handlers.QueryDenomsFromCreator)
 }
 
 // GetTxCmd returns the root tx command for the bank/v2 module.
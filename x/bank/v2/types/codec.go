@@ -9,5 +9,12 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*transaction.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgSend{},
+		&MsgBurn{},
+		&MsgMultiSend{},
+		&MsgSetSendEnabled{},
+		&MsgSetDenomMetadata{},
+		&MsgCreateDenom{},
+		&MsgChangeDenomAdmin{},
+		&MsgSetDenomSendRestriction{},
 	)
 }
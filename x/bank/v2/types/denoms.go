@@ -0,0 +1,61 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FactoryDenomPrefix is the prefix of the token factory denoms, formatted as factory/{creator}/{subdenom}.
+	FactoryDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom of a token factory denom.
+	MaxSubdenomLength = 44
+)
+
+// GetTokenDenom returns the token factory denom created by creator with the given subdenom.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", fmt.Errorf("subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", fmt.Errorf("invalid creator %s: cannot contain '/'", creator)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator and the subdenom of a token factory denom.
+func DeconstructDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", err
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) < 3 || parts[0] != FactoryDenomPrefix || parts[1] == "" {
+		return "", "", fmt.Errorf("denom %s is not a token factory denom, expected %s/{creator}/{subdenom}", denom, FactoryDenomPrefix)
+	}
+
+	return parts[1], parts[2], nil
+}
+
+// IsFactoryDenom returns whether the denom has the token factory denom prefix.
+func IsFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, FactoryDenomPrefix+"/")
+}
+
+// A DenomSendRestrictionFn is a send restriction that token factory denom admins can attach to their denoms.
+// It is registered under the name of the module providing it, and only receives the coins of the denoms it
+// is attached to.
+type DenomSendRestrictionFn func(ctx context.Context, fromAddr, toAddr []byte, amt sdk.Coins) (newToAddr []byte, err error)
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (DenomSendRestrictionFn) IsOnePerModuleType() {}
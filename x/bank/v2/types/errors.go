@@ -0,0 +1,18 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/bank/v2 module sentinel errors
+var (
+	ErrNoInputs              = errors.Register(ModuleName, 2, "no inputs to send transaction")
+	ErrNoOutputs             = errors.Register(ModuleName, 3, "no outputs to send transaction")
+	ErrInputOutputMismatch   = errors.Register(ModuleName, 4, "sum inputs != sum outputs")
+	ErrSendDisabled          = errors.Register(ModuleName, 5, "send transactions are disabled")
+	ErrDenomMetadataNotFound = errors.Register(ModuleName, 6, "client denom metadata not found")
+	ErrDuplicateEntry        = errors.Register(ModuleName, 7, "duplicate entry")
+	ErrMultipleSenders       = errors.Register(ModuleName, 8, "multiple senders not allowed")
+	ErrDenomExists           = errors.Register(ModuleName, 9, "denom already exists")
+	ErrDenomNotFound         = errors.Register(ModuleName, 10, "token factory denom not found")
+	ErrUnauthorized          = errors.Register(ModuleName, 11, "unauthorized account")
+	ErrUnknownRestriction    = errors.Register(ModuleName, 12, "unknown denom send restriction")
+)
@@ -21,4 +21,14 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// token factory events name and attributes
+	EventTypeCreateDenom             = "create_denom"
+	EventTypeChangeDenomAdmin        = "change_denom_admin"
+	EventTypeSetDenomSendRestriction = "set_denom_send_restriction"
+
+	AttributeKeyCreator         = "creator"
+	AttributeKeyDenom           = "denom"
+	AttributeKeyNewAdmin        = "new_admin"
+	AttributeKeySendRestriction = "send_restriction"
 )
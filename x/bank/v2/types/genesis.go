@@ -1,17 +1,70 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params) *GenesisState {
+func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata, sendEnabled []SendEnabled, factoryDenoms []GenesisDenom) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:        params,
+		Balances:      balances,
+		Supply:        supply,
+		DenomMetadata: denomMetaData,
+		SendEnabled:   sendEnabled,
+		FactoryDenoms: factoryDenoms,
 	}
 }
 
 // DefaultGenesisState returns a default bank/v2 module genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams())
+	return NewGenesisState(DefaultParams(), []Balance{}, sdk.Coins{}, []Metadata{}, []SendEnabled{}, []GenesisDenom{})
 }
 
+// Validate performs basic validation of the genesis state.
 func (gs *GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenSendEnabled := make(map[string]bool)
+	for _, p := range gs.SendEnabled {
+		if seenSendEnabled[p.Denom] {
+			return fmt.Errorf("duplicate send enabled found: '%s'", p.Denom)
+		}
+		if err := sdk.ValidateDenom(p.Denom); err != nil {
+			return err
+		}
+		seenSendEnabled[p.Denom] = true
+	}
+
+	seenMetadatas := make(map[string]bool)
+	for _, metadata := range gs.DenomMetadata {
+		if seenMetadatas[metadata.Base] {
+			return fmt.Errorf("duplicate client metadata for denom %s", metadata.Base)
+		}
+
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+
+		seenMetadatas[metadata.Base] = true
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, denom := range gs.FactoryDenoms {
+		if seenDenoms[denom.Denom] {
+			return fmt.Errorf("duplicate token factory denom %s", denom.Denom)
+		}
+
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+
+		seenDenoms[denom.Denom] = true
+	}
+
+	return nil
 }
@@ -0,0 +1,61 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewInput creates a transaction input, used with MsgMultiSend.
+func NewInput(addr string, coins sdk.Coins) Input {
+	return Input{
+		Address: addr,
+		Coins:   coins,
+	}
+}
+
+// NewOutput creates a transaction output, used with MsgMultiSend.
+func NewOutput(addr string, coins sdk.Coins) Output {
+	return Output{
+		Address: addr,
+		Coins:   coins,
+	}
+}
+
+// ValidateInputOutputs validates that the input and the outputs coins are valid
+// and that the sum of the outputs equals the input.
+// The addresses are validated by the keeper, using its address codec.
+func ValidateInputOutputs(input Input, outputs []Output) error {
+	if err := validateCoins(input.Address, input.Coins); err != nil {
+		return err
+	}
+
+	var totalOut sdk.Coins
+	for _, out := range outputs {
+		if err := validateCoins(out.Address, out.Coins); err != nil {
+			return err
+		}
+
+		totalOut = totalOut.Add(out.Coins...)
+	}
+
+	// make sure inputs and outputs match
+	if !input.Coins.Equal(totalOut) {
+		return ErrInputOutputMismatch
+	}
+
+	return nil
+}
+
+func validateCoins(addr string, coins sdk.Coins) error {
+	if addr == "" {
+		return sdkerrors.ErrInvalidAddress.Wrap("empty address string is not allowed")
+	}
+
+	if !coins.IsValid() || !coins.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, coins.String())
+	}
+
+	return nil
+}
@@ -31,4 +31,13 @@ var (
 	DenomAddressPrefix = collections.NewPrefix(4)
 
 	SupplyKey = collections.NewPrefix(5)
+
+	// SendEnabledPrefix is the prefix for the SendEnabled flags of the denoms.
+	SendEnabledPrefix = collections.NewPrefix(6)
+
+	// DenomMetadataPrefix is the prefix for the denoms metadata.
+	DenomMetadataPrefix = collections.NewPrefix(7)
+
+	// FactoryDenomsPrefix is the prefix for the authority metadata of the token factory denoms.
+	FactoryDenomsPrefix = collections.NewPrefix(8)
 )
@@ -6,9 +6,52 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ coretransaction.Msg = &MsgSend{}
+var (
+	_ coretransaction.Msg = &MsgSend{}
+	_ coretransaction.Msg = &MsgBurn{}
+	_ coretransaction.Msg = &MsgMultiSend{}
+	_ coretransaction.Msg = &MsgSetSendEnabled{}
+	_ coretransaction.Msg = &MsgSetDenomMetadata{}
+	_ coretransaction.Msg = &MsgCreateDenom{}
+	_ coretransaction.Msg = &MsgChangeDenomAdmin{}
+	_ coretransaction.Msg = &MsgSetDenomSendRestriction{}
+)
 
 // NewMsgSend constructs a msg to send coins from one account to another.
 func NewMsgSend(fromAddr, toAddr string, amount sdk.Coins) *MsgSend {
 	return &MsgSend{FromAddress: fromAddr, ToAddress: toAddr, Amount: amount}
 }
+
+// NewMsgBurn constructs a msg to burn coins from an account.
+func NewMsgBurn(fromAddr string, amount sdk.Coins) *MsgBurn {
+	return &MsgBurn{FromAddress: fromAddr, Amount: amount}
+}
+
+// NewMsgMultiSend constructs a msg to send coins from one account to many.
+func NewMsgMultiSend(in Input, out []Output) *MsgMultiSend {
+	return &MsgMultiSend{Inputs: []Input{in}, Outputs: out}
+}
+
+// NewMsgSetSendEnabled constructs a msg to set the send enabled entries of denoms.
+func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefaultFor []string) *MsgSetSendEnabled {
+	return &MsgSetSendEnabled{
+		Authority:     authority,
+		SendEnabled:   sendEnabled,
+		UseDefaultFor: useDefaultFor,
+	}
+}
+
+// NewMsgCreateDenom constructs a msg to create the token factory denom factory/{sender}/{subdenom}.
+func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{Sender: sender, Subdenom: subdenom}
+}
+
+// NewMsgChangeDenomAdmin constructs a msg to change the admin of a token factory denom.
+func NewMsgChangeDenomAdmin(sender, denom, newAdmin string) *MsgChangeDenomAdmin {
+	return &MsgChangeDenomAdmin{Sender: sender, Denom: denom, NewAdmin: newAdmin}
+}
+
+// NewMsgSetDenomSendRestriction constructs a msg to set the send restriction of a token factory denom.
+func NewMsgSetDenomSendRestriction(sender, denom, sendRestriction string) *MsgSetDenomSendRestriction {
+	return &MsgSetDenomSendRestriction{Sender: sender, Denom: denom, SendRestriction: sendRestriction}
+}
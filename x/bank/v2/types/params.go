@@ -1,16 +1,32 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultDefaultSendEnabled is the value that DefaultSendEnabled will have from DefaultParams().
+var DefaultDefaultSendEnabled = true
+
 // NewParams creates a new parameter configuration for the bank/v2 module
-func NewParams() Params {
-	return Params{}
+func NewParams(defaultSendEnabled bool, denomCreationFee sdk.Coins) Params {
+	return Params{
+		DefaultSendEnabled: defaultSendEnabled,
+		DenomCreationFee:   denomCreationFee,
+	}
 }
 
 // DefaultParams is the default parameter configuration for the bank/v2 module
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultDefaultSendEnabled, sdk.NewCoins())
 }
 
 // Validate all bank/v2 module parameters
 func (p Params) Validate() error {
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+
 	return nil
 }
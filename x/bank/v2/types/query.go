@@ -1,6 +1,20 @@
 package types
 
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
 // NewQueryBalanceRequest creates a new instance of QueryBalanceRequest.
 func NewQueryBalanceRequest(addr, denom string) *QueryBalanceRequest {
 	return &QueryBalanceRequest{Address: addr, Denom: denom}
 }
+
+// NewQueryAllBalancesRequest creates a new instance of QueryAllBalancesRequest.
+func NewQueryAllBalancesRequest(addr string, req *query.PageRequest, resolveDenom bool) *QueryAllBalancesRequest {
+	return &QueryAllBalancesRequest{Address: addr, Pagination: req, ResolveDenom: resolveDenom}
+}
+
+// NewQuerySpendableBalancesRequest creates a new instance of QuerySpendableBalancesRequest.
+func NewQuerySpendableBalancesRequest(addr string, req *query.PageRequest) *QuerySpendableBalancesRequest {
+	return &QuerySpendableBalancesRequest{Address: addr, Pagination: req}
+}
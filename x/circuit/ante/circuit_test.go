@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/transaction"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/circuit/ante"
 	cbtypes "cosmossdk.io/x/circuit/types"
@@ -91,3 +92,99 @@ func TestCircuitBreakerDecorator(t *testing.T) {
 		}
 	}
 }
+
+type MockRateLimiter struct {
+	limited  string
+	consumed map[string]int
+}
+
+func (m MockRateLimiter) CheckRateLimit(_ context.Context, msg transaction.Msg) error {
+	if sdk.MsgTypeURL(msg) == m.limited {
+		return cbtypes.ErrRateLimitExceeded
+	}
+	return nil
+}
+
+func (m MockRateLimiter) ConsumeRateLimit(ctx context.Context, msg transaction.Msg) error {
+	if err := m.CheckRateLimit(ctx, msg); err != nil {
+		return err
+	}
+	m.consumed[sdk.MsgTypeURL(msg)]++
+	return nil
+}
+
+func TestRateLimitDecorator(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	limited := testdata.NewTestMsg(addr1)
+
+	testcases := []struct {
+		msg     sdk.Msg
+		allowed bool
+	}{
+		{msg: &cbtypes.MsgAuthorizeCircuitBreaker{
+			Grantee: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+			Granter: "cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc",
+		}, allowed: true},
+		{msg: limited, allowed: false},
+	}
+
+	rateLimiter := MockRateLimiter{limited: sdk.MsgTypeURL(limited), consumed: map[string]int{}}
+	decorator := ante.NewRateLimitDecorator(rateLimiter)
+
+	for _, tc := range testcases {
+		err := f.txBuilder.SetMsgs(tc.msg)
+		require.NoError(t, err)
+
+		tx := f.txBuilder.GetTx()
+
+		sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+		_, err = decorator.AnteHandle(sdkCtx, tx, false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			return ctx, nil
+		})
+
+		if tc.allowed {
+			require.NoError(t, err)
+		} else {
+			require.ErrorIs(t, err, cbtypes.ErrRateLimitExceeded)
+		}
+	}
+
+	// the ante handler only checks the rate limits
+	require.Empty(t, rateLimiter.consumed)
+}
+
+func TestRateLimitPostDecorator(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	msg := &cbtypes.MsgAuthorizeCircuitBreaker{
+		Grantee: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+		Granter: "cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc",
+	}
+	require.NoError(t, f.txBuilder.SetMsgs(msg))
+	tx := f.txBuilder.GetTx()
+
+	rateLimiter := MockRateLimiter{consumed: map[string]int{}}
+	decorator := ante.NewRateLimitPostDecorator(rateLimiter)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+
+	// the rate limits are not consumed by failed txs
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+	_, err := decorator.PostHandle(sdkCtx, tx, false, false, next)
+	require.NoError(t, err)
+	require.Empty(t, rateLimiter.consumed)
+
+	_, err = decorator.PostHandle(sdkCtx, tx, false, true, next)
+	require.NoError(t, err)
+	require.Equal(t, 1, rateLimiter.consumed[sdk.MsgTypeURL(msg)])
+
+	// a successful tx exceeding its rate limits fails
+	rateLimiter.limited = sdk.MsgTypeURL(msg)
+	_, err = ante.NewRateLimitPostDecorator(rateLimiter).PostHandle(sdkCtx, tx, false, true, next)
+	require.ErrorIs(t, err, cbtypes.ErrRateLimitExceeded)
+}
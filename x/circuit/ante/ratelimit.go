@@ -0,0 +1,80 @@
+package ante
+
+import (
+	"context"
+
+	"cosmossdk.io/core/transaction"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RateLimiter is an interface that defines the methods for a message rate limiter.
+type RateLimiter interface {
+	// CheckRateLimit returns an error if the execution of msg would exceed its rate limit.
+	CheckRateLimit(ctx context.Context, msg transaction.Msg) error
+	// ConsumeRateLimit records the successful execution of msg against its rate limit.
+	ConsumeRateLimit(ctx context.Context, msg transaction.Msg) error
+}
+
+// RateLimitDecorator is an AnteDecorator that checks if the transaction messages are within the rate limits of their type.
+// It does not consume the rate limits, which is done by the RateLimitPostDecorator once the messages are executed, so
+// that transactions whose messages fail cannot exhaust the rate limits.
+type RateLimitDecorator struct {
+	rateLimiter RateLimiter
+}
+
+func NewRateLimitDecorator(rl RateLimiter) RateLimitDecorator {
+	return RateLimitDecorator{
+		rateLimiter: rl,
+	}
+}
+
+// As for the CircuitBreakerDecorator, nested authz.Exec messages are not inspected by this decorator.
+func (rld RateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := rld.ValidateTx(ctx, tx); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (rld RateLimitDecorator) ValidateTx(ctx context.Context, tx transaction.Tx) error {
+	// loop through all the messages and check their rate limit
+	msgs, err := tx.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		if err := rld.rateLimiter.CheckRateLimit(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RateLimitPostDecorator is a PostDecorator that consumes the rate limits of the messages of successfully
+// executed transactions. It fails the transaction, reverting the execution of its messages, if they exceed
+// their rate limits. Server/v2 applications consume the rate limits in a post message handler of the module
+// instead.
+type RateLimitPostDecorator struct {
+	rateLimiter RateLimiter
+}
+
+func NewRateLimitPostDecorator(rl RateLimiter) RateLimitPostDecorator {
+	return RateLimitPostDecorator{
+		rateLimiter: rl,
+	}
+}
+
+func (rld RateLimitPostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if success {
+		for _, msg := range tx.GetMsgs() {
+			if err := rld.rateLimiter.ConsumeRateLimit(ctx, msg); err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
@@ -30,6 +30,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "disabled-list",
 					Short:     "Query a list of all disabled message types",
 				},
+				{
+					RpcMethod:      "RateLimit",
+					Use:            "rate-limit <msg_type_url>",
+					Short:          "Query the rate limit of a message type and its usage in the current window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "msg_type_url"}},
+				},
+				{
+					RpcMethod: "RateLimits",
+					Use:       "rate-limits",
+					Short:     "Query the rate limits of all message types",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -65,6 +76,25 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "msg_type_urls", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "SetRateLimit",
+					Use:       "set-rate-limit <msg_type_url> --rate-limit [rate-limit]",
+					Short:     "Throttle a message instead of disabling it",
+					Long:      `Throttle a message instead of disabling it. The rate limit caps the number of messages and/or the coins they move out within a window of blocks.`,
+					Example:   fmt.Sprintf(`%s tx circuit set-rate-limit "/cosmos.bank.v1beta1.MsgSend" --rate-limit '{"max_msgs":"10","max_outflow":[{"denom":"stake","amount":"1000000"}],"window_blocks":"100"}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "msg_type_url"},
+					},
+				},
+				{
+					RpcMethod: "RemoveRateLimit",
+					Use:       "remove-rate-limit <msg_type_urls>",
+					Short:     "Stop throttling a message",
+					Example:   fmt.Sprintf(`%s tx circuit remove-rate-limit "/cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend"`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "msg_type_urls", Varargs: true},
+					},
+				},
 			},
 		},
 	}
@@ -35,6 +35,7 @@ type ModuleInputs struct {
 	Environment appmodule.Environment
 
 	AddressCodec address.Codec
+	OutflowFn    types.OutflowFn `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -63,6 +64,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authorityAddr,
 		in.AddressCodec,
 	)
+	if in.OutflowFn != nil {
+		circuitkeeper.SetOutflowFn(in.OutflowFn)
+	}
 	m := NewAppModule(in.Cdc, circuitkeeper)
 
 	baseappOpt := func(app *baseapp.BaseApp) {
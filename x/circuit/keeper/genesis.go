@@ -10,6 +10,7 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState, e
 	var (
 		permissions  []*types.GenesisAccountPermissions
 		disabledMsgs []string
+		rateLimits   []*types.GenesisRateLimit
 	)
 
 	err = k.Permissions.Walk(ctx, nil, func(address []byte, perm types.Permissions) (stop bool, err error) {
@@ -37,9 +38,21 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState, e
 		return nil, err
 	}
 
+	err = k.RateLimits.Walk(ctx, nil, func(msgUrl string, limit types.RateLimit) (stop bool, err error) {
+		rateLimits = append(rateLimits, &types.GenesisRateLimit{
+			MsgTypeUrl: msgUrl,
+			RateLimit:  &limit,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		AccountPermissions: permissions,
 		DisabledTypeUrls:   disabledMsgs,
+		RateLimits:         rateLimits,
 	}, nil
 }
 
@@ -62,6 +75,12 @@ func (k *Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState)
 			return err
 		}
 	}
+	for _, limit := range genState.RateLimits {
+		// Set the rate limits, their usage starts fresh
+		if err := k.RateLimits.Set(ctx, limit.MsgTypeUrl, *limit.RateLimit); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -17,6 +17,7 @@ import (
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -76,6 +77,9 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 	genesisState := &types.GenesisState{
 		AccountPermissions: accounts,
 		DisabledTypeUrls:   []string{url},
+		RateLimits: []*types.GenesisRateLimit{
+			{MsgTypeUrl: "limited_url", RateLimit: &types.RateLimit{MaxMsgs: 5, MaxOutflow: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), WindowBlocks: 10}},
+		},
 	}
 
 	err = s.keeper.InitGenesis(s.ctx, genesisState)
@@ -92,4 +96,5 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 
 	s.Require().Equal(genesisState.AccountPermissions, exportedGenesisState.AccountPermissions)
 	s.Require().Equal(genesisState.DisabledTypeUrls, exportedGenesisState.DisabledTypeUrls)
+	s.Require().Equal(genesisState.RateLimits, exportedGenesisState.RateLimits)
 }
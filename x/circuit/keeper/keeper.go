@@ -6,9 +6,12 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/transaction"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/circuit/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Keeper defines the circuit module's keeper.
@@ -18,12 +21,17 @@ type Keeper struct {
 	cdc          codec.BinaryCodec
 	addressCodec address.Codec
 	authority    []byte
+	outflowFn    types.OutflowFn
 
 	Schema collections.Schema
 	// Permissions contains the permissions for each account
 	Permissions collections.Map[[]byte, types.Permissions]
 	// DisableList contains the message URLs that are disabled
 	DisableList collections.KeySet[string]
+	// RateLimits contains the rate limits of the throttled message URLs
+	RateLimits collections.Map[string, types.RateLimit]
+	// RateLimitUsage contains the consumption of the rate limits in their current window
+	RateLimitUsage collections.Map[string, types.RateLimitUsage]
 }
 
 // NewKeeper constructs a new Circuit Keeper instance
@@ -40,6 +48,7 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, authority strin
 		cdc:          cdc,
 		authority:    auth,
 		addressCodec: addressCodec,
+		outflowFn:    types.DefaultOutflowFn,
 		Permissions: collections.NewMap(
 			sb,
 			types.AccountPermissionPrefix,
@@ -53,6 +62,20 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, authority strin
 			"disable_list",
 			collections.StringKey,
 		),
+		RateLimits: collections.NewMap(
+			sb,
+			types.RateLimitsPrefix,
+			"rate_limits",
+			collections.StringKey,
+			codec.CollValue[types.RateLimit](cdc),
+		),
+		RateLimitUsage: collections.NewMap(
+			sb,
+			types.RateLimitUsagePrefix,
+			"rate_limit_usage",
+			collections.StringKey,
+			codec.CollValue[types.RateLimitUsage](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -73,3 +96,92 @@ func (k *Keeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
 	has, err := k.DisableList.Has(ctx, msgURL)
 	return !has, err
 }
+
+// SetOutflowFn sets the function used to compute the coins moved out by a msg
+// when enforcing the max_outflow of rate limits. It defaults to types.DefaultOutflowFn.
+func (k *Keeper) SetOutflowFn(fn types.OutflowFn) {
+	k.outflowFn = fn
+}
+
+// GetRateLimitUsage returns the consumption of the rate limit of msgURL in the
+// current window. Counters of an elapsed window are returned reset.
+func (k *Keeper) GetRateLimitUsage(ctx context.Context, msgURL string, limit types.RateLimit) (types.RateLimitUsage, error) {
+	height := k.HeaderService.HeaderInfo(ctx).Height
+
+	usage, err := k.RateLimitUsage.Get(ctx, msgURL)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return types.RateLimitUsage{}, err
+	}
+
+	if err != nil || height-usage.WindowStart >= limit.Window() {
+		return types.RateLimitUsage{WindowStart: height}, nil
+	}
+
+	return usage, nil
+}
+
+// CheckRateLimit returns ErrRateLimitExceeded when the execution of msg would
+// exceed the rate limit of its type URL, without recording it.
+func (k *Keeper) CheckRateLimit(ctx context.Context, msg transaction.Msg) error {
+	_, _, err := k.addRateLimitUsage(ctx, msg)
+	return err
+}
+
+// ConsumeRateLimit records the execution of msg against the rate limit of its
+// type URL, if any, and returns ErrRateLimitExceeded when it exceeds it. It must
+// only be called once msg has been successfully executed, so that failing msgs
+// do not consume the rate limit.
+func (k *Keeper) ConsumeRateLimit(ctx context.Context, msg transaction.Msg) error {
+	msgURL, usage, err := k.addRateLimitUsage(ctx, msg)
+	if err != nil || usage == nil {
+		return err
+	}
+
+	return k.RateLimitUsage.Set(ctx, msgURL, *usage)
+}
+
+// addRateLimitUsage returns the usage of the rate limit of the type URL of msg
+// including its execution, or nil if the type URL is not rate limited.
+func (k *Keeper) addRateLimitUsage(ctx context.Context, msg transaction.Msg) (string, *types.RateLimitUsage, error) {
+	msgURL := sdk.MsgTypeURL(msg)
+
+	limit, err := k.RateLimits.Get(ctx, msgURL)
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return msgURL, nil, nil
+		}
+
+		return msgURL, nil, err
+	}
+
+	usage, err := k.GetRateLimitUsage(ctx, msgURL, limit)
+	if err != nil {
+		return msgURL, nil, err
+	}
+
+	usage.Msgs++
+	if limit.MaxMsgs > 0 && usage.Msgs > limit.MaxMsgs {
+		return msgURL, nil, errorsmod.Wrapf(types.ErrRateLimitExceeded, "%s is limited to %d msgs per %d blocks", msgURL, limit.MaxMsgs, limit.Window())
+	}
+
+	if !limit.MaxOutflow.Empty() {
+		outflow, err := k.outflowFn(ctx, msg)
+		if err != nil {
+			return msgURL, nil, err
+		}
+
+		for _, coin := range outflow {
+			maxAmount := limit.MaxOutflow.AmountOf(coin.Denom)
+			if maxAmount.IsZero() {
+				continue
+			}
+
+			usage.Outflow = usage.Outflow.Add(coin)
+			if usage.Outflow.AmountOf(coin.Denom).GT(maxAmount) {
+				return msgURL, nil, errorsmod.Wrapf(types.ErrRateLimitExceeded, "%s outflow is limited to %s%s per %d blocks", msgURL, maxAmount, coin.Denom, limit.Window())
+			}
+		}
+	}
+
+	return msgURL, &usage, nil
+}
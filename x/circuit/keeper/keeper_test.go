@@ -3,13 +3,16 @@ package keeper_test
 import (
 	"bytes"
 	"context"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/circuit"
 	"cosmossdk.io/x/circuit/keeper"
 	"cosmossdk.io/x/circuit/types"
@@ -18,6 +21,7 @@ import (
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -204,3 +208,118 @@ func TestIsAllowed(t *testing.T) {
 		})
 	}
 }
+
+func TestConsumeRateLimit(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx).WithHeaderInfo(header.Info{Height: 10})
+
+	send := &banktypes.MsgSend{
+		FromAddress: addresses[0],
+		ToAddress:   addresses[1],
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 60)),
+	}
+	msgURL := sdk.MsgTypeURL(send)
+
+	// msgs without rate limit are not throttled
+	require.NoError(t, f.keeper.ConsumeRateLimit(sdkCtx, send))
+
+	err := f.keeper.RateLimits.Set(sdkCtx, msgURL, types.RateLimit{
+		MaxMsgs:      2,
+		MaxOutflow:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		WindowBlocks: 5,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.ConsumeRateLimit(sdkCtx, send))
+	err = f.keeper.ConsumeRateLimit(sdkCtx, send)
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+	require.ErrorContains(t, err, "outflow is limited to 100stake per 5 blocks")
+
+	// denoms that are not limited do not count towards the outflow
+	other := &banktypes.MsgSend{
+		FromAddress: addresses[0],
+		ToAddress:   addresses[1],
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+	}
+	require.NoError(t, f.keeper.ConsumeRateLimit(sdkCtx, other))
+	err = f.keeper.ConsumeRateLimit(sdkCtx, other)
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+	require.ErrorContains(t, err, "is limited to 2 msgs per 5 blocks")
+
+	usage, err := f.keeper.RateLimitUsage.Get(sdkCtx, msgURL)
+	require.NoError(t, err)
+	require.Equal(t, types.RateLimitUsage{
+		WindowStart: 10,
+		Msgs:        2,
+		Outflow:     sdk.NewCoins(sdk.NewInt64Coin("stake", 60)),
+	}, usage)
+
+	// counters are still consumed within the window
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Height: 14})
+	require.ErrorIs(t, f.keeper.ConsumeRateLimit(sdkCtx, send), types.ErrRateLimitExceeded)
+
+	// counters are reset once the window elapsed
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Height: 15})
+	require.NoError(t, f.keeper.ConsumeRateLimit(sdkCtx, send))
+
+	usage, err = f.keeper.RateLimitUsage.Get(sdkCtx, msgURL)
+	require.NoError(t, err)
+	require.Equal(t, int64(15), usage.WindowStart)
+	require.Equal(t, uint64(1), usage.Msgs)
+}
+
+func TestCheckRateLimit(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx).WithHeaderInfo(header.Info{Height: 10})
+
+	send := &banktypes.MsgSend{
+		FromAddress: addresses[0],
+		ToAddress:   addresses[1],
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 60)),
+	}
+	msgURL := sdk.MsgTypeURL(send)
+
+	err := f.keeper.RateLimits.Set(sdkCtx, msgURL, types.RateLimit{
+		MaxOutflow:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		WindowBlocks: 5,
+	})
+	require.NoError(t, err)
+
+	// checking the rate limit does not consume it
+	require.NoError(t, f.keeper.CheckRateLimit(sdkCtx, send))
+	require.NoError(t, f.keeper.CheckRateLimit(sdkCtx, send))
+	has, err := f.keeper.RateLimitUsage.Has(sdkCtx, msgURL)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, f.keeper.ConsumeRateLimit(sdkCtx, send))
+	require.ErrorIs(t, f.keeper.CheckRateLimit(sdkCtx, send), types.ErrRateLimitExceeded)
+}
+
+func TestGetRateLimitUsageMaxWindow(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx).WithHeaderInfo(header.Info{Height: 10})
+
+	send := &banktypes.MsgSend{
+		FromAddress: addresses[0],
+		ToAddress:   addresses[1],
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 60)),
+	}
+	msgURL := sdk.MsgTypeURL(send)
+
+	limit := types.RateLimit{MaxMsgs: 1, WindowBlocks: math.MaxInt64}
+	require.NoError(t, limit.Validate())
+	require.NoError(t, f.keeper.RateLimits.Set(sdkCtx, msgURL, limit))
+	require.NoError(t, f.keeper.ConsumeRateLimit(sdkCtx, send))
+
+	// the window never elapses, the end of the window does not overflow
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Height: math.MaxInt64})
+	usage, err := f.keeper.GetRateLimitUsage(sdkCtx, msgURL, limit)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), usage.WindowStart)
+	require.Equal(t, uint64(1), usage.Msgs)
+	require.ErrorIs(t, f.keeper.ConsumeRateLimit(sdkCtx, send), types.ErrRateLimitExceeded)
+}
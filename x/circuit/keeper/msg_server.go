@@ -226,6 +226,127 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
 }
 
+// SetRateLimit throttles processing of a Msg type URL in the state machine
+// instead of fully disabling it.
+func (srv msgServer) SetRateLimit(ctx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	address, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.RateLimit == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "rate limit cannot be nil")
+	}
+
+	if err := msg.RateLimit.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	msgTypeURL := types.MsgTypeURLValidation([]string{msg.MsgTypeUrl})[0]
+	if len(msgTypeURL) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg type url cannot be empty")
+	}
+
+	perms, err := srv.Permissions.Get(ctx, address)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	if err := srv.checkMsgPermission(address, perms, msgTypeURL); err != nil {
+		return nil, err
+	}
+
+	if err = srv.RateLimits.Set(ctx, msgTypeURL, *msg.RateLimit); err != nil {
+		return nil, err
+	}
+
+	// a new rate limit starts with fresh counters
+	if err = srv.RateLimitUsage.Remove(ctx, msgTypeURL); err != nil {
+		return nil, err
+	}
+
+	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV(
+		"set_rate_limit",
+		event.NewAttribute("authority", msg.Authority),
+		event.NewAttribute("msg_url", msgTypeURL),
+		event.NewAttribute("rate_limit", msg.RateLimit.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit removes the throttling of Msg's that has been set using
+// SetRateLimit.
+func (srv msgServer) RemoveRateLimit(ctx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	address, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := srv.Permissions.Get(ctx, address)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	msgTypeUrls := types.MsgTypeURLValidation(msg.MsgTypeUrls)
+	if len(msgTypeUrls) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg type urls cannot be empty")
+	}
+
+	for _, msgTypeURL := range msgTypeUrls {
+		has, err := srv.RateLimits.Has(ctx, msgTypeURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if !has {
+			return nil, fmt.Errorf("message %s is not rate limited", msgTypeURL)
+		}
+
+		if err := srv.checkMsgPermission(address, perms, msgTypeURL); err != nil {
+			return nil, err
+		}
+
+		if err = srv.RateLimits.Remove(ctx, msgTypeURL); err != nil {
+			return nil, err
+		}
+
+		if err = srv.RateLimitUsage.Remove(ctx, msgTypeURL); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV(
+		"remove_rate_limit",
+		event.NewAttribute("authority", msg.Authority),
+		event.NewAttribute("msg_url", strings.Join(msgTypeUrls, ",")),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// checkMsgPermission returns an error if the account cannot trip or reset the
+// circuit breaker for the message.
+func (srv msgServer) checkMsgPermission(address []byte, perms types.Permissions, msgTypeURL string) error {
+	switch {
+	case perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || bytes.Equal(address, srv.GetAuthority()):
+		// if the sender is a super admin or the module authority, no need to check perms
+		return nil
+	case perms.Level == types.Permissions_LEVEL_SOME_MSGS:
+		// if the sender has permission for some messages, check if the sender has permission for this specific message
+		if !hasPermissionForMsg(perms, msgTypeURL) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to rate limit message %s", msgTypeURL)
+		}
+		return nil
+	default:
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to rate limit messages")
+	}
+}
+
 // hasPermissionForMsg returns true if the account can trip or reset the message.
 func hasPermissionForMsg(perms types.Permissions, msg string) bool {
 	for _, msgurl := range perms.LimitTypeUrls {
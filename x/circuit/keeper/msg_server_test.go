@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -11,6 +12,7 @@ import (
 	"cosmossdk.io/x/circuit/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const msgSend = "/cosmos.bank.v1beta1.MsgSend"
@@ -521,3 +523,92 @@ func TestResetCircuitBreakerEmptyMsgs(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, allowed, "circuit breaker should be reset")
 }
+
+func TestSetRateLimit(t *testing.T) {
+	ft := initFixture(t)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	limit := types.RateLimit{MaxMsgs: 2, WindowBlocks: 10}
+
+	// admin sets a rate limit
+	msg := &types.MsgSetRateLimit{Authority: authority, MsgTypeUrl: msgSend, RateLimit: &limit}
+	_, err = srv.SetRateLimit(ft.ctx, msg)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"set_rate_limit",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("msg_url", msgSend),
+			sdk.NewAttribute("rate_limit", limit.String()),
+		),
+		lastEvent(ft.ctx),
+	)
+
+	stored, err := ft.keeper.RateLimits.Get(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.Equal(t, limit, stored)
+
+	// empty rate limit is rejected
+	msg = &types.MsgSetRateLimit{Authority: authority, MsgTypeUrl: msgSend, RateLimit: &types.RateLimit{WindowBlocks: 10}}
+	_, err = srv.SetRateLimit(ft.ctx, msg)
+	require.ErrorContains(t, err, "rate limit must set max_msgs or max_outflow")
+
+	// a window that does not fit in an int64 is rejected
+	msg = &types.MsgSetRateLimit{Authority: authority, MsgTypeUrl: msgSend, RateLimit: &types.RateLimit{MaxMsgs: 1, WindowBlocks: math.MaxInt64 + 1}}
+	_, err = srv.SetRateLimit(ft.ctx, msg)
+	require.ErrorContains(t, err, "window_blocks cannot exceed")
+
+	// user with no permission cannot set a rate limit
+	msg = &types.MsgSetRateLimit{Authority: addresses[1], MsgTypeUrl: msgSend, RateLimit: &limit}
+	_, err = srv.SetRateLimit(ft.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// user with permission for some msgs can only rate limit those
+	somemsgs := &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend}}
+	_, err = srv.AuthorizeCircuitBreaker(ft.ctx, &types.MsgAuthorizeCircuitBreaker{Granter: authority, Grantee: addresses[1], Permissions: somemsgs})
+	require.NoError(t, err)
+
+	_, err = srv.SetRateLimit(ft.ctx, &types.MsgSetRateLimit{Authority: addresses[1], MsgTypeUrl: msgSend, RateLimit: &limit})
+	require.NoError(t, err)
+
+	_, err = srv.SetRateLimit(ft.ctx, &types.MsgSetRateLimit{Authority: addresses[1], MsgTypeUrl: "/cosmos.bank.v1beta1.MsgMultiSend", RateLimit: &limit})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestRemoveRateLimit(t *testing.T) {
+	ft := initFixture(t)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+
+	// removing a rate limit that does not exist fails
+	_, err = srv.RemoveRateLimit(ft.ctx, &types.MsgRemoveRateLimit{Authority: authority, MsgTypeUrls: []string{msgSend}})
+	require.ErrorContains(t, err, "is not rate limited")
+
+	_, err = srv.SetRateLimit(ft.ctx, &types.MsgSetRateLimit{Authority: authority, MsgTypeUrl: msgSend, RateLimit: &types.RateLimit{MaxMsgs: 1}})
+	require.NoError(t, err)
+
+	// user with no permission cannot remove a rate limit
+	_, err = srv.RemoveRateLimit(ft.ctx, &types.MsgRemoveRateLimit{Authority: addresses[1], MsgTypeUrls: []string{msgSend}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.RemoveRateLimit(ft.ctx, &types.MsgRemoveRateLimit{Authority: authority, MsgTypeUrls: []string{msgSend}})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"remove_rate_limit",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("msg_url", msgSend),
+		),
+		lastEvent(ft.ctx),
+	)
+
+	has, err := ft.keeper.RateLimits.Has(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+}
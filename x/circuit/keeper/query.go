@@ -3,6 +3,11 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/circuit/types"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -73,3 +78,46 @@ func (qs QueryServer) DisabledList(ctx context.Context, req *types.QueryDisabled
 
 	return &types.DisabledListResponse{DisabledList: msgs}, nil
 }
+
+// RateLimit returns the rate limit of a message url and its usage in the current window.
+func (qs QueryServer) RateLimit(ctx context.Context, req *types.QueryRateLimitRequest) (*types.RateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	limit, err := qs.keeper.RateLimits.Get(ctx, req.MsgTypeUrl)
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no rate limit for %s", req.MsgTypeUrl)
+		}
+
+		return nil, err
+	}
+
+	usage, err := qs.keeper.GetRateLimitUsage(ctx, req.MsgTypeUrl, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RateLimitResponse{RateLimit: &limit, Usage: &usage}, nil
+}
+
+// RateLimits returns the rate limits of all message urls.
+func (qs QueryServer) RateLimits(ctx context.Context, req *types.QueryRateLimitsRequest) (*types.RateLimitsResponse, error) {
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.RateLimits,
+		req.Pagination,
+		func(key string, value types.RateLimit) (*types.GenesisRateLimit, error) {
+			return &types.GenesisRateLimit{
+				MsgTypeUrl: key,
+				RateLimit:  &value,
+			}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RateLimitsResponse{RateLimits: results, Pagination: pageRes}, nil
+}
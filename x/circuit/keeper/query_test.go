@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/x/circuit/keeper"
 	"cosmossdk.io/x/circuit/types"
@@ -98,3 +100,36 @@ func TestQueryDisabledList(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []string{f.mockMsgURL}, disabledList.DisabledList)
 }
+
+func TestQueryRateLimits(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	// create a new query server
+	qs := keeper.NewQueryServer(f.keeper)
+
+	// test the RateLimit method with no request or no rate limit
+	_, err := qs.RateLimit(f.ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = qs.RateLimit(f.ctx, &types.QueryRateLimitRequest{MsgTypeUrl: f.mockMsgURL})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	limit := types.RateLimit{MaxMsgs: 1}
+	require.NoError(t, f.keeper.RateLimits.Set(f.ctx, f.mockMsgURL, limit))
+
+	// test the RateLimit method
+	res1, err := qs.RateLimit(f.ctx, &types.QueryRateLimitRequest{MsgTypeUrl: f.mockMsgURL})
+	require.NoError(t, err)
+	require.Equal(t, limit, *res1.RateLimit)
+	require.Equal(t, uint64(0), res1.Usage.Msgs)
+
+	// test the RateLimits method
+	res2, err := qs.RateLimits(f.ctx, &types.QueryRateLimitsRequest{
+		Pagination: &query.PageRequest{Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Len(t, res2.RateLimits, 1)
+	require.Equal(t, f.mockMsgURL, res2.RateLimits[0].MsgTypeUrl)
+	require.Equal(t, limit, *res2.RateLimits[0].RateLimit)
+}
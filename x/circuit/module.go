@@ -33,6 +33,7 @@ var (
 	_ appmodule.HasGenesis                       = AppModule{}
 	_ appmodule.HasRegisterInterfaces            = AppModule{}
 	_ appmodulev2.HasTxValidator[transaction.Tx] = AppModule{}
+	_ appmodulev2.HasPostMsgHandlers             = AppModule{}
 )
 
 // AppModule implements an application module for the circuit module.
@@ -121,7 +122,21 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 // TxValidator implements appmodule.HasTxValidator.
 func (am AppModule) TxValidator(ctx context.Context, tx transaction.Tx) error {
 	validator := ante.NewCircuitBreakerDecorator(&am.keeper)
-	return validator.ValidateTx(ctx, tx)
+	if err := validator.ValidateTx(ctx, tx); err != nil {
+		return err
+	}
+
+	rateLimiter := ante.NewRateLimitDecorator(&am.keeper)
+	return rateLimiter.ValidateTx(ctx, tx)
+}
+
+// RegisterPostMsgHandlers implements appmodulev2.HasPostMsgHandlers.
+// The rate limits are consumed once the messages are successfully executed, so that
+// failing messages do not consume them.
+func (am AppModule) RegisterPostMsgHandlers(router appmodulev2.PostMsgRouter) {
+	router.RegisterGlobalPostMsgHandler(func(ctx context.Context, msg, _ transaction.Msg) error {
+		return am.keeper.ConsumeRateLimit(ctx, msg)
+	})
 }
 
 // ModuleCodec implements schema.HasModuleCodec.
@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// ErrRateLimitExceeded is returned when a Msg exceeds the rate limit of its type URL.
+var ErrRateLimitExceeded = errors.Register(ModuleName, 2, "rate limit exceeded")
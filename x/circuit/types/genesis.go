@@ -20,6 +20,19 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
+	for _, limit := range gs.RateLimits {
+		if limit.MsgTypeUrl == "" {
+			return fmt.Errorf("invalid rate limit msg type url: %s", limit.MsgTypeUrl)
+		}
+		if limit.RateLimit == nil {
+			return fmt.Errorf("rate limit is empty, msg type url: %s", limit.MsgTypeUrl)
+		}
+
+		if err := limit.RateLimit.Validate(); err != nil {
+			return fmt.Errorf("invalid rate limit for msg type url %s: %w", limit.MsgTypeUrl, err)
+		}
+	}
+
 	return nil
 }
 
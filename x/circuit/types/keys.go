@@ -19,4 +19,6 @@ const (
 var (
 	AccountPermissionPrefix = collections.NewPrefix(1)
 	DisableListPrefix       = collections.NewPrefix(2)
+	RateLimitsPrefix        = collections.NewPrefix(3)
+	RateLimitUsagePrefix    = collections.NewPrefix(4)
 )
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+
+	"cosmossdk.io/core/transaction"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OutflowFn returns the coins that msg moves out of its signers' accounts. It
+// is used to enforce the max_outflow of rate limits.
+type OutflowFn func(ctx context.Context, msg transaction.Msg) (sdk.Coins, error)
+
+var (
+	coinsType = reflect.TypeOf(sdk.Coins{})
+	coinType  = reflect.TypeOf(sdk.Coin{})
+)
+
+// DefaultOutflowFn sums all the top-level sdk.Coins and sdk.Coin fields of msg
+// (e.g. the amount of a bank MsgSend or of a staking MsgDelegate). Msg's that
+// carry their coins in nested fields, such as a bank MsgMultiSend, need a
+// dedicated OutflowFn.
+func DefaultOutflowFn(_ context.Context, msg transaction.Msg) (sdk.Coins, error) {
+	v := reflect.Indirect(reflect.ValueOf(msg))
+	if v.Kind() != reflect.Struct {
+		return nil, nil
+	}
+
+	outflow := sdk.NewCoins()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		switch field.Type() {
+		case coinsType:
+			outflow = outflow.Add(field.Interface().(sdk.Coins)...)
+		case coinType:
+			coin := field.Interface().(sdk.Coin)
+			if coin.Amount.IsNil() {
+				continue
+			}
+			outflow = outflow.Add(coin)
+		}
+	}
+
+	return outflow, nil
+}
+
+// Validate performs a basic validation of the rate limit.
+func (r *RateLimit) Validate() error {
+	if r.MaxMsgs == 0 && r.MaxOutflow.Empty() {
+		return errors.New("rate limit must set max_msgs or max_outflow")
+	}
+
+	if r.WindowBlocks > math.MaxInt64 {
+		return fmt.Errorf("rate limit window_blocks cannot exceed %d", int64(math.MaxInt64))
+	}
+
+	if !r.MaxOutflow.Empty() {
+		return r.MaxOutflow.Validate()
+	}
+
+	return nil
+}
+
+// Window returns the number of blocks after which the counters of the rate
+// limit are reset.
+func (r *RateLimit) Window() int64 {
+	if r.WindowBlocks == 0 {
+		return 1
+	}
+
+	return int64(r.WindowBlocks)
+}
@@ -79,6 +79,29 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "delegator_address"},
 					},
 				},
+				{
+					RpcMethod: "DelegatorWithdrawSplit",
+					Use:       "withdraw-split <delegator-addr>",
+					Short:     "Query the split of the withdrawals of a delegator between several addresses",
+					Example:   fmt.Sprintf("$ %s query distribution withdraw-split [delegator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
+				{
+					RpcMethod: "DelegatorAutoCompound",
+					Use:       "auto-compound <delegator-addr>",
+					Short:     "Query the validators of the auto-compounded delegations of a delegator",
+					Example:   fmt.Sprintf("$ %s query distribution auto-compound [delegator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
+				{
+					RpcMethod: "AutoCompoundProgress",
+					Use:       "auto-compound-progress",
+					Short:     "Query the compounding in progress of the auto-compounded delegations",
+				},
 				{
 					RpcMethod: "CommunityPool",
 					Use:       "community-pool",
@@ -115,6 +138,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "WithdrawTokenizeShareRecordReward",
+					Use:       "withdraw-tokenize-share-rewards <record-id>",
+					Short:     "Withdraw the rewards of a tokenize share record (must be the record owner)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "record_id"},
+					},
+				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound <validator-addr> <enabled>",
+					Short:     "Enable or disable the auto-compounding of the rewards of a delegation",
+					Example:   fmt.Sprintf("%s tx distribution set-auto-compound cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp true --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "enabled"},
+					},
+				},
+				{
+					RpcMethod: "SetWithdrawSplit",
+					Use:       "set-withdraw-split",
+					Short:     "Split the rewards and commission withdrawn by an address between several addresses",
+					Long:      "Split the rewards and commission withdrawn by an address between several addresses, whose fractions must sum to 1. Without recipients, the split is removed and withdrawals go to the withdraw address again.",
+					Example:   fmt.Sprintf(`%s tx distribution set-withdraw-split --recipients '{"address":"cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p","fraction":"0.7"}' --recipients '{"address":"cosmos1cwz8l6zhmxs55jq0dne5yg2fz6mt8nk5qwq5vx","fraction":"0.3"}' --from mykey`, version.AppName),
+				},
 				{
 					RpcMethod: "DepositValidatorRewardsPool",
 					Use:       "fund-validator-rewards-pool <validator-addr> <amount>",
@@ -0,0 +1,20 @@
+package distribution
+
+import (
+	"context"
+
+	epochstypes "cosmossdk.io/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = AppModule{}
+
+// BeforeEpochStart is a noop
+func (am AppModule) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// AfterEpochEnd starts compounding the rewards of the auto-compounded delegations
+// at the end of the auto-compound epoch.
+func (am AppModule) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	return am.keeper.StartAutoCompound(ctx, epochIdentifier, epochNumber)
+}
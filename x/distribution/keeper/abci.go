@@ -37,5 +37,6 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 		}
 	}
 
-	return nil
+	// compound the next batch of auto-compounded delegations, if any
+	return k.CompoundDelegations(ctx)
 }
@@ -0,0 +1,305 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	coregas "cosmossdk.io/core/gas"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetAutoCompound enables or disables the auto-compounding of the rewards of a delegation.
+// Enabling it requires auto-compounding to be enabled in the params, the delegation to exist
+// and the delegator to be its own withdraw address, without a withdraw split.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	key := collections.Join(delAddr, valAddr)
+	if enabled {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !params.AutoCompoundEnabled() {
+			return types.ErrAutoCompoundDisabled
+		}
+
+		if err := k.checkAutoCompound(ctx, delAddr, valAddr); err != nil {
+			return err
+		}
+
+		if err := k.AutoCompoundDelegations.Set(ctx, key); err != nil {
+			return err
+		}
+	} else if err := k.AutoCompoundDelegations.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	delegator, err := k.addrCdc.BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyValidator, validator),
+		event.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+	)
+}
+
+// checkAutoCompound returns an error if the rewards of a delegation cannot be compounded.
+func (k Keeper) checkAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	del, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if del == nil {
+		return types.ErrNoDelegationExists
+	}
+
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	if !withdrawAddr.Equals(delAddr) {
+		return types.ErrAutoCompoundWithdrawAddr
+	}
+
+	hasSplit, err := k.DelegatorWithdrawSplit.Has(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	if hasSplit {
+		return types.ErrAutoCompoundWithdrawAddr
+	}
+
+	return nil
+}
+
+// StartAutoCompound starts compounding the rewards of the auto-compounded delegations at the end
+// of an auto-compound epoch, with the gas budget of the params. If the compounding of the
+// previous epoch ran out of gas, it resumes where it stopped.
+func (k Keeper) StartAutoCompound(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.AutoCompoundEnabled() || epochIdentifier != params.AutoCompoundEpochIdentifier {
+		return nil
+	}
+
+	progress, err := k.AutoCompoundProgress.Get(ctx)
+	switch {
+	case err == nil:
+		progress.EpochNumber = epochNumber
+		progress.GasRemaining = params.AutoCompoundGasBudget
+
+		return k.AutoCompoundProgress.Set(ctx, progress)
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	var first *collections.Pair[sdk.AccAddress, sdk.ValAddress]
+	err = k.AutoCompoundDelegations.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (stop bool, err error) {
+		first = &key
+		return true, nil
+	})
+	if err != nil || first == nil {
+		return err
+	}
+
+	progress = types.AutoCompoundProgress{
+		EpochNumber:  epochNumber,
+		GasRemaining: params.AutoCompoundGasBudget,
+	}
+	if err := k.setAutoCompoundCursor(&progress, *first); err != nil {
+		return err
+	}
+
+	return k.AutoCompoundProgress.Set(ctx, progress)
+}
+
+// CompoundDelegations compounds the rewards of the next batch of auto-compounded delegations of
+// the compounding in progress, if any. Each delegation is compounded in its own branch, whose gas
+// is deducted from the gas budget of the epoch. A delegation whose compounding fails is skipped,
+// and its opt-in is removed if the delegation no longer exists, if the delegator changed its
+// withdraw address or set a withdraw split, or if it runs out of the whole gas budget.
+func (k Keeper) CompoundDelegations(ctx context.Context) error {
+	progress, err := k.AutoCompoundProgress.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if progress.GasRemaining == 0 {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.AutoCompoundEnabled() {
+		return k.AutoCompoundProgress.Remove(ctx)
+	}
+
+	delAddr, err := k.addrCdc.StringToBytes(progress.NextDelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(progress.NextValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	// fetch one more delegation than the batch to know where the next batch starts
+	batchSize := int(params.AutoCompoundMaxPerBlock)
+	batch := make([]collections.Pair[sdk.AccAddress, sdk.ValAddress], 0, batchSize+1)
+	rng := new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]]).
+		StartInclusive(collections.Join(sdk.AccAddress(delAddr), sdk.ValAddress(valAddr)))
+	err = k.AutoCompoundDelegations.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (stop bool, err error) {
+		batch = append(batch, key)
+		return len(batch) > batchSize, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var next *collections.Pair[sdk.AccAddress, sdk.ValAddress]
+	for i, key := range batch {
+		if i == batchSize || progress.GasRemaining == 0 {
+			next = &batch[i]
+			break
+		}
+
+		gasLimit := progress.GasRemaining
+		gasUsed, err := k.BranchService.ExecuteWithGasLimit(ctx, gasLimit, func(ctx context.Context) error {
+			return k.compoundDelegation(ctx, key.K1(), key.K2())
+		})
+		progress.GasRemaining -= min(gasUsed, progress.GasRemaining)
+
+		switch {
+		case err == nil:
+		case (isOutOfGas(err) || progress.GasRemaining == 0) && gasLimit >= params.AutoCompoundGasBudget:
+			// the delegation cannot be compounded with the whole budget of an epoch, drop it instead
+			// of retrying it every epoch
+			progress.GasRemaining = 0
+			k.Logger.Info("dropped the auto-compounding of a delegation exceeding the gas budget", "delegator", key.K1().String(), "validator", key.K2().String(), "err", err)
+			if err := k.AutoCompoundDelegations.Remove(ctx, key); err != nil {
+				return err
+			}
+		case isOutOfGas(err) || progress.GasRemaining == 0:
+			// the budget ran out while compounding the delegation, retry it in the next epoch
+			progress.GasRemaining = 0
+			next = &batch[i]
+		case errors.Is(err, types.ErrNoDelegationExists), errors.Is(err, types.ErrAutoCompoundWithdrawAddr):
+			if err := k.AutoCompoundDelegations.Remove(ctx, key); err != nil {
+				return err
+			}
+		default:
+			k.Logger.Error("failed to auto-compound delegation rewards", "delegator", key.K1().String(), "validator", key.K2().String(), "err", err)
+		}
+
+		if next != nil {
+			break
+		}
+	}
+
+	if next == nil {
+		return k.AutoCompoundProgress.Remove(ctx)
+	}
+
+	if err := k.setAutoCompoundCursor(&progress, *next); err != nil {
+		return err
+	}
+
+	return k.AutoCompoundProgress.Set(ctx, progress)
+}
+
+// compoundDelegation withdraws the rewards of a delegation and delegates the ones in the bond denom
+// to its validator.
+func (k Keeper) compoundDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if err := k.checkAutoCompound(ctx, delAddr, valAddr); err != nil {
+		return err
+	}
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	amount := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	delegator, err := k.addrCdc.BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.MsgRouterService.Invoke(ctx, stakingtypes.NewMsgDelegate(delegator, validator, amount)); err != nil {
+		return errorsmod.Wrap(err, "failed to delegate rewards")
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAutoCompound,
+		event.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyValidator, validator),
+	)
+}
+
+// isOutOfGas returns true if the error is an out of gas error of either the gas meter of
+// the runtime or the gas meter of the store.
+func isOutOfGas(err error) bool {
+	return errors.Is(err, coregas.ErrOutOfGas) || errors.Is(err, sdkerrors.ErrOutOfGas)
+}
+
+// setAutoCompoundCursor sets the next delegation to compound of the compounding in progress.
+func (k Keeper) setAutoCompoundCursor(progress *types.AutoCompoundProgress, key collections.Pair[sdk.AccAddress, sdk.ValAddress]) error {
+	delegator, err := k.addrCdc.BytesToString(key.K1())
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(key.K2())
+	if err != nil {
+		return err
+	}
+
+	progress.NextDelegatorAddress = delegator
+	progress.NextValidatorAddress = validator
+	return nil
+}
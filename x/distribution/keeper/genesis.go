@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -113,6 +114,38 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) error
 		}
 	}
 
+	for _, acd := range data.AutoCompoundDelegations {
+		delegatorAddress, err := k.addrCdc.StringToBytes(acd.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(acd.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		err = k.AutoCompoundDelegations.Set(ctx, collections.Join(sdk.AccAddress(delegatorAddress), sdk.ValAddress(valAddr)))
+		if err != nil {
+			return err
+		}
+	}
+
+	if data.AutoCompoundProgress != nil {
+		if err := k.AutoCompoundProgress.Set(ctx, *data.AutoCompoundProgress); err != nil {
+			return err
+		}
+	}
+
+	for _, dws := range data.DelegatorWithdrawSplits {
+		delegatorAddress, err := k.addrCdc.StringToBytes(dws.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		err = k.DelegatorWithdrawSplit.Set(ctx, delegatorAddress, types.WithdrawSplit{Recipients: dws.Recipients})
+		if err != nil {
+			return err
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.DecimalPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -282,5 +315,55 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
-	return types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes), nil
+	autoCompound := make([]types.AutoCompoundDelegation, 0)
+	err = k.AutoCompoundDelegations.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (stop bool, err error) {
+		delAddr, err := k.addrCdc.BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		autoCompound = append(autoCompound, types.AutoCompoundDelegation{
+			DelegatorAddress: delAddr,
+			ValidatorAddress: valAddr,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	splits := make([]types.DelegatorWithdrawSplitRecord, 0)
+	err = k.DelegatorWithdrawSplit.Walk(ctx, nil, func(delAddr sdk.AccAddress, split types.WithdrawSplit) (stop bool, err error) {
+		delegator, err := k.addrCdc.BytesToString(delAddr)
+		if err != nil {
+			return true, err
+		}
+
+		splits = append(splits, types.DelegatorWithdrawSplitRecord{
+			DelegatorAddress: delegator,
+			Recipients:       split.Recipients,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes)
+	genState.AutoCompoundDelegations = autoCompound
+	genState.DelegatorWithdrawSplits = splits
+
+	progress, err := k.AutoCompoundProgress.Get(ctx)
+	switch {
+	case err == nil:
+		genState.AutoCompoundProgress = &progress
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
+	return genState, nil
 }
@@ -369,6 +369,80 @@ func (k Querier) DelegatorWithdrawAddress(ctx context.Context, req *types.QueryD
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: addr}, nil
 }
 
+// DelegatorWithdrawSplit queries the withdraw split of a delegator
+func (k Querier) DelegatorWithdrawSplit(ctx context.Context, req *types.QueryDelegatorWithdrawSplitRequest) (*types.QueryDelegatorWithdrawSplitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := k.addrCdc.StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	split, err := k.Keeper.DelegatorWithdrawSplit.Get(ctx, delAdr)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return &types.QueryDelegatorWithdrawSplitResponse{Recipients: []types.WithdrawRecipient{}}, nil
+		}
+		return nil, err
+	}
+
+	return &types.QueryDelegatorWithdrawSplitResponse{Recipients: split.Recipients}, nil
+}
+
+// DelegatorAutoCompound queries the validators of the auto-compounded delegations of a delegator
+func (k Querier) DelegatorAutoCompound(ctx context.Context, req *types.QueryDelegatorAutoCompoundRequest) (*types.QueryDelegatorAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := k.addrCdc.StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]string, 0)
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delAdr)
+	err = k.AutoCompoundDelegations.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (stop bool, err error) {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		validators = append(validators, valAddr)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegatorAutoCompoundResponse{Validators: validators}, nil
+}
+
+// AutoCompoundProgress queries the compounding in progress of the auto-compounded delegations
+func (k Querier) AutoCompoundProgress(ctx context.Context, req *types.QueryAutoCompoundProgressRequest) (*types.QueryAutoCompoundProgressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	progress, err := k.Keeper.AutoCompoundProgress.Get(ctx)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return &types.QueryAutoCompoundProgressResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return &types.QueryAutoCompoundProgressResponse{Progress: &progress}, nil
+}
+
 // Deprecated: DO NOT USE
 // This method uses deprecated query request. Use CommunityPool from x/protocolpool module instead.
 // CommunityPool queries the community pool coins
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"cosmossdk.io/collections"
 	collcodec "cosmossdk.io/collections/codec"
@@ -13,6 +14,7 @@ import (
 	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -54,6 +56,12 @@ type Keeper struct {
 	ValidatorHistoricalRewards collections.Map[collections.Pair[sdk.ValAddress, uint64], types.ValidatorHistoricalRewards]
 	// ValidatorSlashEvents key: valAddr+height+period | value: ValidatorSlashEvent
 	ValidatorSlashEvents collections.Map[collections.Triple[sdk.ValAddress, uint64, uint64], types.ValidatorSlashEvent]
+	// AutoCompoundDelegations key: delAddr+valAddr | value: none used (index key for auto-compounded delegations)
+	AutoCompoundDelegations collections.KeySet[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
+	// AutoCompoundProgress stores the compounding in progress of the auto-compounded delegations
+	AutoCompoundProgress collections.Item[types.AutoCompoundProgress]
+	// DelegatorWithdrawSplit key: delAddr | value: WithdrawSplit
+	DelegatorWithdrawSplit collections.Map[sdk.AccAddress, types.WithdrawSplit]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -153,6 +161,30 @@ func NewKeeper(
 			),
 			codec.CollValue[types.ValidatorSlashEvent](cdc),
 		),
+		AutoCompoundDelegations: collections.NewKeySet(
+			sb,
+			types.AutoCompoundDelegationPrefix,
+			"auto_compound_delegations",
+			collections.NamedPairKeyCodec(
+				"delegator_address",
+				sdk.AccAddressKey,
+				"validator_address",
+				sdk.ValAddressKey,
+			),
+		),
+		AutoCompoundProgress: collections.NewItem(
+			sb,
+			types.AutoCompoundProgressKey,
+			"auto_compound_progress",
+			codec.CollValue[types.AutoCompoundProgress](cdc),
+		),
+		DelegatorWithdrawSplit: collections.NewMap(
+			sb,
+			types.DelegatorWithdrawSplitPrefix,
+			"delegator_withdraw_split",
+			sdk.AccAddressKey,
+			codec.CollValue[types.WithdrawSplit](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -261,14 +293,7 @@ func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.Val
 	}
 
 	if !commission.IsZero() {
-		accAddr := sdk.AccAddress(valAddr)
-		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, accAddr)
-		if err != nil {
-			return nil, err
-		}
-
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
-		if err != nil {
+		if err := k.sendWithdrawal(ctx, sdk.AccAddress(valAddr), commission); err != nil {
 			return nil, err
 		}
 	}
@@ -284,6 +309,58 @@ func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.Val
 	return commission, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegation of a tokenize share record
+// of x/staking to the owner of the record. The rewards withdrawn to the record account whenever the
+// tokenized delegation is modified are sent to the owner as well.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx context.Context, ownerAddr sdk.AccAddress, recordID uint64) (sdk.Coins, error) {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := k.addrCdc.BytesToString(ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Owner != owner {
+		return nil, errorsmod.Wrapf(stakingtypes.ErrNotTokenizeShareRecordOwner, "%s is not the owner of record %d", owner, recordID)
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	if _, err := k.stakingKeeper.Delegation(ctx, recordAddr, valAddr); err == nil {
+		if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+	if !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, ownerAddr, rewards); err != nil {
+			return nil, err
+		}
+	}
+
+	err = k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeWithdrawTokenizeShareReward,
+		event.NewAttribute(types.AttributeKeyWithdrawAddress, owner),
+		event.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(recordID, 10)),
+		event.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return rewards, nil
+}
+
 // GetTotalRewards returns the total amount of fee distribution rewards held in the store
 func (k Keeper) GetTotalRewards(ctx context.Context) (totalRewards sdk.DecCoins) {
 	err := k.ValidatorOutstandingRewards.Walk(ctx, nil, func(_ sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool, err error) {
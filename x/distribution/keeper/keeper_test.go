@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/math"
@@ -15,6 +16,7 @@ import (
 	"cosmossdk.io/x/distribution/keeper"
 	distrtestutil "cosmossdk.io/x/distribution/testutil"
 	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
@@ -136,6 +138,34 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	}, remainder)
 }
 
+func TestWithdrawValidatorCommissionWithSplit(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+
+	valAddr := sdk.ValAddress(addrs[0])
+	valCommission := sdk.NewDecCoins(sdk.NewInt64DecCoin("mytoken", 5), sdk.NewInt64DecCoin("stake", 10))
+	require.NoError(t, distrKeeper.ValidatorOutstandingRewards.Set(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: valCommission}))
+	require.NoError(t, distrKeeper.ValidatorsAccumulatedCommission.Set(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: valCommission}))
+
+	addr0Str, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addrs[0])
+	require.NoError(t, err)
+	addr1Str, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addrs[1])
+	require.NoError(t, err)
+
+	dep.bankKeeper.EXPECT().BlockedAddr(addrs[0]).Return(false)
+	require.NoError(t, distrKeeper.SetWithdrawSplit(ctx, addrs[0], []types.WithdrawRecipient{
+		{Address: addr1Str, Fraction: math.LegacyNewDecWithPrec(7, 1)},
+		{Address: addr0Str, Fraction: math.LegacyNewDecWithPrec(3, 1)},
+	}))
+
+	// the amounts of the first recipient are truncated, the last one receives the remainder
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "distribution", addrs[1], sdk.NewCoins(sdk.NewInt64Coin("mytoken", 3), sdk.NewInt64Coin("stake", 7))).Return(nil)
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "distribution", addrs[0], sdk.NewCoins(sdk.NewInt64Coin("mytoken", 2), sdk.NewInt64Coin("stake", 3))).Return(nil)
+
+	commission, err := distrKeeper.WithdrawValidatorCommission(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("mytoken", 5), sdk.NewInt64Coin("stake", 10)), commission)
+}
+
 func TestGetTotalRewards(t *testing.T) {
 	ctx, addrs, distrKeeper, _ := initFixture(t)
 
@@ -152,3 +182,101 @@ func TestGetTotalRewards(t *testing.T) {
 
 	require.Equal(t, expectedRewards, totalRewards)
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+
+	owner, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addrs[0])
+	require.NoError(t, err)
+	valAddr := sdk.ValAddress(addrs[1])
+	valStr, err := address.NewBech32Codec("cosmosvaloper").BytesToString(valAddr)
+	require.NoError(t, err)
+
+	record := stakingtypes.NewTokenizeShareRecord(1, owner, valStr)
+	recordAddr := record.GetModuleAddress()
+	dep.stakingKeeper.EXPECT().GetTokenizeShareRecord(gomock.Any(), uint64(1)).Return(record, nil).Times(2)
+
+	// only the owner of the record can withdraw its rewards
+	_, err = distrKeeper.WithdrawTokenizeShareRecordReward(ctx, addrs[1], 1)
+	require.ErrorIs(t, err, stakingtypes.ErrNotTokenizeShareRecordOwner)
+
+	// the balance left on the record account is sent to the owner once the delegation is redeemed
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("stake", 3))
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), recordAddr, valAddr).Return(nil, collections.ErrNotFound)
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), recordAddr).Return(rewards)
+	dep.bankKeeper.EXPECT().SendCoins(gomock.Any(), recordAddr, addrs[0], rewards).Return(nil)
+
+	res, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, addrs[0], 1)
+	require.NoError(t, err)
+	require.Equal(t, rewards, res)
+}
+
+func TestStartAutoCompound(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+
+	params := types.DefaultParams()
+	params.AutoCompoundEpochIdentifier = "day"
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	valAddr := sdk.ValAddress(addrs[1])
+	valStr, err := address.NewBech32Codec("cosmosvaloper").BytesToString(valAddr)
+	require.NoError(t, err)
+	delStrs := make([]string, len(addrs))
+	for i, addr := range addrs {
+		delStrs[i], err = codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addr)
+		require.NoError(t, err)
+	}
+
+	// nothing to compound without auto-compounded delegations
+	require.NoError(t, distrKeeper.StartAutoCompound(ctx, "day", 1))
+	_, err = distrKeeper.AutoCompoundProgress.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	require.NoError(t, distrKeeper.AutoCompoundDelegations.Set(ctx, collections.Join(addrs[1], valAddr)))
+	require.NoError(t, distrKeeper.AutoCompoundDelegations.Set(ctx, collections.Join(addrs[0], valAddr)))
+
+	// other epochs are ignored
+	require.NoError(t, distrKeeper.StartAutoCompound(ctx, "week", 1))
+	_, err = distrKeeper.AutoCompoundProgress.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// the compounding starts at the first delegation with the gas budget of the params
+	require.NoError(t, distrKeeper.StartAutoCompound(ctx, "day", 2))
+	progress, err := distrKeeper.AutoCompoundProgress.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.AutoCompoundProgress{
+		EpochNumber:          2,
+		NextDelegatorAddress: delStrs[0],
+		NextValidatorAddress: valStr,
+		GasRemaining:         params.AutoCompoundGasBudget,
+	}, progress)
+
+	// a compounding which ran out of gas resumes where it stopped with a new budget
+	progress.NextDelegatorAddress = delStrs[1]
+	progress.GasRemaining = 0
+	require.NoError(t, distrKeeper.AutoCompoundProgress.Set(ctx, progress))
+	require.NoError(t, distrKeeper.CompoundDelegations(ctx))
+
+	require.NoError(t, distrKeeper.StartAutoCompound(ctx, "day", 3))
+	progress, err = distrKeeper.AutoCompoundProgress.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.AutoCompoundProgress{
+		EpochNumber:          3,
+		NextDelegatorAddress: delStrs[1],
+		NextValidatorAddress: valStr,
+		GasRemaining:         params.AutoCompoundGasBudget,
+	}, progress)
+
+	// the compounding in progress is dropped once auto-compounding is disabled
+	params.AutoCompoundEpochIdentifier = ""
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	require.NoError(t, distrKeeper.CompoundDelegations(ctx))
+	_, err = distrKeeper.AutoCompoundProgress.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// the opt-in of a delegation is removed with the delegation
+	require.NoError(t, distrKeeper.Hooks().BeforeDelegationRemoved(ctx, addrs[0], valAddr))
+	has, err := distrKeeper.AutoCompoundDelegations.Has(ctx, collections.Join(addrs[0], valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+}
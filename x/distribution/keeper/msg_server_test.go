@@ -6,9 +6,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/distribution/keeper"
 	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -253,6 +255,17 @@ func TestMsgUpdateParams(t *testing.T) {
 			},
 			errMsg: "community tax must be positive",
 		},
+		{
+			name: "invalid auto-compound params",
+			msg: &types.MsgUpdateParams{
+				Authority: authorityAddr,
+				Params: types.Params{
+					CommunityTax:                math.LegacyNewDecWithPrec(2, 2),
+					AutoCompoundEpochIdentifier: "day",
+				},
+			},
+			errMsg: "auto-compound max per block must be positive",
+		},
 		{
 			name: "success",
 			msg: &types.MsgUpdateParams{
@@ -378,3 +391,186 @@ func TestMsgDepositValidatorRewardsPool(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	addr0Str, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addrs[0])
+	require.NoError(t, err)
+	valAddr := sdk.ValAddress(addrs[1])
+	valAddr1Str, err := codectestutil.CodecOptions{}.GetValidatorCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+	delegation := stakingtypes.NewDelegation(addr0Str, valAddr1Str, math.LegacyNewDec(100))
+
+	enableAutoCompound := func() {
+		params := types.DefaultParams()
+		params.AutoCompoundEpochIdentifier = "day"
+		require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	}
+
+	cases := []struct {
+		name   string
+		preRun func()
+		msg    *types.MsgSetAutoCompound
+		errMsg string
+	}{
+		{
+			name:   "invalid delegator address",
+			msg:    types.NewMsgSetAutoCompound("invalid", valAddr1Str, true),
+			errMsg: "invalid delegator address",
+		},
+		{
+			name:   "invalid validator address",
+			msg:    types.NewMsgSetAutoCompound(addr0Str, "invalid", true),
+			errMsg: "invalid validator address",
+		},
+		{
+			name:   "auto-compounding disabled",
+			msg:    types.NewMsgSetAutoCompound(addr0Str, valAddr1Str, true),
+			errMsg: "auto-compounding disabled",
+		},
+		{
+			name: "no delegation",
+			preRun: func() {
+				enableAutoCompound()
+				dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), addrs[0], valAddr).Return(nil, collections.ErrNotFound)
+			},
+			msg:    types.NewMsgSetAutoCompound(addr0Str, valAddr1Str, true),
+			errMsg: "delegation does not exist",
+		},
+		{
+			name: "withdraw address is not the delegator",
+			preRun: func() {
+				require.NoError(t, distrKeeper.SetWithdrawAddr(ctx, addrs[0], addrs[1]))
+				dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), addrs[0], valAddr).Return(delegation, nil)
+			},
+			msg:    types.NewMsgSetAutoCompound(addr0Str, valAddr1Str, true),
+			errMsg: "requires the delegator to be its own withdraw address",
+		},
+		{
+			name: "enable",
+			preRun: func() {
+				require.NoError(t, distrKeeper.DelegatorsWithdrawAddress.Remove(ctx, addrs[0]))
+				dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), addrs[0], valAddr).Return(delegation, nil)
+			},
+			msg: types.NewMsgSetAutoCompound(addr0Str, valAddr1Str, true),
+		},
+		{
+			name: "disable",
+			msg:  types.NewMsgSetAutoCompound(addr0Str, valAddr1Str, false),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := msgServer.SetAutoCompound(ctx, tc.msg)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			}
+
+			has, err := distrKeeper.AutoCompoundDelegations.Has(ctx, collections.Join(addrs[0], valAddr))
+			require.NoError(t, err)
+			require.Equal(t, tc.name == "enable", has)
+		})
+	}
+}
+
+func TestMsgSetWithdrawSplit(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	addr0Str, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addrs[0])
+	require.NoError(t, err)
+	addr1Str, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addrs[1])
+	require.NoError(t, err)
+	distrAccStr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(distrAcc.GetAddress())
+	require.NoError(t, err)
+
+	recipients := []types.WithdrawRecipient{
+		{Address: addr1Str, Fraction: math.LegacyOneDec()},
+	}
+
+	cases := []struct {
+		name     string
+		preRun   func()
+		msg      *types.MsgSetWithdrawSplit
+		errMsg   string
+		hasSplit bool
+	}{
+		{
+			name:   "invalid delegator address",
+			msg:    types.NewMsgSetWithdrawSplit("invalid", recipients),
+			errMsg: "invalid delegator address",
+		},
+		{
+			name: "withdraw address disabled",
+			preRun: func() {
+				params := types.DefaultParams()
+				params.WithdrawAddrEnabled = false
+				require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			},
+			msg:    types.NewMsgSetWithdrawSplit(addr0Str, recipients),
+			errMsg: "set withdraw address disabled",
+		},
+		{
+			name: "fractions do not sum to 1",
+			preRun: func() {
+				require.NoError(t, distrKeeper.Params.Set(ctx, types.DefaultParams()))
+			},
+			msg: types.NewMsgSetWithdrawSplit(addr0Str, []types.WithdrawRecipient{
+				{Address: addr1Str, Fraction: math.LegacyNewDecWithPrec(5, 1)},
+			}),
+			errMsg: "fractions must sum to 1",
+		},
+		{
+			name: "invalid recipient address",
+			msg: types.NewMsgSetWithdrawSplit(addr0Str, []types.WithdrawRecipient{
+				{Address: "invalid", Fraction: math.LegacyOneDec()},
+			}),
+			errMsg: "invalid withdraw split recipient address",
+		},
+		{
+			name: "blocked recipient",
+			msg: types.NewMsgSetWithdrawSplit(addr0Str, []types.WithdrawRecipient{
+				{Address: addr1Str, Fraction: math.LegacyNewDecWithPrec(5, 1)},
+				{Address: distrAccStr, Fraction: math.LegacyNewDecWithPrec(5, 1)},
+			}),
+			errMsg: "is not allowed to receive external funds",
+		},
+		{
+			name:     "set",
+			msg:      types.NewMsgSetWithdrawSplit(addr0Str, recipients),
+			hasSplit: true,
+		},
+		{
+			name: "remove",
+			msg:  types.NewMsgSetWithdrawSplit(addr0Str, nil),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := msgServer.SetWithdrawSplit(ctx, tc.msg)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			}
+
+			has, err := distrKeeper.DelegatorWithdrawSplit.Has(ctx, addrs[0])
+			require.NoError(t, err)
+			require.Equal(t, tc.hasSplit, has)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetWithdrawSplit splits the rewards and commission withdrawn by a delegator between
+// recipients, or removes the split of the delegator if there are no recipients. The split
+// takes precedence over the withdraw address of the delegator.
+func (k Keeper) SetWithdrawSplit(ctx context.Context, delAddr sdk.AccAddress, recipients []types.WithdrawRecipient) error {
+	delegator, err := k.addrCdc.BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	if len(recipients) == 0 {
+		if err := k.DelegatorWithdrawSplit.Remove(ctx, delAddr); err != nil {
+			return err
+		}
+
+		return k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeSetWithdrawSplit,
+			event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		)
+	}
+
+	withdrawAddrEnabled, err := k.GetWithdrawAddrEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !withdrawAddrEnabled {
+		return types.ErrSetWithdrawAddrDisabled
+	}
+
+	split := types.WithdrawSplit{Recipients: recipients}
+	if err := split.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidWithdrawSplit, err.Error())
+	}
+
+	for _, r := range recipients {
+		addr, err := k.addrCdc.StringToBytes(r.Address)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid withdraw split recipient address: %s", err)
+		}
+
+		if k.bankKeeper.BlockedAddr(addr) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", r.Address)
+		}
+	}
+
+	if err := k.DelegatorWithdrawSplit.Set(ctx, delAddr, split); err != nil {
+		return err
+	}
+
+	for _, r := range recipients {
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeSetWithdrawSplit,
+			event.NewAttribute(types.AttributeKeyDelegator, delegator),
+			event.NewAttribute(types.AttributeKeyWithdrawAddress, r.Address),
+			event.NewAttribute(types.AttributeKeyFraction, r.Fraction.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendWithdrawal sends the rewards or commission withdrawn by a delegator to its withdraw
+// address, or splits them between the recipients of its withdraw split if it has one.
+func (k Keeper) sendWithdrawal(ctx context.Context, delAddr sdk.AccAddress, coins sdk.Coins) error {
+	split, err := k.DelegatorWithdrawSplit.Get(ctx, delAddr)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		if err != nil {
+			return err
+		}
+
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
+	}
+
+	delegator, err := k.addrCdc.BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	amounts := split.Split(coins)
+	for i, r := range split.Recipients {
+		if amounts[i].IsZero() {
+			continue
+		}
+
+		addr, err := k.addrCdc.StringToBytes(r.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amounts[i]); err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeWithdrawSplit,
+			event.NewAttribute(sdk.AttributeKeyAmount, amounts[i].String()),
+			event.NewAttribute(types.AttributeKeyDelegator, delegator),
+			event.NewAttribute(types.AttributeKeyWithdrawAddress, r.Address),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -38,6 +38,17 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_epoch_identifier is the identifier of the x/epochs epoch at
+	// the end of which the rewards of the auto-compounded delegations are
+	// re-delegated. Auto-compounding is disabled when empty.
+	AutoCompoundEpochIdentifier string `protobuf:"bytes,5,opt,name=auto_compound_epoch_identifier,json=autoCompoundEpochIdentifier,proto3" json:"auto_compound_epoch_identifier,omitempty"`
+	// auto_compound_max_per_block is the maximum number of delegations compounded
+	// per block.
+	AutoCompoundMaxPerBlock uint32 `protobuf:"varint,6,opt,name=auto_compound_max_per_block,json=autoCompoundMaxPerBlock,proto3" json:"auto_compound_max_per_block,omitempty"`
+	// auto_compound_gas_budget is the gas available to compound the delegations
+	// of an epoch. The delegations left once it is spent are compounded in the
+	// next epoch.
+	AutoCompoundGasBudget uint64 `protobuf:"varint,7,opt,name=auto_compound_gas_budget,json=autoCompoundGasBudget,proto3" json:"auto_compound_gas_budget,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +91,27 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundEpochIdentifier() string {
+	if m != nil {
+		return m.AutoCompoundEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetAutoCompoundMaxPerBlock() uint32 {
+	if m != nil {
+		return m.AutoCompoundMaxPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundGasBudget() uint64 {
+	if m != nil {
+		return m.AutoCompoundGasBudget
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -637,6 +669,177 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// AutoCompoundProgress tracks the compounding of the auto-compounded
+// delegations of an epoch, which is spread over several blocks.
+type AutoCompoundProgress struct {
+	// epoch_number is the number of the epoch being compounded.
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// next_delegator_address is the delegator of the next delegation to compound.
+	NextDelegatorAddress string `protobuf:"bytes,2,opt,name=next_delegator_address,json=nextDelegatorAddress,proto3" json:"next_delegator_address,omitempty"`
+	// next_validator_address is the validator of the next delegation to compound.
+	NextValidatorAddress string `protobuf:"bytes,3,opt,name=next_validator_address,json=nextValidatorAddress,proto3" json:"next_validator_address,omitempty"`
+	// gas_remaining is the gas left in the budget of the epoch.
+	GasRemaining uint64 `protobuf:"varint,4,opt,name=gas_remaining,json=gasRemaining,proto3" json:"gas_remaining,omitempty"`
+}
+
+func (m *AutoCompoundProgress) Reset()         { *m = AutoCompoundProgress{} }
+func (m *AutoCompoundProgress) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundProgress) ProtoMessage()    {}
+func (*AutoCompoundProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *AutoCompoundProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundProgress.Merge(m, src)
+}
+func (m *AutoCompoundProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundProgress proto.InternalMessageInfo
+
+func (m *AutoCompoundProgress) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *AutoCompoundProgress) GetNextDelegatorAddress() string {
+	if m != nil {
+		return m.NextDelegatorAddress
+	}
+	return ""
+}
+
+func (m *AutoCompoundProgress) GetNextValidatorAddress() string {
+	if m != nil {
+		return m.NextValidatorAddress
+	}
+	return ""
+}
+
+func (m *AutoCompoundProgress) GetGasRemaining() uint64 {
+	if m != nil {
+		return m.GasRemaining
+	}
+	return 0
+}
+
+// WithdrawRecipient is a recipient of a fraction of the rewards and commission
+// withdrawn by a delegator.
+type WithdrawRecipient struct {
+	// address is the address receiving the fraction of the withdrawals.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// fraction is the fraction of the withdrawals sent to the address, the
+	// fractions of all the recipients of a split summing to 1.
+	Fraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+}
+
+func (m *WithdrawRecipient) Reset()         { *m = WithdrawRecipient{} }
+func (m *WithdrawRecipient) String() string { return proto.CompactTextString(m) }
+func (*WithdrawRecipient) ProtoMessage()    {}
+func (*WithdrawRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *WithdrawRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawRecipient.Merge(m, src)
+}
+func (m *WithdrawRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawRecipient proto.InternalMessageInfo
+
+func (m *WithdrawRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// WithdrawSplit is the split of the rewards and commission withdrawn by a
+// delegator between several recipients. It takes precedence over the withdraw
+// address of the delegator.
+type WithdrawSplit struct {
+	Recipients []WithdrawRecipient `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *WithdrawSplit) Reset()         { *m = WithdrawSplit{} }
+func (m *WithdrawSplit) String() string { return proto.CompactTextString(m) }
+func (*WithdrawSplit) ProtoMessage()    {}
+func (*WithdrawSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{14}
+}
+func (m *WithdrawSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawSplit.Merge(m, src)
+}
+func (m *WithdrawSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawSplit proto.InternalMessageInfo
+
+func (m *WithdrawSplit) GetRecipients() []WithdrawRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -650,6 +853,9 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*AutoCompoundProgress)(nil), "cosmos.distribution.v1beta1.AutoCompoundProgress")
+	proto.RegisterType((*WithdrawRecipient)(nil), "cosmos.distribution.v1beta1.WithdrawRecipient")
+	proto.RegisterType((*WithdrawSplit)(nil), "cosmos.distribution.v1beta1.WithdrawSplit")
 }
 
 func init() {
@@ -657,73 +863,89 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+	// 1301 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0xae, 0xd3, 0x4e, 0x92, 0x96, 0x4e, 0x9d, 0xd4, 0x4d, 0x8a, 0xe3, 0x1a, 0x2a,
+	0x4c, 0x20, 0x36, 0x6d, 0x25, 0x40, 0x11, 0x12, 0x6a, 0x9c, 0x16, 0x2a, 0xb5, 0x25, 0xda, 0x20,
+	0x2a, 0xe0, 0xb0, 0x1a, 0xef, 0x4e, 0xec, 0x69, 0x76, 0x67, 0x96, 0x99, 0x59, 0x37, 0x39, 0xc1,
+	0x8d, 0xc2, 0x01, 0xb8, 0x81, 0xe0, 0x52, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0x3e, 0x44, 0xc5, 0xa9,
+	0xaa, 0xa0, 0x42, 0x1c, 0x0a, 0xa4, 0x87, 0x20, 0x3e, 0x05, 0x9a, 0x3f, 0xbb, 0xb6, 0x93, 0xb4,
+	0x0a, 0x41, 0x11, 0x97, 0x28, 0xfb, 0xde, 0xcc, 0xfb, 0xfd, 0xde, 0xbf, 0x79, 0xcf, 0xa0, 0xe6,
+	0x33, 0x11, 0x31, 0x51, 0x0f, 0x88, 0x90, 0x9c, 0x34, 0x13, 0x49, 0x18, 0xad, 0x77, 0xce, 0x35,
+	0xb1, 0x44, 0xe7, 0xfa, 0x84, 0xb5, 0x98, 0x33, 0xc9, 0xe0, 0x94, 0x39, 0x5f, 0xeb, 0x53, 0xd9,
+	0xf3, 0x93, 0x85, 0x16, 0x6b, 0x31, 0x7d, 0xae, 0xae, 0xfe, 0x33, 0x57, 0x26, 0x4b, 0x16, 0xa2,
+	0x89, 0x04, 0xce, 0x4c, 0xfb, 0x8c, 0x58, 0x93, 0x93, 0xa7, 0x8c, 0xde, 0x33, 0x17, 0xad, 0x7d,
+	0xa3, 0x3a, 0x8e, 0x22, 0x42, 0x59, 0x5d, 0xff, 0x35, 0xa2, 0xca, 0xc3, 0x1c, 0xc8, 0x2f, 0x22,
+	0x8e, 0x22, 0x01, 0x3f, 0x04, 0x63, 0x3e, 0x8b, 0xa2, 0x84, 0x12, 0xb9, 0xe6, 0x49, 0xb4, 0x5a,
+	0x74, 0xca, 0x4e, 0xf5, 0xc8, 0xfc, 0xab, 0xf7, 0x1e, 0x4d, 0x0f, 0xfc, 0xf6, 0x68, 0xda, 0x52,
+	0x15, 0xc1, 0x4a, 0x8d, 0xb0, 0x7a, 0x84, 0x64, 0xbb, 0x76, 0x15, 0xb7, 0x90, 0xbf, 0xb6, 0x80,
+	0xfd, 0x07, 0x1b, 0xb3, 0xc0, 0x22, 0x2d, 0x60, 0xff, 0xee, 0xd6, 0xfa, 0x8c, 0xe3, 0x8e, 0x66,
+	0xc6, 0xde, 0x45, 0xab, 0xf0, 0x26, 0x28, 0x28, 0xc2, 0x8a, 0x55, 0xcc, 0x04, 0xe6, 0x1e, 0xc7,
+	0xb7, 0x10, 0x0f, 0x8a, 0x83, 0x1a, 0xe3, 0xf5, 0xfd, 0x61, 0x14, 0x1d, 0x17, 0x2a, 0xab, 0x8b,
+	0xd6, 0xa8, 0xab, 0x6d, 0xc2, 0x10, 0x8c, 0x37, 0x19, 0x4d, 0xc4, 0x0e, 0xb0, 0xa1, 0xff, 0x08,
+	0x76, 0x42, 0x9b, 0xdd, 0x86, 0x76, 0x1e, 0x8c, 0xdf, 0x22, 0xb2, 0x1d, 0x70, 0x74, 0xcb, 0x43,
+	0x41, 0xc0, 0x3d, 0x4c, 0x51, 0x33, 0xc4, 0x41, 0x31, 0x57, 0x76, 0xaa, 0x87, 0xdd, 0x13, 0xa9,
+	0xf2, 0x62, 0x10, 0xf0, 0x4b, 0x46, 0x05, 0x1b, 0xa0, 0x84, 0x12, 0xc9, 0x3c, 0x9f, 0x45, 0x31,
+	0x4b, 0x68, 0xe0, 0xe1, 0x98, 0xf9, 0x6d, 0x8f, 0x04, 0x98, 0x4a, 0xb2, 0x4c, 0x30, 0x2f, 0x1e,
+	0x52, 0x54, 0xdd, 0x29, 0x75, 0xaa, 0x61, 0x0f, 0x5d, 0x52, 0x67, 0xae, 0x64, 0x47, 0xe0, 0x1b,
+	0x60, 0xaa, 0xdf, 0x48, 0x84, 0x56, 0xbd, 0x18, 0x73, 0xaf, 0x19, 0x32, 0x7f, 0xa5, 0x98, 0x2f,
+	0x3b, 0xd5, 0x31, 0xf7, 0x64, 0xaf, 0x85, 0x6b, 0x68, 0x75, 0x11, 0xf3, 0x79, 0xa5, 0x86, 0xaf,
+	0x81, 0x62, 0xff, 0xed, 0x16, 0x12, 0x5e, 0x33, 0x09, 0x5a, 0x58, 0x16, 0x87, 0xcb, 0x4e, 0x35,
+	0xe7, 0x8e, 0xf7, 0x5e, 0x7d, 0x0b, 0x89, 0x79, 0xad, 0x9c, 0x3b, 0xfb, 0xf9, 0xd6, 0xfa, 0x4c,
+	0xd9, 0x04, 0x67, 0x56, 0x04, 0x2b, 0xf5, 0xd5, 0xfe, 0x6a, 0x37, 0xd5, 0x54, 0x79, 0xe8, 0x80,
+	0xc9, 0xf7, 0x50, 0x48, 0x02, 0x24, 0x19, 0x7f, 0x9b, 0x08, 0xc9, 0x38, 0xf1, 0x51, 0x68, 0x82,
+	0x26, 0xe0, 0x17, 0x0e, 0x38, 0xe9, 0x27, 0x51, 0x12, 0x22, 0x49, 0x3a, 0xd8, 0x26, 0xc8, 0xe3,
+	0x48, 0x12, 0x56, 0x74, 0xca, 0x43, 0xd5, 0x91, 0xf3, 0xa7, 0x6d, 0x2f, 0xd5, 0x54, 0x86, 0xd3,
+	0x9e, 0x50, 0xd9, 0x68, 0x30, 0x42, 0x4d, 0x12, 0x7f, 0xfc, 0x7d, 0xfa, 0xa5, 0x16, 0x91, 0xed,
+	0xa4, 0x59, 0xf3, 0x59, 0x64, 0x6b, 0xbd, 0xde, 0x43, 0x4d, 0xae, 0xc5, 0x58, 0xa4, 0x77, 0x84,
+	0xa9, 0xcb, 0xf1, 0x2e, 0xac, 0x21, 0xe3, 0x2a, 0x50, 0xf8, 0x02, 0x38, 0xc6, 0xf1, 0x32, 0xe6,
+	0x98, 0xfa, 0xd8, 0xf3, 0x59, 0x42, 0xa5, 0xae, 0xcd, 0x31, 0xf7, 0x68, 0x26, 0x6e, 0x28, 0x69,
+	0xe5, 0x07, 0x07, 0x9c, 0xcc, 0x1c, 0x6b, 0x24, 0x9c, 0x63, 0x2a, 0x53, 0xaf, 0x62, 0x30, 0x6c,
+	0x3c, 0x11, 0x07, 0xec, 0x44, 0x0a, 0x03, 0x27, 0x40, 0x3e, 0xc6, 0x9c, 0x30, 0xd3, 0x49, 0x39,
+	0xd7, 0x7e, 0x55, 0xbe, 0x71, 0x40, 0x29, 0x63, 0x79, 0xd1, 0xb7, 0x3e, 0xe3, 0xa0, 0xc1, 0xa2,
+	0x88, 0x08, 0x41, 0x18, 0x85, 0x1d, 0x00, 0xfc, 0xec, 0xeb, 0x80, 0xf9, 0xf6, 0x20, 0x55, 0xbe,
+	0x74, 0xc0, 0x54, 0x46, 0xed, 0x9d, 0x44, 0x0a, 0x89, 0x68, 0x40, 0x68, 0xeb, 0x7f, 0x0b, 0xa2,
+	0x62, 0x74, 0x22, 0x63, 0xb4, 0x14, 0x22, 0xd1, 0xbe, 0xd4, 0xc1, 0x54, 0xc2, 0x17, 0xc1, 0x33,
+	0x9d, 0x54, 0xec, 0xd9, 0x30, 0x3b, 0x3a, 0xcc, 0xc7, 0x32, 0xf9, 0xa2, 0x16, 0xc3, 0x6b, 0xe0,
+	0xf0, 0x32, 0x47, 0xbe, 0xea, 0x00, 0xfb, 0xa6, 0x9d, 0xfb, 0xd7, 0xcf, 0x8c, 0x9b, 0x99, 0xa8,
+	0x7c, 0xe6, 0x80, 0xc2, 0x2e, 0x8c, 0x04, 0xfc, 0x08, 0x4c, 0x74, 0x29, 0x09, 0xa5, 0xf0, 0xb0,
+	0xd6, 0xd8, 0x58, 0xbd, 0x52, 0x7b, 0xca, 0x44, 0xa9, 0xed, 0x62, 0x72, 0xfe, 0x88, 0xe2, 0x69,
+	0x02, 0x52, 0xe8, 0xec, 0x02, 0x59, 0xf9, 0x64, 0x10, 0x0c, 0x5f, 0xc6, 0x78, 0x91, 0xb1, 0x10,
+	0x7e, 0x0c, 0x8e, 0x76, 0x67, 0x44, 0xcc, 0x58, 0xb8, 0xa7, 0x14, 0xcd, 0xed, 0x37, 0x45, 0x45,
+	0xc7, 0xed, 0xce, 0x24, 0x4d, 0x40, 0x82, 0xd1, 0x00, 0xfb, 0x24, 0x42, 0xa1, 0x81, 0x1f, 0xdc,
+	0x03, 0xfc, 0x85, 0x7d, 0xc0, 0xbb, 0x23, 0x16, 0x46, 0xa1, 0x56, 0xbe, 0x1e, 0x04, 0x93, 0x8d,
+	0x5e, 0x1e, 0x4b, 0x31, 0xa6, 0x81, 0x19, 0x04, 0x28, 0x84, 0x05, 0x70, 0x48, 0x12, 0x19, 0x62,
+	0x33, 0x31, 0x5d, 0xf3, 0x01, 0xcb, 0x60, 0x24, 0xc0, 0xc2, 0xe7, 0x24, 0xee, 0x56, 0x85, 0xdb,
+	0x2b, 0x82, 0xa7, 0xc1, 0x11, 0x8e, 0x7d, 0x12, 0x13, 0x4c, 0xa5, 0x19, 0x4e, 0x6e, 0x57, 0x00,
+	0xd7, 0x40, 0x1e, 0x45, 0xfa, 0x21, 0xca, 0x69, 0x27, 0x4f, 0xed, 0xea, 0xa4, 0xf6, 0xf0, 0xb2,
+	0xf5, 0xb0, 0xba, 0x07, 0x0f, 0xb5, 0x7b, 0xdf, 0x6e, 0xad, 0xcf, 0x8c, 0x86, 0xba, 0x0c, 0x3d,
+	0xbf, 0xdb, 0x11, 0x16, 0x70, 0xae, 0x7a, 0xfb, 0xce, 0xf4, 0xc0, 0x5f, 0x77, 0xa6, 0x07, 0x7e,
+	0xda, 0x98, 0x9d, 0xb4, 0xa8, 0x2d, 0xd6, 0xe9, 0x01, 0xa5, 0x52, 0x71, 0x76, 0x2a, 0x3f, 0x3b,
+	0x60, 0x7c, 0x01, 0x2b, 0x4b, 0xaa, 0x6a, 0x24, 0xe2, 0x92, 0xd0, 0xd6, 0x15, 0xba, 0xac, 0x1f,
+	0xd4, 0x98, 0xe3, 0x0e, 0x61, 0x6a, 0x10, 0xf7, 0xf6, 0xce, 0xd1, 0x54, 0x6c, 0x5b, 0xe7, 0x2a,
+	0x38, 0x24, 0x24, 0x5a, 0xc1, 0xb6, 0x6f, 0xf6, 0xbb, 0x6f, 0x18, 0x23, 0x70, 0x01, 0xe4, 0xdb,
+	0x98, 0xb4, 0xda, 0x26, 0xa0, 0xb9, 0xf9, 0x97, 0xff, 0x7e, 0x34, 0x7d, 0xcc, 0xe7, 0x58, 0x3d,
+	0xf2, 0xd4, 0x33, 0xaa, 0xef, 0xb7, 0xd6, 0x67, 0xb6, 0xcb, 0x6c, 0x00, 0xcc, 0x47, 0xe5, 0x4f,
+	0x07, 0x9c, 0xb2, 0x6e, 0x11, 0x46, 0x33, 0x07, 0xed, 0xc8, 0xbf, 0x0e, 0x8e, 0x77, 0x9b, 0x50,
+	0xcd, 0x7c, 0x2c, 0x84, 0xdd, 0x96, 0xce, 0x3c, 0xd8, 0x98, 0x7d, 0xd6, 0x52, 0xeb, 0xbe, 0xbf,
+	0xe6, 0xc8, 0x92, 0xe4, 0xea, 0x99, 0xeb, 0xbe, 0x29, 0x56, 0x0e, 0x29, 0xc8, 0x67, 0xeb, 0xd0,
+	0x41, 0x3e, 0x78, 0x16, 0x65, 0x2e, 0xa7, 0xd2, 0x5b, 0xf9, 0xc5, 0x01, 0x67, 0x9f, 0x5c, 0xd4,
+	0x37, 0x88, 0x6c, 0x2f, 0xe0, 0x98, 0x09, 0x22, 0x0f, 0xa8, 0xbe, 0x27, 0x7a, 0xea, 0x5b, 0xa9,
+	0xec, 0x17, 0x2c, 0x82, 0xe1, 0xc0, 0x00, 0xdb, 0x2d, 0x28, 0xfd, 0x9c, 0x7b, 0xfe, 0xf6, 0x5e,
+	0x4a, 0xf2, 0xd3, 0x41, 0x50, 0xb8, 0xd8, 0xb3, 0xba, 0x2c, 0x72, 0xd6, 0xd2, 0x61, 0x3e, 0x03,
+	0x46, 0xcd, 0x9e, 0x45, 0x93, 0xa8, 0x89, 0xb9, 0xf6, 0x66, 0xc8, 0x1d, 0xd1, 0xb2, 0xeb, 0x5a,
+	0x04, 0xaf, 0x83, 0x09, 0x8a, 0x57, 0xa5, 0x17, 0xa4, 0x19, 0xcf, 0xd2, 0x6b, 0x8a, 0xb3, 0xf8,
+	0x60, 0x63, 0xb6, 0x60, 0xd1, 0xfb, 0xb3, 0x5a, 0x50, 0xf7, 0xb2, 0x42, 0x49, 0x33, 0x7b, 0xc3,
+	0xda, 0xdb, 0x59, 0x2e, 0x43, 0x7b, 0x2d, 0x17, 0x6d, 0x78, 0xbb, 0x0e, 0x3e, 0x07, 0xc6, 0xd4,
+	0xc2, 0xc6, 0x71, 0x84, 0x08, 0x25, 0xb4, 0xa5, 0x63, 0x98, 0x73, 0x47, 0x5b, 0x48, 0xb8, 0xa9,
+	0xac, 0xf2, 0x9d, 0x03, 0x8e, 0xdf, 0xb0, 0xeb, 0xa7, 0x9b, 0xc5, 0xfd, 0x3c, 0x18, 0xee, 0xaf,
+	0xd9, 0x27, 0x3b, 0x95, 0x1e, 0x84, 0xee, 0x8e, 0xf1, 0xb6, 0xdf, 0x36, 0xed, 0xce, 0xb8, 0x9b,
+	0x60, 0x2c, 0x25, 0xb7, 0x14, 0x87, 0x44, 0xc2, 0xf7, 0x01, 0xc8, 0xaa, 0x23, 0x9d, 0x67, 0xb5,
+	0xa7, 0xce, 0xb3, 0x1d, 0xce, 0xf5, 0x4e, 0xb3, 0x1e, 0x63, 0xf3, 0x6f, 0xde, 0xdd, 0x2c, 0x39,
+	0xf7, 0x36, 0x4b, 0xce, 0xfd, 0xcd, 0x92, 0xf3, 0xc7, 0x66, 0xc9, 0xf9, 0xea, 0x71, 0x69, 0xe0,
+	0xfe, 0xe3, 0xd2, 0xc0, 0xaf, 0x8f, 0x4b, 0x03, 0x1f, 0x9c, 0xe9, 0xf3, 0x61, 0xdb, 0x3e, 0xab,
+	0x1b, 0xa9, 0x99, 0xd7, 0x3f, 0x97, 0x2e, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xac, 0xd5,
+	0xf5, 0xe1, 0x0d, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -757,6 +979,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundEpochIdentifier != that1.AutoCompoundEpochIdentifier {
+		return false
+	}
+	if this.AutoCompoundMaxPerBlock != that1.AutoCompoundMaxPerBlock {
+		return false
+	}
+	if this.AutoCompoundGasBudget != that1.AutoCompoundGasBudget {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1072,6 +1303,95 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *AutoCompoundProgress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoCompoundProgress)
+	if !ok {
+		that2, ok := that.(AutoCompoundProgress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EpochNumber != that1.EpochNumber {
+		return false
+	}
+	if this.NextDelegatorAddress != that1.NextDelegatorAddress {
+		return false
+	}
+	if this.NextValidatorAddress != that1.NextValidatorAddress {
+		return false
+	}
+	if this.GasRemaining != that1.GasRemaining {
+		return false
+	}
+	return true
+}
+func (this *WithdrawRecipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WithdrawRecipient)
+	if !ok {
+		that2, ok := that.(WithdrawRecipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Fraction.Equal(that1.Fraction) {
+		return false
+	}
+	return true
+}
+func (this *WithdrawSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WithdrawSplit)
+	if !ok {
+		that2, ok := that.(WithdrawSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Recipients) != len(that1.Recipients) {
+		return false
+	}
+	for i := range this.Recipients {
+		if !this.Recipients[i].Equal(&that1.Recipients[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1092,6 +1412,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundGasBudget != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundGasBudget))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AutoCompoundMaxPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundMaxPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AutoCompoundEpochIdentifier) > 0 {
+		i -= len(m.AutoCompoundEpochIdentifier)
+		copy(dAtA[i:], m.AutoCompoundEpochIdentifier)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.AutoCompoundEpochIdentifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1622,60 +1959,194 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDistribution(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AutoCompoundProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CommunityTax.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	l = m.BaseProposerReward.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	l = m.BonusProposerReward.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	if m.WithdrawAddrEnabled {
-		n += 2
-	}
-	return n
+
+func (m *AutoCompoundProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorHistoricalRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AutoCompoundProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CumulativeRewardRatio) > 0 {
-		for _, e := range m.CumulativeRewardRatio {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
-		}
+	if m.GasRemaining != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.GasRemaining))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ReferenceCount != 0 {
-		n += 1 + sovDistribution(uint64(m.ReferenceCount))
+	if len(m.NextValidatorAddress) > 0 {
+		i -= len(m.NextValidatorAddress)
+		copy(dAtA[i:], m.NextValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.NextValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.NextDelegatorAddress) > 0 {
+		i -= len(m.NextDelegatorAddress)
+		copy(dAtA[i:], m.NextDelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.NextDelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorCurrentRewards) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WithdrawRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BaseProposerReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BonusProposerReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.WithdrawAddrEnabled {
+		n += 2
+	}
+	l = len(m.AutoCompoundEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.AutoCompoundMaxPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundMaxPerBlock))
+	}
+	if m.AutoCompoundGasBudget != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundGasBudget))
+	}
+	return n
+}
+
+func (m *ValidatorHistoricalRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CumulativeRewardRatio) > 0 {
+		for _, e := range m.CumulativeRewardRatio {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.ReferenceCount != 0 {
+		n += 1 + sovDistribution(uint64(m.ReferenceCount))
+	}
+	return n
+}
+
+func (m *ValidatorCurrentRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
 		for _, e := range m.Rewards {
 			l = e.Size()
 			n += 1 + l + sovDistribution(uint64(l))
@@ -1859,6 +2330,59 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *AutoCompoundProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovDistribution(uint64(m.EpochNumber))
+	}
+	l = len(m.NextDelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.NextValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.GasRemaining != 0 {
+		n += 1 + sovDistribution(uint64(m.GasRemaining))
+	}
+	return n
+}
+
+func (m *WithdrawRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *WithdrawSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2016,6 +2540,76 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxPerBlock", wireType)
+			}
+			m.AutoCompoundMaxPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundMaxPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundGasBudget", wireType)
+			}
+			m.AutoCompoundGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3344,6 +3938,358 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCompoundProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextDelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRemaining", wireType)
+			}
+			m.GasRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, WithdrawRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,7 +9,18 @@ const (
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
 
+	EventTypeWithdrawTokenizeShareReward = "withdraw_tokenize_share_reward"
+
+	EventTypeSetAutoCompound = "set_auto_compound"
+	EventTypeAutoCompound    = "auto_compound"
+
+	EventTypeSetWithdrawSplit = "set_withdraw_split"
+	EventTypeWithdrawSplit    = "withdraw_split"
+
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyRecordID        = "record_id"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyFraction        = "fraction"
 )
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
@@ -30,6 +32,8 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundDelegations:         []AutoCompoundDelegation{},
+		DelegatorWithdrawSplits:         []DelegatorWithdrawSplitRecord{},
 	}
 }
 
@@ -38,5 +42,11 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, record := range gs.DelegatorWithdrawSplits {
+		split := WithdrawSplit{Recipients: record.Recipients}
+		if err := split.Validate(); err != nil {
+			return fmt.Errorf("invalid withdraw split of %s: %w", record.DelegatorAddress, err)
+		}
+	}
 	return gs.FeePool.ValidateGenesis()
 }
@@ -13,6 +13,9 @@ var (
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgFundCommunityPool)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgWithdrawTokenizeShareRecordReward)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
+	_ sdk.Msg = (*MsgSetWithdrawSplit)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr string) *MsgSetWithdrawAddress {
@@ -53,3 +56,31 @@ func NewMsgDepositValidatorRewardsPool(depositor, valAddr string, amount sdk.Coi
 		ValidatorAddress: valAddr,
 	}
 }
+
+// NewMsgWithdrawTokenizeShareRecordReward returns a new MsgWithdrawTokenizeShareRecordReward
+// with the owner of a tokenize share record and the id of the record.
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr string, recordID uint64) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr,
+		RecordId:     recordID,
+	}
+}
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound enabling or disabling
+// the auto-compounding of the rewards of a delegation.
+func NewMsgSetAutoCompound(delAddr, valAddr string, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Enabled:          enabled,
+	}
+}
+
+// NewMsgSetWithdrawSplit returns a new MsgSetWithdrawSplit splitting the withdrawals
+// of a delegator between recipients, or removing its split if there are none.
+func NewMsgSetWithdrawSplit(delAddr string, recipients []WithdrawRecipient) *MsgSetWithdrawSplit {
+	return &MsgSetWithdrawSplit{
+		DelegatorAddress: delAddr,
+		Recipients:       recipients,
+	}
+}
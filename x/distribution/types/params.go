@@ -3,23 +3,43 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"cosmossdk.io/math"
 )
 
+const (
+	// DefaultAutoCompoundMaxPerBlock is the default maximum number of delegations
+	// compounded per block.
+	DefaultAutoCompoundMaxPerBlock = uint32(50)
+	// DefaultAutoCompoundGasBudget is the default gas budget of the compounding of an epoch.
+	DefaultAutoCompoundGasBudget = uint64(100_000_000)
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        math.LegacyNewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
-		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:            math.LegacyNewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:      math.LegacyZeroDec(),            // deprecated
+		BonusProposerReward:     math.LegacyZeroDec(),            // deprecated
+		WithdrawAddrEnabled:     true,
+		AutoCompoundMaxPerBlock: DefaultAutoCompoundMaxPerBlock,
+		AutoCompoundGasBudget:   DefaultAutoCompoundGasBudget,
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	return validateAutoCompound(p.AutoCompoundEpochIdentifier, p.AutoCompoundMaxPerBlock, p.AutoCompoundGasBudget)
+}
+
+// AutoCompoundEnabled returns true if the rewards of the auto-compounded delegations are compounded.
+func (p Params) AutoCompoundEnabled() bool {
+	return p.AutoCompoundEpochIdentifier != ""
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -40,3 +60,21 @@ func validateCommunityTax(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoCompound(epochIdentifier string, maxPerBlock uint32, gasBudget uint64) error {
+	if epochIdentifier == "" {
+		return nil
+	}
+
+	if strings.TrimSpace(epochIdentifier) != epochIdentifier {
+		return fmt.Errorf("auto-compound epoch identifier cannot have leading or trailing spaces: %q", epochIdentifier)
+	}
+	if maxPerBlock == 0 {
+		return errors.New("auto-compound max per block must be positive when auto-compounding is enabled")
+	}
+	if gasBudget == 0 {
+		return errors.New("auto-compound gas budget must be positive when auto-compounding is enabled")
+	}
+
+	return nil
+}
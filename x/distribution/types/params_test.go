@@ -47,3 +47,30 @@ func TestParams_ValidateBasic(t *testing.T) {
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
+
+func TestParams_ValidateBasicAutoCompound(t *testing.T) {
+	tests := []struct {
+		name            string
+		epochIdentifier string
+		maxPerBlock     uint32
+		gasBudget       uint64
+		wantErr         bool
+	}{
+		{"disabled", "", 0, 0, false},
+		{"enabled", "day", 10, 1_000_000, false},
+		{"padded epoch identifier", " day", 10, 1_000_000, true},
+		{"zero max per block", "day", 0, 1_000_000, true},
+		{"zero gas budget", "day", 10, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := types.DefaultParams()
+			p.AutoCompoundEpochIdentifier = tt.epochIdentifier
+			p.AutoCompoundMaxPerBlock = tt.maxPerBlock
+			p.AutoCompoundGasBudget = tt.gasBudget
+			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
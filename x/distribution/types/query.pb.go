@@ -867,6 +867,252 @@ func (m *QueryDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryDelegatorWithdrawAddressResponse proto.InternalMessageInfo
 
+// QueryDelegatorWithdrawSplitRequest is the request type for the
+// Query/DelegatorWithdrawSplit RPC method.
+type QueryDelegatorWithdrawSplitRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorWithdrawSplitRequest) Reset()         { *m = QueryDelegatorWithdrawSplitRequest{} }
+func (m *QueryDelegatorWithdrawSplitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorWithdrawSplitRequest) ProtoMessage()    {}
+func (*QueryDelegatorWithdrawSplitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryDelegatorWithdrawSplitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorWithdrawSplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorWithdrawSplitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorWithdrawSplitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorWithdrawSplitRequest.Merge(m, src)
+}
+func (m *QueryDelegatorWithdrawSplitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorWithdrawSplitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorWithdrawSplitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorWithdrawSplitRequest proto.InternalMessageInfo
+
+// QueryDelegatorWithdrawSplitResponse is the response type for the
+// Query/DelegatorWithdrawSplit RPC method.
+type QueryDelegatorWithdrawSplitResponse struct {
+	// recipients defines the recipients of the withdrawals of the delegator. It
+	// is empty if the delegator has no withdraw split.
+	Recipients []WithdrawRecipient `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *QueryDelegatorWithdrawSplitResponse) Reset()         { *m = QueryDelegatorWithdrawSplitResponse{} }
+func (m *QueryDelegatorWithdrawSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorWithdrawSplitResponse) ProtoMessage()    {}
+func (*QueryDelegatorWithdrawSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryDelegatorWithdrawSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorWithdrawSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorWithdrawSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorWithdrawSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorWithdrawSplitResponse.Merge(m, src)
+}
+func (m *QueryDelegatorWithdrawSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorWithdrawSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorWithdrawSplitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorWithdrawSplitResponse proto.InternalMessageInfo
+
+// QueryDelegatorAutoCompoundRequest is the request type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) Reset()         { *m = QueryDelegatorAutoCompoundRequest{} }
+func (m *QueryDelegatorAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundRequest) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundRequest proto.InternalMessageInfo
+
+// QueryDelegatorAutoCompoundResponse is the response type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundResponse struct {
+	// validators defines the validators of the auto-compounded delegations.
+	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Reset()         { *m = QueryDelegatorAutoCompoundResponse{} }
+func (m *QueryDelegatorAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundResponse) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundResponse proto.InternalMessageInfo
+
+// QueryAutoCompoundProgressRequest is the request type for the
+// Query/AutoCompoundProgress RPC method.
+type QueryAutoCompoundProgressRequest struct {
+}
+
+func (m *QueryAutoCompoundProgressRequest) Reset()         { *m = QueryAutoCompoundProgressRequest{} }
+func (m *QueryAutoCompoundProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundProgressRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{22}
+}
+func (m *QueryAutoCompoundProgressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundProgressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundProgressRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundProgressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundProgressRequest proto.InternalMessageInfo
+
+// QueryAutoCompoundProgressResponse is the response type for the
+// Query/AutoCompoundProgress RPC method.
+type QueryAutoCompoundProgressResponse struct {
+	// progress defines the compounding in progress, if any.
+	Progress *AutoCompoundProgress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+}
+
+func (m *QueryAutoCompoundProgressResponse) Reset()         { *m = QueryAutoCompoundProgressResponse{} }
+func (m *QueryAutoCompoundProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundProgressResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{23}
+}
+func (m *QueryAutoCompoundProgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundProgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundProgressResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundProgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundProgressResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundProgressResponse) GetProgress() *AutoCompoundProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
 // QueryCommunityPoolRequest is the request type for the Query/CommunityPool RPC
 // method.
 //
@@ -880,7 +1126,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+	return fileDescriptor_5efd02cbc06efdc9, []int{24}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1170,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+	return fileDescriptor_5efd02cbc06efdc9, []int{25}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -979,6 +1225,12 @@ func init() {
 	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsResponse")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryDelegatorWithdrawSplitRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawSplitRequest")
+	proto.RegisterType((*QueryDelegatorWithdrawSplitResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawSplitResponse")
+	proto.RegisterType((*QueryDelegatorAutoCompoundRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundRequest")
+	proto.RegisterType((*QueryDelegatorAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundResponse")
+	proto.RegisterType((*QueryAutoCompoundProgressRequest)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundProgressRequest")
+	proto.RegisterType((*QueryAutoCompoundProgressResponse)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundProgressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
 }
@@ -988,89 +1240,102 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x06, 0xe4, 0x6c, 0x53, 0x27, 0xdd,
-	0xd0, 0x26, 0x6a, 0x14, 0x6f, 0x9a, 0x8a, 0x52, 0x12, 0x2a, 0x88, 0x9d, 0x84, 0x56, 0x44, 0x69,
-	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x9c, 0xa5, 0xf6, 0x8e, 0xb3, 0xb3, 0x8e, 0x89,
-	0xaa, 0x5e, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x0d, 0x8e, 0x88, 0x13, 0x87, 0xdc, 0xe1, 0x58,
-	0xc1, 0xa5, 0x0a, 0x12, 0x42, 0x1c, 0x00, 0x25, 0x48, 0x94, 0x03, 0x88, 0x1b, 0x57, 0xe4, 0x99,
-	0x59, 0x7b, 0xd7, 0x5e, 0xaf, 0xff, 0xc9, 0x97, 0xc4, 0x9a, 0x9d, 0xf7, 0x7d, 0xef, 0x7b, 0xef,
-	0xcd, 0xec, 0x67, 0xc3, 0x74, 0x86, 0xd0, 0x3c, 0xa1, 0x8a, 0x66, 0x50, 0xdb, 0x32, 0xd2, 0x45,
-	0xdb, 0x20, 0xa6, 0xb2, 0x7b, 0x31, 0xad, 0xdb, 0xea, 0x45, 0x65, 0xa7, 0xa8, 0x5b, 0x7b, 0xb1,
-	0x82, 0x45, 0x6c, 0x82, 0x4f, 0xf3, 0x8d, 0x31, 0xf7, 0xc6, 0x98, 0xd8, 0x28, 0x5d, 0x10, 0x28,
-	0x69, 0x95, 0xea, 0x3c, 0xaa, 0x82, 0x51, 0x50, 0xb3, 0x86, 0xa9, 0xb2, 0xdd, 0x0c, 0x48, 0x1a,
-	0xcd, 0x92, 0x2c, 0x61, 0x1f, 0x95, 0xf2, 0x27, 0xb1, 0x3a, 0x9e, 0x25, 0x24, 0x9b, 0xd3, 0x15,
-	0xb5, 0x60, 0x28, 0xaa, 0x69, 0x12, 0x9b, 0x85, 0x50, 0xf1, 0x34, 0xea, 0xc6, 0x77, 0x90, 0x33,
-	0xc4, 0x70, 0x30, 0x63, 0x41, 0x2a, 0x3c, 0x19, 0xf3, 0xfd, 0x63, 0x7c, 0x7f, 0x8a, 0xa7, 0x21,
-	0x94, 0xf1, 0x47, 0x61, 0x35, 0x6f, 0x98, 0x44, 0x61, 0x7f, 0xf9, 0x92, 0x3c, 0x0a, 0xf8, 0x56,
-	0x59, 0xd3, 0x4d, 0xd5, 0x52, 0xf3, 0x34, 0xa9, 0xef, 0x14, 0x75, 0x6a, 0xcb, 0x77, 0xe1, 0x94,
-	0x67, 0x95, 0x16, 0x88, 0x49, 0x75, 0xbc, 0x06, 0x83, 0x05, 0xb6, 0x12, 0x41, 0x93, 0x68, 0xe6,
-	0xc4, 0xc2, 0x54, 0x2c, 0xa0, 0x70, 0x31, 0x1e, 0x1c, 0x1f, 0x7a, 0xf2, 0xcb, 0x44, 0xdf, 0x97,
-	0x7f, 0x7c, 0x7d, 0x01, 0x25, 0x45, 0xb4, 0x5c, 0x82, 0x73, 0x0c, 0xfe, 0x6d, 0x35, 0x67, 0x68,
-	0xaa, 0x4d, 0xac, 0x15, 0x57, 0xfc, 0x75, 0x73, 0x8b, 0x88, 0x3c, 0xf0, 0x06, 0x84, 0x77, 0x9d,
-	0x3d, 0x29, 0x55, 0xd3, 0x2c, 0x9d, 0x72, 0xee, 0xa1, 0xf8, 0xd9, 0x83, 0xfd, 0xb9, 0x33, 0x82,
-	0xbe, 0x82, 0xb3, 0xcc, 0xb7, 0xdc, 0xb6, 0x2d, 0xc3, 0xcc, 0x26, 0x47, 0x76, 0x6b, 0xd6, 0xe5,
-	0xbf, 0x43, 0x70, 0xbe, 0x19, 0xb3, 0xd0, 0xba, 0x0e, 0x23, 0xa4, 0xa0, 0x5b, 0x9d, 0x31, 0x0f,
-	0x3b, 0xa1, 0x62, 0x19, 0x3f, 0x44, 0x10, 0xa6, 0x7a, 0x6e, 0x2b, 0x95, 0x26, 0xa6, 0x96, 0xb2,
-	0xf4, 0x92, 0x6a, 0x69, 0x34, 0x12, 0x9a, 0xec, 0x9f, 0x39, 0xb1, 0x30, 0xee, 0x54, 0xb1, 0x3c,
-	0x01, 0x95, 0xea, 0xad, 0xe8, 0x99, 0x04, 0x31, 0xcc, 0xf8, 0x95, 0x72, 0xf9, 0xbe, 0xfa, 0x75,
-	0x62, 0x36, 0x6b, 0xd8, 0xdb, 0xc5, 0x74, 0x2c, 0x43, 0xf2, 0xa2, 0xa9, 0xe2, 0xdf, 0x1c, 0xd5,
-	0xee, 0x29, 0xf6, 0x5e, 0x41, 0xa7, 0x4e, 0x0c, 0xe5, 0xd5, 0x1e, 0x2e, 0x13, 0xc6, 0x89, 0xa9,
-	0x25, 0x39, 0x1d, 0xde, 0x01, 0xc8, 0x90, 0x7c, 0xde, 0xa0, 0xd4, 0x20, 0x66, 0xa4, 0xbf, 0x05,
-	0xf2, 0x4b, 0x1d, 0x90, 0x27, 0x5d, 0x24, 0xf2, 0x1e, 0x4c, 0x7b, 0xeb, 0x7d, 0xa3, 0x68, 0x53,
-	0x5b, 0x35, 0xb5, 0x72, 0x95, 0x78, 0x5a, 0xbd, 0xea, 0xf5, 0x47, 0x08, 0x66, 0x9a, 0x73, 0x8b,
-	0x6e, 0xdf, 0x85, 0xe3, 0x4e, 0x53, 0xf8, 0x68, 0x5f, 0x09, 0x1c, 0xed, 0x00, 0x48, 0xf7, 0xbc,
-	0x3b, 0x98, 0xf2, 0x0e, 0x4c, 0x78, 0x53, 0x49, 0x54, 0x4a, 0xd4, 0x2b, 0xf9, 0x8f, 0x11, 0x4c,
-	0x36, 0xe6, 0x14, 0xb2, 0xb7, 0x3c, 0x13, 0xc1, 0x95, 0x2f, 0xb5, 0xa6, 0x7c, 0x39, 0x93, 0x29,
-	0xe6, 0x8b, 0x39, 0xd5, 0xd6, 0xb5, 0x2a, 0xb0, 0x5b, 0xbc, 0x7b, 0x0c, 0x1e, 0x87, 0x60, 0xdc,
-	0x9b, 0xcc, 0xed, 0x9c, 0x4a, 0xb7, 0xf5, 0x5e, 0x35, 0x1f, 0x4f, 0xc3, 0x30, 0xb5, 0x55, 0xcb,
-	0x36, 0xcc, 0x6c, 0x6a, 0x5b, 0x37, 0xb2, 0xdb, 0x76, 0x24, 0x34, 0x89, 0x66, 0x06, 0x92, 0xcf,
-	0x39, 0xcb, 0xd7, 0xd8, 0x2a, 0x9e, 0x82, 0x93, 0x3a, 0x6b, 0x9f, 0xb3, 0xad, 0x9f, 0x6d, 0xfb,
-	0x3f, 0x5f, 0x14, 0x9b, 0xd6, 0x00, 0xaa, 0x57, 0x7d, 0x64, 0x80, 0x95, 0xe9, 0xbc, 0xe7, 0xe0,
-	0xf0, 0xb7, 0x49, 0xf5, 0xe6, 0xcb, 0xea, 0x42, 0x59, 0xd2, 0x15, 0xb9, 0x38, 0xf0, 0xe8, 0x8b,
-	0x89, 0x3e, 0xf9, 0x1b, 0x04, 0x67, 0x1a, 0x14, 0x43, 0xb4, 0xe5, 0x2d, 0x38, 0x4e, 0xf9, 0x52,
-	0x04, 0xb1, 0x53, 0x3a, 0xdf, 0x5a, 0x4f, 0x18, 0xce, 0xea, 0xae, 0x6e, 0xda, 0x9e, 0x29, 0x14,
-	0x58, 0xf8, 0x0d, 0x8f, 0x8c, 0x10, 0x93, 0x31, 0xdd, 0x54, 0x06, 0xcf, 0xc9, 0xad, 0x43, 0xfe,
-	0xd6, 0x51, 0xb0, 0xa2, 0xe7, 0xf4, 0x2c, 0x5b, 0xab, 0x39, 0xcc, 0xab, 0x10, 0xd6, 0xf8, 0xb3,
-	0xba, 0x7e, 0x46, 0x0e, 0xf6, 0xe7, 0x46, 0x05, 0x69, 0x4d, 0x1b, 0x2b, 0x21, 0x4e, 0x1b, 0x7d,
-	0xc7, 0x22, 0xd4, 0xf1, 0x58, 0x2c, 0xfe, 0xaf, 0xdc, 0x80, 0x67, 0xe5, 0x26, 0x7c, 0x82, 0x20,
-	0xda, 0x48, 0x82, 0xe8, 0x42, 0xc1, 0x7d, 0x27, 0xf4, 0xf2, 0xa2, 0xae, 0x5c, 0x13, 0x45, 0x90,
-	0x6b, 0x72, 0xba, 0x43, 0x6c, 0x35, 0xd7, 0x93, 0xda, 0xba, 0x6a, 0xf1, 0x0f, 0x82, 0xa9, 0x40,
-	0x5e, 0x51, 0x90, 0xf7, 0x6a, 0x0b, 0x72, 0x39, 0x70, 0x2c, 0xab, 0x68, 0x2b, 0x0e, 0x37, 0x47,
-	0xf4, 0xbb, 0x22, 0x71, 0x0e, 0x8e, 0xd9, 0x65, 0xd2, 0x1e, 0xbf, 0x14, 0x39, 0x89, 0x6c, 0x89,
-	0x0b, 0xb9, 0x92, 0x59, 0x65, 0x84, 0x7a, 0x57, 0xe6, 0x75, 0x71, 0x21, 0xfb, 0x72, 0x8a, 0x12,
-	0x47, 0x01, 0x2a, 0x43, 0xcb, 0xab, 0x3c, 0x94, 0x74, 0xad, 0xb8, 0xd0, 0x4a, 0xf0, 0xa2, 0x17,
-	0x6d, 0xd3, 0xb0, 0xb7, 0x35, 0x4b, 0x2d, 0x09, 0xe2, 0x9e, 0xc9, 0xd8, 0x15, 0xe6, 0xad, 0x31,
-	0xb1, 0xd0, 0x92, 0x80, 0x91, 0x92, 0x78, 0xd4, 0x32, 0xf1, 0x70, 0xc9, 0x0b, 0xe6, 0xe2, 0x5d,
-	0x80, 0x31, 0xc6, 0x5b, 0x7e, 0xdb, 0x14, 0x4d, 0xc3, 0xde, 0xbb, 0x49, 0x48, 0x4e, 0xa8, 0x5c,
-	0x7c, 0xfe, 0x60, 0x7f, 0x6e, 0xb8, 0xda, 0xfa, 0xc9, 0xf9, 0xd8, 0x4b, 0xf3, 0x11, 0x24, 0x7f,
-	0x8e, 0x40, 0xf2, 0x0b, 0x12, 0x19, 0xbe, 0x0f, 0x03, 0x05, 0x42, 0x72, 0x3d, 0x3e, 0xde, 0x8c,
-	0xa3, 0x41, 0x86, 0x0b, 0x3f, 0x87, 0xe1, 0x18, 0xcb, 0x10, 0x7f, 0x86, 0x60, 0x90, 0x5b, 0x66,
-	0xac, 0x04, 0x9e, 0xab, 0x7a, 0xbf, 0x2e, 0xcd, 0xb7, 0x1e, 0xc0, 0xa5, 0xcb, 0xb3, 0x0f, 0x7f,
-	0xf8, 0xfd, 0xd3, 0xd0, 0x39, 0x3c, 0xa5, 0x04, 0x7d, 0xbd, 0xe0, 0x7e, 0x1d, 0xff, 0x89, 0x60,
-	0xac, 0xa1, 0x63, 0xc6, 0xf1, 0xe6, 0xe4, 0xcd, 0x8c, 0xbe, 0x94, 0xe8, 0x0a, 0x43, 0x68, 0x4a,
-	0x30, 0x4d, 0x57, 0xf1, 0x52, 0xa0, 0xa6, 0xea, 0x69, 0x52, 0xee, 0xd7, 0xbd, 0x5c, 0x1e, 0xe0,
-	0x0f, 0x43, 0x70, 0x3a, 0xc0, 0xde, 0xe1, 0x95, 0x36, 0x32, 0x6d, 0x68, 0x76, 0xa5, 0xd5, 0x2e,
-	0x51, 0x84, 0xe2, 0x4d, 0xa6, 0xf8, 0x16, 0xbe, 0xd1, 0x85, 0x62, 0x85, 0x54, 0xf1, 0x9d, 0x6f,
-	0x26, 0xf8, 0x10, 0xc1, 0x29, 0x1f, 0xe3, 0x88, 0x5f, 0x6d, 0x23, 0xef, 0x3a, 0x8f, 0x2b, 0x5d,
-	0xed, 0x30, 0x5a, 0xa8, 0xdd, 0x60, 0x6a, 0xaf, 0xe1, 0xb5, 0x6e, 0xd4, 0x56, 0x5d, 0x29, 0xfe,
-	0x11, 0xc1, 0x48, 0xad, 0x07, 0xc3, 0xaf, 0xb4, 0x91, 0xa3, 0xd7, 0xc4, 0x4a, 0x8b, 0x9d, 0x84,
-	0x0a, 0x6d, 0x6f, 0x32, 0x6d, 0xab, 0x38, 0xd1, 0x8d, 0x36, 0xc7, 0xe8, 0xfd, 0x85, 0x20, 0x5c,
-	0xe7, 0x6b, 0x70, 0x0b, 0xe9, 0x35, 0xf2, 0x73, 0xd2, 0x52, 0x47, 0xb1, 0x42, 0x5b, 0x8a, 0x69,
-	0x7b, 0x07, 0x6f, 0x06, 0x6a, 0xab, 0xbc, 0x72, 0xa8, 0x72, 0xbf, 0xee, 0x8d, 0xf5, 0x40, 0x11,
-	0x93, 0xe9, 0x7b, 0x66, 0x9f, 0x21, 0x78, 0xc1, 0xdf, 0xbb, 0xe0, 0xd7, 0xda, 0x49, 0xdc, 0xc7,
-	0x6d, 0x49, 0xaf, 0x77, 0x0e, 0xd0, 0x56, 0x6b, 0x5b, 0x93, 0xcf, 0x0e, 0xa6, 0x8f, 0x81, 0x68,
-	0xe5, 0x60, 0x36, 0xf6, 0x3a, 0xad, 0x1c, 0xcc, 0x00, 0xd7, 0xd2, 0xe2, 0xc1, 0x6c, 0xa2, 0xb0,
-	0x3a, 0xdb, 0xf8, 0x5f, 0x04, 0x91, 0x46, 0xf6, 0x02, 0x2f, 0xb7, 0x91, 0xab, 0xbf, 0x27, 0x92,
-	0xe2, 0xdd, 0x40, 0x08, 0xcd, 0x77, 0x98, 0xe6, 0x0d, 0xbc, 0xde, 0x8d, 0xe6, 0x5a, 0x7f, 0x84,
-	0xbf, 0x47, 0x70, 0xd2, 0xe3, 0x55, 0xf0, 0xe5, 0xe6, 0xb9, 0xfa, 0x39, 0x22, 0xe9, 0xe5, 0xb6,
-	0xe3, 0x84, 0xb0, 0xeb, 0xdf, 0xd5, 0x1b, 0x15, 0xa6, 0x75, 0x0e, 0xcf, 0x06, 0x6a, 0xcd, 0x38,
-	0x70, 0xa9, 0xb2, 0xe1, 0x79, 0x14, 0x42, 0xf1, 0xa5, 0x27, 0x87, 0x51, 0xf4, 0xf4, 0x30, 0x8a,
-	0x7e, 0x3b, 0x8c, 0xa2, 0x8f, 0x8f, 0xa2, 0x7d, 0x4f, 0x8f, 0xa2, 0x7d, 0x3f, 0x1d, 0x45, 0xfb,
-	0xde, 0x3d, 0xcb, 0x81, 0xa8, 0x76, 0x2f, 0x66, 0x10, 0xe5, 0x03, 0x2f, 0x20, 0x33, 0x51, 0xe9,
-	0x41, 0xf6, 0x03, 0xe5, 0xa5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x54, 0xf1, 0x6b, 0xc6,
-	0x15, 0x00, 0x00,
+	// 1512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4d, 0x6c, 0x1b, 0xc5,
+	0x17, 0xcf, 0xb8, 0xe9, 0xd7, 0xeb, 0xbf, 0xff, 0x24, 0xd3, 0x14, 0xdc, 0x6d, 0xeb, 0xa4, 0x1b,
+	0xda, 0x44, 0xad, 0xe2, 0x4d, 0x53, 0xb5, 0x94, 0x84, 0xb6, 0xc4, 0x4e, 0x4a, 0x4b, 0x43, 0x3f,
+	0xdc, 0x42, 0x55, 0x50, 0x65, 0x6d, 0xbc, 0x5b, 0x67, 0xa9, 0xbd, 0xb3, 0xd9, 0x1d, 0xc7, 0x44,
+	0x55, 0x2f, 0xe5, 0x52, 0x7a, 0x01, 0xc1, 0x85, 0x1b, 0x1c, 0x11, 0x27, 0x0e, 0xbd, 0xc3, 0xb1,
+	0x82, 0x4b, 0x15, 0x24, 0xc4, 0x09, 0x50, 0x82, 0x44, 0x11, 0xe2, 0xe3, 0xc6, 0x15, 0x79, 0x66,
+	0xd6, 0xde, 0xb5, 0xd7, 0xeb, 0xb5, 0x8d, 0x2f, 0xad, 0x35, 0x3b, 0xef, 0xf7, 0x7b, 0xbf, 0xf7,
+	0xde, 0xcc, 0xbc, 0xa7, 0xc0, 0x78, 0x8e, 0x38, 0x45, 0xe2, 0x28, 0x9a, 0xe1, 0x50, 0xdb, 0x58,
+	0x2a, 0x51, 0x83, 0x98, 0xca, 0xea, 0xf1, 0x25, 0x9d, 0xaa, 0xc7, 0x95, 0x95, 0x92, 0x6e, 0xaf,
+	0x25, 0x2d, 0x9b, 0x50, 0x82, 0xf7, 0xf3, 0x8d, 0x49, 0xef, 0xc6, 0xa4, 0xd8, 0x28, 0x1d, 0x15,
+	0x28, 0x4b, 0xaa, 0xa3, 0x73, 0xab, 0x2a, 0x86, 0xa5, 0xe6, 0x0d, 0x53, 0x65, 0xbb, 0x19, 0x90,
+	0x34, 0x9c, 0x27, 0x79, 0xc2, 0x7e, 0x2a, 0x95, 0x5f, 0x62, 0xf5, 0x40, 0x9e, 0x90, 0x7c, 0x41,
+	0x57, 0x54, 0xcb, 0x50, 0x54, 0xd3, 0x24, 0x94, 0x99, 0x38, 0xe2, 0x6b, 0xc2, 0x8b, 0xef, 0x22,
+	0xe7, 0x88, 0xe1, 0x62, 0x26, 0xc3, 0x54, 0xf8, 0x3c, 0xe6, 0xfb, 0xf7, 0xf1, 0xfd, 0x59, 0xee,
+	0x86, 0x50, 0xc6, 0x3f, 0x0d, 0xa9, 0x45, 0xc3, 0x24, 0x0a, 0xfb, 0x97, 0x2f, 0xc9, 0xc3, 0x80,
+	0xaf, 0x55, 0x34, 0x5d, 0x55, 0x6d, 0xb5, 0xe8, 0x64, 0xf4, 0x95, 0x92, 0xee, 0x50, 0xf9, 0x36,
+	0xec, 0xf1, 0xad, 0x3a, 0x16, 0x31, 0x1d, 0x1d, 0x9f, 0x87, 0x6d, 0x16, 0x5b, 0x89, 0xa3, 0x51,
+	0x34, 0xb1, 0x6b, 0x7a, 0x2c, 0x19, 0x12, 0xb8, 0x24, 0x37, 0x4e, 0xed, 0x7c, 0xf2, 0xe3, 0x48,
+	0xdf, 0xe7, 0xbf, 0x7e, 0x79, 0x14, 0x65, 0x84, 0xb5, 0x5c, 0x86, 0xc3, 0x0c, 0xfe, 0x4d, 0xb5,
+	0x60, 0x68, 0x2a, 0x25, 0xf6, 0xbc, 0xc7, 0xfe, 0xa2, 0x79, 0x87, 0x08, 0x3f, 0xf0, 0x65, 0x18,
+	0x5a, 0x75, 0xf7, 0x64, 0x55, 0x4d, 0xb3, 0x75, 0x87, 0x73, 0xef, 0x4c, 0x1d, 0x5a, 0x7f, 0x3c,
+	0x79, 0x50, 0xd0, 0x57, 0x71, 0xe6, 0xf8, 0x96, 0xeb, 0xd4, 0x36, 0xcc, 0x7c, 0x66, 0x70, 0xb5,
+	0x6e, 0x5d, 0xfe, 0x33, 0x06, 0x47, 0x5a, 0x31, 0x0b, 0xad, 0x8b, 0x30, 0x48, 0x2c, 0xdd, 0xee,
+	0x8c, 0x79, 0xc0, 0x35, 0x15, 0xcb, 0xf8, 0x01, 0x82, 0x21, 0x47, 0x2f, 0xdc, 0xc9, 0x2e, 0x11,
+	0x53, 0xcb, 0xda, 0x7a, 0x59, 0xb5, 0x35, 0x27, 0x1e, 0x1b, 0xdd, 0x32, 0xb1, 0x6b, 0xfa, 0x80,
+	0x1b, 0xc5, 0x4a, 0x05, 0x54, 0xa3, 0x37, 0xaf, 0xe7, 0xd2, 0xc4, 0x30, 0x53, 0xa7, 0x2b, 0xe1,
+	0xfb, 0xe2, 0xa7, 0x91, 0x63, 0x79, 0x83, 0x2e, 0x97, 0x96, 0x92, 0x39, 0x52, 0x14, 0x49, 0x15,
+	0xff, 0x4d, 0x3a, 0xda, 0x5d, 0x85, 0xae, 0x59, 0xba, 0xe3, 0xda, 0x38, 0x3c, 0xda, 0x03, 0x15,
+	0xc2, 0x14, 0x31, 0xb5, 0x0c, 0xa7, 0xc3, 0x2b, 0x00, 0x39, 0x52, 0x2c, 0x1a, 0x8e, 0x63, 0x10,
+	0x33, 0xbe, 0x25, 0x02, 0xf9, 0x89, 0x0e, 0xc8, 0x33, 0x1e, 0x12, 0x79, 0x0d, 0xc6, 0xfd, 0xf1,
+	0xbe, 0x52, 0xa2, 0x0e, 0x55, 0x4d, 0xad, 0x12, 0x25, 0xee, 0x56, 0xaf, 0x72, 0xfd, 0x3e, 0x82,
+	0x89, 0xd6, 0xdc, 0x22, 0xdb, 0xb7, 0x61, 0xbb, 0x9b, 0x14, 0x5e, 0xda, 0xa7, 0x43, 0x4b, 0x3b,
+	0x04, 0xd2, 0x5b, 0xef, 0x2e, 0xa6, 0xbc, 0x02, 0x23, 0x7e, 0x57, 0xd2, 0xd5, 0x10, 0xf5, 0x4a,
+	0xfe, 0x23, 0x04, 0xa3, 0xcd, 0x39, 0x85, 0xec, 0x3b, 0xbe, 0x8a, 0xe0, 0xca, 0x67, 0xa3, 0x29,
+	0x9f, 0xcb, 0xe5, 0x4a, 0xc5, 0x52, 0x41, 0xa5, 0xba, 0x56, 0x03, 0xf6, 0x8a, 0xf7, 0x96, 0xc1,
+	0xa3, 0x18, 0x1c, 0xf0, 0x3b, 0x73, 0xbd, 0xa0, 0x3a, 0xcb, 0x7a, 0xaf, 0x92, 0x8f, 0xc7, 0x61,
+	0xc0, 0xa1, 0xaa, 0x4d, 0x0d, 0x33, 0x9f, 0x5d, 0xd6, 0x8d, 0xfc, 0x32, 0x8d, 0xc7, 0x46, 0xd1,
+	0x44, 0x7f, 0xe6, 0xff, 0xee, 0xf2, 0x05, 0xb6, 0x8a, 0xc7, 0x60, 0xb7, 0xce, 0xd2, 0xe7, 0x6e,
+	0xdb, 0xc2, 0xb6, 0xfd, 0x8f, 0x2f, 0x8a, 0x4d, 0xe7, 0x01, 0x6a, 0x57, 0x7d, 0xbc, 0x9f, 0x85,
+	0xe9, 0x88, 0xef, 0xe0, 0xf0, 0xd7, 0xa4, 0x76, 0xf3, 0xe5, 0x75, 0xa1, 0x2c, 0xe3, 0xb1, 0x9c,
+	0xe9, 0x7f, 0xf8, 0xd9, 0x48, 0x9f, 0xfc, 0x15, 0x82, 0x83, 0x4d, 0x82, 0x21, 0xd2, 0xf2, 0x06,
+	0x6c, 0x77, 0xf8, 0x52, 0x1c, 0xb1, 0x53, 0x3a, 0x15, 0x2d, 0x27, 0x0c, 0x67, 0x61, 0x55, 0x37,
+	0xa9, 0xaf, 0x0a, 0x05, 0x16, 0x7e, 0xd5, 0x27, 0x23, 0xc6, 0x64, 0x8c, 0xb7, 0x94, 0xc1, 0x7d,
+	0xf2, 0xea, 0x90, 0xbf, 0x76, 0x15, 0xcc, 0xeb, 0x05, 0x3d, 0xcf, 0xd6, 0xea, 0x0e, 0xf3, 0x02,
+	0x0c, 0x69, 0xfc, 0x5b, 0x43, 0x3e, 0xe3, 0xeb, 0x8f, 0x27, 0x87, 0x05, 0x69, 0x5d, 0x1a, 0xab,
+	0x26, 0x6e, 0x1a, 0x03, 0xcb, 0x22, 0xd6, 0x71, 0x59, 0xcc, 0xec, 0xa8, 0x24, 0xe0, 0x59, 0x25,
+	0x09, 0x1f, 0x21, 0x48, 0x34, 0x93, 0x20, 0xb2, 0x60, 0x79, 0xef, 0x84, 0x5e, 0x5e, 0xd4, 0xd5,
+	0x6b, 0xa2, 0x04, 0x72, 0x9d, 0x4f, 0x37, 0x08, 0x55, 0x0b, 0x3d, 0x89, 0xad, 0x27, 0x16, 0x7f,
+	0x23, 0x18, 0x0b, 0xe5, 0x15, 0x01, 0x79, 0xbb, 0x3e, 0x20, 0xa7, 0x42, 0xcb, 0xb2, 0x86, 0x36,
+	0xef, 0x72, 0x73, 0xc4, 0xa0, 0x2b, 0x12, 0x17, 0x60, 0x2b, 0xad, 0x90, 0xf6, 0xf8, 0x51, 0xe4,
+	0x24, 0xb2, 0x2d, 0x2e, 0xe4, 0xaa, 0x67, 0xd5, 0x12, 0xea, 0x5d, 0x98, 0x17, 0xc5, 0x85, 0x1c,
+	0xc8, 0x29, 0x42, 0x9c, 0x00, 0xa8, 0x16, 0x2d, 0x8f, 0xf2, 0xce, 0x8c, 0x67, 0xc5, 0x83, 0x56,
+	0x86, 0x17, 0xfc, 0x68, 0x37, 0x0d, 0xba, 0xac, 0xd9, 0x6a, 0x59, 0x10, 0xf7, 0x4c, 0xc6, 0xaa,
+	0x68, 0xde, 0x9a, 0x13, 0x0b, 0x2d, 0x69, 0x18, 0x2c, 0x8b, 0x4f, 0x91, 0x89, 0x07, 0xca, 0x7e,
+	0x30, 0x0f, 0x6f, 0xdd, 0xe1, 0xa8, 0xf1, 0x5e, 0xb7, 0x0a, 0x06, 0xed, 0x99, 0xdc, 0x47, 0x75,
+	0x87, 0xa3, 0x81, 0x57, 0xa8, 0xbd, 0x05, 0x60, 0xeb, 0x39, 0xc3, 0x32, 0x74, 0x93, 0xba, 0xe7,
+	0x23, 0x19, 0x7a, 0x3e, 0x5c, 0x9c, 0x8c, 0x6b, 0xe6, 0x7b, 0x3d, 0x6b, 0x60, 0x1e, 0x67, 0x28,
+	0x1c, 0xf2, 0xfb, 0x32, 0x57, 0xa2, 0x24, 0x4d, 0x8a, 0x16, 0x29, 0x55, 0xda, 0xbc, 0x1e, 0x85,
+	0x60, 0xa5, 0x3e, 0xf2, 0x7e, 0x56, 0x11, 0x80, 0xb9, 0xc6, 0xd2, 0x8d, 0x72, 0x49, 0x07, 0x57,
+	0xb7, 0x2c, 0xce, 0x8a, 0x97, 0xe9, 0xaa, 0x4d, 0xf2, 0x9e, 0xca, 0x96, 0x6d, 0x11, 0x8c, 0xe0,
+	0x3d, 0xc2, 0xab, 0xd7, 0x61, 0x87, 0x25, 0xd6, 0x44, 0x7f, 0x73, 0x3c, 0x34, 0x29, 0x81, 0x60,
+	0x55, 0x08, 0x79, 0x1a, 0xf6, 0x31, 0xce, 0x4a, 0xcb, 0x53, 0x32, 0x0d, 0xba, 0x76, 0x95, 0x90,
+	0x82, 0x70, 0x68, 0x66, 0xef, 0xfa, 0xe3, 0xc9, 0x81, 0xda, 0xfd, 0x33, 0x3a, 0x95, 0x3c, 0x39,
+	0x15, 0x47, 0xf2, 0xa7, 0x08, 0xa4, 0x20, 0x23, 0xe1, 0xe1, 0x3b, 0xd0, 0x6f, 0x11, 0x52, 0xe8,
+	0xf1, 0x1b, 0xc3, 0x38, 0x9a, 0x78, 0x38, 0xfd, 0xc1, 0xf3, 0xb0, 0x95, 0x79, 0x88, 0x3f, 0x41,
+	0xb0, 0x8d, 0xcf, 0x6d, 0x58, 0x09, 0x8d, 0x53, 0xe3, 0xd0, 0x28, 0x4d, 0x45, 0x37, 0xe0, 0xd2,
+	0xe5, 0x63, 0x0f, 0xbe, 0xfb, 0xe5, 0xe3, 0xd8, 0x61, 0x3c, 0xa6, 0x84, 0xcd, 0xb8, 0x7c, 0x68,
+	0xc4, 0xbf, 0x21, 0xd8, 0xd7, 0x74, 0x6c, 0xc3, 0xa9, 0xd6, 0xe4, 0xad, 0xa6, 0x4d, 0x29, 0xdd,
+	0x15, 0x86, 0xd0, 0x94, 0x66, 0x9a, 0xce, 0xe0, 0xd9, 0x50, 0x4d, 0xb5, 0xa2, 0x57, 0xee, 0x35,
+	0x74, 0x38, 0xf7, 0xf1, 0x7b, 0x31, 0xd8, 0x1f, 0x32, 0x63, 0xe0, 0xf9, 0x36, 0x3c, 0x6d, 0x3a,
+	0x71, 0x49, 0x0b, 0x5d, 0xa2, 0x08, 0xc5, 0x37, 0x99, 0xe2, 0x6b, 0xf8, 0x4a, 0x17, 0x8a, 0x15,
+	0x52, 0xc3, 0x77, 0xc7, 0x63, 0xbc, 0x81, 0x60, 0x4f, 0xc0, 0xf4, 0x82, 0x5f, 0x6e, 0xc3, 0xef,
+	0x86, 0x41, 0x4b, 0x3a, 0xd3, 0xa1, 0xb5, 0x50, 0x7b, 0x99, 0xa9, 0xbd, 0x80, 0xcf, 0x77, 0xa3,
+	0xb6, 0x36, 0x1a, 0xe1, 0xef, 0x11, 0x0c, 0xd6, 0x0f, 0x02, 0xf8, 0xa5, 0x36, 0x7c, 0xf4, 0x4f,
+	0x52, 0xd2, 0x4c, 0x27, 0xa6, 0x42, 0xdb, 0x25, 0xa6, 0x6d, 0x01, 0xa7, 0xbb, 0xd1, 0xe6, 0x4e,
+	0x1b, 0x7f, 0x20, 0x18, 0x6a, 0x68, 0xae, 0x71, 0x04, 0xf7, 0x9a, 0x0d, 0x15, 0xd2, 0x6c, 0x47,
+	0xb6, 0x42, 0x5b, 0x96, 0x69, 0xbb, 0x85, 0x6f, 0x86, 0x6a, 0xab, 0xbe, 0x82, 0x8e, 0x72, 0xaf,
+	0xe1, 0x11, 0xbd, 0xaf, 0x88, 0xca, 0x0c, 0x3c, 0xb3, 0xcf, 0x10, 0x3c, 0x17, 0xdc, 0x40, 0xe3,
+	0x73, 0xed, 0x38, 0x1e, 0xd0, 0xf2, 0x4b, 0xaf, 0x74, 0x0e, 0xd0, 0x56, 0x6a, 0xa3, 0xc9, 0x67,
+	0x07, 0x33, 0xa0, 0x8b, 0x8d, 0x72, 0x30, 0x9b, 0x37, 0xdc, 0x51, 0x0e, 0x66, 0x48, 0xeb, 0x1c,
+	0xf1, 0x60, 0xb6, 0x50, 0x58, 0xab, 0x6d, 0xfc, 0x0f, 0x82, 0x78, 0xb3, 0x1e, 0x17, 0xcf, 0xb5,
+	0xe1, 0x6b, 0x70, 0x63, 0x2e, 0xa5, 0xba, 0x81, 0x10, 0x9a, 0x6f, 0x30, 0xcd, 0x97, 0xf1, 0x62,
+	0x37, 0x9a, 0xeb, 0x9b, 0x74, 0xfc, 0x57, 0xad, 0x92, 0xeb, 0xba, 0xdd, 0x36, 0x2a, 0x39, 0xb8,
+	0x3f, 0x6f, 0xa3, 0x92, 0x9b, 0x34, 0xda, 0x72, 0x86, 0x69, 0x5e, 0xc4, 0xaf, 0xfd, 0x27, 0x9a,
+	0x1d, 0x26, 0xeb, 0x77, 0x04, 0x7b, 0x03, 0xbb, 0x5b, 0x7c, 0xb6, 0x0d, 0x7f, 0x03, 0x9a, 0x71,
+	0xe9, 0x5c, 0xc7, 0xf6, 0x42, 0xee, 0x35, 0x26, 0xf7, 0x12, 0xbe, 0xd8, 0x8d, 0x5c, 0xb5, 0x44,
+	0x49, 0x36, 0xe7, 0x6a, 0x5a, 0x47, 0x30, 0x1c, 0xd4, 0xe7, 0xe2, 0x08, 0x27, 0x30, 0xa4, 0x21,
+	0x97, 0xce, 0x76, 0x6a, 0x2e, 0xa4, 0xce, 0x32, 0xa9, 0x27, 0xf1, 0x89, 0x50, 0xa9, 0x3e, 0x2d,
+	0x59, 0xb7, 0x33, 0xc7, 0xdf, 0x22, 0xd8, 0xed, 0x6b, 0xb0, 0xf1, 0xa9, 0xd6, 0xee, 0x04, 0xb5,
+	0xf1, 0xd2, 0x8b, 0x6d, 0xdb, 0x09, 0xff, 0x2f, 0x7e, 0xd3, 0xd8, 0x5d, 0x33, 0x49, 0x93, 0xf8,
+	0x58, 0xa8, 0xa4, 0x9c, 0x0b, 0x97, 0xad, 0x74, 0xe9, 0x0f, 0x63, 0x28, 0x35, 0xfb, 0x64, 0x23,
+	0x81, 0x9e, 0x6e, 0x24, 0xd0, 0xcf, 0x1b, 0x09, 0xf4, 0xe1, 0x66, 0xa2, 0xef, 0xe9, 0x66, 0xa2,
+	0xef, 0x87, 0xcd, 0x44, 0xdf, 0x5b, 0x87, 0x38, 0x90, 0xa3, 0xdd, 0x4d, 0x1a, 0x44, 0x79, 0xd7,
+	0x0f, 0xc8, 0x3a, 0xff, 0xa5, 0x6d, 0xec, 0x4f, 0x3b, 0x27, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff,
+	0x59, 0x3f, 0xce, 0x4e, 0x00, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1104,6 +1369,14 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorWithdrawSplit queries the withdraw split of a delegator.
+	DelegatorWithdrawSplit(ctx context.Context, in *QueryDelegatorWithdrawSplitRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawSplitResponse, error)
+	// DelegatorAutoCompound queries the validators of the auto-compounded
+	// delegations of a delegator.
+	DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error)
+	// AutoCompoundProgress queries the compounding in progress of the
+	// auto-compounded delegations.
+	AutoCompoundProgress(ctx context.Context, in *QueryAutoCompoundProgressRequest, opts ...grpc.CallOption) (*QueryAutoCompoundProgressResponse, error)
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
@@ -1199,6 +1472,33 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorWithdrawSplit(ctx context.Context, in *QueryDelegatorWithdrawSplitRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawSplitResponse, error) {
+	out := new(QueryDelegatorWithdrawSplitResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error) {
+	out := new(QueryDelegatorAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoCompoundProgress(ctx context.Context, in *QueryAutoCompoundProgressRequest, opts ...grpc.CallOption) (*QueryAutoCompoundProgressResponse, error) {
+	out := new(QueryAutoCompoundProgressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/AutoCompoundProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
@@ -1230,6 +1530,14 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorWithdrawSplit queries the withdraw split of a delegator.
+	DelegatorWithdrawSplit(context.Context, *QueryDelegatorWithdrawSplitRequest) (*QueryDelegatorWithdrawSplitResponse, error)
+	// DelegatorAutoCompound queries the validators of the auto-compounded
+	// delegations of a delegator.
+	DelegatorAutoCompound(context.Context, *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error)
+	// AutoCompoundProgress queries the compounding in progress of the
+	// auto-compounded delegations.
+	AutoCompoundProgress(context.Context, *QueryAutoCompoundProgressRequest) (*QueryAutoCompoundProgressResponse, error)
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
@@ -1267,6 +1575,15 @@ func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorWithdrawSplit(ctx context.Context, req *QueryDelegatorWithdrawSplitRequest) (*QueryDelegatorWithdrawSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawSplit not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorAutoCompound(ctx context.Context, req *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompound not implemented")
+}
+func (*UnimplementedQueryServer) AutoCompoundProgress(ctx context.Context, req *QueryAutoCompoundProgressRequest) (*QueryAutoCompoundProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundProgress not implemented")
+}
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -1437,6 +1754,60 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorWithdrawSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorWithdrawSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorWithdrawSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorWithdrawSplit(ctx, req.(*QueryDelegatorWithdrawSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, req.(*QueryDelegatorAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoCompoundProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/AutoCompoundProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundProgress(ctx, req.(*QueryAutoCompoundProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -1496,6 +1867,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorWithdrawSplit",
+			Handler:    _Query_DelegatorWithdrawSplit_Handler,
+		},
+		{
+			MethodName: "DelegatorAutoCompound",
+			Handler:    _Query_DelegatorAutoCompound_Handler,
+		},
+		{
+			MethodName: "AutoCompoundProgress",
+			Handler:    _Query_AutoCompoundProgress_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
@@ -2153,7 +2536,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegatorWithdrawSplitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2163,20 +2546,27 @@ func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCommunityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegatorWithdrawSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCommunityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegatorWithdrawSplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegatorWithdrawSplitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2186,20 +2576,20 @@ func (m *QueryCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegatorWithdrawSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegatorWithdrawSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pool) > 0 {
-		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2213,35 +2603,215 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDelegatorAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDelegatorAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Progress != nil {
+		{
+			size, err := m.Progress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
 
 func (m *QueryValidatorDistributionInfoRequest) Size() (n int) {
@@ -2492,6 +3062,84 @@ func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorWithdrawSplitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorWithdrawSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAutoCompoundProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Progress != nil {
+		l = m.Progress.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryCommunityPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4219,6 +4867,472 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorWithdrawSplitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorWithdrawSplitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorWithdrawSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorWithdrawSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorWithdrawSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorWithdrawSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, WithdrawRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoCompoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundProgressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundProgressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundProgressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundProgressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Progress == nil {
+				m.Progress = &AutoCompoundProgress{}
+			}
+			if err := m.Progress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
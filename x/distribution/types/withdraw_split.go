@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxWithdrawSplitRecipients is the maximum number of recipients of a withdraw split.
+const MaxWithdrawSplitRecipients = 10
+
+// Validate validates the recipients of a withdraw split. A split has between one and
+// MaxWithdrawSplitRecipients distinct recipients, whose positive fractions sum to 1.
+func (s WithdrawSplit) Validate() error {
+	if len(s.Recipients) == 0 {
+		return fmt.Errorf("withdraw split must have at least one recipient")
+	}
+
+	if len(s.Recipients) > MaxWithdrawSplitRecipients {
+		return fmt.Errorf("withdraw split has %d recipients, maximum is %d", len(s.Recipients), MaxWithdrawSplitRecipients)
+	}
+
+	total := math.LegacyZeroDec()
+	seen := make(map[string]struct{}, len(s.Recipients))
+	for _, r := range s.Recipients {
+		if r.Address == "" {
+			return fmt.Errorf("withdraw split recipient address cannot be empty")
+		}
+
+		if _, ok := seen[r.Address]; ok {
+			return fmt.Errorf("duplicate withdraw split recipient %s", r.Address)
+		}
+		seen[r.Address] = struct{}{}
+
+		if r.Fraction.IsNil() || !r.Fraction.IsPositive() || r.Fraction.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("withdraw split fraction of %s must be positive and at most 1: %s", r.Address, r.Fraction)
+		}
+
+		total = total.Add(r.Fraction)
+	}
+
+	if !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("withdraw split fractions must sum to 1: %s", total)
+	}
+
+	return nil
+}
+
+// Split splits coins between the recipients of a withdraw split, in the order of the
+// recipients. The amount of each recipient is truncated, the last recipient receiving
+// the remainder so that no coins are lost.
+func (s WithdrawSplit) Split(coins sdk.Coins) []sdk.Coins {
+	amounts := make([]sdk.Coins, len(s.Recipients))
+	remaining := coins
+	for i, r := range s.Recipients {
+		if i == len(s.Recipients)-1 {
+			amounts[i] = remaining
+			break
+		}
+
+		amount := sdk.NewCoins()
+		for _, coin := range coins {
+			amount = amount.Add(sdk.NewCoin(coin.Denom, math.LegacyNewDecFromInt(coin.Amount).Mul(r.Fraction).TruncateInt()))
+		}
+
+		amounts[i] = amount
+		remaining = remaining.Sub(amount...)
+	}
+
+	return amounts
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWithdrawSplit_Validate(t *testing.T) {
+	half := math.LegacyNewDecWithPrec(5, 1)
+	recipient := func(addr string, fraction math.LegacyDec) types.WithdrawRecipient {
+		return types.WithdrawRecipient{Address: addr, Fraction: fraction}
+	}
+
+	tooMany := make([]types.WithdrawRecipient, types.MaxWithdrawSplitRecipients+1)
+	for i := range tooMany {
+		tooMany[i] = recipient(string(rune('a'+i)), math.LegacyOneDec().QuoInt64(int64(len(tooMany))))
+	}
+
+	tests := []struct {
+		name       string
+		recipients []types.WithdrawRecipient
+		wantErr    bool
+	}{
+		{"single recipient", []types.WithdrawRecipient{recipient("a", math.LegacyOneDec())}, false},
+		{"two recipients", []types.WithdrawRecipient{recipient("a", half), recipient("b", half)}, false},
+		{"no recipient", nil, true},
+		{"too many recipients", tooMany, true},
+		{"empty address", []types.WithdrawRecipient{recipient("", math.LegacyOneDec())}, true},
+		{"duplicate recipient", []types.WithdrawRecipient{recipient("a", half), recipient("a", half)}, true},
+		{"nil fraction", []types.WithdrawRecipient{recipient("a", math.LegacyDec{})}, true},
+		{"zero fraction", []types.WithdrawRecipient{recipient("a", math.LegacyOneDec()), recipient("b", math.LegacyZeroDec())}, true},
+		{"negative fraction", []types.WithdrawRecipient{recipient("a", math.LegacyNewDec(2)), recipient("b", math.LegacyNewDec(-1))}, true},
+		{"sum below 1", []types.WithdrawRecipient{recipient("a", half)}, true},
+		{"sum above 1", []types.WithdrawRecipient{recipient("a", half), recipient("b", math.LegacyOneDec())}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.WithdrawSplit{Recipients: tt.recipients}.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWithdrawSplit_Split(t *testing.T) {
+	third := math.LegacyOneDec().QuoInt64(3)
+	split := types.WithdrawSplit{Recipients: []types.WithdrawRecipient{
+		{Address: "a", Fraction: third},
+		{Address: "b", Fraction: third},
+		{Address: "c", Fraction: math.LegacyOneDec().Sub(third).Sub(third)},
+	}}
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("mytoken", 1), sdk.NewInt64Coin("stake", 100))
+	amounts := split.Split(coins)
+	require.Equal(t, []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 33)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 33)),
+		sdk.NewCoins(sdk.NewInt64Coin("mytoken", 1), sdk.NewInt64Coin("stake", 34)),
+	}, amounts)
+}
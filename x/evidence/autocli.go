@@ -28,6 +28,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all (paginated) submitted evidence",
 					Example:   fmt.Sprintf("%s query evidence list --page=2 --page-limit=50", version.AppName),
 				},
+				{
+					RpcMethod:      "EquivocationDecision",
+					Use:            "equivocation-decision <hash>",
+					Short:          "Query how an equivocation was punished by evidence hash",
+					Example:        fmt.Sprintf("%s query evidence equivocation-decision DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "hash"}},
+				},
+				{
+					RpcMethod: "EquivocationDecisions",
+					Use:       "equivocation-decisions",
+					Short:     "Query all (paginated) equivocation decisions",
+					Example:   fmt.Sprintf("%s query evidence equivocation-decisions --page=2 --page-limit=50", version.AppName),
+				},
 			},
 		},
 	}
@@ -22,8 +22,11 @@ A full setup of the evidence module may look something as follows:
 	)
 
 	// Second, create the evidence Handler and register all desired routes.
+	// Handlers of app-defined misbehavior of validators may be built with
+	// the keeper's NewMisbehaviorHandler.
 	evidenceRouter := evidence.NewRouter().
 	  AddRoute(evidenceRoute, evidenceHandler).
+	  AddRoute(misbehaviorRoute, evidenceKeeper.NewMisbehaviorHandler(misbehaviorPolicy)).
 	  AddRoute(..., ...)
 
 	evidenceKeeper.SetRouter(evidenceRouter)
@@ -32,6 +32,12 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, gs *types.GenesisState) e
 			return err
 		}
 	}
+
+	for _, decision := range gs.Decisions {
+		if err := k.SetEquivocationDecision(ctx, decision); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -49,5 +55,13 @@ func ExportGenesis(ctx context.Context, k keeper.Keeper) (*types.GenesisState, e
 	if err != nil {
 		return nil, err
 	}
+
+	err = k.Decisions.Walk(ctx, nil, func(_ []byte, decision types.EquivocationDecision) (stop bool, err error) {
+		gs.Decisions = append(gs.Decisions, decision)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 	return gs, nil
 }
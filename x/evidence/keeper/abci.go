@@ -11,7 +11,8 @@ import (
 )
 
 // BeginBlocker iterates through and handles any newly discovered evidence of
-// misbehavior submitted by CometBFT. Currently, only equivocation is handled.
+// misbehavior submitted by CometBFT. Currently, only duplicate votes and light
+// client attacks are handled.
 func (k Keeper) BeginBlocker(ctx context.Context, cometService comet.Service) error {
 	start := telemetry.Now()
 	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyBeginBlocker)
@@ -21,14 +22,21 @@ func (k Keeper) BeginBlocker(ctx context.Context, cometService comet.Service) er
 	evidences := bi.Evidence
 	for _, evidence := range evidences {
 		switch evidence.Type {
-		// It's still ongoing discussion how should we treat and slash attacks with
-		// premeditation. So for now we agree to treat them in the same way.
-		case comet.LightClientAttack, comet.DuplicateVote:
+		case comet.DuplicateVote:
 			evidence := types.FromABCIEvidence(evidence, k.consensusAddressCodec)
 			err := k.handleEquivocationEvidence(ctx, evidence)
 			if err != nil {
 				return err
 			}
+		// It's still ongoing discussion how should we treat and slash attacks with
+		// premeditation. So for now we punish them as duplicate votes, but keep
+		// them as a distinct evidence type.
+		case comet.LightClientAttack:
+			evidence := types.FromABCILightClientAttack(evidence, k.consensusAddressCodec)
+			err := k.handleEquivocationEvidence(ctx, evidence)
+			if err != nil {
+				return err
+			}
 		default:
 			k.Logger.Error(fmt.Sprintf("ignored unknown evidence type: %x", evidence.Type))
 		}
@@ -3,12 +3,14 @@ package keeper
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 
 	"github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
@@ -74,3 +76,44 @@ func (k Querier) AllEvidence(ctx context.Context, req *types.QueryAllEvidenceReq
 
 	return &types.QueryAllEvidenceResponse{Evidence: evidences, Pagination: pageRes}, nil
 }
+
+// EquivocationDecision implements the Query/EquivocationDecision gRPC method
+func (k Querier) EquivocationDecision(ctx context.Context, req *types.QueryEquivocationDecisionRequest) (*types.QueryEquivocationDecisionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Hash == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request; hash is empty")
+	}
+
+	decodedHash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid evidence hash: %w", err)
+	}
+
+	decision, err := k.k.Decisions.Get(ctx, decodedHash)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "equivocation decision of evidence %s not found", req.Hash)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEquivocationDecisionResponse{Decision: decision}, nil
+}
+
+// EquivocationDecisions implements the Query/EquivocationDecisions gRPC method
+func (k Querier) EquivocationDecisions(ctx context.Context, req *types.QueryEquivocationDecisionsRequest) (*types.QueryEquivocationDecisionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	decisions, pageRes, err := query.CollectionPaginate(ctx, k.k.Decisions, req.Pagination, func(_ []byte, value types.EquivocationDecision) (types.EquivocationDecision, error) {
+		return value, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEquivocationDecisionsResponse{Decisions: decisions, Pagination: pageRes}, nil
+}
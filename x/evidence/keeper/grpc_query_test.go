@@ -4,10 +4,14 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -156,3 +160,52 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryEquivocationDecision() {
+	valAddr, err := address.NewBech32Codec("cosmosvaloper").BytesToString(valAddress)
+	suite.Require().NoError(err)
+
+	tombstoned := types.EquivocationDecision{
+		EvidenceHash:     "DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660",
+		ValidatorAddress: valAddr,
+		Height:           10,
+		Outcome:          types.EQUIVOCATION_OUTCOME_TOMBSTONED,
+		SlashFraction:    math.LegacyNewDecWithPrec(5, 2),
+		JailedUntil:      types.DoubleSignJailEndTime.UTC(),
+	}
+	graced := types.EquivocationDecision{
+		EvidenceHash:     "DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7661",
+		ValidatorAddress: valAddr,
+		Height:           12,
+		Outcome:          types.EQUIVOCATION_OUTCOME_ROTATION_GRACE,
+		SlashFraction:    math.LegacyNewDecWithPrec(1, 2),
+		JailedUntil:      time.Unix(100, 0).UTC(),
+		RotationHeight:   11,
+	}
+
+	suite.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+	suite.Require().NoError(suite.evidenceKeeper.SetEquivocationDecision(suite.ctx, tombstoned))
+	suite.Require().NoError(suite.evidenceKeeper.SetEquivocationDecision(suite.ctx, graced))
+
+	// only the rotation whose grace window was applied is marked as graced
+	ok, err := suite.evidenceKeeper.GracedRotations.Has(suite.ctx, collections.Join([]byte(valAddress), uint64(11)))
+	suite.Require().NoError(err)
+	suite.Require().True(ok)
+	ok, err = suite.evidenceKeeper.GracedRotations.Has(suite.ctx, collections.Join([]byte(valAddress), uint64(0)))
+	suite.Require().NoError(err)
+	suite.Require().False(ok)
+
+	_, err = suite.queryClient.EquivocationDecision(suite.ctx, &types.QueryEquivocationDecisionRequest{})
+	suite.Require().ErrorContains(err, "invalid request; hash is empty")
+
+	_, err = suite.queryClient.EquivocationDecision(suite.ctx, &types.QueryEquivocationDecisionRequest{Hash: strings.Repeat("a", 64)})
+	suite.Require().ErrorContains(err, "not found")
+
+	res, err := suite.queryClient.EquivocationDecision(suite.ctx, &types.QueryEquivocationDecisionRequest{Hash: graced.EvidenceHash})
+	suite.Require().NoError(err)
+	suite.Require().Equal(graced, res.Decision)
+
+	all, err := suite.queryClient.EquivocationDecisions(suite.ctx, &types.QueryEquivocationDecisionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.EquivocationDecision{tombstoned, graced}, all.Decisions)
+}
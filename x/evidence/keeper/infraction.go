@@ -2,20 +2,49 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// handleEquivocationEvidence implements an equivocation evidence handler. Assuming the
+// equivocationEvidence defines the evidence of a validator signing conflicting
+// blocks reported by CometBFT, i.e. an Equivocation or a LightClientAttack.
+type equivocationEvidence interface {
+	exported.Evidence
+
+	GetConsensusAddress(address.Codec) sdk.ConsAddress
+	GetTime() time.Time
+	GetValidatorPower() int64
+}
+
+// handleEquivocationEvidence implements an equivocation evidence handler, shared
+// by duplicate votes and light client attacks. Assuming the
 // evidence is valid, the validator committing the misbehavior will be slashed,
 // jailed and tombstoned. Once tombstoned, the validator will not be able to
 // recover. Note, the evidence contains the block time and height at the time of
 // the equivocation.
 //
+// If the equivocation happened within the grace window of a consensus key
+// rotation of the validator, i.e. in the configured number of blocks following
+// the rotation, and was signed with the old or the new key of the rotation, the
+// validator is slashed and jailed for the configured fraction and duration
+// instead, without being tombstoned. The grace window is applied at most once
+// per rotation: further equivocations in the same window are slashed, jailed and
+// tombstoned as usual.
+// How each equivocation was punished is recorded as an EquivocationDecision.
+//
 // The evidence is considered invalid if:
 // - the evidence is too old
 // - the validator is unbonded or does not exist
@@ -24,8 +53,9 @@ import (
 //
 // TODO: Some of the invalid constraints listed above may need to be reconsidered
 // in the case of a lunatic attack.
-func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.Equivocation) error {
+func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence equivocationEvidence) error {
 	consAddr := evidence.GetConsensusAddress(k.consensusAddressCodec)
+	evidenceConsAddr := consAddr
 
 	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
 	if err != nil {
@@ -116,19 +146,73 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	// That's fine since this is just used to filter unbonding delegations & redelegations.
 	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
 
-	// Slash validator. The `power` is the int64 power of the validator as provided
-	// to/by CometBFT. This value is validator.Tokens as sent to CometBFT via
-	// ABCI, and now received as evidence. The fraction is passed in to separately
-	// to slash unbonding and rebonding delegations.
-	slashFractionDoubleSign, err := k.slashingKeeper.SlashFractionDoubleSign(ctx)
+	evidenceConsStr, err := k.consensusAddressCodec.BytesToString(evidenceConsAddr)
+	if err != nil {
+		return err
+	}
+
+	decision := types.EquivocationDecision{
+		EvidenceHash:     strings.ToUpper(hex.EncodeToString(evidence.Hash())),
+		ConsensusAddress: evidenceConsStr,
+		ValidatorAddress: validator.GetOperator(),
+		Height:           infractionHeight,
+	}
+
+	valAddr, rotationHeight, inGrace, err := k.rotationGraceWindow(ctx, validator, evidenceConsAddr, infractionHeight)
 	if err != nil {
 		return err
 	}
 
+	if inGrace {
+		decision.RotationHeight = rotationHeight
+		graced, err := k.GracedRotations.Has(ctx, collections.Join([]byte(valAddr), rotationHeight))
+		if err != nil {
+			return err
+		}
+
+		if graced {
+			// the grace window only covers a single mistake, a repeated equivocation is punished as usual
+			k.Logger.Info(
+				"equivocation in the grace window of an already punished key rotation",
+				"validator", consAddr,
+				"infraction_height", infractionHeight,
+				"rotation_height", rotationHeight,
+			)
+			decision.Outcome = types.EQUIVOCATION_OUTCOME_ROTATION_GRACE_REPEATED
+			decision.SlashFraction, err = k.slashingKeeper.SlashFractionDoubleSign(ctx)
+			if err != nil {
+				return err
+			}
+			decision.JailedUntil = types.DoubleSignJailEndTime
+		} else {
+			decision.Outcome = types.EQUIVOCATION_OUTCOME_ROTATION_GRACE
+			decision.SlashFraction, err = k.slashingKeeper.SlashFractionDoubleSignRotation(ctx)
+			if err != nil {
+				return err
+			}
+			jailDuration, err := k.slashingKeeper.DoubleSignRotationJailDuration(ctx)
+			if err != nil {
+				return err
+			}
+			decision.JailedUntil = headerInfo.Time.Add(jailDuration)
+		}
+	} else {
+		decision.Outcome = types.EQUIVOCATION_OUTCOME_TOMBSTONED
+		decision.SlashFraction, err = k.slashingKeeper.SlashFractionDoubleSign(ctx)
+		if err != nil {
+			return err
+		}
+		decision.JailedUntil = types.DoubleSignJailEndTime
+	}
+
+	// Slash validator. The `power` is the int64 power of the validator as provided
+	// to/by CometBFT. This value is validator.Tokens as sent to CometBFT via
+	// ABCI, and now received as evidence. The fraction is passed in to separately
+	// to slash unbonding and rebonding delegations.
 	err = k.slashingKeeper.SlashWithInfractionReason(
 		ctx,
 		consAddr,
-		slashFractionDoubleSign,
+		decision.SlashFraction,
 		evidence.GetValidatorPower(), distributionHeight,
 		st.Infraction_INFRACTION_DOUBLE_SIGN,
 	)
@@ -145,14 +229,74 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		}
 	}
 
-	err = k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
+	err = k.slashingKeeper.JailUntil(ctx, consAddr, decision.JailedUntil)
 	if err != nil {
 		return err
 	}
 
-	err = k.slashingKeeper.Tombstone(ctx, consAddr)
-	if err != nil {
+	if decision.Outcome != types.EQUIVOCATION_OUTCOME_ROTATION_GRACE {
+		err = k.slashingKeeper.Tombstone(ctx, consAddr)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := k.SetEquivocationDecision(ctx, decision); err != nil {
 		return err
 	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeEquivocationDecision,
+		event.NewAttribute(types.AttributeKeyEvidenceHash, decision.EvidenceHash),
+		event.NewAttribute(types.AttributeKeyConsensusAddress, decision.ConsensusAddress),
+		event.NewAttribute(types.AttributeKeyValidator, decision.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(decision.Height, 10)),
+		event.NewAttribute(types.AttributeKeyOutcome, decision.Outcome.String()),
+		event.NewAttribute(types.AttributeKeySlashFraction, decision.SlashFraction.String()),
+		event.NewAttribute(types.AttributeKeyJailedUntil, decision.JailedUntil.String()),
+		event.NewAttribute(types.AttributeKeyRotationHeight, strconv.FormatUint(decision.RotationHeight, 10)),
+	); err != nil {
+		return err
+	}
+
 	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
 }
+
+// rotationGraceWindow returns the height of the consensus key rotation of the validator whose grace
+// window contains the equivocation, if the equivocation was signed with the old or the new key of
+// the rotation. The operator address of the validator is returned along.
+func (k Keeper) rotationGraceWindow(
+	ctx context.Context, validator sdk.ValidatorI, consAddr sdk.ConsAddress, infractionHeight int64,
+) (valAddr sdk.ValAddress, rotationHeight uint64, ok bool, err error) {
+	graceBlocks, err := k.slashingKeeper.DoubleSignRotationGraceBlocks(ctx)
+	if err != nil || graceBlocks == 0 || len(validator.GetOperator()) == 0 {
+		return nil, 0, false, err
+	}
+
+	valAddr, err = k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	history, err := k.stakingKeeper.GetValidatorConsPubKeyRotationHistory(ctx, valAddr)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	for _, rotation := range history {
+		height := int64(rotation.Height)
+		if infractionHeight < height || infractionHeight > height+graceBlocks {
+			continue
+		}
+
+		oldPk, okOld := rotation.OldConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+		newPk, okNew := rotation.NewConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+		if (okOld && consAddr.Equals(sdk.ConsAddress(oldPk.Address()))) ||
+			(okNew && consAddr.Equals(sdk.ConsAddress(newPk.Address()))) {
+			// keep the latest rotation whose grace window contains the equivocation
+			rotationHeight, ok = rotation.Height, true
+		}
+	}
+
+	return valAddr, rotationHeight, ok, nil
+}
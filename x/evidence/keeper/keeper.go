@@ -34,6 +34,10 @@ type Keeper struct {
 	Schema collections.Schema
 	// Evidences key: evidence hash bytes | value: Evidence
 	Evidences collections.Map[[]byte, exported.Evidence]
+	// Decisions key: evidence hash bytes | value: EquivocationDecision
+	Decisions collections.Map[[]byte, types.EquivocationDecision]
+	// GracedRotations key: validator operator address bytes + consensus key rotation height | value: none
+	GracedRotations collections.KeySet[collections.Pair[[]byte, uint64]]
 }
 
 // NewKeeper creates a new Keeper object.
@@ -51,6 +55,8 @@ func NewKeeper(
 		addressCodec:          ac,
 		consensusAddressCodec: consensusAddressCodec,
 		Evidences:             collections.NewMap(sb, types.KeyPrefixEvidence, "evidences", collections.BytesKey, codec.CollInterfaceValue[exported.Evidence](cdc)),
+		Decisions:             collections.NewMap(sb, types.KeyPrefixEquivocationDecision, "decisions", collections.BytesKey, codec.CollValue[types.EquivocationDecision](cdc)),
+		GracedRotations:       collections.NewKeySet(sb, types.KeyPrefixGracedRotation, "graced_rotations", collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -81,7 +87,7 @@ func (k *Keeper) SetRouter(rtr types.Router) {
 // GetEvidenceHandler returns a registered Handler for a given Evidence type. If
 // no handler exists, an error is returned.
 func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, error) {
-	if !k.router.HasRoute(evidenceRoute) {
+	if k.router == nil || !k.router.HasRoute(evidenceRoute) {
 		return nil, errors.Wrap(types.ErrNoEvidenceHandlerExists, evidenceRoute)
 	}
 
@@ -96,7 +102,7 @@ func (k Keeper) SubmitEvidence(ctx context.Context, evidence exported.Evidence)
 	if _, err := k.Evidences.Get(ctx, evidence.Hash()); err == nil {
 		return errors.Wrap(types.ErrEvidenceExists, strings.ToUpper(hex.EncodeToString(evidence.Hash())))
 	}
-	if !k.router.HasRoute(evidence.Route()) {
+	if k.router == nil || !k.router.HasRoute(evidence.Route()) {
 		return errors.Wrap(types.ErrNoEvidenceHandlerExists, evidence.Route())
 	}
 
@@ -114,3 +120,26 @@ func (k Keeper) SubmitEvidence(ctx context.Context, evidence exported.Evidence)
 
 	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
 }
+
+// SetEquivocationDecision records how an equivocation was punished. The consensus key rotation
+// whose grace window was applied to the equivocation, if any, is marked as graced so that the
+// grace window is applied at most once per rotation.
+func (k Keeper) SetEquivocationDecision(ctx context.Context, decision types.EquivocationDecision) error {
+	hash, err := hex.DecodeString(decision.EvidenceHash)
+	if err != nil {
+		return fmt.Errorf("invalid evidence hash: %w", err)
+	}
+
+	if decision.Outcome == types.EQUIVOCATION_OUTCOME_ROTATION_GRACE {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(decision.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.GracedRotations.Set(ctx, collections.Join(valAddr, decision.RotationHeight)); err != nil {
+			return err
+		}
+	}
+
+	return k.Decisions.Set(ctx, hash, decision)
+}
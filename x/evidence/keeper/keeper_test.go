@@ -78,13 +78,14 @@ type KeeperTestSuite struct {
 	addressCodec     coreaddress.Codec
 	consAddressCodec coreaddress.ConsensusAddressCodec
 
-	evidenceKeeper keeper.Keeper
-	accountKeeper  *evidencetestutil.MockAccountKeeper
-	slashingKeeper *evidencetestutil.MockSlashingKeeper
-	stakingKeeper  *evidencetestutil.MockStakingKeeper
-	queryClient    types.QueryClient
-	encCfg         moduletestutil.TestEncodingConfig
-	msgServer      types.MsgServer
+	evidenceKeeper  keeper.Keeper
+	accountKeeper   *evidencetestutil.MockAccountKeeper
+	slashingKeeper  *evidencetestutil.MockSlashingKeeper
+	stakingKeeper   *evidencetestutil.MockStakingKeeper
+	consensusKeeper *evidencetestutil.MockConsensusKeeper
+	queryClient     types.QueryClient
+	encCfg          moduletestutil.TestEncodingConfig
+	msgServer       types.MsgServer
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
@@ -116,6 +117,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 
 	suite.stakingKeeper = stakingKeeper
 	suite.slashingKeeper = slashingKeeper
+	suite.consensusKeeper = ck
 
 	router := types.NewRouter()
 	router = router.AddRoute(types.RouteEquivocation, testEquivocationHandler(evidenceKeeper))
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMisbehaviorHandler returns an evidence Handler punishing the validators
+// committing an app-defined misbehavior, e.g. an oracle double reporting,
+// according to the given policy. The handled evidence must implement the
+// ValidatorEvidence interface and is first verified by the policy. The validator
+// is slashed on its current consensus power in the staking state rather than on
+// the power claimed by the evidence, which is chosen by the submitter.
+//
+// The evidence is rejected if:
+// - it is not verified by the policy
+// - the misbehavior happened at a future height or is too old
+// - the validator is unbonded or does not exist
+// - the validator is already tombstoned
+//
+// The handler is meant to be registered in the evidence Router of the keeper,
+// under the route of the app-defined evidence type, so that the evidence can be
+// submitted with MsgSubmitEvidence. It panics if the policy is invalid.
+func (k Keeper) NewMisbehaviorHandler(policy types.MisbehaviorPolicy) types.Handler {
+	if err := policy.Validate(); err != nil {
+		panic(err)
+	}
+
+	return func(ctx context.Context, evidence exported.Evidence) error {
+		validatorEvidence, ok := evidence.(exported.ValidatorEvidence)
+		if !ok {
+			return fmt.Errorf("expected validator evidence, got %T", evidence)
+		}
+
+		if err := evidence.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if err := policy.Verify(ctx, evidence); err != nil {
+			return err
+		}
+
+		return k.handleMisbehavior(ctx, validatorEvidence, policy)
+	}
+}
+
+// handleMisbehavior slashes, jails and tombstones the validator committing an
+// app-defined misbehavior according to the given policy.
+func (k Keeper) handleMisbehavior(ctx context.Context, evidence exported.ValidatorEvidence, policy types.MisbehaviorPolicy) error {
+	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, evidence.GetConsensusAddress())
+	if err != nil {
+		return err
+	}
+	if validator == nil || validator.IsUnbonded() {
+		return fmt.Errorf("validator %s is unbonded or does not exist", evidence.GetConsensusAddress())
+	}
+
+	// Get the consAddr from the validator read from the store and not from the evidence,
+	// because if the validator has rotated its key, the key in evidence could be outdated.
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return fmt.Errorf("validator %s is already tombstoned", consAddr)
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	infractionHeight := evidence.GetHeight()
+	if infractionHeight > headerInfo.Height {
+		return fmt.Errorf("misbehavior at future height %d; current height %d", infractionHeight, headerInfo.Height)
+	}
+
+	eviAgeBlocks, _, _, err := k.consensusKeeper.EvidenceParams(ctx)
+	if err == nil && headerInfo.Height-infractionHeight > eviAgeBlocks {
+		return fmt.Errorf("misbehavior at height %d is too old; max age %d blocks", infractionHeight, eviAgeBlocks)
+	}
+
+	k.Logger.Info(
+		"confirmed misbehavior",
+		"route", evidence.Route(),
+		"validator", consAddr,
+		"infraction_height", infractionHeight,
+	)
+
+	// See handleEquivocationEvidence for the rationale of the distribution height.
+	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+
+	if policy.SlashFraction.IsPositive() {
+		power := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+		err = k.slashingKeeper.SlashWithInfractionReason(
+			ctx,
+			consAddr,
+			policy.SlashFraction,
+			power, distributionHeight,
+			st.Infraction_INFRACTION_UNSPECIFIED,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	var jailedUntil time.Time
+	switch {
+	case policy.Tombstone:
+		jailedUntil = types.DoubleSignJailEndTime
+	case policy.JailDuration > 0:
+		jailedUntil = headerInfo.Time.Add(policy.JailDuration)
+	}
+
+	if !jailedUntil.IsZero() {
+		if !validator.IsJailed() {
+			if err := k.slashingKeeper.Jail(ctx, consAddr); err != nil {
+				return err
+			}
+		}
+
+		if err := k.slashingKeeper.JailUntil(ctx, consAddr, jailedUntil); err != nil {
+			return err
+		}
+	}
+
+	if policy.Tombstone {
+		if err := k.slashingKeeper.Tombstone(ctx, consAddr); err != nil {
+			return err
+		}
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeMisbehavior,
+		event.NewAttribute(types.AttributeKeyEvidenceHash, strings.ToUpper(hex.EncodeToString(evidence.Hash()))),
+		event.NewAttribute(types.AttributeKeyRoute, evidence.Route()),
+		event.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+		event.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(infractionHeight, 10)),
+		event.NewAttribute(types.AttributeKeySlashFraction, policy.SlashFraction.String()),
+		event.NewAttribute(types.AttributeKeyJailedUntil, jailedUntil.String()),
+		event.NewAttribute(types.AttributeKeyTombstoned, strconv.FormatBool(policy.Tombstone)),
+	)
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// oracleDoubleReport is an app-defined evidence of a validator reporting two
+// different oracle prices for the same round.
+type oracleDoubleReport struct {
+	height   int64
+	power    int64
+	consAddr sdk.ConsAddress
+}
+
+var _ exported.ValidatorEvidence = &oracleDoubleReport{}
+
+func (e *oracleDoubleReport) Reset()                               {}
+func (e *oracleDoubleReport) ProtoMessage()                        {}
+func (e *oracleDoubleReport) String() string                       { return fmt.Sprintf("%d/%s", e.height, e.consAddr) }
+func (e *oracleDoubleReport) Route() string                        { return "oracle" }
+func (e *oracleDoubleReport) GetHeight() int64                     { return e.height }
+func (e *oracleDoubleReport) GetConsensusAddress() sdk.ConsAddress { return e.consAddr }
+func (e *oracleDoubleReport) GetValidatorPower() int64             { return e.power }
+func (e *oracleDoubleReport) GetTotalPower() int64                 { return 0 }
+
+func (e *oracleDoubleReport) Hash() []byte {
+	hash := sha256.Sum256([]byte(e.String()))
+	return hash[:]
+}
+
+func (e *oracleDoubleReport) ValidateBasic() error {
+	if e.height < 1 {
+		return fmt.Errorf("invalid oracle report height: %d", e.height)
+	}
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestMisbehaviorHandler() {
+	now := time.Unix(1000, 0).UTC()
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 20, Time: now})
+	consAddr := sdk.ConsAddress(pubkeys[0].Address())
+	valStr, err := address.NewBech32Codec("cosmosvaloper").BytesToString(valAddress)
+	suite.Require().NoError(err)
+	validator, err := stakingtypes.NewValidator(valStr, pubkeys[0], stakingtypes.Description{})
+	suite.Require().NoError(err)
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+
+	errNotVerified := errors.New("conflicting reports not signed by the validator")
+	policy := types.MisbehaviorPolicy{
+		Verify: func(_ context.Context, evidence exported.Evidence) error {
+			if evidence.(*oracleDoubleReport).power == 50 {
+				return errNotVerified
+			}
+			return nil
+		},
+		SlashFraction: math.LegacyNewDecWithPrec(1, 2),
+		JailDuration:  time.Hour,
+	}
+	handler := suite.evidenceKeeper.NewMisbehaviorHandler(policy)
+
+	// the evidence must be a validator evidence
+	suite.Require().ErrorContains(handler(ctx, &types.Equivocation{}), "expected validator evidence")
+
+	// the evidence must be valid and verified by the policy
+	suite.Require().Error(handler(ctx, &oracleDoubleReport{height: 0, power: 100, consAddr: consAddr}))
+	suite.Require().ErrorIs(handler(ctx, &oracleDoubleReport{height: 10, power: 50, consAddr: consAddr}), errNotVerified)
+
+	// the misbehavior cannot happen in the future
+	suite.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, consAddr).Return(validator, nil).AnyTimes()
+	suite.slashingKeeper.EXPECT().IsTombstoned(ctx, consAddr).Return(false).AnyTimes()
+	suite.Require().ErrorContains(handler(ctx, &oracleDoubleReport{height: 21, power: 100, consAddr: consAddr}), "future height")
+
+	// nor be too old
+	suite.consensusKeeper.EXPECT().EvidenceParams(ctx).Return(int64(5), time.Hour, uint64(0), nil).AnyTimes()
+	suite.Require().ErrorContains(handler(ctx, &oracleDoubleReport{height: 10, power: 100, consAddr: consAddr}), "too old")
+
+	// the validator is slashed on its power in the staking state, not on the power claimed
+	// by the evidence, and jailed for the policy duration, but not tombstoned
+	suite.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.DefaultPowerReduction).AnyTimes()
+	suite.slashingKeeper.EXPECT().SlashWithInfractionReason(ctx, consAddr, policy.SlashFraction, int64(100), int64(15-sdk.ValidatorUpdateDelay), st.Infraction_INFRACTION_UNSPECIFIED).Return(nil)
+	suite.slashingKeeper.EXPECT().Jail(ctx, consAddr).Return(nil)
+	suite.slashingKeeper.EXPECT().JailUntil(ctx, consAddr, now.Add(time.Hour)).Return(nil)
+	suite.Require().NoError(handler(ctx, &oracleDoubleReport{height: 15, power: 1000, consAddr: consAddr}))
+
+	// a tombstoning policy jails the validator forever
+	policy.Tombstone = true
+	handler = suite.evidenceKeeper.NewMisbehaviorHandler(policy)
+	suite.slashingKeeper.EXPECT().SlashWithInfractionReason(ctx, consAddr, policy.SlashFraction, int64(100), int64(16-sdk.ValidatorUpdateDelay), st.Infraction_INFRACTION_UNSPECIFIED).Return(nil)
+	suite.slashingKeeper.EXPECT().Jail(ctx, consAddr).Return(nil)
+	suite.slashingKeeper.EXPECT().JailUntil(ctx, consAddr, types.DoubleSignJailEndTime).Return(nil)
+	suite.slashingKeeper.EXPECT().Tombstone(ctx, consAddr).Return(nil)
+	suite.Require().NoError(handler(ctx, &oracleDoubleReport{height: 16, power: 100, consAddr: consAddr}))
+
+	// an invalid policy is rejected
+	suite.Require().Panics(func() {
+		suite.evidenceKeeper.NewMisbehaviorHandler(types.MisbehaviorPolicy{Verify: policy.Verify, SlashFraction: math.LegacyNewDec(2)})
+	})
+
+	// as well as a policy that does not verify the evidence
+	suite.Require().Panics(func() {
+		suite.evidenceKeeper.NewMisbehaviorHandler(types.MisbehaviorPolicy{SlashFraction: policy.SlashFraction})
+	})
+}
@@ -15,7 +15,9 @@ import (
 	time "time"
 
 	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	address "cosmossdk.io/core/address"
 	math "cosmossdk.io/math"
+	types1 "cosmossdk.io/x/staking/types"
 	types "github.com/cosmos/cosmos-sdk/crypto/types"
 	types0 "github.com/cosmos/cosmos-sdk/types"
 	gomock "go.uber.org/mock/gomock"
@@ -45,6 +47,49 @@ func (m *MockStakingKeeper) EXPECT() *MockStakingKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetValidatorConsPubKeyRotationHistory mocks base method.
+func (m *MockStakingKeeper) GetValidatorConsPubKeyRotationHistory(arg0 context.Context, arg1 types0.ValAddress) ([]types1.ConsPubKeyRotationHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorConsPubKeyRotationHistory", arg0, arg1)
+	ret0, _ := ret[0].([]types1.ConsPubKeyRotationHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorConsPubKeyRotationHistory indicates an expected call of GetValidatorConsPubKeyRotationHistory.
+func (mr *MockStakingKeeperMockRecorder) GetValidatorConsPubKeyRotationHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorConsPubKeyRotationHistory", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidatorConsPubKeyRotationHistory), arg0, arg1)
+}
+
+// PowerReduction mocks base method.
+func (m *MockStakingKeeper) PowerReduction(arg0 context.Context) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerReduction", arg0)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// PowerReduction indicates an expected call of PowerReduction.
+func (mr *MockStakingKeeperMockRecorder) PowerReduction(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerReduction", reflect.TypeOf((*MockStakingKeeper)(nil).PowerReduction), arg0)
+}
+
+// ValidatorAddressCodec mocks base method.
+func (m *MockStakingKeeper) ValidatorAddressCodec() address.Codec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorAddressCodec")
+	ret0, _ := ret[0].(address.Codec)
+	return ret0
+}
+
+// ValidatorAddressCodec indicates an expected call of ValidatorAddressCodec.
+func (mr *MockStakingKeeperMockRecorder) ValidatorAddressCodec() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorAddressCodec", reflect.TypeOf((*MockStakingKeeper)(nil).ValidatorAddressCodec))
+}
+
 // ValidatorByConsAddr mocks base method.
 func (m *MockStakingKeeper) ValidatorByConsAddr(arg0 context.Context, arg1 types0.ConsAddress) (types0.ValidatorI, error) {
 	m.ctrl.T.Helper()
@@ -84,6 +129,36 @@ func (m *MockSlashingKeeper) EXPECT() *MockSlashingKeeperMockRecorder {
 	return m.recorder
 }
 
+// DoubleSignRotationGraceBlocks mocks base method.
+func (m *MockSlashingKeeper) DoubleSignRotationGraceBlocks(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DoubleSignRotationGraceBlocks", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DoubleSignRotationGraceBlocks indicates an expected call of DoubleSignRotationGraceBlocks.
+func (mr *MockSlashingKeeperMockRecorder) DoubleSignRotationGraceBlocks(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoubleSignRotationGraceBlocks", reflect.TypeOf((*MockSlashingKeeper)(nil).DoubleSignRotationGraceBlocks), arg0)
+}
+
+// DoubleSignRotationJailDuration mocks base method.
+func (m *MockSlashingKeeper) DoubleSignRotationJailDuration(arg0 context.Context) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DoubleSignRotationJailDuration", arg0)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DoubleSignRotationJailDuration indicates an expected call of DoubleSignRotationJailDuration.
+func (mr *MockSlashingKeeperMockRecorder) DoubleSignRotationJailDuration(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoubleSignRotationJailDuration", reflect.TypeOf((*MockSlashingKeeper)(nil).DoubleSignRotationJailDuration), arg0)
+}
+
 // GetPubkey mocks base method.
 func (m *MockSlashingKeeper) GetPubkey(arg0 context.Context, arg1 types.Address) (types.PubKey, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +259,21 @@ func (mr *MockSlashingKeeperMockRecorder) SlashFractionDoubleSign(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFractionDoubleSign", reflect.TypeOf((*MockSlashingKeeper)(nil).SlashFractionDoubleSign), arg0)
 }
 
+// SlashFractionDoubleSignRotation mocks base method.
+func (m *MockSlashingKeeper) SlashFractionDoubleSignRotation(arg0 context.Context) (math.LegacyDec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashFractionDoubleSignRotation", arg0)
+	ret0, _ := ret[0].(math.LegacyDec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlashFractionDoubleSignRotation indicates an expected call of SlashFractionDoubleSignRotation.
+func (mr *MockSlashingKeeperMockRecorder) SlashFractionDoubleSignRotation(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFractionDoubleSignRotation", reflect.TypeOf((*MockSlashingKeeper)(nil).SlashFractionDoubleSignRotation), arg0)
+}
+
 // SlashWithInfractionReason mocks base method.
 func (m *MockSlashingKeeper) SlashWithInfractionReason(arg0 context.Context, arg1 types0.ConsAddress, arg2 math.LegacyDec, arg3, arg4 int64, arg5 stakingv1beta1.Infraction) error {
 	m.ctrl.T.Helper()
@@ -15,6 +15,7 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	registrar.RegisterInterface((*exported.Evidence)(nil), nil)
 	legacy.RegisterAminoMsg(registrar, &MsgSubmitEvidence{}, "cosmos-sdk/MsgSubmitEvidence")
 	registrar.RegisterConcrete(&Equivocation{}, "cosmos-sdk/Equivocation")
+	registrar.RegisterConcrete(&LightClientAttack{}, "cosmos-sdk/LightClientAttack")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -24,6 +25,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		"cosmos.evidence.v1beta1.Evidence",
 		(*exported.Evidence)(nil),
 		&Equivocation{},
+		&LightClientAttack{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
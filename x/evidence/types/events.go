@@ -2,7 +2,18 @@ package types
 
 // evidence module events
 const (
-	EventTypeSubmitEvidence = "submit_evidence"
+	EventTypeSubmitEvidence       = "submit_evidence"
+	EventTypeEquivocationDecision = "equivocation_decision"
+	EventTypeMisbehavior          = "misbehavior"
 
-	AttributeKeyEvidenceHash = "evidence_hash"
+	AttributeKeyEvidenceHash     = "evidence_hash"
+	AttributeKeyConsensusAddress = "consensus_address"
+	AttributeKeyValidator        = "validator"
+	AttributeKeyHeight           = "height"
+	AttributeKeyOutcome          = "outcome"
+	AttributeKeySlashFraction    = "slash_fraction"
+	AttributeKeyJailedUntil      = "jailed_until"
+	AttributeKeyRotationHeight   = "rotation_height"
+	AttributeKeyRoute            = "route"
+	AttributeKeyTombstoned       = "tombstoned"
 )
@@ -13,9 +13,15 @@ import (
 )
 
 // Evidence type constants
-const RouteEquivocation = "equivocation"
+const (
+	RouteEquivocation      = "equivocation"
+	RouteLightClientAttack = "lightclientattack"
+)
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence = &Equivocation{}
+	_ exported.Evidence = &LightClientAttack{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -91,3 +97,83 @@ func FromABCIEvidence(e comet.Evidence, conAc address.Codec) *Equivocation {
 		Time:             e.Time,
 	}
 }
+
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e *LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Hash returns the hash of a LightClientAttack object.
+func (e *LightClientAttack) Hash() []byte {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	hash := sha256.Sum256(bz)
+
+	return hash[:]
+}
+
+// ValidateBasic performs basic stateless validation checks on a LightClientAttack object.
+func (e *LightClientAttack) ValidateBasic() error {
+	if e.Time.Unix() <= 0 {
+		return fmt.Errorf("invalid light client attack time: %s", e.Time)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid light client attack height: %d", e.Height)
+	}
+	if e.Power < 1 {
+		return fmt.Errorf("invalid light client attack validator power: %d", e.Power)
+	}
+	if e.TotalPower < e.Power {
+		return fmt.Errorf("invalid light client attack total power: %d", e.TotalPower)
+	}
+	if e.ConsensusAddress == "" {
+		return fmt.Errorf("invalid light client attack validator consensus address: %s", e.ConsensusAddress)
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the validator's consensus address at time of the
+// LightClientAttack infraction.
+func (e LightClientAttack) GetConsensusAddress(consAc address.Codec) sdk.ConsAddress {
+	addr, _ := consAc.StringToBytes(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the height at time of the LightClientAttack infraction.
+func (e LightClientAttack) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time at time of the LightClientAttack infraction.
+func (e LightClientAttack) GetTime() time.Time {
+	return e.Time
+}
+
+// GetValidatorPower returns the validator's power at time of the LightClientAttack
+// infraction.
+func (e LightClientAttack) GetValidatorPower() int64 {
+	return e.Power
+}
+
+// GetTotalPower returns the total power of the validator set at time of the
+// LightClientAttack infraction.
+func (e LightClientAttack) GetTotalPower() int64 { return e.TotalPower }
+
+// FromABCILightClientAttack converts a CometBFT light client attack Evidence to
+// SDK Evidence using LightClientAttack as the concrete type.
+func FromABCILightClientAttack(e comet.Evidence, conAc address.Codec) *LightClientAttack {
+	consAddr, err := conAc.BytesToString(e.Validator.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	return &LightClientAttack{
+		Height:           e.Height,
+		Power:            e.Validator.Power,
+		ConsensusAddress: consAddr,
+		Time:             e.Time,
+		TotalPower:       e.TotalVotingPower,
+	}
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -29,6 +30,46 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EquivocationOutcome defines how an equivocation was punished.
+type EquivocationOutcome int32
+
+const (
+	// EQUIVOCATION_OUTCOME_UNSPECIFIED defines an empty outcome.
+	EQUIVOCATION_OUTCOME_UNSPECIFIED EquivocationOutcome = 0
+	// EQUIVOCATION_OUTCOME_TOMBSTONED defines an equivocation for which the validator was slashed,
+	// jailed forever and tombstoned.
+	EQUIVOCATION_OUTCOME_TOMBSTONED EquivocationOutcome = 1
+	// EQUIVOCATION_OUTCOME_ROTATION_GRACE defines an equivocation in the grace window of a consensus
+	// key rotation, for which the validator was slashed and jailed without being tombstoned.
+	EQUIVOCATION_OUTCOME_ROTATION_GRACE EquivocationOutcome = 2
+	// EQUIVOCATION_OUTCOME_ROTATION_GRACE_REPEATED defines an equivocation in the grace window of a
+	// consensus key rotation which was already punished. The validator was slashed, jailed forever and
+	// tombstoned, as the grace window only covers a single equivocation.
+	EQUIVOCATION_OUTCOME_ROTATION_GRACE_REPEATED EquivocationOutcome = 3
+)
+
+var EquivocationOutcome_name = map[int32]string{
+	0: "EQUIVOCATION_OUTCOME_UNSPECIFIED",
+	1: "EQUIVOCATION_OUTCOME_TOMBSTONED",
+	2: "EQUIVOCATION_OUTCOME_ROTATION_GRACE",
+	3: "EQUIVOCATION_OUTCOME_ROTATION_GRACE_REPEATED",
+}
+
+var EquivocationOutcome_value = map[string]int32{
+	"EQUIVOCATION_OUTCOME_UNSPECIFIED":             0,
+	"EQUIVOCATION_OUTCOME_TOMBSTONED":              1,
+	"EQUIVOCATION_OUTCOME_ROTATION_GRACE":          2,
+	"EQUIVOCATION_OUTCOME_ROTATION_GRACE_REPEATED": 3,
+}
+
+func (x EquivocationOutcome) String() string {
+	return proto.EnumName(EquivocationOutcome_name, int32(x))
+}
+
+func (EquivocationOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{0}
+}
+
 // Equivocation implements the Evidence interface and defines evidence of double
 // signing misbehavior.
 type Equivocation struct {
@@ -75,8 +116,164 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// LightClientAttack implements the Evidence interface and defines evidence of a
+// light client attack reported by CometBFT, i.e. of a validator signing a
+// conflicting block to deceive light clients.
+type LightClientAttack struct {
+	// height is the height of the conflicting block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the conflicting block.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// power is the attacking validator power.
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+	// consensus_address is the attacking validator consensus address.
+	ConsensusAddress string `protobuf:"bytes,4,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// total_power is the total voting power of the validator set at the height of the conflicting
+	// block.
+	TotalPower int64 `protobuf:"varint,5,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+}
+
+func (m *LightClientAttack) Reset()         { *m = LightClientAttack{} }
+func (m *LightClientAttack) String() string { return proto.CompactTextString(m) }
+func (*LightClientAttack) ProtoMessage()    {}
+func (*LightClientAttack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *LightClientAttack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttack.Merge(m, src)
+}
+func (m *LightClientAttack) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttack) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttack proto.InternalMessageInfo
+
+// EquivocationDecision records how an equivocation was punished.
+type EquivocationDecision struct {
+	// evidence_hash is the HEX encoded hash of the equivocation evidence.
+	EvidenceHash string `protobuf:"bytes,1,opt,name=evidence_hash,json=evidenceHash,proto3" json:"evidence_hash,omitempty"`
+	// consensus_address is the consensus address of the equivocation evidence.
+	ConsensusAddress string `protobuf:"bytes,2,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// validator_address is the operator address of the punished validator.
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height is the equivocation height.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// outcome defines how the equivocation was punished.
+	Outcome EquivocationOutcome `protobuf:"varint,5,opt,name=outcome,proto3,enum=cosmos.evidence.v1beta1.EquivocationOutcome" json:"outcome,omitempty"`
+	// slash_fraction is the fraction of the validator stake slashed for the equivocation.
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	// jailed_until is the time until which the validator is jailed.
+	JailedUntil time.Time `protobuf:"bytes,7,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+	// rotation_height is the height of the consensus key rotation whose grace window contains the
+	// equivocation, zero if none.
+	RotationHeight uint64 `protobuf:"varint,8,opt,name=rotation_height,json=rotationHeight,proto3" json:"rotation_height,omitempty"`
+}
+
+func (m *EquivocationDecision) Reset()         { *m = EquivocationDecision{} }
+func (m *EquivocationDecision) String() string { return proto.CompactTextString(m) }
+func (*EquivocationDecision) ProtoMessage()    {}
+func (*EquivocationDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{2}
+}
+func (m *EquivocationDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EquivocationDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EquivocationDecision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EquivocationDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EquivocationDecision.Merge(m, src)
+}
+func (m *EquivocationDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *EquivocationDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_EquivocationDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EquivocationDecision proto.InternalMessageInfo
+
+func (m *EquivocationDecision) GetEvidenceHash() string {
+	if m != nil {
+		return m.EvidenceHash
+	}
+	return ""
+}
+
+func (m *EquivocationDecision) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+func (m *EquivocationDecision) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EquivocationDecision) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EquivocationDecision) GetOutcome() EquivocationOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return EQUIVOCATION_OUTCOME_UNSPECIFIED
+}
+
+func (m *EquivocationDecision) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+func (m *EquivocationDecision) GetRotationHeight() uint64 {
+	if m != nil {
+		return m.RotationHeight
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.evidence.v1beta1.EquivocationOutcome", EquivocationOutcome_name, EquivocationOutcome_value)
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*LightClientAttack)(nil), "cosmos.evidence.v1beta1.LightClientAttack")
+	proto.RegisterType((*EquivocationDecision)(nil), "cosmos.evidence.v1beta1.EquivocationDecision")
 }
 
 func init() {
@@ -84,31 +281,99 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x51, 0x41, 0x4e, 0xc2, 0x40,
-	0x14, 0xed, 0x08, 0x92, 0x58, 0x35, 0x91, 0x86, 0x68, 0x25, 0xb1, 0x25, 0xc6, 0x18, 0x42, 0x42,
-	0x27, 0xe8, 0x4e, 0xe3, 0x42, 0x12, 0x2e, 0x80, 0xae, 0xdc, 0x90, 0xd2, 0x8e, 0x65, 0x82, 0xed,
-	0xaf, 0x9d, 0x01, 0xf5, 0x06, 0xc6, 0x15, 0x47, 0x60, 0xc9, 0x92, 0x85, 0x87, 0x60, 0x49, 0x5c,
-	0xb9, 0x52, 0x53, 0x16, 0x70, 0x0c, 0xc3, 0xcc, 0x80, 0x6e, 0x26, 0xff, 0xbd, 0x79, 0xef, 0xbf,
-	0xf9, 0xf3, 0xf5, 0x53, 0x0f, 0x58, 0x08, 0x0c, 0x93, 0x3e, 0xf5, 0x49, 0xe4, 0x11, 0xdc, 0xaf,
-	0xb5, 0x09, 0x77, 0x6b, 0x6b, 0xc2, 0x89, 0x13, 0xe0, 0x60, 0x1c, 0x48, 0x9d, 0xb3, 0xa6, 0x95,
-	0xae, 0x98, 0x77, 0x43, 0x1a, 0x01, 0x16, 0xa7, 0xd4, 0x16, 0x0b, 0x01, 0x04, 0x20, 0x4a, 0xbc,
-	0xac, 0x14, 0x6b, 0x07, 0x00, 0xc1, 0x03, 0xc1, 0x02, 0xb5, 0x7b, 0xf7, 0x98, 0xd3, 0x90, 0x30,
-	0xee, 0x86, 0xb1, 0x12, 0x1c, 0xca, 0x88, 0x96, 0x74, 0xaa, 0x3c, 0x01, 0x8e, 0x17, 0x48, 0xdf,
-	0x69, 0x3c, 0xf6, 0x68, 0x1f, 0x3c, 0x97, 0x53, 0x88, 0x8c, 0x7d, 0x3d, 0xd7, 0x21, 0x34, 0xe8,
-	0x70, 0x13, 0x95, 0x50, 0x39, 0xd3, 0x54, 0xc8, 0xb8, 0xd2, 0xb3, 0xcb, 0xb6, 0xe6, 0x46, 0x09,
-	0x95, 0xb7, 0xcf, 0x8a, 0x8e, 0xcc, 0x74, 0x56, 0x99, 0xce, 0xed, 0x2a, 0xb3, 0xbe, 0x3b, 0xf9,
-	0xb2, 0xb5, 0xc1, 0xb7, 0x8d, 0x46, 0xf3, 0x71, 0x05, 0x35, 0x85, 0xcd, 0x28, 0xe8, 0x9b, 0x31,
-	0x3c, 0x91, 0xc4, 0xcc, 0x88, 0xae, 0x12, 0x18, 0x0d, 0x3d, 0xef, 0x41, 0xc4, 0x48, 0xc4, 0x7a,
-	0xac, 0xe5, 0xfa, 0x7e, 0x42, 0x18, 0x33, 0xb3, 0x25, 0x54, 0xde, 0xaa, 0x9b, 0x1f, 0xef, 0xd5,
-	0x82, 0x7a, 0xea, 0xb5, 0xbc, 0xb9, 0xe1, 0x09, 0x8d, 0x82, 0xe6, 0xde, 0xda, 0xa2, 0xf8, 0x8b,
-	0x93, 0xd7, 0xa1, 0xad, 0x2d, 0x86, 0xb6, 0xf6, 0x36, 0x1f, 0x57, 0xd4, 0x7f, 0x56, 0x99, 0xdf,
-	0xc5, 0xff, 0x27, 0xab, 0x5f, 0x8e, 0x52, 0x0b, 0x4d, 0x52, 0x0b, 0x4d, 0x53, 0x0b, 0xfd, 0xa4,
-	0x16, 0x1a, 0xcc, 0x2c, 0x6d, 0x3a, 0xb3, 0xb4, 0xcf, 0x99, 0xa5, 0xdd, 0x1d, 0x49, 0x1b, 0xf3,
-	0xbb, 0x0e, 0x05, 0xfc, 0xfc, 0xb7, 0x36, 0xfe, 0x12, 0x13, 0xd6, 0xce, 0x89, 0x41, 0xcf, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x67, 0x41, 0x63, 0x62, 0xd6, 0x01, 0x00, 0x00,
+	// 718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x4f, 0x4f, 0x13, 0x41,
+	0x1c, 0xdd, 0x6d, 0x0b, 0xc8, 0x50, 0xb0, 0x5d, 0x1b, 0xad, 0x55, 0x76, 0x2b, 0x10, 0xa9, 0x84,
+	0xee, 0x0a, 0x26, 0x1e, 0x30, 0x1e, 0xfa, 0x67, 0x91, 0x26, 0xd0, 0xc5, 0xa5, 0xe5, 0x60, 0x62,
+	0x36, 0xc3, 0x76, 0x68, 0x57, 0xda, 0x9d, 0xda, 0x99, 0x56, 0xf9, 0x06, 0xc4, 0x13, 0xc6, 0x2f,
+	0x40, 0xe2, 0x85, 0x23, 0x07, 0xbe, 0x80, 0x37, 0x8e, 0x84, 0x93, 0xf1, 0x80, 0xa6, 0x1c, 0xe0,
+	0x63, 0x98, 0x9d, 0xdd, 0xd6, 0x02, 0x25, 0xd1, 0xa3, 0x97, 0x66, 0xe7, 0xf5, 0xfd, 0xde, 0xeb,
+	0x7b, 0xf3, 0xeb, 0x82, 0xc7, 0x26, 0x26, 0x35, 0x4c, 0x14, 0xd4, 0xb2, 0x4a, 0xc8, 0x36, 0x91,
+	0xd2, 0x9a, 0xdb, 0x40, 0x14, 0xce, 0x75, 0x01, 0xb9, 0xde, 0xc0, 0x14, 0x0b, 0xf7, 0x5c, 0x9e,
+	0xdc, 0x85, 0x3d, 0x5e, 0x2c, 0x0c, 0x6b, 0x96, 0x8d, 0x15, 0xf6, 0xe9, 0x72, 0x63, 0x91, 0x32,
+	0x2e, 0x63, 0xf6, 0xa8, 0x38, 0x4f, 0x1e, 0x2a, 0x95, 0x31, 0x2e, 0x57, 0x91, 0xc2, 0x4e, 0x1b,
+	0xcd, 0x4d, 0x85, 0x5a, 0x35, 0x44, 0x28, 0xac, 0xd5, 0x3d, 0xc2, 0x7d, 0xd7, 0xc2, 0x70, 0x27,
+	0x3d, 0x3f, 0x76, 0x98, 0xb8, 0xe0, 0x41, 0x50, 0x7d, 0xdf, 0xb4, 0x5a, 0xd8, 0x84, 0xd4, 0xc2,
+	0xb6, 0x70, 0x17, 0x0c, 0x56, 0x90, 0x55, 0xae, 0xd0, 0x28, 0x1f, 0xe7, 0x13, 0x7e, 0xdd, 0x3b,
+	0x09, 0x2f, 0x41, 0xc0, 0x91, 0x8d, 0xfa, 0xe2, 0x7c, 0x62, 0x64, 0x3e, 0x26, 0xbb, 0x9e, 0x72,
+	0xc7, 0x53, 0x2e, 0x74, 0x3c, 0xd3, 0xa3, 0x47, 0xa7, 0x12, 0xb7, 0xfb, 0x53, 0xe2, 0xf7, 0xcf,
+	0x0f, 0x66, 0x78, 0x9d, 0x8d, 0x09, 0x11, 0x30, 0x50, 0xc7, 0x1f, 0x50, 0x23, 0xea, 0x67, 0xaa,
+	0xee, 0x41, 0x50, 0x41, 0xd8, 0xc4, 0x36, 0x41, 0x36, 0x69, 0x12, 0x03, 0x96, 0x4a, 0x0d, 0x44,
+	0x48, 0x34, 0x10, 0xe7, 0x13, 0xc3, 0xe9, 0xe8, 0xc9, 0x61, 0x32, 0xe2, 0xfd, 0xd4, 0x94, 0xfb,
+	0xcd, 0x1a, 0x6d, 0x58, 0x76, 0x59, 0x0f, 0x75, 0x47, 0x3c, 0x7c, 0x61, 0x6a, 0x67, 0x4f, 0xe2,
+	0x2e, 0xf6, 0x24, 0xee, 0xd3, 0xf9, 0xc1, 0x8c, 0xd7, 0x67, 0x92, 0x94, 0xb6, 0x94, 0xde, 0x64,
+	0x13, 0x5f, 0x7c, 0x20, 0xbc, 0xec, 0x64, 0xc9, 0x54, 0x2d, 0x64, 0xd3, 0x14, 0xa5, 0xd0, 0xdc,
+	0xfa, 0x1f, 0xf3, 0x0a, 0x12, 0x18, 0xa1, 0x98, 0xc2, 0xaa, 0xe1, 0x5a, 0x0c, 0x30, 0x0b, 0xc0,
+	0xa0, 0x55, 0x07, 0x59, 0x78, 0xd2, 0x5b, 0xc8, 0xc3, 0x9e, 0x42, 0xae, 0xe5, 0x9f, 0xf8, 0x1c,
+	0x00, 0x91, 0xde, 0x9a, 0xb2, 0xc8, 0xb4, 0x88, 0xb3, 0x08, 0x93, 0x60, 0xb4, 0xb3, 0x92, 0x46,
+	0x05, 0x92, 0x0a, 0xeb, 0x67, 0x58, 0x0f, 0x76, 0xc0, 0x25, 0x48, 0x2a, 0x42, 0xbe, 0x5f, 0x20,
+	0x1f, 0x0b, 0xf4, 0xe8, 0xe4, 0x30, 0x39, 0xee, 0x05, 0xca, 0x5c, 0x49, 0x70, 0x63, 0xb2, 0x3c,
+	0x08, 0xb7, 0x60, 0xd5, 0x2a, 0x41, 0x8a, 0x1b, 0x5d, 0x3d, 0xff, 0x35, 0xbd, 0xf5, 0x0e, 0xe7,
+	0x8a, 0x5e, 0xeb, 0x0a, 0xde, 0x73, 0xbb, 0x81, 0x4b, 0xb7, 0xbb, 0x08, 0x86, 0x70, 0x93, 0x9a,
+	0xb8, 0x86, 0x58, 0x7b, 0x63, 0xf3, 0xb3, 0xf2, 0x0d, 0x7f, 0x43, 0xb9, 0xb7, 0x1c, 0xcd, 0x9d,
+	0xd1, 0x3b, 0xc3, 0xc2, 0x5b, 0x30, 0x46, 0xaa, 0x90, 0x54, 0x8c, 0xcd, 0x06, 0x34, 0x1d, 0x46,
+	0x74, 0x30, 0xce, 0x27, 0x82, 0xe9, 0xe7, 0xce, 0x4e, 0xfc, 0x38, 0x95, 0x1e, 0xb8, 0xaa, 0xa4,
+	0xb4, 0x25, 0x5b, 0x58, 0xa9, 0x41, 0x5a, 0x91, 0x97, 0x51, 0x19, 0x9a, 0xdb, 0x59, 0x64, 0x9e,
+	0x1c, 0x26, 0x81, 0x67, 0x9a, 0x45, 0xa6, 0xbb, 0x3c, 0xa3, 0x4c, 0x6d, 0xd1, 0x13, 0x13, 0x96,
+	0x41, 0xf0, 0x1d, 0xb4, 0xaa, 0xa8, 0x64, 0x34, 0x6d, 0x6a, 0x55, 0xa3, 0x43, 0xff, 0xba, 0x8c,
+	0x23, 0xee, 0x78, 0xd1, 0x99, 0x16, 0xa6, 0xc1, 0xed, 0x06, 0xa6, 0x2c, 0x88, 0xe1, 0xb5, 0x72,
+	0x2b, 0xce, 0x27, 0x02, 0xfa, 0x58, 0x07, 0x5e, 0x62, 0xe8, 0xcc, 0x37, 0x1e, 0xdc, 0xe9, 0x13,
+	0x5b, 0x98, 0x02, 0x71, 0xf5, 0x75, 0x31, 0xb7, 0xae, 0x65, 0x52, 0x85, 0x9c, 0x96, 0x37, 0xb4,
+	0x62, 0x21, 0xa3, 0xad, 0xa8, 0x46, 0x31, 0xbf, 0xb6, 0xaa, 0x66, 0x72, 0x8b, 0x39, 0x35, 0x1b,
+	0xe2, 0x84, 0x49, 0x20, 0xf5, 0x65, 0x15, 0xb4, 0x95, 0xf4, 0x5a, 0x41, 0xcb, 0xab, 0xd9, 0x10,
+	0x2f, 0x4c, 0x83, 0xc9, 0xbe, 0x24, 0x5d, 0x2b, 0xb8, 0xc0, 0x2b, 0x3d, 0x95, 0x51, 0x43, 0x3e,
+	0xe1, 0x29, 0x98, 0xfd, 0x0b, 0xa2, 0xa1, 0xab, 0xab, 0x6a, 0xaa, 0xa0, 0x66, 0x43, 0xfe, 0x58,
+	0x60, 0xe7, 0xab, 0xc8, 0xa5, 0x5f, 0xec, 0xb7, 0x45, 0xfe, 0xa8, 0x2d, 0xf2, 0xc7, 0x6d, 0x91,
+	0xff, 0xd5, 0x16, 0xf9, 0xdd, 0x33, 0x91, 0x3b, 0x3e, 0x13, 0xb9, 0xef, 0x67, 0x22, 0xf7, 0x66,
+	0xfc, 0xd2, 0xbd, 0x7c, 0xfc, 0xf3, 0x92, 0xa6, 0xdb, 0x75, 0x44, 0x36, 0x06, 0x59, 0xb3, 0xcf,
+	0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x06, 0xa5, 0x12, 0xc4, 0x05, 0x00, 0x00,
 }
 
+func (this *EquivocationDecision) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EquivocationDecision)
+	if !ok {
+		that2, ok := that.(EquivocationDecision)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EvidenceHash != that1.EvidenceHash {
+		return false
+	}
+	if this.ConsensusAddress != that1.ConsensusAddress {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Outcome != that1.Outcome {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if !this.JailedUntil.Equal(that1.JailedUntil) {
+		return false
+	}
+	if this.RotationHeight != that1.RotationHeight {
+		return false
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -157,6 +422,136 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LightClientAttack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Power != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvidence(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EquivocationDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EquivocationDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EquivocationDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RotationHeight != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.RotationHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintEvidence(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Outcome != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvidenceHash) > 0 {
+		i -= len(m.EvidenceHash)
+		copy(dAtA[i:], m.EvidenceHash)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.EvidenceHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -189,6 +584,64 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *LightClientAttack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovEvidence(uint64(m.Power))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalPower))
+	}
+	return n
+}
+
+func (m *EquivocationDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvidenceHash)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovEvidence(uint64(m.Outcome))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.RotationHeight != 0 {
+		n += 1 + sovEvidence(uint64(m.RotationHeight))
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +801,447 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LightClientAttack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EquivocationDecision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EquivocationDecision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EquivocationDecision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= EquivocationOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationHeight", wireType)
+			}
+			m.RotationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RotationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -76,6 +76,51 @@ func TestEquivocationValidateBasic(t *testing.T) {
 	}
 }
 
+func TestLightClientAttackValidateBasic(t *testing.T) {
+	var zeroTime time.Time
+	addr, err := address.NewBech32Codec("cosmosvalcons").BytesToString(sdk.ConsAddress("foo_________________"))
+	require.NoError(t, err)
+
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	testCases := []struct {
+		name      string
+		e         types.LightClientAttack
+		expectErr bool
+	}{
+		{"valid", types.LightClientAttack{100, n, 1000000, addr, 3000000}, false},
+		{"invalid time", types.LightClientAttack{100, zeroTime, 1000000, addr, 3000000}, true},
+		{"invalid height", types.LightClientAttack{0, n, 1000000, addr, 3000000}, true},
+		{"invalid power", types.LightClientAttack{100, n, 0, addr, 3000000}, true},
+		{"invalid total power", types.LightClientAttack{100, n, 1000000, addr, 999999}, true},
+		{"invalid address", types.LightClientAttack{100, n, 1000000, "", 3000000}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestLightClientAttackFromABCI(t *testing.T) {
+	consCodec := address.NewBech32Codec("cosmosvalcons")
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	tmEvidence := NewCometMisbehavior(100, 3000000, n, comet.LightClientAttack,
+		comet.Validator{Address: []byte("foo_________________"), Power: 1000000})
+
+	e := types.FromABCILightClientAttack(tmEvidence, consCodec)
+	require.NoError(t, e.ValidateBasic())
+	require.Equal(t, tmEvidence.Validator.Address, e.GetConsensusAddress(consCodec).Bytes())
+	require.Equal(t, int64(100), e.GetHeight())
+	require.Equal(t, n, e.GetTime())
+	require.Equal(t, int64(1000000), e.GetValidatorPower())
+	require.Equal(t, int64(3000000), e.GetTotalPower())
+	require.Equal(t, types.RouteLightClientAttack, e.Route())
+
+	// the hash differs from the one of a duplicate vote with the same fields
+	require.NotEqual(t, types.FromABCIEvidence(tmEvidence, consCodec).Hash(), e.Hash())
+}
+
 func TestEvidenceAddressConversion(t *testing.T) {
 	sdk.GetConfig().SetBech32PrefixForConsensusNode("testcnclcons", "testcnclconspub")
 	tmEvidence := NewCometMisbehavior(1, 100, time.Now(), comet.DuplicateVote,
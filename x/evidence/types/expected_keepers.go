@@ -5,7 +5,9 @@ import (
 	"time"
 
 	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +17,9 @@ import (
 // evidence module.
 type StakingKeeper interface {
 	ValidatorByConsAddr(context.Context, sdk.ConsAddress) (sdk.ValidatorI, error)
+	ValidatorAddressCodec() address.Codec
+	GetValidatorConsPubKeyRotationHistory(context.Context, sdk.ValAddress) ([]stakingtypes.ConsPubKeyRotationHistory, error)
+	PowerReduction(context.Context) math.Int
 }
 
 // SlashingKeeper defines the slashing module interface contract needed by the
@@ -27,6 +32,9 @@ type SlashingKeeper interface {
 	Slash(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64) error
 	SlashWithInfractionReason(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64, st.Infraction) error
 	SlashFractionDoubleSign(context.Context) (math.LegacyDec, error)
+	DoubleSignRotationGraceBlocks(context.Context) (int64, error)
+	SlashFractionDoubleSignRotation(context.Context) (math.LegacyDec, error)
+	DoubleSignRotationJailDuration(context.Context) (time.Duration, error)
 	Jail(context.Context, sdk.ConsAddress) error
 	JailUntil(context.Context, sdk.ConsAddress, time.Time) error
 }
@@ -1,12 +1,14 @@
 package types
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 
 	"github.com/cosmos/gogoproto/proto"
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/evidence/exported"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -53,6 +55,24 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	decisions := make(map[string]bool, len(gs.Decisions))
+	for _, decision := range gs.Decisions {
+		if _, err := hex.DecodeString(decision.EvidenceHash); err != nil || decision.EvidenceHash == "" {
+			return fmt.Errorf("invalid evidence hash of equivocation decision: %q", decision.EvidenceHash)
+		}
+		if decisions[decision.EvidenceHash] {
+			return fmt.Errorf("duplicate equivocation decision of evidence %s", decision.EvidenceHash)
+		}
+		decisions[decision.EvidenceHash] = true
+
+		if decision.Outcome == EQUIVOCATION_OUTCOME_UNSPECIFIED {
+			return fmt.Errorf("unspecified outcome of equivocation decision of evidence %s", decision.EvidenceHash)
+		}
+		if decision.SlashFraction.IsNil() || decision.SlashFraction.IsNegative() || decision.SlashFraction.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("invalid slash fraction of equivocation decision of evidence %s: %s", decision.EvidenceHash, decision.SlashFraction)
+		}
+	}
+
 	return nil
 }
 
@@ -8,6 +8,7 @@ import (
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
@@ -109,6 +110,58 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid equivocation decisions",
+			func() {
+				genesisState = types.DefaultGenesisState()
+				genesisState.Decisions = []types.EquivocationDecision{
+					{EvidenceHash: "0A", Outcome: types.EQUIVOCATION_OUTCOME_TOMBSTONED, SlashFraction: math.LegacyNewDecWithPrec(5, 2)},
+					{EvidenceHash: "0B", Outcome: types.EQUIVOCATION_OUTCOME_ROTATION_GRACE, SlashFraction: math.LegacyNewDecWithPrec(1, 2), RotationHeight: 10},
+				}
+			},
+			true,
+		},
+		{
+			"duplicate equivocation decisions",
+			func() {
+				genesisState = types.DefaultGenesisState()
+				genesisState.Decisions = []types.EquivocationDecision{
+					{EvidenceHash: "0A", Outcome: types.EQUIVOCATION_OUTCOME_TOMBSTONED, SlashFraction: math.LegacyNewDecWithPrec(5, 2)},
+					{EvidenceHash: "0A", Outcome: types.EQUIVOCATION_OUTCOME_TOMBSTONED, SlashFraction: math.LegacyNewDecWithPrec(5, 2)},
+				}
+			},
+			false,
+		},
+		{
+			"equivocation decision with invalid evidence hash",
+			func() {
+				genesisState = types.DefaultGenesisState()
+				genesisState.Decisions = []types.EquivocationDecision{
+					{EvidenceHash: "invalid", Outcome: types.EQUIVOCATION_OUTCOME_TOMBSTONED, SlashFraction: math.LegacyNewDecWithPrec(5, 2)},
+				}
+			},
+			false,
+		},
+		{
+			"equivocation decision with unspecified outcome",
+			func() {
+				genesisState = types.DefaultGenesisState()
+				genesisState.Decisions = []types.EquivocationDecision{
+					{EvidenceHash: "0A", SlashFraction: math.LegacyNewDecWithPrec(5, 2)},
+				}
+			},
+			false,
+		},
+		{
+			"equivocation decision with invalid slash fraction",
+			func() {
+				genesisState = types.DefaultGenesisState()
+				genesisState.Decisions = []types.EquivocationDecision{
+					{EvidenceHash: "0A", Outcome: types.EQUIVOCATION_OUTCOME_TOMBSTONED, SlashFraction: math.LegacyNewDec(2)},
+				}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
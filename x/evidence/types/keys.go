@@ -12,5 +12,7 @@ const (
 
 // KVStore key prefixes
 var (
-	KeyPrefixEvidence = collections.NewPrefix(0)
+	KeyPrefixEvidence             = collections.NewPrefix(0)
+	KeyPrefixEquivocationDecision = collections.NewPrefix(1)
+	KeyPrefixGracedRotation       = collections.NewPrefix(2)
 )
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// MisbehaviorPolicy defines how the validators committing an app-defined
+// misbehavior, e.g. an oracle double reporting, are punished by the Handler
+// returned by the keeper's NewMisbehaviorHandler.
+type MisbehaviorPolicy struct {
+	// Verify verifies the evidence of misbehavior against the application state,
+	// e.g. the signatures of the conflicting reports by the validator. It is
+	// required, since anyone can submit evidence and its ValidateBasic cannot
+	// check it against the validator state.
+	Verify Handler
+
+	// SlashFraction is the fraction of the validator stake slashed for the
+	// misbehavior.
+	SlashFraction math.LegacyDec
+
+	// JailDuration is the duration the validator is jailed for. A zero duration
+	// does not jail the validator.
+	JailDuration time.Duration
+
+	// Tombstone jails the validator forever and tombstones it, regardless of
+	// JailDuration.
+	Tombstone bool
+}
+
+// Validate performs basic validation of the misbehavior policy.
+func (p MisbehaviorPolicy) Validate() error {
+	if p.Verify == nil {
+		return errors.New("misbehavior policy must verify the evidence")
+	}
+	if p.SlashFraction.IsNil() || p.SlashFraction.IsNegative() || p.SlashFraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("misbehavior slash fraction must be between 0 and 1: %s", p.SlashFraction)
+	}
+	if p.JailDuration < 0 {
+		return fmt.Errorf("misbehavior jail duration cannot be negative: %s", p.JailDuration)
+	}
+
+	return nil
+}
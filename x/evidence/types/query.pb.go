@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
@@ -237,11 +239,212 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryEquivocationDecisionRequest is the request type for the Query/EquivocationDecision RPC
+// method.
+type QueryEquivocationDecisionRequest struct {
+	// hash defines the HEX encoded hash of the evidence of the requested decision.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *QueryEquivocationDecisionRequest) Reset()         { *m = QueryEquivocationDecisionRequest{} }
+func (m *QueryEquivocationDecisionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEquivocationDecisionRequest) ProtoMessage()    {}
+func (*QueryEquivocationDecisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryEquivocationDecisionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEquivocationDecisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEquivocationDecisionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEquivocationDecisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEquivocationDecisionRequest.Merge(m, src)
+}
+func (m *QueryEquivocationDecisionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEquivocationDecisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEquivocationDecisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEquivocationDecisionRequest proto.InternalMessageInfo
+
+func (m *QueryEquivocationDecisionRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// QueryEquivocationDecisionResponse is the response type for the Query/EquivocationDecision RPC
+// method.
+type QueryEquivocationDecisionResponse struct {
+	// decision returns the requested equivocation decision.
+	Decision EquivocationDecision `protobuf:"bytes,1,opt,name=decision,proto3" json:"decision"`
+}
+
+func (m *QueryEquivocationDecisionResponse) Reset()         { *m = QueryEquivocationDecisionResponse{} }
+func (m *QueryEquivocationDecisionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEquivocationDecisionResponse) ProtoMessage()    {}
+func (*QueryEquivocationDecisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryEquivocationDecisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEquivocationDecisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEquivocationDecisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEquivocationDecisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEquivocationDecisionResponse.Merge(m, src)
+}
+func (m *QueryEquivocationDecisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEquivocationDecisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEquivocationDecisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEquivocationDecisionResponse proto.InternalMessageInfo
+
+func (m *QueryEquivocationDecisionResponse) GetDecision() EquivocationDecision {
+	if m != nil {
+		return m.Decision
+	}
+	return EquivocationDecision{}
+}
+
+// QueryEquivocationDecisionsRequest is the request type for the Query/EquivocationDecisions RPC
+// method.
+type QueryEquivocationDecisionsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEquivocationDecisionsRequest) Reset()         { *m = QueryEquivocationDecisionsRequest{} }
+func (m *QueryEquivocationDecisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEquivocationDecisionsRequest) ProtoMessage()    {}
+func (*QueryEquivocationDecisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{6}
+}
+func (m *QueryEquivocationDecisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEquivocationDecisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEquivocationDecisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEquivocationDecisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEquivocationDecisionsRequest.Merge(m, src)
+}
+func (m *QueryEquivocationDecisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEquivocationDecisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEquivocationDecisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEquivocationDecisionsRequest proto.InternalMessageInfo
+
+func (m *QueryEquivocationDecisionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEquivocationDecisionsResponse is the response type for the Query/EquivocationDecisions RPC
+// method.
+type QueryEquivocationDecisionsResponse struct {
+	// decisions returns all equivocation decisions.
+	Decisions []EquivocationDecision `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEquivocationDecisionsResponse) Reset()         { *m = QueryEquivocationDecisionsResponse{} }
+func (m *QueryEquivocationDecisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEquivocationDecisionsResponse) ProtoMessage()    {}
+func (*QueryEquivocationDecisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{7}
+}
+func (m *QueryEquivocationDecisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEquivocationDecisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEquivocationDecisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEquivocationDecisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEquivocationDecisionsResponse.Merge(m, src)
+}
+func (m *QueryEquivocationDecisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEquivocationDecisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEquivocationDecisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEquivocationDecisionsResponse proto.InternalMessageInfo
+
+func (m *QueryEquivocationDecisionsResponse) GetDecisions() []EquivocationDecision {
+	if m != nil {
+		return m.Decisions
+	}
+	return nil
+}
+
+func (m *QueryEquivocationDecisionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryEquivocationDecisionRequest)(nil), "cosmos.evidence.v1beta1.QueryEquivocationDecisionRequest")
+	proto.RegisterType((*QueryEquivocationDecisionResponse)(nil), "cosmos.evidence.v1beta1.QueryEquivocationDecisionResponse")
+	proto.RegisterType((*QueryEquivocationDecisionsRequest)(nil), "cosmos.evidence.v1beta1.QueryEquivocationDecisionsRequest")
+	proto.RegisterType((*QueryEquivocationDecisionsResponse)(nil), "cosmos.evidence.v1beta1.QueryEquivocationDecisionsResponse")
 }
 
 func init() {
@@ -249,36 +452,48 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcd, 0xae, 0xd2, 0x40,
-	0x14, 0xc7, 0x99, 0xfa, 0x91, 0xeb, 0xdc, 0x6b, 0x4c, 0xc6, 0x6b, 0x2e, 0xb7, 0xd1, 0x06, 0x4b,
-	0x22, 0x48, 0xc2, 0x4c, 0x41, 0x13, 0xd6, 0x90, 0xf8, 0xb5, 0xd3, 0x2e, 0xdd, 0x90, 0x29, 0x8c,
-	0x6d, 0x03, 0xce, 0x14, 0xa6, 0x10, 0x89, 0x71, 0xe3, 0x13, 0x98, 0x18, 0x57, 0xc6, 0xb7, 0xf0,
-	0x15, 0x4c, 0x5c, 0x92, 0xb8, 0x31, 0xae, 0x0c, 0xf8, 0x20, 0x86, 0x99, 0x29, 0x20, 0x1f, 0xa2,
-	0xcb, 0xe9, 0xf9, 0x9f, 0xff, 0xff, 0xd7, 0x73, 0x0e, 0x2c, 0x76, 0x84, 0x7c, 0x29, 0x24, 0x61,
-	0xe3, 0xb8, 0xcb, 0x78, 0x87, 0x91, 0x71, 0x2d, 0x60, 0x29, 0xad, 0x91, 0xc1, 0x88, 0x0d, 0x27,
-	0x38, 0x19, 0x8a, 0x54, 0xa0, 0x33, 0x2d, 0xc2, 0x99, 0x08, 0x1b, 0x91, 0x7d, 0xae, 0x0b, 0x6d,
-	0x25, 0x23, 0x46, 0xa5, 0x1e, 0x76, 0xc5, 0x18, 0x07, 0x54, 0x32, 0x6d, 0xb6, 0xb4, 0x4e, 0x68,
-	0x18, 0x73, 0x9a, 0xc6, 0x82, 0x1b, 0xed, 0x79, 0x28, 0x44, 0xd8, 0x67, 0x44, 0xbd, 0x82, 0xd1,
-	0x0b, 0x42, 0xb9, 0x89, 0xb6, 0x6f, 0x9a, 0x12, 0x4d, 0x62, 0x42, 0x39, 0x17, 0xa9, 0xea, 0x33,
-	0x21, 0x6e, 0x04, 0x4f, 0x9f, 0x2d, 0xac, 0x1f, 0x18, 0x30, 0x9f, 0x0d, 0x46, 0x4c, 0xa6, 0xa8,
-	0x04, 0xaf, 0x66, 0xac, 0xed, 0x88, 0xca, 0x28, 0x0f, 0x0a, 0xa0, 0x7c, 0xd2, 0xb2, 0xf2, 0xc0,
-	0x3f, 0xc9, 0x0a, 0x8f, 0xa9, 0x8c, 0x50, 0x09, 0x5e, 0x54, 0x75, 0xab, 0x00, 0xca, 0x57, 0x5a,
-	0xd7, 0x7f, 0x7c, 0xae, 0x5e, 0xd3, 0xdc, 0x55, 0xd9, 0xed, 0x15, 0x3c, 0x7c, 0xbf, 0xe1, 0x2b,
-	0x81, 0xfb, 0x04, 0xde, 0xd8, 0x48, 0x92, 0x89, 0xe0, 0x92, 0x21, 0x0f, 0x1e, 0x65, 0x8e, 0x2a,
-	0xe5, 0xb8, 0x7e, 0x8a, 0x35, 0x33, 0xce, 0x7e, 0x07, 0x37, 0xf9, 0xc4, 0x5f, 0xaa, 0x5c, 0x0a,
-	0xcf, 0x94, 0x55, 0xb3, 0xdf, 0xdf, 0xe4, 0x7e, 0x08, 0xe1, 0x6a, 0x38, 0xc6, 0xee, 0x0e, 0x36,
-	0x73, 0x5d, 0x4c, 0x12, 0xeb, 0xb5, 0x98, 0x49, 0xe2, 0xa7, 0x34, 0xcc, 0x7a, 0xfd, 0xb5, 0x4e,
-	0xf7, 0x03, 0x80, 0xf9, 0xed, 0x8c, 0x9d, 0xc4, 0x17, 0x0e, 0x13, 0xa3, 0x47, 0x7f, 0x60, 0x59,
-	0x0a, 0xab, 0x74, 0x10, 0x4b, 0xc7, 0xad, 0x73, 0xd5, 0xbf, 0x58, 0xf0, 0x92, 0xe2, 0x42, 0x1f,
-	0x01, 0x3c, 0xca, 0xc8, 0x50, 0x15, 0xef, 0x39, 0x30, 0xbc, 0x6b, 0xbb, 0x36, 0xfe, 0x57, 0xb9,
-	0x26, 0x70, 0xbd, 0xb7, 0xdf, 0x7e, 0xbd, 0xb7, 0x2a, 0xa8, 0x4c, 0xf6, 0x1d, 0xfb, 0xf2, 0xc3,
-	0xeb, 0xc5, 0xb2, 0xdf, 0xa0, 0x4f, 0x00, 0x1e, 0xaf, 0x8d, 0x0e, 0x79, 0x7f, 0x4f, 0xdc, 0xde,
-	0xa4, 0x5d, 0xfb, 0x8f, 0x0e, 0x83, 0x79, 0x57, 0x61, 0x16, 0xd1, 0xed, 0x83, 0x98, 0xad, 0xc6,
-	0xd7, 0x99, 0x03, 0xa6, 0x33, 0x07, 0xfc, 0x9c, 0x39, 0xe0, 0xdd, 0xdc, 0xc9, 0x4d, 0xe7, 0x4e,
-	0xee, 0xfb, 0xdc, 0xc9, 0x3d, 0xbf, 0xa5, 0x7b, 0x65, 0xb7, 0x87, 0x63, 0x41, 0x5e, 0xad, 0x3c,
-	0xd2, 0x49, 0xc2, 0x64, 0x70, 0x59, 0x6d, 0xf8, 0xde, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76,
-	0x21, 0xac, 0x55, 0xf7, 0x03, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6b, 0x53, 0x4d,
+	0x14, 0xce, 0xe4, 0x7d, 0x2b, 0xc9, 0xb4, 0x22, 0x8e, 0x29, 0x4d, 0x2f, 0x7a, 0x4d, 0x6f, 0xa1,
+	0x89, 0x81, 0xcc, 0x24, 0x55, 0x1a, 0x3f, 0x40, 0x68, 0xf0, 0x73, 0xa7, 0x17, 0x71, 0xe1, 0x26,
+	0x4c, 0x92, 0xf1, 0xe6, 0x92, 0xf4, 0x4e, 0xd2, 0xb9, 0x09, 0x06, 0x71, 0xe3, 0x2f, 0x10, 0xc4,
+	0x95, 0xb8, 0x77, 0xe9, 0xc2, 0xbf, 0x20, 0xd4, 0x85, 0x50, 0x70, 0x23, 0x2e, 0x44, 0x13, 0xc1,
+	0xbf, 0x21, 0x99, 0x3b, 0x93, 0xa4, 0x6d, 0x3e, 0x6c, 0xe8, 0x26, 0xe4, 0x9e, 0x79, 0xce, 0xf3,
+	0x3c, 0xe7, 0x9c, 0x39, 0x03, 0xd7, 0xcb, 0x5c, 0xec, 0x70, 0x41, 0x58, 0xdb, 0xad, 0x30, 0xaf,
+	0xcc, 0x48, 0x3b, 0x57, 0x62, 0x3e, 0xcd, 0x91, 0x66, 0x8b, 0xed, 0x76, 0x70, 0x63, 0x97, 0xfb,
+	0x1c, 0xad, 0x04, 0x20, 0xac, 0x41, 0x58, 0x81, 0x8c, 0xd5, 0xe0, 0xa0, 0x28, 0x61, 0x44, 0xa1,
+	0xe4, 0x87, 0x91, 0x56, 0xc4, 0x25, 0x2a, 0x58, 0x40, 0x36, 0xa0, 0x6e, 0x50, 0xc7, 0xf5, 0xa8,
+	0xef, 0x72, 0x4f, 0x61, 0x57, 0x1d, 0xce, 0x9d, 0x3a, 0x23, 0xf2, 0xab, 0xd4, 0x7a, 0x4a, 0xa8,
+	0xa7, 0xa4, 0x8d, 0xf3, 0xea, 0x88, 0x36, 0x5c, 0x42, 0x3d, 0x8f, 0xfb, 0x32, 0x4f, 0x8b, 0xc4,
+	0x1c, 0xee, 0xf0, 0x40, 0xbc, 0xff, 0x4f, 0x45, 0xcf, 0xd2, 0x1d, 0xd7, 0xe3, 0x44, 0xfe, 0xaa,
+	0xd0, 0xc6, 0xa4, 0x32, 0x07, 0x25, 0x49, 0x9c, 0x55, 0x85, 0xb1, 0x87, 0x7d, 0xaf, 0xb7, 0x55,
+	0xd8, 0x66, 0xcd, 0x16, 0x13, 0x3e, 0x4a, 0xc2, 0xd3, 0x1a, 0x59, 0xac, 0x52, 0x51, 0x8d, 0x83,
+	0x04, 0x48, 0x2d, 0x15, 0xc2, 0x71, 0x60, 0x2f, 0xe9, 0x83, 0x7b, 0x54, 0x54, 0x51, 0x12, 0xfe,
+	0x2f, 0xcf, 0xc3, 0x09, 0x90, 0x8a, 0x16, 0xce, 0x7d, 0xff, 0x98, 0x39, 0x13, 0x48, 0x67, 0x44,
+	0xa5, 0x96, 0xc8, 0xe2, 0x2b, 0x79, 0x5b, 0x02, 0xac, 0xfb, 0x70, 0xf9, 0x90, 0x92, 0x68, 0x70,
+	0x4f, 0x30, 0x94, 0x85, 0x11, 0xcd, 0x28, 0x55, 0x16, 0x37, 0x63, 0x38, 0x68, 0x02, 0xd6, 0xfd,
+	0xc1, 0xdb, 0x5e, 0xc7, 0x1e, 0xa0, 0x2c, 0x0a, 0x57, 0x24, 0xd5, 0x76, 0xbd, 0x7e, 0xd8, 0xf7,
+	0x1d, 0x08, 0x87, 0xdd, 0x56, 0x74, 0x1b, 0x58, 0x0d, 0xaa, 0x3f, 0x1a, 0x1c, 0xcc, 0x59, 0xb5,
+	0x03, 0x3f, 0xa0, 0x8e, 0xce, 0xb5, 0x47, 0x32, 0xad, 0x37, 0x00, 0xc6, 0x8f, 0x6a, 0x8c, 0x75,
+	0xfc, 0xdf, 0x6c, 0xc7, 0xe8, 0xee, 0x01, 0x5b, 0x61, 0x69, 0x2b, 0x39, 0xd3, 0x56, 0x20, 0x77,
+	0xc0, 0xd7, 0x16, 0x4c, 0x04, 0x5d, 0x6c, 0xb6, 0xdc, 0x36, 0x2f, 0xcb, 0xe0, 0x2d, 0x56, 0x76,
+	0x85, 0xcb, 0x3d, 0xdd, 0x03, 0xa4, 0x46, 0xd2, 0xaf, 0x3e, 0xaa, 0xba, 0xdf, 0x81, 0x6b, 0x53,
+	0xf2, 0x54, 0x5d, 0x8f, 0x60, 0xa4, 0xa2, 0x62, 0xaa, 0x75, 0x19, 0x3c, 0x61, 0x13, 0xf0, 0x38,
+	0xa2, 0x42, 0x74, 0xef, 0xc7, 0xc5, 0xd0, 0xfb, 0x3f, 0x1f, 0xd2, 0xc0, 0x1e, 0x30, 0x59, 0xb5,
+	0x29, 0xd2, 0xe2, 0xa4, 0xe7, 0xf6, 0x09, 0x40, 0x6b, 0x9a, 0x9a, 0xaa, 0xf4, 0x31, 0x8c, 0x6a,
+	0x7f, 0x42, 0x8d, 0x70, 0xfe, 0x52, 0x87, 0x54, 0x27, 0x36, 0xe7, 0xcd, 0x5f, 0x0b, 0x70, 0x41,
+	0xd6, 0x81, 0xde, 0x02, 0x18, 0xd1, 0x37, 0x10, 0x4d, 0x36, 0x39, 0x6e, 0x8b, 0x0d, 0xfc, 0xaf,
+	0xf0, 0xc0, 0x81, 0x95, 0x7d, 0xf9, 0xf5, 0xf7, 0xeb, 0x70, 0x1a, 0xa5, 0xc8, 0xac, 0xe7, 0x83,
+	0x3c, 0xef, 0x5f, 0xab, 0x17, 0xe8, 0x1d, 0x80, 0x8b, 0x23, 0x2b, 0x82, 0xb2, 0xd3, 0x15, 0x8f,
+	0x6e, 0xac, 0x91, 0x3b, 0x46, 0x86, 0xb2, 0x79, 0x49, 0xda, 0x5c, 0x47, 0x6b, 0x33, 0x6d, 0xa2,
+	0x2f, 0x00, 0xc6, 0xc6, 0xcd, 0x0f, 0x5d, 0x9b, 0xd1, 0x9a, 0xc9, 0xfb, 0x65, 0x5c, 0x9f, 0x27,
+	0x55, 0x59, 0xbf, 0x29, 0xad, 0x5f, 0x45, 0x5b, 0x93, 0xad, 0x8f, 0xa4, 0x17, 0x07, 0x37, 0x4b,
+	0xf7, 0xfb, 0x33, 0x80, 0xcb, 0x63, 0xaf, 0x36, 0x9a, 0xc3, 0x95, 0xde, 0x3e, 0xe3, 0xc6, 0x5c,
+	0xb9, 0xaa, 0xa4, 0xbc, 0x2c, 0x29, 0x87, 0xc8, 0x31, 0x4b, 0x2a, 0xe4, 0xf7, 0xba, 0x26, 0xd8,
+	0xef, 0x9a, 0xe0, 0x67, 0xd7, 0x04, 0xaf, 0x7a, 0x66, 0x68, 0xbf, 0x67, 0x86, 0xbe, 0xf5, 0xcc,
+	0xd0, 0x93, 0x0b, 0x01, 0x93, 0xa8, 0xd4, 0xb0, 0xcb, 0xc9, 0xb3, 0x21, 0xa3, 0xdf, 0x69, 0x30,
+	0x51, 0x3a, 0x25, 0x5f, 0xd9, 0xcb, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xae, 0xc5, 0xb8, 0xbd,
+	0xcc, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -297,6 +512,10 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// EquivocationDecision queries the equivocation decision of an evidence based on evidence hash.
+	EquivocationDecision(ctx context.Context, in *QueryEquivocationDecisionRequest, opts ...grpc.CallOption) (*QueryEquivocationDecisionResponse, error)
+	// EquivocationDecisions queries all equivocation decisions.
+	EquivocationDecisions(ctx context.Context, in *QueryEquivocationDecisionsRequest, opts ...grpc.CallOption) (*QueryEquivocationDecisionsResponse, error)
 }
 
 type queryClient struct {
@@ -325,12 +544,34 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) EquivocationDecision(ctx context.Context, in *QueryEquivocationDecisionRequest, opts ...grpc.CallOption) (*QueryEquivocationDecisionResponse, error) {
+	out := new(QueryEquivocationDecisionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/EquivocationDecision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EquivocationDecisions(ctx context.Context, in *QueryEquivocationDecisionsRequest, opts ...grpc.CallOption) (*QueryEquivocationDecisionsResponse, error) {
+	out := new(QueryEquivocationDecisionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/EquivocationDecisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// EquivocationDecision queries the equivocation decision of an evidence based on evidence hash.
+	EquivocationDecision(context.Context, *QueryEquivocationDecisionRequest) (*QueryEquivocationDecisionResponse, error)
+	// EquivocationDecisions queries all equivocation decisions.
+	EquivocationDecisions(context.Context, *QueryEquivocationDecisionsRequest) (*QueryEquivocationDecisionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -343,6 +584,12 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) EquivocationDecision(ctx context.Context, req *QueryEquivocationDecisionRequest) (*QueryEquivocationDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EquivocationDecision not implemented")
+}
+func (*UnimplementedQueryServer) EquivocationDecisions(ctx context.Context, req *QueryEquivocationDecisionsRequest) (*QueryEquivocationDecisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EquivocationDecisions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -384,6 +631,42 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EquivocationDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEquivocationDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EquivocationDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/EquivocationDecision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EquivocationDecision(ctx, req.(*QueryEquivocationDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EquivocationDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEquivocationDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EquivocationDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/EquivocationDecisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EquivocationDecisions(ctx, req.(*QueryEquivocationDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
@@ -397,6 +680,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "EquivocationDecision",
+			Handler:    _Query_EquivocationDecision_Handler,
+		},
+		{
+			MethodName: "EquivocationDecisions",
+			Handler:    _Query_EquivocationDecisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -558,86 +849,577 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryEquivocationDecisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryEvidenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryEquivocationDecisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEquivocationDecisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EvidenceHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEquivocationDecisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryEvidenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEquivocationDecisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEquivocationDecisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Decision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEquivocationDecisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEquivocationDecisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEquivocationDecisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Evidence != nil {
-		l = m.Evidence.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEquivocationDecisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEquivocationDecisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEquivocationDecisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Decisions) > 0 {
+		for iNdEx := len(m.Decisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Decisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvidenceHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEquivocationDecisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEquivocationDecisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Decision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEquivocationDecisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEquivocationDecisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Decisions) > 0 {
+		for _, e := range m.Decisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceHash = append(m.EvidenceHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvidenceHash == nil {
+				m.EvidenceHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &any.Any{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryAllEvidenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryAllEvidenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Evidence) > 0 {
-		for _, e := range m.Evidence {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllEvidenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEvidenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -660,17 +1442,17 @@ func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEvidenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -680,27 +1462,113 @@ func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvidenceHash = append(m.EvidenceHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvidenceHash == nil {
-				m.EvidenceHash = []byte{}
+			m.Evidence = append(m.Evidence, &any.Any{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEquivocationDecisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEquivocationDecisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEquivocationDecisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
@@ -753,7 +1621,7 @@ func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEquivocationDecisionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -776,15 +1644,15 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEvidenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEquivocationDecisionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEquivocationDecisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -811,10 +1679,7 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Evidence == nil {
-				m.Evidence = &any.Any{}
-			}
-			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Decision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -839,7 +1704,7 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEvidenceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEquivocationDecisionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -862,10 +1727,10 @@ func (m *QueryAllEvidenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEvidenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEquivocationDecisionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEquivocationDecisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -925,7 +1790,7 @@ func (m *QueryAllEvidenceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEquivocationDecisionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -948,15 +1813,15 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEvidenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEquivocationDecisionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEquivocationDecisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decisions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -983,8 +1848,8 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Evidence = append(m.Evidence, &any.Any{})
-			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Decisions = append(m.Decisions, EquivocationDecision{})
+			if err := m.Decisions[len(m.Decisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
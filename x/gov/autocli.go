@@ -94,6 +94,36 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "constitution",
 					Short:     "Query the current chain constitution",
 				},
+				{
+					RpcMethod:      "VoteDelegation",
+					Use:            "vote-delegation <delegator-addr>",
+					Short:          "Query the account a delegator delegates its vote to",
+					Example:        fmt.Sprintf("%s query gov vote-delegation cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator"}},
+				},
+				{
+					RpcMethod:      "VoteDelegations",
+					Use:            "vote-delegations <delegatee-addr>",
+					Short:          "Query the vote delegations made directly to an account",
+					Example:        fmt.Sprintf("%s query gov vote-delegations cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegatee"}},
+				},
+				{
+					RpcMethod:      "VoteDelegationTree",
+					Use:            "vote-delegation-tree <delegatee-addr>",
+					Short:          "Query all the vote delegations made directly or transitively to an account",
+					Example:        fmt.Sprintf("%s query gov vote-delegation-tree cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegatee"}},
+				},
+				{
+					RpcMethod: "SimulateProposal",
+					Use:       "simulate-proposal [msg-json-file...]",
+					Short:     "Simulate the execution of proposal messages, or of an existing proposal, without committing any state change",
+					Example:   fmt.Sprintf("$ %s query gov simulate-proposal msg.json\n $ %[1]s query gov simulate-proposal --proposal-id 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "messages", Varargs: true},
+					},
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -134,6 +164,20 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						"metadata": {Name: "metadata", Usage: "Add a description to the vote"},
 					},
 				},
+				{
+					RpcMethod:      "DelegateVote",
+					Use:            "delegate-vote <delegatee>",
+					Short:          "Delegate your governance voting power to another account",
+					Long:           "Delegate your governance voting power to another account. The delegatee votes on your behalf on every proposal you do not vote on yourself.",
+					Example:        fmt.Sprintf("$ %s tx gov delegate-vote cosmos1... --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegatee"}},
+				},
+				{
+					RpcMethod: "UndelegateVote",
+					Use:       "undelegate-vote",
+					Short:     "Remove the delegation of your governance voting power",
+					Example:   fmt.Sprintf("$ %s tx gov undelegate-vote --from mykey", version.AppName),
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal <params>",
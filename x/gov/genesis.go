@@ -73,6 +73,23 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		}
 	}
 
+	for _, delegation := range data.VoteDelegations {
+		delegator, err := ak.AddressCodec().StringToBytes(delegation.Delegator)
+		if err != nil {
+			return err
+		}
+		delegatee, err := ak.AddressCodec().StringToBytes(delegation.Delegatee)
+		if err != nil {
+			return err
+		}
+		if err := k.VoteDelegations.Set(ctx, delegator, delegatee); err != nil {
+			return err
+		}
+		if err := k.VoteDelegators.Set(ctx, collections.Join(sdk.AccAddress(delegatee), sdk.AccAddress(delegator))); err != nil {
+			return err
+		}
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -131,6 +148,11 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		return nil, err
 	}
 
+	voteDelegations, err := k.GetVoteDelegations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
 		StartingProposalId: startingProposalID,
 		Deposits:           proposalsDeposits,
@@ -138,5 +160,6 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		Proposals:          proposals,
 		Params:             &params,
 		Constitution:       constitution,
+		VoteDelegations:    voteDelegations,
 	}, nil
 }
@@ -106,6 +106,7 @@ func mockDefaultExpectations(ctx sdk.Context, m mocks) error {
 	m.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).AnyTimes()
 	m.stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	m.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(10000000), nil).AnyTimes()
+	m.stakingKeeper.EXPECT().GetEffectiveBondedTokens(gomock.Any()).Return(nil, nil).AnyTimes()
 	return nil
 }
 
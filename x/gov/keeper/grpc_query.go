@@ -28,6 +28,9 @@ var (
 		OptionFour:  "no_with_veto",
 		OptionSpam:  "spam",
 	}
+
+	// errVoteDelegationTreePageFull stops the walk of the vote delegation tree once a page is full.
+	errVoteDelegationTreePageFull = stderrors.New("vote delegation tree page is full")
 )
 
 type queryServer struct{ k *Keeper }
@@ -334,6 +337,190 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// VoteDelegation returns the vote delegation of an account
+func (q queryServer) VoteDelegation(ctx context.Context, req *v1.QueryVoteDelegationRequest) (*v1.QueryVoteDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Delegator == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegator, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatee, err := q.k.VoteDelegations.Get(ctx, delegator)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vote delegation of %s doesn't exist", req.Delegator)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	delegateeStrAddr, err := q.k.authKeeper.AddressCodec().BytesToString(delegatee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryVoteDelegationResponse{VoteDelegation: &v1.VoteDelegation{Delegator: req.Delegator, Delegatee: delegateeStrAddr}}, nil
+}
+
+// VoteDelegations returns the vote delegations made directly to an account
+func (q queryServer) VoteDelegations(ctx context.Context, req *v1.QueryVoteDelegationsRequest) (*v1.QueryVoteDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Delegatee == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegatee address")
+	}
+
+	delegatee, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Delegatee)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, pageRes, err := query.CollectionPaginate(ctx, q.k.VoteDelegators, req.Pagination, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ collections.NoValue) (*v1.VoteDelegation, error) {
+		delegator, err := q.k.authKeeper.AddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return nil, err
+		}
+		return &v1.VoteDelegation{Delegator: delegator, Delegatee: req.Delegatee}, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](delegatee))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryVoteDelegationsResponse{VoteDelegations: delegations, Pagination: pageRes}, nil
+}
+
+// VoteDelegationTree returns the vote delegations made directly or transitively to an account,
+// paginated by their position in the breadth-first walk of the tree
+func (q queryServer) VoteDelegationTree(ctx context.Context, req *v1.QueryVoteDelegationTreeRequest) (*v1.QueryVoteDelegationTreeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Delegatee == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegatee address")
+	}
+
+	root, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Delegatee)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, limit := uint64(0), uint64(query.DefaultLimit)
+	if req.Pagination != nil {
+		switch {
+		case len(req.Pagination.Key) > 0 && req.Pagination.Offset > 0:
+			return nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+		case len(req.Pagination.Key) > 0:
+			if len(req.Pagination.Key) != 8 {
+				return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+			}
+			offset = sdk.BigEndianToUint64(req.Pagination.Key)
+		default:
+			offset = req.Pagination.Offset
+		}
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+
+	// the walk stops after the first vote delegation of the next page, so that its cost is bounded
+	// by the requested page
+	var (
+		delegations []*v1.VoteDelegation
+		position    uint64
+		pageRes     = &query.PageResponse{}
+	)
+	err = q.k.walkVoteDelegationTree(ctx, root, func(delegator, delegatee sdk.AccAddress) (bool, error) {
+		if position < offset {
+			position++
+			return false, nil
+		}
+		if uint64(len(delegations)) == limit {
+			pageRes.NextKey = sdk.Uint64ToBigEndian(position)
+			return false, errVoteDelegationTreePageFull
+		}
+
+		delegatorStrAddr, err := q.k.authKeeper.AddressCodec().BytesToString(delegator)
+		if err != nil {
+			return false, err
+		}
+		delegateeStrAddr, err := q.k.authKeeper.AddressCodec().BytesToString(delegatee)
+		if err != nil {
+			return false, err
+		}
+
+		delegations = append(delegations, &v1.VoteDelegation{Delegator: delegatorStrAddr, Delegatee: delegateeStrAddr})
+		position++
+		return false, nil
+	})
+	if err != nil && !stderrors.Is(err, errVoteDelegationTreePageFull) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryVoteDelegationTreeResponse{VoteDelegations: delegations, Pagination: pageRes}, nil
+}
+
+// SimulateProposal executes the messages of a proposal, or of an existing proposal, without committing any state change
+func (q queryServer) SimulateProposal(ctx context.Context, req *v1.QuerySimulateProposalRequest) (*v1.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var (
+		msgs []sdk.Msg
+		err  error
+	)
+	if req.ProposalId != 0 {
+		if len(req.Messages) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "proposal id and messages are mutually exclusive")
+		}
+
+		proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
+		if err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		msgs, err = proposal.GetMsgs()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	} else {
+		msgs, err = req.GetMsgs()
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if len(msgs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no messages to simulate")
+	}
+
+	results, gasUsed, err := q.k.SimulateProposal(ctx, msgs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	success := len(results) == len(msgs)
+	for _, result := range results {
+		if result.Error != "" {
+			success = false
+		}
+	}
+
+	return &v1.QuerySimulateProposalResponse{Results: results, GasUsed: gasUsed, Success: success}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct{ qs v1.QueryServer }
@@ -11,9 +11,11 @@ import (
 	"cosmossdk.io/x/gov/types/v1beta1"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
@@ -540,7 +542,8 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 					Voter:      addr0Str,
 				}
 
-				expRes = &v1.QueryVoteResponse{Vote: &v1.Vote{ProposalId: proposal.Id, Voter: addr0Str, Options: []*v1.WeightedVoteOption{{Option: v1.OptionAbstain, Weight: math.LegacyMustNewDecFromStr("1.0").String()}}}}
+				submitTime := suite.ctx.HeaderInfo().Time.UTC()
+				expRes = &v1.QueryVoteResponse{Vote: &v1.Vote{ProposalId: proposal.Id, Voter: addr0Str, Options: []*v1.WeightedVoteOption{{Option: v1.OptionAbstain, Weight: math.LegacyMustNewDecFromStr("1.0").String()}}, SubmitTime: &submitTime}}
 			},
 			true,
 		},
@@ -763,9 +766,10 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 				proposal.Status = v1.StatusVotingPeriod
 				err := suite.govKeeper.Proposals.Set(suite.ctx, proposal.Id, proposal)
 				suite.Require().NoError(err)
+				submitTime := suite.ctx.HeaderInfo().Time.UTC()
 				votes = []*v1.Vote{
-					{ProposalId: proposal.Id, Voter: addr0Str, Options: v1.NewNonSplitVoteOption(v1.OptionAbstain)},
-					{ProposalId: proposal.Id, Voter: addr1Str, Options: v1.NewNonSplitVoteOption(v1.OptionYes)},
+					{ProposalId: proposal.Id, Voter: addr0Str, Options: v1.NewNonSplitVoteOption(v1.OptionAbstain), SubmitTime: &submitTime},
+					{ProposalId: proposal.Id, Voter: addr1Str, Options: v1.NewNonSplitVoteOption(v1.OptionYes), SubmitTime: &submitTime},
 				}
 
 				codec := address.NewBech32Codec("cosmos")
@@ -2038,3 +2042,161 @@ func (suite *KeeperTestSuite) TestProposalVoteOptions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryVoteDelegations() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+	addrStrs := make([]string, len(addrs))
+	for i, addr := range addrs {
+		addrStr, err := suite.acctKeeper.AddressCodec().BytesToString(addr)
+		suite.Require().NoError(err)
+		addrStrs[i] = addrStr
+	}
+
+	// addr2 <- addr1 <- addr0
+	suite.Require().NoError(suite.govKeeper.SetVoteDelegation(ctx, addrs[1], addrs[2]))
+	suite.Require().NoError(suite.govKeeper.SetVoteDelegation(ctx, addrs[0], addrs[1]))
+
+	_, err := queryClient.VoteDelegation(ctx, &v1.QueryVoteDelegationRequest{})
+	suite.Require().ErrorContains(err, "empty delegator address")
+
+	_, err = queryClient.VoteDelegation(ctx, &v1.QueryVoteDelegationRequest{Delegator: addrStrs[2]})
+	suite.Require().ErrorContains(err, "doesn't exist")
+
+	delegationRes, err := queryClient.VoteDelegation(ctx, &v1.QueryVoteDelegationRequest{Delegator: addrStrs[0]})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&v1.VoteDelegation{Delegator: addrStrs[0], Delegatee: addrStrs[1]}, delegationRes.VoteDelegation)
+
+	_, err = queryClient.VoteDelegations(ctx, &v1.QueryVoteDelegationsRequest{})
+	suite.Require().ErrorContains(err, "empty delegatee address")
+
+	delegationsRes, err := queryClient.VoteDelegations(ctx, &v1.QueryVoteDelegationsRequest{Delegatee: addrStrs[2]})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.VoteDelegation{{Delegator: addrStrs[1], Delegatee: addrStrs[2]}}, delegationsRes.VoteDelegations)
+
+	delegationsRes, err = queryClient.VoteDelegations(ctx, &v1.QueryVoteDelegationsRequest{Delegatee: addrStrs[0]})
+	suite.Require().NoError(err)
+	suite.Require().Empty(delegationsRes.VoteDelegations)
+
+	_, err = queryClient.VoteDelegationTree(ctx, &v1.QueryVoteDelegationTreeRequest{})
+	suite.Require().ErrorContains(err, "empty delegatee address")
+
+	treeRes, err := queryClient.VoteDelegationTree(ctx, &v1.QueryVoteDelegationTreeRequest{Delegatee: addrStrs[2]})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.VoteDelegation{
+		{Delegator: addrStrs[1], Delegatee: addrStrs[2]},
+		{Delegator: addrStrs[0], Delegatee: addrStrs[1]},
+	}, treeRes.VoteDelegations)
+	suite.Require().Nil(treeRes.Pagination.NextKey)
+
+	// the tree is paginated in breadth-first order
+	treeRes, err = queryClient.VoteDelegationTree(ctx, &v1.QueryVoteDelegationTreeRequest{Delegatee: addrStrs[2], Pagination: &query.PageRequest{Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.VoteDelegation{{Delegator: addrStrs[1], Delegatee: addrStrs[2]}}, treeRes.VoteDelegations)
+	suite.Require().NotNil(treeRes.Pagination.NextKey)
+
+	treeRes, err = queryClient.VoteDelegationTree(ctx, &v1.QueryVoteDelegationTreeRequest{Delegatee: addrStrs[2], Pagination: &query.PageRequest{Key: treeRes.Pagination.NextKey, Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.VoteDelegation{{Delegator: addrStrs[0], Delegatee: addrStrs[1]}}, treeRes.VoteDelegations)
+	suite.Require().Nil(treeRes.Pagination.NextKey)
+
+	treeRes, err = queryClient.VoteDelegationTree(ctx, &v1.QueryVoteDelegationTreeRequest{Delegatee: addrStrs[2], Pagination: &query.PageRequest{Offset: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.VoteDelegation{{Delegator: addrStrs[0], Delegatee: addrStrs[1]}}, treeRes.VoteDelegations)
+
+	_, err = queryClient.VoteDelegationTree(ctx, &v1.QueryVoteDelegationTreeRequest{Delegatee: addrStrs[2], Pagination: &query.PageRequest{Key: []byte("foo")}})
+	suite.Require().ErrorContains(err, "invalid pagination key")
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposal() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	govAcctStr, err := suite.acctKeeper.AddressCodec().BytesToString(govAcct)
+	suite.Require().NoError(err)
+	addrStr, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[0])
+	suite.Require().NoError(err)
+
+	params := v1.DefaultParams()
+	params.Quorum = "0.5"
+	updateParams := &v1.MsgUpdateParams{Authority: govAcctStr, Params: params}
+	wrongSigner := &v1.MsgUpdateParams{Authority: addrStr, Params: params}
+
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{updateParams}, "", "title", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	suite.Require().NoError(err)
+
+	toAnys := func(msgs ...sdk.Msg) []*codectypes.Any {
+		anys, err := sdktx.SetMsgs(msgs)
+		suite.Require().NoError(err)
+		return anys
+	}
+
+	testCases := []struct {
+		msg           string
+		req           *v1.QuerySimulateProposalRequest
+		expErrMsg     string
+		expSuccess    bool
+		expNumResults int
+		expResultErr  string
+	}{
+		{
+			msg:       "empty request",
+			req:       &v1.QuerySimulateProposalRequest{},
+			expErrMsg: "no messages to simulate",
+		},
+		{
+			msg:       "proposal id and messages",
+			req:       &v1.QuerySimulateProposalRequest{ProposalId: proposal.Id, Messages: toAnys(updateParams)},
+			expErrMsg: "mutually exclusive",
+		},
+		{
+			msg:       "non existing proposal",
+			req:       &v1.QuerySimulateProposalRequest{ProposalId: proposal.Id + 1},
+			expErrMsg: "doesn't exist",
+		},
+		{
+			msg:           "messages",
+			req:           &v1.QuerySimulateProposalRequest{Messages: toAnys(updateParams)},
+			expSuccess:    true,
+			expNumResults: 1,
+		},
+		{
+			msg:           "existing proposal",
+			req:           &v1.QuerySimulateProposalRequest{ProposalId: proposal.Id},
+			expSuccess:    true,
+			expNumResults: 1,
+		},
+		{
+			msg:           "execution stops at the first failing message",
+			req:           &v1.QuerySimulateProposalRequest{Messages: toAnys(updateParams, wrongSigner, updateParams)},
+			expNumResults: 2,
+			expResultErr:  "expected authority account as only signer for proposal message",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			res, err := queryClient.SimulateProposal(gocontext.Background(), tc.req)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expSuccess, res.Success)
+			suite.Require().Len(res.Results, tc.expNumResults)
+			suite.Require().Equal(sdk.MsgTypeURL(updateParams), res.Results[0].MsgTypeUrl)
+			suite.Require().NotNil(res.Results[0].MsgResponse)
+			suite.Require().Empty(res.Results[0].Error)
+			suite.Require().NotZero(res.GasUsed)
+			if tc.expResultErr != "" {
+				suite.Require().Contains(res.Results[len(res.Results)-1].Error, tc.expResultErr)
+			}
+
+			// the simulated execution must not change the state
+			storedParams, err := suite.govKeeper.Params.Get(ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(v1.DefaultParams().Quorum, storedParams.Quorum)
+		})
+	}
+}
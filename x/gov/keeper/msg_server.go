@@ -281,6 +281,39 @@ func (k msgServer) VoteWeighted(ctx context.Context, msg *v1.MsgVoteWeighted) (*
 	return &v1.MsgVoteWeightedResponse{}, nil
 }
 
+// DelegateVote implements the MsgServer.DelegateVote method.
+func (k msgServer) DelegateVote(ctx context.Context, msg *v1.MsgDelegateVote) (*v1.MsgDelegateVoteResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.Delegator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	delegatee, err := k.authKeeper.AddressCodec().StringToBytes(msg.Delegatee)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegatee address: %s", err)
+	}
+
+	if err := k.Keeper.SetVoteDelegation(ctx, delegator, delegatee); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgDelegateVoteResponse{}, nil
+}
+
+// UndelegateVote implements the MsgServer.UndelegateVote method.
+func (k msgServer) UndelegateVote(ctx context.Context, msg *v1.MsgUndelegateVote) (*v1.MsgUndelegateVoteResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.Delegator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.Keeper.RemoveVoteDelegation(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUndelegateVoteResponse{}, nil
+}
+
 // Deposit implements the MsgServer.Deposit method.
 func (k msgServer) Deposit(ctx context.Context, msg *v1.MsgDeposit) (*v1.MsgDepositResponse, error) {
 	accAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Depositor)
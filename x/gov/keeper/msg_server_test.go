@@ -4,6 +4,7 @@ import (
 	"strings"
 	"time"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	banktypes "cosmossdk.io/x/bank/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
@@ -2317,3 +2318,133 @@ func (suite *KeeperTestSuite) TestMsgSudoExec() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgDelegateVote() {
+	suite.reset()
+	addrs := make([]string, len(suite.addrs))
+	for i, addr := range suite.addrs {
+		addrStr, err := suite.acctKeeper.AddressCodec().BytesToString(addr)
+		suite.Require().NoError(err)
+		addrs[i] = addrStr
+	}
+
+	testCases := []struct {
+		name      string
+		input     *v1.MsgDelegateVote
+		expErrMsg string
+	}{
+		{
+			name:      "invalid delegator",
+			input:     v1.NewMsgDelegateVote("invalid", addrs[1]),
+			expErrMsg: "invalid delegator address",
+		},
+		{
+			name:      "invalid delegatee",
+			input:     v1.NewMsgDelegateVote(addrs[0], "invalid"),
+			expErrMsg: "invalid delegatee address",
+		},
+		{
+			name:      "delegate to self",
+			input:     v1.NewMsgDelegateVote(addrs[0], addrs[0]),
+			expErrMsg: "cannot delegate vote to self",
+		},
+		{
+			name:  "delegate addr0 to addr1",
+			input: v1.NewMsgDelegateVote(addrs[0], addrs[1]),
+		},
+		{
+			name:  "delegate addr1 to addr2",
+			input: v1.NewMsgDelegateVote(addrs[1], addrs[2]),
+		},
+		{
+			name:      "transitive cycle",
+			input:     v1.NewMsgDelegateVote(addrs[2], addrs[0]),
+			expErrMsg: "vote delegation cycle",
+		},
+		{
+			name:  "redelegate addr0 to addr2",
+			input: v1.NewMsgDelegateVote(addrs[0], addrs[2]),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.DelegateVote(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			delegator, err := suite.acctKeeper.AddressCodec().StringToBytes(tc.input.Delegator)
+			suite.Require().NoError(err)
+			delegatee, err := suite.govKeeper.VoteDelegations.Get(suite.ctx, delegator)
+			suite.Require().NoError(err)
+			delegateeStr, err := suite.acctKeeper.AddressCodec().BytesToString(delegatee)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.input.Delegatee, delegateeStr)
+		})
+	}
+
+	// the previous delegation of addr0 has been removed from the delegators of addr1
+	has, err := suite.govKeeper.VoteDelegators.Has(suite.ctx, collections.Join(suite.addrs[1], suite.addrs[0]))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	has, err = suite.govKeeper.VoteDelegators.Has(suite.ctx, collections.Join(suite.addrs[2], suite.addrs[0]))
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+}
+
+func (suite *KeeperTestSuite) TestMsgUndelegateVote() {
+	suite.reset()
+	addr0Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	addr1Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[1])
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.DelegateVote(suite.ctx, v1.NewMsgDelegateVote(addr0Str, addr1Str))
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		input     *v1.MsgUndelegateVote
+		expErrMsg string
+	}{
+		{
+			name:      "invalid delegator",
+			input:     v1.NewMsgUndelegateVote("invalid"),
+			expErrMsg: "invalid delegator address",
+		},
+		{
+			name:      "no vote delegation",
+			input:     v1.NewMsgUndelegateVote(addr1Str),
+			expErrMsg: "no vote delegation",
+		},
+		{
+			name:  "valid",
+			input: v1.NewMsgUndelegateVote(addr0Str),
+		},
+		{
+			name:      "already undelegated",
+			input:     v1.NewMsgUndelegateVote(addr0Str),
+			expErrMsg: "no vote delegation",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.UndelegateVote(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+
+	has, err := suite.govKeeper.VoteDelegators.Has(suite.ctx, collections.Join(suite.addrs[1], suite.addrs[0]))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
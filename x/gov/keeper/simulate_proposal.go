@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// errSimulatedProposal is used to revert the state changes of a simulated proposal execution.
+var errSimulatedProposal = errors.New("simulated proposal execution is reverted")
+
+// SimulateProposal executes the messages of a proposal as the governance account, like the messages
+// of a passed proposal, in a branched state that is never committed. It returns the result of each
+// executed message and the gas used by the whole execution, bounded by the ProposalExecutionGas param.
+// As when executing a passed proposal, the execution stops at the first failing message.
+func (k Keeper) SimulateProposal(ctx context.Context, messages []sdk.Msg) (results []*v1.SimulateProposalMsgResult, gasUsed uint64, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	govAddr := k.GetGovernanceAccount(ctx).GetAddress()
+	err = k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		var execErr error
+		gasUsed, execErr = k.BranchService.ExecuteWithGasLimit(ctx, params.ProposalExecutionGas, func(ctx context.Context) error {
+			for _, msg := range messages {
+				result, err := k.simulateProposalMsg(ctx, govAddr, msg)
+				results = append(results, result)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+
+		// the execution ran out of gas before the result of the current message was recorded
+		if execErr != nil && len(results) < len(messages) && (len(results) == 0 || results[len(results)-1].Error == "") {
+			results = append(results, &v1.SimulateProposalMsgResult{
+				MsgTypeUrl: sdk.MsgTypeURL(messages[len(results)]),
+				Error:      execErr.Error(),
+			})
+		}
+
+		return errSimulatedProposal
+	})
+	if !errors.Is(err, errSimulatedProposal) {
+		return nil, 0, err
+	}
+
+	return results, gasUsed, nil
+}
+
+// simulateProposalMsg checks that msg could be part of a proposal and executes it,
+// recording its response, emitted events, gas used and error in the returned result.
+func (k Keeper) simulateProposalMsg(ctx context.Context, govAddr sdk.AccAddress, msg sdk.Msg) (*v1.SimulateProposalMsgResult, error) {
+	result := &v1.SimulateProposalMsgResult{MsgTypeUrl: sdk.MsgTypeURL(msg)}
+
+	// events can only be collected when executing within a sdk.Context
+	var eventManager *sdk.EventManager
+	if sdkCtx, ok := ctx.(sdk.Context); ok {
+		eventManager = sdk.NewEventManager()
+		ctx = sdkCtx.WithEventManager(eventManager)
+	}
+
+	gasBefore := k.GasService.GasMeter(ctx).Consumed()
+	resp, err := k.executeSimulatedProposalMsg(ctx, govAddr, msg)
+	result.GasUsed = k.GasService.GasMeter(ctx).Consumed() - gasBefore
+	if eventManager != nil {
+		result.Events = sdk.StringifyEvents(eventManager.ABCIEvents())
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result, err
+	}
+
+	if resp != nil {
+		result.MsgResponse, err = codectypes.NewAnyWithValue(resp)
+		if err != nil {
+			result.Error = err.Error()
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+func (k Keeper) executeSimulatedProposalMsg(ctx context.Context, govAddr sdk.AccAddress, msg sdk.Msg) (sdk.Msg, error) {
+	// perform the same checks as when submitting a proposal
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
+		}
+	}
+
+	signers, _, err := k.cdc.GetMsgSigners(msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) != 1 {
+		return nil, types.ErrInvalidSigner
+	}
+	if !bytes.Equal(signers[0], govAddr) {
+		addr, err := k.authKeeper.AddressCodec().BytesToString(signers[0])
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidSigner, err.Error())
+		}
+		return nil, errorsmod.Wrap(types.ErrInvalidSigner, addr)
+	}
+
+	if err := k.MsgRouterService.CanInvoke(ctx, sdk.MsgTypeURL(msg)); err != nil {
+		return nil, errorsmod.Wrap(types.ErrUnroutableProposalMsg, err.Error())
+	}
+
+	return safeExecuteHandler(ctx, msg, k.MsgRouterService)
+}
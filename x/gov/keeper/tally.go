@@ -18,19 +18,17 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 		return false, false, v1.TallyResult{}, err
 	}
 
-	if k.config.CalculateVoteResultsAndVotingPowerFn == nil {
-		k.config.CalculateVoteResultsAndVotingPowerFn = defaultCalculateVoteResultsAndVotingPower
-	}
-
-	totalVoterPower, results, err := k.config.CalculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
+	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
 
-	params, err := k.Params.Get(ctx)
+	calculateVoteResultsAndVotingPowerFn := k.getCalculateVoteResultsAndVotingPowerFn(params.GetTallyStrategy(proposal.ProposalType))
+	totalVoterPower, results, err := calculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
+
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// If there is no staked coins, the proposal fails
@@ -210,15 +208,27 @@ func (k Keeper) tallyMultipleChoice(totalVoterPower math.LegacyDec, totalBonded
 
 // getCurrentValidators fetches all the bonded validators, insert them into currValidators
 func (k Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.ValidatorGovInfo, error) {
+	// the voting power of the validators is capped like their consensus power
+	effectiveTokens, err := k.sk.GetEffectiveBondedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	currValidators := make(map[string]v1.ValidatorGovInfo)
 	if err := k.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator sdk.ValidatorI) (stop bool) {
 		valBz, err := k.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
 		if err != nil {
 			return false
 		}
+
+		bondedTokens := validator.GetBondedTokens()
+		if tokens, ok := effectiveTokens[validator.GetOperator()]; ok {
+			bondedTokens = tokens
+		}
+
 		currValidators[validator.GetOperator()] = v1.NewValidatorGovInfo(
 			valBz,
-			validator.GetBondedTokens(),
+			bondedTokens,
 			validator.GetDelegatorShares(),
 			math.LegacyZeroDec(),
 			v1.WeightedVoteOptions{},
@@ -263,31 +273,39 @@ func defaultCalculateVoteResultsAndVotingPower(
 			validators[valAddrStr] = val
 		}
 
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr()
+		// iterate over all delegations from delegator, deduct from any delegated-to validators
+		tallyDelegations := func(delegator sdk.AccAddress) error {
+			return k.sk.IterateDelegations(ctx, delegator, func(index int64, delegation sdk.DelegationI) (stop bool) {
+				valAddrStr := delegation.GetValidatorAddr()
+
+				if val, ok := validators[valAddrStr]; ok {
+					// There is no need to handle the special case that validator address equal to voter address.
+					// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
+					val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+					validators[valAddrStr] = val
 
-			if val, ok := validators[valAddrStr]; ok {
-				// There is no need to handle the special case that validator address equal to voter address.
-				// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				validators[valAddrStr] = val
+					// delegation shares * bonded / total shares
+					votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
 
-				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+					for _, option := range vote.Options {
+						weight, _ := math.LegacyNewDecFromStr(option.Weight)
+						subPower := votingPower.Mul(weight)
+						results[option.Option] = results[option.Option].Add(subPower)
+					}
 
-				for _, option := range vote.Options {
-					weight, _ := math.LegacyNewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
+					totalVP = totalVP.Add(votingPower)
 				}
 
-				totalVP = totalVP.Add(votingPower)
-			}
+				return false
+			})
+		}
 
-			return false
-		})
-		if err != nil {
+		if err := tallyDelegations(voter); err != nil {
+			return false, err
+		}
+
+		// the accounts that delegated their vote to the voter, and did not vote themselves, vote like the voter
+		if err := k.iterateVoteDelegators(ctx, proposalID, voter, tallyDelegations); err != nil {
 			return false, err
 		}
 
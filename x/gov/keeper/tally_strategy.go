@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// voterPowerWeightFn returns the weighted voting power of a voter given its vote and
+// its stake weighted voting power.
+type voterPowerWeightFn func(vote v1.Vote, votingPower math.LegacyDec) (math.LegacyDec, error)
+
+// weightedVoter holds the vote and the stake weighted voting power of a voter.
+type weightedVoter struct {
+	vote        v1.Vote
+	votingPower math.LegacyDec
+}
+
+// getCalculateVoteResultsAndVotingPowerFn returns the function used to tally the votes of a proposal,
+// depending on the tally strategy of the proposal type.
+func (k Keeper) getCalculateVoteResultsAndVotingPowerFn(strategy v1.TallyStrategy) CalculateVoteResultsAndVotingPowerFn {
+	switch strategy {
+	case v1.TallyStrategy_TALLY_STRATEGY_QUADRATIC:
+		return quadraticCalculateVoteResultsAndVotingPower
+	case v1.TallyStrategy_TALLY_STRATEGY_CONVICTION:
+		return convictionCalculateVoteResultsAndVotingPower
+	default:
+		if k.config.CalculateVoteResultsAndVotingPowerFn == nil {
+			return defaultCalculateVoteResultsAndVotingPower
+		}
+		return k.config.CalculateVoteResultsAndVotingPowerFn
+	}
+}
+
+// quadraticCalculateVoteResultsAndVotingPower tallies the votes of a proposal using quadratic voting:
+// the voting power of each voter is the square root of its delegated stake.
+func quadraticCalculateVoteResultsAndVotingPower(
+	ctx context.Context,
+	k Keeper,
+	proposalID uint64,
+	validators map[string]v1.ValidatorGovInfo,
+) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+	return calculateWeightedVoteResultsAndVotingPower(ctx, k, proposalID, validators,
+		func(_ v1.Vote, votingPower math.LegacyDec) (math.LegacyDec, error) {
+			return votingPower.ApproxSqrt()
+		})
+}
+
+// convictionCalculateVoteResultsAndVotingPower tallies the votes of a proposal using conviction voting:
+// the voting power of each voter is its delegated stake weighted by the fraction of the voting period
+// during which its vote was held. Votes without a submit time are given full weight.
+func convictionCalculateVoteResultsAndVotingPower(
+	ctx context.Context,
+	k Keeper,
+	proposalID uint64,
+	validators map[string]v1.ValidatorGovInfo,
+) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	var votingPeriod time.Duration
+	if proposal.VotingStartTime != nil && proposal.VotingEndTime != nil {
+		votingPeriod = proposal.VotingEndTime.Sub(*proposal.VotingStartTime)
+	}
+
+	return calculateWeightedVoteResultsAndVotingPower(ctx, k, proposalID, validators,
+		func(vote v1.Vote, votingPower math.LegacyDec) (math.LegacyDec, error) {
+			if vote.SubmitTime == nil || votingPeriod <= 0 {
+				return votingPower, nil
+			}
+
+			held := proposal.VotingEndTime.Sub(*vote.SubmitTime)
+			switch {
+			case held <= 0:
+				return math.LegacyZeroDec(), nil
+			case held >= votingPeriod:
+				return votingPower, nil
+			default:
+				return votingPower.MulInt64(int64(held)).QuoInt64(int64(votingPeriod)), nil
+			}
+		})
+}
+
+// calculateWeightedVoteResultsAndVotingPower iterates over all votes and computes the stake weighted
+// voting power of each voter (delegations and, for validators, the inherited voting power of their
+// delegators who did not vote), like the default tally. Accounts that delegated their vote to a voter
+// and did not vote are considered as distinct voters voting like the voter. The voting power of each voter is then weighted
+// with weightFn, and the weighted results are scaled so that their total equals the stake weighted
+// voting power. This way the quorum is still computed on the participating stake, while the results
+// reflect the weighted voting power of each voter.
+func calculateWeightedVoteResultsAndVotingPower(
+	ctx context.Context,
+	k Keeper,
+	proposalID uint64,
+	validators map[string]v1.ValidatorGovInfo,
+	weightFn voterPowerWeightFn,
+) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+	totalVP := math.LegacyZeroDec()
+	results := createEmptyResults()
+
+	// voters are keyed by address, so that a validator voting with both its own delegations and
+	// the voting power inherited from its delegators is considered as a single voter
+	voters := make(map[string]weightedVoter)
+	validatorVotes := make(map[string]v1.Vote)
+
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
+	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
+		if err != nil {
+			return false, err
+		}
+
+		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
+		if err != nil {
+			return false, err
+		}
+
+		if val, ok := validators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			validators[valAddrStr] = val
+			validatorVotes[valAddrStr] = vote
+		}
+
+		// the accounts that delegated their vote to the voter and did not vote are weighted
+		// as distinct voters voting like the voter
+		addVoter := func(delegator sdk.AccAddress) error {
+			votingPower := math.LegacyZeroDec()
+			err := k.sk.IterateDelegations(ctx, delegator, func(index int64, delegation sdk.DelegationI) (stop bool) {
+				valAddrStr := delegation.GetValidatorAddr()
+
+				if val, ok := validators[valAddrStr]; ok {
+					val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+					validators[valAddrStr] = val
+
+					// delegation shares * bonded / total shares
+					votingPower = votingPower.Add(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+				}
+
+				return false
+			})
+			if err != nil {
+				return err
+			}
+
+			voters[string(delegator)] = weightedVoter{vote: vote, votingPower: votingPower}
+			return nil
+		}
+
+		if err := addVoter(voter); err != nil {
+			return false, err
+		}
+
+		if err := k.iterateVoteDelegators(ctx, proposalID, voter, addVoter); err != nil {
+			return false, err
+		}
+		votesToRemove = append(votesToRemove, key)
+		return false, nil
+	}); err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	// remove all votes from store
+	for _, key := range votesToRemove {
+		if err := k.Votes.Remove(ctx, key); err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+	}
+
+	// add the voting power inherited by the validators that voted
+	for valAddrStr, vote := range validatorVotes {
+		val := validators[valAddrStr]
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+
+		voter := voters[string(val.Address)]
+		voters[string(val.Address)] = weightedVoter{vote: vote, votingPower: voter.votingPower.Add(votingPower)}
+	}
+
+	// weight the voting power of each voter
+	weightedPowers := make(map[string]math.LegacyDec, len(voters))
+	totalWeightedVP := math.LegacyZeroDec()
+	for addr, voter := range voters {
+		weightedPower, err := weightFn(voter.vote, voter.votingPower)
+		if err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+
+		weightedPowers[addr] = weightedPower
+		totalVP = totalVP.Add(voter.votingPower)
+		totalWeightedVP = totalWeightedVP.Add(weightedPower)
+	}
+
+	if totalWeightedVP.IsZero() {
+		return totalVP, results, nil
+	}
+
+	// scale the weighted voting power back to the stake weighted voting power
+	for addr, voter := range voters {
+		votingPower := weightedPowers[addr].Mul(totalVP).Quo(totalWeightedVP)
+
+		for _, option := range voter.vote.Options {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			subPower := votingPower.Mul(weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+	}
+
+	return totalVP, results, nil
+}
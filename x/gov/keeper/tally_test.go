@@ -3,12 +3,14 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/gov/keeper"
 	v1 "cosmossdk.io/x/gov/types/v1"
@@ -34,6 +36,7 @@ var (
 	setTotalBonded = func(s tallyFixture, n int64) {
 		s.mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
 		s.mocks.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(n), nil)
+		s.mocks.stakingKeeper.EXPECT().GetEffectiveBondedTokens(gomock.Any()).Return(nil, nil).AnyTimes()
 	}
 	delegatorVote = func(s tallyFixture, voter sdk.AccAddress, delegations []stakingtypes.Delegation, vote v1.VoteOption) {
 		err := s.keeper.AddVote(s.ctx, s.proposal.Id, voter, v1.NewNonSplitVoteOption(vote), "")
@@ -121,6 +124,29 @@ func TestTally_Standard(t *testing.T) {
 				SpamCount:        "0",
 			},
 		},
+		{
+			name: "one capped validator votes: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				val0Addr, err := address.NewBech32Codec("cosmosvaloper").BytesToString(s.valAddrs[0])
+				require.NoError(s.t, err)
+				s.mocks.stakingKeeper.EXPECT().GetEffectiveBondedTokens(gomock.Any()).Return(map[string]sdkmath.Int{val0Addr: sdkmath.NewInt(500000)}, nil)
+				setTotalBonded(s, 10000000)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "0",
+				AbstainCount:     "0",
+				NoCount:          "500000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "0",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "500000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
 		{
 			name: "one account votes without delegation: prop fails/burn deposit",
 			setup: func(s tallyFixture) {
@@ -1546,3 +1572,551 @@ func TestTally_MultipleChoice(t *testing.T) {
 		})
 	}
 }
+
+func TestTally_Strategies(t *testing.T) {
+	// votedAt is like delegatorVote but submits the vote at the given offset from the voting start time
+	votedAt := func(s tallyFixture, voter sdk.AccAddress, delegations []stakingtypes.Delegation, vote v1.VoteOption, offset time.Duration) {
+		proposal, err := s.keeper.Proposals.Get(s.ctx, s.proposal.Id)
+		require.NoError(s.t, err)
+		voteCtx := s.ctx.WithHeaderInfo(header.Info{Time: proposal.VotingStartTime.Add(offset)})
+		err = s.keeper.AddVote(voteCtx, s.proposal.Id, voter, v1.NewNonSplitVoteOption(vote), "")
+		require.NoError(s.t, err)
+		s.mocks.stakingKeeper.EXPECT().
+			IterateDelegations(s.ctx, voter, gomock.Any()).
+			DoAndReturn(
+				func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+					for i, d := range delegations {
+						fn(int64(i), d)
+					}
+					return nil
+				})
+	}
+	delegateToVal0 := func(s tallyFixture, delAddr sdk.AccAddress, shares int64) []stakingtypes.Delegation {
+		del, err := s.mocks.acctKeeper.AddressCodec().BytesToString(delAddr)
+		require.NoError(s.t, err)
+		val0Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[0])
+		require.NoError(s.t, err)
+		return []stakingtypes.Delegation{{
+			DelegatorAddress: del,
+			ValidatorAddress: val0Addr,
+			Shares:           sdkmath.LegacyNewDec(shares),
+		}}
+	}
+
+	tests := []struct {
+		name          string
+		strategy      v1.TallyStrategy
+		proposalType  v1.ProposalType
+		setup         func(tallyFixture)
+		expectedPass  bool
+		expectedBurn  bool
+		expectedTally v1.TallyResult
+	}{
+		{
+			name:         "quadratic: quorum reached with yes>.5: prop succeeds",
+			strategy:     v1.TallyStrategy_TALLY_STRATEGY_QUADRATIC,
+			proposalType: v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[1], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[2], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[3], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[4], v1.VoteOption_VOTE_OPTION_THREE)
+				validatorVote(s, s.valAddrs[5], v1.VoteOption_VOTE_OPTION_THREE)
+				validatorVote(s, s.valAddrs[6], v1.VoteOption_VOTE_OPTION_FOUR)
+			},
+			// voters with the same stake have the same quadratic voting power, results are unchanged
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "4000000",
+				AbstainCount:     "0",
+				NoCount:          "2000000",
+				NoWithVetoCount:  "1000000",
+				OptionOneCount:   "4000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "2000000",
+				OptionFourCount:  "1000000",
+				SpamCount:        "0",
+			},
+		},
+		{
+			// one delegator delegates 42 shares to 2 different validators (21 each)
+			// delegator votes yes
+			// first validator votes no
+			// second validator votes yes
+			// third validator (no delegation) votes abstain
+			name:         "quadratic: delegator with mixed delegations: prop fails/burn deposit",
+			strategy:     v1.TallyStrategy_TALLY_STRATEGY_QUADRATIC,
+			proposalType: v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				del0Addr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(s.delAddrs[0])
+				require.NoError(t, err)
+				val0Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[0])
+				require.NoError(t, err)
+				val1Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[1])
+				require.NoError(t, err)
+				delegations := []stakingtypes.Delegation{
+					{
+						DelegatorAddress: del0Addr,
+						ValidatorAddress: val0Addr,
+						Shares:           sdkmath.LegacyNewDec(21),
+					},
+					{
+						DelegatorAddress: del0Addr,
+						ValidatorAddress: val1Addr,
+						Shares:           sdkmath.LegacyNewDec(21),
+					},
+				}
+				delegatorVote(s, s.delAddrs[0], delegations, v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+				validatorVote(s, s.valAddrs[1], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[2], v1.VoteOption_VOTE_OPTION_TWO)
+			},
+			// sqrt(42) + sqrt(999979) yes, sqrt(999979) no, sqrt(1000000) abstain, scaled to 3000000
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "1004307",
+				AbstainCount:     "997851",
+				NoCount:          "997840",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "1004307",
+				OptionTwoCount:   "997851",
+				OptionThreeCount: "997840",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:         "quadratic: many small delegators outweigh validators: prop succeeds",
+			strategy:     v1.TallyStrategy_TALLY_STRATEGY_QUADRATIC,
+			proposalType: v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				// 5 delegators delegate the whole stake of the first validator and vote yes
+				for _, delAddr := range s.delAddrs {
+					delegatorVote(s, delAddr, delegateToVal0(s, delAddr, 200000), v1.VoteOption_VOTE_OPTION_ONE)
+				}
+				validatorVote(s, s.valAddrs[1], v1.VoteOption_VOTE_OPTION_THREE)
+				validatorVote(s, s.valAddrs[2], v1.VoteOption_VOTE_OPTION_THREE)
+				validatorVote(s, s.valAddrs[3], v1.VoteOption_VOTE_OPTION_TWO)
+			},
+			// 5 * sqrt(200000) yes, 2 * sqrt(1000000) no, sqrt(1000000) abstain, scaled to 4000000
+			// with a stake weighted tally, the proposal would fail with 1000000 yes and 2000000 no
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "1708203",
+				AbstainCount:     "763932",
+				NoCount:          "1527864",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "1708203",
+				OptionTwoCount:   "763932",
+				OptionThreeCount: "1527864",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:         "quadratic: strategy of another proposal type: stake weighted tally",
+			strategy:     v1.TallyStrategy_TALLY_STRATEGY_QUADRATIC,
+			proposalType: v1.ProposalType_PROPOSAL_TYPE_EXPEDITED,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				for _, delAddr := range s.delAddrs {
+					delegatorVote(s, delAddr, delegateToVal0(s, delAddr, 200000), v1.VoteOption_VOTE_OPTION_ONE)
+				}
+				validatorVote(s, s.valAddrs[1], v1.VoteOption_VOTE_OPTION_THREE)
+				validatorVote(s, s.valAddrs[2], v1.VoteOption_VOTE_OPTION_THREE)
+				validatorVote(s, s.valAddrs[3], v1.VoteOption_VOTE_OPTION_TWO)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because expedited quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "1000000",
+				AbstainCount:     "1000000",
+				NoCount:          "2000000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "1000000",
+				OptionTwoCount:   "1000000",
+				OptionThreeCount: "2000000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:         "conviction: votes held during the whole voting period: prop succeeds",
+			strategy:     v1.TallyStrategy_TALLY_STRATEGY_CONVICTION,
+			proposalType: v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[1], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[2], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[3], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[4], v1.VoteOption_VOTE_OPTION_THREE)
+				validatorVote(s, s.valAddrs[5], v1.VoteOption_VOTE_OPTION_THREE)
+				validatorVote(s, s.valAddrs[6], v1.VoteOption_VOTE_OPTION_FOUR)
+			},
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "4000000",
+				AbstainCount:     "0",
+				NoCount:          "2000000",
+				NoWithVetoCount:  "1000000",
+				OptionOneCount:   "4000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "2000000",
+				OptionFourCount:  "1000000",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:         "conviction: late votes have less weight: prop succeeds",
+			strategy:     v1.TallyStrategy_TALLY_STRATEGY_CONVICTION,
+			proposalType: v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				votedAt(s, sdk.AccAddress(s.valAddrs[0]), nil, v1.VoteOption_VOTE_OPTION_ONE, 0)
+				votedAt(s, sdk.AccAddress(s.valAddrs[1]), nil, v1.VoteOption_VOTE_OPTION_ONE, 0)
+				// no votes are submitted at the middle of the voting period
+				votedAt(s, sdk.AccAddress(s.valAddrs[2]), nil, v1.VoteOption_VOTE_OPTION_THREE, v1.DefaultPeriod/2)
+				votedAt(s, sdk.AccAddress(s.valAddrs[3]), nil, v1.VoteOption_VOTE_OPTION_THREE, v1.DefaultPeriod/2)
+				votedAt(s, sdk.AccAddress(s.valAddrs[4]), nil, v1.VoteOption_VOTE_OPTION_THREE, v1.DefaultPeriod/2)
+			},
+			// yes is weighted 2000000, no 1500000, scaled to 5000000
+			// with a stake weighted tally, the proposal would fail with 2000000 yes and 3000000 no
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "2857142",
+				AbstainCount:     "0",
+				NoCount:          "2142857",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "2857142",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "2142857",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:         "conviction: votes submitted at the end of the voting period have no weight: prop fails",
+			strategy:     v1.TallyStrategy_TALLY_STRATEGY_CONVICTION,
+			proposalType: v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				votedAt(s, sdk.AccAddress(s.valAddrs[0]), nil, v1.VoteOption_VOTE_OPTION_ONE, v1.DefaultPeriod)
+				votedAt(s, sdk.AccAddress(s.valAddrs[1]), nil, v1.VoteOption_VOTE_OPTION_ONE, v1.DefaultPeriod)
+				votedAt(s, sdk.AccAddress(s.valAddrs[2]), nil, v1.VoteOption_VOTE_OPTION_ONE, v1.DefaultPeriod)
+				votedAt(s, sdk.AccAddress(s.valAddrs[3]), nil, v1.VoteOption_VOTE_OPTION_THREE, 0)
+			},
+			expectedPass: false,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "0",
+				AbstainCount:     "0",
+				NoCount:          "4000000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "0",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "4000000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			params := v1.DefaultParams()
+			// Ensure params value are different than false
+			params.BurnVoteQuorum = true
+			params.BurnVoteVeto = true
+			params.TallyStrategies = []v1.ProposalTallyStrategy{{
+				ProposalType:  v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+				TallyStrategy: tt.strategy,
+			}}
+			err := govKeeper.Params.Set(ctx, params)
+			require.NoError(t, err)
+			var (
+				numVals       = 10
+				numDelegators = 5
+				addrs         = simtestutil.CreateRandomAccounts(numVals + numDelegators)
+				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
+				delAddrs      = addrs[numVals:]
+			)
+			// Mocks a bunch of validators
+			mocks.stakingKeeper.EXPECT().
+				IterateBondedValidatorsByPower(ctx, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+						for i := int64(0); i < int64(numVals); i++ {
+							valAddr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[i])
+							require.NoError(t, err)
+							fn(i, stakingtypes.Validator{
+								OperatorAddress: valAddr,
+								Status:          stakingtypes.Bonded,
+								Tokens:          sdkmath.NewInt(1000000),
+								DelegatorShares: sdkmath.LegacyNewDec(1000000),
+							})
+						}
+						return nil
+					})
+
+			// Submit and activate a proposal
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], tt.proposalType)
+			require.NoError(t, err)
+			err = govKeeper.ActivateVotingPeriod(ctx, proposal)
+			require.NoError(t, err)
+			suite := tallyFixture{
+				t:        t,
+				proposal: proposal,
+				valAddrs: valAddrs,
+				delAddrs: delAddrs,
+				ctx:      ctx,
+				keeper:   govKeeper,
+				mocks:    mocks,
+			}
+			tt.setup(suite)
+
+			pass, burn, tally, err := govKeeper.Tally(ctx, proposal)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
+			assert.Equal(t, tt.expectedBurn, burn, "wrong burn")
+			assert.Equal(t, tt.expectedTally, tally)
+			// Assert votes removal after tally
+			rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+			_, err = suite.keeper.Votes.Iterate(suite.ctx, rng)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTally_VoteDelegation(t *testing.T) {
+	// delegateVote delegates the vote of delegator to delegatee, the delegations of delegator
+	// are only iterated when its voting power is tallied through delegatee
+	delegateVote := func(s tallyFixture, delegator, delegatee sdk.AccAddress, delegations []stakingtypes.Delegation) {
+		err := s.keeper.SetVoteDelegation(s.ctx, delegator, delegatee)
+		require.NoError(s.t, err)
+		if delegations == nil {
+			return
+		}
+		s.mocks.stakingKeeper.EXPECT().
+			IterateDelegations(s.ctx, delegator, gomock.Any()).
+			DoAndReturn(
+				func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+					for i, d := range delegations {
+						fn(int64(i), d)
+					}
+					return nil
+				})
+	}
+	delegateToVal0 := func(s tallyFixture, delAddr sdk.AccAddress, shares int64) []stakingtypes.Delegation {
+		del, err := s.mocks.acctKeeper.AddressCodec().BytesToString(delAddr)
+		require.NoError(s.t, err)
+		val0Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[0])
+		require.NoError(s.t, err)
+		return []stakingtypes.Delegation{{
+			DelegatorAddress: del,
+			ValidatorAddress: val0Addr,
+			Shares:           sdkmath.LegacyNewDec(shares),
+		}}
+	}
+
+	tests := []struct {
+		name          string
+		strategy      v1.TallyStrategy
+		setup         func(tallyFixture)
+		expectedPass  bool
+		expectedBurn  bool
+		expectedTally v1.TallyResult
+	}{
+		{
+			name: "delegator delegates its vote to a voter, validator votes no: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegateVote(s, s.delAddrs[0], s.delAddrs[1], delegateToVal0(s, s.delAddrs[0], 42))
+				delegatorVote(s, s.delAddrs[1], nil, v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "42",
+				AbstainCount:     "0",
+				NoCount:          "999958",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "42",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "999958",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "transitive vote delegations: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegateVote(s, s.delAddrs[0], s.delAddrs[1], delegateToVal0(s, s.delAddrs[0], 42))
+				delegateVote(s, s.delAddrs[1], s.delAddrs[2], delegateToVal0(s, s.delAddrs[1], 8))
+				delegatorVote(s, s.delAddrs[2], delegateToVal0(s, s.delAddrs[2], 10), v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "60",
+				AbstainCount:     "0",
+				NoCount:          "999940",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "60",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "999940",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			// the direct vote of the delegator overrides its vote delegation,
+			// and the accounts delegating to it follow its vote
+			name: "delegator votes directly: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegateVote(s, s.delAddrs[0], s.delAddrs[1], nil)
+				delegateVote(s, s.delAddrs[2], s.delAddrs[0], delegateToVal0(s, s.delAddrs[2], 8))
+				delegatorVote(s, s.delAddrs[0], delegateToVal0(s, s.delAddrs[0], 42), v1.VoteOption_VOTE_OPTION_THREE)
+				delegatorVote(s, s.delAddrs[1], delegateToVal0(s, s.delAddrs[1], 10), v1.VoteOption_VOTE_OPTION_ONE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "10",
+				AbstainCount:     "0",
+				NoCount:          "50",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "10",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "50",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "delegatee does not vote, validator inherits the voting power: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegateVote(s, s.delAddrs[0], s.delAddrs[1], nil)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_ONE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "1000000",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "1000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			// the delegators are weighted as distinct voters:
+			// yes = sqrt(100) + sqrt(100) = 20, no = sqrt(900) = 30, scaled to the 1100 voting power
+			name:     "quadratic: delegators are weighted separately: prop fails/burn deposit",
+			strategy: v1.TallyStrategy_TALLY_STRATEGY_QUADRATIC,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegateVote(s, s.delAddrs[0], s.delAddrs[1], delegateToVal0(s, s.delAddrs[0], 100))
+				delegateVote(s, s.delAddrs[2], s.delAddrs[1], delegateToVal0(s, s.delAddrs[2], 100))
+				delegatorVote(s, s.delAddrs[1], nil, v1.VoteOption_VOTE_OPTION_ONE)
+				delegatorVote(s, s.delAddrs[3], delegateToVal0(s, s.delAddrs[3], 900), v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "440",
+				AbstainCount:     "0",
+				NoCount:          "660",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "440",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "660",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			params := v1.DefaultParams()
+			// Ensure params value are different than false
+			params.BurnVoteQuorum = true
+			params.BurnVoteVeto = true
+			if tt.strategy != v1.TallyStrategy_TALLY_STRATEGY_UNSPECIFIED {
+				params.TallyStrategies = []v1.ProposalTallyStrategy{{
+					ProposalType:  v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+					TallyStrategy: tt.strategy,
+				}}
+			}
+			err := govKeeper.Params.Set(ctx, params)
+			require.NoError(t, err)
+			var (
+				numVals       = 10
+				numDelegators = 5
+				addrs         = simtestutil.CreateRandomAccounts(numVals + numDelegators)
+				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
+				delAddrs      = addrs[numVals:]
+			)
+			// Mocks a bunch of validators
+			mocks.stakingKeeper.EXPECT().
+				IterateBondedValidatorsByPower(ctx, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+						for i := int64(0); i < int64(numVals); i++ {
+							valAddr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[i])
+							require.NoError(t, err)
+							fn(i, stakingtypes.Validator{
+								OperatorAddress: valAddr,
+								Status:          stakingtypes.Bonded,
+								Tokens:          sdkmath.NewInt(1000000),
+								DelegatorShares: sdkmath.LegacyNewDec(1000000),
+							})
+						}
+						return nil
+					})
+
+			// Submit and activate a proposal
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+			err = govKeeper.ActivateVotingPeriod(ctx, proposal)
+			require.NoError(t, err)
+			suite := tallyFixture{
+				t:        t,
+				proposal: proposal,
+				valAddrs: valAddrs,
+				delAddrs: delAddrs,
+				ctx:      ctx,
+				keeper:   govKeeper,
+				mocks:    mocks,
+			}
+			tt.setup(suite)
+
+			pass, burn, tally, err := govKeeper.Tally(ctx, proposal)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
+			assert.Equal(t, tt.expectedBurn, burn, "wrong burn")
+			assert.Equal(t, tt.expectedTally, tally)
+		})
+	}
+}
@@ -78,6 +78,8 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 		return err
 	}
 	vote := v1.NewVote(proposalID, voterStrAddr, options, metadata)
+	submitTime := k.HeaderService.HeaderInfo(ctx).Time
+	vote.SubmitTime = &submitTime
 	err = k.Votes.Set(ctx, collections.Join(proposalID, voterAddr), vote)
 	if err != nil {
 		return err
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"context"
+	stderrors "errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetVoteDelegation delegates the governance voting power of delegator to delegatee.
+// An existing vote delegation of delegator is replaced. The delegation is rejected
+// if delegatee delegates, directly or transitively, its voting power to delegator.
+func (k Keeper) SetVoteDelegation(ctx context.Context, delegator, delegatee sdk.AccAddress) error {
+	if delegator.Equals(delegatee) {
+		return errors.Wrap(types.ErrInvalidVoteDelegation, "cannot delegate vote to self")
+	}
+
+	// walk up the delegation chain of the delegatee to prevent cycles
+	for current := delegatee; ; {
+		next, err := k.VoteDelegations.Get(ctx, current)
+		if err != nil {
+			if stderrors.Is(err, collections.ErrNotFound) {
+				break
+			}
+
+			return err
+		}
+
+		if next.Equals(delegator) {
+			return errors.Wrap(types.ErrInvalidVoteDelegation, "vote delegation cycle")
+		}
+		current = next
+	}
+
+	previous, err := k.VoteDelegations.Get(ctx, delegator)
+	if err == nil {
+		if err := k.VoteDelegators.Remove(ctx, collections.Join(previous, delegator)); err != nil {
+			return err
+		}
+	} else if !stderrors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if err := k.VoteDelegations.Set(ctx, delegator, delegatee); err != nil {
+		return err
+	}
+	if err := k.VoteDelegators.Set(ctx, collections.Join(delegatee, delegator)); err != nil {
+		return err
+	}
+
+	delegatorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(delegator)
+	if err != nil {
+		return err
+	}
+	delegateeStrAddr, err := k.authKeeper.AddressCodec().BytesToString(delegatee)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeDelegateVote,
+		event.NewAttribute(types.AttributeKeyDelegator, delegatorStrAddr),
+		event.NewAttribute(types.AttributeKeyDelegatee, delegateeStrAddr),
+	)
+}
+
+// RemoveVoteDelegation removes the vote delegation of delegator.
+func (k Keeper) RemoveVoteDelegation(ctx context.Context, delegator sdk.AccAddress) error {
+	delegatee, err := k.VoteDelegations.Get(ctx, delegator)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return errors.Wrapf(types.ErrNoVoteDelegation, "%s", delegator)
+		}
+
+		return err
+	}
+
+	if err := k.VoteDelegations.Remove(ctx, delegator); err != nil {
+		return err
+	}
+	if err := k.VoteDelegators.Remove(ctx, collections.Join(delegatee, delegator)); err != nil {
+		return err
+	}
+
+	delegatorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(delegator)
+	if err != nil {
+		return err
+	}
+	delegateeStrAddr, err := k.authKeeper.AddressCodec().BytesToString(delegatee)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeUndelegateVote,
+		event.NewAttribute(types.AttributeKeyDelegator, delegatorStrAddr),
+		event.NewAttribute(types.AttributeKeyDelegatee, delegateeStrAddr),
+	)
+}
+
+// GetVoteDelegations returns all the vote delegations.
+func (k Keeper) GetVoteDelegations(ctx context.Context) ([]*v1.VoteDelegation, error) {
+	var delegations []*v1.VoteDelegation
+	err := k.VoteDelegations.Walk(ctx, nil, func(delegator, delegatee sdk.AccAddress) (stop bool, err error) {
+		delegatorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(delegator)
+		if err != nil {
+			return true, err
+		}
+		delegateeStrAddr, err := k.authKeeper.AddressCodec().BytesToString(delegatee)
+		if err != nil {
+			return true, err
+		}
+
+		delegations = append(delegations, &v1.VoteDelegation{Delegator: delegatorStrAddr, Delegatee: delegateeStrAddr})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return delegations, nil
+}
+
+// walkVoteDelegationTree walks, in breadth-first order, the accounts delegating their
+// voting power directly or transitively to root. When fn returns skip, the accounts
+// delegating to the given delegator are not walked.
+func (k Keeper) walkVoteDelegationTree(ctx context.Context, root sdk.AccAddress, fn func(delegator, delegatee sdk.AccAddress) (skip bool, err error)) error {
+	queue := []sdk.AccAddress{root}
+	for len(queue) > 0 {
+		delegatee := queue[0]
+		queue = queue[1:]
+
+		rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](delegatee)
+		var delegators []sdk.AccAddress
+		if err := k.VoteDelegators.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+			delegators = append(delegators, key.K2())
+			return false, nil
+		}); err != nil {
+			return err
+		}
+
+		for _, delegator := range delegators {
+			skip, err := fn(delegator, delegatee)
+			if err != nil {
+				return err
+			}
+			if !skip {
+				queue = append(queue, delegator)
+			}
+		}
+	}
+
+	return nil
+}
+
+// iterateVoteDelegators calls fn for every account delegating its voting power, directly
+// or transitively, to voter and that did not vote on the proposal itself. A direct vote
+// overrides the vote delegation of the account and of the accounts delegating to it.
+func (k Keeper) iterateVoteDelegators(ctx context.Context, proposalID uint64, voter sdk.AccAddress, fn func(delegator sdk.AccAddress) error) error {
+	return k.walkVoteDelegationTree(ctx, voter, func(delegator, _ sdk.AccAddress) (bool, error) {
+		voted, err := k.Votes.Has(ctx, collections.Join(proposalID, delegator))
+		if err != nil {
+			return false, err
+		}
+		if voted {
+			return true, nil
+		}
+
+		return false, fn(delegator)
+	})
+}
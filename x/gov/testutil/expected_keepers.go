@@ -61,6 +61,8 @@ type StakingKeeper interface {
 		fn func(index int64, delegation sdk.DelegationI) (stop bool),
 	) error
 
+	GetEffectiveBondedTokens(ctx context.Context) (map[string]math.Int, error)
+
 	BondDenom(ctx context.Context) (string, error)
 	TokensFromConsensusPower(ctx context.Context, power int64) math.Int
 }
@@ -350,6 +350,21 @@ func (mr *MockStakingKeeperMockRecorder) BondDenom(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockStakingKeeper)(nil).BondDenom), ctx)
 }
 
+// GetEffectiveBondedTokens mocks base method.
+func (m *MockStakingKeeper) GetEffectiveBondedTokens(ctx context.Context) (map[string]math.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEffectiveBondedTokens", ctx)
+	ret0, _ := ret[0].(map[string]math.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEffectiveBondedTokens indicates an expected call of GetEffectiveBondedTokens.
+func (mr *MockStakingKeeperMockRecorder) GetEffectiveBondedTokens(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffectiveBondedTokens", reflect.TypeOf((*MockStakingKeeper)(nil).GetEffectiveBondedTokens), ctx)
+}
+
 // IterateBondedValidatorsByPower mocks base method.
 func (m *MockStakingKeeper) IterateBondedValidatorsByPower(arg0 context.Context, arg1 func(int64, types.ValidatorI) bool) error {
 	m.ctrl.T.Helper()
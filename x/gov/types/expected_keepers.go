@@ -22,6 +22,8 @@ type StakingKeeper interface {
 		ctx context.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation sdk.DelegationI) (stop bool),
 	) error
+	// bonded tokens of the bonded validators capped like their consensus power, nil if uncapped
+	GetEffectiveBondedTokens(ctx context.Context) (map[string]math.Int, error)
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
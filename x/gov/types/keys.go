@@ -26,6 +26,8 @@ var (
 	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
 	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
 	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	VoteDelegationsKeyPrefix     = collections.NewPrefix(52) // VoteDelegationsKeyPrefix stores the vote delegations by delegator.
+	VoteDelegatorsKeyPrefix      = collections.NewPrefix(53) // VoteDelegatorsKeyPrefix indexes the vote delegators by delegatee.
 )
 
 // Reserved kvstore keys
@@ -98,6 +98,11 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// verify vote delegations
+	errGroup.Go(func() error {
+		return validateVoteDelegations(ac, data.VoteDelegations)
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
@@ -106,6 +111,45 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 	return errGroup.Wait()
 }
 
+// validateVoteDelegations checks that the vote delegations have valid addresses,
+// that no account delegates its vote twice or to itself, and that there are no cycles.
+func validateVoteDelegations(ac address.Codec, delegations []*VoteDelegation) error {
+	delegatees := make(map[string]string, len(delegations))
+	for _, d := range delegations {
+		if _, err := ac.StringToBytes(d.Delegator); err != nil {
+			return fmt.Errorf("invalid vote delegation delegator address %s: %w", d.Delegator, err)
+		}
+		if _, err := ac.StringToBytes(d.Delegatee); err != nil {
+			return fmt.Errorf("invalid vote delegation delegatee address %s: %w", d.Delegatee, err)
+		}
+		if d.Delegator == d.Delegatee {
+			return fmt.Errorf("vote delegation of %s to itself", d.Delegator)
+		}
+		if _, ok := delegatees[d.Delegator]; ok {
+			return fmt.Errorf("duplicate vote delegation of %s", d.Delegator)
+		}
+
+		delegatees[d.Delegator] = d.Delegatee
+	}
+
+	// every delegation chain must end within len(delegations) hops
+	for _, d := range delegations {
+		current := d.Delegatee
+		for i := 0; i <= len(delegations); i++ {
+			next, ok := delegatees[current]
+			if !ok {
+				break
+			}
+			if next == d.Delegator {
+				return fmt.Errorf("vote delegation cycle involving %s", d.Delegator)
+			}
+			current = next
+		}
+	}
+
+	return nil
+}
+
 var _ gogoprotoany.UnpackInterfacesMessage = GenesisState{}
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
@@ -1,10 +1,12 @@
 package v1_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	addresscodec "cosmossdk.io/core/address"
 	sdkmath "cosmossdk.io/math"
 	v1 "cosmossdk.io/x/gov/types/v1"
 
@@ -23,6 +25,7 @@ func TestEmptyGenesis(t *testing.T) {
 func TestValidateGenesis(t *testing.T) {
 	codec := address.NewBech32Codec("cosmos")
 	params := v1.DefaultParams()
+	addr0, addr1, addr2 := testAddr(t, codec, 0), testAddr(t, codec, 1), testAddr(t, codec, 2)
 
 	testCases := []struct {
 		name         string
@@ -172,6 +175,114 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "valid tally strategies",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.TallyStrategies = []v1.ProposalTallyStrategy{
+					{ProposalType: v1.ProposalType_PROPOSAL_TYPE_STANDARD, TallyStrategy: v1.TallyStrategy_TALLY_STRATEGY_QUADRATIC},
+					{ProposalType: v1.ProposalType_PROPOSAL_TYPE_EXPEDITED, TallyStrategy: v1.TallyStrategy_TALLY_STRATEGY_CONVICTION},
+				}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+		},
+		{
+			name: "tally strategy of unspecified proposal type",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.TallyStrategies = []v1.ProposalTallyStrategy{
+					{ProposalType: v1.ProposalType_PROPOSAL_TYPE_UNSPECIFIED, TallyStrategy: v1.TallyStrategy_TALLY_STRATEGY_QUADRATIC},
+				}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "invalid proposal type of tally strategy: PROPOSAL_TYPE_UNSPECIFIED",
+		},
+		{
+			name: "invalid tally strategy",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.TallyStrategies = []v1.ProposalTallyStrategy{
+					{ProposalType: v1.ProposalType_PROPOSAL_TYPE_STANDARD, TallyStrategy: v1.TallyStrategy(42)},
+				}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "invalid tally strategy for proposal type PROPOSAL_TYPE_STANDARD: 42",
+		},
+		{
+			name: "duplicate tally strategy",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.TallyStrategies = []v1.ProposalTallyStrategy{
+					{ProposalType: v1.ProposalType_PROPOSAL_TYPE_STANDARD, TallyStrategy: v1.TallyStrategy_TALLY_STRATEGY_QUADRATIC},
+					{ProposalType: v1.ProposalType_PROPOSAL_TYPE_STANDARD, TallyStrategy: v1.TallyStrategy_TALLY_STRATEGY_CONVICTION},
+				}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "duplicate tally strategy for proposal type PROPOSAL_TYPE_STANDARD",
+		},
+		{
+			name: "valid vote delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoteDelegations = []*v1.VoteDelegation{
+					{Delegator: addr0, Delegatee: addr1},
+					{Delegator: addr1, Delegatee: addr2},
+				}
+
+				return state
+			},
+		},
+		{
+			name: "invalid vote delegation address",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoteDelegations = []*v1.VoteDelegation{{Delegator: addr0, Delegatee: "invalid"}}
+
+				return state
+			},
+			expErrMsg: "invalid vote delegation delegatee address invalid",
+		},
+		{
+			name: "vote delegation to self",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoteDelegations = []*v1.VoteDelegation{{Delegator: addr0, Delegatee: addr0}}
+
+				return state
+			},
+			expErrMsg: "vote delegation of " + addr0 + " to itself",
+		},
+		{
+			name: "duplicate vote delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoteDelegations = []*v1.VoteDelegation{
+					{Delegator: addr0, Delegatee: addr1},
+					{Delegator: addr0, Delegatee: addr2},
+				}
+
+				return state
+			},
+			expErrMsg: "duplicate vote delegation of " + addr0,
+		},
+		{
+			name: "vote delegation cycle",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoteDelegations = []*v1.VoteDelegation{
+					{Delegator: addr0, Delegatee: addr1},
+					{Delegator: addr1, Delegatee: addr2},
+					{Delegator: addr2, Delegatee: addr0},
+				}
+
+				return state
+			},
+			expErrMsg: "vote delegation cycle",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -186,3 +297,10 @@ func TestValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func testAddr(t *testing.T, ac addresscodec.Codec, i byte) string {
+	t.Helper()
+	addr, err := ac.BytesToString(bytes.Repeat([]byte{i + 1}, 20))
+	require.NoError(t, err)
+	return addr
+}
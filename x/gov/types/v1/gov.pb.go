@@ -73,6 +73,41 @@ func (ProposalType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{0}
 }
 
+// TallyStrategy enumerates the valid strategies to compute the voting power of voters when tallying a proposal.
+type TallyStrategy int32
+
+const (
+	// TALLY_STRATEGY_UNSPECIFIED defines no tally strategy, which fallback to the stake weighted tally
+	// (or the custom tally function set in the module config).
+	TallyStrategy_TALLY_STRATEGY_UNSPECIFIED TallyStrategy = 0
+	// TALLY_STRATEGY_QUADRATIC defines a quadratic tally, where the voting power of a voter is the
+	// square root of its delegated stake.
+	TallyStrategy_TALLY_STRATEGY_QUADRATIC TallyStrategy = 1
+	// TALLY_STRATEGY_CONVICTION defines a time-weighted tally, where the voting power of a voter is
+	// its delegated stake weighted by the fraction of the voting period during which its vote was held.
+	TallyStrategy_TALLY_STRATEGY_CONVICTION TallyStrategy = 2
+)
+
+var TallyStrategy_name = map[int32]string{
+	0: "TALLY_STRATEGY_UNSPECIFIED",
+	1: "TALLY_STRATEGY_QUADRATIC",
+	2: "TALLY_STRATEGY_CONVICTION",
+}
+
+var TallyStrategy_value = map[string]int32{
+	"TALLY_STRATEGY_UNSPECIFIED": 0,
+	"TALLY_STRATEGY_QUADRATIC":   1,
+	"TALLY_STRATEGY_CONVICTION":  2,
+}
+
+func (x TallyStrategy) String() string {
+	return proto.EnumName(TallyStrategy_name, int32(x))
+}
+
+func (TallyStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{1}
+}
+
 // VoteOption enumerates the valid vote options for a given governance proposal.
 type VoteOption int32
 
@@ -130,7 +165,7 @@ func (x VoteOption) String() string {
 }
 
 func (VoteOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{1}
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
 }
 
 // ProposalStatus enumerates the valid statuses of a proposal.
@@ -179,7 +214,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{2}
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
 }
 
 // WeightedVoteOption defines a unit of vote for vote split.
@@ -710,6 +745,8 @@ type Vote struct {
 	// metadata is any arbitrary metadata attached to the vote.
 	// the recommended format of the metadata is to be found here: https://docs.cosmos.network/v0.47/modules/gov#vote-5
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// submit_time is the time of the last vote submission of the voter on the proposal.
+	SubmitTime *time.Time `protobuf:"bytes,6,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
@@ -773,6 +810,13 @@ func (m *Vote) GetMetadata() string {
 	return ""
 }
 
+func (m *Vote) GetSubmitTime() *time.Time {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return nil
+}
+
 // DepositParams defines the params for deposits on governance proposals.
 //
 // Deprecated: Do not use.
@@ -1005,6 +1049,9 @@ type Params struct {
 	// considered valid for an expedited proposal.
 	ExpeditedQuorum      string `protobuf:"bytes,21,opt,name=expedited_quorum,json=expeditedQuorum,proto3" json:"expedited_quorum,omitempty"`
 	ProposalExecutionGas uint64 `protobuf:"varint,22,opt,name=proposal_execution_gas,json=proposalExecutionGas,proto3" json:"proposal_execution_gas,omitempty"`
+	// tally_strategies defines the tally strategy used per proposal type.
+	// Proposal types without a tally strategy use the stake weighted tally.
+	TallyStrategies []ProposalTallyStrategy `protobuf:"bytes,23,rep,name=tally_strategies,json=tallyStrategies,proto3" json:"tally_strategies"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1194,6 +1241,124 @@ func (m *Params) GetProposalExecutionGas() uint64 {
 	return 0
 }
 
+func (m *Params) GetTallyStrategies() []ProposalTallyStrategy {
+	if m != nil {
+		return m.TallyStrategies
+	}
+	return nil
+}
+
+// ProposalTallyStrategy defines the tally strategy of a proposal type.
+type ProposalTallyStrategy struct {
+	// proposal_type is the proposal type the tally strategy applies to.
+	ProposalType ProposalType `protobuf:"varint,1,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// tally_strategy is the tally strategy used for tallying the proposals of that type.
+	TallyStrategy TallyStrategy `protobuf:"varint,2,opt,name=tally_strategy,json=tallyStrategy,proto3,enum=cosmos.gov.v1.TallyStrategy" json:"tally_strategy,omitempty"`
+}
+
+func (m *ProposalTallyStrategy) Reset()         { *m = ProposalTallyStrategy{} }
+func (m *ProposalTallyStrategy) String() string { return proto.CompactTextString(m) }
+func (*ProposalTallyStrategy) ProtoMessage()    {}
+func (*ProposalTallyStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
+}
+func (m *ProposalTallyStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalTallyStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalTallyStrategy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalTallyStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalTallyStrategy.Merge(m, src)
+}
+func (m *ProposalTallyStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalTallyStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalTallyStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalTallyStrategy proto.InternalMessageInfo
+
+func (m *ProposalTallyStrategy) GetProposalType() ProposalType {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+}
+
+func (m *ProposalTallyStrategy) GetTallyStrategy() TallyStrategy {
+	if m != nil {
+		return m.TallyStrategy
+	}
+	return TallyStrategy_TALLY_STRATEGY_UNSPECIFIED
+}
+
+// VoteDelegation defines the delegation of the governance voting power of an account to another account.
+// The voting power of the delegator is used by the delegatee when the delegator does not vote itself.
+type VoteDelegation struct {
+	// delegator is the address of the account delegating its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegatee is the address of the account receiving the voting power.
+	Delegatee string `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+}
+
+func (m *VoteDelegation) Reset()         { *m = VoteDelegation{} }
+func (m *VoteDelegation) String() string { return proto.CompactTextString(m) }
+func (*VoteDelegation) ProtoMessage()    {}
+func (*VoteDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *VoteDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteDelegation.Merge(m, src)
+}
+func (m *VoteDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteDelegation proto.InternalMessageInfo
+
+func (m *VoteDelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *VoteDelegation) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
 // MessageBasedParams defines the parameters of specific messages in a proposal.
 // It is used to define the parameters of a proposal that is based on a specific message.
 // Once a message has message based params, it only supports a standard proposal type.
@@ -1215,7 +1380,7 @@ func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
 func (m *MessageBasedParams) String() string { return proto.CompactTextString(m) }
 func (*MessageBasedParams) ProtoMessage()    {}
 func (*MessageBasedParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{10}
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
 }
 func (m *MessageBasedParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1281,6 +1446,7 @@ func (m *MessageBasedParams) GetVetoThreshold() string {
 
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
+	proto.RegisterEnum("cosmos.gov.v1.TallyStrategy", TallyStrategy_name, TallyStrategy_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
@@ -1293,139 +1459,153 @@ func init() {
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*ProposalTallyStrategy)(nil), "cosmos.gov.v1.ProposalTallyStrategy")
+	proto.RegisterType((*VoteDelegation)(nil), "cosmos.gov.v1.VoteDelegation")
 	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 2006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
-	0x19, 0x0e, 0x25, 0xf9, 0x43, 0xaf, 0x25, 0x99, 0x1e, 0xdb, 0x31, 0x63, 0xaf, 0x3f, 0x62, 0x14,
-	0x0b, 0x37, 0xbb, 0x96, 0xec, 0x6c, 0xdd, 0x6e, 0xd3, 0xcd, 0x41, 0xb2, 0x98, 0x84, 0x41, 0x6c,
-	0xa9, 0x14, 0xe3, 0x24, 0x2d, 0x0a, 0x82, 0x36, 0x27, 0x32, 0x77, 0x45, 0x8e, 0x4a, 0x8e, 0x6c,
-	0xab, 0xbf, 0x62, 0x8f, 0x3d, 0x15, 0xbd, 0x75, 0x8f, 0x3d, 0x04, 0xbd, 0xf7, 0xd4, 0x45, 0x0f,
-	0xc5, 0x22, 0xa7, 0x62, 0x81, 0xa6, 0x45, 0x72, 0x28, 0xb0, 0x3f, 0xa1, 0xe8, 0xa1, 0x98, 0xe1,
-	0x50, 0xa4, 0x3e, 0x1c, 0xcb, 0x8b, 0x5e, 0x12, 0x79, 0xe6, 0x79, 0x9e, 0x79, 0xe7, 0xfd, 0x9a,
-	0x57, 0x82, 0xa5, 0x13, 0x12, 0xb8, 0x24, 0x28, 0x35, 0xc9, 0x59, 0xe9, 0x6c, 0x97, 0xfd, 0x57,
-	0x6c, 0xfb, 0x84, 0x12, 0x94, 0x0f, 0x37, 0x8a, 0x6c, 0xe5, 0x6c, 0x77, 0x79, 0x4d, 0xe0, 0x8e,
-	0xad, 0x00, 0x97, 0xce, 0x76, 0x8f, 0x31, 0xb5, 0x76, 0x4b, 0x27, 0xc4, 0xf1, 0x42, 0xf8, 0xf2,
-	0x42, 0x93, 0x34, 0x09, 0xff, 0x58, 0x62, 0x9f, 0xc4, 0xea, 0x7a, 0x93, 0x90, 0x66, 0x0b, 0x97,
-	0xf8, 0x5f, 0xc7, 0x9d, 0x97, 0x25, 0xea, 0xb8, 0x38, 0xa0, 0x96, 0xdb, 0x16, 0x80, 0x5b, 0x83,
-	0x00, 0xcb, 0xeb, 0x8a, 0xad, 0xb5, 0xc1, 0x2d, 0xbb, 0xe3, 0x5b, 0xd4, 0x21, 0xd1, 0x89, 0xb7,
-	0x42, 0x8b, 0xcc, 0xf0, 0x50, 0x61, 0x6d, 0xb8, 0x35, 0x67, 0xb9, 0x8e, 0x47, 0x4a, 0xfc, 0xdf,
-	0x70, 0x69, 0x93, 0x00, 0x7a, 0x86, 0x9d, 0xe6, 0x29, 0xc5, 0xf6, 0x11, 0xa1, 0xb8, 0xd6, 0x66,
-	0x4a, 0x68, 0x17, 0x26, 0x09, 0xff, 0xa4, 0x48, 0x1b, 0xd2, 0x56, 0xe1, 0xee, 0xad, 0x62, 0xdf,
-	0xad, 0x8b, 0x31, 0x54, 0x17, 0x40, 0xf4, 0x21, 0x4c, 0x9e, 0x73, 0x21, 0x25, 0xb5, 0x21, 0x6d,
-	0x65, 0x2b, 0x85, 0xd7, 0xaf, 0xb6, 0x41, 0xb0, 0xaa, 0xf8, 0x44, 0x17, 0xbb, 0x9b, 0xbf, 0x97,
-	0x60, 0xaa, 0x8a, 0xdb, 0x24, 0x70, 0x28, 0x5a, 0x87, 0x99, 0xb6, 0x4f, 0xda, 0x24, 0xb0, 0x5a,
-	0xa6, 0x63, 0xf3, 0xb3, 0x32, 0x3a, 0x44, 0x4b, 0x9a, 0x8d, 0x7e, 0x0c, 0x59, 0x3b, 0xc4, 0x12,
-	0x5f, 0xe8, 0x2a, 0xaf, 0x5f, 0x6d, 0x2f, 0x08, 0xdd, 0xb2, 0x6d, 0xfb, 0x38, 0x08, 0x1a, 0xd4,
-	0x77, 0xbc, 0xa6, 0x1e, 0x43, 0xd1, 0x67, 0x30, 0x69, 0xb9, 0xa4, 0xe3, 0x51, 0x25, 0xbd, 0x91,
-	0xde, 0x9a, 0x89, 0xed, 0x67, 0x61, 0x2a, 0x8a, 0x30, 0x15, 0xf7, 0x89, 0xe3, 0x55, 0xb2, 0x5f,
-	0xbf, 0x59, 0xbf, 0xf1, 0xd5, 0xbf, 0xff, 0x78, 0x47, 0xd2, 0x05, 0x67, 0xf3, 0xcf, 0x53, 0x30,
-	0x5d, 0x17, 0x46, 0xa0, 0x02, 0xa4, 0x7a, 0xa6, 0xa5, 0x1c, 0x1b, 0xed, 0xc0, 0xb4, 0x8b, 0x83,
-	0xc0, 0x6a, 0xe2, 0x40, 0x49, 0x71, 0xf1, 0x85, 0x62, 0x18, 0x91, 0x62, 0x14, 0x91, 0x62, 0xd9,
-	0xeb, 0xea, 0x3d, 0x14, 0xda, 0x83, 0xc9, 0x80, 0x5a, 0xb4, 0x13, 0x28, 0x69, 0xee, 0xcc, 0xd5,
-	0x01, 0x67, 0x46, 0x47, 0x35, 0x38, 0x48, 0x17, 0x60, 0xf4, 0x08, 0xd0, 0x4b, 0xc7, 0xb3, 0x5a,
-	0x26, 0xb5, 0x5a, 0xad, 0xae, 0xe9, 0xe3, 0xa0, 0xd3, 0xa2, 0x4a, 0x66, 0x43, 0xda, 0x9a, 0xb9,
-	0xbb, 0x3c, 0x20, 0x61, 0x30, 0x88, 0xce, 0x11, 0xba, 0xcc, 0x59, 0x89, 0x15, 0x54, 0x86, 0x99,
-	0xa0, 0x73, 0xec, 0x3a, 0xd4, 0x64, 0x69, 0xa6, 0x4c, 0x08, 0x89, 0x41, 0xab, 0x8d, 0x28, 0x07,
-	0x2b, 0x99, 0x2f, 0xff, 0xb9, 0x2e, 0xe9, 0x10, 0x92, 0xd8, 0x32, 0x7a, 0x0c, 0xb2, 0xf0, 0xae,
-	0x89, 0x3d, 0x3b, 0xd4, 0x99, 0x1c, 0x53, 0xa7, 0x20, 0x98, 0xaa, 0x67, 0x73, 0x2d, 0x0d, 0xf2,
-	0x94, 0x50, 0xab, 0x65, 0x8a, 0x75, 0x65, 0xea, 0x1a, 0x31, 0xca, 0x71, 0x6a, 0x94, 0x40, 0x4f,
-	0x60, 0xee, 0x8c, 0x50, 0xc7, 0x6b, 0x9a, 0x01, 0xb5, 0x7c, 0x71, 0xbf, 0xe9, 0x31, 0xed, 0x9a,
-	0x0d, 0xa9, 0x0d, 0xc6, 0xe4, 0x86, 0x3d, 0x02, 0xb1, 0x14, 0xdf, 0x31, 0x3b, 0xa6, 0x56, 0x3e,
-	0x24, 0x46, 0x57, 0x5c, 0x66, 0x49, 0x42, 0x2d, 0xdb, 0xa2, 0x96, 0x02, 0x2c, 0x6d, 0xf5, 0xde,
-	0xdf, 0xe8, 0x87, 0x30, 0x41, 0x1d, 0xda, 0xc2, 0xca, 0x0c, 0xcf, 0xe7, 0xf9, 0x6f, 0x5f, 0x6d,
-	0xcf, 0x86, 0x37, 0xdf, 0x0e, 0xec, 0x2f, 0x36, 0x76, 0x8a, 0x3f, 0xfa, 0x89, 0x1e, 0x22, 0xd0,
-	0x36, 0x4c, 0x05, 0x1d, 0xd7, 0xb5, 0xfc, 0xae, 0x92, 0xbb, 0x1c, 0x1c, 0x61, 0xd0, 0x43, 0x98,
-	0x0e, 0x6b, 0x07, 0xfb, 0x4a, 0x9e, 0xe3, 0x3f, 0xba, 0xac, 0x58, 0x46, 0xe9, 0xf4, 0xc8, 0xe8,
-	0x13, 0xc8, 0xe2, 0x8b, 0x36, 0xb6, 0x1d, 0x8a, 0x6d, 0xa5, 0xb0, 0x21, 0x6d, 0x4d, 0x57, 0x16,
-	0x87, 0x18, 0x7b, 0x3b, 0x8a, 0xa4, 0xc7, 0x38, 0xf4, 0x29, 0xe4, 0x5f, 0x5a, 0x4e, 0x0b, 0xdb,
-	0xa6, 0x8f, 0xad, 0x80, 0x78, 0xca, 0xec, 0x25, 0x26, 0xef, 0xed, 0xe8, 0xb9, 0x10, 0xa9, 0x73,
-	0x20, 0xd2, 0x21, 0xdf, 0x6b, 0x03, 0xb4, 0xdb, 0xc6, 0x8a, 0xcc, 0xeb, 0x64, 0xe5, 0x92, 0x3a,
-	0x31, 0xba, 0x6d, 0x5c, 0x91, 0xbf, 0x7d, 0xb5, 0x9d, 0xbb, 0x60, 0x7d, 0x79, 0xe3, 0x6c, 0xa7,
-	0x78, 0xb7, 0xb8, 0xa3, 0xe7, 0xda, 0x89, 0xfd, 0xcd, 0xbf, 0x4a, 0x30, 0x1f, 0x11, 0xe2, 0x6e,
-	0x15, 0xa0, 0x55, 0x80, 0xb0, 0x61, 0x99, 0xc4, 0xc3, 0xbc, 0xac, 0xb3, 0x7a, 0x36, 0x5c, 0xa9,
-	0x79, 0x38, 0xb1, 0x4d, 0xcf, 0x49, 0xd8, 0x71, 0xa2, 0x6d, 0xe3, 0x9c, 0xa0, 0xdb, 0x90, 0x8b,
-	0xb6, 0x4f, 0x7d, 0x8c, 0x79, 0x41, 0x67, 0xf5, 0x19, 0x01, 0x60, 0x4b, 0xac, 0xa7, 0x09, 0xc8,
-	0x4b, 0xd2, 0xf1, 0x79, 0xbd, 0x66, 0x75, 0x21, 0xfa, 0x80, 0x74, 0xfc, 0x04, 0x20, 0x68, 0x5b,
-	0x2e, 0xaf, 0xc6, 0x1e, 0xa0, 0xd1, 0xb6, 0xdc, 0x7b, 0xf2, 0xeb, 0x81, 0xab, 0x6d, 0xfe, 0x37,
-	0x0d, 0x33, 0xc9, 0x82, 0xde, 0x86, 0x6c, 0x17, 0x07, 0xe6, 0x09, 0xef, 0x70, 0xfc, 0x0e, 0x15,
-	0x39, 0xd1, 0x6e, 0x35, 0xb6, 0xaa, 0x4f, 0x77, 0x71, 0xb0, 0xcf, 0x10, 0x68, 0x0f, 0xf2, 0xd6,
-	0x71, 0x40, 0x2d, 0xc7, 0x13, 0x94, 0xd4, 0x25, 0x94, 0x9c, 0x80, 0x85, 0xb4, 0x8f, 0x60, 0xda,
-	0x23, 0x82, 0x91, 0xbe, 0x84, 0x31, 0xe5, 0x91, 0x10, 0x7c, 0x1f, 0x90, 0x47, 0xcc, 0x73, 0x87,
-	0x9e, 0x9a, 0x67, 0x98, 0x46, 0xb4, 0xcc, 0x25, 0xb4, 0x59, 0x8f, 0x3c, 0x73, 0xe8, 0xe9, 0x11,
-	0xa6, 0x82, 0xfe, 0x29, 0xc8, 0x71, 0x58, 0x04, 0x79, 0x62, 0xe8, 0x1d, 0xd1, 0x3c, 0xaa, 0x17,
-	0x7a, 0xc1, 0x1a, 0x64, 0xd2, 0xf3, 0xe8, 0xd8, 0xc9, 0xf7, 0x31, 0x8d, 0x73, 0x71, 0xe6, 0x67,
-	0x80, 0x92, 0xc1, 0x14, 0xdc, 0xa9, 0x91, 0x5c, 0x39, 0x11, 0xe2, 0x90, 0x7d, 0x0f, 0xe6, 0x12,
-	0x71, 0x16, 0xe4, 0xe9, 0x91, 0xe4, 0xd9, 0x38, 0xfa, 0x21, 0x77, 0x1b, 0x80, 0xc5, 0x5e, 0x90,
-	0xb2, 0x23, 0x49, 0x59, 0x86, 0xe0, 0xf0, 0xcd, 0x3f, 0x49, 0x90, 0x61, 0x39, 0x7c, 0xf5, 0x7b,
-	0x59, 0x84, 0x89, 0x33, 0x42, 0xf1, 0xd5, 0x6f, 0x65, 0x08, 0x43, 0x3f, 0x83, 0xa9, 0xd0, 0xb6,
-	0x40, 0xc9, 0xf0, 0x26, 0x7c, 0x7b, 0xa0, 0xe6, 0x86, 0x67, 0x03, 0x3d, 0x62, 0xf4, 0x35, 0xb9,
-	0x89, 0xfe, 0x26, 0xf7, 0x38, 0x33, 0x9d, 0x96, 0x33, 0x9b, 0xff, 0x90, 0x20, 0x2f, 0x5a, 0x75,
-	0xdd, 0xf2, 0x2d, 0x37, 0x40, 0x2f, 0x60, 0xc6, 0x75, 0xbc, 0x5e, 0xe7, 0x97, 0xae, 0xea, 0xfc,
-	0xab, 0xac, 0xf3, 0x7f, 0xf7, 0x66, 0x7d, 0x31, 0xc1, 0xfa, 0x98, 0xb8, 0x0e, 0xc5, 0x6e, 0x9b,
-	0x76, 0x75, 0x70, 0x1d, 0x2f, 0x7a, 0x0b, 0x5c, 0x40, 0xae, 0x75, 0x11, 0x81, 0xcc, 0x36, 0xf6,
-	0x1d, 0x62, 0x73, 0x47, 0xb0, 0x13, 0x06, 0x1b, 0x78, 0x55, 0x0c, 0x4d, 0x95, 0x1f, 0x7c, 0xf7,
-	0x66, 0xfd, 0x83, 0x61, 0x62, 0x7c, 0xc8, 0x6f, 0x59, 0x7f, 0x97, 0x5d, 0xeb, 0x22, 0xba, 0x09,
-	0xdf, 0xbf, 0x97, 0x52, 0xa4, 0xcd, 0xe7, 0x90, 0x3b, 0xe2, 0x7d, 0x5f, 0xdc, 0xae, 0x0a, 0xe2,
-	0x1d, 0x88, 0x4e, 0x97, 0xae, 0x3a, 0x3d, 0xc3, 0xd5, 0x73, 0x21, 0x2b, 0xa1, 0xfc, 0x3b, 0x49,
-	0x54, 0xbc, 0x50, 0xfe, 0x10, 0x26, 0x7f, 0xdd, 0x21, 0x7e, 0xc7, 0x15, 0xe5, 0x3e, 0x34, 0x5d,
-	0x85, 0xbb, 0xe8, 0x63, 0xc8, 0xb2, 0x64, 0x0e, 0x4e, 0x49, 0xcb, 0xbe, 0x64, 0x10, 0x8b, 0x01,
-	0x68, 0x0f, 0x0a, 0xbc, 0x58, 0x63, 0x4a, 0x7a, 0x24, 0x25, 0xcf, 0x50, 0x46, 0x04, 0xe2, 0x06,
-	0xfe, 0x25, 0x0f, 0x93, 0xc2, 0x36, 0xf5, 0x9a, 0x31, 0x4d, 0xbc, 0xe6, 0xc9, 0xf8, 0x1d, 0x7c,
-	0xbf, 0xf8, 0x65, 0x46, 0xc7, 0x67, 0x38, 0x16, 0xe9, 0xef, 0x11, 0x8b, 0x84, 0xdf, 0x33, 0xe3,
-	0xfb, 0x7d, 0xe2, 0xfa, 0x7e, 0x9f, 0x1c, 0xc3, 0xef, 0x48, 0x83, 0x5b, 0xcc, 0xd1, 0x8e, 0xe7,
-	0x50, 0x27, 0x1e, 0x9f, 0x4c, 0x6e, 0xfe, 0x88, 0xbe, 0xc5, 0x14, 0x6e, 0xba, 0x8e, 0xa7, 0x85,
-	0x78, 0xe1, 0x1e, 0x9d, 0xa1, 0xd1, 0x53, 0x58, 0xec, 0x75, 0x92, 0x13, 0xcb, 0x3b, 0xc1, 0x2d,
-	0x21, 0x13, 0x76, 0xb0, 0xdb, 0xfd, 0x32, 0xa3, 0x9e, 0xf0, 0xf9, 0x88, 0xbf, 0xcf, 0xe9, 0xa1,
-	0xec, 0xaf, 0x60, 0x61, 0x50, 0xd6, 0xc6, 0x41, 0xd4, 0xe2, 0xc6, 0x9f, 0x46, 0xf6, 0x76, 0x74,
-	0xd4, 0xaf, 0x5f, 0xc5, 0x01, 0x45, 0x9f, 0xc3, 0x52, 0x6f, 0xde, 0x30, 0xfb, 0xa3, 0x0b, 0x57,
-	0x45, 0x77, 0x89, 0x45, 0x77, 0xd4, 0x41, 0x8b, 0x3d, 0xc9, 0xa3, 0x64, 0xe4, 0x75, 0x98, 0x8f,
-	0xcf, 0x8a, 0x03, 0x35, 0x33, 0xae, 0x7f, 0x50, 0x8f, 0x1d, 0x07, 0xf0, 0x39, 0xc4, 0x87, 0x99,
-	0xc9, 0x9a, 0xc9, 0x5d, 0xa3, 0x66, 0x62, 0xb3, 0x0e, 0xe2, 0xe2, 0xb9, 0x0f, 0xf2, 0x71, 0xc7,
-	0xf7, 0x98, 0x53, 0xb0, 0x29, 0x32, 0x36, 0xcf, 0x07, 0xb7, 0x91, 0x23, 0x63, 0x81, 0x81, 0x59,
-	0x4f, 0xff, 0x79, 0x98, 0xbe, 0x47, 0xb0, 0xca, 0xe9, 0xbd, 0xe0, 0xf5, 0xaa, 0xd0, 0xc7, 0x4c,
-	0x52, 0x0c, 0x81, 0x23, 0xb5, 0x96, 0x19, 0x33, 0x1a, 0xb5, 0xa2, 0x1a, 0x0c, 0x69, 0xe8, 0xa7,
-	0x50, 0x88, 0xcd, 0x62, 0xc9, 0xcc, 0x87, 0xc2, 0x4b, 0x84, 0x72, 0x91, 0x51, 0x6c, 0x2c, 0x40,
-	0x07, 0x30, 0x97, 0xf0, 0x90, 0xc8, 0x4e, 0x79, 0x5c, 0xef, 0xcf, 0xc6, 0x8d, 0x25, 0xcc, 0xcc,
-	0x5f, 0xc2, 0xf2, 0x60, 0x66, 0xb2, 0x6e, 0x23, 0xb2, 0x67, 0x8e, 0xeb, 0xae, 0x0d, 0xe9, 0xf6,
-	0x4f, 0x98, 0x4b, 0xfd, 0x29, 0x79, 0x60, 0x5d, 0x88, 0x5c, 0x69, 0xc3, 0x3a, 0x7b, 0x14, 0x5d,
-	0x27, 0xa0, 0xce, 0x89, 0x69, 0x75, 0xe8, 0x29, 0xf1, 0x9d, 0xdf, 0x60, 0xdb, 0xb4, 0xc2, 0x2c,
-	0xc7, 0x81, 0x82, 0x36, 0xd2, 0x5b, 0xd9, 0xca, 0xd6, 0x7b, 0x2a, 0xa0, 0xff, 0xac, 0xd5, 0x58,
-	0xb0, 0xdc, 0xd3, 0x2b, 0x47, 0x72, 0xe8, 0x18, 0x12, 0x00, 0xd3, 0xc7, 0x9f, 0xe3, 0x93, 0xfe,
-	0x3c, 0x9d, 0x1f, 0xeb, 0x46, 0x2b, 0xb1, 0x88, 0x2e, 0x34, 0xe2, 0x6c, 0xbd, 0x0f, 0xc0, 0xa6,
-	0x4c, 0x91, 0x4d, 0x0b, 0x63, 0x09, 0xb2, 0xb9, 0x54, 0xe4, 0x94, 0x06, 0x72, 0x9c, 0xec, 0x42,
-	0x64, 0xf1, 0x0a, 0x91, 0xdd, 0xe2, 0x4e, 0x71, 0x47, 0x9f, 0xed, 0xf1, 0x84, 0xd4, 0x03, 0xb8,
-	0xd9, 0x0b, 0x1e, 0xbe, 0xc0, 0x27, 0x1d, 0x3e, 0x77, 0x35, 0xad, 0x40, 0xb9, 0xc9, 0x46, 0xa0,
-	0x11, 0x5f, 0x06, 0x7a, 0x6d, 0x48, 0x8d, 0xe0, 0x0f, 0xad, 0xe0, 0xde, 0xfc, 0xeb, 0xe1, 0xb4,
-	0xdb, 0xfc, 0x2a, 0x05, 0xe8, 0x20, 0xfc, 0xae, 0x5e, 0xb1, 0x02, 0x6c, 0xff, 0x3f, 0xdf, 0xf2,
-	0xc4, 0xfb, 0x91, 0x7a, 0xef, 0xfb, 0xb1, 0x3d, 0xc2, 0xd7, 0x43, 0x0f, 0x48, 0xec, 0xdb, 0xbe,
-	0xe7, 0x26, 0x7d, 0xfd, 0xe7, 0x26, 0x33, 0xce, 0x33, 0x3f, 0xf4, 0x3d, 0xe4, 0xce, 0x1f, 0x24,
-	0xc8, 0x25, 0xbf, 0x85, 0xa1, 0x55, 0xb8, 0x55, 0xd7, 0x6b, 0xf5, 0x5a, 0xa3, 0xfc, 0xc4, 0x34,
-	0x5e, 0xd4, 0x55, 0xf3, 0xe9, 0x61, 0xa3, 0xae, 0xee, 0x6b, 0x0f, 0x34, 0xb5, 0x2a, 0xdf, 0x40,
-	0xcb, 0x70, 0xb3, 0x7f, 0xbb, 0x61, 0x94, 0x0f, 0xab, 0x65, 0xbd, 0x2a, 0x4b, 0xe8, 0x36, 0xac,
-	0xf6, 0xef, 0x1d, 0x3c, 0x7d, 0x62, 0x68, 0xf5, 0x27, 0xaa, 0xb9, 0xff, 0xa8, 0xa6, 0xed, 0xab,
-	0x72, 0x0a, 0x7d, 0x00, 0x4a, 0x3f, 0xa4, 0x56, 0x37, 0xb4, 0x03, 0xad, 0x61, 0x68, 0xfb, 0x72,
-	0x1a, 0xad, 0xc0, 0x52, 0xff, 0xae, 0xfa, 0xbc, 0xae, 0x56, 0x35, 0x43, 0xad, 0xca, 0x99, 0x3b,
-	0xff, 0x91, 0x00, 0x12, 0xbf, 0x67, 0xad, 0xc0, 0xd2, 0x51, 0xcd, 0x08, 0x05, 0x6a, 0x87, 0x03,
-	0x56, 0xce, 0xc3, 0x6c, 0x72, 0xf3, 0x85, 0xda, 0x90, 0xa5, 0xc1, 0xc5, 0xda, 0xa1, 0x2a, 0x4b,
-	0x68, 0x09, 0xe6, 0x93, 0x8b, 0xe5, 0x4a, 0xc3, 0x28, 0x6b, 0x87, 0x72, 0x6a, 0x10, 0x6d, 0x3c,
-	0xab, 0xc9, 0x29, 0x84, 0xa0, 0x90, 0x5c, 0x3c, 0xac, 0xc9, 0x69, 0xb4, 0x08, 0x73, 0x7d, 0xc0,
-	0x47, 0xba, 0xaa, 0xca, 0x69, 0x76, 0xd3, 0x7e, 0xa8, 0xf9, 0x4c, 0x33, 0x1e, 0x99, 0x47, 0xaa,
-	0x51, 0x93, 0x33, 0x68, 0x01, 0xe4, 0xe4, 0xee, 0x83, 0xda, 0x53, 0x7d, 0x78, 0xb5, 0x51, 0x2f,
-	0x1f, 0xc8, 0x13, 0xcb, 0x29, 0x59, 0xba, 0xf3, 0x37, 0x09, 0x0a, 0xfd, 0x3f, 0x2a, 0xa1, 0x75,
-	0x58, 0xe9, 0x39, 0xab, 0x61, 0x94, 0x8d, 0xa7, 0x8d, 0x01, 0x27, 0x6c, 0xc2, 0xda, 0x20, 0xa0,
-	0xaa, 0xd6, 0x6b, 0x0d, 0xcd, 0x30, 0xeb, 0xaa, 0xae, 0xd5, 0x06, 0x43, 0x26, 0x30, 0x47, 0x35,
-	0x43, 0x3b, 0x7c, 0x18, 0x41, 0x52, 0x7d, 0x11, 0x17, 0x90, 0x7a, 0xb9, 0xd1, 0x50, 0xab, 0xe1,
-	0x25, 0x07, 0xf7, 0x74, 0xf5, 0xb1, 0xba, 0xcf, 0x23, 0x36, 0x8a, 0xf9, 0xa0, 0xac, 0x3d, 0x51,
-	0xab, 0xf2, 0x44, 0x65, 0xef, 0xeb, 0xb7, 0x6b, 0xd2, 0x37, 0x6f, 0xd7, 0xa4, 0x7f, 0xbd, 0x5d,
-	0x93, 0xbe, 0x7c, 0xb7, 0x76, 0xe3, 0x9b, 0x77, 0x6b, 0x37, 0xfe, 0xfe, 0x6e, 0xed, 0xc6, 0x2f,
-	0x56, 0xc2, 0xf4, 0x0d, 0xec, 0x2f, 0x8a, 0x0e, 0x29, 0xf1, 0x64, 0x2d, 0xd1, 0x6e, 0x1b, 0x07,
-	0xa5, 0xb3, 0xdd, 0xe3, 0x49, 0x5e, 0xa3, 0x9f, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x10, 0x4b,
-	0xae, 0xb9, 0xcc, 0x15, 0x00, 0x00,
+	// 2196 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x6f, 0x1b, 0xc7,
+	0x19, 0xf7, 0x92, 0xd4, 0x83, 0x9f, 0x48, 0x6a, 0x35, 0x92, 0xac, 0xb5, 0x64, 0x3d, 0x2c, 0x04,
+	0x81, 0xea, 0x44, 0xa4, 0xe4, 0x54, 0x6d, 0xea, 0x26, 0x40, 0xf9, 0x58, 0xdb, 0x1b, 0x48, 0x22,
+	0xb3, 0x5c, 0xc9, 0x76, 0x8b, 0x62, 0xb1, 0x12, 0xc7, 0xd4, 0x26, 0xdc, 0x5d, 0x76, 0x77, 0x29,
+	0x89, 0xfd, 0x0f, 0x7a, 0x0b, 0x7a, 0xca, 0xa9, 0xe8, 0xad, 0x39, 0x15, 0x39, 0xf8, 0x1f, 0xe8,
+	0x2d, 0xe8, 0xa1, 0x08, 0x7c, 0x2a, 0x02, 0xd4, 0x2d, 0xec, 0x43, 0x81, 0xfc, 0x09, 0x45, 0x0f,
+	0xc5, 0x3c, 0x96, 0xfb, 0x20, 0x65, 0xd1, 0x41, 0x2f, 0xb6, 0x38, 0xf3, 0xfb, 0xfd, 0xe6, 0x9b,
+	0xf9, 0x1e, 0xf3, 0xcd, 0xc2, 0xd2, 0xa9, 0xe3, 0x59, 0x8e, 0x57, 0x6a, 0x3b, 0xe7, 0xa5, 0xf3,
+	0x5d, 0xf2, 0x5f, 0xb1, 0xeb, 0x3a, 0xbe, 0x83, 0xf2, 0x6c, 0xa2, 0x48, 0x46, 0xce, 0x77, 0x97,
+	0xd7, 0x38, 0xee, 0xc4, 0xf0, 0x70, 0xe9, 0x7c, 0xf7, 0x04, 0xfb, 0xc6, 0x6e, 0xe9, 0xd4, 0x31,
+	0x6d, 0x06, 0x5f, 0x5e, 0x68, 0x3b, 0x6d, 0x87, 0xfe, 0x59, 0x22, 0x7f, 0xf1, 0xd1, 0xf5, 0xb6,
+	0xe3, 0xb4, 0x3b, 0xb8, 0x44, 0x7f, 0x9d, 0xf4, 0x9e, 0x95, 0x7c, 0xd3, 0xc2, 0x9e, 0x6f, 0x58,
+	0x5d, 0x0e, 0xb8, 0x95, 0x04, 0x18, 0x76, 0x9f, 0x4f, 0xad, 0x25, 0xa7, 0x5a, 0x3d, 0xd7, 0xf0,
+	0x4d, 0x27, 0x58, 0xf1, 0x16, 0xb3, 0x48, 0x67, 0x8b, 0x72, 0x6b, 0xd9, 0xd4, 0x9c, 0x61, 0x99,
+	0xb6, 0x53, 0xa2, 0xff, 0xb2, 0xa1, 0x4d, 0x07, 0xd0, 0x63, 0x6c, 0xb6, 0xcf, 0x7c, 0xdc, 0x3a,
+	0x76, 0x7c, 0x5c, 0xef, 0x12, 0x25, 0xb4, 0x0b, 0x93, 0x0e, 0xfd, 0x4b, 0x12, 0x36, 0x84, 0xad,
+	0xc2, 0xbd, 0x5b, 0xc5, 0xd8, 0xae, 0x8b, 0x21, 0x54, 0xe5, 0x40, 0xf4, 0x2e, 0x4c, 0x5e, 0x50,
+	0x21, 0x29, 0xb5, 0x21, 0x6c, 0x65, 0x2b, 0x85, 0x17, 0xcf, 0xb7, 0x81, 0xb3, 0x6a, 0xf8, 0x54,
+	0xe5, 0xb3, 0x9b, 0x7f, 0x14, 0x60, 0xaa, 0x86, 0xbb, 0x8e, 0x67, 0xfa, 0x68, 0x1d, 0x66, 0xba,
+	0xae, 0xd3, 0x75, 0x3c, 0xa3, 0xa3, 0x9b, 0x2d, 0xba, 0x56, 0x46, 0x85, 0x60, 0x48, 0x69, 0xa1,
+	0x9f, 0x40, 0xb6, 0xc5, 0xb0, 0x8e, 0xcb, 0x75, 0xa5, 0x17, 0xcf, 0xb7, 0x17, 0xb8, 0x6e, 0xb9,
+	0xd5, 0x72, 0xb1, 0xe7, 0x35, 0x7d, 0xd7, 0xb4, 0xdb, 0x6a, 0x08, 0x45, 0x1f, 0xc1, 0xa4, 0x61,
+	0x39, 0x3d, 0xdb, 0x97, 0xd2, 0x1b, 0xe9, 0xad, 0x99, 0xd0, 0x7e, 0xe2, 0xa6, 0x22, 0x77, 0x53,
+	0xb1, 0xea, 0x98, 0x76, 0x25, 0xfb, 0xcd, 0xcb, 0xf5, 0x1b, 0x5f, 0xfd, 0xfb, 0xeb, 0xbb, 0x82,
+	0xca, 0x39, 0x9b, 0x7f, 0x99, 0x82, 0xe9, 0x06, 0x37, 0x02, 0x15, 0x20, 0x35, 0x30, 0x2d, 0x65,
+	0xb6, 0xd0, 0x0e, 0x4c, 0x5b, 0xd8, 0xf3, 0x8c, 0x36, 0xf6, 0xa4, 0x14, 0x15, 0x5f, 0x28, 0x32,
+	0x8f, 0x14, 0x03, 0x8f, 0x14, 0xcb, 0x76, 0x5f, 0x1d, 0xa0, 0xd0, 0x1e, 0x4c, 0x7a, 0xbe, 0xe1,
+	0xf7, 0x3c, 0x29, 0x4d, 0x0f, 0x73, 0x35, 0x71, 0x98, 0xc1, 0x52, 0x4d, 0x0a, 0x52, 0x39, 0x18,
+	0x3d, 0x02, 0xf4, 0xcc, 0xb4, 0x8d, 0x8e, 0xee, 0x1b, 0x9d, 0x4e, 0x5f, 0x77, 0xb1, 0xd7, 0xeb,
+	0xf8, 0x52, 0x66, 0x43, 0xd8, 0x9a, 0xb9, 0xb7, 0x9c, 0x90, 0xd0, 0x08, 0x44, 0xa5, 0x08, 0x55,
+	0xa4, 0xac, 0xc8, 0x08, 0x2a, 0xc3, 0x8c, 0xd7, 0x3b, 0xb1, 0x4c, 0x5f, 0x27, 0x61, 0x26, 0x4d,
+	0x70, 0x89, 0xa4, 0xd5, 0x5a, 0x10, 0x83, 0x95, 0xcc, 0x17, 0xff, 0x5c, 0x17, 0x54, 0x60, 0x24,
+	0x32, 0x8c, 0x3e, 0x01, 0x91, 0x9f, 0xae, 0x8e, 0xed, 0x16, 0xd3, 0x99, 0x1c, 0x53, 0xa7, 0xc0,
+	0x99, 0xb2, 0xdd, 0xa2, 0x5a, 0x0a, 0xe4, 0x7d, 0xc7, 0x37, 0x3a, 0x3a, 0x1f, 0x97, 0xa6, 0xde,
+	0xc2, 0x47, 0x39, 0x4a, 0x0d, 0x02, 0x68, 0x1f, 0xe6, 0xce, 0x1d, 0xdf, 0xb4, 0xdb, 0xba, 0xe7,
+	0x1b, 0x2e, 0xdf, 0xdf, 0xf4, 0x98, 0x76, 0xcd, 0x32, 0x6a, 0x93, 0x30, 0xa9, 0x61, 0x8f, 0x80,
+	0x0f, 0x85, 0x7b, 0xcc, 0x8e, 0xa9, 0x95, 0x67, 0xc4, 0x60, 0x8b, 0xcb, 0x24, 0x48, 0x7c, 0xa3,
+	0x65, 0xf8, 0x86, 0x04, 0x24, 0x6c, 0xd5, 0xc1, 0x6f, 0xf4, 0x23, 0x98, 0xf0, 0x4d, 0xbf, 0x83,
+	0xa5, 0x19, 0x1a, 0xcf, 0xf3, 0xdf, 0x3d, 0xdf, 0x9e, 0x65, 0x3b, 0xdf, 0xf6, 0x5a, 0x9f, 0x6f,
+	0xec, 0x14, 0x7f, 0xfc, 0x53, 0x95, 0x21, 0xd0, 0x36, 0x4c, 0x79, 0x3d, 0xcb, 0x32, 0xdc, 0xbe,
+	0x94, 0xbb, 0x1a, 0x1c, 0x60, 0xd0, 0x43, 0x98, 0x66, 0xb9, 0x83, 0x5d, 0x29, 0x4f, 0xf1, 0xef,
+	0x5d, 0x95, 0x2c, 0xa3, 0x74, 0x06, 0x64, 0xf4, 0x01, 0x64, 0xf1, 0x65, 0x17, 0xb7, 0x4c, 0x1f,
+	0xb7, 0xa4, 0xc2, 0x86, 0xb0, 0x35, 0x5d, 0x59, 0x1c, 0x62, 0xec, 0xed, 0x48, 0x82, 0x1a, 0xe2,
+	0xd0, 0x87, 0x90, 0x7f, 0x66, 0x98, 0x1d, 0xdc, 0xd2, 0x5d, 0x6c, 0x78, 0x8e, 0x2d, 0xcd, 0x5e,
+	0x61, 0xf2, 0xde, 0x8e, 0x9a, 0x63, 0x48, 0x95, 0x02, 0x91, 0x0a, 0xf9, 0x41, 0x19, 0xf0, 0xfb,
+	0x5d, 0x2c, 0x89, 0x34, 0x4f, 0x56, 0xae, 0xc8, 0x13, 0xad, 0xdf, 0xc5, 0x15, 0xf1, 0xbb, 0xe7,
+	0xdb, 0xb9, 0x4b, 0x52, 0x97, 0x37, 0xce, 0x77, 0x8a, 0xf7, 0x8a, 0x3b, 0x6a, 0xae, 0x1b, 0x99,
+	0xdf, 0xfc, 0xab, 0x00, 0xf3, 0x01, 0x21, 0xac, 0x56, 0x1e, 0x5a, 0x05, 0x60, 0x05, 0x4b, 0x77,
+	0x6c, 0x4c, 0xd3, 0x3a, 0xab, 0x66, 0xd9, 0x48, 0xdd, 0xc6, 0x91, 0x69, 0xff, 0xc2, 0x61, 0x15,
+	0x27, 0x98, 0xd6, 0x2e, 0x1c, 0x74, 0x07, 0x72, 0xc1, 0xf4, 0x99, 0x8b, 0x31, 0x4d, 0xe8, 0xac,
+	0x3a, 0xc3, 0x01, 0x64, 0x88, 0xd4, 0x34, 0x0e, 0x79, 0xe6, 0xf4, 0x5c, 0x9a, 0xaf, 0x59, 0x95,
+	0x8b, 0x3e, 0x70, 0x7a, 0x6e, 0x04, 0xe0, 0x75, 0x0d, 0x8b, 0x66, 0xe3, 0x00, 0xd0, 0xec, 0x1a,
+	0xd6, 0x7d, 0xf1, 0x45, 0x62, 0x6b, 0x9b, 0xff, 0x4d, 0xc3, 0x4c, 0x34, 0xa1, 0xb7, 0x21, 0xdb,
+	0xc7, 0x9e, 0x7e, 0x4a, 0x2b, 0x1c, 0xdd, 0x43, 0x45, 0x8c, 0x94, 0x5b, 0x85, 0x8c, 0xaa, 0xd3,
+	0x7d, 0xec, 0x55, 0x09, 0x02, 0xed, 0x41, 0xde, 0x38, 0xf1, 0x7c, 0xc3, 0xb4, 0x39, 0x25, 0x75,
+	0x05, 0x25, 0xc7, 0x61, 0x8c, 0xf6, 0x1e, 0x4c, 0xdb, 0x0e, 0x67, 0xa4, 0xaf, 0x60, 0x4c, 0xd9,
+	0x0e, 0x03, 0x7f, 0x0c, 0xc8, 0x76, 0xf4, 0x0b, 0xd3, 0x3f, 0xd3, 0xcf, 0xb1, 0x1f, 0xd0, 0x32,
+	0x57, 0xd0, 0x66, 0x6d, 0xe7, 0xb1, 0xe9, 0x9f, 0x1d, 0x63, 0x9f, 0xd3, 0x3f, 0x04, 0x31, 0x74,
+	0x0b, 0x27, 0x4f, 0x0c, 0xdd, 0x23, 0x8a, 0xed, 0xab, 0x85, 0x81, 0xb3, 0x92, 0x4c, 0xff, 0x22,
+	0x58, 0x76, 0xf2, 0x4d, 0x4c, 0xed, 0x82, 0xaf, 0xf9, 0x11, 0xa0, 0xa8, 0x33, 0x39, 0x77, 0x6a,
+	0x24, 0x57, 0x8c, 0xb8, 0x98, 0xb1, 0xef, 0xc3, 0x5c, 0xc4, 0xcf, 0x9c, 0x3c, 0x3d, 0x92, 0x3c,
+	0x1b, 0x7a, 0x9f, 0x71, 0xb7, 0x01, 0x88, 0xef, 0x39, 0x29, 0x3b, 0x92, 0x94, 0x25, 0x08, 0x0a,
+	0xdf, 0xfc, 0x5d, 0x0a, 0x32, 0x24, 0x86, 0xaf, 0xbf, 0x2f, 0x8b, 0x30, 0x71, 0xee, 0xf8, 0xf8,
+	0xfa, 0xbb, 0x92, 0xc1, 0xd0, 0xcf, 0x61, 0x8a, 0xd9, 0xe6, 0x49, 0x19, 0x5a, 0x84, 0xef, 0x24,
+	0x72, 0x6e, 0xb8, 0x37, 0x50, 0x03, 0x46, 0xac, 0xc8, 0x4d, 0x24, 0x8a, 0xdc, 0xa7, 0xf1, 0x2b,
+	0xe7, 0xfa, 0xab, 0x62, 0x81, 0x94, 0xd1, 0x48, 0x4e, 0xef, 0x16, 0x77, 0x8a, 0x3b, 0xd1, 0x2b,
+	0xe8, 0x93, 0xcc, 0x74, 0x5a, 0xcc, 0x6c, 0xfe, 0x43, 0x80, 0x3c, 0xaf, 0xfe, 0x0d, 0xc3, 0x35,
+	0x2c, 0x0f, 0x3d, 0x85, 0x19, 0xcb, 0xb4, 0x07, 0x97, 0x89, 0x70, 0xdd, 0x65, 0xb2, 0x4a, 0x2e,
+	0x93, 0xef, 0x5f, 0xae, 0x2f, 0x46, 0x58, 0xef, 0x3b, 0x96, 0xe9, 0x63, 0xab, 0xeb, 0xf7, 0x55,
+	0xb0, 0x4c, 0x3b, 0xb8, 0x5e, 0x2c, 0x40, 0x96, 0x71, 0x19, 0x80, 0xf4, 0x2e, 0x76, 0x4d, 0xa7,
+	0x45, 0xcf, 0x96, 0xac, 0x90, 0xdc, 0x4c, 0x8d, 0xf7, 0x61, 0x95, 0x77, 0xbe, 0x7f, 0xb9, 0x7e,
+	0x7b, 0x98, 0x18, 0x2e, 0xf2, 0x25, 0xb9, 0x32, 0x44, 0xcb, 0xb8, 0x0c, 0x76, 0x42, 0xe7, 0xef,
+	0xa7, 0x24, 0x61, 0xf3, 0x09, 0xe4, 0x8e, 0xe9, 0x55, 0xc2, 0x77, 0x57, 0x03, 0x7e, 0xb5, 0x04,
+	0xab, 0x0b, 0xd7, 0xad, 0x9e, 0xa1, 0xea, 0x39, 0xc6, 0x8a, 0x28, 0xff, 0x41, 0xe0, 0x45, 0x84,
+	0x2b, 0xbf, 0x0b, 0x93, 0xbf, 0xe9, 0x39, 0x6e, 0xcf, 0xe2, 0x15, 0x64, 0xa8, 0x61, 0x63, 0xb3,
+	0xe8, 0x7d, 0xc8, 0x92, 0xfc, 0xf0, 0xce, 0x9c, 0x4e, 0xeb, 0x8a, 0xde, 0x2e, 0x04, 0xa0, 0x3d,
+	0x28, 0xd0, 0xfc, 0x0f, 0x29, 0xe9, 0x91, 0x94, 0x3c, 0x41, 0x69, 0x01, 0x88, 0x1a, 0xf8, 0xe7,
+	0x02, 0x4c, 0x72, 0xdb, 0xe4, 0xb7, 0xf4, 0x69, 0xa4, 0x41, 0x88, 0xfa, 0xef, 0xe0, 0x87, 0xf9,
+	0x2f, 0x33, 0xda, 0x3f, 0xc3, 0xbe, 0x48, 0xff, 0x00, 0x5f, 0x44, 0xce, 0x3d, 0x33, 0xfe, 0xb9,
+	0x4f, 0xbc, 0xfd, 0xb9, 0x4f, 0x8e, 0x71, 0xee, 0x48, 0x81, 0x5b, 0xe4, 0xa0, 0x4d, 0xdb, 0xf4,
+	0xcd, 0xb0, 0x23, 0xd3, 0xa9, 0xf9, 0x23, 0x4a, 0x21, 0x51, 0xb8, 0x69, 0x99, 0xb6, 0xc2, 0xf0,
+	0xfc, 0x78, 0x54, 0x82, 0x46, 0x47, 0xb0, 0x38, 0x28, 0x4e, 0xa7, 0x86, 0x7d, 0x8a, 0x3b, 0x5c,
+	0x86, 0x15, 0xc5, 0x3b, 0x71, 0x99, 0x51, 0x5d, 0xc1, 0x7c, 0xc0, 0xaf, 0x52, 0x3a, 0x93, 0xfd,
+	0x35, 0x2c, 0x24, 0x65, 0x5b, 0xd8, 0x0b, 0xaa, 0xe6, 0xf8, 0x0d, 0xce, 0xde, 0x8e, 0x8a, 0xe2,
+	0xfa, 0x35, 0xec, 0xf9, 0xe8, 0x33, 0x58, 0x1a, 0xb4, 0x30, 0x7a, 0xdc, 0xbb, 0x70, 0x9d, 0x77,
+	0x97, 0xbe, 0x64, 0x35, 0x6b, 0x68, 0xa1, 0xc5, 0x81, 0xe4, 0x71, 0xd4, 0xf3, 0x2a, 0xcc, 0x87,
+	0x6b, 0x85, 0x8e, 0x9a, 0x19, 0xf7, 0x7c, 0xd0, 0x80, 0x1d, 0x3a, 0xf0, 0x09, 0x84, 0x8b, 0xe9,
+	0xd1, 0x9c, 0xc9, 0xbd, 0x45, 0xce, 0x84, 0x66, 0x1d, 0x84, 0xc9, 0xf3, 0x31, 0x88, 0x27, 0x3d,
+	0xd7, 0x26, 0x87, 0x82, 0x75, 0x1e, 0xb1, 0x79, 0xda, 0x0b, 0x8e, 0xec, 0x42, 0x0b, 0x04, 0x4c,
+	0xae, 0x89, 0x4f, 0x59, 0xf8, 0x1e, 0xc3, 0x2a, 0xa5, 0x0f, 0x9c, 0x37, 0xc8, 0x42, 0x17, 0x13,
+	0x49, 0xde, 0x57, 0x8e, 0xd4, 0x5a, 0x26, 0xcc, 0xa0, 0x7b, 0x0b, 0x72, 0x90, 0xd1, 0xd0, 0xcf,
+	0xa0, 0x10, 0x9a, 0x45, 0x82, 0x99, 0xf6, 0x99, 0x57, 0x08, 0xe5, 0x02, 0xa3, 0x48, 0xa7, 0x81,
+	0x0e, 0x60, 0x2e, 0x72, 0x42, 0x3c, 0x3a, 0xc5, 0x71, 0x4f, 0x7f, 0x36, 0x2c, 0x2c, 0x2c, 0x32,
+	0x7f, 0x05, 0xcb, 0xc9, 0xc8, 0x24, 0xd5, 0x86, 0x47, 0xcf, 0x1c, 0xd5, 0x5d, 0x1b, 0xd2, 0x8d,
+	0x37, 0xad, 0x4b, 0xf1, 0x90, 0x3c, 0x30, 0x2e, 0x79, 0xac, 0x74, 0x61, 0x9d, 0xdc, 0xb3, 0x96,
+	0xe9, 0xf9, 0xe6, 0xa9, 0x6e, 0xf4, 0xfc, 0x33, 0xc7, 0x35, 0x7f, 0x8b, 0x5b, 0xba, 0xc1, 0xa2,
+	0x1c, 0x7b, 0x12, 0xda, 0x48, 0x6f, 0x65, 0x2b, 0x5b, 0x6f, 0xc8, 0x80, 0xf8, 0x5a, 0xab, 0xa1,
+	0x60, 0x79, 0xa0, 0x57, 0x0e, 0xe4, 0xd0, 0x09, 0x44, 0x00, 0xba, 0x8b, 0x3f, 0xc3, 0xa7, 0xf1,
+	0x38, 0x9d, 0x1f, 0x6b, 0x47, 0x2b, 0xa1, 0x88, 0xca, 0x35, 0xc2, 0x68, 0xfd, 0x18, 0x80, 0x34,
+	0xae, 0x3c, 0x9a, 0x16, 0xc6, 0x12, 0x24, 0xad, 0x2e, 0x8f, 0x29, 0x05, 0xc4, 0x30, 0xd8, 0xb9,
+	0xc8, 0xe2, 0x35, 0x22, 0xac, 0x91, 0x98, 0x1d, 0xf0, 0xb8, 0xd4, 0x03, 0xb8, 0x39, 0x70, 0x1e,
+	0xbe, 0xc4, 0xa7, 0x3d, 0xda, 0xca, 0xb5, 0x0d, 0x4f, 0xba, 0x49, 0xba, 0xaa, 0x11, 0xef, 0x8b,
+	0x41, 0x19, 0x92, 0x03, 0xf8, 0x43, 0xc3, 0x43, 0x18, 0x44, 0xf6, 0x3e, 0xf7, 0x7c, 0xd7, 0xf0,
+	0x71, 0xdb, 0xc4, 0x9e, 0xb4, 0x44, 0x53, 0xef, 0x9d, 0xab, 0x9e, 0x2f, 0x04, 0xde, 0x64, 0xe8,
+	0x7e, 0x65, 0x81, 0x64, 0xe1, 0xb0, 0xb9, 0x7e, 0x04, 0x64, 0x62, 0xef, 0xfe, 0xfc, 0x8b, 0xe1,
+	0xe8, 0xde, 0xfc, 0x5a, 0x80, 0xc5, 0x91, 0xaa, 0xe8, 0x17, 0xc9, 0x17, 0x95, 0x70, 0xed, 0x8b,
+	0x2a, 0xfe, 0x7e, 0x42, 0x55, 0x28, 0xc4, 0xf6, 0xd5, 0xa7, 0xd7, 0x66, 0xe1, 0xde, 0xed, 0x51,
+	0x5f, 0x1e, 0x82, 0x75, 0xd5, 0x7c, 0xd4, 0xee, 0x7e, 0xec, 0x25, 0x43, 0x37, 0xb6, 0xf9, 0x7b,
+	0x01, 0x0a, 0x24, 0x1f, 0x6b, 0xb8, 0x83, 0xdb, 0xb4, 0x8a, 0xb2, 0x6f, 0x3c, 0xf4, 0x97, 0xe3,
+	0xf2, 0x56, 0xe4, 0x8d, 0xdf, 0x78, 0x38, 0x34, 0xc2, 0xc3, 0x78, 0x9c, 0x6f, 0x43, 0x1c, 0x3a,
+	0xc2, 0xa8, 0xaf, 0x52, 0x80, 0x0e, 0xd8, 0xd7, 0x9a, 0x8a, 0xe1, 0xe1, 0xd6, 0xff, 0xb3, 0xf5,
+	0x8a, 0x5c, 0xf7, 0xa9, 0x37, 0x5e, 0xf7, 0xdb, 0x23, 0x52, 0x63, 0xe8, 0xbe, 0x0f, 0x53, 0x21,
+	0xd6, 0x1d, 0xa4, 0xdf, 0xbe, 0x3b, 0xc8, 0x8c, 0xd3, 0x95, 0x0d, 0xbd, 0x44, 0xef, 0xfe, 0x49,
+	0x80, 0x5c, 0x34, 0x6a, 0xd0, 0x2a, 0xdc, 0x6a, 0xa8, 0xf5, 0x46, 0xbd, 0x59, 0xde, 0xd7, 0xb5,
+	0xa7, 0x0d, 0x59, 0x3f, 0x3a, 0x6c, 0x36, 0xe4, 0xaa, 0xf2, 0x40, 0x91, 0x6b, 0xe2, 0x0d, 0xb4,
+	0x0c, 0x37, 0xe3, 0xd3, 0x4d, 0xad, 0x7c, 0x58, 0x2b, 0xab, 0x35, 0x51, 0x40, 0x77, 0x60, 0x35,
+	0x3e, 0x77, 0x70, 0xb4, 0xaf, 0x29, 0x8d, 0x7d, 0x59, 0xaf, 0x3e, 0xaa, 0x2b, 0x55, 0x59, 0x4c,
+	0xa1, 0xdb, 0x20, 0xc5, 0x21, 0xf5, 0x86, 0xa6, 0x1c, 0x28, 0x4d, 0x4d, 0xa9, 0x8a, 0x69, 0xb4,
+	0x02, 0x4b, 0xf1, 0x59, 0xf9, 0x49, 0x43, 0xae, 0x29, 0x9a, 0x5c, 0x13, 0x33, 0x77, 0x3b, 0x90,
+	0x8f, 0xe7, 0xc4, 0x1a, 0x2c, 0x6b, 0xe5, 0xfd, 0xfd, 0xa7, 0x7a, 0x53, 0x53, 0xcb, 0x9a, 0xfc,
+	0xf0, 0x69, 0xc2, 0xd4, 0xdb, 0x20, 0x25, 0xe6, 0x3f, 0x3d, 0x2a, 0xd7, 0xd4, 0x32, 0x59, 0x4b,
+	0x20, 0xfb, 0x4c, 0xcc, 0x56, 0xeb, 0x87, 0xc7, 0x4a, 0x55, 0x53, 0xea, 0x87, 0x62, 0xea, 0xee,
+	0x7f, 0x04, 0x80, 0xc8, 0xf7, 0xd3, 0x15, 0x58, 0x3a, 0xae, 0x6b, 0xcc, 0xdc, 0xfa, 0x61, 0x62,
+	0xa1, 0x79, 0x98, 0x8d, 0x4e, 0x3e, 0x95, 0x9b, 0xa2, 0x90, 0x1c, 0xac, 0x1f, 0xca, 0xa2, 0x80,
+	0x96, 0x60, 0x3e, 0x3a, 0x58, 0xae, 0x34, 0xb5, 0xb2, 0x72, 0x28, 0xa6, 0x92, 0x68, 0xed, 0x71,
+	0x5d, 0x4c, 0x21, 0x04, 0x85, 0xe8, 0xe0, 0x61, 0x5d, 0x4c, 0xa3, 0x45, 0x98, 0x8b, 0x01, 0x1f,
+	0xa9, 0xb2, 0x2c, 0xa6, 0xc9, 0x5e, 0xe3, 0x50, 0xfd, 0xb1, 0xa2, 0x3d, 0xd2, 0x8f, 0x65, 0xad,
+	0x2e, 0x66, 0xd0, 0x02, 0x88, 0xd1, 0xd9, 0x07, 0xf5, 0x23, 0x75, 0x78, 0xb4, 0xd9, 0x28, 0x1f,
+	0x88, 0x13, 0xcb, 0x29, 0x51, 0xb8, 0xfb, 0x37, 0x01, 0x0a, 0xf1, 0x8f, 0x98, 0x68, 0x1d, 0x56,
+	0x06, 0xae, 0x69, 0x6a, 0x65, 0xed, 0xa8, 0x99, 0x38, 0x84, 0x4d, 0x58, 0x4b, 0x02, 0x6a, 0x72,
+	0xa3, 0xde, 0x54, 0x34, 0xbd, 0x21, 0xab, 0x4a, 0x3d, 0x19, 0x20, 0x1c, 0x73, 0x5c, 0xd7, 0x94,
+	0xc3, 0x87, 0x01, 0x24, 0x15, 0x8b, 0x2f, 0x0e, 0x69, 0x94, 0x9b, 0x4d, 0xb9, 0xc6, 0x36, 0x99,
+	0x9c, 0x53, 0xe5, 0x4f, 0xe4, 0x2a, 0x8d, 0x8f, 0x51, 0xcc, 0x07, 0x65, 0x65, 0x5f, 0xae, 0x89,
+	0x13, 0x95, 0xbd, 0x6f, 0x5e, 0xad, 0x09, 0xdf, 0xbe, 0x5a, 0x13, 0xfe, 0xf5, 0x6a, 0x4d, 0xf8,
+	0xe2, 0xf5, 0xda, 0x8d, 0x6f, 0x5f, 0xaf, 0xdd, 0xf8, 0xfb, 0xeb, 0xb5, 0x1b, 0xbf, 0x5c, 0x61,
+	0xc9, 0xe2, 0xb5, 0x3e, 0x2f, 0x9a, 0x4e, 0x89, 0xa6, 0x46, 0x89, 0x14, 0x58, 0xaf, 0x74, 0xbe,
+	0x7b, 0x32, 0x49, 0x2b, 0xc2, 0x07, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x78, 0x67, 0x84, 0xa0,
+	0x3c, 0x18, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1841,6 +2021,16 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SubmitTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1898,12 +2088,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1945,12 +2135,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2021,6 +2211,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TallyStrategies) > 0 {
+		for iNdEx := len(m.TallyStrategies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TallyStrategies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
 	if m.ProposalExecutionGas != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalExecutionGas))
 		i--
@@ -2136,12 +2342,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -2188,22 +2394,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2224,6 +2430,76 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalTallyStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalTallyStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalTallyStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TallyStrategy != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.TallyStrategy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalType != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2275,12 +2551,12 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.VotingPeriod != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err11 != nil {
-			return 0, err11
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n11
-		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2506,6 +2782,10 @@ func (m *Vote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2658,6 +2938,44 @@ func (m *Params) Size() (n int) {
 	if m.ProposalExecutionGas != 0 {
 		n += 2 + sovGov(uint64(m.ProposalExecutionGas))
 	}
+	if len(m.TallyStrategies) > 0 {
+		for _, e := range m.TallyStrategies {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalTallyStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalType != 0 {
+		n += 1 + sovGov(uint64(m.ProposalType))
+	}
+	if m.TallyStrategy != 0 {
+		n += 1 + sovGov(uint64(m.TallyStrategy))
+	}
+	return n
+}
+
+func (m *VoteDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -4161,6 +4479,42 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5234,6 +5588,242 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyStrategies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TallyStrategies = append(m.TallyStrategies, ProposalTallyStrategy{})
+			if err := m.TallyStrategies[len(m.TallyStrategies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalTallyStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalTallyStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalTallyStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			m.ProposalType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalType |= ProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyStrategy", wireType)
+			}
+			m.TallyStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TallyStrategy |= TallyStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegatee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
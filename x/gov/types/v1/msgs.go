@@ -135,6 +135,21 @@ func NewMsgCancelProposal(proposalID uint64, proposer string) *MsgCancelProposal
 	}
 }
 
+// NewMsgDelegateVote creates a new MsgDelegateVote instance.
+func NewMsgDelegateVote(delegator, delegatee string) *MsgDelegateVote {
+	return &MsgDelegateVote{
+		Delegator: delegator,
+		Delegatee: delegatee,
+	}
+}
+
+// NewMsgUndelegateVote creates a new MsgUndelegateVote instance.
+func NewMsgUndelegateVote(delegator string) *MsgUndelegateVote {
+	return &MsgUndelegateVote{
+		Delegator: delegator,
+	}
+}
+
 // GetSudoedMsg returns the cache values from the MsgSudoExec.Msg if present.
 func (msg *MsgSudoExec) GetSudoedMsg() (sdk.Msg, error) {
 	if msg.Msg == nil {
@@ -275,9 +275,39 @@ func (p Params) ValidateBasic(addressCodec address.Codec) error {
 		return fmt.Errorf("proposal execution gas must be positive: %d", p.ProposalExecutionGas)
 	}
 
+	proposalTypes := make(map[ProposalType]bool, len(p.TallyStrategies))
+	for _, ts := range p.TallyStrategies {
+		if _, ok := ProposalType_name[int32(ts.ProposalType)]; !ok || ts.ProposalType == ProposalType_PROPOSAL_TYPE_UNSPECIFIED {
+			return fmt.Errorf("invalid proposal type of tally strategy: %s", ts.ProposalType)
+		}
+		if _, ok := TallyStrategy_name[int32(ts.TallyStrategy)]; !ok {
+			return fmt.Errorf("invalid tally strategy for proposal type %s: %s", ts.ProposalType, ts.TallyStrategy)
+		}
+		if proposalTypes[ts.ProposalType] {
+			return fmt.Errorf("duplicate tally strategy for proposal type %s", ts.ProposalType)
+		}
+		proposalTypes[ts.ProposalType] = true
+	}
+
 	return nil
 }
 
+// GetTallyStrategy returns the tally strategy of the given proposal type.
+// Proposals without a proposal type are considered standard proposals.
+func (p Params) GetTallyStrategy(proposalType ProposalType) TallyStrategy {
+	if proposalType == ProposalType_PROPOSAL_TYPE_UNSPECIFIED {
+		proposalType = ProposalType_PROPOSAL_TYPE_STANDARD
+	}
+
+	for _, ts := range p.TallyStrategies {
+		if ts.ProposalType == proposalType {
+			return ts.TallyStrategy
+		}
+	}
+
+	return TallyStrategy_TALLY_STRATEGY_UNSPECIFIED
+}
+
 // ValidateBasic performs basic validation on governance parameters.
 func (p MessageBasedParams) ValidateBasic() error {
 	if p.VotingPeriod == nil {
@@ -1169,6 +1169,182 @@ func (m *MsgSudoExecResponse) GetResult() []byte {
 	return nil
 }
 
+// MsgDelegateVote defines a message to delegate the governance voting power of an account to another account.
+// The delegatee votes on behalf of the delegator on every proposal the delegator does not vote on itself.
+type MsgDelegateVote struct {
+	// delegator is the address of the account delegating its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegatee is the address of the account receiving the voting power.
+	Delegatee string `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+}
+
+func (m *MsgDelegateVote) Reset()         { *m = MsgDelegateVote{} }
+func (m *MsgDelegateVote) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVote) ProtoMessage()    {}
+func (*MsgDelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{20}
+}
+func (m *MsgDelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVote.Merge(m, src)
+}
+func (m *MsgDelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVote proto.InternalMessageInfo
+
+func (m *MsgDelegateVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgDelegateVote) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+// MsgDelegateVoteResponse defines the Msg/DelegateVote response type.
+type MsgDelegateVoteResponse struct {
+}
+
+func (m *MsgDelegateVoteResponse) Reset()         { *m = MsgDelegateVoteResponse{} }
+func (m *MsgDelegateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVoteResponse) ProtoMessage()    {}
+func (*MsgDelegateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{21}
+}
+func (m *MsgDelegateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVoteResponse.Merge(m, src)
+}
+func (m *MsgDelegateVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVoteResponse proto.InternalMessageInfo
+
+// MsgUndelegateVote defines a message to remove the vote delegation of an account.
+type MsgUndelegateVote struct {
+	// delegator is the address of the account removing its vote delegation.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *MsgUndelegateVote) Reset()         { *m = MsgUndelegateVote{} }
+func (m *MsgUndelegateVote) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateVote) ProtoMessage()    {}
+func (*MsgUndelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{22}
+}
+func (m *MsgUndelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateVote.Merge(m, src)
+}
+func (m *MsgUndelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateVote proto.InternalMessageInfo
+
+func (m *MsgUndelegateVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+// MsgUndelegateVoteResponse defines the Msg/UndelegateVote response type.
+type MsgUndelegateVoteResponse struct {
+}
+
+func (m *MsgUndelegateVoteResponse) Reset()         { *m = MsgUndelegateVoteResponse{} }
+func (m *MsgUndelegateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateVoteResponse) ProtoMessage()    {}
+func (*MsgUndelegateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{23}
+}
+func (m *MsgUndelegateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateVoteResponse.Merge(m, src)
+}
+func (m *MsgUndelegateVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateVoteResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -1190,105 +1366,116 @@ func init() {
 	proto.RegisterType((*MsgUpdateMessageParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateMessageParamsResponse")
 	proto.RegisterType((*MsgSudoExec)(nil), "cosmos.gov.v1.MsgSudoExec")
 	proto.RegisterType((*MsgSudoExecResponse)(nil), "cosmos.gov.v1.MsgSudoExecResponse")
+	proto.RegisterType((*MsgDelegateVote)(nil), "cosmos.gov.v1.MsgDelegateVote")
+	proto.RegisterType((*MsgDelegateVoteResponse)(nil), "cosmos.gov.v1.MsgDelegateVoteResponse")
+	proto.RegisterType((*MsgUndelegateVote)(nil), "cosmos.gov.v1.MsgUndelegateVote")
+	proto.RegisterType((*MsgUndelegateVoteResponse)(nil), "cosmos.gov.v1.MsgUndelegateVoteResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1481 bytes of a gzipped FileDescriptorProto
+	// 1597 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x89, 0xed, 0x4c, 0xdc, 0xa4, 0xd9, 0xb8, 0xc9, 0x66, 0x9b, 0xda, 0xee, 0x16,
-	0xa8, 0x49, 0xf1, 0xda, 0x4e, 0x9b, 0x02, 0xa6, 0xaa, 0x54, 0x87, 0x02, 0x95, 0x30, 0x54, 0xdb,
-	0x0f, 0x24, 0xa8, 0x64, 0x6d, 0xbc, 0xc3, 0x66, 0x55, 0xaf, 0x67, 0xf1, 0xac, 0xad, 0xf8, 0x80,
-	0x84, 0x38, 0xf6, 0xd4, 0x0b, 0x12, 0x42, 0xe2, 0x0e, 0x9c, 0x72, 0xf0, 0x09, 0x8e, 0x5c, 0xaa,
-	0x1c, 0x50, 0x95, 0x03, 0x42, 0x3d, 0xb4, 0xa8, 0x15, 0x44, 0xe2, 0x9f, 0x00, 0xcd, 0xec, 0xec,
-	0xae, 0xbd, 0xbb, 0x76, 0xd2, 0x22, 0x21, 0x2e, 0xad, 0xf7, 0x7d, 0xcd, 0x7b, 0xbf, 0x79, 0xf3,
-	0x7b, 0x2f, 0x60, 0xa9, 0x81, 0xb0, 0x89, 0x70, 0x51, 0x47, 0xdd, 0x62, 0xb7, 0x5c, 0xb4, 0x77,
-	0x64, 0xab, 0x8d, 0x6c, 0xc4, 0x1f, 0x73, 0xe4, 0xb2, 0x8e, 0xba, 0x72, 0xb7, 0x2c, 0x66, 0x98,
-	0xd9, 0x96, 0x8a, 0x61, 0xb1, 0x5b, 0xde, 0x82, 0xb6, 0x5a, 0x2e, 0x36, 0x90, 0xd1, 0x72, 0xcc,
-	0xc5, 0xe5, 0xe1, 0x30, 0xc4, 0xcb, 0x51, 0xa4, 0x75, 0xa4, 0x23, 0xfa, 0xb3, 0x48, 0x7e, 0x31,
-	0xe9, 0x8a, 0x63, 0x5e, 0x77, 0x14, 0xec, 0x28, 0xa6, 0xd2, 0x11, 0xd2, 0x9b, 0xb0, 0x48, 0xbf,
-	0xb6, 0x3a, 0x9f, 0x16, 0xd5, 0x56, 0x2f, 0x70, 0x88, 0x89, 0x75, 0x72, 0x88, 0x89, 0x75, 0xa6,
-	0x58, 0x50, 0x4d, 0xa3, 0x85, 0x8a, 0xf4, 0x5f, 0x26, 0xca, 0x06, 0xc3, 0xd8, 0x86, 0x09, 0xb1,
-	0xad, 0x9a, 0x96, 0x63, 0x20, 0xfd, 0x38, 0x05, 0x16, 0x6a, 0x58, 0xbf, 0xd1, 0xd9, 0x32, 0x0d,
-	0xfb, 0x7a, 0x1b, 0x59, 0x08, 0xab, 0x4d, 0xbe, 0x04, 0x92, 0x26, 0xc4, 0x58, 0xd5, 0x21, 0x16,
-	0xb8, 0x5c, 0x2c, 0x3f, 0xbb, 0x9e, 0x96, 0x9d, 0x48, 0xb2, 0x1b, 0x49, 0xbe, 0xd2, 0xea, 0x29,
-	0x9e, 0x15, 0x7f, 0x8f, 0x03, 0xf3, 0x46, 0xcb, 0xb0, 0x0d, 0xb5, 0x59, 0xd7, 0xa0, 0x85, 0xb0,
-	0x61, 0x0b, 0x93, 0xd4, 0x73, 0x45, 0x66, 0x85, 0x11, 0xd0, 0x64, 0x06, 0x9a, 0xbc, 0x89, 0x8c,
-	0x56, 0xf5, 0x9d, 0x07, 0x8f, 0xb3, 0x13, 0x3f, 0x3c, 0xc9, 0xe6, 0x75, 0xc3, 0xde, 0xee, 0x6c,
-	0xc9, 0x0d, 0x64, 0x32, 0x14, 0xd8, 0x7f, 0x05, 0xac, 0xdd, 0x2d, 0xda, 0x3d, 0x0b, 0x62, 0xea,
-	0x80, 0xbf, 0x39, 0xd8, 0x5d, 0x4b, 0x35, 0xa1, 0xae, 0x36, 0x7a, 0x75, 0x02, 0x3b, 0xfe, 0xee,
-	0x60, 0x77, 0x8d, 0x53, 0xe6, 0xd8, 0xc9, 0x6f, 0x3b, 0x07, 0xf3, 0x17, 0x40, 0xd2, 0xa2, 0xa5,
-	0xc0, 0xb6, 0x10, 0xcb, 0x71, 0xf9, 0x99, 0xaa, 0xb0, 0xdf, 0x2f, 0xa4, 0x59, 0x1e, 0x57, 0x34,
-	0xad, 0x0d, 0x31, 0xbe, 0x61, 0xb7, 0x8d, 0x96, 0xae, 0x78, 0x96, 0xbc, 0x48, 0x8a, 0xb6, 0x55,
-	0x4d, 0xb5, 0x55, 0x61, 0x8a, 0x78, 0x29, 0xde, 0x37, 0xff, 0x2a, 0x98, 0xb6, 0x0d, 0xbb, 0x09,
-	0x85, 0x69, 0x1a, 0x6e, 0xf1, 0x51, 0xbf, 0x30, 0xef, 0xa7, 0x98, 0x2b, 0xc9, 0x17, 0x5e, 0x57,
-	0x1c, 0x0b, 0xbe, 0x00, 0x12, 0xb8, 0x63, 0x9a, 0x6a, 0xbb, 0x27, 0xc4, 0x47, 0x1b, 0xbb, 0x36,
-	0xfc, 0x79, 0x30, 0x03, 0x77, 0x2c, 0xa8, 0x19, 0x36, 0xd4, 0x84, 0x44, 0x8e, 0xcb, 0x27, 0xab,
-	0x27, 0x42, 0x0e, 0x1b, 0x25, 0x81, 0x53, 0x7c, 0x3b, 0x5e, 0x01, 0xc7, 0x2c, 0x76, 0x57, 0x75,
-	0x02, 0x8f, 0x90, 0xcc, 0x71, 0xf9, 0xb9, 0xf5, 0x93, 0xf2, 0x50, 0xbb, 0xca, 0xee, 0x7d, 0xde,
-	0xec, 0x59, 0xb0, 0x7a, 0xfc, 0x51, 0xbf, 0x90, 0xda, 0x21, 0x3d, 0x99, 0xeb, 0x96, 0xe4, 0x75,
-	0xb9, 0xa4, 0xa4, 0xac, 0x01, 0x7d, 0xa5, 0xfc, 0xe5, 0xc1, 0xee, 0x9a, 0x87, 0xc6, 0xbd, 0x83,
-	0xdd, 0xb5, 0xec, 0xc0, 0x25, 0x74, 0xcb, 0xc5, 0x50, 0x9b, 0x48, 0x97, 0xc0, 0x4a, 0x48, 0xa8,
-	0x40, 0x6c, 0xa1, 0x16, 0x86, 0x7c, 0x16, 0xcc, 0x7a, 0x39, 0x1a, 0x9a, 0xc0, 0xe5, 0xb8, 0xfc,
-	0x94, 0x02, 0x5c, 0xd1, 0x35, 0x4d, 0xfa, 0x89, 0x03, 0xe9, 0x1a, 0xd6, 0xaf, 0xee, 0xc0, 0xc6,
-	0xfb, 0xf4, 0x4a, 0x37, 0x51, 0xcb, 0x86, 0x2d, 0x9b, 0xff, 0x00, 0x24, 0x1a, 0xce, 0x4f, 0xea,
-	0x35, 0xa2, 0xf9, 0xaa, 0x99, 0xbd, 0x7e, 0x41, 0x1c, 0x2a, 0xd8, 0x6d, 0x2d, 0xea, 0xab, 0xb8,
-	0x41, 0xf8, 0x55, 0x30, 0xa3, 0x76, 0xec, 0x6d, 0xd4, 0x36, 0xec, 0x9e, 0x30, 0x49, 0x6f, 0xd6,
-	0x17, 0x54, 0x36, 0x48, 0xdd, 0xfe, 0x37, 0x29, 0x5c, 0x0a, 0x15, 0x1e, 0x4a, 0x52, 0xca, 0x80,
-	0xd5, 0x28, 0xb9, 0x5b, 0xbe, 0xf4, 0x07, 0x07, 0x12, 0x35, 0xac, 0xdf, 0x46, 0x36, 0xe4, 0x37,
-	0x22, 0xa0, 0xa8, 0xa6, 0xff, 0x7a, 0x9c, 0x1d, 0x14, 0x3b, 0xad, 0x3c, 0x00, 0x10, 0x2f, 0x83,
-	0xe9, 0x2e, 0xb2, 0x61, 0xdb, 0xc9, 0x79, 0x4c, 0x0f, 0x3b, 0x66, 0x7c, 0x19, 0xc4, 0x91, 0x65,
-	0x1b, 0xa8, 0x45, 0x9b, 0x7e, 0xce, 0x7f, 0x79, 0xac, 0x1d, 0x48, 0x2e, 0x1f, 0x52, 0x03, 0x85,
-	0x19, 0x8e, 0xeb, 0xf9, 0xca, 0x4b, 0x04, 0x18, 0x27, 0x34, 0x01, 0xe5, 0x44, 0x08, 0x14, 0x12,
-	0x4f, 0x5a, 0x00, 0xf3, 0xec, 0xa7, 0x57, 0xfa, 0xdf, 0x9c, 0x27, 0xfb, 0x08, 0x1a, 0xfa, 0x36,
-	0xe9, 0xd8, 0xff, 0x08, 0x82, 0xb7, 0x40, 0xc2, 0xa9, 0x0c, 0x0b, 0x31, 0xca, 0x3e, 0xa7, 0x03,
-	0x18, 0xb8, 0x09, 0x0d, 0x60, 0xe1, 0x7a, 0x8c, 0x05, 0xe3, 0xb5, 0x61, 0x30, 0x4e, 0x45, 0x82,
-	0xe1, 0x06, 0x97, 0x56, 0xc0, 0x72, 0x40, 0xe4, 0x81, 0xf3, 0x27, 0x07, 0x40, 0x0d, 0xeb, 0x2e,
-	0x55, 0xbd, 0x20, 0x2e, 0x17, 0xc1, 0x0c, 0x63, 0x59, 0x74, 0x38, 0x36, 0xbe, 0x29, 0x7f, 0x09,
-	0xc4, 0x55, 0x13, 0x75, 0x5a, 0x36, 0x83, 0x67, 0x0c, 0x39, 0xcf, 0x10, 0x72, 0x76, 0x4e, 0x66,
-	0x3e, 0x95, 0x73, 0xf4, 0xa9, 0x78, 0xd1, 0x08, 0x10, 0x42, 0x08, 0x08, 0x56, 0x99, 0x94, 0x06,
-	0xbc, 0xff, 0xe5, 0x95, 0xff, 0x8b, 0xd3, 0x1b, 0xb7, 0x2c, 0x4d, 0xb5, 0xe1, 0x75, 0xb5, 0xad,
-	0x9a, 0x98, 0x14, 0xe3, 0xbf, 0x4f, 0xee, 0xb0, 0x62, 0x3c, 0x53, 0xfe, 0x0d, 0x10, 0xb7, 0x68,
-	0x04, 0x8a, 0xc0, 0xec, 0xfa, 0x89, 0x20, 0xfd, 0x51, 0xe5, 0x50, 0x21, 0x8e, 0x7d, 0xe5, 0xda,
-	0x7e, 0x98, 0x92, 0xc3, 0x34, 0x70, 0x66, 0xa0, 0xb6, 0x1d, 0x77, 0xa6, 0x07, 0x92, 0x97, 0x64,
-	0x7a, 0xd5, 0x83, 0x22, 0xb7, 0xd6, 0xca, 0x62, 0xc4, 0x29, 0xd2, 0xb7, 0x1c, 0x1d, 0xb8, 0x9b,
-	0x6a, 0xab, 0x01, 0x9b, 0x03, 0x03, 0x37, 0xa2, 0x0d, 0xe6, 0x03, 0x6d, 0x30, 0xd4, 0x01, 0x83,
-	0x33, 0x6e, 0xf2, 0xa8, 0x33, 0xae, 0x92, 0xdb, 0x0f, 0x8f, 0x96, 0x21, 0xde, 0x97, 0x7e, 0xe5,
-	0x28, 0xa9, 0x0f, 0xe7, 0xe7, 0x91, 0xfa, 0xf3, 0xe7, 0x79, 0x0d, 0x1c, 0x6b, 0xd0, 0x58, 0x50,
-	0xab, 0x93, 0xe5, 0x83, 0xdd, 0x95, 0x18, 0xa2, 0xf4, 0x9b, 0xee, 0x66, 0x52, 0x4d, 0x92, 0x0b,
-	0xbb, 0xff, 0x24, 0xcb, 0x29, 0x29, 0xd7, 0x95, 0x28, 0xf9, 0xb3, 0x60, 0xde, 0x0b, 0xb5, 0x4d,
-	0xdf, 0x15, 0x25, 0xba, 0x29, 0x65, 0xce, 0x15, 0xbf, 0x47, 0xa5, 0x11, 0xc0, 0x6f, 0x94, 0xa4,
-	0xaf, 0x62, 0x20, 0xeb, 0x4d, 0xab, 0x5a, 0xa7, 0x69, 0x1b, 0x56, 0x13, 0x6e, 0x6e, 0x23, 0xa3,
-	0x01, 0xbd, 0x6b, 0x88, 0xda, 0x62, 0xb8, 0xff, 0xc3, 0x16, 0x33, 0xf9, 0x42, 0x5b, 0x4c, 0x2c,
-	0xb0, 0xc5, 0xa4, 0xdd, 0x2d, 0xc6, 0x61, 0x37, 0xb6, 0xb0, 0x08, 0xfe, 0xc2, 0x42, 0xb7, 0x1b,
-	0x7f, 0x37, 0xb9, 0x0a, 0x52, 0x84, 0xf1, 0xea, 0x2e, 0xa5, 0xc6, 0xe9, 0xd5, 0x49, 0x23, 0xb6,
-	0x0c, 0x9f, 0x52, 0xb1, 0x32, 0xdb, 0xf5, 0x3f, 0x2a, 0xab, 0xfb, 0xfd, 0xc2, 0xac, 0xb3, 0x79,
-	0x94, 0xe5, 0x92, 0x1c, 0x68, 0xb8, 0x4f, 0xc0, 0xd9, 0x43, 0xae, 0xe5, 0xc8, 0x2b, 0x45, 0x65,
-	0x3e, 0x70, 0x92, 0xf4, 0x33, 0x07, 0x96, 0xbc, 0xe7, 0x59, 0x73, 0x96, 0xd5, 0x7f, 0xc9, 0x3a,
-	0xcb, 0x20, 0x61, 0x62, 0xbd, 0xde, 0x69, 0x37, 0xd9, 0x2e, 0x11, 0x37, 0xb1, 0x7e, 0xab, 0xdd,
-	0xe4, 0xdf, 0xf4, 0xe8, 0x28, 0x46, 0x71, 0x0a, 0x8e, 0x1e, 0x76, 0x7c, 0x55, 0xc5, 0x50, 0x63,
-	0x4c, 0xe1, 0xf2, 0xd1, 0xa9, 0x08, 0x84, 0xfc, 0x23, 0xa5, 0x32, 0xc8, 0x44, 0x17, 0xe1, 0x51,
-	0x4d, 0xa8, 0xf0, 0xef, 0x39, 0x30, 0x4b, 0x61, 0xd5, 0x10, 0xd9, 0x51, 0x5e, 0xb8, 0xda, 0x4d,
-	0x10, 0x33, 0xb1, 0xce, 0x1e, 0x6d, 0xf4, 0x1e, 0x76, 0x72, 0xaf, 0x5f, 0x58, 0x8e, 0x7a, 0x1d,
-	0x35, 0xac, 0x2b, 0xc4, 0xfb, 0xb0, 0xf2, 0x2e, 0x83, 0xc5, 0x81, 0x54, 0xbd, 0xdb, 0x5e, 0x02,
-	0xf1, 0x36, 0xc4, 0x9d, 0xa6, 0xb3, 0x05, 0xa6, 0x14, 0xf6, 0x15, 0xaa, 0x75, 0xfd, 0x5e, 0x12,
-	0xc4, 0x6a, 0x58, 0xe7, 0xef, 0x80, 0xb9, 0xc0, 0xdf, 0x31, 0xb9, 0xe0, 0x15, 0x04, 0xb7, 0x55,
-	0x31, 0x7f, 0x98, 0x85, 0x97, 0x0e, 0x04, 0x0b, 0xe1, 0x55, 0xf5, 0x4c, 0xd8, 0x3d, 0x64, 0x24,
-	0x9e, 0x3b, 0x82, 0x91, 0x77, 0xcc, 0x65, 0x30, 0x45, 0x77, 0xc6, 0xa5, 0xb0, 0x13, 0x91, 0x8b,
-	0x99, 0x68, 0xb9, 0xe7, 0x7f, 0x1b, 0xa4, 0x86, 0x16, 0xaf, 0x11, 0xf6, 0xae, 0x5e, 0x7c, 0x65,
-	0xbc, 0xde, 0x8b, 0xfb, 0x2e, 0x48, 0xb8, 0xc4, 0xb4, 0x12, 0x76, 0x61, 0x2a, 0xf1, 0xf4, 0x48,
-	0x95, 0x17, 0xe8, 0x2e, 0x48, 0x0d, 0x4d, 0xff, 0x88, 0x04, 0x07, 0xf5, 0x51, 0x09, 0x46, 0x4d,
-	0x5b, 0x69, 0x71, 0x2f, 0x3c, 0x6d, 0xf9, 0xcf, 0xc0, 0x5c, 0x60, 0xd2, 0x46, 0xb4, 0xc4, 0xb0,
-	0x45, 0x54, 0x4b, 0x44, 0x4f, 0xc3, 0x88, 0x23, 0x37, 0x4a, 0xfc, 0xd7, 0x1c, 0x58, 0x1d, 0x3b,
-	0x64, 0xe4, 0x51, 0x2d, 0x17, 0x6d, 0x2f, 0x5e, 0x7c, 0x3e, 0x7b, 0x2f, 0xbb, 0xe3, 0x7b, 0xfd,
-	0x42, 0x2a, 0x37, 0xf0, 0x50, 0xf8, 0xcf, 0xc1, 0x62, 0x14, 0x13, 0xbe, 0x3c, 0x0a, 0xe1, 0x21,
-	0x33, 0xb1, 0x70, 0x24, 0xb3, 0x31, 0xc7, 0xdf, 0x01, 0x49, 0x8f, 0x8f, 0xc4, 0xa8, 0xa2, 0x1c,
-	0x9d, 0x28, 0x8d, 0xd6, 0x8d, 0x8e, 0x2e, 0x4e, 0x7f, 0x41, 0xa6, 0x68, 0x75, 0xe3, 0xc1, 0xd3,
-	0x0c, 0xf7, 0xf0, 0x69, 0x86, 0xfb, 0xfd, 0x69, 0x86, 0xbb, 0xff, 0x2c, 0x33, 0xf1, 0xf0, 0x59,
-	0x66, 0xe2, 0xb7, 0x67, 0x99, 0x89, 0x8f, 0x4f, 0x3a, 0x51, 0xb1, 0x76, 0x57, 0x36, 0x10, 0x5b,
-	0xe8, 0xe8, 0x54, 0x2e, 0x76, 0xcb, 0x5b, 0x71, 0x4a, 0x69, 0xe7, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x7b, 0xac, 0x54, 0xba, 0x09, 0x12, 0x00, 0x00,
+	0x14, 0xcf, 0xc6, 0x89, 0x93, 0x4c, 0xdc, 0xa4, 0xd9, 0xa4, 0xc9, 0x66, 0x9b, 0xda, 0xee, 0x16,
+	0x68, 0x48, 0xeb, 0xb5, 0x9d, 0x36, 0x05, 0x4c, 0x55, 0x54, 0xa7, 0x05, 0x2a, 0x61, 0xa8, 0xb6,
+	0x1f, 0x48, 0x50, 0x29, 0xda, 0x78, 0x87, 0xcd, 0xaa, 0x5e, 0xcf, 0xe2, 0x59, 0x5b, 0xc9, 0x01,
+	0x09, 0x71, 0xec, 0x01, 0xf5, 0x82, 0x84, 0x90, 0xb8, 0x03, 0xa7, 0x1c, 0x72, 0x82, 0x1b, 0x5c,
+	0xaa, 0x1c, 0x50, 0x95, 0x03, 0x42, 0x3d, 0xb4, 0x28, 0x15, 0x44, 0xe2, 0x9f, 0x00, 0xed, 0xec,
+	0xec, 0xf7, 0xd8, 0x4e, 0x0b, 0x42, 0x5c, 0x12, 0xcf, 0x9b, 0xf7, 0xde, 0xbc, 0xf7, 0x9b, 0x37,
+	0xef, 0xfd, 0x16, 0xcc, 0xd6, 0x11, 0x36, 0x11, 0x2e, 0xea, 0xa8, 0x53, 0xec, 0x94, 0x8b, 0xf6,
+	0xa6, 0x6c, 0xb5, 0x90, 0x8d, 0xf8, 0x23, 0xae, 0x5c, 0xd6, 0x51, 0x47, 0xee, 0x94, 0xc5, 0x2c,
+	0x55, 0x5b, 0x57, 0x31, 0x2c, 0x76, 0xca, 0xeb, 0xd0, 0x56, 0xcb, 0xc5, 0x3a, 0x32, 0x9a, 0xae,
+	0xba, 0x38, 0x17, 0x75, 0xe3, 0x58, 0xb9, 0x1b, 0x33, 0x3a, 0xd2, 0x11, 0xf9, 0x59, 0x74, 0x7e,
+	0x51, 0xe9, 0xbc, 0xab, 0xbe, 0xe6, 0x6e, 0xd0, 0xa3, 0xe8, 0x96, 0x8e, 0x90, 0xde, 0x80, 0x45,
+	0xb2, 0x5a, 0x6f, 0x7f, 0x54, 0x54, 0x9b, 0x5b, 0xb1, 0x43, 0x4c, 0xac, 0x3b, 0x87, 0x98, 0x58,
+	0xa7, 0x1b, 0x53, 0xaa, 0x69, 0x34, 0x51, 0x91, 0xfc, 0xa5, 0xa2, 0x5c, 0xdc, 0x8d, 0x6d, 0x98,
+	0x10, 0xdb, 0xaa, 0x69, 0xb9, 0x0a, 0xd2, 0xf7, 0x43, 0x60, 0xaa, 0x86, 0xf5, 0x1b, 0xed, 0x75,
+	0xd3, 0xb0, 0xaf, 0xb7, 0x90, 0x85, 0xb0, 0xda, 0xe0, 0x4b, 0x60, 0xd4, 0x84, 0x18, 0xab, 0x3a,
+	0xc4, 0x02, 0x97, 0x4f, 0x2d, 0x8e, 0x2f, 0xcf, 0xc8, 0xae, 0x27, 0xd9, 0xf3, 0x24, 0x5f, 0x6e,
+	0x6e, 0x29, 0xbe, 0x16, 0x7f, 0x8f, 0x03, 0x93, 0x46, 0xd3, 0xb0, 0x0d, 0xb5, 0xb1, 0xa6, 0x41,
+	0x0b, 0x61, 0xc3, 0x16, 0x06, 0x89, 0xe5, 0xbc, 0x4c, 0x13, 0x73, 0x40, 0x93, 0x29, 0x68, 0xf2,
+	0x2a, 0x32, 0x9a, 0xd5, 0x37, 0x1f, 0x3c, 0xce, 0x0d, 0x7c, 0xf7, 0x24, 0xb7, 0xa8, 0x1b, 0xf6,
+	0x46, 0x7b, 0x5d, 0xae, 0x23, 0x93, 0xa2, 0x40, 0xff, 0x15, 0xb0, 0x76, 0xb7, 0x68, 0x6f, 0x59,
+	0x10, 0x13, 0x03, 0xfc, 0xd5, 0xc1, 0xf6, 0x52, 0xa6, 0x01, 0x75, 0xb5, 0xbe, 0xb5, 0xe6, 0xc0,
+	0x8e, 0xbf, 0x39, 0xd8, 0x5e, 0xe2, 0x94, 0x09, 0x7a, 0xf2, 0x15, 0xf7, 0x60, 0xfe, 0x3c, 0x18,
+	0xb5, 0x48, 0x2a, 0xb0, 0x25, 0xa4, 0xf2, 0xdc, 0xe2, 0x58, 0x55, 0xd8, 0xdb, 0x29, 0xcc, 0xd0,
+	0x38, 0x2e, 0x6b, 0x5a, 0x0b, 0x62, 0x7c, 0xc3, 0x6e, 0x19, 0x4d, 0x5d, 0xf1, 0x35, 0x79, 0xd1,
+	0x49, 0xda, 0x56, 0x35, 0xd5, 0x56, 0x85, 0x21, 0xc7, 0x4a, 0xf1, 0xd7, 0xfc, 0xcb, 0x60, 0xd8,
+	0x36, 0xec, 0x06, 0x14, 0x86, 0x89, 0xbb, 0xe9, 0x47, 0x3b, 0x85, 0xc9, 0x20, 0xc4, 0x7c, 0x49,
+	0x3e, 0xff, 0x8a, 0xe2, 0x6a, 0xf0, 0x05, 0x30, 0x82, 0xdb, 0xa6, 0xa9, 0xb6, 0xb6, 0x84, 0x74,
+	0x77, 0x65, 0x4f, 0x87, 0x3f, 0x07, 0xc6, 0xe0, 0xa6, 0x05, 0x35, 0xc3, 0x86, 0x9a, 0x30, 0x92,
+	0xe7, 0x16, 0x47, 0xab, 0xc7, 0x12, 0x06, 0x2b, 0x25, 0x81, 0x53, 0x02, 0x3d, 0x5e, 0x01, 0x47,
+	0x2c, 0x7a, 0x57, 0x6b, 0x0e, 0x3c, 0xc2, 0x68, 0x9e, 0x5b, 0x9c, 0x58, 0x3e, 0x2e, 0x47, 0xca,
+	0x55, 0xf6, 0xee, 0xf3, 0xe6, 0x96, 0x05, 0xab, 0x47, 0x1f, 0xed, 0x14, 0x32, 0x9b, 0x4e, 0x4d,
+	0xe6, 0x3b, 0x25, 0x79, 0x59, 0x2e, 0x29, 0x19, 0x2b, 0xb4, 0x5f, 0x29, 0x7f, 0x76, 0xb0, 0xbd,
+	0xe4, 0xa3, 0x71, 0xef, 0x60, 0x7b, 0x29, 0x17, 0xba, 0x84, 0x4e, 0xb9, 0x98, 0x28, 0x13, 0xe9,
+	0x22, 0x98, 0x4f, 0x08, 0x15, 0x88, 0x2d, 0xd4, 0xc4, 0x90, 0xcf, 0x81, 0x71, 0x3f, 0x46, 0x43,
+	0x13, 0xb8, 0x3c, 0xb7, 0x38, 0xa4, 0x00, 0x4f, 0x74, 0x4d, 0x93, 0x7e, 0xe0, 0xc0, 0x4c, 0x0d,
+	0xeb, 0x57, 0x37, 0x61, 0xfd, 0x1d, 0x72, 0xa5, 0xab, 0xa8, 0x69, 0xc3, 0xa6, 0xcd, 0xbf, 0x0b,
+	0x46, 0xea, 0xee, 0x4f, 0x62, 0xd5, 0xa5, 0xf8, 0xaa, 0xd9, 0xdd, 0x9d, 0x82, 0x18, 0x49, 0xd8,
+	0x2b, 0x2d, 0x62, 0xab, 0x78, 0x4e, 0xf8, 0x05, 0x30, 0xa6, 0xb6, 0xed, 0x0d, 0xd4, 0x32, 0xec,
+	0x2d, 0x61, 0x90, 0xdc, 0x6c, 0x20, 0xa8, 0xac, 0x38, 0x79, 0x07, 0x6b, 0x27, 0x71, 0x29, 0x91,
+	0x78, 0x22, 0x48, 0x29, 0x0b, 0x16, 0x58, 0x72, 0x2f, 0x7d, 0xe9, 0x77, 0x0e, 0x8c, 0xd4, 0xb0,
+	0x7e, 0x1b, 0xd9, 0x90, 0x5f, 0x61, 0x40, 0x51, 0x9d, 0xf9, 0xf3, 0x71, 0x2e, 0x2c, 0x76, 0x4b,
+	0x39, 0x04, 0x10, 0x2f, 0x83, 0xe1, 0x0e, 0xb2, 0x61, 0xcb, 0x8d, 0xb9, 0x47, 0x0d, 0xbb, 0x6a,
+	0x7c, 0x19, 0xa4, 0x91, 0x65, 0x1b, 0xa8, 0x49, 0x8a, 0x7e, 0x22, 0x78, 0x79, 0xb4, 0x1c, 0x9c,
+	0x58, 0xde, 0x23, 0x0a, 0x0a, 0x55, 0xec, 0x55, 0xf3, 0x95, 0x17, 0x1c, 0x60, 0x5c, 0xd7, 0x0e,
+	0x28, 0xc7, 0x12, 0xa0, 0x38, 0xfe, 0xa4, 0x29, 0x30, 0x49, 0x7f, 0xfa, 0xa9, 0xff, 0xc5, 0xf9,
+	0xb2, 0xf7, 0xa1, 0xa1, 0x6f, 0x38, 0x15, 0xfb, 0x1f, 0x41, 0xf0, 0x3a, 0x18, 0x71, 0x33, 0xc3,
+	0x42, 0x8a, 0x74, 0x9f, 0x93, 0x31, 0x0c, 0xbc, 0x80, 0x42, 0x58, 0x78, 0x16, 0x3d, 0xc1, 0x38,
+	0x1b, 0x05, 0xe3, 0x04, 0x13, 0x0c, 0xcf, 0xb9, 0x34, 0x0f, 0xe6, 0x62, 0x22, 0x1f, 0x9c, 0x3f,
+	0x38, 0x00, 0x6a, 0x58, 0xf7, 0x5a, 0xd5, 0x73, 0xe2, 0x72, 0x01, 0x8c, 0xd1, 0x2e, 0x8b, 0xfa,
+	0x63, 0x13, 0xa8, 0xf2, 0x17, 0x41, 0x5a, 0x35, 0x51, 0xbb, 0x69, 0x53, 0x78, 0x7a, 0x34, 0xe7,
+	0x31, 0xa7, 0x39, 0xbb, 0x27, 0x53, 0x9b, 0xca, 0x19, 0xf2, 0x54, 0x7c, 0x6f, 0x0e, 0x10, 0x42,
+	0x02, 0x08, 0x9a, 0x99, 0x34, 0x03, 0xf8, 0x60, 0xe5, 0xa7, 0xff, 0xb3, 0x5b, 0x1b, 0xb7, 0x2c,
+	0x4d, 0xb5, 0xe1, 0x75, 0xb5, 0xa5, 0x9a, 0xd8, 0x49, 0x26, 0x78, 0x9f, 0x5c, 0xbf, 0x64, 0x7c,
+	0x55, 0xfe, 0x55, 0x90, 0xb6, 0x88, 0x07, 0x82, 0xc0, 0xf8, 0xf2, 0xb1, 0x78, 0xfb, 0x23, 0x9b,
+	0x91, 0x44, 0x5c, 0xfd, 0xca, 0xb5, 0xbd, 0x64, 0x4b, 0x4e, 0xb6, 0x81, 0x53, 0xa1, 0xdc, 0x36,
+	0xbd, 0x99, 0x1e, 0x0b, 0x5e, 0x92, 0xc9, 0x55, 0x87, 0x45, 0x5e, 0xae, 0x95, 0x69, 0xc6, 0x29,
+	0xd2, 0xd7, 0x1c, 0x19, 0xb8, 0xab, 0x6a, 0xb3, 0x0e, 0x1b, 0xa1, 0x81, 0xcb, 0x28, 0x83, 0xc9,
+	0x58, 0x19, 0x44, 0x2a, 0x20, 0x3c, 0xe3, 0x06, 0x0f, 0x3b, 0xe3, 0x2a, 0xf9, 0xbd, 0xe4, 0x68,
+	0x89, 0xf4, 0x7d, 0xe9, 0x17, 0x8e, 0x34, 0xf5, 0x68, 0x7c, 0x7e, 0x53, 0x7f, 0xf6, 0x38, 0xaf,
+	0x81, 0x23, 0x75, 0xe2, 0x0b, 0x6a, 0x6b, 0x0e, 0xf9, 0xa0, 0x77, 0x25, 0x26, 0x5a, 0xfa, 0x4d,
+	0x8f, 0x99, 0x54, 0x47, 0x9d, 0x0b, 0xbb, 0xff, 0x24, 0xc7, 0x29, 0x19, 0xcf, 0xd4, 0xd9, 0xe4,
+	0x4f, 0x83, 0x49, 0xdf, 0xd5, 0x06, 0x79, 0x57, 0xa4, 0xd1, 0x0d, 0x29, 0x13, 0x9e, 0xf8, 0x6d,
+	0x22, 0x65, 0x00, 0xbf, 0x52, 0x92, 0xbe, 0x48, 0x81, 0x9c, 0x3f, 0xad, 0x6a, 0xed, 0x86, 0x6d,
+	0x58, 0x0d, 0xb8, 0xba, 0x81, 0x8c, 0x3a, 0xf4, 0xaf, 0x81, 0xc5, 0x62, 0xb8, 0xff, 0x03, 0x8b,
+	0x19, 0x7c, 0x2e, 0x16, 0x93, 0x8a, 0xb1, 0x98, 0x19, 0x8f, 0xc5, 0xb8, 0xdd, 0x8d, 0x12, 0x16,
+	0x21, 0x20, 0x2c, 0x84, 0xdd, 0x04, 0xdc, 0xe4, 0x2a, 0xc8, 0x38, 0x1d, 0x6f, 0xcd, 0x6b, 0xa9,
+	0x69, 0x72, 0x75, 0x52, 0x17, 0x96, 0x11, 0xb4, 0x54, 0xac, 0x8c, 0x77, 0x82, 0x45, 0x65, 0x61,
+	0x6f, 0xa7, 0x30, 0xee, 0x32, 0x8f, 0xb2, 0x5c, 0x92, 0x63, 0x05, 0xf7, 0x21, 0x38, 0xdd, 0xe7,
+	0x5a, 0x0e, 0x4d, 0x29, 0x2a, 0x93, 0xb1, 0x93, 0xa4, 0x9f, 0x38, 0x30, 0xeb, 0x3f, 0xcf, 0x9a,
+	0x4b, 0x56, 0xff, 0x61, 0xd7, 0x99, 0x03, 0x23, 0x26, 0xd6, 0xd7, 0xda, 0xad, 0x06, 0xe5, 0x12,
+	0x69, 0x13, 0xeb, 0xb7, 0x5a, 0x0d, 0xfe, 0x35, 0xbf, 0x1d, 0xa5, 0x08, 0x4e, 0xf1, 0xd1, 0x43,
+	0x8f, 0xaf, 0xaa, 0x18, 0x6a, 0xb4, 0x53, 0x78, 0xfd, 0xe8, 0x04, 0x03, 0xa1, 0xe0, 0x48, 0xa9,
+	0x0c, 0xb2, 0xec, 0x24, 0xfc, 0x56, 0x93, 0x48, 0xfc, 0x5b, 0x0e, 0x8c, 0x13, 0x58, 0x35, 0xe4,
+	0x70, 0x94, 0xe7, 0xce, 0x76, 0x15, 0xa4, 0x4c, 0xac, 0xd3, 0x47, 0xcb, 0xe6, 0x61, 0xc7, 0x77,
+	0x77, 0x0a, 0x73, 0xac, 0xd7, 0x51, 0xc3, 0xba, 0xe2, 0x58, 0xf7, 0x4b, 0xef, 0x12, 0x98, 0x0e,
+	0x85, 0xea, 0xdf, 0xf6, 0x2c, 0x48, 0xb7, 0x20, 0x6e, 0x37, 0x5c, 0x16, 0x98, 0x51, 0xe8, 0x2a,
+	0x99, 0xeb, 0x8f, 0xee, 0x4c, 0xb9, 0x02, 0x9d, 0x37, 0x65, 0x43, 0x42, 0xb9, 0xc8, 0x80, 0x24,
+	0x6b, 0xd4, 0xea, 0x9f, 0xaf, 0xaf, 0x1a, 0xb2, 0x83, 0xf0, 0x30, 0x83, 0x95, 0xaa, 0x56, 0xde,
+	0x60, 0xa5, 0xe8, 0xbb, 0x65, 0x53, 0x86, 0x70, 0xc0, 0xd2, 0x12, 0x99, 0x23, 0x61, 0x51, 0xf7,
+	0xcb, 0xfd, 0xdc, 0x9d, 0x21, 0xb7, 0x9a, 0xda, 0xbf, 0x90, 0x72, 0xe5, 0x72, 0xdf, 0xd0, 0x93,
+	0x1f, 0x02, 0xd1, 0xa3, 0xa5, 0xb3, 0x64, 0x66, 0x44, 0x85, 0x5d, 0xc3, 0x5f, 0xde, 0x1f, 0x03,
+	0xa9, 0x1a, 0xd6, 0xf9, 0x3b, 0x60, 0x22, 0xf6, 0xdd, 0x99, 0x8f, 0x3f, 0x99, 0xf8, 0xd7, 0x85,
+	0xb8, 0xd8, 0x4f, 0xc3, 0x2f, 0x1f, 0x08, 0xa6, 0x92, 0x9f, 0x16, 0xa7, 0x92, 0xe6, 0x09, 0x25,
+	0xf1, 0xcc, 0x21, 0x94, 0xfc, 0x63, 0x2e, 0x81, 0x21, 0x82, 0xfe, 0x6c, 0xd2, 0xc8, 0x91, 0x8b,
+	0x59, 0xb6, 0xdc, 0xb7, 0xbf, 0x0d, 0x32, 0x11, 0xa2, 0xdc, 0x45, 0xdf, 0xdb, 0x17, 0x5f, 0xea,
+	0xbd, 0xef, 0xfb, 0x7d, 0x0b, 0x8c, 0x78, 0x83, 0x64, 0x3e, 0x69, 0x42, 0xb7, 0xc4, 0x93, 0x5d,
+	0xb7, 0x7c, 0x47, 0x77, 0x41, 0x26, 0xc2, 0xd6, 0x18, 0x01, 0x86, 0xf7, 0x59, 0x01, 0xb2, 0xd8,
+	0x91, 0x34, 0xbd, 0x9b, 0x64, 0x47, 0xfc, 0xc7, 0x60, 0x22, 0xc6, 0x8c, 0x18, 0x25, 0x11, 0xd5,
+	0x60, 0x95, 0x04, 0x9b, 0xbd, 0x30, 0x8e, 0x5c, 0x29, 0xf1, 0x5f, 0x72, 0x60, 0xa1, 0x27, 0x29,
+	0x90, 0xbb, 0x95, 0x1c, 0x5b, 0x5f, 0xbc, 0xf0, 0x6c, 0xfa, 0x7e, 0x74, 0x47, 0x77, 0x77, 0x0a,
+	0x99, 0x7c, 0xe8, 0xa1, 0xf0, 0x9f, 0x80, 0x69, 0xd6, 0xe4, 0x7a, 0xb1, 0x1b, 0xc2, 0x11, 0x35,
+	0xb1, 0x70, 0x28, 0xb5, 0x1e, 0xc7, 0xdf, 0x01, 0xa3, 0xfe, 0xfc, 0x10, 0x59, 0x49, 0xb9, 0x7b,
+	0xa2, 0xd4, 0x7d, 0xaf, 0x87, 0x77, 0x1d, 0x64, 0x22, 0x1d, 0x3b, 0xcb, 0x2a, 0xc5, 0x60, 0x9f,
+	0x55, 0x57, 0xac, 0x6e, 0x29, 0x4d, 0xee, 0x46, 0xdb, 0x0d, 0x6f, 0x82, 0x89, 0x58, 0xa7, 0x64,
+	0xd4, 0x54, 0x54, 0x83, 0x55, 0x53, 0xec, 0xee, 0x96, 0x38, 0x4e, 0x1c, 0xfe, 0xd4, 0x61, 0x73,
+	0xd5, 0x95, 0x07, 0xfb, 0x59, 0xee, 0xe1, 0x7e, 0x96, 0xfb, 0x6d, 0x3f, 0xcb, 0xdd, 0x7f, 0x9a,
+	0x1d, 0x78, 0xf8, 0x34, 0x3b, 0xf0, 0xeb, 0xd3, 0xec, 0xc0, 0x07, 0xc7, 0x5d, 0xd7, 0x58, 0xbb,
+	0x2b, 0x1b, 0x88, 0x7e, 0x58, 0x10, 0x76, 0x58, 0xec, 0x94, 0xd7, 0xd3, 0x64, 0xb4, 0x9e, 0xfb,
+	0x3b, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xa3, 0xd8, 0x9b, 0x91, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1326,6 +1513,10 @@ type MsgClient interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// DelegateVote defines a method to delegate the governance voting power of an account to another account.
+	DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method to remove the vote delegation of an account.
+	UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error)
 }
 
 type msgClient struct {
@@ -1426,6 +1617,24 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error) {
+	out := new(MsgDelegateVoteResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/DelegateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error) {
+	out := new(MsgUndelegateVoteResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/UndelegateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -1451,6 +1660,10 @@ type MsgServer interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// DelegateVote defines a method to delegate the governance voting power of an account to another account.
+	DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method to remove the vote delegation of an account.
+	UndelegateVote(context.Context, *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1487,6 +1700,12 @@ func (*UnimplementedMsgServer) UpdateMessageParams(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) SudoExec(ctx context.Context, req *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (*UnimplementedMsgServer) DelegateVote(ctx context.Context, req *MsgDelegateVote) (*MsgDelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVote not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateVote(ctx context.Context, req *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateVote not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1672,6 +1891,42 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/DelegateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVote(ctx, req.(*MsgDelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/UndelegateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateVote(ctx, req.(*MsgUndelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
@@ -1717,6 +1972,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "DelegateVote",
+			Handler:    _Msg_DelegateVote_Handler,
+		},
+		{
+			MethodName: "UndelegateVote",
+			Handler:    _Msg_UndelegateVote_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -2518,6 +2781,119 @@ func (m *MsgSudoExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgDelegateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2872,16 +3248,64 @@ func (m *MsgSudoExecResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgDelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgDelegateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *MsgUndelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -5110,6 +5534,302 @@ func (m *MsgSudoExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDelegateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegatee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
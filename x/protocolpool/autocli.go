@@ -28,6 +28,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`$ %s query protocolpool unclaimed-budget cosmos1...`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod:      "PaymentStream",
+					Use:            "payment-stream <stream-id>",
+					Short:          "Query a payment stream and the amount withdrawable from it",
+					Example:        fmt.Sprintf(`$ %s query protocolpool payment-stream 1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "stream_id"}},
+				},
+				{
+					RpcMethod: "PaymentStreams",
+					Use:       "payment-streams",
+					Short:     "Query payment streams, optionally filtered by sender or recipient",
+					Example:   fmt.Sprintf(`$ %s query protocolpool payment-streams --sender cosmos1...`, version.AppName),
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -89,6 +102,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "CreatePaymentStream",
+					Use:       "create-payment-stream <recipient> <amount> <end-time>",
+					Short:     "Stream the specified amount to a recipient until the end time",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool create-payment-stream cosmos1... 1000000uatom 2023-11-31T12:34:56.789Z --start-time 2023-10-31T12:34:56.789Z --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "recipient"},
+						{ProtoField: "amount"},
+						{ProtoField: "end_time"},
+					},
+				},
+				{
+					RpcMethod:      "WithdrawPaymentStream",
+					Use:            "withdraw-payment-stream <stream-id>",
+					Short:          "Withdraw the funds streamed so far to the recipient",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool withdraw-payment-stream 1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "stream_id"}},
+				},
+				{
+					RpcMethod:      "CancelPaymentStream",
+					Use:            "cancel-payment-stream <stream-id>",
+					Short:          "Cancel a payment stream and refund the funds not streamed yet to the sender",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool cancel-payment-stream 1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "stream_id"}},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal <params>",
@@ -68,6 +68,24 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error
 		}
 	}
 
+	for _, stream := range data.PaymentStreams {
+		senderAddress, err := k.authKeeper.AddressCodec().StringToBytes(stream.Sender)
+		if err != nil {
+			return fmt.Errorf("failed to decode sender address: %w", err)
+		}
+		recipientAddress, err := k.authKeeper.AddressCodec().StringToBytes(stream.Recipient)
+		if err != nil {
+			return fmt.Errorf("failed to decode recipient address: %w", err)
+		}
+		if err := k.setPaymentStream(ctx, senderAddress, recipientAddress, stream); err != nil {
+			return fmt.Errorf("failed to set payment stream %d: %w", stream.Id, err)
+		}
+	}
+
+	if err := k.PaymentStreamSequence.Set(ctx, data.NextPaymentStreamId); err != nil {
+		return fmt.Errorf("failed to set next payment stream id: %w", err)
+	}
+
 	// sanity check to avoid trying to distribute more than what is available
 
 	if totalToBeDistributed.IsAnyGT(data.LastBalance.Amount) || !totalToBeDistributed.DenomsSubsetOf(data.LastBalance.Amount) {
@@ -142,5 +160,18 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 
 	genState.Params = &params
 
+	err = k.PaymentStreams.Walk(ctx, nil, func(_ uint64, value types.PaymentStream) (stop bool, err error) {
+		genState.PaymentStreams = append(genState.PaymentStreams, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState.NextPaymentStreamId, err = k.PaymentStreamSequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return genState, nil
 }
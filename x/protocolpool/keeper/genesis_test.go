@@ -36,6 +36,20 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 		Time:   &time.Time{},
 	})
 
+	streamStart := time.Unix(1700000000, 0).UTC()
+	gs.PaymentStreams = []types.PaymentStream{
+		{
+			Id:        3,
+			Sender:    "cosmos1qy3529yj3v4xw2z3vz3vz3vz3vz3vz3v3k0vyf",
+			Recipient: "cosmos1tygms3xhhs3yv487phx3dw4a95jn7t7lpm470r",
+			Amount:    sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1000))),
+			Withdrawn: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10))),
+			StartTime: streamStart,
+			EndTime:   streamStart.Add(hour),
+		},
+	}
+	gs.NextPaymentStreamId = 4
+
 	err := suite.poolKeeper.InitGenesis(suite.ctx, gs)
 	suite.Require().ErrorContains(err, "total to be distributed is greater than the last balance")
 
@@ -50,4 +64,10 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(gs.ContinuousFund, exportedGenState.ContinuousFund)
 	suite.Require().Equal(gs.Budget, exportedGenState.Budget)
 	suite.Require().Equal(math.NewInt(101), exportedGenState.LastBalance.Amount.AmountOf("stake"))
+	suite.Require().Equal(gs.PaymentStreams, exportedGenState.PaymentStreams)
+	suite.Require().Equal(uint64(4), exportedGenState.NextPaymentStreamId)
+
+	id, err := suite.poolKeeper.PaymentStreamSequence.Next(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(4), id)
 }
@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/x/protocolpool/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Querier{}
@@ -73,3 +74,78 @@ func (k Querier) UnclaimedBudget(ctx context.Context, req *types.QueryUnclaimedB
 		TranchesLeft:    budget.TranchesLeft,
 	}, nil
 }
+
+// PaymentStream queries a payment stream by its id
+func (k Querier) PaymentStream(ctx context.Context, req *types.QueryPaymentStreamRequest) (*types.QueryPaymentStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	stream, err := k.Keeper.PaymentStreams.Get(ctx, req.StreamId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no payment stream found for id: %d", req.StreamId)
+		}
+		return nil, err
+	}
+
+	return &types.QueryPaymentStreamResponse{
+		Stream:       stream,
+		Withdrawable: k.Keeper.PaymentStreamWithdrawable(ctx, stream),
+	}, nil
+}
+
+// PaymentStreams queries the payment streams of a sender, of a recipient, from a sender to a
+// recipient, or all of them
+func (k Querier) PaymentStreams(ctx context.Context, req *types.QueryPaymentStreamsRequest) (*types.QueryPaymentStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var (
+		streams []types.PaymentStream
+		pageRes *query.PageResponse
+		err     error
+	)
+	if req.Sender == "" && req.Recipient == "" {
+		streams, pageRes, err = query.CollectionPaginate(ctx, k.Keeper.PaymentStreams, req.Pagination,
+			func(_ uint64, stream types.PaymentStream) (types.PaymentStream, error) {
+				return stream, nil
+			})
+	} else {
+		var sender, recipient sdk.AccAddress
+		if req.Sender != "" {
+			sender, err = k.Keeper.authKeeper.AddressCodec().StringToBytes(req.Sender)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid sender address: %s", err.Error())
+			}
+		}
+		if req.Recipient != "" {
+			recipient, err = k.Keeper.authKeeper.AddressCodec().StringToBytes(req.Recipient)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid recipient address: %s", err.Error())
+			}
+		}
+
+		// paginate over the streams of the sender, filtered by recipient if both are set,
+		// or over the streams of the recipient
+		index, address := k.Keeper.PaymentStreamsBySender, sender
+		if sender == nil {
+			index, address = k.Keeper.PaymentStreamsByRecipient, recipient
+		}
+		streams, pageRes, err = query.CollectionFilteredPaginate(ctx, index, req.Pagination,
+			func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (bool, error) {
+				if sender == nil || recipient == nil {
+					return true, nil
+				}
+				return k.Keeper.PaymentStreamsByRecipient.Has(ctx, collections.Join(recipient, key.K2()))
+			},
+			func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.PaymentStream, error) {
+				return k.Keeper.PaymentStreams.Get(ctx, key.K2())
+			}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](address))
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPaymentStreamsResponse{Streams: streams, Pagination: pageRes}, nil
+}
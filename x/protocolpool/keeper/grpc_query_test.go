@@ -3,11 +3,15 @@ package keeper_test
 import (
 	"time"
 
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/protocolpool/types"
 
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func (suite *KeeperTestSuite) TestUnclaimedBudget() {
@@ -123,3 +127,90 @@ func (suite *KeeperTestSuite) TestUnclaimedBudget() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestPaymentStreams() {
+	addressCodec := codectestutil.CodecOptions{}.GetAddressCodec()
+	sender := sdk.AccAddress([]byte("sender1_________________"))
+	senderStrAddr, err := addressCodec.BytesToString(sender)
+	suite.Require().NoError(err)
+	recipientStrAddr, err := addressCodec.BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+	otherStrAddr, err := addressCodec.BytesToString(sdk.AccAddress([]byte("other1__________________")))
+	suite.Require().NoError(err)
+
+	startTime := suite.environment.HeaderService.HeaderInfo(suite.ctx).Time
+	amount := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+	suite.bankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false).Times(2)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, sender, types.StreamAccount, amount).Return(nil).Times(2)
+	for _, recipient := range []string{recipientStrAddr, otherStrAddr} {
+		_, err := suite.msgServer.CreatePaymentStream(suite.ctx, &types.MsgCreatePaymentStream{
+			Sender: senderStrAddr, Recipient: recipient, Amount: amount, EndTime: startTime.Add(100 * time.Second),
+		})
+		suite.Require().NoError(err)
+	}
+
+	suite.ctx = suite.ctx.WithHeaderInfo(header.Info{Time: startTime.Add(10 * time.Second)})
+	res, err := suite.queryServer.PaymentStream(suite.ctx, &types.QueryPaymentStreamRequest{StreamId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(otherStrAddr, res.Stream.Recipient)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 10)), res.Withdrawable)
+
+	_, err = suite.queryServer.PaymentStream(suite.ctx, &types.QueryPaymentStreamRequest{StreamId: 2})
+	suite.Require().ErrorContains(err, "no payment stream found for id: 2")
+
+	testCases := map[string]struct {
+		req    *types.QueryPaymentStreamsRequest
+		expIDs []uint64
+		expErr string
+	}{
+		"all streams": {
+			req:    &types.QueryPaymentStreamsRequest{},
+			expIDs: []uint64{0, 1},
+		},
+		"by sender": {
+			req:    &types.QueryPaymentStreamsRequest{Sender: senderStrAddr},
+			expIDs: []uint64{0, 1},
+		},
+		"by recipient": {
+			req:    &types.QueryPaymentStreamsRequest{Recipient: otherStrAddr},
+			expIDs: []uint64{1},
+		},
+		"by sender with pagination": {
+			req:    &types.QueryPaymentStreamsRequest{Sender: senderStrAddr, Pagination: &query.PageRequest{Offset: 1}},
+			expIDs: []uint64{1},
+		},
+		"by sender and recipient": {
+			req:    &types.QueryPaymentStreamsRequest{Sender: senderStrAddr, Recipient: otherStrAddr},
+			expIDs: []uint64{1},
+		},
+		"by sender and recipient without streams": {
+			req: &types.QueryPaymentStreamsRequest{Sender: otherStrAddr, Recipient: recipientStrAddr},
+		},
+		"no streams": {
+			req: &types.QueryPaymentStreamsRequest{Sender: otherStrAddr},
+		},
+		"invalid address": {
+			req:    &types.QueryPaymentStreamsRequest{Recipient: "invalid"},
+			expErr: "invalid recipient address",
+		},
+		"invalid recipient address with sender": {
+			req:    &types.QueryPaymentStreamsRequest{Sender: senderStrAddr, Recipient: "invalid"},
+			expErr: "invalid recipient address",
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			res, err := suite.queryServer.PaymentStreams(suite.ctx, tc.req)
+			if tc.expErr != "" {
+				suite.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Streams, len(tc.expIDs))
+			for i, stream := range res.Streams {
+				suite.Require().Equal(tc.expIDs[i], stream.Id)
+			}
+		})
+	}
+}
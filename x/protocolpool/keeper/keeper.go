@@ -38,6 +38,13 @@ type Keeper struct {
 	Distributions             collections.Map[time.Time, types.DistributionAmount] // key: time.Time, denom | value: amounts
 	LastBalance               collections.Item[types.DistributionAmount]
 	Params                    collections.Item[types.Params]
+	// PaymentStreams key: StreamID | value: PaymentStream
+	PaymentStreams        collections.Map[uint64, types.PaymentStream]
+	PaymentStreamSequence collections.Sequence
+	// PaymentStreamsBySender key: SenderAddr+StreamID | value: none used (index key for payment streams of a sender)
+	PaymentStreamsBySender collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// PaymentStreamsByRecipient key: RecipientAddr+StreamID | value: none used (index key for payment streams of a recipient)
+	PaymentStreamsByRecipient collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
 }
 
 const (
@@ -73,6 +80,10 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.Accoun
 		Distributions:             collections.NewMap(sb, types.DistributionsKey, "distributions", sdk.TimeKey, codec.CollValue[types.DistributionAmount](cdc)),
 		LastBalance:               collections.NewItem(sb, types.LastBalanceKey, "last_balance", codec.CollValue[types.DistributionAmount](cdc)),
 		Params:                    collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		PaymentStreams:            collections.NewMap(sb, types.PaymentStreamsKey, "payment_streams", collections.Uint64Key, codec.CollValue[types.PaymentStream](cdc)),
+		PaymentStreamSequence:     collections.NewSequence(sb, types.PaymentStreamSequenceKey, "payment_stream_sequence"),
+		PaymentStreamsBySender:    collections.NewKeySet(sb, types.PaymentStreamsBySenderKey, "payment_streams_by_sender", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		PaymentStreamsByRecipient: collections.NewKeySet(sb, types.PaymentStreamsByRecipientKey, "payment_streams_by_recipient", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
 	}
 
 	schema, err := sb.Build()
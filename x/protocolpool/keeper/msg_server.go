@@ -225,6 +225,41 @@ func (k MsgServer) CancelContinuousFund(ctx context.Context, msg *types.MsgCance
 	}, nil
 }
 
+func (k MsgServer) CreatePaymentStream(ctx context.Context, msg *types.MsgCreatePaymentStream) (*types.MsgCreatePaymentStreamResponse, error) {
+	id, err := k.Keeper.CreatePaymentStream(ctx, msg.Sender, msg.Recipient, msg.Amount, msg.StartTime, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePaymentStreamResponse{StreamId: id}, nil
+}
+
+func (k MsgServer) WithdrawPaymentStream(ctx context.Context, msg *types.MsgWithdrawPaymentStream) (*types.MsgWithdrawPaymentStreamResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Recipient); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	amount, err := k.Keeper.WithdrawPaymentStream(ctx, msg.Recipient, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawPaymentStreamResponse{Amount: amount}, nil
+}
+
+func (k MsgServer) CancelPaymentStream(ctx context.Context, msg *types.MsgCancelPaymentStream) (*types.MsgCancelPaymentStreamResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Sender); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	refunded, withdrawn, err := k.Keeper.CancelPaymentStream(ctx, msg.Sender, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelPaymentStreamResponse{Refunded: refunded, Withdrawn: withdrawn}, nil
+}
+
 func (k MsgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if err := k.validateAuthority(msg.GetAuthority()); err != nil {
 		return nil, err
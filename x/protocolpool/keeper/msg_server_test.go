@@ -12,6 +12,8 @@ import (
 
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var (
@@ -1051,3 +1053,137 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 	suite.Require().NoError(err)
 	suite.Require().Len(params.EnabledDistributionDenoms, 1)
 }
+
+func (suite *KeeperTestSuite) TestPaymentStream() {
+	suite.SetupTest()
+
+	addressCodec := codectestutil.CodecOptions{}.GetAddressCodec()
+	sender := sdk.AccAddress([]byte("sender1_________________"))
+	senderStrAddr, err := addressCodec.BytesToString(sender)
+	suite.Require().NoError(err)
+	recipientStrAddr, err := addressCodec.BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	startTime := suite.environment.HeaderService.HeaderInfo(suite.ctx).Time
+	endTime := startTime.Add(100 * time.Second)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("foo", 1000))
+
+	// invalid streams are rejected
+	blockedAddr := authtypes.NewModuleAddress(types.ModuleName)
+	blockedStrAddr, err := addressCodec.BytesToString(blockedAddr)
+	suite.Require().NoError(err)
+	suite.bankKeeper.EXPECT().BlockedAddr(blockedAddr).Return(true)
+	_, err = suite.msgServer.CreatePaymentStream(suite.ctx, &types.MsgCreatePaymentStream{
+		Sender: senderStrAddr, Recipient: blockedStrAddr, Amount: amount, EndTime: endTime,
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().ErrorContains(err, "is not allowed to receive funds")
+
+	suite.bankKeeper.EXPECT().BlockedAddr(recipientAddr).Return(false).AnyTimes()
+	pastTime := startTime.Add(-time.Second)
+	_, err = suite.msgServer.CreatePaymentStream(suite.ctx, &types.MsgCreatePaymentStream{
+		Sender: senderStrAddr, Recipient: recipientStrAddr, Amount: amount, StartTime: &pastTime, EndTime: endTime,
+	})
+	suite.Require().ErrorContains(err, "start time cannot be less than the current block time")
+	_, err = suite.msgServer.CreatePaymentStream(suite.ctx, &types.MsgCreatePaymentStream{
+		Sender: senderStrAddr, Recipient: recipientStrAddr, Amount: amount, EndTime: startTime,
+	})
+	suite.Require().ErrorContains(err, "end time must be after the start time")
+	_, err = suite.msgServer.CreatePaymentStream(suite.ctx, &types.MsgCreatePaymentStream{
+		Sender: senderStrAddr, Recipient: recipientStrAddr, Amount: sdk.NewCoins(), EndTime: endTime,
+	})
+	suite.Require().ErrorContains(err, "payment stream amount cannot be zero")
+
+	// create a stream, escrowing the funds in the stream account
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, sender, types.StreamAccount, amount).Return(nil)
+	res, err := suite.msgServer.CreatePaymentStream(suite.ctx, &types.MsgCreatePaymentStream{
+		Sender: senderStrAddr, Recipient: recipientStrAddr, Amount: amount, EndTime: endTime,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), res.StreamId)
+
+	// nothing has been streamed yet
+	_, err = suite.msgServer.WithdrawPaymentStream(suite.ctx, &types.MsgWithdrawPaymentStream{Recipient: recipientStrAddr, StreamId: res.StreamId})
+	suite.Require().ErrorContains(err, "no funds to withdraw")
+
+	// only the recipient can withdraw
+	suite.ctx = suite.ctx.WithHeaderInfo(header.Info{Time: startTime.Add(25 * time.Second)})
+	_, err = suite.msgServer.WithdrawPaymentStream(suite.ctx, &types.MsgWithdrawPaymentStream{Recipient: senderStrAddr, StreamId: res.StreamId})
+	suite.Require().ErrorContains(err, "is not the recipient of payment stream 0")
+
+	// a quarter of the stream has been released
+	streamed := sdk.NewCoins(sdk.NewInt64Coin("foo", 250))
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.StreamAccount, recipientAddr, streamed).Return(nil)
+	withdrawRes, err := suite.msgServer.WithdrawPaymentStream(suite.ctx, &types.MsgWithdrawPaymentStream{Recipient: recipientStrAddr, StreamId: res.StreamId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(streamed, withdrawRes.Amount)
+
+	stream, err := suite.poolKeeper.PaymentStreams.Get(suite.ctx, res.StreamId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(streamed, stream.Withdrawn)
+
+	// only the sender can cancel
+	suite.ctx = suite.ctx.WithHeaderInfo(header.Info{Time: startTime.Add(60 * time.Second)})
+	_, err = suite.msgServer.CancelPaymentStream(suite.ctx, &types.MsgCancelPaymentStream{Sender: recipientStrAddr, StreamId: res.StreamId})
+	suite.Require().ErrorContains(err, "is not the sender of payment stream 0")
+
+	// cancelling pays out the streamed funds and refunds the rest pro-rata
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.StreamAccount, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", 350))).Return(nil)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.StreamAccount, sender, sdk.NewCoins(sdk.NewInt64Coin("foo", 400))).Return(nil)
+	cancelRes, err := suite.msgServer.CancelPaymentStream(suite.ctx, &types.MsgCancelPaymentStream{Sender: senderStrAddr, StreamId: res.StreamId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 400)), cancelRes.Refunded)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 350)), cancelRes.Withdrawn)
+
+	has, err := suite.poolKeeper.PaymentStreams.Has(suite.ctx, res.StreamId)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	has, err = suite.poolKeeper.PaymentStreamsBySender.Has(suite.ctx, collections.Join(sender, res.StreamId))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	_, err = suite.msgServer.WithdrawPaymentStream(suite.ctx, &types.MsgWithdrawPaymentStream{Recipient: recipientStrAddr, StreamId: res.StreamId})
+	suite.Require().ErrorIs(err, types.ErrNoPaymentStream)
+}
+
+func (suite *KeeperTestSuite) TestWithdrawPaymentStreamAfterEnd() {
+	suite.SetupTest()
+
+	addressCodec := codectestutil.CodecOptions{}.GetAddressCodec()
+	sender := sdk.AccAddress([]byte("sender1_________________"))
+	senderStrAddr, err := addressCodec.BytesToString(sender)
+	suite.Require().NoError(err)
+	recipientStrAddr, err := addressCodec.BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	now := suite.environment.HeaderService.HeaderInfo(suite.ctx).Time
+	startTime := now.Add(10 * time.Second)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("foo", 1000), sdk.NewInt64Coin("bar", 3))
+
+	suite.bankKeeper.EXPECT().BlockedAddr(recipientAddr).Return(false)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, sender, types.StreamAccount, amount).Return(nil)
+	res, err := suite.msgServer.CreatePaymentStream(suite.ctx, &types.MsgCreatePaymentStream{
+		Sender: senderStrAddr, Recipient: recipientStrAddr, Amount: amount, StartTime: &startTime, EndTime: startTime.Add(30 * time.Second),
+	})
+	suite.Require().NoError(err)
+
+	// amounts are truncated per denom
+	suite.ctx = suite.ctx.WithHeaderInfo(header.Info{Time: startTime.Add(10 * time.Second)})
+	streamed := sdk.NewCoins(sdk.NewInt64Coin("foo", 333), sdk.NewInt64Coin("bar", 1))
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.StreamAccount, recipientAddr, streamed).Return(nil)
+	withdrawRes, err := suite.msgServer.WithdrawPaymentStream(suite.ctx, &types.MsgWithdrawPaymentStream{Recipient: recipientStrAddr, StreamId: res.StreamId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(streamed, withdrawRes.Amount)
+
+	// the remaining funds are withdrawn after the end time and the stream is removed
+	suite.ctx = suite.ctx.WithHeaderInfo(header.Info{Time: startTime.Add(time.Hour)})
+	remaining := amount.Sub(streamed...)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.StreamAccount, recipientAddr, remaining).Return(nil)
+	withdrawRes, err = suite.msgServer.WithdrawPaymentStream(suite.ctx, &types.MsgWithdrawPaymentStream{Recipient: recipientStrAddr, StreamId: res.StreamId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(remaining, withdrawRes.Amount)
+
+	has, err := suite.poolKeeper.PaymentStreams.Has(suite.ctx, res.StreamId)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
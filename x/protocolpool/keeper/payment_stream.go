@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreatePaymentStream escrows amount from the sender into the stream account and
+// creates a payment stream releasing it linearly to the recipient between startTime
+// and endTime. If startTime is nil, the stream starts at the current block time.
+// The recipient cannot be an address blocked from receiving funds.
+// It returns the id of the created stream.
+func (k Keeper) CreatePaymentStream(ctx context.Context, sender, recipient string, amount sdk.Coins, startTime *time.Time, endTime time.Time) (uint64, error) {
+	senderAddr, err := k.authKeeper.AddressCodec().StringToBytes(sender)
+	if err != nil {
+		return 0, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	recipientAddr, err := k.authKeeper.AddressCodec().StringToBytes(recipient)
+	if err != nil {
+		return 0, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if k.bankKeeper.BlockedAddr(recipientAddr) {
+		return 0, sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", recipient)
+	}
+
+	if err := validateAmount(amount); err != nil {
+		return 0, err
+	}
+	if amount.IsZero() {
+		return 0, errors.New("payment stream amount cannot be zero")
+	}
+
+	currentTime := k.HeaderService.HeaderInfo(ctx).Time
+	start := currentTime
+	if startTime != nil {
+		if startTime.Before(currentTime) {
+			return 0, errors.New("start time cannot be less than the current block time")
+		}
+		start = *startTime
+	}
+	if !endTime.After(start) {
+		return 0, errors.New("end time must be after the start time")
+	}
+
+	// escrow the whole amount in the stream account
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, types.StreamAccount, amount); err != nil {
+		return 0, fmt.Errorf("error while escrowing payment stream funds: %w", err)
+	}
+
+	id, err := k.PaymentStreamSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	stream := types.PaymentStream{
+		Id:        id,
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+		Withdrawn: sdk.NewCoins(),
+		StartTime: start,
+		EndTime:   endTime,
+	}
+	if err := k.setPaymentStream(ctx, senderAddr, recipientAddr, stream); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// WithdrawPaymentStream sends to the recipient the funds streamed so far and not
+// withdrawn yet. The stream is removed once its whole amount has been withdrawn.
+func (k Keeper) WithdrawPaymentStream(ctx context.Context, recipient string, id uint64) (sdk.Coins, error) {
+	stream, err := k.getPaymentStream(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if stream.Recipient != recipient {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not the recipient of payment stream %d", recipient, id)
+	}
+
+	recipientAddr, err := k.authKeeper.AddressCodec().StringToBytes(stream.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	withdrawable := k.PaymentStreamWithdrawable(ctx, stream)
+	if withdrawable.IsZero() {
+		return nil, fmt.Errorf("no funds to withdraw from payment stream %d", id)
+	}
+
+	if err := k.DistributeFromStreamFunds(ctx, withdrawable, recipientAddr); err != nil {
+		return nil, fmt.Errorf("error while distributing funds: %w", err)
+	}
+
+	stream.Withdrawn = stream.Withdrawn.Add(withdrawable...)
+
+	// the stream is fully paid out, remove it
+	if stream.Withdrawn.Equal(stream.Amount) {
+		senderAddr, err := k.authKeeper.AddressCodec().StringToBytes(stream.Sender)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+		}
+		if err := k.removePaymentStream(ctx, senderAddr, recipientAddr, id); err != nil {
+			return nil, err
+		}
+		return withdrawable, nil
+	}
+
+	if err := k.PaymentStreams.Set(ctx, id, stream); err != nil {
+		return nil, err
+	}
+
+	return withdrawable, nil
+}
+
+// CancelPaymentStream cancels a payment stream on behalf of its sender. The funds
+// streamed so far and not withdrawn yet are sent to the recipient, and the funds
+// that have not been streamed yet are refunded to the sender.
+func (k Keeper) CancelPaymentStream(ctx context.Context, sender string, id uint64) (refunded, withdrawn sdk.Coins, err error) {
+	stream, err := k.getPaymentStream(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if stream.Sender != sender {
+		return nil, nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not the sender of payment stream %d", sender, id)
+	}
+
+	senderAddr, err := k.authKeeper.AddressCodec().StringToBytes(stream.Sender)
+	if err != nil {
+		return nil, nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	recipientAddr, err := k.authKeeper.AddressCodec().StringToBytes(stream.Recipient)
+	if err != nil {
+		return nil, nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	streamed := k.paymentStreamStreamed(ctx, stream)
+	withdrawn = streamed.Sub(stream.Withdrawn...)
+	refunded = stream.Amount.Sub(streamed...)
+
+	if !withdrawn.IsZero() {
+		if err := k.DistributeFromStreamFunds(ctx, withdrawn, recipientAddr); err != nil {
+			return nil, nil, fmt.Errorf("error while distributing funds to recipient: %w", err)
+		}
+	}
+
+	if !refunded.IsZero() {
+		if err := k.DistributeFromStreamFunds(ctx, refunded, senderAddr); err != nil {
+			return nil, nil, fmt.Errorf("error while refunding sender: %w", err)
+		}
+	}
+
+	if err := k.removePaymentStream(ctx, senderAddr, recipientAddr, id); err != nil {
+		return nil, nil, err
+	}
+
+	return refunded, withdrawn, nil
+}
+
+// PaymentStreamWithdrawable returns the amount of a payment stream that has been
+// streamed at the current block time and not withdrawn yet.
+func (k Keeper) PaymentStreamWithdrawable(ctx context.Context, stream types.PaymentStream) sdk.Coins {
+	return k.paymentStreamStreamed(ctx, stream).Sub(stream.Withdrawn...)
+}
+
+// paymentStreamStreamed returns the amount of a payment stream released to the
+// recipient at the current block time, truncated per denom.
+func (k Keeper) paymentStreamStreamed(ctx context.Context, stream types.PaymentStream) sdk.Coins {
+	currentTime := k.HeaderService.HeaderInfo(ctx).Time
+
+	if !currentTime.After(stream.StartTime) {
+		return sdk.NewCoins()
+	}
+	if !currentTime.Before(stream.EndTime) {
+		return stream.Amount
+	}
+
+	elapsed := math.NewInt(int64(currentTime.Sub(stream.StartTime)))
+	duration := math.NewInt(int64(stream.EndTime.Sub(stream.StartTime)))
+
+	streamed := sdk.NewCoins()
+	for _, coin := range stream.Amount {
+		streamed = streamed.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(elapsed).Quo(duration)))
+	}
+	return streamed
+}
+
+func (k Keeper) getPaymentStream(ctx context.Context, id uint64) (types.PaymentStream, error) {
+	stream, err := k.PaymentStreams.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PaymentStream{}, types.ErrNoPaymentStream.Wrapf("id %d", id)
+		}
+		return types.PaymentStream{}, err
+	}
+	return stream, nil
+}
+
+func (k Keeper) setPaymentStream(ctx context.Context, sender, recipient sdk.AccAddress, stream types.PaymentStream) error {
+	if err := k.PaymentStreams.Set(ctx, stream.Id, stream); err != nil {
+		return err
+	}
+	if err := k.PaymentStreamsBySender.Set(ctx, collections.Join(sender, stream.Id)); err != nil {
+		return err
+	}
+	return k.PaymentStreamsByRecipient.Set(ctx, collections.Join(recipient, stream.Id))
+}
+
+func (k Keeper) removePaymentStream(ctx context.Context, sender, recipient sdk.AccAddress, id uint64) error {
+	if err := k.PaymentStreams.Remove(ctx, id); err != nil {
+		return err
+	}
+	if err := k.PaymentStreamsBySender.Remove(ctx, collections.Join(sender, id)); err != nil {
+		return err
+	}
+	return k.PaymentStreamsByRecipient.Remove(ctx, collections.Join(recipient, id))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -42,6 +43,47 @@ func ValidateGenesis(gs *GenesisState) error {
 			return err
 		}
 	}
+	ids := make(map[uint64]bool, len(gs.PaymentStreams))
+	for _, ps := range gs.PaymentStreams {
+		if ids[ps.Id] {
+			return fmt.Errorf("duplicate payment stream id %d", ps.Id)
+		}
+		ids[ps.Id] = true
+
+		if ps.Id >= gs.NextPaymentStreamId {
+			return fmt.Errorf("payment stream id %d must be lower than the next payment stream id %d", ps.Id, gs.NextPaymentStreamId)
+		}
+		if err := validatePaymentStream(ps); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validatePaymentStream(ps PaymentStream) error {
+	if ps.Sender == "" {
+		return errors.New("sender cannot be empty")
+	}
+	if ps.Recipient == "" {
+		return errors.New("recipient cannot be empty")
+	}
+
+	if ps.Amount.IsZero() {
+		return errors.New("payment stream amount cannot be zero")
+	}
+	if err := ps.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, ps.Amount.String())
+	}
+	if err := ps.Withdrawn.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, ps.Withdrawn.String())
+	}
+	if !ps.Withdrawn.IsAllLTE(ps.Amount) {
+		return fmt.Errorf("withdrawn amount %s cannot exceed the payment stream amount %s", ps.Withdrawn, ps.Amount)
+	}
+
+	if !ps.EndTime.After(ps.StartTime) {
+		return errors.New("payment stream end time must be after the start time")
+	}
 	return nil
 }
 
@@ -44,6 +44,10 @@ type GenesisState struct {
 	// params defines the parameters of this module, currently only contains the
 	// denoms that will be used for continuous fund distributions.
 	Params *Params `protobuf:"bytes,5,opt,name=params,proto3" json:"params,omitempty"`
+	// payment_streams defines the payment streams at genesis.
+	PaymentStreams []PaymentStream `protobuf:"bytes,6,rep,name=payment_streams,json=paymentStreams,proto3" json:"payment_streams"`
+	// next_payment_stream_id is the id assigned to the next payment stream.
+	NextPaymentStreamId uint64 `protobuf:"varint,7,opt,name=next_payment_stream_id,json=nextPaymentStreamId,proto3" json:"next_payment_stream_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -114,6 +118,20 @@ func (m *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (m *GenesisState) GetPaymentStreams() []PaymentStream {
+	if m != nil {
+		return m.PaymentStreams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextPaymentStreamId() uint64 {
+	if m != nil {
+		return m.NextPaymentStreamId
+	}
+	return 0
+}
+
 type Distribution struct {
 	// time at which this distribution was made, in order to distribute to non-expired funds only
 	// and funds that existed at that time. Because we don't distribute right away, we keep track
@@ -180,32 +198,36 @@ func init() {
 }
 
 var fileDescriptor_72560a99455b4146 = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0xeb, 0xd3, 0x30,
-	0x18, 0x87, 0x9b, 0xad, 0xee, 0x90, 0x4d, 0x85, 0x22, 0x52, 0x7b, 0xe8, 0xe6, 0x18, 0x32, 0x3c,
-	0xa4, 0x6c, 0x8a, 0x17, 0x4f, 0x56, 0x51, 0xf1, 0xa2, 0x74, 0x9e, 0xbc, 0x8c, 0xb4, 0xcd, 0x4a,
-	0xb1, 0xcd, 0x5b, 0x96, 0x64, 0xe8, 0x97, 0x90, 0x7d, 0xac, 0x1d, 0x77, 0xf4, 0xa4, 0xb2, 0x7d,
-	0x01, 0x3f, 0x82, 0x34, 0xe9, 0xa4, 0x03, 0x0b, 0xf2, 0xbf, 0xa5, 0x6f, 0x9f, 0xdf, 0xc3, 0xfb,
-	0xe6, 0x0d, 0x9e, 0x25, 0x20, 0x4a, 0x10, 0x41, 0xb5, 0x05, 0x09, 0x09, 0x14, 0x15, 0x40, 0x11,
-	0xec, 0x16, 0x41, 0xc6, 0x38, 0x13, 0xb9, 0x20, 0xba, 0xee, 0xdc, 0x37, 0x14, 0x69, 0x53, 0x64,
-	0xb7, 0xf0, 0xa6, 0x1d, 0x69, 0xf9, 0xb5, 0x62, 0x0d, 0xed, 0xdd, 0xcb, 0x20, 0x03, 0x7d, 0x0c,
-	0xea, 0x53, 0x53, 0x7d, 0x60, 0x92, 0x6b, 0xf3, 0xa3, 0xad, 0xf7, 0xc6, 0x19, 0x40, 0x56, 0x30,
-	0x23, 0x8d, 0xd5, 0x26, 0x90, 0x79, 0xc9, 0x84, 0xa4, 0x65, 0x65, 0x80, 0xe9, 0xef, 0x1e, 0x1e,
-	0xbd, 0x31, 0xfd, 0xad, 0x24, 0x95, 0xcc, 0x79, 0x8f, 0xef, 0x26, 0xc0, 0x65, 0xce, 0x15, 0x28,
-	0xb1, 0xde, 0x28, 0x9e, 0xba, 0x68, 0xd2, 0x9f, 0x0f, 0x97, 0x8f, 0xc8, 0xbf, 0x1b, 0x27, 0x2f,
-	0xff, 0xe2, 0xaf, 0x15, 0x4f, 0xa3, 0x3b, 0xc9, 0xd5, 0xb7, 0xf3, 0x0c, 0x0f, 0x62, 0x95, 0x66,
-	0x4c, 0xba, 0x3d, 0xed, 0xf1, 0xbb, 0x3c, 0xa1, 0xa6, 0xa2, 0x86, 0x76, 0x56, 0x78, 0x54, 0x50,
-	0x21, 0xd7, 0x31, 0x2d, 0x28, 0x4f, 0x98, 0xdb, 0x9f, 0xa0, 0xf9, 0x70, 0xf9, 0xb8, 0x2b, 0xfd,
-	0x2a, 0x17, 0x72, 0x9b, 0xc7, 0x4a, 0xe6, 0xc0, 0x5f, 0x94, 0xa0, 0xb8, 0x0c, 0xed, 0xc3, 0x8f,
-	0xb1, 0x15, 0x0d, 0x6b, 0x4b, 0x68, 0x24, 0xce, 0x3b, 0x7c, 0x3b, 0x6d, 0x81, 0xc2, 0xb5, 0x75,
-	0x4f, 0xb3, 0xff, 0xb1, 0x46, 0xd7, 0xd1, 0x7a, 0xb0, 0x8a, 0x6e, 0x69, 0x29, 0xdc, 0x5b, 0xba,
-	0xb5, 0xce, 0xc1, 0x3e, 0x68, 0x2a, 0x6a, 0xe8, 0xe9, 0x37, 0x84, 0x47, 0x6d, 0xaf, 0xf3, 0x14,
-	0xdb, 0xf5, 0x5a, 0x5c, 0xa4, 0x35, 0x1e, 0x31, 0x3b, 0x23, 0x97, 0x9d, 0x91, 0x8f, 0x97, 0x9d,
-	0x85, 0xf6, 0xfe, 0xe7, 0x18, 0x45, 0x9a, 0x76, 0xde, 0xe2, 0x01, 0xd5, 0x73, 0xba, 0xbd, 0x1b,
-	0xde, 0x4c, 0x93, 0x0f, 0x9f, 0x1f, 0x4e, 0x3e, 0x3a, 0x9e, 0x7c, 0xf4, 0xeb, 0xe4, 0xa3, 0xfd,
-	0xd9, 0xb7, 0x8e, 0x67, 0xdf, 0xfa, 0x7e, 0xf6, 0xad, 0x4f, 0x0f, 0x8d, 0x52, 0xa4, 0x9f, 0x49,
-	0x0e, 0xc1, 0x97, 0xeb, 0xb7, 0xa9, 0x1f, 0x66, 0x3c, 0xd0, 0xb5, 0x27, 0x7f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0xa2, 0x1c, 0x62, 0x0f, 0xfd, 0x02, 0x00, 0x00,
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0x35, 0x14, 0xc9, 0x2d, 0x9b, 0x64, 0xd0, 0x14, 0x7a, 0x48, 0x4b, 0x35, 0x50,
+	0xc5, 0xc1, 0xd1, 0x36, 0xc4, 0x85, 0x13, 0x01, 0xf1, 0xeb, 0x02, 0x4a, 0x77, 0xe2, 0x12, 0x39,
+	0x89, 0x17, 0x45, 0x24, 0x7e, 0x51, 0x6d, 0x4f, 0xdb, 0x3f, 0x81, 0xf6, 0x67, 0xed, 0xb8, 0x23,
+	0x27, 0x40, 0xed, 0x9f, 0xc1, 0x05, 0xc5, 0x4e, 0x51, 0x22, 0x11, 0x09, 0x71, 0x73, 0x9f, 0x3f,
+	0xef, 0xa3, 0xf7, 0x6d, 0x9e, 0xf1, 0x51, 0x02, 0xb2, 0x04, 0xe9, 0x57, 0x6b, 0x50, 0x90, 0x40,
+	0x51, 0x01, 0x14, 0xfe, 0xc5, 0xb1, 0x9f, 0x71, 0xc1, 0x65, 0x2e, 0xa9, 0xa9, 0x93, 0x43, 0x4b,
+	0xd1, 0x36, 0x45, 0x2f, 0x8e, 0xa7, 0x8b, 0x9e, 0x6e, 0x75, 0x55, 0xf1, 0x86, 0x9e, 0x3e, 0xc8,
+	0x20, 0x03, 0x73, 0xf4, 0xeb, 0x53, 0x53, 0x7d, 0x68, 0x3b, 0x23, 0x7b, 0xd1, 0xd6, 0x4f, 0x67,
+	0x19, 0x40, 0x56, 0x70, 0x2b, 0x8d, 0xf5, 0xb9, 0xaf, 0xf2, 0x92, 0x4b, 0xc5, 0xca, 0xca, 0x02,
+	0x8b, 0x5f, 0x43, 0x3c, 0x79, 0x6b, 0xe7, 0x5b, 0x29, 0xa6, 0x38, 0xf9, 0x88, 0x0f, 0x12, 0x10,
+	0x2a, 0x17, 0x1a, 0xb4, 0x8c, 0xce, 0xb5, 0x48, 0x5d, 0x34, 0x1f, 0x2e, 0xc7, 0x27, 0x4f, 0xe8,
+	0xdf, 0x07, 0xa7, 0xaf, 0xfe, 0xe0, 0x6f, 0xb4, 0x48, 0xc3, 0xfd, 0xa4, 0xf3, 0x9b, 0x3c, 0xc7,
+	0xa3, 0x58, 0xa7, 0x19, 0x57, 0xee, 0x9e, 0xf1, 0x78, 0x7d, 0x9e, 0xc0, 0x50, 0x61, 0x43, 0x93,
+	0x15, 0x9e, 0x14, 0x4c, 0xaa, 0x28, 0x66, 0x05, 0x13, 0x09, 0x77, 0x87, 0x73, 0xb4, 0x1c, 0x9f,
+	0x3c, 0xed, 0xeb, 0x7e, 0x9d, 0x4b, 0xb5, 0xce, 0x63, 0xad, 0x72, 0x10, 0x2f, 0x4b, 0xd0, 0x42,
+	0x05, 0xce, 0xcd, 0xf7, 0xd9, 0x20, 0x1c, 0xd7, 0x96, 0xc0, 0x4a, 0xc8, 0x07, 0x7c, 0x2f, 0x6d,
+	0x81, 0xd2, 0x75, 0xcc, 0x4c, 0x47, 0xff, 0x62, 0x0d, 0xbb, 0xad, 0x75, 0xb0, 0x8a, 0xad, 0x59,
+	0x29, 0xdd, 0x3b, 0x66, 0xb4, 0xde, 0x60, 0x9f, 0x0c, 0x15, 0x36, 0x34, 0x39, 0xc3, 0x07, 0x15,
+	0xbb, 0x2a, 0xb9, 0x50, 0x91, 0x54, 0x6b, 0x5e, 0x0b, 0x46, 0x66, 0x8a, 0xc7, 0xfd, 0x02, 0x83,
+	0xaf, 0x0c, 0xdd, 0xc4, 0xda, 0xaf, 0xda, 0x45, 0x49, 0x4e, 0xf1, 0xa1, 0xe0, 0x97, 0x2a, 0xea,
+	0xaa, 0xa3, 0x3c, 0x75, 0xef, 0xce, 0xd1, 0xd2, 0x09, 0xef, 0xd7, 0xb7, 0x1d, 0xd1, 0xfb, 0x74,
+	0xf1, 0x15, 0xe1, 0x49, 0x3b, 0x22, 0x79, 0x86, 0x9d, 0x7a, 0x43, 0x5c, 0x64, 0x12, 0x4d, 0xa9,
+	0x5d, 0x1f, 0xba, 0x5b, 0x1f, 0x7a, 0xb6, 0x5b, 0x9f, 0xc0, 0xb9, 0xfe, 0x31, 0x43, 0xa1, 0xa1,
+	0xc9, 0x3b, 0x3c, 0x62, 0xe6, 0x2f, 0x77, 0xf7, 0xfe, 0xf3, 0x23, 0x35, 0xfd, 0xc1, 0x8b, 0x9b,
+	0x8d, 0x87, 0x6e, 0x37, 0x1e, 0xfa, 0xb9, 0xf1, 0xd0, 0xf5, 0xd6, 0x1b, 0xdc, 0x6e, 0xbd, 0xc1,
+	0xb7, 0xad, 0x37, 0xf8, 0xfc, 0xc8, 0x2a, 0x65, 0xfa, 0x85, 0xe6, 0xe0, 0x5f, 0x76, 0x9f, 0x89,
+	0x79, 0x23, 0xf1, 0xc8, 0xd4, 0x4e, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x15, 0x68, 0x57, 0x4d,
+	0x88, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -228,6 +250,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextPaymentStreamId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPaymentStreamId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PaymentStreams) > 0 {
+		for iNdEx := len(m.PaymentStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.Params != nil {
 		{
 			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
@@ -379,6 +420,15 @@ func (m *GenesisState) Size() (n int) {
 		l = m.Params.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.PaymentStreams) > 0 {
+		for _, e := range m.PaymentStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPaymentStreamId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPaymentStreamId))
+	}
 	return n
 }
 
@@ -603,6 +653,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentStreams = append(m.PaymentStreams, PaymentStream{})
+			if err := m.PaymentStreams[len(m.PaymentStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPaymentStreamId", wireType)
+			}
+			m.NextPaymentStreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPaymentStreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
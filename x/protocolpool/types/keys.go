@@ -32,4 +32,8 @@ var (
 	DistributionsKey             = collections.NewPrefix(6)
 	LastBalanceKey               = collections.NewPrefix(7)
 	ParamsKey                    = collections.NewPrefix(8)
+	PaymentStreamsKey            = collections.NewPrefix(9)
+	PaymentStreamSequenceKey     = collections.NewPrefix(10)
+	PaymentStreamsBySenderKey    = collections.NewPrefix(11)
+	PaymentStreamsByRecipientKey = collections.NewPrefix(12)
 )
@@ -229,6 +229,109 @@ func (m *DistributionAmount) GetAmount() github_com_cosmos_cosmos_sdk_types.Coin
 	return nil
 }
 
+// PaymentStream defines a continuous payment between two arbitrary accounts.
+// The streamed amount is escrowed in the stream account when the stream is
+// created and released linearly to the recipient between start_time and
+// end_time, i.e. at a rate of amount / (end_time - start_time).
+type PaymentStream struct {
+	// id is the unique identifier of the payment stream.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the address of the account funding the stream.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the address of the account receiving the stream.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the total amount streamed between start_time and end_time.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// withdrawn is the amount already withdrawn by the recipient.
+	Withdrawn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=withdrawn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn"`
+	// start_time is the time from which funds start streaming.
+	StartTime time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the time at which the whole amount has been streamed.
+	EndTime time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *PaymentStream) Reset()         { *m = PaymentStream{} }
+func (m *PaymentStream) String() string { return proto.CompactTextString(m) }
+func (*PaymentStream) ProtoMessage()    {}
+func (*PaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{3}
+}
+func (m *PaymentStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentStream.Merge(m, src)
+}
+func (m *PaymentStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentStream proto.InternalMessageInfo
+
+func (m *PaymentStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PaymentStream) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PaymentStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *PaymentStream) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *PaymentStream) GetWithdrawn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return nil
+}
+
+func (m *PaymentStream) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *PaymentStream) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 // Params defines the parameters for the protocolpool module.
 type Params struct {
 	// enabled_distribution_denoms lists the denoms that are allowed to be distributed.
@@ -240,7 +343,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b7d0ea246d7f44, []int{3}
+	return fileDescriptor_c1b7d0ea246d7f44, []int{4}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,6 +383,7 @@ func init() {
 	proto.RegisterType((*Budget)(nil), "cosmos.protocolpool.v1.Budget")
 	proto.RegisterType((*ContinuousFund)(nil), "cosmos.protocolpool.v1.ContinuousFund")
 	proto.RegisterType((*DistributionAmount)(nil), "cosmos.protocolpool.v1.DistributionAmount")
+	proto.RegisterType((*PaymentStream)(nil), "cosmos.protocolpool.v1.PaymentStream")
 	proto.RegisterType((*Params)(nil), "cosmos.protocolpool.v1.Params")
 }
 
@@ -288,47 +392,54 @@ func init() {
 }
 
 var fileDescriptor_c1b7d0ea246d7f44 = []byte{
-	// 627 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x4b, 0xdc, 0x4c,
-	0x18, 0xde, 0x7c, 0xee, 0x17, 0xd8, 0xf9, 0xd4, 0x4f, 0x07, 0x29, 0x71, 0x85, 0xec, 0x76, 0x7b,
-	0x59, 0x04, 0x13, 0xb6, 0x85, 0xb6, 0x50, 0x28, 0x35, 0x6e, 0xad, 0x07, 0x0f, 0x36, 0xf5, 0xd4,
-	0x4b, 0x98, 0x24, 0xaf, 0x71, 0x30, 0x99, 0x09, 0x33, 0x13, 0x71, 0xaf, 0xfd, 0x03, 0xf5, 0x58,
-	0xfa, 0x0b, 0x4a, 0x4f, 0x1e, 0xfc, 0x11, 0x1e, 0x45, 0x28, 0x94, 0x1e, 0xb4, 0xe8, 0xc1, 0xbf,
-	0x51, 0x92, 0xcc, 0xda, 0xd5, 0x43, 0xed, 0x65, 0x37, 0xbc, 0xef, 0xf3, 0x3c, 0xf3, 0x3c, 0xef,
-	0x9b, 0x09, 0xea, 0x45, 0x5c, 0x66, 0x5c, 0xba, 0xb9, 0xe0, 0x8a, 0x47, 0x3c, 0xcd, 0x39, 0x4f,
-	0xdd, 0xfd, 0x81, 0xab, 0x46, 0x39, 0x48, 0xa7, 0xaa, 0xe2, 0x07, 0x35, 0xc6, 0x99, 0xc4, 0x38,
-	0xfb, 0x83, 0xf6, 0x42, 0xc2, 0x13, 0x5e, 0x15, 0xdd, 0xf2, 0xa9, 0xee, 0xb7, 0x17, 0x6b, 0x74,
-	0x50, 0x37, 0x26, 0xa9, 0x6d, 0x5b, 0x1f, 0x16, 0x12, 0x09, 0xee, 0xfe, 0x20, 0x04, 0x45, 0x06,
-	0x6e, 0xc4, 0x29, 0xd3, 0xfd, 0x4e, 0xc2, 0x79, 0x92, 0x42, 0x6d, 0x26, 0x2c, 0x76, 0x5c, 0x45,
-	0x33, 0x90, 0x8a, 0x64, 0xf9, 0x58, 0xe0, 0x2e, 0x20, 0x2e, 0x04, 0x51, 0x94, 0x8f, 0x05, 0xe6,
-	0x49, 0x46, 0x19, 0x77, 0xab, 0xdf, 0xba, 0xd4, 0xfb, 0x30, 0x85, 0x4c, 0xaf, 0x88, 0x13, 0x50,
-	0xf8, 0x35, 0x9a, 0x17, 0x10, 0xd1, 0x9c, 0x02, 0x53, 0x01, 0x89, 0x63, 0x01, 0x52, 0x5a, 0x46,
-	0xd7, 0xe8, 0xb7, 0x3c, 0xeb, 0xec, 0x78, 0x65, 0x41, 0x7b, 0x5d, 0xad, 0x3b, 0xef, 0x94, 0xa0,
-	0x2c, 0xf1, 0xe7, 0x6e, 0x28, 0xba, 0x8e, 0x5f, 0xa1, 0xd9, 0x28, 0x25, 0x34, 0x83, 0x38, 0x20,
-	0x19, 0x2f, 0x98, 0xb2, 0xfe, 0xe9, 0x1a, 0xfd, 0xff, 0x1e, 0x2f, 0x3a, 0x5a, 0xa0, 0x8c, 0xe7,
-	0xe8, 0x78, 0xce, 0x1a, 0xa7, 0xcc, 0x9f, 0xd1, 0x84, 0xd5, 0x0a, 0x8f, 0x37, 0xd0, 0xff, 0x29,
-	0x91, 0x2a, 0xb8, 0x91, 0x51, 0xd6, 0x54, 0x25, 0xd1, 0x76, 0xea, 0x80, 0xce, 0x38, 0xa0, 0xb3,
-	0x3d, 0x9e, 0x80, 0xd7, 0x3c, 0xbc, 0xe8, 0x18, 0xfe, 0x4c, 0x49, 0x5c, 0xd3, 0x6a, 0x0a, 0x3f,
-	0x42, 0x33, 0x4a, 0x10, 0x16, 0xed, 0x82, 0x0c, 0x52, 0xd8, 0x51, 0x56, 0xb3, 0x6b, 0xf4, 0x9b,
-	0xfe, 0xf4, 0xb8, 0xb8, 0x09, 0x3b, 0x0a, 0xbf, 0x41, 0x38, 0xac, 0x26, 0x10, 0xe4, 0x20, 0x02,
-	0xdd, 0xb2, 0xfe, 0xbd, 0xcf, 0xf4, 0x5c, 0x4d, 0xda, 0x02, 0xb1, 0x5d, 0x53, 0xf0, 0x33, 0x64,
-	0xe6, 0x20, 0x28, 0x8f, 0x2d, 0x53, 0x93, 0xef, 0xda, 0x1d, 0xea, 0x7d, 0x78, 0xcd, 0x4f, 0xa5,
-	0x5b, 0x0d, 0xef, 0x7d, 0x33, 0xd0, 0xec, 0x1a, 0x67, 0x8a, 0xb2, 0x82, 0x17, 0x72, 0xbd, 0x60,
-	0x31, 0x7e, 0x8a, 0x5a, 0x37, 0x93, 0xbd, 0x77, 0x09, 0xbf, 0xa1, 0xf8, 0x2d, 0x42, 0x39, 0x88,
-	0x08, 0x98, 0x22, 0x09, 0x54, 0x93, 0x6f, 0x79, 0x83, 0x93, 0xf3, 0x4e, 0xe3, 0xc7, 0x79, 0x67,
-	0xa9, 0x26, 0xcb, 0x78, 0xcf, 0xa1, 0xdc, 0xcd, 0x88, 0xda, 0x75, 0x36, 0x21, 0x21, 0xd1, 0x68,
-	0x08, 0xd1, 0xd9, 0xf1, 0x0a, 0xd2, 0xda, 0x43, 0x88, 0xfc, 0x09, 0x11, 0xfc, 0x1c, 0x99, 0x70,
-	0x90, 0x53, 0x31, 0xfa, 0xeb, 0x2d, 0x68, 0x7c, 0xef, 0xa3, 0x81, 0xf0, 0x90, 0x4a, 0x25, 0x68,
-	0x58, 0x94, 0xb1, 0xf5, 0x7e, 0x47, 0xc8, 0xd4, 0x6f, 0x86, 0xd1, 0x9d, 0xfa, 0xe3, 0x90, 0xbd,
-	0xf5, 0xd2, 0xfa, 0xd7, 0x8b, 0x4e, 0x3f, 0xa1, 0x6a, 0xb7, 0x08, 0x9d, 0x88, 0x67, 0xfa, 0xce,
-	0xe8, 0xbf, 0x15, 0x19, 0xef, 0xe9, 0xdb, 0x58, 0x12, 0xe4, 0xe7, 0xeb, 0xa3, 0xe5, 0xe9, 0xb4,
-	0x4a, 0x15, 0x94, 0x57, 0x47, 0x7e, 0xb9, 0x3e, 0x5a, 0x36, 0x7c, 0x7d, 0x60, 0x6f, 0x03, 0x99,
-	0x5b, 0x44, 0x90, 0x4c, 0xe2, 0x97, 0x68, 0x09, 0x18, 0x09, 0x53, 0x88, 0x83, 0x78, 0xc2, 0x62,
-	0x10, 0x03, 0xe3, 0x99, 0xac, 0x9c, 0xb5, 0xfc, 0x45, 0x0d, 0x99, 0x0c, 0x31, 0xac, 0x00, 0xde,
-	0x8b, 0x93, 0x4b, 0xdb, 0x38, 0xbd, 0xb4, 0x8d, 0x9f, 0x97, 0xb6, 0x71, 0x78, 0x65, 0x37, 0x4e,
-	0xaf, 0xec, 0xc6, 0xf7, 0x2b, 0xbb, 0xf1, 0xfe, 0xe1, 0xad, 0x31, 0x1f, 0xdc, 0xfe, 0x76, 0x54,
-	0x56, 0x43, 0xb3, 0xaa, 0x3d, 0xf9, 0x15, 0x00, 0x00, 0xff, 0xff, 0x20, 0xd5, 0xf7, 0x00, 0x5f,
-	0x04, 0x00, 0x00,
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x9b, 0xac, 0x77, 0x33, 0x6c, 0xca, 0xee, 0x68, 0x85, 0xdc, 0xac, 0x94, 0x84, 0x70,
+	0x89, 0x56, 0xaa, 0x4d, 0x40, 0x02, 0x24, 0x24, 0x60, 0x93, 0xb0, 0xec, 0x61, 0x0f, 0xc5, 0xed,
+	0x89, 0x8b, 0x35, 0xf6, 0xbc, 0x3a, 0xa3, 0xda, 0x33, 0xd6, 0xcc, 0xb8, 0x6d, 0x4e, 0x48, 0x5c,
+	0x39, 0xd0, 0x23, 0xe2, 0x17, 0x20, 0x4e, 0x3d, 0xf4, 0x47, 0xf4, 0x58, 0x55, 0x42, 0x42, 0x1c,
+	0x5a, 0xd4, 0x1e, 0xfa, 0x37, 0x90, 0xed, 0x49, 0x9b, 0x56, 0xa2, 0x2d, 0x97, 0x5e, 0xda, 0xe8,
+	0xbd, 0xef, 0xfb, 0xe6, 0xbd, 0xef, 0xbd, 0x97, 0xa0, 0x7e, 0x24, 0x54, 0x2a, 0x94, 0x97, 0x49,
+	0xa1, 0x45, 0x24, 0x92, 0x4c, 0x88, 0xc4, 0xdb, 0x1e, 0x7a, 0x7a, 0x96, 0x81, 0x72, 0xcb, 0x28,
+	0xfe, 0xa0, 0xc2, 0xb8, 0x8b, 0x18, 0x77, 0x7b, 0xd8, 0x7e, 0x11, 0x8b, 0x58, 0x94, 0x41, 0xaf,
+	0xf8, 0x54, 0xe5, 0xdb, 0x2b, 0x15, 0x3a, 0xa8, 0x12, 0x8b, 0xd4, 0x76, 0xc7, 0x3c, 0x16, 0x12,
+	0x05, 0xde, 0xf6, 0x30, 0x04, 0x4d, 0x86, 0x5e, 0x24, 0x18, 0x37, 0xf9, 0x6e, 0x2c, 0x44, 0x9c,
+	0x40, 0x55, 0x4c, 0x98, 0x6f, 0x7a, 0x9a, 0xa5, 0xa0, 0x34, 0x49, 0xb3, 0xb9, 0xc0, 0x4d, 0x00,
+	0xcd, 0x25, 0xd1, 0x4c, 0xcc, 0x05, 0x9e, 0x93, 0x94, 0x71, 0xe1, 0x95, 0x7f, 0xab, 0x50, 0xff,
+	0xa7, 0x3a, 0xb2, 0x47, 0x39, 0x8d, 0x41, 0xe3, 0x6f, 0xd1, 0x73, 0x09, 0x11, 0xcb, 0x18, 0x70,
+	0x1d, 0x10, 0x4a, 0x25, 0x28, 0xe5, 0x58, 0x3d, 0x6b, 0xd0, 0x1c, 0x39, 0xc7, 0x07, 0xab, 0x2f,
+	0x4c, 0xad, 0xaf, 0xab, 0xcc, 0xba, 0x96, 0x8c, 0xc7, 0xfe, 0xb3, 0x4b, 0x8a, 0x89, 0xe3, 0x6f,
+	0xd0, 0x72, 0x94, 0x10, 0x96, 0x02, 0x0d, 0x48, 0x2a, 0x72, 0xae, 0x9d, 0xa5, 0x9e, 0x35, 0x78,
+	0xef, 0x93, 0x15, 0xd7, 0x08, 0x14, 0xed, 0xb9, 0xa6, 0x3d, 0x77, 0x2c, 0x18, 0xf7, 0x5b, 0x86,
+	0xf0, 0xba, 0xc4, 0xe3, 0xb7, 0xe8, 0xfd, 0x84, 0x28, 0x1d, 0x5c, 0xca, 0x68, 0xa7, 0x5e, 0x4a,
+	0xb4, 0xdd, 0xaa, 0x41, 0x77, 0xde, 0xa0, 0xbb, 0x31, 0x77, 0x60, 0xd4, 0xd8, 0x3b, 0xed, 0x5a,
+	0x7e, 0xab, 0x20, 0x8e, 0x8d, 0x9a, 0xc6, 0x1f, 0xa1, 0x96, 0x96, 0x84, 0x47, 0x53, 0x50, 0x41,
+	0x02, 0x9b, 0xda, 0x69, 0xf4, 0xac, 0x41, 0xc3, 0x7f, 0x3a, 0x0f, 0xbe, 0x83, 0x4d, 0x8d, 0xbf,
+	0x43, 0x38, 0x2c, 0x1d, 0x08, 0x32, 0x90, 0x81, 0x49, 0x39, 0x8f, 0xee, 0x2a, 0xfa, 0x59, 0x45,
+	0x5a, 0x03, 0xb9, 0x51, 0x51, 0xf0, 0xe7, 0xc8, 0xce, 0x40, 0x32, 0x41, 0x1d, 0xdb, 0x90, 0x6f,
+	0x96, 0x3b, 0x31, 0xf3, 0x18, 0x35, 0x7e, 0x2d, 0xaa, 0x35, 0xf0, 0xfe, 0x9f, 0x16, 0x5a, 0x1e,
+	0x0b, 0xae, 0x19, 0xcf, 0x45, 0xae, 0xde, 0xe4, 0x9c, 0xe2, 0xcf, 0x50, 0xf3, 0xd2, 0xd9, 0x3b,
+	0x87, 0x70, 0x05, 0xc5, 0xdf, 0x23, 0x94, 0x81, 0x8c, 0x80, 0x6b, 0x12, 0x43, 0xe9, 0x7c, 0x73,
+	0x34, 0x3c, 0x3c, 0xe9, 0xd6, 0xfe, 0x3e, 0xe9, 0xbe, 0xac, 0xc8, 0x8a, 0x6e, 0xb9, 0x4c, 0x78,
+	0x29, 0xd1, 0x53, 0xf7, 0x1d, 0xc4, 0x24, 0x9a, 0x4d, 0x20, 0x3a, 0x3e, 0x58, 0x45, 0x46, 0x7b,
+	0x02, 0x91, 0xbf, 0x20, 0x82, 0xbf, 0x40, 0x36, 0xec, 0x66, 0x4c, 0xce, 0xee, 0x3d, 0x05, 0x83,
+	0xef, 0xff, 0x62, 0x21, 0x3c, 0x61, 0x4a, 0x4b, 0x16, 0xe6, 0x45, 0xdb, 0x66, 0xbe, 0x33, 0x64,
+	0x9b, 0xcd, 0xb0, 0x7a, 0xf5, 0x5b, 0x4d, 0x1e, 0xbd, 0x29, 0x4a, 0xff, 0xe3, 0xb4, 0x3b, 0x88,
+	0x99, 0x9e, 0xe6, 0xa1, 0x1b, 0x89, 0xd4, 0xdc, 0x8c, 0xf9, 0xb7, 0xaa, 0xe8, 0x96, 0xb9, 0xc6,
+	0x82, 0xa0, 0x7e, 0xbb, 0xd8, 0x7f, 0xf5, 0x34, 0x29, 0xbb, 0x0a, 0x8a, 0xd3, 0x51, 0xbf, 0x5f,
+	0xec, 0xbf, 0xb2, 0x7c, 0xf3, 0x60, 0xff, 0xe7, 0x06, 0x6a, 0xad, 0x91, 0x59, 0x0a, 0x5c, 0xaf,
+	0x6b, 0x09, 0x24, 0xc5, 0xcb, 0x68, 0x89, 0xd1, 0xd2, 0xe1, 0x86, 0xbf, 0xc4, 0x28, 0xfe, 0x18,
+	0xd9, 0x0a, 0x38, 0x05, 0x69, 0xcc, 0xfb, 0x6f, 0xd7, 0x0d, 0xee, 0xfa, 0xa8, 0xea, 0xf7, 0x1f,
+	0xd5, 0x95, 0x0d, 0x8d, 0x07, 0xb6, 0x01, 0xff, 0x88, 0x9a, 0x3b, 0x4c, 0x4f, 0xa9, 0x24, 0x3b,
+	0xdc, 0x79, 0xf4, 0x50, 0xaf, 0x5f, 0xbd, 0x89, 0xc7, 0x08, 0x29, 0x4d, 0xa4, 0x0e, 0x8a, 0xaf,
+	0x30, 0x73, 0x2e, 0xb7, 0xed, 0xd5, 0x93, 0xa2, 0x84, 0x72, 0xb7, 0x9a, 0x25, 0xaf, 0xc8, 0xe0,
+	0xaf, 0xd1, 0x13, 0xe0, 0xb4, 0x92, 0x78, 0xfc, 0x3f, 0x24, 0x1e, 0x03, 0xa7, 0x45, 0xbc, 0xff,
+	0x16, 0xd9, 0x6b, 0x44, 0x92, 0x54, 0xe1, 0xaf, 0xd0, 0x4b, 0xe0, 0x24, 0x4c, 0x80, 0x06, 0x74,
+	0x61, 0x61, 0x03, 0x0a, 0x5c, 0xa4, 0xaa, 0xdc, 0xd3, 0xa6, 0xbf, 0x62, 0x20, 0x8b, 0x2b, 0x3d,
+	0x29, 0x01, 0xa3, 0x2f, 0x0f, 0xcf, 0x3a, 0xd6, 0xd1, 0x59, 0xc7, 0xfa, 0xe7, 0xac, 0x63, 0xed,
+	0x9d, 0x77, 0x6a, 0x47, 0xe7, 0x9d, 0xda, 0x5f, 0xe7, 0x9d, 0xda, 0x0f, 0x1f, 0x5e, 0x3b, 0xba,
+	0xdd, 0xeb, 0xbf, 0x24, 0xa5, 0x67, 0xa1, 0x5d, 0xc6, 0x3e, 0xfd, 0x37, 0x00, 0x00, 0xff, 0xff,
+	0x65, 0x65, 0x35, 0xb4, 0x6d, 0x06, 0x00, 0x00,
 }
 
 func (m *Budget) Marshal() (dAtA []byte, err error) {
@@ -497,6 +608,92 @@ func (m *DistributionAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x3a
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Withdrawn) > 0 {
+		for iNdEx := len(m.Withdrawn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -606,6 +803,42 @@ func (m *DistributionAmount) Size() (n int) {
 	return n
 }
 
+func (m *PaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Withdrawn) > 0 {
+		for _, e := range m.Withdrawn {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1108,6 +1341,273 @@ func (m *DistributionAmount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PaymentStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawn = append(m.Withdrawn, types.Coin{})
+			if err := m.Withdrawn[len(m.Withdrawn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
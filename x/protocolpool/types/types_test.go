@@ -24,6 +24,9 @@ func TestRegisterInterfaces(t *testing.T) {
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCreateContinuousFund{}))
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCancelContinuousFund{}))
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgWithdrawContinuousFund{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCreatePaymentStream{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgWithdrawPaymentStream{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCancelPaymentStream{}))
 }
 
 func TestNewMsgFundCommunityPool(t *testing.T) {
@@ -81,6 +84,78 @@ func TestValidateGenesis(t *testing.T) {
 	require.EqualError(t, err, "recipient cannot be empty")
 }
 
+func TestValidatePaymentStreamsGenesis(t *testing.T) {
+	start := time.Unix(1700000000, 0).UTC()
+	stream := PaymentStream{
+		Id:        1,
+		Sender:    "cosmos1qypq2q2l8z4wz2z2l8z4wz2z2l8z4wz2z2l8z4",
+		Recipient: "cosmos1qypq2q2l8z4wz2z2l8z4wz2z2l8z4wz2z2l8z5",
+		Amount:    sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(100))),
+		StartTime: start,
+		EndTime:   start.Add(hour),
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func(gs *GenesisState)
+		expErrMsg string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name:      "id not lower than next id",
+			malleate:  func(gs *GenesisState) { gs.NextPaymentStreamId = 1 },
+			expErrMsg: "payment stream id 1 must be lower than the next payment stream id 1",
+		},
+		{
+			name:      "duplicate id",
+			malleate:  func(gs *GenesisState) { gs.PaymentStreams = append(gs.PaymentStreams, stream) },
+			expErrMsg: "duplicate payment stream id 1",
+		},
+		{
+			name:      "empty sender",
+			malleate:  func(gs *GenesisState) { gs.PaymentStreams[0].Sender = "" },
+			expErrMsg: "sender cannot be empty",
+		},
+		{
+			name:      "zero amount",
+			malleate:  func(gs *GenesisState) { gs.PaymentStreams[0].Amount = sdk.NewCoins() },
+			expErrMsg: "payment stream amount cannot be zero",
+		},
+		{
+			name: "withdrawn exceeds amount",
+			malleate: func(gs *GenesisState) {
+				gs.PaymentStreams[0].Withdrawn = sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(101)))
+			},
+			expErrMsg: "withdrawn amount 101stake cannot exceed the payment stream amount 100stake",
+		},
+		{
+			name:      "end time before start time",
+			malleate:  func(gs *GenesisState) { gs.PaymentStreams[0].EndTime = start },
+			expErrMsg: "payment stream end time must be after the start time",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			gs.PaymentStreams = []PaymentStream{stream}
+			gs.NextPaymentStreamId = 2
+			if tc.malleate != nil {
+				tc.malleate(gs)
+			}
+
+			err := ValidateGenesis(gs)
+			if tc.expErrMsg != "" {
+				require.EqualError(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestValidateBudget(t *testing.T) {
 	testCases := []struct {
 		name      string
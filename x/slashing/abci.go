@@ -23,6 +23,12 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper, cometService comet.Servi
 	if err != nil {
 		return err
 	}
+	// unjail the validators whose jail duration for an automatically unjailed
+	// downtime offense has elapsed
+	if err := k.AutoUnjailValidators(ctx); err != nil {
+		return err
+	}
+
 	ci := cometService.CometInfo(ctx)
 	for _, vote := range ci.LastCommit.Votes {
 		err := k.HandleValidatorSignatureWithParams(ctx, params, vote.Validator.Address, vote.Validator.Power, vote.BlockIDFlag)
@@ -34,6 +34,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "signing-infos",
 					Short:     "Query signing information of all validators",
 				},
+				{
+					RpcMethod: "DowntimeOffenses",
+					Use:       "downtime-offenses <validator-address>",
+					Short:     "Query a validator's downtime offense history",
+					Long:      "Query the downtime offenses of a validator with a validator consensus address, with their slash fraction and jail duration",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cons_address"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
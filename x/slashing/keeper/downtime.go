@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetDowntimeOffenses returns the downtime offenses of a validator, from the oldest to the newest.
+func (k Keeper) GetDowntimeOffenses(ctx context.Context, consAddr sdk.ConsAddress) ([]types.DowntimeOffense, error) {
+	var offenses []types.DowntimeOffense
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](consAddr)
+	err := k.DowntimeOffenses.Walk(ctx, rng, func(_ collections.Pair[[]byte, uint64], offense types.DowntimeOffense) (bool, error) {
+		offenses = append(offenses, offense)
+		return false, nil
+	})
+
+	return offenses, err
+}
+
+// setDowntimeOffense records a downtime offense of a validator, queueing the automatic unjail of the
+// validator if the offense allows it.
+func (k Keeper) setDowntimeOffense(ctx context.Context, consAddr sdk.ConsAddress, offense types.DowntimeOffense) error {
+	if err := k.DowntimeOffenses.Set(ctx, collections.Join([]byte(consAddr), offense.Offense), offense); err != nil {
+		return err
+	}
+
+	if !offense.AutoUnjail {
+		return nil
+	}
+
+	return k.DowntimeAutoUnjailQueue.Set(ctx, collections.Join(offense.Time.Add(offense.JailDuration), []byte(consAddr)))
+}
+
+// AutoUnjailValidators unjails the validators whose jail duration for a downtime offense allowing the
+// automatic unjail has elapsed. The validators that were unjailed in the meantime, jailed again or
+// that can no longer be unjailed, e.g. because their self-delegation dropped below their minimum
+// self-delegation, are left as they are.
+func (k Keeper) AutoUnjailValidators(ctx context.Context) error {
+	now := k.HeaderService.HeaderInfo(ctx).Time
+
+	var due []collections.Pair[time.Time, []byte]
+	err := k.DowntimeAutoUnjailQueue.Walk(ctx, nil, func(key collections.Pair[time.Time, []byte]) (bool, error) {
+		if key.K1().After(now) {
+			return true, nil
+		}
+
+		due = append(due, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		if err := k.DowntimeAutoUnjailQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		if err := k.autoUnjailValidator(ctx, sdk.ConsAddress(key.K2())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// autoUnjailValidator unjails a validator if its last downtime offense allows the automatic unjail.
+func (k Keeper) autoUnjailValidator(ctx context.Context, consAddr sdk.ConsAddress) error {
+	signInfo, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	offense, err := k.DowntimeOffenses.Get(ctx, collections.Join([]byte(consAddr), signInfo.DowntimeOffenseCount))
+	if errors.Is(err, collections.ErrNotFound) || (err == nil && !offense.AutoUnjail) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	validator, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil || validator == nil || !validator.IsJailed() {
+		// the validator was removed or unjailed in the meantime
+		return nil
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	err = k.Unjail(ctx, valAddr)
+	switch {
+	case errors.Is(err, types.ErrValidatorJailed),
+		errors.Is(err, types.ErrMissingSelfDelegation),
+		errors.Is(err, types.ErrSelfDelegationTooLowToUnjail):
+		k.Logger.Info("validator cannot be automatically unjailed", "validator", signInfo.Address, "reason", err.Error())
+		return nil
+	case err != nil:
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeUnjail,
+		event.NewAttribute(types.AttributeKeyAddress, signInfo.Address),
+	)
+}
+
+// migrateDowntimeOffenses moves the downtime offenses and the queued automatic unjail of a validator
+// from its old consensus address to its new one.
+func (k Keeper) migrateDowntimeOffenses(ctx context.Context, oldConsAddr, newConsAddr sdk.ConsAddress, newConsStr string) error {
+	offenses, err := k.GetDowntimeOffenses(ctx, oldConsAddr)
+	if err != nil {
+		return err
+	}
+
+	for _, offense := range offenses {
+		if err := k.DowntimeOffenses.Remove(ctx, collections.Join([]byte(oldConsAddr), offense.Offense)); err != nil {
+			return err
+		}
+
+		oldKey := collections.Join(offense.Time.Add(offense.JailDuration), []byte(oldConsAddr))
+		queued, err := k.DowntimeAutoUnjailQueue.Has(ctx, oldKey)
+		if err != nil {
+			return err
+		}
+		if queued {
+			if err := k.DowntimeAutoUnjailQueue.Remove(ctx, oldKey); err != nil {
+				return err
+			}
+		}
+
+		offense.Address = newConsStr
+		if err := k.DowntimeOffenses.Set(ctx, collections.Join([]byte(newConsAddr), offense.Offense), offense); err != nil {
+			return err
+		}
+		if queued {
+			if err := k.DowntimeAutoUnjailQueue.Set(ctx, collections.Join(offense.Time.Add(offense.JailDuration), []byte(newConsAddr))); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/slashing/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -59,6 +61,31 @@ func (keeper Keeper) InitGenesis(ctx context.Context, stakingKeeper types.Stakin
 		}
 	}
 
+	for _, offense := range data.DowntimeOffenses {
+		address, err := keeper.sk.ConsensusAddressCodec().StringToBytes(offense.Address)
+		if err != nil {
+			return err
+		}
+
+		// only queue the automatic unjail of the pending last offense of the validator
+		info, err := keeper.ValidatorSigningInfo.Get(ctx, address)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		pending := offense.AutoUnjail && err == nil && info.DowntimeOffenseCount == offense.Offense &&
+			info.JailedUntil.Equal(offense.Time.Add(offense.JailDuration))
+
+		if err := keeper.DowntimeOffenses.Set(ctx, collections.Join(address, offense.Offense), offense); err != nil {
+			return err
+		}
+
+		if pending {
+			if err := keeper.DowntimeAutoUnjailQueue.Set(ctx, collections.Join(info.JailedUntil, address)); err != nil {
+				return err
+			}
+		}
+	}
+
 	if err := keeper.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
@@ -100,5 +127,17 @@ func (keeper Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, er
 	if err != nil {
 		return nil, err
 	}
-	return types.NewGenesisState(params, signingInfos, missedBlocks), nil
+
+	downtimeOffenses := make([]types.DowntimeOffense, 0)
+	err = keeper.DowntimeOffenses.Walk(ctx, nil, func(_ collections.Pair[[]byte, uint64], offense types.DowntimeOffense) (stop bool, err error) {
+		downtimeOffenses = append(downtimeOffenses, offense)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis := types.NewGenesisState(params, signingInfos, missedBlocks)
+	genesis.DowntimeOffenses = downtimeOffenses
+	return genesis, nil
 }
@@ -5,6 +5,8 @@ import (
 
 	"go.uber.org/mock/gomock"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/testutil"
 	"cosmossdk.io/x/slashing/types"
 
@@ -28,6 +30,19 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 	info2 := types.NewValidatorSigningInfo(consStr2, int64(5),
 		time.Now().UTC().Add(10000000000), false, int64(10))
 
+	// the first validator is jailed for a downtime offense with an automatic unjail
+	info1.DowntimeOffenseCount = 1
+	offense := types.DowntimeOffense{
+		Address:       consStr1,
+		Offense:       1,
+		Height:        3,
+		Time:          info1.JailedUntil.Add(-time.Hour),
+		SlashFraction: math.LegacyNewDecWithPrec(1, 2),
+		JailDuration:  time.Hour,
+		AutoUnjail:    true,
+	}
+	require.NoError(keeper.DowntimeOffenses.Set(ctx, collections.Join([]byte(consAddr1), uint64(1)), offense))
+
 	s.Require().NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr1, info1))
 	s.Require().NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr2, info2))
 	genesisState, err := keeper.ExportGenesis(ctx)
@@ -36,6 +51,7 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 	require.Equal(genesisState.Params, testutil.TestParams())
 	require.Len(genesisState.SigningInfos, 2)
 	require.Equal(genesisState.SigningInfos[0].ValidatorSigningInfo, info1)
+	require.Equal([]types.DowntimeOffense{offense}, genesisState.DowntimeOffenses)
 
 	// Tombstone validators after genesis shouldn't effect genesis state
 	err = keeper.Tombstone(ctx, consAddr1)
@@ -62,4 +78,9 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 	newInfo2, _ := keeper.ValidatorSigningInfo.Get(ctx, consAddr2)
 	require.Equal(info1, newInfo1)
 	require.Equal(info2, newInfo2)
+
+	// the automatic unjail of the pending offense is queued again
+	queued, err := keeper.DowntimeAutoUnjailQueue.Has(ctx, collections.Join(info1.JailedUntil, []byte(consAddr1)))
+	require.NoError(err)
+	require.True(queued)
 }
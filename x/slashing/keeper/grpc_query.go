@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/slashing/types"
 
@@ -80,3 +81,31 @@ func (k Querier) SigningInfos(ctx context.Context, req *types.QuerySigningInfosR
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// DowntimeOffenses returns the downtime offense history of a specific validator.
+func (k Querier) DowntimeOffenses(ctx context.Context, req *types.QueryDowntimeOffensesRequest) (*types.QueryDowntimeOffensesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	offenses, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.DowntimeOffenses, req.Pagination,
+		func(_ collections.Pair[[]byte, uint64], offense types.DowntimeOffense) (types.DowntimeOffense, error) {
+			return offense, nil
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, uint64](consAddr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDowntimeOffensesResponse{Offenses: offenses, Pagination: pageRes}, nil
+}
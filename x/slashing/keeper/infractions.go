@@ -145,10 +145,11 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 		// This is acceptable since it's only used to filter unbonding delegations & redelegations.
 		distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-		slashFractionDowntime, err := k.SlashFractionDowntime(ctx)
-		if err != nil {
-			return err
-		}
+		// repeat offenders are punished with the escalating slash fractions and jail
+		// durations of the graduated downtime slashing mode, if enabled
+		offense := signInfo.DowntimeOffenseCount + 1
+		slashFractionDowntime := params.DowntimeSlashFraction(offense)
+		downtimeJailDur := params.DowntimeJailDurationFor(offense)
 
 		coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME)
 		if err != nil {
@@ -162,6 +163,7 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			event.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 			event.NewAttribute(types.AttributeKeyJailed, consStr),
 			event.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
+			event.NewAttribute(types.AttributeKeyOffense, fmt.Sprintf("%d", offense)),
 		); err != nil {
 			return err
 		}
@@ -170,11 +172,23 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 		if err != nil {
 			return err
 		}
-		downtimeJailDur, err := k.DowntimeJailDuration(ctx)
+		headerInfo := k.HeaderService.HeaderInfo(ctx)
+		signInfo.JailedUntil = headerInfo.Time.Add(downtimeJailDur)
+		signInfo.DowntimeOffenseCount = offense
+
+		// only the first offense of a validator is automatically unjailed
+		err = k.setDowntimeOffense(ctx, consAddr, types.DowntimeOffense{
+			Address:       consStr,
+			Offense:       offense,
+			Height:        height,
+			Time:          headerInfo.Time,
+			SlashFraction: slashFractionDowntime,
+			JailDuration:  downtimeJailDur,
+			AutoUnjail:    params.DowntimeAutoUnjail && offense == 1,
+		})
 		if err != nil {
 			return err
 		}
-		signInfo.JailedUntil = k.HeaderService.HeaderInfo(ctx).Time.Add(downtimeJailDur)
 
 		// We need to reset the counter & bitmap so that the validator won't be
 		// immediately slashed for downtime upon re-bonding.
@@ -4,9 +4,11 @@ import (
 	"time"
 
 	gogoany "github.com/cosmos/gogoproto/types/any"
+	"go.uber.org/mock/gomock"
 
 	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
 	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/types"
 	stakingtypes "cosmossdk.io/x/staking/types"
@@ -125,3 +127,97 @@ func (s *KeeperTestSuite) TestKeeper_HandleValidatorSignature() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestHandleValidatorSignatureGraduated() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, edPubKey, valAddr := testdata.KeyTestPubAddrED25519()
+	consAddr := sdk.ConsAddress(edPubKey.Address())
+	valStrAddr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(err)
+	consStrAddr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+	vpk, err := gogoany.NewAnyWithCacheWithValue(edPubKey)
+	require.NoError(err)
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.GraduatedSlashFractionsDowntime = []math.LegacyDec{math.LegacyNewDecWithPrec(1, 2), math.LegacyNewDecWithPrec(5, 2)}
+	params.GraduatedDowntimeJailDurations = []time.Duration{10 * time.Minute, time.Hour}
+	params.DowntimeAutoUnjail = true
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	validator := stakingtypes.Validator{
+		OperatorAddress:   valStrAddr,
+		ConsensusPubkey:   vpk,
+		Status:            stakingtypes.Bonded,
+		Tokens:            math.NewInt(100),
+		DelegatorShares:   math.LegacyNewDec(100),
+		MinSelfDelegation: math.OneInt(),
+	}
+	jailedValidator := validator
+	jailedValidator.Jailed = true
+
+	headerInfo := ctx.HeaderInfo()
+	headerInfo.Height = 2000
+	ctx = ctx.WithHeaderInfo(headerInfo)
+	s.stakingKeeper.EXPECT().ValidatorIdentifier(gomock.Any(), consAddr).Return(consAddr, nil).AnyTimes()
+
+	// missOffense makes the validator miss enough blocks to commit its next downtime offense
+	missOffense := func(slashFraction math.LegacyDec) {
+		info, err := keeper.ValidatorSigningInfo.Get(ctx, consAddr)
+		if err != nil {
+			info = types.NewValidatorSigningInfo(consStrAddr, 0, time.Unix(0, 0), false, 0)
+		}
+		info.MissedBlocksCounter = 501
+		require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, info))
+
+		s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil)
+		s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, int64(1998), int64(10), slashFraction, stakingv1beta1.Infraction_INFRACTION_DOWNTIME).Return(math.NewInt(1), nil)
+		s.stakingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Return(nil)
+		require.NoError(keeper.HandleValidatorSignature(ctx, edPubKey.Address(), 10, comet.BlockIDFlagAbsent))
+	}
+
+	// the first offense is automatically unjailed once its jail duration has elapsed
+	missOffense(math.LegacyNewDecWithPrec(1, 2))
+	info, err := keeper.ValidatorSigningInfo.Get(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(uint64(1), info.DowntimeOffenseCount)
+	require.Equal(headerInfo.Time.Add(10*time.Minute), info.JailedUntil)
+
+	require.NoError(keeper.AutoUnjailValidators(ctx))
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2000, Time: info.JailedUntil})
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(jailedValidator, nil)
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valAddr)).Return(jailedValidator, nil)
+	s.stakingKeeper.EXPECT().Delegation(gomock.Any(), valAddr, sdk.ValAddress(valAddr)).Return(stakingtypes.NewDelegation(valStrAddr, valStrAddr, math.LegacyNewDec(100)), nil)
+	s.stakingKeeper.EXPECT().Unjail(gomock.Any(), consAddr).Return(nil)
+	require.NoError(keeper.AutoUnjailValidators(ctx))
+
+	// the repeat offenses are punished more and are not automatically unjailed
+	missOffense(math.LegacyNewDecWithPrec(5, 2))
+	missOffense(math.LegacyNewDecWithPrec(5, 2))
+	info, err = keeper.ValidatorSigningInfo.Get(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(uint64(3), info.DowntimeOffenseCount)
+	require.Equal(ctx.HeaderInfo().Time.Add(time.Hour), info.JailedUntil)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2000, Time: info.JailedUntil})
+	require.NoError(keeper.AutoUnjailValidators(ctx))
+
+	res, err := s.queryClient.DowntimeOffenses(ctx, &types.QueryDowntimeOffensesRequest{ConsAddress: consStrAddr})
+	require.NoError(err)
+	require.Len(res.Offenses, 3)
+	require.Equal(types.DowntimeOffense{
+		Address:       consStrAddr,
+		Offense:       1,
+		Height:        2000,
+		Time:          headerInfo.Time,
+		SlashFraction: math.LegacyNewDecWithPrec(1, 2),
+		JailDuration:  10 * time.Minute,
+		AutoUnjail:    true,
+	}, res.Offenses[0])
+	require.Equal(uint64(3), res.Offenses[2].Offense)
+	require.False(res.Offenses[2].AutoUnjail)
+}
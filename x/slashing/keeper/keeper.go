@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	st "cosmossdk.io/api/cosmos/staking/v1beta1"
 	"cosmossdk.io/collections"
@@ -36,6 +37,10 @@ type Keeper struct {
 	AddrPubkeyRelation collections.Map[[]byte, cryptotypes.PubKey]
 	// ValidatorMissedBlockBitmap key: ConsAddr | value: byte key for a validator's missed block bitmap chunk
 	ValidatorMissedBlockBitmap collections.Map[collections.Pair[[]byte, uint64], []byte]
+	// DowntimeOffenses key: ConsAddr | offense | value: DowntimeOffense
+	DowntimeOffenses collections.Map[collections.Pair[[]byte, uint64], types.DowntimeOffense]
+	// DowntimeAutoUnjailQueue key: JailedUntil | ConsAddr
+	DowntimeAutoUnjailQueue collections.KeySet[collections.Pair[time.Time, []byte]]
 }
 
 // NewKeeper creates a slashing keeper
@@ -69,6 +74,19 @@ func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyA
 			collections.PairKeyCodec(sdk.LengthPrefixedBytesKey, collections.Uint64Key),
 			collections.BytesValue,
 		),
+		DowntimeOffenses: collections.NewMap(
+			sb,
+			types.DowntimeOffensesKeyPrefix,
+			"downtime_offenses",
+			collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key),
+			codec.CollValue[types.DowntimeOffense](cdc),
+		),
+		DowntimeAutoUnjailQueue: collections.NewKeySet(
+			sb,
+			types.DowntimeAutoUnjailQueueKeyPrefix,
+			"downtime_auto_unjail_queue",
+			collections.PairKeyCodec(sdk.TimeKey, collections.BytesKey),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -162,7 +162,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 		func(i int64) {
 			s.ctx.KVStore(s.key).Set(validatorMissedBlockBitmapKey(consAddr, index), []byte{})
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"5069bafdf9d1c2b711430a139f0be4431ac44abc2cf07d5191a370760709f3f2",
 	)
 	s.Require().NoError(err)
 
@@ -174,7 +174,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 			err := s.slashingKeeper.SetMissedBlockBitmapChunk(s.ctx, consAddr, index, []byte{})
 			s.Require().NoError(err)
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"5069bafdf9d1c2b711430a139f0be4431ac44abc2cf07d5191a370760709f3f2",
 	)
 	s.Require().NoError(err)
 }
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	v4 "cosmossdk.io/x/slashing/migrations/v4"
+	"cosmossdk.io/x/slashing/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 )
@@ -47,3 +48,18 @@ func (m Migrator) Migrate3to4(ctx context.Context) error {
 	}
 	return v4.Migrate(ctx, m.keeper.cdc, store, params, m.valCodec)
 }
+
+// Migrate4to5 migrates the x/slashing module state from the consensus
+// version 4 to version 5. Specifically, it sets the default slash fraction and
+// jail duration of the equivocations in the grace window of a consensus key
+// rotation. The grace window itself stays disabled.
+func (m Migrator) Migrate4to5(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.SlashFractionDoubleSignRotation = types.DefaultSlashFractionDoubleSignRotation
+	params.DoubleSignRotationJailDuration = types.DefaultDoubleSignRotationJailDuration
+	return m.keeper.Params.Set(ctx, params)
+}
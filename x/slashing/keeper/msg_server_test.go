@@ -123,11 +123,14 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			request: &slashingtypes.MsgUpdateParams{
 				Authority: s.slashingKeeper.GetAuthority(),
 				Params: slashingtypes.Params{
-					SignedBlocksWindow:      int64(750),
-					MinSignedPerWindow:      minSignedPerWindow,
-					DowntimeJailDuration:    time.Duration(34800000000000),
-					SlashFractionDoubleSign: slashFractionDoubleSign,
-					SlashFractionDowntime:   slashFractionDowntime,
+					SignedBlocksWindow:              int64(750),
+					MinSignedPerWindow:              minSignedPerWindow,
+					DowntimeJailDuration:            time.Duration(34800000000000),
+					SlashFractionDoubleSign:         slashFractionDoubleSign,
+					SlashFractionDowntime:           slashFractionDowntime,
+					DoubleSignRotationGraceBlocks:   int64(10),
+					SlashFractionDoubleSignRotation: slashFractionDowntime,
+					DoubleSignRotationJailDuration:  time.Hour,
 				},
 			},
 			expectErr: false,
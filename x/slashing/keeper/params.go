@@ -40,3 +40,24 @@ func (k Keeper) SlashFractionDowntime(ctx context.Context) (sdkmath.LegacyDec, e
 	params, err := k.Params.Get(ctx)
 	return params.SlashFractionDowntime, err
 }
+
+// DoubleSignRotationGraceBlocks - number of blocks after a consensus key rotation during which
+// equivocations are not tombstoned
+func (k Keeper) DoubleSignRotationGraceBlocks(ctx context.Context) (int64, error) {
+	params, err := k.Params.Get(ctx)
+	return params.DoubleSignRotationGraceBlocks, err
+}
+
+// SlashFractionDoubleSignRotation - fraction of power slashed in case of double sign in the grace
+// window of a consensus key rotation
+func (k Keeper) SlashFractionDoubleSignRotation(ctx context.Context) (sdkmath.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	return params.SlashFractionDoubleSignRotation, err
+}
+
+// DoubleSignRotationJailDuration - jail duration in case of double sign in the grace window of a
+// consensus key rotation
+func (k Keeper) DoubleSignRotationJailDuration(ctx context.Context) (time.Duration, error) {
+	params, err := k.Params.Get(ctx)
+	return params.DoubleSignRotationJailDuration, err
+}
@@ -66,6 +66,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
 	)
+	params.SlashFractionDoubleSignRotation = types.DefaultSlashFractionDoubleSignRotation
+	params.DoubleSignRotationJailDuration = types.DefaultDoubleSignRotationJailDuration
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
 
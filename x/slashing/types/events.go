@@ -4,6 +4,7 @@ package types
 const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
+	EventTypeUnjail   = "unjail"
 
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
@@ -12,6 +13,7 @@ const (
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeyOffense      = "offense"
 
 	AttributeValueUnspecified      = "unspecified"
 	AttributeValueDoubleSign       = "double_sign"
@@ -62,5 +62,38 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateGraduatedSlashFractionsDowntime(data.Params.GraduatedSlashFractionsDowntime); err != nil {
+		return err
+	}
+
+	if err := validateGraduatedDowntimeJailDurations(data.Params.GraduatedDowntimeJailDurations); err != nil {
+		return err
+	}
+
+	if err := validateDoubleSignRotationGraceBlocks(data.Params.DoubleSignRotationGraceBlocks); err != nil {
+		return err
+	}
+
+	if err := validateSlashFractionDoubleSignRotation(data.Params.SlashFractionDoubleSignRotation); err != nil {
+		return err
+	}
+
+	if err := validateDoubleSignRotationJailDuration(data.Params.DoubleSignRotationJailDuration); err != nil {
+		return err
+	}
+
+	offenses := make(map[string]bool, len(data.DowntimeOffenses))
+	for _, offense := range data.DowntimeOffenses {
+		if offense.Offense == 0 {
+			return fmt.Errorf("downtime offense number of validator %s must be positive", offense.Address)
+		}
+
+		key := fmt.Sprintf("%s/%d", offense.Address, offense.Offense)
+		if offenses[key] {
+			return fmt.Errorf("duplicate downtime offense %d of validator %s", offense.Offense, offense.Address)
+		}
+		offenses[key] = true
+	}
+
 	return nil
 }
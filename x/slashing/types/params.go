@@ -9,14 +9,19 @@ import (
 
 // Default parameter namespace
 const (
-	DefaultSignedBlocksWindow   = int64(100)
-	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultSignedBlocksWindow             = int64(100)
+	DefaultDowntimeJailDuration           = 60 * 10 * time.Second
+	DefaultDoubleSignRotationJailDuration = 24 * time.Hour
 )
 
 var (
 	DefaultMinSignedPerWindow      = math.LegacyNewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
 	DefaultSlashFractionDowntime   = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+
+	// DefaultSlashFractionDoubleSignRotation is the slash fraction of an equivocation in the grace window of a
+	// consensus key rotation. The grace window is disabled by default.
+	DefaultSlashFractionDoubleSignRotation = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
 )
 
 // NewParams creates a new Params object
@@ -35,13 +40,17 @@ func NewParams(
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultSignedBlocksWindow,
 		DefaultMinSignedPerWindow,
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
 	)
+	params.SlashFractionDoubleSignRotation = DefaultSlashFractionDoubleSignRotation
+	params.DoubleSignRotationJailDuration = DefaultDoubleSignRotationJailDuration
+
+	return params
 }
 
 // Validate validates the params
@@ -61,6 +70,21 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateGraduatedSlashFractionsDowntime(p.GraduatedSlashFractionsDowntime); err != nil {
+		return err
+	}
+	if err := validateGraduatedDowntimeJailDurations(p.GraduatedDowntimeJailDurations); err != nil {
+		return err
+	}
+	if err := validateDoubleSignRotationGraceBlocks(p.DoubleSignRotationGraceBlocks); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDoubleSignRotation(p.SlashFractionDoubleSignRotation); err != nil {
+		return err
+	}
+	if err := validateDoubleSignRotationJailDuration(p.DoubleSignRotationJailDuration); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -147,6 +171,120 @@ func validateSlashFractionDowntime(i interface{}) error {
 	return nil
 }
 
+func validateGraduatedSlashFractionsDowntime(i interface{}) error {
+	v, ok := i.([]math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for j, fraction := range v {
+		if err := validateSlashFractionDowntime(fraction); err != nil {
+			return fmt.Errorf("graduated %w", err)
+		}
+		if j > 0 && fraction.LT(v[j-1]) {
+			return fmt.Errorf("graduated downtime slash fractions must be non-decreasing: %s < %s", fraction, v[j-1])
+		}
+	}
+
+	return nil
+}
+
+func validateGraduatedDowntimeJailDurations(i interface{}) error {
+	v, ok := i.([]time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for j, duration := range v {
+		if err := validateDowntimeJailDuration(duration); err != nil {
+			return fmt.Errorf("graduated %w", err)
+		}
+		if j > 0 && duration < v[j-1] {
+			return fmt.Errorf("graduated downtime jail durations must be non-decreasing: %s < %s", duration, v[j-1])
+		}
+	}
+
+	return nil
+}
+
+func validateDoubleSignRotationGraceBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("double sign rotation grace blocks cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateSlashFractionDoubleSignRotation(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("double sign rotation slash fraction cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("double sign rotation slash fraction cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("double sign rotation slash fraction too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateDoubleSignRotationJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("double sign rotation jail duration must be positive: %s", v)
+	}
+
+	return nil
+}
+
+// DowntimeSlashFraction returns the slash fraction of the given downtime offense of a validator,
+// starting at 1 for its first offense.
+func (p Params) DowntimeSlashFraction(offense uint64) math.LegacyDec {
+	if len(p.GraduatedSlashFractionsDowntime) == 0 {
+		return p.SlashFractionDowntime
+	}
+
+	return p.GraduatedSlashFractionsDowntime[graduatedIndex(offense, len(p.GraduatedSlashFractionsDowntime))]
+}
+
+// DowntimeJailDurationFor returns the jail duration of the given downtime offense of a validator,
+// starting at 1 for its first offense.
+func (p Params) DowntimeJailDurationFor(offense uint64) time.Duration {
+	if len(p.GraduatedDowntimeJailDurations) == 0 {
+		return p.DowntimeJailDuration
+	}
+
+	return p.GraduatedDowntimeJailDurations[graduatedIndex(offense, len(p.GraduatedDowntimeJailDurations))]
+}
+
+// graduatedIndex returns the index of an offense in a graduated list of n values, the last value
+// applying to all the further offenses.
+func graduatedIndex(offense uint64, n int) int {
+	if offense == 0 {
+		return 0
+	}
+	if offense > uint64(n) {
+		return n - 1
+	}
+
+	return int(offense - 1)
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
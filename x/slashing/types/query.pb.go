@@ -309,6 +309,116 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryDowntimeOffensesRequest is the request type for the Query/DowntimeOffenses RPC
+// method
+type QueryDowntimeOffensesRequest struct {
+	// cons_address is the address to query the downtime offenses of
+	ConsAddress string             `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDowntimeOffensesRequest) Reset()         { *m = QueryDowntimeOffensesRequest{} }
+func (m *QueryDowntimeOffensesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeOffensesRequest) ProtoMessage()    {}
+func (*QueryDowntimeOffensesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryDowntimeOffensesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeOffensesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeOffensesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeOffensesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeOffensesRequest.Merge(m, src)
+}
+func (m *QueryDowntimeOffensesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeOffensesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeOffensesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeOffensesRequest proto.InternalMessageInfo
+
+func (m *QueryDowntimeOffensesRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *QueryDowntimeOffensesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDowntimeOffensesResponse is the response type for the Query/DowntimeOffenses RPC
+// method
+type QueryDowntimeOffensesResponse struct {
+	// offenses are the downtime offenses of the validator, from the oldest to the newest
+	Offenses   []DowntimeOffense   `protobuf:"bytes,1,rep,name=offenses,proto3" json:"offenses"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDowntimeOffensesResponse) Reset()         { *m = QueryDowntimeOffensesResponse{} }
+func (m *QueryDowntimeOffensesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeOffensesResponse) ProtoMessage()    {}
+func (*QueryDowntimeOffensesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryDowntimeOffensesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeOffensesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeOffensesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeOffensesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeOffensesResponse.Merge(m, src)
+}
+func (m *QueryDowntimeOffensesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeOffensesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeOffensesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeOffensesResponse proto.InternalMessageInfo
+
+func (m *QueryDowntimeOffensesResponse) GetOffenses() []DowntimeOffense {
+	if m != nil {
+		return m.Offenses
+	}
+	return nil
+}
+
+func (m *QueryDowntimeOffensesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -316,6 +426,8 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryDowntimeOffensesRequest)(nil), "cosmos.slashing.v1beta1.QueryDowntimeOffensesRequest")
+	proto.RegisterType((*QueryDowntimeOffensesResponse)(nil), "cosmos.slashing.v1beta1.QueryDowntimeOffensesResponse")
 }
 
 func init() {
@@ -323,43 +435,49 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x06, 0x3a, 0x29, 0xa2, 0x63, 0xa1, 0x6d, 0xd0, 0x8d, 0x5d, 0x21, 0x2d,
-	0xd5, 0xee, 0x98, 0x88, 0xf4, 0xe4, 0xc1, 0x28, 0x8a, 0xe0, 0x41, 0x53, 0x10, 0xf4, 0x12, 0x66,
-	0x9b, 0xe9, 0x38, 0xb8, 0x99, 0xd9, 0xee, 0x6c, 0x82, 0x45, 0xf4, 0xe0, 0xd9, 0x83, 0xe0, 0x67,
-	0x10, 0x3c, 0xaa, 0xf8, 0x21, 0x7a, 0x2c, 0x7a, 0xf1, 0x24, 0x92, 0x08, 0xde, 0xfd, 0x04, 0xb2,
-	0x33, 0x93, 0x64, 0x4a, 0x5c, 0x4d, 0xe9, 0x25, 0x0c, 0x6f, 0xde, 0xff, 0xfd, 0x7f, 0xef, 0xe5,
-	0xcd, 0xc2, 0x8b, 0xdb, 0x52, 0x75, 0xa4, 0xc2, 0x2a, 0x22, 0xea, 0x09, 0x17, 0x0c, 0xf7, 0x6a,
-	0x21, 0x4d, 0x49, 0x0d, 0xef, 0x76, 0x69, 0xb2, 0x17, 0xc4, 0x89, 0x4c, 0x25, 0x5a, 0x34, 0x49,
-	0xc1, 0x30, 0x29, 0xb0, 0x49, 0xe5, 0x75, 0xab, 0x0e, 0x89, 0xa2, 0x46, 0x31, 0xd2, 0xc7, 0x84,
-	0x71, 0x41, 0x52, 0x2e, 0x85, 0x29, 0x52, 0x5e, 0x60, 0x92, 0x49, 0x7d, 0xc4, 0xd9, 0xc9, 0x46,
-	0xcf, 0x31, 0x29, 0x59, 0x44, 0x31, 0x89, 0x39, 0x26, 0x42, 0xc8, 0x54, 0x4b, 0x94, 0xbd, 0xad,
-	0xe6, 0xd1, 0x8d, 0x48, 0x4c, 0xde, 0xb2, 0xc9, 0x6b, 0x99, 0xf2, 0x96, 0xd6, 0x5c, 0x9d, 0x21,
-	0x1d, 0x2e, 0x24, 0xd6, 0xbf, 0x26, 0xe4, 0x2f, 0x40, 0xf4, 0x20, 0x63, 0xbd, 0x4f, 0x12, 0xd2,
-	0x51, 0x4d, 0xba, 0xdb, 0xa5, 0x2a, 0xf5, 0x1f, 0xc1, 0xb3, 0x87, 0xa2, 0x2a, 0x96, 0x42, 0x51,
-	0xd4, 0x80, 0xc5, 0x58, 0x47, 0x96, 0xc0, 0x05, 0xb0, 0x56, 0xaa, 0x57, 0x82, 0x9c, 0x61, 0x04,
-	0x46, 0xd8, 0x98, 0xdb, 0xff, 0x5e, 0x29, 0xbc, 0xff, 0xf5, 0x61, 0x1d, 0x34, 0xad, 0xd2, 0x6f,
-	0xc1, 0x45, 0x5d, 0x7a, 0x8b, 0x33, 0xc1, 0x05, 0xbb, 0x2b, 0x76, 0xa4, 0x75, 0x45, 0xb7, 0xe0,
-	0xfc, 0xb6, 0x14, 0xaa, 0x45, 0xda, 0xed, 0x84, 0x2a, 0x63, 0x32, 0xd7, 0x58, 0xf9, 0xf2, 0x79,
-	0xe3, 0xbc, 0xf5, 0xb9, 0x99, 0x61, 0x08, 0xd5, 0x55, 0x37, 0x4c, 0xca, 0x56, 0x9a, 0x70, 0xc1,
-	0x9a, 0xa5, 0x4c, 0x66, 0x43, 0xfe, 0x4b, 0xb8, 0x34, 0x69, 0x60, 0x1b, 0x08, 0xe1, 0xe9, 0x1e,
-	0x89, 0x5a, 0xca, 0x5c, 0xb5, 0xb8, 0xd8, 0x91, 0xb6, 0x95, 0x8d, 0xdc, 0x56, 0x1e, 0x92, 0x88,
-	0xb7, 0x49, 0x2a, 0x13, 0xa7, 0xa0, 0xdb, 0xd8, 0xa9, 0x1e, 0x89, 0x9c, 0x2b, 0x3f, 0x9c, 0xf4,
-	0x1f, 0xce, 0x15, 0xdd, 0x86, 0x70, 0xbc, 0x0b, 0xd6, 0xb9, 0x3a, 0x74, 0xce, 0x16, 0x27, 0x30,
-	0xab, 0x36, 0x1e, 0x23, 0xa3, 0x56, 0xdb, 0x74, 0x94, 0xfe, 0x27, 0x00, 0x97, 0xff, 0x62, 0x62,
-	0xbb, 0xbc, 0x07, 0x4f, 0xd8, 0xce, 0x66, 0x8f, 0xd5, 0x99, 0xae, 0x82, 0xee, 0x1c, 0x62, 0x9e,
-	0xd1, 0xcc, 0xab, 0xff, 0x65, 0x36, 0x28, 0x2e, 0x74, 0xfd, 0xf7, 0x2c, 0x3c, 0xa9, 0xa1, 0xd1,
-	0x6b, 0x00, 0x8b, 0x66, 0x43, 0xd0, 0xa5, 0x5c, 0xba, 0xc9, 0xb5, 0x2c, 0x5f, 0x9e, 0x2e, 0xd9,
-	0x78, 0xfb, 0xab, 0xaf, 0xbe, 0xfe, 0x7c, 0x3b, 0xb3, 0x82, 0x2a, 0x38, 0xef, 0xe5, 0x98, 0x95,
-	0x44, 0x1f, 0x01, 0x2c, 0x39, 0x23, 0x40, 0x57, 0xfe, 0x6d, 0x33, 0xb9, 0xb9, 0xe5, 0xda, 0x11,
-	0x14, 0x96, 0xee, 0xba, 0xa6, 0xdb, 0x44, 0xd7, 0x72, 0xe9, 0xdc, 0x2d, 0x55, 0xf8, 0xb9, 0xfb,
-	0x34, 0x5e, 0xa0, 0x77, 0x00, 0xce, 0xbb, 0x7f, 0x3e, 0x9a, 0x1e, 0x61, 0x34, 0xce, 0xfa, 0x51,
-	0x24, 0x16, 0x3b, 0xd0, 0xd8, 0x6b, 0xa8, 0x3a, 0x1d, 0x76, 0x63, 0x73, 0xbf, 0xef, 0x81, 0x83,
-	0xbe, 0x07, 0x7e, 0xf4, 0x3d, 0xf0, 0x66, 0xe0, 0x15, 0x0e, 0x06, 0x5e, 0xe1, 0xdb, 0xc0, 0x2b,
-	0x3c, 0xb6, 0x6f, 0x5a, 0xb5, 0x9f, 0x06, 0x5c, 0xe2, 0x67, 0xe3, 0x42, 0xe9, 0x5e, 0x4c, 0x55,
-	0x58, 0xd4, 0xdf, 0xa7, 0xab, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x12, 0x73, 0x05, 0x05, 0x95,
-	0x05, 0x00, 0x00,
+	// 660 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x6b, 0x13, 0x4f,
+	0x14, 0xc7, 0x33, 0xf9, 0xfd, 0x1a, 0xec, 0xa4, 0x48, 0x1d, 0x0b, 0x6d, 0x43, 0xbb, 0xb1, 0x2b,
+	0xa4, 0xa1, 0xda, 0x5d, 0x13, 0xa9, 0x45, 0xc4, 0x43, 0x63, 0x51, 0x04, 0xa1, 0x9a, 0x82, 0xa0,
+	0x97, 0x65, 0xd2, 0x4c, 0xd6, 0xc1, 0x64, 0x66, 0xbb, 0xb3, 0x89, 0x16, 0xd1, 0x83, 0x67, 0x0f,
+	0x82, 0x7f, 0x83, 0xe0, 0xc1, 0x83, 0x8a, 0x97, 0xde, 0x3d, 0xf4, 0x58, 0xf4, 0xe2, 0x49, 0x24,
+	0x11, 0xfc, 0x37, 0x24, 0x33, 0x93, 0x64, 0x93, 0xb8, 0x35, 0xd1, 0x5e, 0xca, 0xf2, 0xe6, 0x7d,
+	0xdf, 0xfb, 0xbc, 0x6f, 0xdf, 0x4c, 0xe0, 0xd9, 0x1d, 0x2e, 0x6a, 0x5c, 0xd8, 0xa2, 0x8a, 0xc5,
+	0x03, 0xca, 0x5c, 0xbb, 0x91, 0x2b, 0x91, 0x00, 0xe7, 0xec, 0xdd, 0x3a, 0xf1, 0xf7, 0x2c, 0xcf,
+	0xe7, 0x01, 0x47, 0xb3, 0x2a, 0xc9, 0xea, 0x24, 0x59, 0x3a, 0x29, 0xb5, 0xa2, 0xd5, 0x25, 0x2c,
+	0x88, 0x52, 0x74, 0xf5, 0x1e, 0x76, 0x29, 0xc3, 0x01, 0xe5, 0x4c, 0x15, 0x49, 0xcd, 0xb8, 0xdc,
+	0xe5, 0xf2, 0xd3, 0x6e, 0x7f, 0xe9, 0xe8, 0x82, 0xcb, 0xb9, 0x5b, 0x25, 0x36, 0xf6, 0xa8, 0x8d,
+	0x19, 0xe3, 0x81, 0x94, 0x08, 0x7d, 0x9a, 0x89, 0xa2, 0xeb, 0x92, 0xa8, 0xbc, 0x79, 0x95, 0xe7,
+	0xa8, 0xf2, 0x9a, 0x56, 0x1d, 0x9d, 0xc2, 0x35, 0xca, 0xb8, 0x2d, 0xff, 0xaa, 0x90, 0x39, 0x03,
+	0xd1, 0x9d, 0x36, 0xeb, 0x6d, 0xec, 0xe3, 0x9a, 0x28, 0x92, 0xdd, 0x3a, 0x11, 0x81, 0x79, 0x0f,
+	0x9e, 0xee, 0x8b, 0x0a, 0x8f, 0x33, 0x41, 0x50, 0x01, 0x26, 0x3c, 0x19, 0x99, 0x03, 0x67, 0x40,
+	0x36, 0x99, 0x4f, 0x5b, 0x11, 0x66, 0x58, 0x4a, 0x58, 0x98, 0x3c, 0xf8, 0x96, 0x8e, 0xbd, 0xf9,
+	0xf9, 0x6e, 0x05, 0x14, 0xb5, 0xd2, 0x74, 0xe0, 0xac, 0x2c, 0xbd, 0x4d, 0x5d, 0x46, 0x99, 0x7b,
+	0x93, 0x55, 0xb8, 0xee, 0x8a, 0x36, 0xe1, 0xd4, 0x0e, 0x67, 0xc2, 0xc1, 0xe5, 0xb2, 0x4f, 0x84,
+	0x6a, 0x32, 0x59, 0x58, 0xfa, 0xfc, 0x71, 0x75, 0x51, 0xf7, 0xb9, 0xd6, 0xc6, 0x60, 0xa2, 0x2e,
+	0x36, 0x54, 0xca, 0x76, 0xe0, 0x53, 0xe6, 0x16, 0x93, 0x6d, 0x99, 0x0e, 0x99, 0xcf, 0xe0, 0xdc,
+	0x70, 0x03, 0x3d, 0x40, 0x09, 0x4e, 0x37, 0x70, 0xd5, 0x11, 0xea, 0xc8, 0xa1, 0xac, 0xc2, 0xf5,
+	0x28, 0xab, 0x91, 0xa3, 0xdc, 0xc5, 0x55, 0x5a, 0xc6, 0x01, 0xf7, 0x43, 0x05, 0xc3, 0x83, 0x9d,
+	0x6c, 0xe0, 0x6a, 0xe8, 0xc8, 0x2c, 0x0d, 0xf7, 0xef, 0xf8, 0x8a, 0xae, 0x43, 0xd8, 0xdb, 0x05,
+	0xdd, 0x39, 0xd3, 0xe9, 0xdc, 0x5e, 0x1c, 0x4b, 0xad, 0x5a, 0xcf, 0x46, 0x97, 0x68, 0x6d, 0x31,
+	0xa4, 0x34, 0x3f, 0x00, 0x38, 0xff, 0x9b, 0x26, 0x7a, 0xca, 0x5b, 0xf0, 0x7f, 0x3d, 0xd9, 0x7f,
+	0xff, 0x34, 0x99, 0xac, 0x82, 0x6e, 0xf4, 0x31, 0xc7, 0x25, 0xf3, 0xf2, 0x1f, 0x99, 0x15, 0x4a,
+	0x1f, 0xf4, 0x5b, 0x00, 0x17, 0x24, 0xf4, 0x26, 0x7f, 0xc4, 0x02, 0x5a, 0x23, 0x5b, 0x95, 0x0a,
+	0x61, 0x82, 0x88, 0x63, 0xfd, 0xff, 0x0f, 0x78, 0x1c, 0xff, 0x6b, 0x8f, 0xf7, 0x01, 0x5c, 0x8c,
+	0xc0, 0xd5, 0x3e, 0x6f, 0xc1, 0x13, 0x5c, 0xc7, 0xb4, 0xd7, 0xd9, 0x48, 0xaf, 0x07, 0x8a, 0x84,
+	0x6d, 0xee, 0x16, 0x39, 0x36, 0xab, 0xf3, 0xfb, 0x13, 0x70, 0x42, 0xb2, 0xa3, 0x17, 0x00, 0x26,
+	0xd4, 0x65, 0x44, 0xe7, 0x22, 0xe1, 0x86, 0x5f, 0x80, 0xd4, 0xf9, 0xd1, 0x92, 0x55, 0x6f, 0x73,
+	0xf9, 0xf9, 0x97, 0x1f, 0xaf, 0xe2, 0x4b, 0x28, 0x6d, 0x47, 0x3d, 0x52, 0xea, 0xf6, 0xa3, 0xf7,
+	0x00, 0x26, 0x43, 0xdb, 0x86, 0x2e, 0x1c, 0xdd, 0x66, 0xf8, 0x91, 0x48, 0xe5, 0xc6, 0x50, 0x68,
+	0xba, 0xab, 0x92, 0x6e, 0x1d, 0xad, 0x45, 0xd2, 0x85, 0x1f, 0x04, 0x61, 0x3f, 0x09, 0x6f, 0xe1,
+	0x53, 0xf4, 0x1a, 0xc0, 0xa9, 0xf0, 0x3d, 0x43, 0xa3, 0x23, 0x74, 0xed, 0xcc, 0x8f, 0x23, 0xd1,
+	0xd8, 0x96, 0xc4, 0xce, 0xa2, 0xcc, 0x68, 0xd8, 0xe8, 0x13, 0x80, 0xd3, 0x83, 0xbb, 0x8a, 0xd6,
+	0x8e, 0x6e, 0x1c, 0x71, 0x15, 0x53, 0x97, 0xc6, 0x95, 0x69, 0xe6, 0x0d, 0xc9, 0x7c, 0x05, 0x5d,
+	0x8e, 0x64, 0x2e, 0x6b, 0xa9, 0xd3, 0xd9, 0xfa, 0x01, 0xbb, 0x0b, 0xeb, 0x07, 0x4d, 0x03, 0x1c,
+	0x36, 0x0d, 0xf0, 0xbd, 0x69, 0x80, 0x97, 0x2d, 0x23, 0x76, 0xd8, 0x32, 0x62, 0x5f, 0x5b, 0x46,
+	0xec, 0xbe, 0x7e, 0x05, 0x44, 0xf9, 0xa1, 0x45, 0xb9, 0xfd, 0xb8, 0x57, 0x3b, 0xd8, 0xf3, 0x88,
+	0x28, 0x25, 0xe4, 0x2f, 0xda, 0xc5, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x71, 0xc0, 0xd7, 0x23,
+	0xc7, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +498,8 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// DowntimeOffenses queries the downtime offense history of given cons address
+	DowntimeOffenses(ctx context.Context, in *QueryDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryDowntimeOffensesResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +537,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) DowntimeOffenses(ctx context.Context, in *QueryDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryDowntimeOffensesResponse, error) {
+	out := new(QueryDowntimeOffensesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/DowntimeOffenses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +554,8 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// DowntimeOffenses queries the downtime offense history of given cons address
+	DowntimeOffenses(context.Context, *QueryDowntimeOffensesRequest) (*QueryDowntimeOffensesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +571,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) DowntimeOffenses(ctx context.Context, req *QueryDowntimeOffensesRequest) (*QueryDowntimeOffensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimeOffenses not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +633,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DowntimeOffenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimeOffensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimeOffenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/DowntimeOffenses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimeOffenses(ctx, req.(*QueryDowntimeOffensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
@@ -516,6 +668,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "DowntimeOffenses",
+			Handler:    _Query_DowntimeOffenses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -724,6 +880,97 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDowntimeOffensesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeOffensesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeOffensesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDowntimeOffensesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeOffensesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeOffensesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Offenses) > 0 {
+		for iNdEx := len(m.Offenses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offenses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -811,6 +1058,42 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDowntimeOffensesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDowntimeOffensesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offenses) > 0 {
+		for _, e := range m.Offenses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1321,6 +1604,244 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDowntimeOffensesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeOffensesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeOffensesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDowntimeOffensesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeOffensesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeOffensesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offenses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offenses = append(m.Offenses, DowntimeOffense{})
+			if err := m.Offenses[len(m.Offenses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -50,6 +50,9 @@ type ValidatorSigningInfo struct {
 	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
 	// reads in the missed block bitmap.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Number of downtime offenses committed by the validator. It is used to
+	// escalate the punishment of repeat offenders.
+	DowntimeOffenseCount uint64 `protobuf:"varint,7,opt,name=downtime_offense_count,json=downtimeOffenseCount,proto3" json:"downtime_offense_count,omitempty"`
 }
 
 func (m *ValidatorSigningInfo) Reset()         { *m = ValidatorSigningInfo{} }
@@ -128,6 +131,105 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetDowntimeOffenseCount() uint64 {
+	if m != nil {
+		return m.DowntimeOffenseCount
+	}
+	return 0
+}
+
+// DowntimeOffense records a downtime offense of a validator and its punishment.
+type DowntimeOffense struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// 1-based number of the offense among the downtime offenses of the validator.
+	Offense uint64 `protobuf:"varint,2,opt,name=offense,proto3" json:"offense,omitempty"`
+	// Height at which the offense was punished.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Time at which the offense was punished.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// Fraction of the validator stake slashed for the offense.
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	// Duration the validator was jailed for.
+	JailDuration time.Duration `protobuf:"bytes,6,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+	// Whether the validator is automatically unjailed once the jail duration has elapsed.
+	AutoUnjail bool `protobuf:"varint,7,opt,name=auto_unjail,json=autoUnjail,proto3" json:"auto_unjail,omitempty"`
+}
+
+func (m *DowntimeOffense) Reset()         { *m = DowntimeOffense{} }
+func (m *DowntimeOffense) String() string { return proto.CompactTextString(m) }
+func (*DowntimeOffense) ProtoMessage()    {}
+func (*DowntimeOffense) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{1}
+}
+func (m *DowntimeOffense) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimeOffense) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimeOffense.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimeOffense) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimeOffense.Merge(m, src)
+}
+func (m *DowntimeOffense) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimeOffense) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimeOffense.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimeOffense proto.InternalMessageInfo
+
+func (m *DowntimeOffense) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DowntimeOffense) GetOffense() uint64 {
+	if m != nil {
+		return m.Offense
+	}
+	return 0
+}
+
+func (m *DowntimeOffense) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DowntimeOffense) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *DowntimeOffense) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *DowntimeOffense) GetAutoUnjail() bool {
+	if m != nil {
+		return m.AutoUnjail
+	}
+	return false
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                       `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -135,13 +237,32 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// graduated_slash_fractions_downtime are the slash fractions of the successive
+	// downtime offenses of a validator, the last one applying to all the further
+	// offenses. When empty, slash_fraction_downtime applies to all the offenses.
+	GraduatedSlashFractionsDowntime []cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,rep,name=graduated_slash_fractions_downtime,json=graduatedSlashFractionsDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"graduated_slash_fractions_downtime"`
+	// graduated_downtime_jail_durations are the jail durations of the successive
+	// downtime offenses of a validator, the last one applying to all the further
+	// offenses. When empty, downtime_jail_duration applies to all the offenses.
+	GraduatedDowntimeJailDurations []time.Duration `protobuf:"bytes,7,rep,name=graduated_downtime_jail_durations,json=graduatedDowntimeJailDurations,proto3,stdduration" json:"graduated_downtime_jail_durations"`
+	// downtime_auto_unjail automatically unjails the validators jailed for their
+	// first downtime offense once their jail duration has elapsed.
+	DowntimeAutoUnjail bool `protobuf:"varint,8,opt,name=downtime_auto_unjail,json=downtimeAutoUnjail,proto3" json:"downtime_auto_unjail,omitempty"`
+	// double_sign_rotation_grace_blocks is the number of blocks after a consensus
+	// key rotation during which an equivocation of the old or new key of the
+	// validator is punished with slash_fraction_double_sign_rotation and
+	// double_sign_rotation_jail_duration instead of tombstoning the validator.
+	// Zero disables the grace window.
+	DoubleSignRotationGraceBlocks   int64                       `protobuf:"varint,9,opt,name=double_sign_rotation_grace_blocks,json=doubleSignRotationGraceBlocks,proto3" json:"double_sign_rotation_grace_blocks,omitempty"`
+	SlashFractionDoubleSignRotation cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=slash_fraction_double_sign_rotation,json=slashFractionDoubleSignRotation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign_rotation"`
+	DoubleSignRotationJailDuration  time.Duration               `protobuf:"bytes,11,opt,name=double_sign_rotation_jail_duration,json=doubleSignRotationJailDuration,proto3,stdduration" json:"double_sign_rotation_jail_duration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{1}
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,8 +305,37 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetGraduatedDowntimeJailDurations() []time.Duration {
+	if m != nil {
+		return m.GraduatedDowntimeJailDurations
+	}
+	return nil
+}
+
+func (m *Params) GetDowntimeAutoUnjail() bool {
+	if m != nil {
+		return m.DowntimeAutoUnjail
+	}
+	return false
+}
+
+func (m *Params) GetDoubleSignRotationGraceBlocks() int64 {
+	if m != nil {
+		return m.DoubleSignRotationGraceBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetDoubleSignRotationJailDuration() time.Duration {
+	if m != nil {
+		return m.DoubleSignRotationJailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
+	proto.RegisterType((*DowntimeOffense)(nil), "cosmos.slashing.v1beta1.DowntimeOffense")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
 }
 
@@ -194,47 +344,63 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x00, 0x3f, 0x7e, 0x3a, 0xad, 0x07, 0xc7, 0x22, 0x4b, 0x95, 0x6d, 0x21, 0xd1, 0x34,
-	0x24, 0xec, 0x0a, 0x26, 0x1e, 0xe0, 0x64, 0x69, 0x8c, 0x1a, 0x12, 0xc9, 0xe2, 0x9f, 0xc4, 0x83,
-	0x9b, 0xd9, 0x9d, 0xe9, 0x76, 0x64, 0x77, 0xa6, 0xd9, 0x99, 0x15, 0xf8, 0x0a, 0x26, 0x26, 0x1c,
-	0x3d, 0x7a, 0xe4, 0xc8, 0x81, 0xaf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0x7e,
-	0x0c, 0xb3, 0x33, 0xbb, 0x80, 0x70, 0xe3, 0xd2, 0xb4, 0xcf, 0xf3, 0xbc, 0xcf, 0x3b, 0xef, 0xf3,
-	0xbe, 0x29, 0x7c, 0x18, 0x0a, 0x99, 0x08, 0xe9, 0xca, 0x18, 0xcb, 0x3e, 0xe3, 0x91, 0xfb, 0x69,
-	0x21, 0xa0, 0x0a, 0x2f, 0x9c, 0x01, 0xce, 0x20, 0x15, 0x4a, 0xa0, 0x49, 0xa3, 0x73, 0xce, 0xe0,
-	0x42, 0xd7, 0xa8, 0x47, 0x22, 0x12, 0x5a, 0xe3, 0xe6, 0xdf, 0x8c, 0xbc, 0x61, 0x47, 0x42, 0x44,
-	0x31, 0x75, 0xf5, 0xaf, 0x20, 0xeb, 0xb9, 0x24, 0x4b, 0xb1, 0x62, 0x82, 0x17, 0x7c, 0xf3, 0x32,
-	0xaf, 0x58, 0x42, 0xa5, 0xc2, 0xc9, 0xa0, 0x10, 0x4c, 0x99, 0x7e, 0xbe, 0x71, 0x2e, 0x9a, 0x1b,
-	0xea, 0x36, 0x4e, 0x18, 0x17, 0xae, 0xfe, 0x34, 0xd0, 0xec, 0xf7, 0x11, 0x58, 0x7f, 0x8b, 0x63,
-	0x46, 0xb0, 0x12, 0xe9, 0x3a, 0x8b, 0x38, 0xe3, 0xd1, 0x0b, 0xde, 0x13, 0x68, 0x19, 0xfe, 0x8f,
-	0x09, 0x49, 0xa9, 0x94, 0x16, 0x68, 0x81, 0xf6, 0xcd, 0xce, 0xcc, 0xd1, 0xfe, 0xfc, 0x74, 0x61,
-	0xb7, 0x22, 0xb8, 0xa4, 0x5c, 0x66, 0xf2, 0xa9, 0x91, 0xac, 0xab, 0x94, 0xf1, 0xc8, 0x2b, 0x2b,
-	0xd0, 0x0c, 0xac, 0x49, 0x85, 0x53, 0xe5, 0xf7, 0x29, 0x8b, 0xfa, 0xca, 0x1a, 0x69, 0x81, 0xf6,
-	0xa8, 0x57, 0xd5, 0xd8, 0x73, 0x0d, 0xa1, 0x07, 0xb0, 0xc6, 0x38, 0xa1, 0x5b, 0xbe, 0xe8, 0xf5,
-	0x24, 0x55, 0xd6, 0x68, 0x2e, 0xe9, 0x8c, 0x58, 0xc0, 0xab, 0x6a, 0xfc, 0x95, 0x86, 0xd1, 0x2a,
-	0xac, 0x7d, 0xc4, 0x2c, 0xa6, 0xc4, 0xcf, 0xb8, 0x62, 0xb1, 0x35, 0xd6, 0x02, 0xed, 0xea, 0x62,
-	0xc3, 0x31, 0x29, 0x38, 0x65, 0x0a, 0xce, 0xeb, 0x32, 0x85, 0xce, 0xad, 0x83, 0xe3, 0x66, 0x65,
-	0xe7, 0x57, 0x13, 0xec, 0x9e, 0xee, 0xcd, 0x01, 0xaf, 0x6a, 0xca, 0xdf, 0xe4, 0xd5, 0xc8, 0x86,
-	0x50, 0x89, 0x24, 0x90, 0x4a, 0x70, 0x4a, 0xac, 0xff, 0x5a, 0xa0, 0x7d, 0xc3, 0xbb, 0x80, 0xa0,
-	0x45, 0x38, 0x91, 0x30, 0x29, 0x29, 0xf1, 0x83, 0x58, 0x84, 0x1b, 0xd2, 0x0f, 0x45, 0xc6, 0x15,
-	0x4d, 0xad, 0x71, 0x3d, 0xc0, 0x1d, 0x43, 0x76, 0x34, 0xb7, 0x62, 0xa8, 0xa5, 0xb1, 0x3f, 0xdf,
-	0x9a, 0x60, 0xf6, 0xcb, 0x18, 0x1c, 0x5f, 0xc3, 0x29, 0x4e, 0x24, 0x7a, 0x04, 0xeb, 0x92, 0x45,
-	0xfc, 0xdc, 0x64, 0x93, 0x71, 0x22, 0x36, 0x75, 0x8c, 0xa3, 0x1e, 0x32, 0x9c, 0xf1, 0x78, 0xa7,
-	0x19, 0xc4, 0xf2, 0xb6, 0xdc, 0x2f, 0xaa, 0x06, 0x34, 0x2d, 0x4b, 0xf2, 0xdc, 0x6a, 0x9d, 0x27,
-	0xf9, 0x44, 0x3f, 0x8f, 0x9b, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x38, 0x4c, 0xb8, 0x09, 0x56, 0x7d,
-	0x67, 0x95, 0x46, 0x38, 0xdc, 0xee, 0xd2, 0xf0, 0x68, 0x7f, 0x1e, 0x16, 0xcb, 0xe9, 0xd2, 0xd0,
-	0x8c, 0x8e, 0x12, 0xc6, 0xd7, 0xb5, 0xe7, 0x1a, 0x4d, 0x8b, 0x56, 0x1f, 0xe0, 0x5d, 0x22, 0x36,
-	0x79, 0x7e, 0x34, 0x7e, 0x9e, 0x8c, 0x5f, 0x9e, 0x97, 0x5e, 0x40, 0x75, 0x71, 0xea, 0x4a, 0xb2,
-	0xdd, 0x42, 0x60, 0x82, 0xfd, 0x7a, 0x16, 0x6c, 0xbd, 0xf4, 0x79, 0x89, 0x59, 0x5c, 0x8a, 0x90,
-	0x84, 0x0d, 0x7d, 0xe8, 0x7e, 0x2f, 0xc5, 0x61, 0x8e, 0xf8, 0x44, 0x64, 0x41, 0x4c, 0xf5, 0x70,
-	0x7a, 0x7b, 0xd7, 0x9f, 0x67, 0x52, 0x3b, 0x3f, 0x2b, 0x8c, 0xbb, 0xda, 0x37, 0x9f, 0x0f, 0x71,
-	0x38, 0x79, 0xa5, 0xa9, 0x79, 0x9b, 0xde, 0xf1, 0xf5, 0x3b, 0x4e, 0x5c, 0xea, 0x68, 0x4c, 0x97,
-	0x66, 0x3e, 0x9f, 0xee, 0xcd, 0xdd, 0x37, 0xe2, 0x79, 0x49, 0x36, 0xdc, 0xad, 0xf3, 0x7f, 0x01,
-	0x73, 0x04, 0x9d, 0xe5, 0xdd, 0xa1, 0x0d, 0x0e, 0x86, 0x36, 0x38, 0x1c, 0xda, 0xe0, 0xf7, 0xd0,
-	0x06, 0x3b, 0x27, 0x76, 0xe5, 0xf0, 0xc4, 0xae, 0xfc, 0x38, 0xb1, 0x2b, 0xef, 0xa7, 0xff, 0x79,
-	0xc7, 0x85, 0x6a, 0xb5, 0x3d, 0xa0, 0x32, 0x18, 0xd7, 0xe1, 0x3f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0xe6, 0xe5, 0x80, 0x63, 0x04, 0x00, 0x00,
+	// 882 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xc4, 0xc6, 0x49, 0xc6, 0x09, 0x88, 0x21, 0x6d, 0xb6, 0x81, 0xec, 0xda, 0x41, 0x20,
+	0xab, 0x52, 0xbc, 0x34, 0x20, 0x0e, 0xad, 0x38, 0xd4, 0xb5, 0xa0, 0xa0, 0xa2, 0x56, 0x1b, 0x0a,
+	0x12, 0x12, 0xac, 0xc6, 0x3b, 0xe3, 0xcd, 0x10, 0xef, 0x8c, 0xb5, 0x33, 0x4b, 0xda, 0x23, 0x82,
+	0x13, 0xa7, 0x1e, 0x38, 0x70, 0xe4, 0xd8, 0x63, 0x0f, 0xfd, 0x23, 0x7a, 0xac, 0x7a, 0x42, 0x1c,
+	0x0a, 0x72, 0x0e, 0xe5, 0x7f, 0xe0, 0x82, 0x76, 0x66, 0x76, 0xbd, 0x6e, 0x92, 0x83, 0xeb, 0x8b,
+	0xe5, 0x79, 0xbf, 0xdf, 0xf7, 0x7d, 0xcf, 0x32, 0x7c, 0x3f, 0x12, 0x32, 0x11, 0xd2, 0x97, 0x23,
+	0x2c, 0x0f, 0x19, 0x8f, 0xfd, 0x1f, 0xaf, 0x0c, 0xa8, 0xc2, 0x57, 0x4a, 0x43, 0x77, 0x9c, 0x0a,
+	0x25, 0xd0, 0x96, 0x89, 0xeb, 0x96, 0x66, 0x1b, 0xb7, 0xbd, 0x19, 0x8b, 0x58, 0xe8, 0x18, 0x3f,
+	0xff, 0x66, 0xc2, 0xb7, 0xdd, 0x58, 0x88, 0x78, 0x44, 0x7d, 0xfd, 0x1a, 0x64, 0x43, 0x9f, 0x64,
+	0x29, 0x56, 0x4c, 0x70, 0xeb, 0xf7, 0x5e, 0xf6, 0x2b, 0x96, 0x50, 0xa9, 0x70, 0x32, 0xb6, 0x01,
+	0x97, 0x4c, 0xbf, 0xd0, 0x54, 0xb6, 0xcd, 0x8d, 0xeb, 0x4d, 0x9c, 0x30, 0x2e, 0x7c, 0xfd, 0x69,
+	0x4c, 0xbb, 0xff, 0x2d, 0xc3, 0xcd, 0xaf, 0xf1, 0x88, 0x11, 0xac, 0x44, 0x7a, 0xc0, 0x62, 0xce,
+	0x78, 0xfc, 0x39, 0x1f, 0x0a, 0x74, 0x0d, 0xae, 0x60, 0x42, 0x52, 0x2a, 0xa5, 0x03, 0x5a, 0xa0,
+	0xb3, 0xd6, 0x6b, 0x3f, 0x7b, 0xbc, 0xb7, 0x63, 0xcb, 0xdd, 0x10, 0x5c, 0x52, 0x2e, 0x33, 0x79,
+	0xdd, 0x84, 0x1c, 0xa8, 0x94, 0xf1, 0x38, 0x28, 0x32, 0x50, 0x1b, 0xae, 0x4b, 0x85, 0x53, 0x15,
+	0x1e, 0x52, 0x16, 0x1f, 0x2a, 0x67, 0xb9, 0x05, 0x3a, 0xb5, 0xa0, 0xa9, 0x6d, 0x37, 0xb5, 0x09,
+	0xbd, 0x07, 0xd7, 0x19, 0x27, 0xf4, 0x5e, 0x28, 0x86, 0x43, 0x49, 0x95, 0x53, 0xcb, 0x43, 0x7a,
+	0xcb, 0x0e, 0x08, 0x9a, 0xda, 0x7e, 0x5b, 0x9b, 0xd1, 0x2d, 0xb8, 0xfe, 0x03, 0x66, 0x23, 0x4a,
+	0xc2, 0x8c, 0x2b, 0x36, 0x72, 0xea, 0x2d, 0xd0, 0x69, 0xee, 0x6f, 0x77, 0x0d, 0x0a, 0xdd, 0x02,
+	0x85, 0xee, 0x57, 0x05, 0x0a, 0xbd, 0x8d, 0x27, 0xcf, 0xbd, 0xa5, 0x07, 0x7f, 0x7b, 0xe0, 0xe1,
+	0x8b, 0x47, 0x97, 0x41, 0xd0, 0x34, 0xe9, 0x77, 0xf3, 0x6c, 0xe4, 0x42, 0xa8, 0x44, 0x32, 0x90,
+	0x4a, 0x70, 0x4a, 0x9c, 0xd7, 0x5a, 0xa0, 0xb3, 0x1a, 0x54, 0x2c, 0x68, 0x1f, 0x5e, 0x48, 0x98,
+	0x94, 0x94, 0x84, 0x83, 0x91, 0x88, 0x8e, 0x64, 0x18, 0x89, 0x8c, 0x2b, 0x9a, 0x3a, 0x0d, 0xbd,
+	0xc0, 0x5b, 0xc6, 0xd9, 0xd3, 0xbe, 0x1b, 0xc6, 0x85, 0x3e, 0x82, 0x17, 0x89, 0x38, 0xe6, 0x39,
+	0x0d, 0xf9, 0x2e, 0x94, 0x4b, 0x6a, 0xd2, 0x9c, 0x95, 0x16, 0xe8, 0xd4, 0x83, 0xcd, 0xc2, 0x7b,
+	0xdb, 0x38, 0x75, 0xde, 0xd5, 0xfa, 0xbf, 0x7f, 0x78, 0x60, 0xf7, 0xb7, 0x1a, 0x7c, 0xa3, 0x3f,
+	0xeb, 0x5e, 0x0c, 0x78, 0x07, 0xae, 0xd8, 0x19, 0x34, 0xe6, 0xf5, 0xa0, 0x78, 0xa2, 0x8b, 0xb0,
+	0x61, 0xc9, 0xd0, 0x48, 0x07, 0xf6, 0x85, 0x3e, 0x81, 0xf5, 0xbc, 0xfb, 0xfc, 0xc0, 0xea, 0x34,
+	0xf4, 0x1d, 0x7c, 0x5d, 0x0b, 0x3b, 0x1c, 0xa6, 0x38, 0xca, 0x65, 0xaa, 0x51, 0x5d, 0xef, 0x7d,
+	0x9c, 0x07, 0xff, 0xf5, 0xdc, 0x7b, 0xdb, 0x0c, 0x2e, 0xc9, 0x51, 0x97, 0x09, 0x3f, 0xc1, 0xea,
+	0xb0, 0x7b, 0x8b, 0xc6, 0x38, 0xba, 0xdf, 0xa7, 0xd1, 0xb3, 0xc7, 0x7b, 0xd0, 0xee, 0xd5, 0xa7,
+	0x91, 0xa9, 0xba, 0xa1, 0xab, 0x7d, 0x6a, 0x8b, 0xa1, 0x2f, 0xe1, 0x46, 0xce, 0x5f, 0x58, 0x1c,
+	0x81, 0x26, 0xa2, 0xb9, 0x7f, 0xe9, 0xd4, 0x98, 0x7d, 0x1b, 0x60, 0xa6, 0xfc, 0xbd, 0x9c, 0x52,
+	0xab, 0xa7, 0x70, 0x22, 0x0f, 0x36, 0x71, 0xa6, 0x44, 0x98, 0xf1, 0xdc, 0xac, 0x09, 0x5a, 0x0d,
+	0x60, 0x6e, 0xba, 0xab, 0x2d, 0x96, 0x96, 0x9f, 0xd6, 0x60, 0xe3, 0x0e, 0x4e, 0x71, 0x22, 0xd1,
+	0x07, 0x70, 0x53, 0xb2, 0x98, 0x4f, 0x15, 0x71, 0xcc, 0x38, 0x11, 0xc7, 0x9a, 0x9a, 0x5a, 0x80,
+	0x8c, 0xcf, 0x08, 0xe2, 0x1b, 0xed, 0x41, 0x2c, 0xd7, 0x10, 0x0f, 0x6d, 0xd6, 0x98, 0xa6, 0x45,
+	0xca, 0xf2, 0x42, 0xc0, 0xa0, 0x84, 0xf1, 0x03, 0x5d, 0xf3, 0x0e, 0x4d, 0x6d, 0xab, 0xef, 0x2b,
+	0xd2, 0x9b, 0x85, 0xa9, 0x36, 0x27, 0x4c, 0xa5, 0x48, 0xbf, 0xa8, 0xc2, 0x25, 0xe1, 0xf6, 0x2c,
+	0xb9, 0x21, 0x11, 0xd9, 0x60, 0x44, 0xf5, 0x72, 0x5a, 0x31, 0xaf, 0xbe, 0xcf, 0xd6, 0x0c, 0xd1,
+	0x7d, 0x5d, 0x37, 0xdf, 0x0f, 0x71, 0xb8, 0x75, 0xaa, 0xa9, 0x99, 0x6d, 0x41, 0x69, 0x5d, 0x78,
+	0xa9, 0xa3, 0x29, 0x8a, 0x7e, 0x06, 0x70, 0x37, 0x4e, 0x31, 0xc9, 0xb0, 0xa2, 0x24, 0x9c, 0x6d,
+	0x2d, 0xa7, 0xbd, 0x1b, 0xad, 0xda, 0x02, 0xbd, 0xbd, 0xb2, 0xc3, 0x41, 0x75, 0x08, 0x59, 0x4e,
+	0x21, 0x61, 0x7b, 0x3a, 0xc4, 0xd9, 0xa4, 0x4a, 0x67, 0xa5, 0x55, 0x9b, 0x8b, 0x55, 0xb7, 0x2c,
+	0xd9, 0x3f, 0x83, 0x5e, 0x2d, 0xee, 0xb2, 0x55, 0xf5, 0x2e, 0x56, 0xf5, 0x5d, 0xa0, 0xc2, 0x77,
+	0xbd, 0xbc, 0x0f, 0x74, 0x13, 0xb6, 0x2b, 0x12, 0x08, 0x53, 0xa1, 0x74, 0xa9, 0x30, 0x4e, 0x71,
+	0x44, 0xed, 0x89, 0x38, 0x6b, 0xfa, 0x36, 0x76, 0x48, 0xc9, 0x69, 0x60, 0xc3, 0x3e, 0xcb, 0xa3,
+	0xcc, 0xb1, 0xa0, 0x5f, 0x00, 0x7c, 0xf7, 0x7c, 0x71, 0x95, 0x95, 0x1d, 0xb8, 0x10, 0xe7, 0xde,
+	0x39, 0x2a, 0x2b, 0x26, 0x42, 0x0a, 0xee, 0x9e, 0xb9, 0xd0, 0xec, 0x39, 0x35, 0xe7, 0x3c, 0x27,
+	0xf7, 0xf4, 0xee, 0x55, 0xe4, 0xaf, 0xb6, 0x7f, 0x7d, 0xf1, 0xe8, 0xf2, 0x3b, 0x66, 0xd8, 0x3d,
+	0x49, 0x8e, 0xfc, 0x7b, 0xd3, 0xbf, 0x11, 0xe6, 0x87, 0xa7, 0x77, 0xed, 0xe1, 0xc4, 0x05, 0x4f,
+	0x26, 0x2e, 0x78, 0x3a, 0x71, 0xc1, 0x3f, 0x13, 0x17, 0x3c, 0x38, 0x71, 0x97, 0x9e, 0x9e, 0xb8,
+	0x4b, 0x7f, 0x9e, 0xb8, 0x4b, 0xdf, 0xee, 0xcc, 0xe0, 0x50, 0xc9, 0x56, 0xf7, 0xc7, 0x54, 0x0e,
+	0x1a, 0x7a, 0xc2, 0x0f, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x02, 0xd7, 0xc6, 0x02, 0xa4, 0x08,
+	0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -274,6 +440,51 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.DowntimeOffenseCount != that1.DowntimeOffenseCount {
+		return false
+	}
+	return true
+}
+func (this *DowntimeOffense) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DowntimeOffense)
+	if !ok {
+		that2, ok := that.(DowntimeOffense)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Offense != that1.Offense {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	if this.AutoUnjail != that1.AutoUnjail {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -310,6 +521,34 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if len(this.GraduatedSlashFractionsDowntime) != len(that1.GraduatedSlashFractionsDowntime) {
+		return false
+	}
+	for i := range this.GraduatedSlashFractionsDowntime {
+		if !this.GraduatedSlashFractionsDowntime[i].Equal(that1.GraduatedSlashFractionsDowntime[i]) {
+			return false
+		}
+	}
+	if len(this.GraduatedDowntimeJailDurations) != len(that1.GraduatedDowntimeJailDurations) {
+		return false
+	}
+	for i := range this.GraduatedDowntimeJailDurations {
+		if this.GraduatedDowntimeJailDurations[i] != that1.GraduatedDowntimeJailDurations[i] {
+			return false
+		}
+	}
+	if this.DowntimeAutoUnjail != that1.DowntimeAutoUnjail {
+		return false
+	}
+	if this.DoubleSignRotationGraceBlocks != that1.DoubleSignRotationGraceBlocks {
+		return false
+	}
+	if !this.SlashFractionDoubleSignRotation.Equal(that1.SlashFractionDoubleSignRotation) {
+		return false
+	}
+	if this.DoubleSignRotationJailDuration != that1.DoubleSignRotationJailDuration {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -332,6 +571,11 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DowntimeOffenseCount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.DowntimeOffenseCount))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -375,6 +619,82 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DowntimeOffense) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimeOffense) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimeOffense) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoUnjail {
+		i--
+		if m.AutoUnjail {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Offense != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Offense))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -395,6 +715,65 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DoubleSignRotationJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DoubleSignRotationJailDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.SlashFractionDoubleSignRotation.Size()
+		i -= size
+		if _, err := m.SlashFractionDoubleSignRotation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.DoubleSignRotationGraceBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.DoubleSignRotationGraceBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DowntimeAutoUnjail {
+		i--
+		if m.DowntimeAutoUnjail {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.GraduatedDowntimeJailDurations) > 0 {
+		for iNdEx := len(m.GraduatedDowntimeJailDurations) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.GraduatedDowntimeJailDurations[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GraduatedDowntimeJailDurations[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintSlashing(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.GraduatedSlashFractionsDowntime) > 0 {
+		for iNdEx := len(m.GraduatedSlashFractionsDowntime) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.GraduatedSlashFractionsDowntime[iNdEx].Size()
+				i -= size
+				if _, err := m.GraduatedSlashFractionsDowntime[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -415,12 +794,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n5
+	i = encodeVarintSlashing(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -476,6 +855,37 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.DowntimeOffenseCount != 0 {
+		n += 1 + sovSlashing(uint64(m.DowntimeOffenseCount))
+	}
+	return n
+}
+
+func (m *DowntimeOffense) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Offense != 0 {
+		n += 1 + sovSlashing(uint64(m.Offense))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.AutoUnjail {
+		n += 2
+	}
 	return n
 }
 
@@ -496,6 +906,28 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.GraduatedSlashFractionsDowntime) > 0 {
+		for _, e := range m.GraduatedSlashFractionsDowntime {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if len(m.GraduatedDowntimeJailDurations) > 0 {
+		for _, e := range m.GraduatedDowntimeJailDurations {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(e)
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.DowntimeAutoUnjail {
+		n += 2
+	}
+	if m.DoubleSignRotationGraceBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.DoubleSignRotationGraceBlocks))
+	}
+	l = m.SlashFractionDoubleSignRotation.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DoubleSignRotationJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -676,11 +1108,269 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSlashing(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenseCount", wireType)
+			}
+			m.DowntimeOffenseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeOffenseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimeOffense) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimeOffense: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimeOffense: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offense", wireType)
+			}
+			m.Offense = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offense |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoUnjail", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoUnjail = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSlashing
@@ -877,6 +1567,180 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraduatedSlashFractionsDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.GraduatedSlashFractionsDowntime = append(m.GraduatedSlashFractionsDowntime, v)
+			if err := m.GraduatedSlashFractionsDowntime[len(m.GraduatedSlashFractionsDowntime)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraduatedDowntimeJailDurations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GraduatedDowntimeJailDurations = append(m.GraduatedDowntimeJailDurations, time.Duration(0))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&(m.GraduatedDowntimeJailDurations[len(m.GraduatedDowntimeJailDurations)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeAutoUnjail", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DowntimeAutoUnjail = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSignRotationGraceBlocks", wireType)
+			}
+			m.DoubleSignRotationGraceBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DoubleSignRotationGraceBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSignRotation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSignRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSignRotationJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DoubleSignRotationJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
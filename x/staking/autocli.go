@@ -133,6 +133,50 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod:      "TokenizeShareRecord",
+					Use:            "tokenize-share-record <id>",
+					Short:          "Query a tokenize share record by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "TokenizeShareRecordsOwned",
+					Use:            "tokenize-share-records-owned <owner>",
+					Short:          "Query the tokenize share records owned by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod: "TotalLiquidStaked",
+					Use:       "total-liquid-staked",
+					Short:     "Query the amount of tokens delegated through tokenized shares",
+				},
+				{
+					RpcMethod: "ValidatorPower",
+					Use:       "validator-power <validator>",
+					Short:     "Query the raw and effective consensus power of a validator",
+					Long:      "Query the consensus power of the tokens of a validator, and its power in the last validator set after the validator set policy and the max validator power ratio cap.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_addr"},
+					},
+				},
+				{
+					RpcMethod: "ValidatorPowers",
+					Use:       "validator-powers",
+					Short:     "Query the raw and effective consensus power of the validators of the last validator set",
+				},
+				{
+					RpcMethod: "ValidatorChurnQueues",
+					Use:       "validator-churn-queues",
+					Short:     "Query the validators waiting to enter or leave the validator set",
+				},
+				{
+					RpcMethod: "ValidatorChurnQueuePosition",
+					Use:       "validator-churn-queue-position <validator>",
+					Short:     "Query the churn queue of a validator and its position in the queue",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_addr"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -185,6 +229,28 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-share <validator-addr> <amount> <rewards-owner> --from <delegator_address>",
+					Short:          "Tokenize delegation shares into share tokens",
+					Long:           "Tokenize an amount of delegated tokens into transferable share tokens. The rewards of the tokenized delegation accrue to the rewards owner.",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-share cosmosvaloper... 100stake cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "tokenized_share_owner"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens <amount> --from <delegator_address>",
+					Short:          "Redeem share tokens for a delegation",
+					Example:        fmt.Sprintf(`%s tx staking redeem-tokens 100cosmosvaloper.../1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "TransferTokenizeShareRecord",
+					Use:            "transfer-tokenize-share-record <record-id> <new-owner> --from <sender>",
+					Short:          "Transfer the rewards ownership of a tokenize share record",
+					Example:        fmt.Sprintf(`%s tx staking transfer-tokenize-share-record 1 cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tokenize_share_record_id"}, {ProtoField: "new_owner"}},
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -48,6 +48,8 @@ type ModuleInputs struct {
 	Cdc                   codec.Codec
 	Environment           appmodule.Environment
 	CometInfoService      comet.Service
+
+	ValidatorSetPolicy types.ValidatorSetPolicy `optional:"true"`
 }
 
 // Dependency Injection Outputs
@@ -81,6 +83,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.ConsensusAddressCodec,
 		in.CometInfoService,
 	)
+	if in.ValidatorSetPolicy != nil {
+		k.SetValidatorSetPolicy(in.ValidatorSetPolicy)
+	}
 	m := NewAppModule(in.Cdc, k)
 	return ModuleOutputs{StakingKeeper: k, Module: m}
 }
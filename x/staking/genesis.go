@@ -2,6 +2,7 @@ package staking
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
@@ -54,9 +55,59 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateChurnQueues(data.ValidatorEntryQueue, data.ValidatorExitQueue); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateChurnQueues(entryQueue, exitQueue []string) error {
+	queued := make(map[string]bool, len(entryQueue)+len(exitQueue))
+
+	for _, queue := range [][]string{entryQueue, exitQueue} {
+		for _, valAddr := range queue {
+			if valAddr == "" {
+				return errors.New("empty validator address in validator churn queues")
+			}
+
+			if queued[valAddr] {
+				return fmt.Errorf("duplicate validator in validator churn queues: %s", valAddr)
+			}
+
+			queued[valAddr] = true
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id == 0 || record.Id > lastID {
+			return fmt.Errorf("tokenize share record id %d must be positive and not greater than the last record id %d", record.Id, lastID)
+		}
+
+		if record.ModuleAccount != types.NewTokenizeShareRecord(record.Id, record.Owner, record.Validator).ModuleAccount {
+			return fmt.Errorf("invalid module account %s of tokenize share record %d", record.ModuleAccount, record.Id)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -41,6 +41,18 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate genesis churn queues
+		{"queued validators", func(data *types.GenesisState) {
+			data.ValidatorEntryQueue = []string{"val1", "val2"}
+			data.ValidatorExitQueue = []string{"val3"}
+		}, false},
+		{"validator in both churn queues", func(data *types.GenesisState) {
+			data.ValidatorEntryQueue = []string{"val1"}
+			data.ValidatorExitQueue = []string{"val1"}
+		}, true},
+		{"empty validator in churn queue", func(data *types.GenesisState) {
+			data.ValidatorEntryQueue = []string{""}
+		}, true},
 	}
 
 	for _, tt := range tests {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/staking/types"
 
@@ -77,6 +78,41 @@ func (k Keeper) IterateBondedValidatorsByPower(ctx context.Context, fn func(inde
 	return nil
 }
 
+// GetEffectiveBondedTokens returns the bonded tokens of the bonded validators, keyed by operator address,
+// capped by the MaxValidatorPowerRatio param like their consensus power. It returns nil when the cap
+// is disabled, in which case the effective bonded tokens are the bonded tokens of the validators.
+func (k Keeper) GetEffectiveBondedTokens(ctx context.Context) (map[string]math.Int, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.MaxValidatorPowerRatio.GTE(math.LegacyOneDec()) {
+		return nil, nil
+	}
+
+	var (
+		operators []string
+		tokens    []math.Int
+	)
+	err = k.IterateBondedValidatorsByPower(ctx, func(_ int64, validator sdk.ValidatorI) (stop bool) {
+		operators = append(operators, validator.GetOperator())
+		tokens = append(tokens, validator.GetBondedTokens())
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tokens = types.CapPowers(tokens, params.MaxValidatorPowerRatio)
+	effectiveTokens := make(map[string]math.Int, len(operators))
+	for i, operator := range operators {
+		effectiveTokens[operator] = tokens[i]
+	}
+
+	return effectiveTokens, nil
+}
+
 // Validator gets the Validator interface for a particular address
 func (k Keeper) Validator(ctx context.Context, address sdk.ValAddress) (sdk.ValidatorI, error) {
 	return k.GetValidator(ctx, address)
@@ -0,0 +1,292 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sort"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setMember is a validator selected for the validator set, along with its consensus power.
+type setMember struct {
+	validator types.Validator
+	addr      sdk.ValAddress
+	power     int64
+}
+
+// applyChurnLimit limits the consensus power entering and leaving the validator set in the block to
+// the validator churn limit of the last total power. It returns the members of the next validator
+// set, i.e. the selected validators that were already bonded or that enter the set, followed by
+// the validators of the last set whose exit is delayed, along with the churn left for the power
+// changes of the validators staying in the set, see limitPowerChanges, which is nil when the churn
+// is not limited.
+//
+// The validators that cannot enter the set are kept in the entry queue, and the validators that
+// cannot leave the set are kept in the exit queue. The queued validators are processed first in
+// the next blocks, in the order of their queue, followed by the new entries in decreasing order of
+// power and by the new exits in increasing order of power. Entries above the remaining churn are
+// skipped, so that a large validator doesn't block the queue, while the first exit of each block
+// is always processed, so that a validator never stays in the set forever. Jailed validators and
+// validators without consensus power always leave the set right away.
+func (k Keeper) applyChurnLimit(ctx context.Context, params types.Params, selected []setMember, last validatorsByAddr) ([]setMember, math.LegacyDec, error) {
+	lastTotalPower, err := k.LastTotalPower.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, math.LegacyDec{}, err
+	}
+
+	if params.ValidatorChurnLimit.GTE(math.LegacyOneDec()) || lastTotalPower.IsNil() || !lastTotalPower.IsPositive() {
+		if err := k.setValidatorChurnQueue(ctx, k.ValidatorEntryQueue, nil); err != nil {
+			return nil, math.LegacyDec{}, err
+		}
+		return selected, math.LegacyDec{}, k.setValidatorChurnQueue(ctx, k.ValidatorExitQueue, nil)
+	}
+
+	limit := params.ValidatorChurnLimit.MulInt(lastTotalPower)
+	powerReduction := k.PowerReduction(ctx)
+
+	entryQueue, err := k.getValidatorChurnQueue(ctx, k.ValidatorEntryQueue)
+	if err != nil {
+		return nil, math.LegacyDec{}, err
+	}
+	exitQueue, err := k.getValidatorChurnQueue(ctx, k.ValidatorExitQueue)
+	if err != nil {
+		return nil, math.LegacyDec{}, err
+	}
+
+	selectedAddrs := make(map[string]struct{}, len(selected))
+	var staying, entering []setMember
+	for _, member := range selected {
+		valAddrStr, err := k.validatorAddressCodec.BytesToString(member.addr)
+		if err != nil {
+			return nil, math.LegacyDec{}, err
+		}
+
+		selectedAddrs[valAddrStr] = struct{}{}
+		if _, ok := last[valAddrStr]; ok {
+			staying = append(staying, member)
+		} else {
+			entering = append(entering, member)
+		}
+	}
+
+	var leaving []setMember
+	for valAddrStr := range last {
+		if _, ok := selectedAddrs[valAddrStr]; ok {
+			continue
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(valAddrStr)
+		if err != nil {
+			return nil, math.LegacyDec{}, err
+		}
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return nil, math.LegacyDec{}, err
+		}
+
+		power := validator.PotentialConsensusPower(powerReduction)
+		if validator.Jailed || power == 0 {
+			continue
+		}
+
+		leaving = append(leaving, setMember{validator: validator, addr: valAddr, power: power})
+	}
+
+	// new exits are processed in increasing order of power, ties broken by address
+	sort.SliceStable(leaving, func(i, j int) bool {
+		if leaving[i].power != leaving[j].power {
+			return leaving[i].power < leaving[j].power
+		}
+		return leaving[i].validator.GetOperator() < leaving[j].validator.GetOperator()
+	})
+	leaving = orderByChurnQueue(leaving, exitQueue)
+
+	var (
+		delayedExits []setMember
+		newExitQueue []string
+		leftPower    int64
+	)
+	for i, member := range leaving {
+		if i == 0 || math.LegacyNewDec(leftPower+member.power).LTE(limit) {
+			leftPower += member.power
+			continue
+		}
+
+		delayedExits = append(delayedExits, member)
+		newExitQueue = append(newExitQueue, member.validator.GetOperator())
+	}
+
+	// new entries are processed in decreasing order of power, as selected
+	entering = orderByChurnQueue(entering, entryQueue)
+
+	var (
+		entered       = make(map[string]struct{}, len(entering))
+		newEntryQueue []string
+		enteredPower  int64
+	)
+	slots := int(params.MaxValidators) - len(staying) - len(delayedExits)
+	for _, member := range entering {
+		if slots > 0 && math.LegacyNewDec(enteredPower+member.power).LTE(limit) {
+			entered[member.validator.GetOperator()] = struct{}{}
+			enteredPower += member.power
+			slots--
+			continue
+		}
+
+		newEntryQueue = append(newEntryQueue, member.validator.GetOperator())
+	}
+
+	if err := k.setValidatorChurnQueue(ctx, k.ValidatorEntryQueue, newEntryQueue); err != nil {
+		return nil, math.LegacyDec{}, err
+	}
+	if err := k.setValidatorChurnQueue(ctx, k.ValidatorExitQueue, newExitQueue); err != nil {
+		return nil, math.LegacyDec{}, err
+	}
+
+	members := make([]setMember, 0, len(staying)+len(entered)+len(delayedExits))
+	for _, member := range selected {
+		valAddrStr := member.validator.GetOperator()
+		if _, ok := last[valAddrStr]; ok {
+			members = append(members, member)
+		} else if _, ok := entered[valAddrStr]; ok {
+			members = append(members, member)
+		}
+	}
+
+	churn := math.LegacyMaxDec(limit.Sub(math.LegacyNewDec(leftPower+enteredPower)), math.LegacyZeroDec())
+
+	return append(members, delayedExits...), churn, nil
+}
+
+// limitPowerChanges limits the sum of the consensus power changes of the validators that were already
+// in the validator set to churn, the churn left by the validators entering and leaving the set. When
+// the changes exceed it, each validator only moves toward its new power by the same fraction of its
+// change, and keeps moving toward it in the next blocks. powers are the consensus powers of the members
+// of the next validator set, whose addresses are addrs.
+func (k Keeper) limitPowerChanges(addrs []sdk.ValAddress, powers []math.Int, last validatorsByAddr, churn math.LegacyDec) ([]math.Int, error) {
+	if churn.IsNil() {
+		return powers, nil
+	}
+
+	lastPowers := make([]math.Int, len(addrs))
+	totalChange := math.ZeroInt()
+	for i, addr := range addrs {
+		valAddrStr, err := k.validatorAddressCodec.BytesToString(addr)
+		if err != nil {
+			return nil, err
+		}
+		bz, ok := last[valAddrStr]
+		if !ok {
+			continue
+		}
+
+		var lastPower gogotypes.Int64Value
+		if err := k.cdc.Unmarshal(bz, &lastPower); err != nil {
+			return nil, err
+		}
+		lastPowers[i] = math.NewInt(lastPower.Value)
+		totalChange = totalChange.Add(powers[i].Sub(lastPowers[i]).Abs())
+	}
+
+	if math.LegacyNewDecFromInt(totalChange).LTE(churn) {
+		return powers, nil
+	}
+
+	limited := make([]math.Int, len(powers))
+	for i, power := range powers {
+		if lastPowers[i].IsNil() {
+			limited[i] = power
+			continue
+		}
+
+		change := churn.MulInt(power.Sub(lastPowers[i])).QuoInt(totalChange).TruncateInt()
+		limited[i] = lastPowers[i].Add(change)
+	}
+
+	return limited, nil
+}
+
+// orderByChurnQueue moves the members that are in the queue to the front, in the order of the queue.
+func orderByChurnQueue(members []setMember, queue []string) []setMember {
+	positions := make(map[string]int, len(queue))
+	for i, valAddrStr := range queue {
+		positions[valAddrStr] = i
+	}
+
+	ordered := slices.Clone(members)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		pi, iQueued := positions[ordered[i].validator.GetOperator()]
+		pj, jQueued := positions[ordered[j].validator.GetOperator()]
+		switch {
+		case iQueued && jQueued:
+			return pi < pj
+		default:
+			return iQueued && !jQueued
+		}
+	})
+
+	return ordered
+}
+
+// getValidatorChurnQueue returns the operator addresses of the validators of a churn queue.
+func (k Keeper) getValidatorChurnQueue(ctx context.Context, queue collections.Item[types.ValAddresses]) ([]string, error) {
+	addrs, err := queue.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return addrs.Addresses, nil
+}
+
+// setValidatorChurnQueue sets the operator addresses of the validators of a churn queue, removing the
+// queue when it is empty.
+func (k Keeper) setValidatorChurnQueue(ctx context.Context, queue collections.Item[types.ValAddresses], addrs []string) error {
+	if len(addrs) == 0 {
+		has, err := queue.Has(ctx)
+		if err != nil || !has {
+			return err
+		}
+		return queue.Remove(ctx)
+	}
+
+	return queue.Set(ctx, types.ValAddresses{Addresses: addrs})
+}
+
+// GetValidatorChurnQueuePosition returns the churn queue of a validator and its 1-based position in the
+// queue, or types.ChurnQueue_CHURN_QUEUE_UNSPECIFIED if the validator is not queued.
+func (k Keeper) GetValidatorChurnQueuePosition(ctx context.Context, valAddr sdk.ValAddress) (types.ChurnQueue, uint64, error) {
+	valAddrStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return types.ChurnQueue_CHURN_QUEUE_UNSPECIFIED, 0, err
+	}
+
+	for _, queue := range []struct {
+		kind  types.ChurnQueue
+		queue collections.Item[types.ValAddresses]
+	}{
+		{types.ChurnQueue_CHURN_QUEUE_ENTRY, k.ValidatorEntryQueue},
+		{types.ChurnQueue_CHURN_QUEUE_EXIT, k.ValidatorExitQueue},
+	} {
+		addrs, err := k.getValidatorChurnQueue(ctx, queue.queue)
+		if err != nil {
+			return types.ChurnQueue_CHURN_QUEUE_UNSPECIFIED, 0, err
+		}
+
+		if i := slices.Index(addrs, valAddrStr); i >= 0 {
+			return queue.kind, uint64(i + 1), nil
+		}
+	}
+
+	return types.ChurnQueue_CHURN_QUEUE_UNSPECIFIED, 0, nil
+}
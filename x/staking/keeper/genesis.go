@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -195,6 +196,46 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		}
 	}
 
+	if err := k.LastTokenizeShareRecordID.Set(ctx, data.LastTokenizeShareRecordId); err != nil {
+		return nil, err
+	}
+
+	// the liquid shares of the validators are the shares of the delegations of the records
+	for _, record := range data.TokenizeShareRecords {
+		owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.setTokenizeShareRecord(ctx, record, owner); err != nil {
+			return nil, err
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		delegation, err := k.Delegations.Get(ctx, collections.Join(record.GetModuleAddress(), sdk.ValAddress(valAddr)))
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if err := k.addValidatorLiquidShares(ctx, valAddr, delegation.Shares); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.setValidatorChurnQueue(ctx, k.ValidatorEntryQueue, data.ValidatorEntryQueue); err != nil {
+		return nil, err
+	}
+
+	if err := k.setValidatorChurnQueue(ctx, k.ValidatorExitQueue, data.ValidatorExitQueue); err != nil {
+		return nil, err
+	}
+
 	// don't need to run CometBFT updates if we exported
 	var moduleValidatorUpdates []appmodule.ValidatorUpdate
 	if data.Exported {
@@ -318,6 +359,26 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	tokenizeShareRecords, err := k.GetAllTokenizeShareRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lastTokenizeShareRecordID, err := k.LastTokenizeShareRecordID.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	entryQueue, err := k.getValidatorChurnQueue(ctx, k.ValidatorEntryQueue)
+	if err != nil {
+		return nil, err
+	}
+
+	exitQueue, err := k.getValidatorChurnQueue(ctx, k.ValidatorExitQueue)
+	if err != nil {
+		return nil, err
+	}
+
 	rotationQueue := []types.RotationQueueRecord{}
 	err = k.ValidatorConsensusKeyRotationRecordQueue.Walk(ctx, nil, func(key time.Time, value types.ValAddrsOfRotatedConsKeys) (stop bool, err error) {
 		record := types.RotationQueueRecord{
@@ -343,5 +404,10 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		RotationIndexRecords: rotationIndex,
 		RotationHistory:      conspubKeyRotationHistory,
 		RotationQueue:        rotationQueue,
+
+		TokenizeShareRecords:      tokenizeShareRecords,
+		LastTokenizeShareRecordId: lastTokenizeShareRecordID,
+		ValidatorEntryQueue:       entryQueue,
+		ValidatorExitQueue:        exitQueue,
 	}, nil
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"strings"
 
+	gogotypes "github.com/cosmos/gogoproto/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -511,6 +512,159 @@ func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// TokenizeShareRecord queries a tokenize share record by id
+func (k Querier) TokenizeShareRecord(ctx context.Context, req *types.QueryTokenizeShareRecordRequest) (*types.QueryTokenizeShareRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "tokenize share record %d not found", req.Id)
+	}
+
+	return &types.QueryTokenizeShareRecordResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsOwned queries the tokenize share records owned by an address
+func (k Querier) TokenizeShareRecordsOwned(ctx context.Context, req *types.QueryTokenizeShareRecordsOwnedRequest) (*types.QueryTokenizeShareRecordsOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := k.GetTokenizeShareRecordsByOwner(ctx, owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordsOwnedResponse{Records: records}, nil
+}
+
+// TotalLiquidStaked queries the amount of tokens delegated by tokenize share records
+func (k Querier) TotalLiquidStaked(ctx context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	tokens, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: tokens}, nil
+}
+
+// ValidatorPower queries the raw and effective consensus power of a validator
+func (k Querier) ValidatorPower(ctx context.Context, req *types.QueryValidatorPowerRequest) (*types.QueryValidatorPowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	power, err := k.getValidatorPower(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, types.ErrNoValidatorFound) {
+			return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorPowerResponse{Power: power}, nil
+}
+
+// ValidatorPowers queries the raw and effective consensus power of the validators of the last validator set
+func (k Querier) ValidatorPowers(ctx context.Context, req *types.QueryValidatorPowersRequest) (*types.QueryValidatorPowersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	powers, pageRes, err := query.CollectionPaginate(ctx, k.LastValidatorPower, req.Pagination,
+		func(valAddr []byte, _ gogotypes.Int64Value) (types.ValidatorPower, error) {
+			return k.getValidatorPower(ctx, valAddr)
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorPowersResponse{Powers: powers, Pagination: pageRes}, nil
+}
+
+// ValidatorChurnQueues queries the validators waiting to enter or leave the validator set
+func (k Querier) ValidatorChurnQueues(ctx context.Context, req *types.QueryValidatorChurnQueuesRequest) (*types.QueryValidatorChurnQueuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	entryQueue, err := k.getValidatorChurnQueue(ctx, k.ValidatorEntryQueue)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	exitQueue, err := k.getValidatorChurnQueue(ctx, k.ValidatorExitQueue)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorChurnQueuesResponse{EntryQueue: entryQueue, ExitQueue: exitQueue}, nil
+}
+
+// ValidatorChurnQueuePosition queries the churn queue of a validator and its position in the queue
+func (k Querier) ValidatorChurnQueuePosition(ctx context.Context, req *types.QueryValidatorChurnQueuePositionRequest) (*types.QueryValidatorChurnQueuePositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	queue, position, err := k.GetValidatorChurnQueuePosition(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorChurnQueuePositionResponse{Queue: queue, Position: position}, nil
+}
+
+// getValidatorPower returns the consensus power of the validator tokens and its power in the last
+// validator set.
+func (k Keeper) getValidatorPower(ctx context.Context, valAddr sdk.ValAddress) (types.ValidatorPower, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.ValidatorPower{}, err
+	}
+
+	effectivePower, err := k.GetLastValidatorPower(ctx, valAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.ValidatorPower{}, err
+	}
+
+	return types.ValidatorPower{
+		ValidatorAddress: validator.GetOperator(),
+		RawPower:         validator.PotentialConsensusPower(k.PowerReduction(ctx)),
+		EffectivePower:   effectivePower,
+	}, nil
+}
+
 func queryRedelegation(ctx context.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
@@ -59,6 +59,7 @@ type Keeper struct {
 	bankKeeper            types.BankKeeper
 	consensusKeeper       types.ConsensusKeeper
 	hooks                 types.StakingHooks
+	validatorSetPolicy    types.ValidatorSetPolicy
 	authority             string
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
@@ -107,6 +108,20 @@ type Keeper struct {
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
+	// TokenizeShareRecords key: recordID | value: TokenizeShareRecord
+	TokenizeShareRecords collections.Map[uint64, types.TokenizeShareRecord]
+	// TokenizeShareRecordsByOwner key: owner+recordID | value: none used (index key for TokenizeShareRecords by owner)
+	TokenizeShareRecordsByOwner collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// LastTokenizeShareRecordID value: id of the last tokenize share record
+	LastTokenizeShareRecordID collections.Item[uint64]
+	// ValidatorLiquidShares key: valAddr | value: delegator shares of the validator held by tokenize share records
+	ValidatorLiquidShares collections.Map[sdk.ValAddress, math.LegacyDec]
+	// ValidatorEntryQueue value: validators waiting to enter the validator set, in processing order
+	ValidatorEntryQueue collections.Item[types.ValAddresses]
+	// ValidatorExitQueue value: validators waiting to leave the validator set, in processing order
+	ValidatorExitQueue collections.Item[types.ValAddresses]
+	// ValidatorTokenPower key: valAddr+height | value: consensus power of the validator tokens, or 0 if it is bonded with that power, and block time
+	ValidatorTokenPower collections.Map[collections.Pair[sdk.ValAddress, int64], collections.Pair[int64, time.Time]]
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -147,6 +162,7 @@ func NewKeeper(
 		bankKeeper:            bk,
 		consensusKeeper:       ck,
 		hooks:                 nil,
+		validatorSetPolicy:    types.DefaultValidatorSetPolicy{},
 		authority:             authority,
 		validatorAddressCodec: validatorAddressCodec,
 		consensusAddressCodec: consensusAddressCodec,
@@ -321,6 +337,51 @@ func NewKeeper(
 			codec.CollValue[types.ConsPubKeyRotationHistory](cdc),
 			NewRotationHistoryIndexes(sb),
 		),
+
+		// key format is: 107 | recordID
+		TokenizeShareRecords: collections.NewMap(
+			sb, types.TokenizeShareRecordPrefix,
+			"tokenize_share_records",
+			collections.Uint64Key.WithName("record_id"),
+			codec.CollValue[types.TokenizeShareRecord](cdc),
+		),
+
+		// key format is: 108 | lengthPrefixedBytes(owner) | recordID
+		TokenizeShareRecordsByOwner: collections.NewKeySet(
+			sb, types.TokenizeShareRecordIDByOwner,
+			"tokenize_share_records_by_owner",
+			collections.NamedPairKeyCodec(
+				"owner",
+				sdk.AccAddressKey,
+				"record_id",
+				collections.Uint64Key,
+			),
+		),
+
+		// key is: 109
+		LastTokenizeShareRecordID: collections.NewItem(sb, types.LastTokenizeShareRecordIDKey, "last_tokenize_share_record_id", collections.Uint64Value),
+
+		// key format is: 110 | valAddr
+		ValidatorLiquidShares: collections.NewMap(
+			sb, types.ValidatorLiquidSharesKey,
+			"validator_liquid_shares",
+			sdk.ValAddressKey,
+			sdk.LegacyDecValue,
+		),
+
+		// key is: 111
+		ValidatorEntryQueue: collections.NewItem(sb, types.ValidatorEntryQueueKey, "validator_entry_queue", codec.CollValue[types.ValAddresses](cdc)),
+
+		// key is: 112
+		ValidatorExitQueue: collections.NewItem(sb, types.ValidatorExitQueueKey, "validator_exit_queue", codec.CollValue[types.ValAddresses](cdc)),
+
+		// key format is: 114 | lengthPrefixedBytes(valAddr) | height
+		ValidatorTokenPower: collections.NewMap(
+			sb, types.ValidatorTokenPowerKey,
+			"validator_token_power",
+			collections.PairKeyCodec(sdk.ValAddressKey, collections.Int64Key),
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.Int64Key, sdk.TimeKey)),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -351,6 +412,12 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) {
 	k.hooks = sh
 }
 
+// SetValidatorSetPolicy sets the policy selecting the bonded validator set. It defaults to
+// types.DefaultValidatorSetPolicy.
+func (k *Keeper) SetValidatorSetPolicy(policy types.ValidatorSetPolicy) {
+	k.validatorSetPolicy = policy
+}
+
 // GetAuthority returns the x/staking module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -284,7 +284,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 
 			s.ctx.KVStore(s.key).Set(getLastValidatorPowerKey(valAddrs[i]), bz)
 		},
-		"d9690cb1904ab91c618a3f6d27ef90bfe6fb57a2c01970b7c088ec4ecd0613eb",
+		"9ee6070b2e09d7697827b7d0dc34fb66915e88d19aa70ef8966fae4253a3954f",
 	)
 	s.Require().NoError(err)
 
@@ -299,7 +299,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 			err = s.stakingKeeper.LastValidatorPower.Set(s.ctx, valAddrs[i], intV)
 			s.Require().NoError(err)
 		},
-		"d9690cb1904ab91c618a3f6d27ef90bfe6fb57a2c01970b7c088ec4ecd0613eb",
+		"9ee6070b2e09d7697827b7d0dc34fb66915e88d19aa70ef8966fae4253a3954f",
 	)
 	s.Require().NoError(err)
 }
@@ -317,7 +317,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValSrcIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"43ab9766738a05bfe5f1fd5dd0fb01c05b574f7d43c004dbf228deb437e0eb7c",
+		"e0a2ebd52712138207de7c37629d164130a62359c91e3634c7ac32e3cae8d21b",
 	)
 	s.Require().NoError(err)
 
@@ -330,7 +330,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValSrc.Set(s.ctx, collections.Join3(valAddrs[i].Bytes(), addrs[i].Bytes(), valAddrs[i+1].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"43ab9766738a05bfe5f1fd5dd0fb01c05b574f7d43c004dbf228deb437e0eb7c",
+		"e0a2ebd52712138207de7c37629d164130a62359c91e3634c7ac32e3cae8d21b",
 	)
 
 	s.Require().NoError(err)
@@ -349,7 +349,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValDstIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"70c00b5171cbef019742d236096df60fc423cd7568c4933ab165baa3c68a64a1", // this hash obtained when ran this test in main branch
+		"e25e6821d6f0693a26ecdcdb900e170d6894ae10a19303a5a4c9b52c143bbd87", // this hash obtained when ran this test in main branch
 	)
 	s.Require().NoError(err)
 
@@ -362,7 +362,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValDst.Set(s.ctx, collections.Join3(valAddrs[i+1].Bytes(), addrs[i].Bytes(), valAddrs[i].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"70c00b5171cbef019742d236096df60fc423cd7568c4933ab165baa3c68a64a1",
+		"e25e6821d6f0693a26ecdcdb900e170d6894ae10a19303a5a4c9b52c143bbd87",
 	)
 
 	s.Require().NoError(err)
@@ -393,7 +393,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			s.ctx.KVStore(s.key).Set(getUBDKey(delAddrs[i], valAddrs[i]), bz)
 			s.ctx.KVStore(s.key).Set(getUBDByValIndexKey(delAddrs[i], valAddrs[i]), []byte{})
 		},
-		"bae8a1f2070bea541bfeca8e7e4a1203cb316126451325b846b303897e8e7082",
+		"12ae5e6fd2c0d5eff6f3dc2dea751b36beeefc8ef30abeaf01cc86324628bea6",
 	)
 	s.Require().NoError(err)
 
@@ -417,7 +417,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingDelegation(s.ctx, ubd)
 			s.Require().NoError(err)
 		},
-		"bae8a1f2070bea541bfeca8e7e4a1203cb316126451325b846b303897e8e7082",
+		"12ae5e6fd2c0d5eff6f3dc2dea751b36beeefc8ef30abeaf01cc86324628bea6",
 	)
 	s.Require().NoError(err)
 }
@@ -434,7 +434,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getUnbondingDelegationTimeKey(date), []byte{})
 		},
-		"3f2de3f984c99cce5307db45961237220212c02981654b01b7b52f7a68b5b21b",
+		"8bf779ea7bcb5e4f343b91fbb6e03590bebaa1a3fe8ea52dd6a70ac2c7bffb5b",
 	)
 	s.Require().NoError(err)
 
@@ -447,7 +447,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUBDQueueTimeSlice(s.ctx, date, nil)
 			s.Require().NoError(err)
 		},
-		"3f2de3f984c99cce5307db45961237220212c02981654b01b7b52f7a68b5b21b",
+		"8bf779ea7bcb5e4f343b91fbb6e03590bebaa1a3fe8ea52dd6a70ac2c7bffb5b",
 	)
 	s.Require().NoError(err)
 }
@@ -481,7 +481,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorKey(valAddrs[i]), valBz)
 		},
-		"d8acdcf8b7c8e17f3e83f0a4c293f89ad619a5dcb14d232911ccc5da15653177",
+		"8fa23ddda0e0e041f7976fcd2878f3589dcf226ee221ab142673a695038211d0",
 	)
 	s.Require().NoError(err)
 
@@ -507,7 +507,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			err := s.stakingKeeper.SetValidator(s.ctx, val)
 			s.Require().NoError(err)
 		},
-		"d8acdcf8b7c8e17f3e83f0a4c293f89ad619a5dcb14d232911ccc5da15653177",
+		"8fa23ddda0e0e041f7976fcd2878f3589dcf226ee221ab142673a695038211d0",
 	)
 	s.Require().NoError(err)
 }
@@ -530,7 +530,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorQueueKey(endTime, endHeight), bz)
 		},
-		"a631942cd94450d778706c98afc4f83231524e3e94c88474cdab79a01a4899a0",
+		"3b8df215076cc28e63953aa4f613bd998ac983e40ce9807250949803d95d8e04",
 	)
 	s.Require().NoError(err)
 
@@ -545,7 +545,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingValidatorsQueue(s.ctx, endTime, endHeight, addrs)
 			s.Require().NoError(err)
 		},
-		"a631942cd94450d778706c98afc4f83231524e3e94c88474cdab79a01a4899a0",
+		"3b8df215076cc28e63953aa4f613bd998ac983e40ce9807250949803d95d8e04",
 	)
 	s.Require().NoError(err)
 }
@@ -573,7 +573,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			s.Require().NoError(err)
 			s.ctx.KVStore(s.key).Set(getRedelegationTimeKey(date), bz)
 		},
-		"58722ccde0cacda42aa81d71d7da1123b2c4a8e35d961d55f1507c3f10ffbc96",
+		"f966da4629cff5933d5717a7feae6176a764f52e46d62cbea7896a34a17d132e",
 	)
 	s.Require().NoError(err)
 
@@ -595,7 +595,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			err := s.stakingKeeper.SetRedelegationQueueTimeSlice(s.ctx, date, dvvTriplets.Triplets)
 			s.Require().NoError(err)
 		},
-		"58722ccde0cacda42aa81d71d7da1123b2c4a8e35d961d55f1507c3f10ffbc96",
+		"f966da4629cff5933d5717a7feae6176a764f52e46d62cbea7896a34a17d132e",
 	)
 	s.Require().NoError(err)
 }
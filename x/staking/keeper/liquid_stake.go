@@ -0,0 +1,419 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TokenizeShares tokenizes shares of the delegation of delAddr to valAddr. The tokens of the shares are
+// delegated again from the account of a new tokenize share record owned by owner, and the delegator
+// receives share tokens of the record, which can be transferred and redeemed for the delegation. The
+// rewards of the tokenized delegation accrue to the owner of the record. Shares of a delegation receiving
+// a redelegation cannot be tokenized until the redelegation completes, since the redelegation entries
+// would no longer find the delegation to slash.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec, owner sdk.AccAddress,
+) (types.TokenizeShareRecord, sdk.Coin, error) {
+	if bytes.Equal(delAddr, valAddr) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrTokenizeSharesSelfDelegation
+	}
+
+	hasRedelegation, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if hasRedelegation {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+	if !tokens.IsPositive() {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "too few shares to tokenize (truncates to zero tokens)")
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, valAddr, shares, tokens); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	ownerStr, err := k.authKeeper.AddressCodec().BytesToString(owner)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	lastID, err := k.LastTokenizeShareRecordID.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	record := types.NewTokenizeShareRecord(lastID+1, ownerStr, validator.GetOperator())
+	if err := k.LastTokenizeShareRecordID.Set(ctx, record.Id); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// move the tokens of the shares from the delegator to the record account, so that locked vesting
+	// coins cannot be tokenized
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return types.TokenizeShareRecord{}, sdk.Coin{}, err
+		}
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, coins); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, recordAddr, coins); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// the validator is updated by the unbonding
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	newShares, err := k.Delegate(ctx, recordAddr, returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.setTokenizeShareRecord(ctx, record, owner); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.addValidatorLiquidShares(ctx, valAddr, newShares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	return record, shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens of a tokenize share record held by delAddr and delegates the
+// tokens of the corresponding shares of the tokenized delegation from delAddr. Once all the share tokens
+// of a record are redeemed, the remaining balance of the record account, such as withdrawn rewards, is
+// sent to the owner of the record and the record is removed.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, error) {
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	delegation, err := k.Delegations.Get(ctx, collections.Join(recordAddr, sdk.ValAddress(valAddr)))
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.Coin{}, types.ErrNoDelegation
+	} else if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, shareToken.Denom)
+	if balance.IsLT(shareToken) {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, shareToken)
+	}
+
+	// the share tokens are a claim on a proportional part of the shares of the tokenized delegation
+	shares := delegation.Shares
+	if supply := k.bankKeeper.GetSupply(ctx, shareToken.Denom); shareToken.IsLT(supply) {
+		shares = shares.MulInt(shareToken.Amount).QuoInt(supply.Amount)
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if !validator.TokensFromShares(shares).TruncateInt().IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "too few share tokens to redeem (truncates to zero tokens)")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, k.authKeeper.GetModuleAddress(types.ModuleName), sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.addValidatorLiquidShares(ctx, valAddr, shares.Neg()); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err = k.GetValidator(ctx, valAddr)
+	switch {
+	case errors.Is(err, types.ErrNoValidatorFound):
+		// the unbonded validator is removed once it has no delegator shares left, the tokens are
+		// returned to the redeemer
+		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+		if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, coins); err != nil {
+			return sdk.Coin{}, err
+		}
+	case err != nil:
+		return sdk.Coin{}, err
+	default:
+		if _, err := k.Delegate(ctx, delAddr, returnAmount, types.BondStatus(validator.GetStatus()), validator, false); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	has, err := k.Delegations.Has(ctx, collections.Join(recordAddr, sdk.ValAddress(valAddr)))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if !has {
+		if err := k.removeTokenizeShareRecord(ctx, record); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return sdk.NewCoin(bondDenom, returnAmount), nil
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share record, and thus of the rewards
+// of its tokenized delegation, from sender to newOwner.
+func (k Keeper) TransferTokenizeShareRecord(ctx context.Context, recordID uint64, sender, newOwner sdk.AccAddress) error {
+	record, err := k.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return err
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(owner, sender) {
+		return types.ErrNotTokenizeShareRecordOwner
+	}
+
+	if err := k.TokenizeShareRecordsByOwner.Remove(ctx, collections.Join(sdk.AccAddress(owner), record.Id)); err != nil {
+		return err
+	}
+
+	record.Owner, err = k.authKeeper.AddressCodec().BytesToString(newOwner)
+	if err != nil {
+		return err
+	}
+
+	return k.setTokenizeShareRecord(ctx, record, newOwner)
+}
+
+// GetTokenizeShareRecord returns a tokenize share record by id.
+func (k Keeper) GetTokenizeShareRecord(ctx context.Context, id uint64) (types.TokenizeShareRecord, error) {
+	record, err := k.TokenizeShareRecords.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotExists, "id %d", id)
+	}
+
+	return record, err
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of a share token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx context.Context, denom string) (types.TokenizeShareRecord, error) {
+	_, id, err := types.ParseShareTokenDenom(denom)
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	if record.GetShareTokenDenom() != denom {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotExists, "denom %s", denom)
+	}
+
+	return record, nil
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by an address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) ([]types.TokenizeShareRecord, error) {
+	var records []types.TokenizeShareRecord
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](owner)
+	err := k.TokenizeShareRecordsByOwner.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, uint64]) (stop bool, err error) {
+		record, err := k.GetTokenizeShareRecord(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		records = append(records, record)
+		return false, nil
+	})
+
+	return records, err
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx context.Context) ([]types.TokenizeShareRecord, error) {
+	var records []types.TokenizeShareRecord
+	err := k.TokenizeShareRecords.Walk(ctx, nil, func(_ uint64, record types.TokenizeShareRecord) (stop bool, err error) {
+		records = append(records, record)
+		return false, nil
+	})
+
+	return records, err
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator held by tokenize share records.
+func (k Keeper) GetValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	shares, err := k.ValidatorLiquidShares.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return shares, err
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens delegated by tokenize share records.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	total := math.ZeroInt()
+	err := k.ValidatorLiquidShares.Walk(ctx, nil, func(valAddr sdk.ValAddress, shares math.LegacyDec) (stop bool, err error) {
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		total = total.Add(validator.TokensFromShares(shares).TruncateInt())
+		return false, nil
+	})
+
+	return total, err
+}
+
+// checkLiquidStakingCaps checks that tokenizing shares worth tokens of a validator keeps the liquid
+// staked shares of the validator and the total liquid staked tokens under their caps. A cap of 100%
+// is never checked.
+func (k Keeper) checkLiquidStakingCaps(
+	ctx context.Context, validator types.Validator, valAddr sdk.ValAddress, shares math.LegacyDec, tokens math.Int,
+) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.ValidatorLiquidStakingCap.LT(math.LegacyOneDec()) {
+		liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if liquidShares.Add(shares).GT(params.ValidatorLiquidStakingCap.Mul(validator.DelegatorShares)) {
+			return types.ErrValidatorLiquidStakingCapExceeded
+		}
+	}
+
+	if params.GlobalLiquidStakingCap.LT(math.LegacyOneDec()) {
+		totalLiquidStaked, err := k.GetTotalLiquidStakedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		totalBonded, err := k.TotalBondedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		if math.LegacyNewDecFromInt(totalLiquidStaked.Add(tokens)).GT(params.GlobalLiquidStakingCap.MulInt(totalBonded)) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	return nil
+}
+
+// addValidatorLiquidShares adds shares, which can be negative, to the liquid shares of a validator.
+func (k Keeper) addValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	liquidShares = liquidShares.Add(shares)
+	if !liquidShares.IsPositive() {
+		return k.ValidatorLiquidShares.Remove(ctx, valAddr)
+	}
+
+	return k.ValidatorLiquidShares.Set(ctx, valAddr, liquidShares)
+}
+
+// setTokenizeShareRecord sets a tokenize share record and indexes it by owner.
+func (k Keeper) setTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord, owner sdk.AccAddress) error {
+	if err := k.TokenizeShareRecords.Set(ctx, record.Id, record); err != nil {
+		return err
+	}
+
+	return k.TokenizeShareRecordsByOwner.Set(ctx, collections.Join(owner, record.Id))
+}
+
+// removeTokenizeShareRecord sends the remaining balance of the account of a tokenize share record to
+// its owner and removes the record.
+func (k Keeper) removeTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	if balances := k.bankKeeper.GetAllBalances(ctx, record.GetModuleAddress()); !balances.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, record.GetModuleAddress(), owner, balances); err != nil {
+			return err
+		}
+	}
+
+	if err := k.TokenizeShareRecordsByOwner.Remove(ctx, collections.Join(sdk.AccAddress(owner), record.Id)); err != nil {
+		return err
+	}
+
+	return k.TokenizeShareRecords.Remove(ctx, record.Id)
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// setupLiquidStakingValidator creates a bonded validator with a self-delegation from addrDels[0] and a
+// delegation from addrDels[1], both of delTokens.
+func (s *KeeperTestSuite) setupLiquidStakingValidator(addrDels []sdk.AccAddress, valAddr sdk.ValAddress, delTokens math.Int) {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	validator, issuedShares := validator.AddTokensFromDel(delTokens)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	require.True(validator.IsBonded())
+	require.NoError(keeper.SetDelegation(ctx, stakingtypes.NewDelegation(s.addressToString(addrDels[0]), s.valAddressToString(valAddr), issuedShares)))
+
+	require.NoError(keeper.DeleteValidatorByPowerIndex(ctx, validator))
+	validator, issuedShares = validator.AddTokensFromDel(delTokens)
+	stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	require.NoError(keeper.SetDelegation(ctx, stakingtypes.NewDelegation(s.addressToString(addrDels[1]), s.valAddressToString(valAddr), issuedShares)))
+}
+
+// expectTokenizeShares sets the expected bank calls of a tokenization of a delegation to a bonded validator.
+func (s *KeeperTestSuite) expectTokenizeShares(delAddr, recordAddr sdk.AccAddress) {
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddr, gomock.Any())
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), delAddr, recordAddr, gomock.Any())
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), recordAddr, stakingtypes.BondedPoolName, gomock.Any())
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), stakingtypes.ModuleName, gomock.Any())
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.ModuleName, delAddr, gomock.Any())
+}
+
+func (s *KeeperTestSuite) TestTokenizeAndRedeemShares() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	addrDels, addrVals := createValAddrs(4)
+	delTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	s.setupLiquidStakingValidator(addrDels, addrVals[0], delTokens)
+
+	delegator, owner, newOwner := addrDels[1], addrDels[2], addrDels[3]
+	valStr := s.valAddressToString(addrVals[0])
+	tokenized := delTokens.QuoRaw(2)
+
+	// validator operators cannot tokenize their self-delegation
+	_, err := msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(
+		s.addressToString(addrDels[0]), valStr, sdk.NewCoin(sdk.DefaultBondDenom, tokenized), s.addressToString(owner),
+	))
+	require.ErrorIs(err, stakingtypes.ErrTokenizeSharesSelfDelegation)
+
+	record := stakingtypes.NewTokenizeShareRecord(1, s.addressToString(owner), valStr)
+	recordAddr := record.GetModuleAddress()
+	s.expectTokenizeShares(delegator, recordAddr)
+
+	res, err := msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(
+		s.addressToString(delegator), valStr, sdk.NewCoin(sdk.DefaultBondDenom, tokenized), s.addressToString(owner),
+	))
+	require.NoError(err)
+	shareToken := sdk.NewCoin(valStr+"/1", tokenized)
+	require.Equal(shareToken, res.Amount)
+
+	resRecord, err := keeper.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	require.NoError(err)
+	require.Equal(record, resRecord)
+
+	delegation, err := keeper.Delegations.Get(ctx, collections.Join(delegator, addrVals[0]))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(delTokens.Sub(tokenized)), delegation.Shares)
+
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(recordAddr, addrVals[0]))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(tokenized), delegation.Shares)
+
+	liquidShares, err := keeper.GetValidatorLiquidShares(ctx, addrVals[0])
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(tokenized), liquidShares)
+
+	totalRes, err := s.queryClient.TotalLiquidStaked(ctx, &stakingtypes.QueryTotalLiquidStakedRequest{})
+	require.NoError(err)
+	require.Equal(tokenized, totalRes.Tokens)
+
+	// only the owner can transfer the record
+	_, err = msgServer.TransferTokenizeShareRecord(ctx, stakingtypes.NewMsgTransferTokenizeShareRecord(1, s.addressToString(delegator), s.addressToString(newOwner)))
+	require.ErrorIs(err, stakingtypes.ErrNotTokenizeShareRecordOwner)
+
+	_, err = msgServer.TransferTokenizeShareRecord(ctx, stakingtypes.NewMsgTransferTokenizeShareRecord(1, s.addressToString(owner), s.addressToString(newOwner)))
+	require.NoError(err)
+
+	ownedRes, err := s.queryClient.TokenizeShareRecordsOwned(ctx, &stakingtypes.QueryTokenizeShareRecordsOwnedRequest{Owner: s.addressToString(owner)})
+	require.NoError(err)
+	require.Empty(ownedRes.Records)
+
+	ownedRes, err = s.queryClient.TokenizeShareRecordsOwned(ctx, &stakingtypes.QueryTokenizeShareRecordsOwnedRequest{Owner: s.addressToString(newOwner)})
+	require.NoError(err)
+	require.Len(ownedRes.Records, 1)
+	require.Equal(s.addressToString(newOwner), ownedRes.Records[0].Owner)
+
+	// redeeming all the share tokens removes the record and sends the withdrawn rewards to the owner
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 7))
+	stakingModuleAddr := authtypes.NewModuleAddress(stakingtypes.ModuleName)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), delegator, shareToken.Denom).Return(shareToken)
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), shareToken.Denom).Return(shareToken)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), delegator, stakingtypes.ModuleName, sdk.NewCoins(shareToken))
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.ModuleName).Return(stakingModuleAddr)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), []byte(stakingModuleAddr), sdk.NewCoins(shareToken))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), recordAddr).Return(rewards)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), recordAddr, newOwner, rewards)
+
+	redeemRes, err := msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(s.addressToString(delegator), shareToken))
+	require.NoError(err)
+	require.Equal(sdk.NewCoin(sdk.DefaultBondDenom, tokenized), redeemRes.Amount)
+
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(delegator, addrVals[0]))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(delTokens), delegation.Shares)
+
+	_, err = keeper.GetTokenizeShareRecord(ctx, 1)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotExists)
+
+	liquidShares, err = keeper.GetValidatorLiquidShares(ctx, addrVals[0])
+	require.NoError(err)
+	require.True(liquidShares.IsZero())
+}
+
+func (s *KeeperTestSuite) TestTokenizeSharesCaps() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	addrDels, addrVals := createValAddrs(3)
+	delTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	s.setupLiquidStakingValidator(addrDels, addrVals[0], delTokens)
+
+	delegator, owner := s.addressToString(addrDels[1]), s.addressToString(addrDels[2])
+	valStr := s.valAddressToString(addrVals[0])
+
+	// the validator has 2*delTokens delegator shares
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	_, err = msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(
+		delegator, valStr, sdk.NewCoin(sdk.DefaultBondDenom, delTokens.QuoRaw(2).AddRaw(1)), owner,
+	))
+	require.ErrorIs(err, stakingtypes.ErrValidatorLiquidStakingCapExceeded)
+
+	// the total bonded tokens are 2*delTokens
+	params.ValidatorLiquidStakingCap = math.LegacyOneDec()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(10, 2)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	bondedPool := authtypes.NewEmptyModuleAccount(stakingtypes.BondedPoolName)
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedPool).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedPool.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewCoin(sdk.DefaultBondDenom, delTokens.MulRaw(2))).AnyTimes()
+
+	_, err = msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(
+		delegator, valStr, sdk.NewCoin(sdk.DefaultBondDenom, delTokens.QuoRaw(5).AddRaw(1)), owner,
+	))
+	require.ErrorIs(err, stakingtypes.ErrGlobalLiquidStakingCapExceeded)
+
+	record := stakingtypes.NewTokenizeShareRecord(1, owner, valStr)
+	s.expectTokenizeShares(addrDels[1], record.GetModuleAddress())
+	_, err = msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(
+		delegator, valStr, sdk.NewCoin(sdk.DefaultBondDenom, delTokens.QuoRaw(5)), owner,
+	))
+	require.NoError(err)
+}
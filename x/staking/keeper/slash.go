@@ -39,11 +39,6 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionH
 		return math.NewInt(0), fmt.Errorf("attempted to slash with a negative slash factor: %v", slashFactor)
 	}
 
-	// Amount of slashing = slash slashFactor * power at time of infraction
-	amount := k.TokensFromConsensusPower(ctx, power)
-	slashAmountDec := math.LegacyNewDecFromInt(amount).Mul(slashFactor)
-	slashAmount := slashAmountDec.TruncateInt()
-
 	// ref https://github.com/cosmos/cosmos-sdk/issues/1348
 
 	validator, err := k.GetValidatorByConsAddr(ctx, consAddr)
@@ -76,6 +71,19 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionH
 		return math.NewInt(0), err
 	}
 
+	// the reported power is the one the validator was bonded with, which differs from the power of its
+	// tokens when it was capped or received the excess power of capped validators, so translate it back
+	// to slash the validator on its tokens
+	power, err = k.tokenPowerAtInfraction(ctx, operatorAddress, infractionHeight, power)
+	if err != nil {
+		return math.NewInt(0), err
+	}
+
+	// Amount of slashing = slash slashFactor * power at time of infraction
+	amount := k.TokensFromConsensusPower(ctx, power)
+	slashAmountDec := math.LegacyNewDecFromInt(amount).Mul(slashFactor)
+	slashAmount := slashAmountDec.TruncateInt()
+
 	// call the before-modification hook
 	if err := k.Hooks().BeforeValidatorModified(ctx, operatorAddress); err != nil {
 		return math.NewInt(0), fmt.Errorf("failed to call before validator modified hook: %w", err)
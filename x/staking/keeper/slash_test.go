@@ -1,8 +1,12 @@
 package keeper_test
 
 import (
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/core/header"
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -50,3 +54,83 @@ func (s *KeeperTestSuite) TestSlashAtFutureHeight() {
 	_, err := keeper.Slash(ctx, consAddr, 1, 10, fraction)
 	require.Error(err)
 }
+
+// tests Slash of validators bonded with a capped power
+func (s *KeeperTestSuite) TestSlashWithPowerCap() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MaxValidatorPowerRatio = sdkmath.LegacyNewDecWithPrec(5, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	powers := []int64{60, 30, 10}
+	for i, power := range powers {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, power))
+		require.NoError(keeper.SetValidator(ctx, validator))
+		require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+		require.NoError(keeper.SetValidatorByPowerIndex(ctx, validator))
+	}
+
+	// the validators are bonded with the powers 50, 37 and 12
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: ctx.HeaderInfo().Time})
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	updates := s.applyValidatorSetUpdates(ctx, keeper, 3)
+	require.Equal(int64(50), updates[0].Power)
+	require.Equal(int64(12), updates[2].Power)
+
+	// the validators are slashed on their tokens rather than on the power they are bonded with
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 20, Time: ctx.HeaderInfo().Time})
+	fraction := sdkmath.LegacyNewDecWithPrec(5, 1)
+	bondDenom, err := keeper.BondDenom(ctx)
+	require.NoError(err)
+
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.BondedPoolName).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), gomock.Any(), sdk.NewCoins(sdk.NewCoin(bondDenom, keeper.TokensFromConsensusPower(ctx, 30))))
+	slashed, err := keeper.Slash(ctx, sdk.ConsAddress(PKs[0].Address()), 15, 50, fraction)
+	require.NoError(err)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 30), slashed)
+
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), gomock.Any(), sdk.NewCoins(sdk.NewCoin(bondDenom, keeper.TokensFromConsensusPower(ctx, 5))))
+	slashed, err = keeper.Slash(ctx, sdk.ConsAddress(PKs[2].Address()), 15, 12, fraction)
+	require.NoError(err)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 5), slashed)
+
+	// infractions before the validators were bonded with a capped power are slashed on the reported power
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), gomock.Any(), sdk.NewCoins(sdk.NewCoin(bondDenom, keeper.TokensFromConsensusPower(ctx, 5))))
+	slashed, err = keeper.Slash(ctx, sdk.ConsAddress(PKs[1].Address()), 5, 10, fraction)
+	require.NoError(err)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 5), slashed)
+}
+
+// tests Slash of validators capped by the validator set policy
+func (s *KeeperTestSuite) TestSlashWithCappedPowerPolicy() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	keeper.SetValidatorSetPolicy(stakingtypes.NewCappedPowerValidatorSetPolicy(60))
+
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 100))
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+	require.NoError(keeper.SetValidatorByPowerIndex(ctx, validator))
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: ctx.HeaderInfo().Time})
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	updates := s.applyValidatorSetUpdates(ctx, keeper, 1)
+	require.Equal(int64(60), updates[0].Power)
+
+	// the validator is slashed on its 100 power of tokens rather than on the capped power
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 20, Time: ctx.HeaderInfo().Time})
+	bondDenom, err := keeper.BondDenom(ctx)
+	require.NoError(err)
+
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.BondedPoolName).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), gomock.Any(), sdk.NewCoins(sdk.NewCoin(bondDenom, keeper.TokensFromConsensusPower(ctx, 10))))
+	slashed, err := keeper.Slash(ctx, sdk.ConsAddress(PKs[0].Address()), 15, 60, sdkmath.LegacyNewDecWithPrec(1, 1))
+	require.NoError(err)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 10), slashed)
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setValidatorTokenPower records the consensus power of the tokens of a bonded validator when it differs
+// from the power the validator is bonded with, e.g. because the validator set policy or the max validator
+// power ratio capped it, gave it the excess power of the capped validators, or the churn limit delayed its
+// power change. Infractions report the power the validator was bonded with, so Slash uses this history to
+// slash the validator on its tokens instead. An entry is only written when the recorded value changes, and
+// the entries that no longer cover a slashable height are pruned.
+func (k Keeper) setValidatorTokenPower(ctx context.Context, valAddr sdk.ValAddress, tokenPower, power int64) error {
+	// a zero token power records that the validator is bonded with the power of its tokens
+	if tokenPower == power {
+		tokenPower = 0
+	}
+
+	last, found, err := k.lastValidatorTokenPower(ctx, valAddr, k.HeaderService.HeaderInfo(ctx).Height)
+	if err != nil {
+		return err
+	}
+	if (!found && tokenPower == 0) || (found && last == tokenPower) {
+		return nil
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	err = k.ValidatorTokenPower.Set(ctx, collections.Join(valAddr, headerInfo.Height), collections.Join(tokenPower, headerInfo.Time))
+	if err != nil {
+		return err
+	}
+
+	return k.pruneValidatorTokenPower(ctx, valAddr)
+}
+
+// lastValidatorTokenPower returns the token power recorded for the validator at the given height, if any.
+// A zero token power means that the validator was bonded with the power of its tokens.
+func (k Keeper) lastValidatorTokenPower(ctx context.Context, valAddr sdk.ValAddress, height int64) (int64, bool, error) {
+	rng := collections.NewPrefixedPairRange[sdk.ValAddress, int64](valAddr).EndInclusive(height).Descending()
+	iter, err := k.ValidatorTokenPower.Iterate(ctx, rng)
+	if err != nil {
+		return 0, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, false, nil
+	}
+
+	value, err := iter.Value()
+	if err != nil {
+		return 0, false, err
+	}
+
+	return value.K1(), true, nil
+}
+
+// pruneValidatorTokenPower removes the token powers of the validator superseded by an entry recorded more
+// than an unbonding period ago, as no infraction they cover can be slashed anymore.
+func (k Keeper) pruneValidatorTokenPower(ctx context.Context, valAddr sdk.ValAddress) error {
+	unbondingTime, err := k.UnbondingTime(ctx)
+	if err != nil {
+		return err
+	}
+	cutoff := k.HeaderService.HeaderInfo(ctx).Time.Add(-unbondingTime)
+
+	iter, err := k.ValidatorTokenPower.Iterate(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, int64](valAddr))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var expired []collections.Pair[sdk.ValAddress, int64]
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
+		if kv.Value.K2().After(cutoff) {
+			break
+		}
+		expired = append(expired, kv.Key)
+	}
+
+	// the last expired entry still covers the heights up to the next entry
+	for i := 0; i < len(expired)-1; i++ {
+		if err := k.ValidatorTokenPower.Remove(ctx, expired[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tokenPowerAtInfraction translates the consensus power of a validator at the time of an infraction to the
+// consensus power of its tokens at that time, which differ when the validator was bonded with a capped power.
+func (k Keeper) tokenPowerAtInfraction(ctx context.Context, valAddr sdk.ValAddress, infractionHeight, power int64) (int64, error) {
+	tokenPower, found, err := k.lastValidatorTokenPower(ctx, valAddr, infractionHeight)
+	if err != nil {
+		return 0, err
+	}
+	if !found || tokenPower == 0 {
+		return power, nil
+	}
+
+	return tokenPower, nil
+}
+
+// removeValidatorTokenPower removes the token power history of a removed validator.
+func (k Keeper) removeValidatorTokenPower(ctx context.Context, valAddr sdk.ValAddress) error {
+	return k.ValidatorTokenPower.Clear(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, int64](valAddr))
+}
@@ -162,10 +162,14 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 	}
 	defer iterator.Close()
 
-	var updates []appmodule.ValidatorUpdate
-	for count := 0; iterator.Valid() && count < int(maxValidators); iterator.Next() {
+	var (
+		updates  []appmodule.ValidatorUpdate
+		selected []setMember
+		admitted []types.Validator
+	)
+	for ; iterator.Valid() && len(selected) < int(maxValidators); iterator.Next() {
 		// everything that is iterated in this loop is becoming or already a
-		// part of the bonded validator set
+		// part of the bonded validator set, unless the churn limit delays it
 		valAddr := sdk.ValAddress(iterator.Value())
 		validator, err := k.GetValidator(ctx, valAddr)
 		if err != nil {
@@ -178,10 +182,40 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 
 		// if we get to a zero-power validator (which we don't bond),
 		// there are no more possible bonded validators
-		if validator.PotentialConsensusPower(k.PowerReduction(ctx)) == 0 {
+		potentialPower := validator.PotentialConsensusPower(powerReduction)
+		if potentialPower == 0 {
 			break
 		}
 
+		// the validator set policy may exclude the validator from the set, or bond it
+		// with a power other than the one of its tokens
+		newPower, ok, err := k.validatorSetPolicy.SelectValidator(ctx, validator, potentialPower, admitted)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if newPower <= 0 {
+			return nil, fmt.Errorf("validator set policy selected validator %s with non-positive power %d", validator.GetOperator(), newPower)
+		}
+
+		admitted = append(admitted, validator)
+		selected = append(selected, setMember{validator: validator, addr: valAddr, power: newPower})
+	}
+
+	// limit the power entering and leaving the set to the validator churn limit
+	selected, churn, err := k.applyChurnLimit(ctx, params, selected, last)
+	if err != nil {
+		return nil, err
+	}
+
+	bonded := make([]types.Validator, len(selected))
+	bondedAddrs := make([]sdk.ValAddress, len(selected))
+	powers := make([]math.Int, len(selected))
+	for i, member := range selected {
+		validator := member.validator
+
 		// apply the appropriate state change if necessary
 		switch {
 		case validator.IsUnbonded():
@@ -202,27 +236,51 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 			return nil, errors.New("unexpected validator status")
 		}
 
+		bonded[i] = validator
+		bondedAddrs[i] = member.addr
+		powers[i] = math.NewInt(member.power)
+	}
+
+	// cap the power of each validator to the max validator power ratio of the total
+	// power, redistributing the excess to the other validators
+	powers = types.CapPowers(powers, params.MaxValidatorPowerRatio)
+
+	// limit the power changes of the validators staying in the set to the churn left by the validators
+	// entering and leaving it
+	powers, err = k.limitPowerChanges(bondedAddrs, powers, last, churn)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, valAddr := range bondedAddrs {
 		// fetch the old power bytes
 		valAddrStr, err := k.validatorAddressCodec.BytesToString(valAddr)
 		if err != nil {
 			return nil, err
 		}
 		oldPowerBytes, found := last[valAddrStr]
-		newPower := validator.ConsensusPower(powerReduction)
+		newPower := powers[i].Int64()
 		newPowerBytes := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: newPower})
 
 		// update the validator set if power has changed
 		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
-			updates = append(updates, validator.ModuleValidatorUpdate(powerReduction))
+			update := bonded[i].ModuleValidatorUpdate(powerReduction)
+			update.Power = newPower
+			updates = append(updates, update)
 			if err = k.SetLastValidatorPower(ctx, valAddr, newPower); err != nil {
 				return nil, err
 			}
 		}
 
+		// record the power of the validator tokens if it is bonded with another power, so that it is slashed
+		// on its tokens
+		if err = k.setValidatorTokenPower(ctx, valAddr, bonded[i].PotentialConsensusPower(powerReduction), newPower); err != nil {
+			return nil, err
+		}
+
 		delete(last, valAddrStr)
-		count++
 
-		totalPower = totalPower.Add(math.NewInt(newPower))
+		totalPower = totalPower.Add(powers[i])
 	}
 
 	noLongerBonded, err := sortNoLongerBonded(last, k.validatorAddressCodec)
@@ -288,6 +346,11 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 		// - a validator can be unbonding state but jailed status false
 		// - a validator can be jailed and status can be unbonding
 		if !(validator.Jailed || validator.Status != types.Bonded) {
+			power, err := k.GetLastValidatorPower(ctx, valAddr)
+			if err != nil {
+				return nil, err
+			}
+
 			updates = append(updates, appmodule.ValidatorUpdate{
 				PubKey:     oldPk.Bytes(),
 				PubKeyType: oldPk.Type(),
@@ -297,7 +360,7 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 			updates = append(updates, appmodule.ValidatorUpdate{
 				PubKey:     newPk.Bytes(),
 				PubKeyType: newPk.Type(),
-				Power:      validator.ConsensusPower(powerReduction),
+				Power:      power,
 			})
 
 			if err := k.updateToNewPubkey(ctx, validator, history.OldConsPubkey, history.NewConsPubkey, history.Fee); err != nil {
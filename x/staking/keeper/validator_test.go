@@ -556,3 +556,237 @@ func (s *KeeperTestSuite) TestValidatorConsPubKeyUpdate() {
 	require.Equal(int64(10), updates[1].Power)
 	require.Equal(newPubKey.Bytes(), updates[1].PubKey)
 }
+
+func (s *KeeperTestSuite) TestApplyAndReturnValidatorSetUpdatesWithPolicy() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	powers := []int64{100, 50, 20}
+	var validators [3]stakingtypes.Validator
+	for i, power := range powers {
+		validators[i] = testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validators[i], _ = validators[i].AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, power))
+		require.NoError(keeper.SetValidator(ctx, validators[i]))
+		require.NoError(keeper.SetValidatorByPowerIndex(ctx, validators[i]))
+	}
+
+	// only the first and last validators are allowed, with their power capped to 60
+	keeper.SetValidatorSetPolicy(stakingtypes.NewMultiValidatorSetPolicy(
+		stakingtypes.NewAllowlistValidatorSetPolicy(validators[0].GetOperator(), validators[2].GetOperator()),
+		stakingtypes.NewCappedPowerValidatorSetPolicy(60),
+	))
+
+	bondedTokens := validators[0].GetTokens().Add(validators[2].GetTokens())
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, bondedTokens)))
+	updates := s.applyValidatorSetUpdates(ctx, keeper, 2)
+	require.Equal(int64(60), updates[0].Power)
+	require.Equal(int64(20), updates[1].Power)
+
+	power, err := keeper.GetLastValidatorPower(ctx, sdk.ValAddress(PKs[0].Address().Bytes()))
+	require.NoError(err)
+	require.Equal(int64(60), power)
+
+	totalPower, err := keeper.LastTotalPower.Get(ctx)
+	require.NoError(err)
+	require.Equal(math.NewInt(80), totalPower)
+
+	validator, err := keeper.GetValidator(ctx, sdk.ValAddress(PKs[1].Address().Bytes()))
+	require.NoError(err)
+	require.True(validator.IsUnbonded())
+}
+
+func (s *KeeperTestSuite) TestApplyAndReturnValidatorSetUpdatesPowerCap() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MaxValidatorPowerRatio = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	powers := []int64{60, 30, 10}
+	var validators [3]stakingtypes.Validator
+	for i, power := range powers {
+		validators[i] = testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validators[i], _ = validators[i].AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, power))
+		require.NoError(keeper.SetValidator(ctx, validators[i]))
+		require.NoError(keeper.SetValidatorByPowerIndex(ctx, validators[i]))
+	}
+
+	// the excess power of the first validator is redistributed to the others
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	updates := s.applyValidatorSetUpdates(ctx, keeper, 3)
+	require.Equal(int64(50), updates[0].Power)
+	require.Equal(int64(37), updates[1].Power)
+	require.Equal(int64(12), updates[2].Power)
+
+	res, err := s.queryClient.ValidatorPower(ctx, &stakingtypes.QueryValidatorPowerRequest{ValidatorAddr: validators[0].GetOperator()})
+	require.NoError(err)
+	require.Equal(stakingtypes.ValidatorPower{ValidatorAddress: validators[0].GetOperator(), RawPower: 60, EffectivePower: 50}, res.Power)
+
+	powersRes, err := s.queryClient.ValidatorPowers(ctx, &stakingtypes.QueryValidatorPowersRequest{})
+	require.NoError(err)
+	require.Len(powersRes.Powers, 3)
+
+	effectiveTokens, err := keeper.GetEffectiveBondedTokens(ctx)
+	require.NoError(err)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 50), effectiveTokens[validators[0].GetOperator()])
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 10).MulRaw(5).QuoRaw(4), effectiveTokens[validators[2].GetOperator()])
+
+	// disabling the cap restores the raw power of the validators
+	params.MaxValidatorPowerRatio = math.LegacyOneDec()
+	require.NoError(keeper.Params.Set(ctx, params))
+	updates = s.applyValidatorSetUpdates(ctx, keeper, 3)
+	require.Equal(int64(60), updates[0].Power)
+
+	effectiveTokens, err = keeper.GetEffectiveBondedTokens(ctx)
+	require.NoError(err)
+	require.Nil(effectiveTokens)
+}
+
+func (s *KeeperTestSuite) TestApplyAndReturnValidatorSetUpdatesChurnLimit() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	powers := []int64{100, 20, 30, 10}
+	var validators [4]stakingtypes.Validator
+	for i, power := range powers {
+		validators[i] = testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validators[i], _ = validators[i].AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, power))
+	}
+
+	queuePosition := func(validator stakingtypes.Validator) (stakingtypes.ChurnQueue, uint64) {
+		res, err := s.queryClient.ValidatorChurnQueuePosition(ctx, &stakingtypes.QueryValidatorChurnQueuePositionRequest{ValidatorAddr: validator.GetOperator()})
+		require.NoError(err)
+		return res.Queue, res.Position
+	}
+
+	// the first validator set is not limited
+	require.NoError(keeper.SetValidator(ctx, validators[0]))
+	require.NoError(keeper.SetValidatorByPowerIndex(ctx, validators[0]))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	s.applyValidatorSetUpdates(ctx, keeper, 1)
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.ValidatorChurnLimit = math.LegacyNewDecWithPrec(25, 2)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	for _, validator := range validators[1:] {
+		require.NoError(keeper.SetValidator(ctx, validator))
+		require.NoError(keeper.SetValidatorByPowerIndex(ctx, validator))
+	}
+
+	// 25 of power can enter: the validator with 30 is skipped, and the one with 10 no longer fits
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	updates := s.applyValidatorSetUpdates(ctx, keeper, 1)
+	require.Equal(int64(20), updates[0].Power)
+
+	queues, err := s.queryClient.ValidatorChurnQueues(ctx, &stakingtypes.QueryValidatorChurnQueuesRequest{})
+	require.NoError(err)
+	require.Equal([]string{validators[2].GetOperator(), validators[3].GetOperator()}, queues.EntryQueue)
+	require.Empty(queues.ExitQueue)
+
+	queue, position := queuePosition(validators[3])
+	require.Equal(stakingtypes.ChurnQueue_CHURN_QUEUE_ENTRY, queue)
+	require.Equal(uint64(2), position)
+	queue, position = queuePosition(validators[1])
+	require.Equal(stakingtypes.ChurnQueue_CHURN_QUEUE_UNSPECIFIED, queue)
+	require.Zero(position)
+
+	// 30 of power can enter, the queued validators are processed first
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	updates = s.applyValidatorSetUpdates(ctx, keeper, 1)
+	require.Equal(int64(30), updates[0].Power)
+	queue, position = queuePosition(validators[3])
+	require.Equal(stakingtypes.ChurnQueue_CHURN_QUEUE_ENTRY, queue)
+	require.Equal(uint64(1), position)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	s.applyValidatorSetUpdates(ctx, keeper, 1)
+	_, err = keeper.ValidatorEntryQueue.Get(ctx)
+	require.ErrorIs(err, collections.ErrNotFound)
+
+	// 16 of power can leave: the first exit is processed, the next one is delayed
+	params.ValidatorChurnLimit = math.LegacyNewDecWithPrec(10, 2)
+	params.MaxValidators = 2
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+	updates = s.applyValidatorSetUpdates(ctx, keeper, 1)
+	require.Equal(validators[3].ModuleValidatorUpdateZero(), updates[0])
+
+	queue, position = queuePosition(validators[1])
+	require.Equal(stakingtypes.ChurnQueue_CHURN_QUEUE_EXIT, queue)
+	require.Equal(uint64(1), position)
+	validator, err := keeper.GetValidator(ctx, sdk.ValAddress(PKs[1].Address().Bytes()))
+	require.NoError(err)
+	require.True(validator.IsBonded())
+
+	// the head of the exit queue always leaves the set
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+	updates = s.applyValidatorSetUpdates(ctx, keeper, 1)
+	require.Equal(validators[1].ModuleValidatorUpdateZero(), updates[0])
+	queue, _ = queuePosition(validators[1])
+	require.Equal(stakingtypes.ChurnQueue_CHURN_QUEUE_UNSPECIFIED, queue)
+
+	totalPower, err := keeper.LastTotalPower.Get(ctx)
+	require.NoError(err)
+	require.Equal(math.NewInt(130), totalPower)
+}
+
+func (s *KeeperTestSuite) TestApplyAndReturnValidatorSetUpdatesChurnLimitPowerChanges() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	powers := []int64{100, 60}
+	var validators [2]stakingtypes.Validator
+	for i, power := range powers {
+		validators[i] = testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validators[i], _ = validators[i].AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, power))
+		require.NoError(keeper.SetValidator(ctx, validators[i]))
+		require.NoError(keeper.SetValidatorByPowerIndex(ctx, validators[i]))
+	}
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	s.applyValidatorSetUpdates(ctx, keeper, 2)
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.ValidatorChurnLimit = math.LegacyNewDecWithPrec(10, 2)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// the first validator gains 40 of power and the second one loses 20
+	for i, change := range []int64{40, -20} {
+		validator, err := keeper.GetValidator(ctx, sdk.ValAddress(PKs[i].Address().Bytes()))
+		require.NoError(err)
+		require.NoError(keeper.DeleteValidatorByPowerIndex(ctx, validator))
+		if change > 0 {
+			validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, change))
+		} else {
+			validator = validator.RemoveTokens(keeper.TokensFromConsensusPower(ctx, -change))
+		}
+		require.NoError(keeper.SetValidator(ctx, validator))
+		require.NoError(keeper.SetValidatorByPowerIndex(ctx, validator))
+	}
+
+	// 16 of power can change, shared in proportion to the changes of the validators
+	updates := s.applyValidatorSetUpdates(ctx, keeper, 2)
+	require.Equal(int64(110), updates[0].Power)
+	require.Equal(int64(55), updates[1].Power)
+
+	// the validators keep moving toward their power in the next blocks
+	updates = s.applyValidatorSetUpdates(ctx, keeper, 2)
+	require.Equal(int64(121), updates[0].Power)
+	require.Equal(int64(50), updates[1].Power)
+
+	for i := 0; i < 10; i++ {
+		if updates = s.applyValidatorSetUpdates(ctx, keeper, -1); len(updates) == 0 {
+			break
+		}
+	}
+	for i, power := range []int64{140, 40} {
+		lastPower, err := keeper.GetLastValidatorPower(ctx, sdk.ValAddress(PKs[i].Address().Bytes()))
+		require.NoError(err)
+		require.Equal(power, lastPower)
+	}
+}
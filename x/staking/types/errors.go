@@ -50,4 +50,12 @@ var (
 	// consensus key errors
 	ErrExceedingMaxConsPubKeyRotations = errors.Register(ModuleName, 46, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid       = errors.Register(ModuleName, 47, "consensus pubkey len is invalid")
+
+	// liquid staking errors
+	ErrTokenizeShareRecordNotExists      = errors.Register(ModuleName, 48, "tokenize share record not exists")
+	ErrNotTokenizeShareRecordOwner       = errors.Register(ModuleName, 49, "not tokenize share record owner")
+	ErrGlobalLiquidStakingCapExceeded    = errors.Register(ModuleName, 50, "tokenization exceeds the global liquid staking cap")
+	ErrValidatorLiquidStakingCapExceeded = errors.Register(ModuleName, 51, "tokenization exceeds the validator liquid staking cap")
+	ErrTokenizeSharesSelfDelegation      = errors.Register(ModuleName, 52, "validator operators cannot tokenize their self-delegation")
+	ErrRedelegationInProgress            = errors.Register(ModuleName, 53, "delegator is not allowed to tokenize shares from validator with a redelegation in progress")
 )
@@ -53,6 +53,16 @@ type GenesisState struct {
 	RotationIndexRecords []RotationIndexRecord       `protobuf:"bytes,9,rep,name=rotation_index_records,json=rotationIndexRecords,proto3" json:"rotation_index_records"`
 	RotationHistory      []ConsPubKeyRotationHistory `protobuf:"bytes,10,rep,name=rotation_history,json=rotationHistory,proto3" json:"rotation_history"`
 	RotationQueue        []RotationQueueRecord       `protobuf:"bytes,11,rep,name=rotation_queue,json=rotationQueue,proto3" json:"rotation_queue"`
+	// tokenize_share_records defines the records of the tokenized delegations.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,12,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last tokenize share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,13,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// validator_entry_queue contains the operator addresses of the validators waiting to enter the
+	// validator set, in processing order.
+	ValidatorEntryQueue []string `protobuf:"bytes,14,rep,name=validator_entry_queue,json=validatorEntryQueue,proto3" json:"validator_entry_queue,omitempty"`
+	// validator_exit_queue contains the operator addresses of the validators waiting to leave the
+	// validator set, in processing order.
+	ValidatorExitQueue []string `protobuf:"bytes,15,rep,name=validator_exit_queue,json=validatorExitQueue,proto3" json:"validator_exit_queue,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -158,6 +168,34 @@ func (m *GenesisState) GetRotationQueue() []RotationQueueRecord {
 	return nil
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetValidatorEntryQueue() []string {
+	if m != nil {
+		return m.ValidatorEntryQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorExitQueue() []string {
+	if m != nil {
+		return m.ValidatorExitQueue
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -301,52 +339,58 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0xbb, 0xfc, 0x29, 0xed, 0x14, 0xf8, 0xf1, 0x1b, 0x0a, 0x59, 0x1b, 0xd3, 0xd6, 0x86,
-	0x43, 0x83, 0x61, 0xd7, 0xa2, 0xf1, 0xe0, 0x8d, 0x6a, 0xa2, 0x04, 0x22, 0xb8, 0x80, 0x07, 0x12,
-	0xb3, 0x99, 0xb2, 0xc3, 0xb2, 0x61, 0xbb, 0x53, 0x67, 0xa6, 0x95, 0xbe, 0x03, 0x8f, 0xdc, 0xbd,
-	0x70, 0xf4, 0xe8, 0x81, 0x17, 0xc1, 0xc5, 0x84, 0x70, 0x32, 0x1e, 0xd0, 0xc0, 0x41, 0x5f, 0x86,
-	0xd9, 0x99, 0xdd, 0x65, 0x9a, 0xb2, 0x44, 0x2f, 0x4d, 0xa7, 0xcf, 0xf7, 0xfb, 0xf9, 0x3e, 0xcf,
-	0x76, 0xe7, 0x01, 0x0b, 0x7b, 0x84, 0xb5, 0x09, 0x33, 0x19, 0x47, 0x87, 0x5e, 0xe0, 0x9a, 0xbd,
-	0x46, 0x0b, 0x73, 0xd4, 0x30, 0x5d, 0x1c, 0x60, 0xe6, 0x31, 0xa3, 0x43, 0x09, 0x27, 0x70, 0x5e,
-	0xaa, 0x8c, 0x48, 0x65, 0x44, 0xaa, 0x52, 0xd1, 0x25, 0x2e, 0x11, 0x12, 0x33, 0xfc, 0x26, 0xd5,
-	0xa5, 0x34, 0x66, 0xec, 0x96, 0xaa, 0x7b, 0x52, 0x65, 0x4b, 0x7b, 0x14, 0x20, 0x4b, 0xff, 0xa3,
-	0xb6, 0x17, 0x10, 0x53, 0x7c, 0x46, 0x3f, 0x55, 0x5c, 0x42, 0x5c, 0x1f, 0x9b, 0xe2, 0xd4, 0xea,
-	0xee, 0x9b, 0xdc, 0x6b, 0x63, 0xc6, 0x51, 0xbb, 0x23, 0x05, 0xb5, 0xaf, 0x13, 0x60, 0xf2, 0xa5,
-	0x6c, 0x7a, 0x8b, 0x23, 0x8e, 0xe1, 0x0a, 0xc8, 0x76, 0x10, 0x45, 0x6d, 0xa6, 0x6b, 0x55, 0xad,
-	0x5e, 0x58, 0x2e, 0x1b, 0xb7, 0x0f, 0x61, 0x6c, 0x0a, 0x55, 0x33, 0x7f, 0x76, 0x59, 0xc9, 0x7c,
-	0xfe, 0xf5, 0x65, 0x51, 0xb3, 0x22, 0x23, 0xdc, 0x05, 0x33, 0x3e, 0x62, 0xdc, 0xe6, 0x84, 0x23,
-	0xdf, 0xee, 0x90, 0x0f, 0x98, 0xea, 0x23, 0x55, 0xad, 0x3e, 0xd9, 0x7c, 0x14, 0x8a, 0xbf, 0x5f,
-	0x56, 0xe6, 0x24, 0x93, 0x39, 0x87, 0x86, 0x47, 0xcc, 0x36, 0xe2, 0x07, 0xc6, 0x6a, 0xc0, 0x2f,
-	0x4e, 0x97, 0x40, 0x14, 0xb6, 0x1a, 0x70, 0xc9, 0x9c, 0x0e, 0x49, 0xdb, 0x21, 0x68, 0x33, 0xe4,
-	0x40, 0x0f, 0xcc, 0x09, 0x76, 0x0f, 0xf9, 0x9e, 0x83, 0x38, 0xa1, 0x92, 0xcf, 0xf4, 0xd1, 0xea,
-	0x68, 0xbd, 0xb0, 0xbc, 0x98, 0xd6, 0xed, 0x3a, 0x62, 0xfc, 0x6d, 0xec, 0x11, 0x28, 0xb5, 0xf3,
-	0x59, 0x7f, 0xa8, 0xcc, 0xe0, 0x3a, 0x00, 0x49, 0x0a, 0xd3, 0xc7, 0x04, 0xff, 0x41, 0x1a, 0x3f,
-	0x31, 0xab, 0x58, 0xc5, 0x0f, 0x37, 0x40, 0xc1, 0xc1, 0x3e, 0x76, 0x11, 0xf7, 0x48, 0xc0, 0xf4,
-	0x71, 0x81, 0xab, 0xa5, 0xe1, 0x5e, 0x24, 0x52, 0x95, 0xa7, 0x12, 0xe0, 0x21, 0x98, 0xeb, 0x06,
-	0x2d, 0x12, 0x38, 0x5e, 0xe0, 0xda, 0x2a, 0x3a, 0x2b, 0xd0, 0x0f, 0xd3, 0xd0, 0x3b, 0xb1, 0xe9,
-	0xf6, 0x8c, 0x62, 0x77, 0xb8, 0xce, 0xe0, 0x0e, 0x98, 0xa2, 0x58, 0x0d, 0x99, 0x10, 0x21, 0x0b,
-	0x69, 0x21, 0x96, 0x22, 0x56, 0xe9, 0x83, 0x14, 0x58, 0x02, 0x39, 0x7c, 0xd4, 0x21, 0x94, 0x63,
-	0x47, 0xcf, 0x55, 0xb5, 0x7a, 0xce, 0x4a, 0xce, 0xd0, 0x07, 0xf3, 0x94, 0x70, 0x21, 0xb4, 0xbd,
-	0xc0, 0xc1, 0x47, 0x36, 0xc5, 0x7b, 0x84, 0x3a, 0x4c, 0xcf, 0xdf, 0x3d, 0xa0, 0x15, 0xb9, 0x56,
-	0x43, 0x93, 0x25, 0x3c, 0x03, 0x03, 0xd2, 0xe1, 0x3a, 0x83, 0x2e, 0x98, 0x49, 0xd2, 0x0e, 0x3c,
-	0xc6, 0x09, 0xed, 0xeb, 0x40, 0xe4, 0x34, 0xd2, 0x72, 0x9e, 0x93, 0x80, 0x6d, 0x76, 0x5b, 0x6b,
-	0xb8, 0x1f, 0x27, 0xbe, 0x92, 0x46, 0x35, 0xed, 0x3f, 0x3a, 0x58, 0x83, 0xef, 0xc0, 0x74, 0x12,
-	0xf4, 0xbe, 0x8b, 0xbb, 0x58, 0x2f, 0xfc, 0xdd, 0x38, 0x6f, 0x42, 0xf1, 0xf0, 0x38, 0x53, 0x54,
-	0xad, 0xd7, 0x0e, 0x00, 0x1c, 0x7e, 0xd5, 0xe1, 0x32, 0x98, 0x40, 0x8e, 0x43, 0x31, 0x93, 0xb7,
-	0x3a, 0xdf, 0xd4, 0x2f, 0x4e, 0x97, 0x8a, 0x51, 0xe0, 0x8a, 0xac, 0x6c, 0x71, 0xea, 0x05, 0xae,
-	0x15, 0x0b, 0x61, 0x11, 0x8c, 0xdf, 0x5c, 0xdd, 0x51, 0x4b, 0x1e, 0x9e, 0xe5, 0x3e, 0x9e, 0x54,
-	0x32, 0xbf, 0x4f, 0x2a, 0x99, 0x1a, 0x01, 0xb3, 0xb7, 0x3c, 0x69, 0xa8, 0x0f, 0x46, 0x4d, 0xde,
-	0x00, 0x9f, 0x80, 0xb1, 0x70, 0xfb, 0xe8, 0x59, 0xb1, 0x57, 0x4a, 0x86, 0x5c, 0x4d, 0x46, 0xbc,
-	0x9a, 0x8c, 0xed, 0x78, 0x35, 0x35, 0xc7, 0x8e, 0x7f, 0x54, 0x34, 0x4b, 0xa8, 0x95, 0xc0, 0x4f,
-	0xda, 0x4d, 0xa2, 0xf2, 0x30, 0xe0, 0x6b, 0x90, 0xef, 0x21, 0xdf, 0x0e, 0x63, 0xe2, 0xa5, 0xd5,
-	0xb8, 0xe3, 0x9a, 0x86, 0xe3, 0xb2, 0x8d, 0x7d, 0x41, 0xc2, 0x4e, 0xf8, 0x27, 0xae, 0xe1, 0x3e,
-	0xb3, 0x72, 0xbd, 0xa8, 0x94, 0xf4, 0x39, 0xf2, 0x2f, 0x7d, 0x36, 0x9f, 0x9e, 0x5d, 0x95, 0xb5,
-	0xf3, 0xab, 0xb2, 0xf6, 0xf3, 0xaa, 0xac, 0x1d, 0x5f, 0x97, 0x33, 0xe7, 0xd7, 0xe5, 0xcc, 0xb7,
-	0xeb, 0x72, 0x66, 0xf7, 0xfe, 0xc0, 0xb2, 0x3b, 0x4a, 0xf6, 0x3b, 0xef, 0x77, 0x30, 0x6b, 0x65,
-	0x05, 0xf7, 0xf1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0x7b, 0xa0, 0x65, 0x52, 0x06, 0x00,
-	0x00,
+	// 816 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x41, 0x6f, 0xe2, 0x46,
+	0x14, 0xc7, 0xf1, 0xc2, 0xb2, 0x30, 0x90, 0xec, 0x76, 0x02, 0x2b, 0x2f, 0xea, 0x02, 0x8b, 0xf6,
+	0x80, 0xb6, 0x8a, 0x5d, 0xd2, 0xaa, 0x87, 0x9e, 0x1a, 0xda, 0xaa, 0x45, 0x89, 0x9a, 0xd4, 0x24,
+	0x3d, 0x44, 0xaa, 0xac, 0x21, 0x9e, 0x98, 0x11, 0xc6, 0x43, 0x67, 0x06, 0x0a, 0xfd, 0x04, 0x3d,
+	0xe6, 0xde, 0x4b, 0x8e, 0xed, 0xad, 0x87, 0x7c, 0x88, 0x1c, 0xa3, 0x9c, 0xaa, 0x1e, 0xd2, 0x2a,
+	0x39, 0xb4, 0x1f, 0xa3, 0xf2, 0x8c, 0x6d, 0x8c, 0x80, 0x28, 0xbd, 0x44, 0x31, 0xef, 0xff, 0xff,
+	0xfd, 0xdf, 0x1b, 0x79, 0x9e, 0xc1, 0xdb, 0x53, 0xca, 0x87, 0x94, 0x9b, 0x5c, 0xa0, 0x01, 0xf1,
+	0x5d, 0x73, 0xd2, 0xea, 0x61, 0x81, 0x5a, 0xa6, 0x8b, 0x7d, 0xcc, 0x09, 0x37, 0x46, 0x8c, 0x0a,
+	0x0a, 0x5f, 0x2a, 0x95, 0x11, 0xaa, 0x8c, 0x50, 0x55, 0x29, 0xb9, 0xd4, 0xa5, 0x52, 0x62, 0x06,
+	0xff, 0x29, 0x75, 0x65, 0x1d, 0x33, 0x72, 0x2b, 0xd5, 0x2b, 0xa5, 0xb2, 0x95, 0x3d, 0x0c, 0x50,
+	0xa5, 0xf7, 0xd0, 0x90, 0xf8, 0xd4, 0x94, 0x7f, 0xc3, 0x9f, 0x6a, 0x2e, 0xa5, 0xae, 0x87, 0x4d,
+	0xf9, 0xd4, 0x1b, 0x9f, 0x99, 0x82, 0x0c, 0x31, 0x17, 0x68, 0x38, 0x52, 0x82, 0xc6, 0x6f, 0x00,
+	0x14, 0xbf, 0x52, 0x4d, 0x77, 0x05, 0x12, 0x18, 0xee, 0x82, 0xec, 0x08, 0x31, 0x34, 0xe4, 0xba,
+	0x56, 0xd7, 0x9a, 0x85, 0x9d, 0xaa, 0xb1, 0x7a, 0x08, 0xe3, 0x50, 0xaa, 0xda, 0xf9, 0xab, 0xdb,
+	0x5a, 0xea, 0xd7, 0x7f, 0x7e, 0x7f, 0xa7, 0x59, 0xa1, 0x11, 0x9e, 0x80, 0x17, 0x1e, 0xe2, 0xc2,
+	0x16, 0x54, 0x20, 0xcf, 0x1e, 0xd1, 0x1f, 0x31, 0xd3, 0x9f, 0xd4, 0xb5, 0x66, 0xb1, 0xfd, 0x61,
+	0x20, 0xfe, 0xf3, 0xb6, 0x56, 0x56, 0x4c, 0xee, 0x0c, 0x0c, 0x42, 0xcd, 0x21, 0x12, 0x7d, 0xa3,
+	0xe3, 0x8b, 0x9b, 0xcb, 0x6d, 0x10, 0x86, 0x75, 0x7c, 0xa1, 0x98, 0x9b, 0x01, 0xe9, 0x28, 0x00,
+	0x1d, 0x06, 0x1c, 0x48, 0x40, 0x59, 0xb2, 0x27, 0xc8, 0x23, 0x0e, 0x12, 0x94, 0x29, 0x3e, 0xd7,
+	0xd3, 0xf5, 0x74, 0xb3, 0xb0, 0xf3, 0x6e, 0x5d, 0xb7, 0xfb, 0x88, 0x8b, 0xef, 0x22, 0x8f, 0x44,
+	0x25, 0x3b, 0xdf, 0xf2, 0x96, 0xca, 0x1c, 0xee, 0x03, 0x10, 0xa7, 0x70, 0x3d, 0x23, 0xf9, 0x6f,
+	0xd6, 0xf1, 0x63, 0x73, 0x12, 0x9b, 0xf0, 0xc3, 0x03, 0x50, 0x70, 0xb0, 0x87, 0x5d, 0x24, 0x08,
+	0xf5, 0xb9, 0xfe, 0x54, 0xe2, 0x1a, 0xeb, 0x70, 0x5f, 0xc4, 0xd2, 0x24, 0x2f, 0x49, 0x80, 0x03,
+	0x50, 0x1e, 0xfb, 0x3d, 0xea, 0x3b, 0xc4, 0x77, 0xed, 0x24, 0x3a, 0x2b, 0xd1, 0x1f, 0xac, 0x43,
+	0x1f, 0x47, 0xa6, 0xd5, 0x19, 0xa5, 0xf1, 0x72, 0x9d, 0xc3, 0x63, 0xb0, 0xc1, 0x70, 0x32, 0xe4,
+	0x99, 0x0c, 0x79, 0xbb, 0x2e, 0xc4, 0x4a, 0x88, 0x93, 0xf4, 0x45, 0x0a, 0xac, 0x80, 0x1c, 0x9e,
+	0x8e, 0x28, 0x13, 0xd8, 0xd1, 0x73, 0x75, 0xad, 0x99, 0xb3, 0xe2, 0x67, 0xe8, 0x81, 0x97, 0x8c,
+	0x0a, 0x29, 0xb4, 0x89, 0xef, 0xe0, 0xa9, 0xcd, 0xf0, 0x29, 0x65, 0x0e, 0xd7, 0xf3, 0x0f, 0x0f,
+	0x68, 0x85, 0xae, 0x4e, 0x60, 0xb2, 0xa4, 0x67, 0x61, 0x40, 0xb6, 0x5c, 0xe7, 0xd0, 0x05, 0x2f,
+	0xe2, 0xb4, 0x3e, 0xe1, 0x82, 0xb2, 0x99, 0x0e, 0x64, 0x4e, 0x6b, 0x5d, 0xce, 0xe7, 0xd4, 0xe7,
+	0x87, 0xe3, 0xde, 0x1e, 0x9e, 0x45, 0x89, 0x5f, 0x2b, 0x63, 0x32, 0xed, 0x39, 0x5b, 0xac, 0xc1,
+	0xef, 0xc1, 0x66, 0x1c, 0xf4, 0xc3, 0x18, 0x8f, 0xb1, 0x5e, 0x78, 0xdc, 0x38, 0xdf, 0x06, 0xe2,
+	0xe5, 0x71, 0x36, 0x58, 0xb2, 0x1e, 0x9c, 0x9a, 0xa0, 0x03, 0xec, 0x93, 0x9f, 0xb0, 0xcd, 0xfb,
+	0x88, 0xe1, 0xf8, 0xd4, 0x8a, 0x0f, 0xc7, 0x1c, 0x85, 0xae, 0x6e, 0x60, 0x5a, 0x71, 0x6a, 0x62,
+	0xb9, 0xce, 0xe1, 0x67, 0xe0, 0x75, 0x78, 0xd3, 0x57, 0x44, 0xda, 0xc4, 0xd1, 0x37, 0xea, 0x5a,
+	0x33, 0x63, 0xbd, 0x52, 0x97, 0x78, 0x09, 0xd0, 0x71, 0xe0, 0x31, 0x28, 0xcf, 0xaf, 0x32, 0xf6,
+	0x05, 0x9b, 0x85, 0xa7, 0xb2, 0x59, 0x4f, 0x37, 0xf3, 0xed, 0x37, 0x37, 0x97, 0xdb, 0xaf, 0xc3,
+	0x8e, 0xe3, 0x2b, 0xb6, 0xeb, 0x38, 0x0c, 0x73, 0xde, 0x15, 0x8c, 0xf8, 0xae, 0xb5, 0x15, 0xfb,
+	0xbf, 0x0c, 0xec, 0xea, 0x18, 0xba, 0xa0, 0x94, 0xc0, 0x4e, 0x89, 0x08, 0xa9, 0xcf, 0x1f, 0x4b,
+	0x85, 0x73, 0xea, 0x94, 0x08, 0x09, 0x6d, 0xf4, 0x01, 0x5c, 0x5e, 0x23, 0x70, 0x07, 0x3c, 0x43,
+	0xca, 0x2a, 0x37, 0x66, 0xbe, 0xad, 0xdf, 0x5c, 0x6e, 0x97, 0x42, 0xfa, 0x22, 0x34, 0x12, 0xc2,
+	0x12, 0x78, 0x3a, 0x5f, 0x8b, 0x69, 0x4b, 0x3d, 0x7c, 0x9a, 0xfb, 0xf9, 0xa2, 0x96, 0xfa, 0xf7,
+	0xa2, 0x96, 0x6a, 0x50, 0xb0, 0xb5, 0xe2, 0x2d, 0x86, 0xfa, 0x62, 0x54, 0x71, 0x0e, 0xfc, 0x18,
+	0x64, 0x82, 0xcd, 0xae, 0x67, 0xe5, 0xce, 0xae, 0x18, 0x6a, 0xed, 0x1b, 0xd1, 0xda, 0x37, 0x8e,
+	0xa2, 0xb5, 0xdf, 0xce, 0x9c, 0xff, 0x55, 0xd3, 0x2c, 0xa9, 0x4e, 0x04, 0xfe, 0xa2, 0xcd, 0x13,
+	0x13, 0x2f, 0x1a, 0xfc, 0x06, 0xe4, 0x27, 0xc8, 0xb3, 0x83, 0x98, 0xe8, 0x83, 0xd0, 0x7a, 0x60,
+	0x05, 0x06, 0xe3, 0xf2, 0x83, 0x33, 0x49, 0xc2, 0x4e, 0x70, 0x41, 0xf6, 0xf0, 0x8c, 0x5b, 0xb9,
+	0x49, 0x58, 0x8a, 0xfb, 0x7c, 0xf2, 0x7f, 0xfa, 0x6c, 0x7f, 0x72, 0x75, 0x57, 0xd5, 0xae, 0xef,
+	0xaa, 0xda, 0xdf, 0x77, 0x55, 0xed, 0xfc, 0xbe, 0x9a, 0xba, 0xbe, 0xaf, 0xa6, 0xfe, 0xb8, 0xaf,
+	0xa6, 0x4e, 0xde, 0x5f, 0xf8, 0x90, 0x4c, 0xe3, 0x6f, 0xa7, 0x98, 0x8d, 0x30, 0xef, 0x65, 0x25,
+	0xf7, 0xa3, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x9a, 0x63, 0xb2, 0xae, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -369,6 +413,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorExitQueue) > 0 {
+		for iNdEx := len(m.ValidatorExitQueue) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorExitQueue[iNdEx])
+			copy(dAtA[i:], m.ValidatorExitQueue[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorExitQueue[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ValidatorEntryQueue) > 0 {
+		for iNdEx := len(m.ValidatorEntryQueue) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorEntryQueue[iNdEx])
+			copy(dAtA[i:], m.ValidatorEntryQueue[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorEntryQueue[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.RotationQueue) > 0 {
 		for iNdEx := len(m.RotationQueue) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -706,6 +787,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	if len(m.ValidatorEntryQueue) > 0 {
+		for _, s := range m.ValidatorEntryQueue {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorExitQueue) > 0 {
+		for _, s := range m.ValidatorExitQueue {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1152,6 +1254,123 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorEntryQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorEntryQueue = append(m.ValidatorEntryQueue, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorExitQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorExitQueue = append(m.ValidatorExitQueue, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
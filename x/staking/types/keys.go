@@ -63,6 +63,16 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	ConsAddrToValidatorIdentifierMapPrefix      = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsAddrMap                         = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	TokenizeShareRecordPrefix    = collections.NewPrefix(107) // key for the tokenize share records
+	TokenizeShareRecordIDByOwner = collections.NewPrefix(108) // prefix for the tokenize share record ids by owner
+	LastTokenizeShareRecordIDKey = collections.NewPrefix(109) // key for the id of the last tokenize share record
+	ValidatorLiquidSharesKey     = collections.NewPrefix(110) // prefix for the tokenized delegator shares of each validator
+
+	ValidatorEntryQueueKey = collections.NewPrefix(111) // key for the validators waiting to enter the validator set
+	ValidatorExitQueueKey  = collections.NewPrefix(112) // key for the validators waiting to leave the validator set
+
+	ValidatorTokenPowerKey = collections.NewPrefix(114) // prefix for the history of the token power of the validators bonded with another power
 )
 
 // Reserved kvstore keys
@@ -30,6 +30,22 @@ const (
 // DefaultMinCommissionRate is set to 0%
 var DefaultMinCommissionRate = math.LegacyZeroDec()
 
+var (
+	// DefaultGlobalLiquidStakingCap is set to 100%, which disables the global cap
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, which disables the validator cap
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+)
+
+var (
+	// DefaultMaxValidatorPowerRatio is set to 100%, which disables the validator power cap
+	DefaultMaxValidatorPowerRatio = math.LegacyOneDec()
+
+	// DefaultValidatorChurnLimit is set to 100%, which disables the validator churn limit
+	DefaultValidatorChurnLimit = math.LegacyOneDec()
+)
+
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration,
 	maxValidators, maxEntries uint32,
@@ -44,6 +60,11 @@ func NewParams(unbondingTime time.Duration,
 		BondDenom:         bondDenom,
 		MinCommissionRate: minCommissionRate,
 		KeyRotationFee:    keyRotationFee,
+
+		GlobalLiquidStakingCap:    DefaultGlobalLiquidStakingCap,
+		ValidatorLiquidStakingCap: DefaultValidatorLiquidStakingCap,
+		MaxValidatorPowerRatio:    DefaultMaxValidatorPowerRatio,
+		ValidatorChurnLimit:       DefaultValidatorChurnLimit,
 	}
 }
 
@@ -109,6 +130,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLiquidStakingCap("global", p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap("validator", p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateMaxValidatorPowerRatio(p.MaxValidatorPowerRatio); err != nil {
+		return err
+	}
+
+	if err := validateValidatorChurnLimit(p.ValidatorChurnLimit); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -221,3 +258,45 @@ func validateKeyRotationFee(bondDenom string, coin sdk.Coin) error {
 
 	return nil
 }
+
+func validateLiquidStakingCap(name string, v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("%s liquid staking cap cannot be nil: %s", name, v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("%s liquid staking cap cannot be negative: %s", name, v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s liquid staking cap cannot be greater than 100%%: %s", name, v)
+	}
+
+	return nil
+}
+
+func validateMaxValidatorPowerRatio(v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("max validator power ratio cannot be nil: %s", v)
+	}
+	if !v.IsPositive() {
+		return fmt.Errorf("max validator power ratio must be positive: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max validator power ratio cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateValidatorChurnLimit(v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("validator churn limit cannot be nil: %s", v)
+	}
+	if !v.IsPositive() {
+		return fmt.Errorf("validator churn limit must be positive: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("validator churn limit cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
@@ -47,3 +47,47 @@ func TestValidateParams(t *testing.T) {
 	params.KeyRotationFee = coinZero
 	require.Error(t, params.Validate())
 }
+
+func TestValidateLiquidStakingCaps(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.GlobalLiquidStakingCap.Equal(math.LegacyOneDec()))
+	require.True(t, params.ValidatorLiquidStakingCap.Equal(math.LegacyOneDec()))
+
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(-1, 1)
+	require.ErrorContains(t, params.Validate(), "global liquid staking cap cannot be negative")
+
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(11, 1)
+	require.ErrorContains(t, params.Validate(), "validator liquid staking cap cannot be greater than 100%")
+
+	params.ValidatorLiquidStakingCap = math.LegacyDec{}
+	require.ErrorContains(t, params.Validate(), "validator liquid staking cap cannot be nil")
+}
+
+func TestValidateMaxValidatorPowerRatio(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.MaxValidatorPowerRatio.Equal(math.LegacyOneDec()))
+
+	params.MaxValidatorPowerRatio = math.LegacyZeroDec()
+	require.ErrorContains(t, params.Validate(), "max validator power ratio must be positive")
+
+	params.MaxValidatorPowerRatio = math.LegacyNewDecWithPrec(11, 1)
+	require.ErrorContains(t, params.Validate(), "max validator power ratio cannot be greater than 100%")
+
+	params.MaxValidatorPowerRatio = math.LegacyNewDecWithPrec(2, 1)
+	require.NoError(t, params.Validate())
+}
+
+func TestValidateValidatorChurnLimit(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.ValidatorChurnLimit.Equal(math.LegacyOneDec()))
+
+	params.ValidatorChurnLimit = math.LegacyNewDecWithPrec(-1, 1)
+	require.ErrorContains(t, params.Validate(), "validator churn limit must be positive")
+
+	params.ValidatorChurnLimit = math.LegacyNewDecWithPrec(2, 0)
+	require.ErrorContains(t, params.Validate(), "validator churn limit cannot be greater than 100%")
+
+	params.ValidatorChurnLimit = math.LegacyNewDecWithPrec(5, 2)
+	require.NoError(t, params.Validate())
+}
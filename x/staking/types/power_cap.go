@@ -0,0 +1,73 @@
+package types
+
+import "cosmossdk.io/math"
+
+// CapPowers caps each of the powers to maxRatio of their total. The excess power of the capped
+// powers is redistributed to the other ones in proportion to their value, capping again the ones
+// that end up above the cap, so that the total power is preserved up to the truncation of the
+// results. When the cap cannot be met, i.e. when maxRatio*len(powers) <= 1, all the powers are
+// set to an equal share of the total. A maxRatio of 100% or more leaves the powers unchanged.
+func CapPowers(powers []math.Int, maxRatio math.LegacyDec) []math.Int {
+	capped := make([]math.Int, len(powers))
+	copy(capped, powers)
+
+	if len(powers) == 0 || maxRatio.GTE(math.LegacyOneDec()) {
+		return capped
+	}
+
+	total := math.ZeroInt()
+	for _, power := range powers {
+		total = total.Add(power)
+	}
+	if total.IsZero() {
+		return capped
+	}
+
+	if maxRatio.MulInt64(int64(len(powers))).LTE(math.LegacyOneDec()) {
+		share := total.QuoRaw(int64(len(powers)))
+		for i := range capped {
+			capped[i] = share
+		}
+		return capped
+	}
+
+	maxPower := maxRatio.MulInt(total)
+	isCapped := make([]bool, len(powers))
+	for {
+		// the power left to the uncapped powers once the capped ones are set to maxPower
+		remaining, uncappedTotal := math.LegacyNewDecFromInt(total), math.LegacyZeroDec()
+		for i, power := range powers {
+			if isCapped[i] {
+				remaining = remaining.Sub(maxPower)
+			} else {
+				uncappedTotal = uncappedTotal.Add(math.LegacyNewDecFromInt(power))
+			}
+		}
+
+		done := true
+		scaled := make([]math.LegacyDec, len(powers))
+		for i, power := range powers {
+			if isCapped[i] || uncappedTotal.IsZero() {
+				continue
+			}
+
+			scaled[i] = math.LegacyNewDecFromInt(power).Mul(remaining).Quo(uncappedTotal)
+			if scaled[i].GT(maxPower) {
+				isCapped[i] = true
+				done = false
+			}
+		}
+
+		if done {
+			for i := range powers {
+				switch {
+				case isCapped[i]:
+					capped[i] = maxPower.TruncateInt()
+				case !uncappedTotal.IsZero():
+					capped[i] = scaled[i].TruncateInt()
+				}
+			}
+			return capped
+		}
+	}
+}
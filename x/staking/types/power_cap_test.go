@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+)
+
+func TestCapPowers(t *testing.T) {
+	ints := func(powers ...int64) []math.Int {
+		res := make([]math.Int, len(powers))
+		for i, power := range powers {
+			res[i] = math.NewInt(power)
+		}
+		return res
+	}
+
+	testCases := []struct {
+		name     string
+		powers   []math.Int
+		maxRatio math.LegacyDec
+		expected []math.Int
+	}{
+		{
+			name:     "disabled cap",
+			powers:   ints(70, 20, 10),
+			maxRatio: math.LegacyOneDec(),
+			expected: ints(70, 20, 10),
+		},
+		{
+			name:     "no power above the cap",
+			powers:   ints(40, 30, 30),
+			maxRatio: math.LegacyNewDecWithPrec(5, 1),
+			expected: ints(40, 30, 30),
+		},
+		{
+			name:     "excess redistributed proportionally",
+			powers:   ints(60, 30, 10),
+			maxRatio: math.LegacyNewDecWithPrec(5, 1),
+			expected: ints(50, 37, 12),
+		},
+		{
+			name:     "redistribution capping another power",
+			powers:   ints(70, 25, 5),
+			maxRatio: math.LegacyNewDecWithPrec(4, 1),
+			expected: ints(40, 40, 20),
+		},
+		{
+			name:     "unreachable cap",
+			powers:   ints(70, 20, 10),
+			maxRatio: math.LegacyNewDecWithPrec(2, 1),
+			expected: ints(33, 33, 33),
+		},
+		{
+			name:     "no powers",
+			powers:   ints(),
+			maxRatio: math.LegacyNewDecWithPrec(2, 1),
+			expected: ints(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.CapPowers(tc.powers, tc.maxRatio))
+		})
+	}
+}
@@ -108,6 +108,38 @@ func (Infraction) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_64c30c6cf92913c9, []int{1}
 }
 
+// ChurnQueue is the queue of the validators waiting to enter or leave the validator set.
+type ChurnQueue int32
+
+const (
+	// UNSPECIFIED defines a validator that is not queued.
+	ChurnQueue_CHURN_QUEUE_UNSPECIFIED ChurnQueue = 0
+	// ENTRY defines the queue of the validators waiting to enter the validator set.
+	ChurnQueue_CHURN_QUEUE_ENTRY ChurnQueue = 1
+	// EXIT defines the queue of the validators waiting to leave the validator set.
+	ChurnQueue_CHURN_QUEUE_EXIT ChurnQueue = 2
+)
+
+var ChurnQueue_name = map[int32]string{
+	0: "CHURN_QUEUE_UNSPECIFIED",
+	1: "CHURN_QUEUE_ENTRY",
+	2: "CHURN_QUEUE_EXIT",
+}
+
+var ChurnQueue_value = map[string]int32{
+	"CHURN_QUEUE_UNSPECIFIED": 0,
+	"CHURN_QUEUE_ENTRY":       1,
+	"CHURN_QUEUE_EXIT":        2,
+}
+
+func (x ChurnQueue) String() string {
+	return proto.EnumName(ChurnQueue_name, int32(x))
+}
+
+func (ChurnQueue) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{2}
+}
+
 // HistoricalInfo contains header and validator information for a given block.
 // It is stored as part of staking module's state, which persists the `n` most
 // recent HistoricalInfo
@@ -1000,6 +1032,20 @@ type Params struct {
 	// key_rotation_fee is fee to be spent when rotating validator's key
 	// (either consensus pubkey or operator key)
 	KeyRotationFee types.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded tokens that can be
+	// tokenized into liquid staking share tokens.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegator shares of a validator
+	// that can be tokenized into liquid staking share tokens.
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
+	// max_validator_power_ratio is the maximum fraction of the total consensus power of the
+	// validator set that a single validator can hold. The excess power of the capped validators
+	// is redistributed to the others in proportion to their power.
+	MaxValidatorPowerRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=max_validator_power_ratio,json=maxValidatorPowerRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_validator_power_ratio"`
+	// validator_churn_limit is the maximum fraction of the total consensus power of the validator set
+	// that can enter the set in a block, and the maximum fraction that can leave it. The validators
+	// above the limit are queued until the next blocks.
+	ValidatorChurnLimit cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=validator_churn_limit,json=validatorChurnLimit,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_churn_limit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1416,9 +1462,85 @@ func (m *ValAddrsOfRotatedConsKeys) GetAddresses() [][]byte {
 	return nil
 }
 
+// TokenizeShareRecord represents a delegation tokenized into liquid staking share tokens.
+// The tokenized delegation is held by the module account of the record, and the rewards it
+// accrues belong to the owner of the record.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address receiving the rewards of the tokenized delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the tokenized delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the address of the validator of the tokenized delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
+	proto.RegisterEnum("cosmos.staking.v1beta1.ChurnQueue", ChurnQueue_name, ChurnQueue_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
 	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
 	proto.RegisterType((*Commission)(nil), "cosmos.staking.v1beta1.Commission")
@@ -1443,6 +1565,7 @@ func init() {
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
 	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
 	proto.RegisterType((*ValAddrsOfRotatedConsKeys)(nil), "cosmos.staking.v1beta1.ValAddrsOfRotatedConsKeys")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 }
 
 func init() {
@@ -1450,142 +1573,156 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2154 bytes of a gzipped FileDescriptorProto
+	// 2381 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
-	0x19, 0xd6, 0x92, 0x0c, 0x25, 0xfe, 0x14, 0x45, 0x6a, 0xfc, 0xa2, 0xe8, 0x58, 0x92, 0x19, 0x27,
-	0x91, 0xdd, 0x98, 0x8c, 0xdc, 0xc2, 0x05, 0x84, 0x20, 0x85, 0x29, 0xca, 0x36, 0x93, 0x58, 0x52,
-	0x97, 0xa2, 0xfa, 0x40, 0x9b, 0xc5, 0x70, 0x77, 0x48, 0x6d, 0x45, 0xee, 0xb2, 0x3b, 0x43, 0xd9,
-	0xbc, 0xf7, 0x10, 0xb8, 0x28, 0x90, 0x53, 0x91, 0x8b, 0x11, 0x03, 0xbd, 0xb4, 0xb7, 0x1c, 0x8c,
-	0xde, 0x7b, 0x4b, 0x0b, 0x14, 0x30, 0x7c, 0x2a, 0x02, 0xd4, 0x2d, 0xec, 0x43, 0x82, 0xe6, 0xd2,
-	0xf6, 0xd4, 0x63, 0x31, 0x8f, 0x7d, 0x50, 0x14, 0x2d, 0x4b, 0xf6, 0x21, 0x68, 0x2f, 0xc4, 0xce,
-	0xcc, 0xff, 0x7f, 0x33, 0xff, 0x7b, 0xe6, 0x27, 0x5c, 0x30, 0x5d, 0xda, 0x75, 0x69, 0x99, 0x32,
-	0xbc, 0x6b, 0x3b, 0xed, 0xf2, 0xde, 0x72, 0x93, 0x30, 0xbc, 0xec, 0x8f, 0x4b, 0x3d, 0xcf, 0x65,
-	0x2e, 0x3a, 0x2d, 0xa9, 0x4a, 0xfe, 0xac, 0xa2, 0x2a, 0xcc, 0xe2, 0xae, 0xed, 0xb8, 0x65, 0xf1,
-	0x2b, 0x49, 0x0b, 0xaf, 0x9a, 0x6e, 0x97, 0xb0, 0x66, 0x8b, 0x95, 0x71, 0xd3, 0xb4, 0xcb, 0x7b,
-	0xcb, 0x65, 0x36, 0xe8, 0x11, 0xaa, 0x56, 0xcf, 0x05, 0xab, 0x62, 0x76, 0xff, 0xf2, 0xbc, 0x3a,
-	0x4d, 0x13, 0x53, 0x12, 0x1c, 0xc5, 0x74, 0x6d, 0x47, 0xad, 0xcf, 0xc9, 0x75, 0x43, 0x8c, 0xca,
-	0xea, 0x50, 0x72, 0xe9, 0x64, 0xdb, 0x6d, 0xbb, 0x72, 0x9e, 0x7f, 0xf9, 0x0c, 0x6d, 0xd7, 0x6d,
-	0x77, 0x48, 0x59, 0x8c, 0x9a, 0xfd, 0x56, 0x19, 0x3b, 0x03, 0x7f, 0xaf, 0xfd, 0x4b, 0x56, 0xdf,
-	0xc3, 0xcc, 0x76, 0xfd, 0xbd, 0x16, 0xf6, 0xaf, 0x33, 0xbb, 0x4b, 0x28, 0xc3, 0xdd, 0x9e, 0x24,
-	0x28, 0x7e, 0xa2, 0xc1, 0xcc, 0x4d, 0x9b, 0x32, 0xd7, 0xb3, 0x4d, 0xdc, 0xa9, 0x39, 0x2d, 0x17,
-	0xbd, 0x03, 0xc9, 0x1d, 0x82, 0x2d, 0xe2, 0xe5, 0xb5, 0x45, 0x6d, 0x29, 0x7d, 0x65, 0xae, 0xe4,
-	0xcb, 0x5b, 0x92, 0x62, 0xee, 0x2d, 0x97, 0x6e, 0x0a, 0x82, 0x4a, 0xea, 0xf3, 0xc7, 0x0b, 0x13,
-	0xbf, 0xfd, 0xf2, 0xb3, 0x4b, 0x9a, 0xae, 0x78, 0x50, 0x15, 0x92, 0x7b, 0xb8, 0x43, 0x09, 0xcb,
-	0xc7, 0x16, 0xe3, 0x4b, 0xe9, 0x2b, 0xe7, 0x4b, 0x07, 0xab, 0xbd, 0xb4, 0x8d, 0x3b, 0xb6, 0x85,
-	0x99, 0x3b, 0x8c, 0x22, 0x79, 0x57, 0x62, 0x79, 0xad, 0xf8, 0xeb, 0x18, 0x64, 0x57, 0xdd, 0x6e,
-	0xd7, 0xa6, 0xd4, 0x76, 0x1d, 0x1d, 0x33, 0x42, 0xd1, 0x7b, 0x90, 0xf0, 0x30, 0x23, 0xe2, 0x64,
-	0xa9, 0xca, 0x55, 0xce, 0xf8, 0xc5, 0xe3, 0x85, 0xb3, 0x72, 0x0b, 0x6a, 0xed, 0x96, 0x6c, 0xb7,
-	0xdc, 0xc5, 0x6c, 0xa7, 0xf4, 0x01, 0x69, 0x63, 0x73, 0x50, 0x25, 0xe6, 0xa3, 0x07, 0x97, 0x41,
-	0x9d, 0xa0, 0x4a, 0x4c, 0xb9, 0x8b, 0xc0, 0x40, 0xdf, 0x87, 0xa9, 0x2e, 0xbe, 0x63, 0x08, 0xbc,
-	0xd8, 0x0b, 0xe1, 0x4d, 0x76, 0xf1, 0x1d, 0x7e, 0x3e, 0xf4, 0x21, 0x64, 0x39, 0xa4, 0xb9, 0x83,
-	0x9d, 0x36, 0x91, 0xc8, 0xf1, 0x17, 0x42, 0xce, 0x74, 0xf1, 0x9d, 0x55, 0x81, 0xc6, 0xf1, 0x57,
-	0x12, 0x5f, 0xdd, 0x5f, 0xd0, 0x8a, 0x7f, 0xd0, 0x00, 0x42, 0xc5, 0x20, 0x0c, 0x39, 0x33, 0x18,
-	0x89, 0x4d, 0xa9, 0xb2, 0xdc, 0x9b, 0xe3, 0x74, 0xbf, 0x4f, 0xad, 0x95, 0x0c, 0x3f, 0xde, 0xc3,
-	0xc7, 0x0b, 0x9a, 0xdc, 0x35, 0x6b, 0x8e, 0xa8, 0x3d, 0xdd, 0xef, 0x59, 0x98, 0x11, 0x83, 0xfb,
-	0x8f, 0xd0, 0x56, 0xfa, 0x4a, 0xa1, 0x24, 0x9d, 0xab, 0xe4, 0x3b, 0x57, 0x69, 0xcb, 0x77, 0x2e,
-	0x09, 0xf8, 0xf1, 0xdf, 0x7c, 0x40, 0x90, 0xdc, 0x7c, 0x5d, 0xc9, 0xf0, 0x2f, 0x0d, 0xd2, 0x55,
-	0x42, 0x4d, 0xcf, 0xee, 0x71, 0x77, 0x45, 0x79, 0x98, 0xec, 0xba, 0x8e, 0xbd, 0xab, 0xbc, 0x2e,
-	0xa5, 0xfb, 0x43, 0x54, 0x80, 0x29, 0xdb, 0x22, 0x0e, 0xb3, 0xd9, 0x40, 0x9a, 0x49, 0x0f, 0xc6,
-	0x9c, 0xeb, 0x36, 0x69, 0x52, 0xdb, 0xd7, 0xb3, 0xee, 0x0f, 0xd1, 0x45, 0xc8, 0x51, 0x62, 0xf6,
-	0x3d, 0x9b, 0x0d, 0x0c, 0xd3, 0x75, 0x18, 0x36, 0x59, 0x3e, 0x21, 0x48, 0xb2, 0xfe, 0xfc, 0xaa,
-	0x9c, 0xe6, 0x20, 0x16, 0x61, 0xd8, 0xee, 0xd0, 0xfc, 0x2b, 0x12, 0x44, 0x0d, 0xd1, 0x0d, 0x98,
-	0xea, 0x12, 0x86, 0x2d, 0xcc, 0x70, 0x3e, 0x29, 0x64, 0x5e, 0x1c, 0xa7, 0xd1, 0x5b, 0x8a, 0x4e,
-	0x38, 0xb3, 0x90, 0x7a, 0x42, 0x0f, 0x98, 0x95, 0xcc, 0x2d, 0x98, 0xf2, 0xc9, 0xd0, 0x1b, 0x90,
-	0xed, 0x79, 0x6e, 0xcb, 0xee, 0x10, 0xa3, 0x67, 0x9b, 0x46, 0xdf, 0xb3, 0x95, 0xdc, 0x19, 0x35,
-	0xbd, 0x69, 0x9b, 0x0d, 0xcf, 0x46, 0x6f, 0x01, 0xa2, 0xae, 0x69, 0xe3, 0x8e, 0xb1, 0x83, 0x1d,
-	0xab, 0x43, 0x38, 0x25, 0x15, 0xa1, 0x95, 0xd2, 0x73, 0x72, 0xe5, 0xa6, 0x58, 0x68, 0x78, 0x36,
-	0x55, 0xfb, 0xdc, 0x9b, 0x84, 0x54, 0x10, 0x5d, 0x68, 0x15, 0x72, 0x6e, 0x8f, 0x78, 0xfc, 0xdb,
-	0xc0, 0x96, 0xe5, 0x11, 0x4a, 0x55, 0xf8, 0xe4, 0x1f, 0x3d, 0xb8, 0x7c, 0x52, 0xc9, 0x73, 0x4d,
-	0xae, 0xd4, 0x99, 0x67, 0x3b, 0x6d, 0x3d, 0xeb, 0x73, 0xa8, 0x69, 0xf4, 0x23, 0xee, 0x63, 0x0e,
-	0x25, 0x0e, 0xed, 0x53, 0xa3, 0xd7, 0x6f, 0xee, 0x92, 0x81, 0xf2, 0x82, 0x93, 0x23, 0x5e, 0x70,
-	0xcd, 0x19, 0x54, 0xf2, 0x7f, 0x0a, 0xa1, 0x4d, 0x6f, 0xd0, 0x63, 0x6e, 0x69, 0xb3, 0xdf, 0x7c,
-	0x9f, 0x0c, 0xb8, 0x6f, 0x29, 0x9c, 0x4d, 0x01, 0x83, 0x4e, 0x43, 0xf2, 0x67, 0xd8, 0xee, 0x10,
-	0x4b, 0x98, 0x70, 0x4a, 0x57, 0x23, 0xb4, 0x02, 0x49, 0xca, 0x30, 0xeb, 0x53, 0x61, 0xb7, 0x99,
-	0x2b, 0xc5, 0x71, 0xaa, 0xaf, 0xb8, 0x8e, 0x55, 0x17, 0x94, 0xba, 0xe2, 0x40, 0xab, 0x90, 0x64,
-	0xee, 0x2e, 0x71, 0x94, 0x45, 0x2b, 0xdf, 0x52, 0xe1, 0x77, 0x6a, 0x34, 0xfc, 0x6a, 0x0e, 0x8b,
-	0x04, 0x5e, 0xcd, 0x61, 0xba, 0x62, 0x45, 0x3f, 0x81, 0x9c, 0x45, 0x3a, 0xa4, 0x2d, 0x34, 0x47,
-	0x77, 0xb0, 0x47, 0xa8, 0xf0, 0x82, 0x54, 0x65, 0xf9, 0xc8, 0xd1, 0xac, 0x67, 0x03, 0xa8, 0xba,
-	0x40, 0x42, 0x9b, 0x90, 0xb6, 0x42, 0xff, 0xcf, 0x4f, 0x0a, 0x65, 0xbe, 0x36, 0x4e, 0xc6, 0x48,
-	0xa8, 0x44, 0xd3, 0x65, 0x14, 0x82, 0xbb, 0x7c, 0xdf, 0x69, 0xba, 0x8e, 0x65, 0x3b, 0x6d, 0x63,
-	0x87, 0xd8, 0xed, 0x1d, 0x96, 0x9f, 0x5a, 0xd4, 0x96, 0xe2, 0x7a, 0x36, 0x98, 0xbf, 0x29, 0xa6,
-	0xd1, 0x26, 0xcc, 0x84, 0xa4, 0x22, 0xa4, 0x53, 0x47, 0x0d, 0xe9, 0x4c, 0x00, 0xc0, 0x49, 0xd0,
-	0x2d, 0x80, 0x30, 0x69, 0xe4, 0x41, 0xa0, 0x15, 0x0f, 0x4f, 0x3f, 0x51, 0x61, 0x22, 0x00, 0xc8,
-	0x81, 0x13, 0x5d, 0xdb, 0x31, 0x28, 0xe9, 0xb4, 0x0c, 0xa5, 0x39, 0x8e, 0x9b, 0x16, 0xea, 0x7f,
-	0xf7, 0x08, 0xd6, 0xfc, 0xe2, 0xc1, 0xe5, 0xac, 0x1c, 0x5d, 0xa6, 0xd6, 0xee, 0xe2, 0xdb, 0xa5,
-	0xef, 0x7c, 0x57, 0x9f, 0xed, 0xda, 0x4e, 0x9d, 0x74, 0x5a, 0xd5, 0x00, 0x18, 0xbd, 0x03, 0x67,
-	0x43, 0x85, 0xb8, 0x8e, 0xb1, 0xe3, 0x76, 0x2c, 0xc3, 0x23, 0x2d, 0xc3, 0x74, 0xfb, 0x0e, 0xcb,
-	0x4f, 0x0b, 0x35, 0x9e, 0x09, 0x48, 0x36, 0x9c, 0x9b, 0x6e, 0xc7, 0xd2, 0x49, 0x6b, 0x95, 0x2f,
-	0xa3, 0xd7, 0x20, 0xd4, 0x86, 0x61, 0x5b, 0x34, 0x9f, 0x59, 0x8c, 0x2f, 0x25, 0xf4, 0xe9, 0x60,
-	0xb2, 0x66, 0xd1, 0x95, 0xa9, 0x8f, 0xee, 0x2f, 0x4c, 0x7c, 0x75, 0x7f, 0x61, 0xa2, 0x78, 0x1d,
-	0xa6, 0xb7, 0x71, 0x47, 0x85, 0x16, 0xa1, 0xe8, 0x2a, 0xa4, 0xb0, 0x3f, 0xc8, 0x6b, 0x3c, 0xb4,
-	0x9f, 0x11, 0x9a, 0x21, 0x69, 0xf1, 0x77, 0x1a, 0x24, 0xab, 0xdb, 0x9b, 0xd8, 0xf6, 0xd0, 0x1a,
-	0xcc, 0x86, 0xbe, 0xfa, 0xbc, 0x51, 0x1e, 0xba, 0xb7, 0x1f, 0xe6, 0xeb, 0x30, 0xbb, 0xe7, 0x27,
-	0x8e, 0x00, 0x46, 0xd6, 0xc6, 0xf3, 0x8f, 0x1e, 0x5c, 0x3e, 0xa7, 0x60, 0x82, 0xe4, 0xb2, 0x0f,
-	0x6f, 0x6f, 0xdf, 0x7c, 0x44, 0xe6, 0xf7, 0x60, 0x52, 0x1e, 0x95, 0xa2, 0xef, 0xc1, 0x2b, 0x3d,
-	0xfe, 0x21, 0x44, 0x4d, 0x5f, 0x99, 0x1f, 0xeb, 0xf3, 0x82, 0x3e, 0xea, 0x21, 0x92, 0xaf, 0xf8,
-	0xcb, 0x18, 0x40, 0x75, 0x7b, 0x7b, 0xcb, 0xb3, 0x7b, 0x1d, 0xc2, 0x5e, 0x96, 0xec, 0x0d, 0x38,
-	0x15, 0xca, 0x4e, 0x3d, 0xf3, 0xe8, 0xf2, 0x9f, 0x08, 0xf8, 0xeb, 0x9e, 0x79, 0x20, 0xac, 0x45,
-	0x59, 0x00, 0x1b, 0x3f, 0x3a, 0x6c, 0x95, 0xb2, 0x51, 0xcd, 0xfe, 0x10, 0xd2, 0xa1, 0x32, 0x28,
-	0xaa, 0xc1, 0x14, 0x53, 0xdf, 0x4a, 0xc1, 0xc5, 0xf1, 0x0a, 0xf6, 0xd9, 0xa2, 0x4a, 0x0e, 0xd8,
-	0x8b, 0xff, 0xd1, 0x00, 0x22, 0x31, 0xf2, 0xcd, 0xf4, 0x31, 0x54, 0x83, 0xa4, 0x4a, 0xce, 0xf1,
-	0xe3, 0x26, 0x67, 0x05, 0x10, 0x51, 0xea, 0xaf, 0x62, 0x70, 0xa2, 0xe1, 0x47, 0xef, 0x37, 0x5f,
-	0x07, 0x0d, 0x98, 0x24, 0x0e, 0xf3, 0x6c, 0xa1, 0x04, 0x6e, 0xf3, 0xb7, 0xc7, 0xd9, 0xfc, 0x00,
-	0xa1, 0xd6, 0x1c, 0xe6, 0x0d, 0xa2, 0x1e, 0xe0, 0x63, 0x45, 0xf4, 0xf1, 0x69, 0x1c, 0xf2, 0xe3,
-	0x58, 0xd1, 0x9b, 0x90, 0x35, 0x3d, 0x22, 0x26, 0xfc, 0xba, 0xa3, 0x89, 0x84, 0x39, 0xe3, 0x4f,
-	0xab, 0xb2, 0xa3, 0x03, 0xbf, 0x59, 0x72, 0xe7, 0xe2, 0xa4, 0xc7, 0xbb, 0x4a, 0xce, 0x84, 0x08,
-	0xa2, 0xf0, 0x6c, 0x41, 0xd6, 0x76, 0x6c, 0xc6, 0x6f, 0x48, 0x4d, 0xdc, 0xc1, 0x8e, 0xe9, 0x5f,
-	0xb9, 0x8f, 0x54, 0xf3, 0x67, 0x14, 0x46, 0x45, 0x42, 0xa0, 0x35, 0x98, 0xf4, 0xd1, 0x12, 0x47,
-	0x47, 0xf3, 0x79, 0xd1, 0xeb, 0x30, 0x1d, 0x2d, 0x0c, 0xe2, 0x36, 0x92, 0xa8, 0xc4, 0xf2, 0x9a,
-	0x9e, 0x8e, 0xd4, 0x86, 0xc3, 0xaa, 0x4f, 0xf2, 0x99, 0xd5, 0x47, 0x5d, 0xfa, 0x3e, 0x8d, 0xc3,
-	0xac, 0x4e, 0xac, 0xff, 0x7d, 0xd3, 0x6c, 0x02, 0xc8, 0x70, 0xe5, 0xd9, 0x54, 0x59, 0xe7, 0x18,
-	0x31, 0x9f, 0x92, 0x20, 0x55, 0xca, 0xd0, 0xf9, 0x83, 0xac, 0xf4, 0xf2, 0x2d, 0xf4, 0xd7, 0x18,
-	0x4c, 0x47, 0x2d, 0xf4, 0x7f, 0x59, 0xb8, 0xd0, 0x7a, 0x98, 0xaa, 0x12, 0x22, 0x55, 0x5d, 0x1c,
-	0x97, 0xaa, 0x46, 0xbc, 0xf9, 0x90, 0x1c, 0xf5, 0x75, 0x1c, 0x92, 0x9b, 0xd8, 0xc3, 0x5d, 0x8a,
-	0x36, 0x46, 0xee, 0xb7, 0x7e, 0x2b, 0x63, 0xbf, 0x33, 0x57, 0x55, 0xbf, 0x44, 0xfa, 0xf2, 0x27,
-	0xe3, 0xae, 0xb7, 0xaf, 0xc3, 0x0c, 0x7f, 0xd8, 0x07, 0x02, 0x49, 0xe5, 0x66, 0xc4, 0xfb, 0x3c,
-	0x90, 0x9e, 0xa2, 0x05, 0x48, 0x73, 0xb2, 0x30, 0x17, 0x73, 0x1a, 0xe8, 0xe2, 0x3b, 0x6b, 0x72,
-	0x06, 0x2d, 0x03, 0xda, 0x09, 0xba, 0x2d, 0x46, 0xa8, 0x08, 0x6d, 0x29, 0x23, 0xd2, 0xc2, 0x6c,
-	0xb8, 0xea, 0xb3, 0x9c, 0x03, 0xe0, 0x27, 0x31, 0x2c, 0xe2, 0xb8, 0x5d, 0xf5, 0x42, 0x4d, 0xf1,
-	0x99, 0x2a, 0x9f, 0x40, 0xbf, 0xd0, 0xe4, 0x55, 0x79, 0x5f, 0x0b, 0x40, 0xbd, 0x54, 0xb6, 0x9e,
-	0x23, 0x30, 0xfe, 0xfd, 0x78, 0xa1, 0x30, 0xc0, 0xdd, 0xce, 0x4a, 0xf1, 0x00, 0x9c, 0xe2, 0x41,
-	0x5d, 0x09, 0x7e, 0x81, 0x1e, 0x6e, 0x21, 0xa0, 0x1a, 0xe4, 0x76, 0xc9, 0xc0, 0xf0, 0x5c, 0x26,
-	0x93, 0x4d, 0x8b, 0x10, 0xf5, 0xa6, 0x99, 0xf3, 0xed, 0xdb, 0xc4, 0x94, 0x44, 0x9e, 0x00, 0xb6,
-	0x53, 0x49, 0xf0, 0xd3, 0xe9, 0x33, 0xbb, 0x64, 0xa0, 0x2b, 0xbe, 0xeb, 0x84, 0xac, 0x5c, 0xe0,
-	0xd1, 0x72, 0xf7, 0xcb, 0xcf, 0x2e, 0x9d, 0x0d, 0x2f, 0xee, 0xe5, 0x3b, 0x41, 0x73, 0x4f, 0x9a,
-	0x98, 0x5f, 0x7e, 0x51, 0x58, 0x88, 0x74, 0x42, 0x7b, 0xfc, 0x5d, 0xc9, 0xdf, 0x21, 0x91, 0xf7,
-	0x82, 0xf6, 0xec, 0x77, 0x48, 0xc8, 0x3f, 0xf4, 0x0e, 0x89, 0x84, 0xe8, 0xbb, 0x61, 0x1d, 0x88,
-	0x1d, 0x26, 0x4d, 0xd4, 0x3b, 0x15, 0x93, 0x88, 0xfc, 0x89, 0xe2, 0x9f, 0x35, 0x98, 0x1b, 0xf1,
-	0xe6, 0xe0, 0xc8, 0x26, 0x20, 0x2f, 0xb2, 0x28, 0xbc, 0x62, 0xa0, 0x8e, 0x7e, 0xbc, 0xe0, 0x98,
-	0xf5, 0x46, 0x0a, 0xc1, 0xcb, 0x29, 0x68, 0x2a, 0x93, 0xfd, 0x51, 0x83, 0x93, 0xd1, 0x03, 0x04,
-	0xa2, 0xd4, 0x61, 0x3a, 0xba, 0xb5, 0x12, 0xe2, 0xc2, 0xf3, 0x08, 0x11, 0x3d, 0xff, 0x10, 0x08,
-	0xda, 0x0e, 0x33, 0x86, 0x6c, 0x29, 0x2e, 0x3f, 0xb7, 0x52, 0xfc, 0x83, 0x1d, 0x98, 0x39, 0xa4,
-	0x6d, 0xbe, 0xd6, 0x20, 0xb1, 0xe9, 0xba, 0x1d, 0xf4, 0x73, 0x98, 0x75, 0x5c, 0x66, 0xf0, 0xc8,
-	0x22, 0x96, 0xa1, 0xda, 0x07, 0x32, 0x1b, 0xaf, 0x3d, 0x53, 0x57, 0xff, 0x78, 0xbc, 0x30, 0xca,
-	0x39, 0xac, 0x40, 0xd5, 0x56, 0x73, 0x5c, 0x56, 0x11, 0x44, 0x5b, 0xb2, 0xc3, 0xd0, 0x82, 0xcc,
-	0xf0, 0x76, 0x32, 0x63, 0x5f, 0x3b, 0x6c, 0xbb, 0xcc, 0xa1, 0x5b, 0x4d, 0x37, 0x23, 0xfb, 0xac,
-	0x4c, 0x71, 0xab, 0xfd, 0x93, 0x5b, 0xee, 0x43, 0xc8, 0x05, 0xe9, 0xaa, 0x21, 0x7a, 0x72, 0x14,
-	0x5d, 0x87, 0x49, 0xd9, 0x9e, 0xf3, 0x1f, 0x0c, 0xe7, 0xc3, 0x86, 0x2f, 0x6e, 0x9a, 0x76, 0x69,
-	0x2f, 0xd2, 0xac, 0x95, 0x4c, 0x43, 0xfa, 0x54, 0xcc, 0xa2, 0x67, 0xfb, 0x30, 0x06, 0x73, 0xab,
-	0xae, 0x43, 0x55, 0xb3, 0x47, 0x45, 0xb5, 0x6c, 0x30, 0x0f, 0xd0, 0xc5, 0x31, 0xad, 0xa8, 0xe9,
-	0xd1, 0x86, 0xd3, 0x36, 0x64, 0x79, 0x89, 0x35, 0x5d, 0xe7, 0x05, 0xfb, 0x4d, 0x19, 0xb7, 0x63,
-	0xa9, 0x13, 0xed, 0x92, 0x01, 0xc7, 0x75, 0xc8, 0xed, 0x21, 0xdc, 0xf8, 0xf1, 0x70, 0x1d, 0x72,
-	0x3b, 0x82, 0x7b, 0x1a, 0x92, 0xea, 0x7e, 0x95, 0x10, 0xb7, 0x07, 0x35, 0x42, 0x57, 0x21, 0xce,
-	0x53, 0xe1, 0x2b, 0x47, 0x48, 0x1e, 0x9c, 0x21, 0x52, 0xd6, 0xea, 0x30, 0xa7, 0xba, 0x05, 0x74,
-	0xa3, 0x25, 0x34, 0x4a, 0x84, 0x40, 0xef, 0x93, 0xc1, 0x01, 0xad, 0x83, 0xe9, 0xe7, 0x6a, 0x1d,
-	0x5c, 0xfa, 0xbd, 0x06, 0x10, 0xf6, 0xcd, 0xd0, 0x5b, 0x70, 0xa6, 0xb2, 0xb1, 0x5e, 0x35, 0xea,
-	0x5b, 0xd7, 0xb6, 0x1a, 0x75, 0xa3, 0xb1, 0x5e, 0xdf, 0x5c, 0x5b, 0xad, 0x5d, 0xaf, 0xad, 0x55,
-	0x73, 0x13, 0x85, 0xec, 0xdd, 0x7b, 0x8b, 0xe9, 0x86, 0x43, 0x7b, 0xc4, 0xb4, 0x5b, 0x36, 0xb1,
-	0xd0, 0x1b, 0x70, 0x72, 0x98, 0x9a, 0x8f, 0xd6, 0xaa, 0x39, 0xad, 0x30, 0x7d, 0xf7, 0xde, 0xe2,
-	0x94, 0x7c, 0x27, 0x10, 0x0b, 0x2d, 0xc1, 0xa9, 0x51, 0xba, 0xda, 0xfa, 0x8d, 0x5c, 0xac, 0x90,
-	0xb9, 0x7b, 0x6f, 0x31, 0x15, 0x3c, 0x28, 0x50, 0x11, 0x50, 0x94, 0x52, 0xe1, 0xc5, 0x0b, 0x70,
-	0xf7, 0xde, 0x62, 0x52, 0x86, 0x4c, 0x21, 0xf1, 0xd1, 0x6f, 0xe6, 0x27, 0x2e, 0xfd, 0x14, 0xa0,
-	0xe6, 0xb4, 0x3c, 0x6c, 0x8a, 0xd4, 0x50, 0x80, 0xd3, 0xb5, 0xf5, 0xeb, 0xfa, 0xb5, 0xd5, 0xad,
-	0xda, 0xc6, 0xfa, 0xf0, 0xb1, 0xf7, 0xad, 0x55, 0x37, 0x1a, 0x95, 0x0f, 0xd6, 0x8c, 0x7a, 0xed,
-	0xc6, 0x7a, 0x4e, 0x43, 0x67, 0xe0, 0xc4, 0xd0, 0xda, 0x0f, 0xd6, 0xb7, 0x6a, 0xb7, 0xd6, 0x72,
-	0xb1, 0xca, 0xd5, 0xcf, 0x9f, 0xcc, 0x6b, 0x0f, 0x9f, 0xcc, 0x6b, 0x7f, 0x7f, 0x32, 0xaf, 0x7d,
-	0xfc, 0x74, 0x7e, 0xe2, 0xe1, 0xd3, 0xf9, 0x89, 0xbf, 0x3c, 0x9d, 0x9f, 0xf8, 0xf1, 0xab, 0x43,
-	0xc1, 0x18, 0x96, 0x23, 0xf1, 0x97, 0x48, 0x33, 0x29, 0xbc, 0xe6, 0xdb, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0x54, 0xe6, 0x7d, 0x82, 0x8a, 0x1a, 0x00, 0x00,
+	0x19, 0xd6, 0x52, 0x34, 0x25, 0xfe, 0x14, 0x45, 0x6a, 0xfc, 0xa2, 0xe4, 0x44, 0x92, 0x99, 0x97,
+	0xe3, 0xc6, 0x54, 0xe4, 0x16, 0x2e, 0x20, 0x04, 0x09, 0xcc, 0x87, 0x63, 0x26, 0x8e, 0xac, 0x2c,
+	0x45, 0x35, 0x29, 0xda, 0x2c, 0x86, 0xbb, 0x43, 0x6a, 0xa2, 0xe5, 0x2e, 0xbd, 0xb3, 0x94, 0xcd,
+	0x9e, 0x7b, 0x08, 0x5c, 0x14, 0xc8, 0xa9, 0xc8, 0xc5, 0x48, 0x80, 0x5e, 0xda, 0x5b, 0x0e, 0x46,
+	0x6e, 0x3d, 0xf4, 0x96, 0x16, 0x28, 0x60, 0xe4, 0x54, 0x04, 0xa8, 0x5b, 0x24, 0x87, 0x04, 0xed,
+	0xa5, 0xed, 0xa9, 0xc7, 0x62, 0x1e, 0xfb, 0xa0, 0x28, 0x5a, 0x0f, 0xfb, 0x10, 0xb4, 0x17, 0x62,
+	0x67, 0xe6, 0xff, 0xbf, 0x99, 0xff, 0x9f, 0xff, 0x35, 0x3f, 0xe1, 0x59, 0xd3, 0x65, 0x5d, 0x97,
+	0xad, 0x30, 0x1f, 0xef, 0x50, 0xa7, 0xb3, 0xb2, 0xbb, 0xda, 0x22, 0x3e, 0x5e, 0x0d, 0xc6, 0xa5,
+	0x9e, 0xe7, 0xfa, 0x2e, 0x3a, 0x23, 0xa9, 0x4a, 0xc1, 0xac, 0xa2, 0x5a, 0x98, 0xc3, 0x5d, 0xea,
+	0xb8, 0x2b, 0xe2, 0x57, 0x92, 0x2e, 0x3c, 0x65, 0xba, 0x5d, 0xe2, 0xb7, 0xda, 0xfe, 0x0a, 0x6e,
+	0x99, 0x74, 0x65, 0x77, 0x75, 0xc5, 0x1f, 0xf4, 0x08, 0x53, 0xab, 0x4f, 0x87, 0xab, 0x62, 0x76,
+	0xef, 0xf2, 0xa2, 0x3a, 0x4d, 0x0b, 0x33, 0x12, 0x1e, 0xc5, 0x74, 0xa9, 0xa3, 0xd6, 0xe7, 0xe5,
+	0xba, 0x21, 0x46, 0x2b, 0xea, 0x50, 0x72, 0xe9, 0x54, 0xc7, 0xed, 0xb8, 0x72, 0x9e, 0x7f, 0x05,
+	0x0c, 0x1d, 0xd7, 0xed, 0xd8, 0x64, 0x45, 0x8c, 0x5a, 0xfd, 0xf6, 0x0a, 0x76, 0x06, 0xc1, 0x5e,
+	0x7b, 0x97, 0xac, 0xbe, 0x87, 0x7d, 0xea, 0x06, 0x7b, 0x2d, 0xed, 0x5d, 0xf7, 0x69, 0x97, 0x30,
+	0x1f, 0x77, 0x7b, 0x92, 0xa0, 0xf8, 0x91, 0x06, 0xb3, 0xd7, 0x29, 0xf3, 0x5d, 0x8f, 0x9a, 0xd8,
+	0xae, 0x3b, 0x6d, 0x17, 0xbd, 0x02, 0xa9, 0x6d, 0x82, 0x2d, 0xe2, 0x15, 0xb4, 0x65, 0xed, 0x42,
+	0xe6, 0xf2, 0x7c, 0x29, 0x90, 0xb7, 0x24, 0xc5, 0xdc, 0x5d, 0x2d, 0x5d, 0x17, 0x04, 0xe5, 0xf4,
+	0xe7, 0x0f, 0x97, 0x26, 0x7e, 0xf3, 0xcd, 0xa7, 0x17, 0x35, 0x5d, 0xf1, 0xa0, 0x2a, 0xa4, 0x76,
+	0xb1, 0xcd, 0x88, 0x5f, 0x48, 0x2c, 0x4f, 0x5e, 0xc8, 0x5c, 0x3e, 0x5f, 0xda, 0x5f, 0xed, 0xa5,
+	0x2d, 0x6c, 0x53, 0x0b, 0xfb, 0xee, 0x30, 0x8a, 0xe4, 0x5d, 0x4b, 0x14, 0xb4, 0xe2, 0xaf, 0x12,
+	0x90, 0xab, 0xb8, 0xdd, 0x2e, 0x65, 0x8c, 0xba, 0x8e, 0x8e, 0x7d, 0xc2, 0xd0, 0x1b, 0x90, 0xf4,
+	0xb0, 0x4f, 0xc4, 0xc9, 0xd2, 0xe5, 0x2b, 0x9c, 0xf1, 0xcb, 0x87, 0x4b, 0xe7, 0xe4, 0x16, 0xcc,
+	0xda, 0x29, 0x51, 0x77, 0xa5, 0x8b, 0xfd, 0xed, 0xd2, 0x0d, 0xd2, 0xc1, 0xe6, 0xa0, 0x4a, 0xcc,
+	0x2f, 0xee, 0x5f, 0x02, 0x75, 0x82, 0x2a, 0x31, 0xe5, 0x2e, 0x02, 0x03, 0xbd, 0x0d, 0xd3, 0x5d,
+	0x7c, 0xc7, 0x10, 0x78, 0x89, 0xc7, 0xc2, 0x9b, 0xea, 0xe2, 0x3b, 0xfc, 0x7c, 0xe8, 0x3d, 0xc8,
+	0x71, 0x48, 0x73, 0x1b, 0x3b, 0x1d, 0x22, 0x91, 0x27, 0x1f, 0x0b, 0x39, 0xdb, 0xc5, 0x77, 0x2a,
+	0x02, 0x8d, 0xe3, 0xaf, 0x25, 0xbf, 0xfd, 0x64, 0x49, 0x2b, 0xfe, 0x5e, 0x03, 0x88, 0x14, 0x83,
+	0x30, 0xe4, 0xcd, 0x70, 0x24, 0x36, 0x65, 0xea, 0xe6, 0x5e, 0x18, 0xa7, 0xfb, 0x3d, 0x6a, 0x2d,
+	0x67, 0xf9, 0xf1, 0x1e, 0x3c, 0x5c, 0xd2, 0xe4, 0xae, 0x39, 0x73, 0x44, 0xed, 0x99, 0x7e, 0xcf,
+	0xc2, 0x3e, 0x31, 0xb8, 0xfd, 0x08, 0x6d, 0x65, 0x2e, 0x2f, 0x94, 0xa4, 0x71, 0x95, 0x02, 0xe3,
+	0x2a, 0x6d, 0x06, 0xc6, 0x25, 0x01, 0x3f, 0xfc, 0x6b, 0x00, 0x08, 0x92, 0x9b, 0xaf, 0x2b, 0x19,
+	0xfe, 0xa5, 0x41, 0xa6, 0x4a, 0x98, 0xe9, 0xd1, 0x1e, 0x37, 0x57, 0x54, 0x80, 0xa9, 0xae, 0xeb,
+	0xd0, 0x1d, 0x65, 0x75, 0x69, 0x3d, 0x18, 0xa2, 0x05, 0x98, 0xa6, 0x16, 0x71, 0x7c, 0xea, 0x0f,
+	0xe4, 0x35, 0xe9, 0xe1, 0x98, 0x73, 0xdd, 0x26, 0x2d, 0x46, 0x03, 0x3d, 0xeb, 0xc1, 0x10, 0xbd,
+	0x08, 0x79, 0x46, 0xcc, 0xbe, 0x47, 0xfd, 0x81, 0x61, 0xba, 0x8e, 0x8f, 0x4d, 0xbf, 0x90, 0x14,
+	0x24, 0xb9, 0x60, 0xbe, 0x22, 0xa7, 0x39, 0x88, 0x45, 0x7c, 0x4c, 0x6d, 0x56, 0x38, 0x21, 0x41,
+	0xd4, 0x10, 0xbd, 0x0e, 0xd3, 0x5d, 0xe2, 0x63, 0x0b, 0xfb, 0xb8, 0x90, 0x12, 0x32, 0x2f, 0x8f,
+	0xd3, 0xe8, 0x5b, 0x8a, 0x4e, 0x18, 0xb3, 0x90, 0x7a, 0x42, 0x0f, 0x99, 0x95, 0xcc, 0x6d, 0x98,
+	0x0e, 0xc8, 0xd0, 0xf3, 0x90, 0xeb, 0x79, 0x6e, 0x9b, 0xda, 0xc4, 0xe8, 0x51, 0xd3, 0xe8, 0x7b,
+	0x54, 0xc9, 0x9d, 0x55, 0xd3, 0x1b, 0xd4, 0x6c, 0x7a, 0x14, 0xbd, 0x04, 0x88, 0xb9, 0x26, 0xc5,
+	0xb6, 0xb1, 0x8d, 0x1d, 0xcb, 0x26, 0x9c, 0x92, 0x09, 0xd7, 0x4a, 0xeb, 0x79, 0xb9, 0x72, 0x5d,
+	0x2c, 0x34, 0x3d, 0xca, 0xd4, 0x3e, 0xf7, 0xa6, 0x20, 0x1d, 0x7a, 0x17, 0xaa, 0x40, 0xde, 0xed,
+	0x11, 0x8f, 0x7f, 0x1b, 0xd8, 0xb2, 0x3c, 0xc2, 0x98, 0x72, 0x9f, 0xc2, 0x17, 0xf7, 0x2f, 0x9d,
+	0x52, 0xf2, 0x5c, 0x95, 0x2b, 0x0d, 0xdf, 0xa3, 0x4e, 0x47, 0xcf, 0x05, 0x1c, 0x6a, 0x1a, 0xbd,
+	0xcb, 0x6d, 0xcc, 0x61, 0xc4, 0x61, 0x7d, 0x66, 0xf4, 0xfa, 0xad, 0x1d, 0x32, 0x50, 0x56, 0x70,
+	0x6a, 0xc4, 0x0a, 0xae, 0x3a, 0x83, 0x72, 0xe1, 0x8f, 0x11, 0xb4, 0xe9, 0x0d, 0x7a, 0xbe, 0x5b,
+	0xda, 0xe8, 0xb7, 0xde, 0x24, 0x03, 0x6e, 0x5b, 0x0a, 0x67, 0x43, 0xc0, 0xa0, 0x33, 0x90, 0x7a,
+	0x1f, 0x53, 0x9b, 0x58, 0xe2, 0x0a, 0xa7, 0x75, 0x35, 0x42, 0x6b, 0x90, 0x62, 0x3e, 0xf6, 0xfb,
+	0x4c, 0xdc, 0xdb, 0xec, 0xe5, 0xe2, 0x38, 0xd5, 0x97, 0x5d, 0xc7, 0x6a, 0x08, 0x4a, 0x5d, 0x71,
+	0xa0, 0x0a, 0xa4, 0x7c, 0x77, 0x87, 0x38, 0xea, 0x46, 0xcb, 0xdf, 0x53, 0xee, 0x77, 0x7a, 0xd4,
+	0xfd, 0xea, 0x8e, 0x1f, 0x73, 0xbc, 0xba, 0xe3, 0xeb, 0x8a, 0x15, 0xfd, 0x04, 0xf2, 0x16, 0xb1,
+	0x49, 0x47, 0x68, 0x8e, 0x6d, 0x63, 0x8f, 0x30, 0x61, 0x05, 0xe9, 0xf2, 0xea, 0x91, 0xbd, 0x59,
+	0xcf, 0x85, 0x50, 0x0d, 0x81, 0x84, 0x36, 0x20, 0x63, 0x45, 0xf6, 0x5f, 0x98, 0x12, 0xca, 0x7c,
+	0x66, 0x9c, 0x8c, 0x31, 0x57, 0x89, 0x87, 0xcb, 0x38, 0x04, 0x37, 0xf9, 0xbe, 0xd3, 0x72, 0x1d,
+	0x8b, 0x3a, 0x1d, 0x63, 0x9b, 0xd0, 0xce, 0xb6, 0x5f, 0x98, 0x5e, 0xd6, 0x2e, 0x4c, 0xea, 0xb9,
+	0x70, 0xfe, 0xba, 0x98, 0x46, 0x1b, 0x30, 0x1b, 0x91, 0x0a, 0x97, 0x4e, 0x1f, 0xd5, 0xa5, 0xb3,
+	0x21, 0x00, 0x27, 0x41, 0x6f, 0x01, 0x44, 0x41, 0xa3, 0x00, 0x02, 0xad, 0x78, 0x70, 0xf8, 0x89,
+	0x0b, 0x13, 0x03, 0x40, 0x0e, 0x9c, 0xec, 0x52, 0xc7, 0x60, 0xc4, 0x6e, 0x1b, 0x4a, 0x73, 0x1c,
+	0x37, 0x23, 0xd4, 0xff, 0xea, 0x11, 0x6e, 0xf3, 0xcb, 0xfb, 0x97, 0x72, 0x72, 0x74, 0x89, 0x59,
+	0x3b, 0xcb, 0x2f, 0x97, 0x7e, 0xf0, 0x43, 0x7d, 0xae, 0x4b, 0x9d, 0x06, 0xb1, 0xdb, 0xd5, 0x10,
+	0x18, 0xbd, 0x02, 0xe7, 0x22, 0x85, 0xb8, 0x8e, 0xb1, 0xed, 0xda, 0x96, 0xe1, 0x91, 0xb6, 0x61,
+	0xba, 0x7d, 0xc7, 0x2f, 0xcc, 0x08, 0x35, 0x9e, 0x0d, 0x49, 0x6e, 0x3a, 0xd7, 0x5d, 0xdb, 0xd2,
+	0x49, 0xbb, 0xc2, 0x97, 0xd1, 0x33, 0x10, 0x69, 0xc3, 0xa0, 0x16, 0x2b, 0x64, 0x97, 0x27, 0x2f,
+	0x24, 0xf5, 0x99, 0x70, 0xb2, 0x6e, 0xb1, 0xb5, 0xe9, 0x0f, 0x3e, 0x59, 0x9a, 0xf8, 0xf6, 0x93,
+	0xa5, 0x89, 0xe2, 0x35, 0x98, 0xd9, 0xc2, 0xb6, 0x72, 0x2d, 0xc2, 0xd0, 0x15, 0x48, 0xe3, 0x60,
+	0x50, 0xd0, 0xb8, 0x6b, 0x3f, 0xc2, 0x35, 0x23, 0xd2, 0xe2, 0x6f, 0x35, 0x48, 0x55, 0xb7, 0x36,
+	0x30, 0xf5, 0x50, 0x0d, 0xe6, 0x22, 0x5b, 0x3d, 0xac, 0x97, 0x47, 0xe6, 0x1d, 0xb8, 0xf9, 0x3a,
+	0xcc, 0xed, 0x06, 0x81, 0x23, 0x84, 0x91, 0xb9, 0xf1, 0xfc, 0x17, 0xf7, 0x2f, 0x3d, 0xad, 0x60,
+	0xc2, 0xe0, 0xb2, 0x07, 0x6f, 0x77, 0xcf, 0x7c, 0x4c, 0xe6, 0x37, 0x60, 0x4a, 0x1e, 0x95, 0xa1,
+	0xd7, 0xe0, 0x44, 0x8f, 0x7f, 0x08, 0x51, 0x33, 0x97, 0x17, 0xc7, 0xda, 0xbc, 0xa0, 0x8f, 0x5b,
+	0x88, 0xe4, 0x2b, 0xfe, 0x22, 0x01, 0x50, 0xdd, 0xda, 0xda, 0xf4, 0x68, 0xcf, 0x26, 0xfe, 0x93,
+	0x92, 0xbd, 0x09, 0xa7, 0x23, 0xd9, 0x99, 0x67, 0x1e, 0x5d, 0xfe, 0x93, 0x21, 0x7f, 0xc3, 0x33,
+	0xf7, 0x85, 0xb5, 0x98, 0x1f, 0xc2, 0x4e, 0x1e, 0x1d, 0xb6, 0xca, 0xfc, 0x51, 0xcd, 0xbe, 0x03,
+	0x99, 0x48, 0x19, 0x0c, 0xd5, 0x61, 0xda, 0x57, 0xdf, 0x4a, 0xc1, 0xc5, 0xf1, 0x0a, 0x0e, 0xd8,
+	0xe2, 0x4a, 0x0e, 0xd9, 0x8b, 0xff, 0xd1, 0x00, 0x62, 0x3e, 0xf2, 0xdd, 0xb4, 0x31, 0x54, 0x87,
+	0x94, 0x0a, 0xce, 0x93, 0xc7, 0x0d, 0xce, 0x0a, 0x20, 0xa6, 0xd4, 0x5f, 0x26, 0xe0, 0x64, 0x33,
+	0xf0, 0xde, 0xef, 0xbe, 0x0e, 0x9a, 0x30, 0x45, 0x1c, 0xdf, 0xa3, 0x42, 0x09, 0xfc, 0xce, 0x5f,
+	0x1e, 0x77, 0xe7, 0xfb, 0x08, 0x55, 0x73, 0x7c, 0x6f, 0x10, 0xb7, 0x80, 0x00, 0x2b, 0xa6, 0x8f,
+	0x8f, 0x27, 0xa1, 0x30, 0x8e, 0x15, 0xbd, 0x00, 0x39, 0xd3, 0x23, 0x62, 0x22, 0xc8, 0x3b, 0x9a,
+	0x08, 0x98, 0xb3, 0xc1, 0xb4, 0x4a, 0x3b, 0x3a, 0xf0, 0xca, 0x92, 0x1b, 0x17, 0x27, 0x3d, 0x5e,
+	0x29, 0x39, 0x1b, 0x21, 0x88, 0xc4, 0xb3, 0x09, 0x39, 0xea, 0x50, 0x9f, 0x57, 0x48, 0x2d, 0x6c,
+	0x63, 0xc7, 0x0c, 0x4a, 0xee, 0x23, 0xe5, 0xfc, 0x59, 0x85, 0x51, 0x96, 0x10, 0xa8, 0x06, 0x53,
+	0x01, 0x5a, 0xf2, 0xe8, 0x68, 0x01, 0x2f, 0x7a, 0x0e, 0x66, 0xe2, 0x89, 0x41, 0x54, 0x23, 0xc9,
+	0x72, 0xa2, 0xa0, 0xe9, 0x99, 0x58, 0x6e, 0x38, 0x28, 0xfb, 0xa4, 0x1e, 0x99, 0x7d, 0x54, 0xd1,
+	0xf7, 0xf1, 0x24, 0xcc, 0xe9, 0xc4, 0xfa, 0xdf, 0xbf, 0x9a, 0x0d, 0x00, 0xe9, 0xae, 0x3c, 0x9a,
+	0xaa, 0xdb, 0x39, 0x86, 0xcf, 0xa7, 0x25, 0x48, 0x95, 0xf9, 0xe8, 0xfc, 0x7e, 0xb7, 0xf4, 0xe4,
+	0x6f, 0xe8, 0x2f, 0x09, 0x98, 0x89, 0xdf, 0xd0, 0xff, 0x65, 0xe2, 0x42, 0xeb, 0x51, 0xa8, 0x4a,
+	0x8a, 0x50, 0xf5, 0xe2, 0xb8, 0x50, 0x35, 0x62, 0xcd, 0x07, 0xc4, 0xa8, 0xcf, 0xa6, 0x20, 0xb5,
+	0x81, 0x3d, 0xdc, 0x65, 0xe8, 0xe6, 0x48, 0x7d, 0x1b, 0xb4, 0x32, 0xf6, 0x1a, 0x73, 0x55, 0xf5,
+	0x4b, 0xa4, 0x2d, 0x7f, 0x34, 0xae, 0xbc, 0x7d, 0x0e, 0x66, 0xf9, 0xc3, 0x3e, 0x14, 0x48, 0x2a,
+	0x37, 0x2b, 0xde, 0xe7, 0xa1, 0xf4, 0x0c, 0x2d, 0x41, 0x86, 0x93, 0x45, 0xb1, 0x98, 0xd3, 0x40,
+	0x17, 0xdf, 0xa9, 0xc9, 0x19, 0xb4, 0x0a, 0x68, 0x3b, 0xec, 0xb6, 0x18, 0x91, 0x22, 0xb4, 0x0b,
+	0x59, 0x11, 0x16, 0xe6, 0xa2, 0xd5, 0x80, 0xe5, 0x69, 0x00, 0x7e, 0x12, 0xc3, 0x22, 0x8e, 0xdb,
+	0x55, 0x2f, 0xd4, 0x34, 0x9f, 0xa9, 0xf2, 0x09, 0xf4, 0x73, 0x4d, 0x96, 0xca, 0x7b, 0x5a, 0x00,
+	0xea, 0xa5, 0xb2, 0x79, 0x08, 0xc7, 0xf8, 0xf7, 0xc3, 0xa5, 0x85, 0x01, 0xee, 0xda, 0x6b, 0xc5,
+	0x7d, 0x70, 0x8a, 0xfb, 0x75, 0x25, 0x78, 0x01, 0x3d, 0xdc, 0x42, 0x40, 0x75, 0xc8, 0xef, 0x90,
+	0x81, 0xe1, 0xb9, 0xbe, 0x0c, 0x36, 0x6d, 0x42, 0xd4, 0x9b, 0x66, 0x3e, 0xb8, 0xdf, 0x16, 0x66,
+	0x24, 0xf6, 0x04, 0xa0, 0x4e, 0x39, 0xc9, 0x4f, 0xa7, 0xcf, 0xee, 0x90, 0x81, 0xae, 0xf8, 0xae,
+	0x11, 0x82, 0x6e, 0xc1, 0x7c, 0xc7, 0x76, 0x5b, 0xd8, 0x36, 0x6c, 0x7a, 0xab, 0x4f, 0x2d, 0x43,
+	0x19, 0x86, 0x61, 0xe2, 0x9e, 0x78, 0xd0, 0x1c, 0xbf, 0x9d, 0x72, 0x46, 0x02, 0xdf, 0x10, 0xb8,
+	0x0d, 0x09, 0x5b, 0xc1, 0x3d, 0x74, 0x1b, 0x9e, 0x8a, 0x6c, 0x7d, 0x9f, 0x5d, 0xd3, 0x8f, 0xb5,
+	0xeb, 0x7c, 0x88, 0x3d, 0xb2, 0xf1, 0x2d, 0x98, 0x1f, 0xb2, 0x2b, 0xa3, 0xe7, 0xde, 0x26, 0x9e,
+	0x21, 0x6c, 0x52, 0xbc, 0xa2, 0x1e, 0x43, 0xd6, 0xb8, 0x69, 0x6e, 0x70, 0x58, 0x9d, 0xa3, 0xa2,
+	0xf7, 0xe3, 0x7e, 0x6d, 0x6e, 0xf7, 0x3d, 0xc7, 0xb0, 0x69, 0x97, 0xfa, 0xea, 0x71, 0x75, 0xdc,
+	0xed, 0x22, 0x67, 0xaf, 0x70, 0xcc, 0x1b, 0x1c, 0x72, 0xed, 0x59, 0x1e, 0xf8, 0xee, 0x7e, 0xf3,
+	0xe9, 0xc5, 0x73, 0xd1, 0x1b, 0x6c, 0xe5, 0x4e, 0xd8, 0xa7, 0x95, 0xde, 0xca, 0xdf, 0x31, 0x28,
+	0xaa, 0x29, 0x74, 0xc2, 0x7a, 0xae, 0xc3, 0xc4, 0x93, 0x32, 0xf6, 0xf4, 0xd3, 0x1e, 0xfd, 0xa4,
+	0x8c, 0xf8, 0x87, 0x9e, 0x94, 0xb1, 0x68, 0xfb, 0x6a, 0x94, 0xd2, 0x13, 0x07, 0x19, 0x66, 0x3c,
+	0xd0, 0x28, 0x26, 0x11, 0xc4, 0x27, 0x8a, 0x7f, 0xd2, 0x60, 0x7e, 0x24, 0x30, 0x85, 0x47, 0x36,
+	0x01, 0x79, 0xb1, 0x45, 0xe1, 0xe0, 0x03, 0x75, 0xf4, 0xe3, 0xc5, 0xb9, 0x39, 0x6f, 0x24, 0xa7,
+	0x3f, 0x99, 0xda, 0x44, 0x25, 0xa5, 0x3f, 0x68, 0x70, 0x2a, 0x7e, 0x80, 0x50, 0x94, 0x06, 0xcc,
+	0xc4, 0xb7, 0x56, 0x42, 0x3c, 0x7b, 0x18, 0x21, 0xe2, 0xe7, 0x1f, 0x02, 0x41, 0x5b, 0x51, 0xf0,
+	0x97, 0xdd, 0xe1, 0xd5, 0x43, 0x2b, 0x25, 0x38, 0xd8, 0xbe, 0x49, 0x40, 0xde, 0xcd, 0x3f, 0x34,
+	0x48, 0x6e, 0xb8, 0xae, 0x8d, 0x6e, 0xc1, 0x9c, 0xe3, 0xfa, 0x06, 0x0f, 0x92, 0xc4, 0x32, 0x54,
+	0x27, 0x48, 0x26, 0xd6, 0xda, 0x23, 0x75, 0xf5, 0xf7, 0x87, 0x4b, 0xa3, 0x9c, 0xc3, 0x0a, 0x54,
+	0x1d, 0x52, 0xc7, 0xf5, 0xcb, 0x82, 0x68, 0x53, 0x36, 0x8b, 0xda, 0x90, 0x1d, 0xde, 0x4e, 0x26,
+	0xdf, 0xab, 0x07, 0x6d, 0x97, 0x3d, 0x70, 0xab, 0x99, 0x56, 0x6c, 0x9f, 0xb5, 0x69, 0x7e, 0x6b,
+	0xff, 0xe4, 0x37, 0xf7, 0x1e, 0xe4, 0x43, 0xf7, 0x6e, 0x8a, 0xf6, 0x2a, 0x43, 0xd7, 0x60, 0x4a,
+	0x76, 0x5a, 0x83, 0xb7, 0xdf, 0xf9, 0xa8, 0x77, 0x8f, 0x5b, 0x26, 0x2d, 0xed, 0xc6, 0xfa, 0xee,
+	0x92, 0x69, 0x48, 0x9f, 0x8a, 0x59, 0xb4, 0xdf, 0x1f, 0x24, 0x60, 0xbe, 0xe2, 0x3a, 0x4c, 0xf5,
+	0xed, 0x54, 0x80, 0x96, 0xff, 0x15, 0x0c, 0xd0, 0x8b, 0x63, 0xba, 0x8a, 0x33, 0xa3, 0xbd, 0xc3,
+	0x2d, 0xc8, 0xf1, 0x6a, 0xc9, 0x74, 0x9d, 0xc7, 0x6c, 0x1d, 0x66, 0x5d, 0xdb, 0x52, 0x27, 0xda,
+	0x21, 0x03, 0x8e, 0xeb, 0x90, 0xdb, 0x43, 0xb8, 0x93, 0xc7, 0xc3, 0x75, 0xc8, 0xed, 0x18, 0xee,
+	0x19, 0x48, 0xa9, 0x52, 0x39, 0x29, 0x0a, 0x41, 0x35, 0x42, 0x57, 0x60, 0x92, 0x67, 0xb5, 0x13,
+	0x47, 0x08, 0x1e, 0x9c, 0x21, 0x56, 0xa1, 0x34, 0x60, 0x5e, 0x35, 0x7e, 0xd8, 0xcd, 0xb6, 0xd0,
+	0x28, 0x11, 0x02, 0xbd, 0x49, 0x06, 0xfb, 0x74, 0x81, 0x66, 0x0e, 0xd7, 0x05, 0xfa, 0x9d, 0x06,
+	0x27, 0x85, 0x71, 0xd0, 0x9f, 0x11, 0xd1, 0x5b, 0xd4, 0x89, 0xe9, 0x7a, 0x16, 0x9a, 0x85, 0x04,
+	0xb5, 0xc4, 0x9d, 0x24, 0xf5, 0x04, 0xb5, 0x50, 0x09, 0x4e, 0xb8, 0xb7, 0x1d, 0xe2, 0x29, 0xcb,
+	0x1c, 0x8f, 0x2d, 0xc9, 0x44, 0xc9, 0xe3, 0x5a, 0x7d, 0x9b, 0x18, 0xd8, 0x94, 0x55, 0xae, 0x6c,
+	0xb1, 0x67, 0xe5, 0xec, 0x55, 0x39, 0x89, 0x5e, 0x83, 0x74, 0x18, 0xf9, 0x55, 0x3c, 0x3a, 0x44,
+	0x69, 0x18, 0xf1, 0x5c, 0xfc, 0x4c, 0x03, 0x88, 0x5a, 0xb8, 0xe8, 0x25, 0x38, 0x5b, 0xbe, 0xb9,
+	0x5e, 0x35, 0x1a, 0x9b, 0x57, 0x37, 0x9b, 0x0d, 0xa3, 0xb9, 0xde, 0xd8, 0xa8, 0x55, 0xea, 0xd7,
+	0xea, 0xb5, 0x6a, 0x7e, 0x62, 0x21, 0x77, 0xf7, 0xde, 0x72, 0xa6, 0xe9, 0xb0, 0x1e, 0x31, 0x69,
+	0x9b, 0x12, 0x0b, 0x3d, 0x0f, 0xa7, 0x86, 0xa9, 0xf9, 0xa8, 0x56, 0xcd, 0x6b, 0x0b, 0x33, 0x77,
+	0xef, 0x2d, 0x4f, 0xcb, 0x27, 0x2b, 0xb1, 0xd0, 0x05, 0x38, 0x3d, 0x4a, 0x57, 0x5f, 0x7f, 0x3d,
+	0x9f, 0x58, 0xc8, 0xde, 0xbd, 0xb7, 0x9c, 0x0e, 0xdf, 0xb6, 0xa8, 0x08, 0x28, 0x4e, 0xa9, 0xf0,
+	0x26, 0x17, 0xe0, 0xee, 0xbd, 0xe5, 0x94, 0x74, 0xf9, 0x85, 0xe4, 0x07, 0xbf, 0x5e, 0x9c, 0xb8,
+	0xf8, 0x53, 0x80, 0xba, 0xd3, 0xf6, 0xb0, 0x29, 0x42, 0xdb, 0x02, 0x9c, 0xa9, 0xaf, 0x5f, 0xd3,
+	0xaf, 0x56, 0x36, 0xeb, 0x37, 0xd7, 0x87, 0x8f, 0xbd, 0x67, 0xad, 0x7a, 0xb3, 0x59, 0xbe, 0x51,
+	0x33, 0x1a, 0xf5, 0xd7, 0xd7, 0xf3, 0x1a, 0x3a, 0x0b, 0x27, 0x87, 0xd6, 0x7e, 0xb4, 0xbe, 0x59,
+	0x7f, 0xab, 0x96, 0x4f, 0x5c, 0xdc, 0x02, 0x10, 0x99, 0xf4, 0xed, 0x3e, 0xe9, 0x13, 0x74, 0x0e,
+	0xce, 0x56, 0xae, 0x37, 0xf5, 0x75, 0xe3, 0xed, 0x66, 0xad, 0x59, 0xdb, 0x83, 0x7f, 0x1a, 0xe6,
+	0xe2, 0x8b, 0xb5, 0xf5, 0x4d, 0xfd, 0xdd, 0xbc, 0x86, 0x4e, 0x41, 0x7e, 0x68, 0xfa, 0x9d, 0xfa,
+	0x66, 0x3e, 0x51, 0xbe, 0xf2, 0xf9, 0x57, 0x8b, 0xda, 0x83, 0xaf, 0x16, 0xb5, 0xbf, 0x7d, 0xb5,
+	0xa8, 0x7d, 0xf8, 0xf5, 0xe2, 0xc4, 0x83, 0xaf, 0x17, 0x27, 0xfe, 0xfc, 0xf5, 0xe2, 0xc4, 0x8f,
+	0x9f, 0x1a, 0x0a, 0x52, 0x51, 0x9a, 0x16, 0xff, 0xfa, 0xb5, 0x52, 0xc2, 0x9b, 0xbe, 0xff, 0xdf,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xee, 0x75, 0x8d, 0x6d, 0x1d, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1594,753 +1731,833 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11930 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x94, 0x1c, 0x57,
-		0x5a, 0x18, 0xae, 0xea, 0xee, 0xe9, 0xe9, 0xfe, 0xfa, 0x55, 0x73, 0x67, 0x24, 0x8d, 0x46, 0xb6,
-		0x66, 0x54, 0xb2, 0x2d, 0x59, 0xb6, 0x47, 0x96, 0x6c, 0xcb, 0x76, 0xfb, 0x45, 0x4f, 0x4f, 0x6b,
-		0xd4, 0xf2, 0xbc, 0xb6, 0xba, 0x47, 0x6b, 0x9b, 0x47, 0x51, 0x53, 0x7d, 0x67, 0xa6, 0xac, 0xee,
-		0xaa, 0xde, 0xaa, 0x6a, 0x49, 0xe3, 0xdf, 0x39, 0xbf, 0xb3, 0x04, 0x96, 0x2c, 0xe2, 0x91, 0x25,
-		0x10, 0x58, 0x76, 0x57, 0x8b, 0x81, 0x00, 0xbb, 0x10, 0x08, 0x64, 0x17, 0xc2, 0x86, 0x93, 0x07,
-		0x39, 0x09, 0xe1, 0x71, 0x42, 0x36, 0xfc, 0x11, 0x38, 0x9c, 0x83, 0x03, 0xbb, 0x9c, 0xc3, 0x86,
-		0x5d, 0x08, 0x90, 0x85, 0xc3, 0xc9, 0x9e, 0xe4, 0xe4, 0xdc, 0x57, 0x3d, 0xba, 0xab, 0xa7, 0x7b,
-		0x64, 0x1b, 0x36, 0x90, 0x7f, 0xa4, 0xa9, 0x7b, 0xbf, 0xef, 0xbb, 0xf7, 0x7e, 0xf7, 0xbb, 0xdf,
-		0xeb, 0x3e, 0x1a, 0x7e, 0xff, 0x0a, 0x2c, 0xec, 0xda, 0xf6, 0x6e, 0x1b, 0x5f, 0xe8, 0x3a, 0xb6,
-		0x67, 0x6f, 0xf7, 0x76, 0x2e, 0xb4, 0xb0, 0x6b, 0x38, 0x66, 0xd7, 0xb3, 0x9d, 0x45, 0x5a, 0x86,
-		0x4a, 0x0c, 0x62, 0x51, 0x40, 0x28, 0x6b, 0x30, 0x75, 0xc5, 0x6c, 0xe3, 0x65, 0x1f, 0xb0, 0x81,
-		0x3d, 0xf4, 0x0c, 0xa4, 0x76, 0xcc, 0x36, 0x9e, 0x95, 0x16, 0x92, 0xe7, 0x72, 0x97, 0x1e, 0x58,
-		0xec, 0x43, 0x5a, 0x8c, 0x62, 0x6c, 0x92, 0x62, 0x95, 0x62, 0x28, 0xff, 0x3b, 0x05, 0xd3, 0x31,
-		0xb5, 0x08, 0x41, 0xca, 0xd2, 0x3b, 0x84, 0xa2, 0x74, 0x2e, 0xab, 0xd2, 0xbf, 0xd1, 0x2c, 0x4c,
-		0x76, 0x75, 0xe3, 0x86, 0xbe, 0x8b, 0x67, 0x13, 0xb4, 0x58, 0x7c, 0xa2, 0x53, 0x00, 0x2d, 0xdc,
-		0xc5, 0x56, 0x0b, 0x5b, 0xc6, 0xfe, 0x6c, 0x72, 0x21, 0x79, 0x2e, 0xab, 0x86, 0x4a, 0xd0, 0x23,
-		0x30, 0xd5, 0xed, 0x6d, 0xb7, 0x4d, 0x43, 0x0b, 0x81, 0xc1, 0x42, 0xf2, 0xdc, 0x84, 0x2a, 0xb3,
-		0x8a, 0xe5, 0x00, 0xf8, 0x2c, 0x94, 0x6e, 0x61, 0xfd, 0x46, 0x18, 0x34, 0x47, 0x41, 0x8b, 0xa4,
-		0x38, 0x04, 0x58, 0x85, 0x7c, 0x07, 0xbb, 0xae, 0xbe, 0x8b, 0x35, 0x6f, 0xbf, 0x8b, 0x67, 0x53,
-		0x74, 0xf4, 0x0b, 0x03, 0xa3, 0xef, 0x1f, 0x79, 0x8e, 0x63, 0x35, 0xf7, 0xbb, 0x18, 0x55, 0x20,
-		0x8b, 0xad, 0x5e, 0x87, 0x51, 0x98, 0x18, 0xc2, 0xbf, 0x9a, 0xd5, 0xeb, 0xf4, 0x53, 0xc9, 0x10,
-		0x34, 0x4e, 0x62, 0xd2, 0xc5, 0xce, 0x4d, 0xd3, 0xc0, 0xb3, 0x69, 0x4a, 0xe0, 0xec, 0x00, 0x81,
-		0x06, 0xab, 0xef, 0xa7, 0x21, 0xf0, 0x50, 0x15, 0xb2, 0xf8, 0xb6, 0x87, 0x2d, 0xd7, 0xb4, 0xad,
-		0xd9, 0x49, 0x4a, 0xe4, 0xc1, 0x98, 0x59, 0xc4, 0xed, 0x56, 0x3f, 0x89, 0x00, 0x0f, 0x5d, 0x86,
-		0x49, 0xbb, 0xeb, 0x99, 0xb6, 0xe5, 0xce, 0x66, 0x16, 0xa4, 0x73, 0xb9, 0x4b, 0xf7, 0xc5, 0x0a,
-		0xc2, 0x06, 0x83, 0x51, 0x05, 0x30, 0xaa, 0x83, 0xec, 0xda, 0x3d, 0xc7, 0xc0, 0x9a, 0x61, 0xb7,
-		0xb0, 0x66, 0x5a, 0x3b, 0xf6, 0x6c, 0x96, 0x12, 0x98, 0x1f, 0x1c, 0x08, 0x05, 0xac, 0xda, 0x2d,
-		0x5c, 0xb7, 0x76, 0x6c, 0xb5, 0xe8, 0x46, 0xbe, 0xd1, 0x31, 0x48, 0xbb, 0xfb, 0x96, 0xa7, 0xdf,
-		0x9e, 0xcd, 0x53, 0x09, 0xe1, 0x5f, 0x44, 0x74, 0x70, 0xcb, 0x24, 0xcd, 0xcd, 0x16, 0x98, 0xe8,
-		0xf0, 0x4f, 0xe5, 0x33, 0x69, 0x28, 0x8d, 0x23, 0x7c, 0xcf, 0xc1, 0xc4, 0x0e, 0x19, 0xff, 0x6c,
-		0xe2, 0x30, 0xdc, 0x61, 0x38, 0x51, 0xf6, 0xa6, 0xef, 0x91, 0xbd, 0x15, 0xc8, 0x59, 0xd8, 0xf5,
-		0x70, 0x8b, 0xc9, 0x4a, 0x72, 0x4c, 0x69, 0x03, 0x86, 0x34, 0x28, 0x6c, 0xa9, 0x7b, 0x12, 0xb6,
-		0x57, 0xa0, 0xe4, 0x77, 0x49, 0x73, 0x74, 0x6b, 0x57, 0x48, 0xed, 0x85, 0x51, 0x3d, 0x59, 0xac,
-		0x09, 0x3c, 0x95, 0xa0, 0xa9, 0x45, 0x1c, 0xf9, 0x46, 0xcb, 0x00, 0xb6, 0x85, 0xed, 0x1d, 0xad,
-		0x85, 0x8d, 0xf6, 0x6c, 0x66, 0x08, 0x97, 0x36, 0x08, 0xc8, 0x00, 0x97, 0x6c, 0x56, 0x6a, 0xb4,
-		0xd1, 0xb3, 0x81, 0x10, 0x4e, 0x0e, 0x91, 0xa1, 0x35, 0xb6, 0xfc, 0x06, 0xe4, 0x70, 0x0b, 0x8a,
-		0x0e, 0x26, 0x2b, 0x02, 0xb7, 0xf8, 0xc8, 0xb2, 0xb4, 0x13, 0x8b, 0x23, 0x47, 0xa6, 0x72, 0x34,
-		0x36, 0xb0, 0x82, 0x13, 0xfe, 0x44, 0x67, 0xc0, 0x2f, 0xd0, 0xa8, 0x58, 0x01, 0xd5, 0x4f, 0x79,
-		0x51, 0xb8, 0xae, 0x77, 0xf0, 0xdc, 0x1b, 0x50, 0x8c, 0xb2, 0x07, 0xcd, 0xc0, 0x84, 0xeb, 0xe9,
-		0x8e, 0x47, 0xa5, 0x70, 0x42, 0x65, 0x1f, 0x48, 0x86, 0x24, 0xb6, 0x5a, 0x54, 0xff, 0x4d, 0xa8,
-		0xe4, 0x4f, 0xf4, 0x35, 0xc1, 0x80, 0x93, 0x74, 0xc0, 0x0f, 0x0d, 0xce, 0x68, 0x84, 0x72, 0xff,
-		0xb8, 0xe7, 0x9e, 0x86, 0x42, 0x64, 0x00, 0xe3, 0x36, 0xad, 0xfc, 0x54, 0x0a, 0x8e, 0xc6, 0xd2,
-		0x46, 0xaf, 0xc0, 0x4c, 0xcf, 0x32, 0x2d, 0x0f, 0x3b, 0x5d, 0x07, 0x13, 0x91, 0x65, 0x6d, 0xcd,
-		0xfe, 0xe1, 0xe4, 0x10, 0xa1, 0xdb, 0x0a, 0x43, 0x33, 0x2a, 0xea, 0x74, 0x6f, 0xb0, 0x10, 0xbd,
-		0x0a, 0x39, 0x22, 0x1f, 0xba, 0xa3, 0x53, 0x82, 0x6c, 0x35, 0x5e, 0x1a, 0x6f, 0xc8, 0x8b, 0xcb,
-		0x01, 0xe6, 0x52, 0xf2, 0x83, 0x52, 0x42, 0x0d, 0xd3, 0x42, 0x7b, 0x90, 0xbf, 0x89, 0x1d, 0x73,
-		0xc7, 0x34, 0x18, 0x6d, 0xc2, 0xce, 0xe2, 0xa5, 0x67, 0xc6, 0xa4, 0x7d, 0x3d, 0x84, 0xda, 0xf0,
-		0x74, 0x0f, 0x97, 0x61, 0x6b, 0xfd, 0x7a, 0x4d, 0xad, 0x5f, 0xa9, 0xd7, 0x96, 0xd5, 0x08, 0xe5,
-		0xb9, 0x4f, 0x49, 0x90, 0x0b, 0xf5, 0x85, 0xa8, 0x2d, 0xab, 0xd7, 0xd9, 0xc6, 0x0e, 0xe7, 0x38,
-		0xff, 0x42, 0x27, 0x21, 0xbb, 0xd3, 0x6b, 0xb7, 0x99, 0xd8, 0x30, 0x9b, 0x97, 0x21, 0x05, 0x44,
-		0x64, 0x88, 0x96, 0xe2, 0x8a, 0x80, 0x6a, 0x29, 0xf2, 0x37, 0x3a, 0x03, 0x39, 0xd3, 0xd5, 0x1c,
-		0xdc, 0xc5, 0xba, 0x87, 0x5b, 0xb3, 0xa9, 0x05, 0xe9, 0x5c, 0x66, 0x29, 0x31, 0x2b, 0xa9, 0x60,
-		0xba, 0x2a, 0x2f, 0x45, 0x73, 0x90, 0x11, 0xb2, 0x37, 0x3b, 0x41, 0x20, 0x54, 0xff, 0x9b, 0xd5,
-		0x71, 0xec, 0xb4, 0xa8, 0x63, 0xdf, 0xca, 0x93, 0x30, 0x35, 0x30, 0x48, 0x54, 0x82, 0xdc, 0x72,
-		0xad, 0xba, 0x5a, 0x51, 0x2b, 0xcd, 0xfa, 0xc6, 0xba, 0x7c, 0x04, 0x15, 0x21, 0x34, 0x6e, 0x59,
-		0x3a, 0x9f, 0xcd, 0x7c, 0x61, 0x52, 0x7e, 0xff, 0xfb, 0xdf, 0xff, 0xfe, 0x84, 0xf2, 0x4b, 0x69,
-		0x98, 0x89, 0xd3, 0x72, 0xb1, 0x0a, 0x37, 0xe0, 0x49, 0x32, 0xc2, 0x93, 0x0a, 0x4c, 0xb4, 0xf5,
-		0x6d, 0xdc, 0xa6, 0x83, 0x2b, 0x5e, 0x7a, 0x64, 0x2c, 0x3d, 0xba, 0xb8, 0x4a, 0x50, 0x54, 0x86,
-		0x89, 0x5e, 0xe4, 0x9c, 0x9b, 0xa0, 0x14, 0xce, 0x8f, 0x47, 0x81, 0x68, 0x3f, 0xce, 0xe5, 0x93,
-		0x90, 0x25, 0xff, 0xb3, 0x69, 0x49, 0xb3, 0x69, 0x21, 0x05, 0x74, 0x5a, 0xe6, 0x20, 0x43, 0x15,
-		0x5b, 0x0b, 0xfb, 0x53, 0x26, 0xbe, 0x89, 0x2a, 0x68, 0xe1, 0x1d, 0xbd, 0xd7, 0xf6, 0xb4, 0x9b,
-		0x7a, 0xbb, 0x87, 0xa9, 0x8a, 0xca, 0xaa, 0x79, 0x5e, 0x78, 0x9d, 0x94, 0xa1, 0x79, 0xc8, 0x31,
-		0x3d, 0x68, 0x5a, 0x2d, 0x7c, 0x9b, 0x5a, 0xc2, 0x09, 0x95, 0xa9, 0xc6, 0x3a, 0x29, 0x21, 0xcd,
-		0xbf, 0xee, 0xda, 0x96, 0x50, 0x26, 0xb4, 0x09, 0x52, 0x40, 0x9b, 0x7f, 0xba, 0xdf, 0x08, 0xdf,
-		0x1f, 0x3f, 0xbc, 0x01, 0xed, 0x77, 0x16, 0x4a, 0x14, 0xe2, 0x09, 0xbe, 0x56, 0xf5, 0xf6, 0xec,
-		0x14, 0x15, 0x80, 0x22, 0x2b, 0xde, 0xe0, 0xa5, 0xca, 0xcf, 0x27, 0x20, 0x45, 0x4d, 0x41, 0x09,
-		0x72, 0xcd, 0x57, 0x37, 0x6b, 0xda, 0xf2, 0xc6, 0xd6, 0xd2, 0x6a, 0x4d, 0x96, 0xc8, 0xd4, 0xd3,
-		0x82, 0x2b, 0xab, 0x1b, 0x95, 0xa6, 0x9c, 0xf0, 0xbf, 0xeb, 0xeb, 0xcd, 0xcb, 0x4f, 0xca, 0x49,
-		0x1f, 0x61, 0x8b, 0x15, 0xa4, 0xc2, 0x00, 0x4f, 0x5c, 0x92, 0x27, 0x90, 0x0c, 0x79, 0x46, 0xa0,
-		0xfe, 0x4a, 0x6d, 0xf9, 0xf2, 0x93, 0x72, 0x3a, 0x5a, 0xf2, 0xc4, 0x25, 0x79, 0x12, 0x15, 0x20,
-		0x4b, 0x4b, 0x96, 0x36, 0x36, 0x56, 0xe5, 0x8c, 0x4f, 0xb3, 0xd1, 0x54, 0xeb, 0xeb, 0x2b, 0x72,
-		0xd6, 0xa7, 0xb9, 0xa2, 0x6e, 0x6c, 0x6d, 0xca, 0xe0, 0x53, 0x58, 0xab, 0x35, 0x1a, 0x95, 0x95,
-		0x9a, 0x9c, 0xf3, 0x21, 0x96, 0x5e, 0x6d, 0xd6, 0x1a, 0x72, 0x3e, 0xd2, 0xad, 0x27, 0x2e, 0xc9,
-		0x05, 0xbf, 0x89, 0xda, 0xfa, 0xd6, 0x9a, 0x5c, 0x44, 0x53, 0x50, 0x60, 0x4d, 0x88, 0x4e, 0x94,
-		0xfa, 0x8a, 0x2e, 0x3f, 0x29, 0xcb, 0x41, 0x47, 0x18, 0x95, 0xa9, 0x48, 0xc1, 0xe5, 0x27, 0x65,
-		0xa4, 0x54, 0x61, 0x82, 0x8a, 0x21, 0x42, 0x50, 0x5c, 0xad, 0x2c, 0xd5, 0x56, 0xb5, 0x8d, 0x4d,
-		0xb2, 0x68, 0x2a, 0xab, 0xb2, 0x14, 0x94, 0xa9, 0xb5, 0xf7, 0x6c, 0xd5, 0xd5, 0xda, 0xb2, 0x9c,
-		0x08, 0x97, 0x6d, 0xd6, 0x2a, 0xcd, 0xda, 0xb2, 0x9c, 0x54, 0x0c, 0x98, 0x89, 0x33, 0x81, 0xb1,
-		0x4b, 0x28, 0x24, 0x0b, 0x89, 0x21, 0xb2, 0x40, 0x69, 0xf5, 0xcb, 0x82, 0xf2, 0xf9, 0x04, 0x4c,
-		0xc7, 0xb8, 0x01, 0xb1, 0x8d, 0xbc, 0x04, 0x13, 0x4c, 0x96, 0x99, 0x2a, 0x7e, 0x38, 0xd6, 0x9f,
-		0xa0, 0x92, 0x3d, 0xe0, 0x1c, 0x51, 0xbc, 0xb0, 0xdb, 0x98, 0x1c, 0xe2, 0x36, 0x12, 0x12, 0x03,
-		0x02, 0xfb, 0xf5, 0x03, 0xe6, 0x9a, 0x79, 0x34, 0x97, 0xc7, 0xf1, 0x68, 0x68, 0xd9, 0xe1, 0xcc,
-		0xf6, 0x44, 0x8c, 0xd9, 0x7e, 0x0e, 0xa6, 0x06, 0x08, 0x8d, 0x6d, 0x3e, 0xbf, 0x59, 0x82, 0xd9,
-		0x61, 0xcc, 0x19, 0xa1, 0x12, 0x13, 0x11, 0x95, 0xf8, 0x5c, 0x3f, 0x07, 0x4f, 0x0f, 0x9f, 0x84,
-		0x81, 0xb9, 0xfe, 0x71, 0x09, 0x8e, 0xc5, 0x87, 0x07, 0xb1, 0x7d, 0x78, 0x11, 0xd2, 0x1d, 0xec,
-		0xed, 0xd9, 0xc2, 0x11, 0x7e, 0x28, 0xc6, 0xbd, 0x22, 0xd5, 0xfd, 0x93, 0xcd, 0xb1, 0xc2, 0xfe,
-		0x59, 0x72, 0x98, 0x8f, 0xcf, 0x7a, 0x33, 0xd0, 0xd3, 0x6f, 0x4b, 0xc0, 0xd1, 0x58, 0xe2, 0xb1,
-		0x1d, 0xbd, 0x1f, 0xc0, 0xb4, 0xba, 0x3d, 0x8f, 0x39, 0xbb, 0x4c, 0x13, 0x67, 0x69, 0x09, 0x55,
-		0x5e, 0x44, 0xcb, 0xf6, 0x3c, 0xbf, 0x9e, 0x19, 0x51, 0x60, 0x45, 0x14, 0xe0, 0x99, 0xa0, 0xa3,
-		0x29, 0xda, 0xd1, 0x53, 0x43, 0x46, 0x3a, 0x20, 0x98, 0x8f, 0x83, 0x6c, 0xb4, 0x4d, 0x6c, 0x79,
-		0x9a, 0xeb, 0x39, 0x58, 0xef, 0x98, 0xd6, 0x2e, 0xb3, 0xb3, 0xe5, 0x89, 0x1d, 0xbd, 0xed, 0x62,
-		0xb5, 0xc4, 0xaa, 0x1b, 0xa2, 0x96, 0x60, 0x50, 0x01, 0x72, 0x42, 0x18, 0xe9, 0x08, 0x06, 0xab,
-		0xf6, 0x31, 0x94, 0xef, 0xce, 0x42, 0x2e, 0x14, 0x4c, 0xa1, 0xd3, 0x90, 0x7f, 0x5d, 0xbf, 0xa9,
-		0x6b, 0x22, 0x40, 0x66, 0x9c, 0xc8, 0x91, 0xb2, 0x4d, 0x1e, 0x24, 0x3f, 0x0e, 0x33, 0x14, 0xc4,
-		0xee, 0x79, 0xd8, 0xd1, 0x8c, 0xb6, 0xee, 0xba, 0x94, 0x69, 0x19, 0x0a, 0x8a, 0x48, 0xdd, 0x06,
-		0xa9, 0xaa, 0x8a, 0x1a, 0xf4, 0x14, 0x4c, 0x53, 0x8c, 0x4e, 0xaf, 0xed, 0x99, 0xdd, 0x36, 0xd6,
-		0x48, 0xc8, 0xee, 0x52, 0x93, 0xe3, 0xf7, 0x6c, 0x8a, 0x40, 0xac, 0x71, 0x00, 0xd2, 0x23, 0x17,
-		0x2d, 0xc3, 0xfd, 0x14, 0x6d, 0x17, 0x5b, 0xd8, 0xd1, 0x3d, 0xac, 0xe1, 0xf7, 0xf5, 0xf4, 0xb6,
-		0xab, 0xe9, 0x56, 0x4b, 0xdb, 0xd3, 0xdd, 0xbd, 0xd9, 0x19, 0xdf, 0x2d, 0x39, 0x41, 0x00, 0x57,
-		0x38, 0x5c, 0x8d, 0x82, 0x55, 0xac, 0xd6, 0x55, 0xdd, 0xdd, 0x43, 0x65, 0x38, 0x46, 0xa9, 0xb8,
-		0x9e, 0x63, 0x5a, 0xbb, 0x9a, 0xb1, 0x87, 0x8d, 0x1b, 0x5a, 0xcf, 0xdb, 0x79, 0x66, 0xf6, 0x64,
-		0xb8, 0x7d, 0xda, 0xc3, 0x06, 0x85, 0xa9, 0x12, 0x90, 0x2d, 0x6f, 0xe7, 0x19, 0xd4, 0x80, 0x3c,
-		0x99, 0x8c, 0x8e, 0xf9, 0x06, 0xd6, 0x76, 0x6c, 0x87, 0xda, 0xd0, 0x62, 0x8c, 0x6a, 0x0a, 0x71,
-		0x70, 0x71, 0x83, 0x23, 0xac, 0xd9, 0x2d, 0x5c, 0x9e, 0x68, 0x6c, 0xd6, 0x6a, 0xcb, 0x6a, 0x4e,
-		0x50, 0xb9, 0x62, 0x3b, 0x44, 0xa0, 0x76, 0x6d, 0x9f, 0xc1, 0x39, 0x26, 0x50, 0xbb, 0xb6, 0x60,
-		0xef, 0x53, 0x30, 0x6d, 0x18, 0x6c, 0xcc, 0xa6, 0xa1, 0xf1, 0xc0, 0xda, 0x9d, 0x95, 0x23, 0xcc,
-		0x32, 0x8c, 0x15, 0x06, 0xc0, 0x65, 0xdc, 0x45, 0xcf, 0xc2, 0xd1, 0x80, 0x59, 0x61, 0xc4, 0xa9,
-		0x81, 0x51, 0xf6, 0xa3, 0x3e, 0x05, 0xd3, 0xdd, 0xfd, 0x41, 0x44, 0x14, 0x69, 0xb1, 0xbb, 0xdf,
-		0x8f, 0xf6, 0x34, 0xcc, 0x74, 0xf7, 0xba, 0x83, 0x78, 0xe7, 0xc3, 0x78, 0xa8, 0xbb, 0xd7, 0xed,
-		0x47, 0x7c, 0x90, 0x66, 0x59, 0x1c, 0x6c, 0x50, 0xef, 0xf0, 0x78, 0x18, 0x3c, 0x54, 0x81, 0x16,
-		0x41, 0x36, 0x0c, 0x0d, 0x5b, 0xfa, 0x76, 0x1b, 0x6b, 0xba, 0x83, 0x2d, 0xdd, 0x9d, 0x9d, 0xa7,
-		0xc0, 0x29, 0xcf, 0xe9, 0x61, 0xb5, 0x68, 0x18, 0x35, 0x5a, 0x59, 0xa1, 0x75, 0xe8, 0x3c, 0x4c,
-		0xd9, 0xdb, 0xaf, 0x1b, 0x4c, 0x22, 0xb5, 0xae, 0x83, 0x77, 0xcc, 0xdb, 0xb3, 0x0f, 0x50, 0xf6,
-		0x96, 0x48, 0x05, 0x95, 0xc7, 0x4d, 0x5a, 0x8c, 0x1e, 0x06, 0xd9, 0x70, 0xf7, 0x74, 0xa7, 0x4b,
-		0x55, 0xb2, 0xdb, 0xd5, 0x0d, 0x3c, 0xfb, 0x20, 0x03, 0x65, 0xe5, 0xeb, 0xa2, 0x98, 0xac, 0x08,
-		0xf7, 0x96, 0xb9, 0xe3, 0x09, 0x8a, 0x67, 0xd9, 0x8a, 0xa0, 0x65, 0x9c, 0xda, 0x39, 0x90, 0x09,
-		0x27, 0x22, 0x0d, 0x9f, 0xa3, 0x60, 0xc5, 0xee, 0x5e, 0x37, 0xdc, 0xee, 0x19, 0x28, 0x10, 0xc8,
-		0xa0, 0xd1, 0x87, 0x99, 0xe3, 0xd6, 0xdd, 0x0b, 0xb5, 0xf8, 0x24, 0x1c, 0x23, 0x40, 0x1d, 0xec,
-		0xe9, 0x2d, 0xdd, 0xd3, 0x43, 0xd0, 0x8f, 0x52, 0x68, 0xc2, 0xf6, 0x35, 0x5e, 0x19, 0xe9, 0xa7,
-		0xd3, 0xdb, 0xde, 0xf7, 0x05, 0xeb, 0x31, 0xd6, 0x4f, 0x52, 0x26, 0x44, 0xeb, 0x5d, 0x8b, 0xa6,
-		0x94, 0x32, 0xe4, 0xc3, 0x72, 0x8f, 0xb2, 0xc0, 0x24, 0x5f, 0x96, 0x88, 0x13, 0x54, 0xdd, 0x58,
-		0x26, 0xee, 0xcb, 0x6b, 0x35, 0x39, 0x41, 0xdc, 0xa8, 0xd5, 0x7a, 0xb3, 0xa6, 0xa9, 0x5b, 0xeb,
-		0xcd, 0xfa, 0x5a, 0x4d, 0x4e, 0x86, 0x1c, 0xfb, 0x6b, 0xa9, 0xcc, 0x43, 0xf2, 0x59, 0xe5, 0x17,
-		0x93, 0x50, 0x8c, 0xc6, 0xd6, 0xe8, 0x79, 0x38, 0x2e, 0x52, 0x64, 0x2e, 0xf6, 0xb4, 0x5b, 0xa6,
-		0x43, 0x17, 0x64, 0x47, 0x67, 0xc6, 0xd1, 0x97, 0x9f, 0x19, 0x0e, 0xd5, 0xc0, 0xde, 0x7b, 0x4d,
-		0x87, 0x2c, 0xb7, 0x8e, 0xee, 0xa1, 0x55, 0x98, 0xb7, 0x6c, 0xcd, 0xf5, 0x74, 0xab, 0xa5, 0x3b,
-		0x2d, 0x2d, 0x48, 0x4e, 0x6a, 0xba, 0x61, 0x60, 0xd7, 0xb5, 0x99, 0x21, 0xf4, 0xa9, 0xdc, 0x67,
-		0xd9, 0x0d, 0x0e, 0x1c, 0x58, 0x88, 0x0a, 0x07, 0xed, 0x13, 0xdf, 0xe4, 0x30, 0xf1, 0x3d, 0x09,
-		0xd9, 0x8e, 0xde, 0xd5, 0xb0, 0xe5, 0x39, 0xfb, 0xd4, 0x3f, 0xcf, 0xa8, 0x99, 0x8e, 0xde, 0xad,
-		0x91, 0x6f, 0x74, 0x1d, 0x1e, 0x0a, 0x40, 0xb5, 0x36, 0xde, 0xd5, 0x8d, 0x7d, 0x8d, 0x3a, 0xe3,
-		0x34, 0xd1, 0xa3, 0x19, 0xb6, 0xb5, 0xd3, 0x36, 0x0d, 0xcf, 0xa5, 0xfa, 0x81, 0xe9, 0x38, 0x25,
-		0xc0, 0x58, 0xa5, 0x08, 0xd7, 0x5c, 0xdb, 0xa2, 0x3e, 0x78, 0x55, 0x40, 0xbf, 0x7b, 0x33, 0x1c,
-		0x9d, 0xa5, 0x94, 0x3c, 0x71, 0x2d, 0x95, 0x99, 0x90, 0xd3, 0xd7, 0x52, 0x99, 0xb4, 0x3c, 0x79,
-		0x2d, 0x95, 0xc9, 0xc8, 0xd9, 0x6b, 0xa9, 0x4c, 0x56, 0x06, 0xe5, 0x03, 0x59, 0xc8, 0x87, 0x23,
-		0x03, 0x12, 0x68, 0x19, 0xd4, 0x36, 0x4a, 0x54, 0x7b, 0x9e, 0x39, 0x30, 0x8e, 0x58, 0xac, 0x12,
-		0xa3, 0x59, 0x4e, 0x33, 0x37, 0x5c, 0x65, 0x98, 0xc4, 0x61, 0x21, 0x62, 0x8d, 0x99, 0xdb, 0x93,
-		0x51, 0xf9, 0x17, 0x5a, 0x81, 0xf4, 0xeb, 0x2e, 0xa5, 0x9d, 0xa6, 0xb4, 0x1f, 0x38, 0x98, 0xf6,
-		0xb5, 0x06, 0x25, 0x9e, 0xbd, 0xd6, 0xd0, 0xd6, 0x37, 0xd4, 0xb5, 0xca, 0xaa, 0xca, 0xd1, 0xd1,
-		0x09, 0x48, 0xb5, 0xf5, 0x37, 0xf6, 0xa3, 0xe6, 0x95, 0x16, 0xa1, 0x45, 0x28, 0xf5, 0x2c, 0x16,
-		0x75, 0x93, 0xa9, 0x22, 0x50, 0xa5, 0x30, 0x54, 0x31, 0xa8, 0x5d, 0x25, 0xf0, 0x63, 0x8a, 0xc7,
-		0x09, 0x48, 0xdd, 0xc2, 0xfa, 0x8d, 0xa8, 0x11, 0xa4, 0x45, 0xe8, 0x1c, 0xe4, 0x5b, 0x78, 0xbb,
-		0xb7, 0xab, 0x39, 0xb8, 0xa5, 0x1b, 0x5e, 0x54, 0xf5, 0xe7, 0x68, 0x95, 0x4a, 0x6b, 0xd0, 0xcb,
-		0x90, 0x25, 0x73, 0x64, 0xd1, 0x39, 0x9e, 0xa2, 0x2c, 0x78, 0xec, 0x60, 0x16, 0xf0, 0x29, 0x16,
-		0x48, 0x6a, 0x80, 0x8f, 0xae, 0x41, 0xda, 0xd3, 0x9d, 0x5d, 0xec, 0x51, 0xcd, 0x5f, 0x8c, 0x49,
-		0x57, 0xc5, 0x50, 0x6a, 0x52, 0x0c, 0xc2, 0x56, 0x2a, 0xa3, 0x9c, 0x02, 0xba, 0x0a, 0x93, 0xec,
-		0x2f, 0x77, 0x76, 0x7a, 0x21, 0x79, 0x78, 0x62, 0xaa, 0x40, 0x7f, 0x17, 0x75, 0xd6, 0x05, 0x98,
-		0xa0, 0xc2, 0x86, 0x00, 0xb8, 0xb8, 0xc9, 0x47, 0x50, 0x06, 0x52, 0xd5, 0x0d, 0x95, 0xe8, 0x2d,
-		0x19, 0xf2, 0xac, 0x54, 0xdb, 0xac, 0xd7, 0xaa, 0x35, 0x39, 0xa1, 0x3c, 0x05, 0x69, 0x26, 0x41,
-		0x44, 0xa7, 0xf9, 0x32, 0x24, 0x1f, 0xe1, 0x9f, 0x9c, 0x86, 0x24, 0x6a, 0xb7, 0xd6, 0x96, 0x6a,
-		0xaa, 0x9c, 0x50, 0xb6, 0xa0, 0xd4, 0xc7, 0x75, 0x74, 0x14, 0xa6, 0xd4, 0x5a, 0xb3, 0xb6, 0x4e,
-		0xa2, 0x36, 0x6d, 0x6b, 0xfd, 0xe5, 0xf5, 0x8d, 0xf7, 0xae, 0xcb, 0x47, 0xa2, 0xc5, 0x42, 0x41,
-		0x4a, 0x68, 0x06, 0xe4, 0xa0, 0xb8, 0xb1, 0xb1, 0xa5, 0xd2, 0xde, 0x7c, 0x47, 0x02, 0xe4, 0x7e,
-		0xb6, 0xa1, 0xe3, 0x30, 0xdd, 0xac, 0xa8, 0x2b, 0xb5, 0xa6, 0xc6, 0x22, 0x51, 0x9f, 0xf4, 0x0c,
-		0xc8, 0xe1, 0x8a, 0x2b, 0x75, 0x1a, 0x68, 0xcf, 0xc3, 0xc9, 0x70, 0x69, 0xed, 0x95, 0x66, 0x6d,
-		0xbd, 0x41, 0x1b, 0xaf, 0xac, 0xaf, 0x10, 0x6d, 0xdd, 0x47, 0x4f, 0xc4, 0xbe, 0x49, 0xd2, 0xd5,
-		0x28, 0xbd, 0xda, 0xea, 0xb2, 0x9c, 0xea, 0x2f, 0xde, 0x58, 0xaf, 0x6d, 0x5c, 0x91, 0x27, 0xfa,
-		0x5b, 0xa7, 0xf1, 0x70, 0x1a, 0xcd, 0xc1, 0xb1, 0xfe, 0x52, 0xad, 0xb6, 0xde, 0x54, 0x5f, 0x95,
-		0x27, 0xfb, 0x1b, 0x6e, 0xd4, 0xd4, 0xeb, 0xf5, 0x6a, 0x4d, 0xce, 0xa0, 0x63, 0x80, 0xa2, 0x3d,
-		0x6a, 0x5e, 0xdd, 0x58, 0x96, 0xb3, 0x03, 0xfa, 0x49, 0x71, 0x21, 0x1f, 0x0e, 0x4a, 0xff, 0x5a,
-		0x54, 0xa3, 0xf2, 0xe1, 0x04, 0xe4, 0x42, 0x41, 0x26, 0x89, 0x0e, 0xf4, 0x76, 0xdb, 0xbe, 0xa5,
-		0xe9, 0x6d, 0x53, 0x77, 0xb9, 0xf6, 0x02, 0x5a, 0x54, 0x21, 0x25, 0xe3, 0x6a, 0x8b, 0xf1, 0xed,
-		0x45, 0xfa, 0xab, 0xd1, 0x5e, 0x4c, 0xc8, 0x69, 0xe5, 0xe3, 0x12, 0xc8, 0xfd, 0xd1, 0x63, 0xdf,
-		0xf0, 0xa5, 0x61, 0xc3, 0xff, 0x6b, 0x99, 0xbb, 0x8f, 0x49, 0x50, 0x8c, 0x86, 0x8c, 0x7d, 0xdd,
-		0x3b, 0xfd, 0x37, 0xda, 0xbd, 0xdf, 0x4b, 0x40, 0x21, 0x12, 0x28, 0x8e, 0xdb, 0xbb, 0xf7, 0xc1,
-		0x94, 0xd9, 0xc2, 0x9d, 0xae, 0xed, 0x61, 0xcb, 0xd8, 0xd7, 0xda, 0xf8, 0x26, 0x6e, 0xcf, 0x2a,
-		0x54, 0xc5, 0x5f, 0x38, 0x38, 0x14, 0x5d, 0xac, 0x07, 0x78, 0xab, 0x04, 0xad, 0x3c, 0x5d, 0x5f,
-		0xae, 0xad, 0x6d, 0x6e, 0x34, 0x6b, 0xeb, 0xd5, 0x57, 0x85, 0x76, 0x51, 0x65, 0xb3, 0x0f, 0xec,
-		0x5d, 0x54, 0xda, 0x9b, 0x20, 0xf7, 0x77, 0x8a, 0xe8, 0x8a, 0x98, 0x6e, 0xc9, 0x47, 0xd0, 0x34,
-		0x94, 0xd6, 0x37, 0xb4, 0x46, 0x7d, 0xb9, 0xa6, 0xd5, 0xae, 0x5c, 0xa9, 0x55, 0x9b, 0x0d, 0x96,
-		0x5c, 0xf4, 0xa1, 0x9b, 0x72, 0x22, 0xcc, 0xe2, 0x8f, 0x24, 0x61, 0x3a, 0xa6, 0x27, 0xa8, 0xc2,
-		0xd3, 0x02, 0x2c, 0x53, 0xf1, 0xd8, 0x38, 0xbd, 0x5f, 0x24, 0x8e, 0xf9, 0xa6, 0xee, 0x78, 0x3c,
-		0x8b, 0xf0, 0x30, 0x10, 0x2e, 0x59, 0x1e, 0xf1, 0x13, 0x1c, 0x9e, 0xb4, 0x65, 0xb9, 0x82, 0x52,
-		0x50, 0xce, 0xf2, 0xb6, 0x8f, 0x02, 0xea, 0xda, 0xae, 0xe9, 0x99, 0x37, 0xb1, 0x66, 0x5a, 0x22,
-		0xc3, 0x9b, 0x5a, 0x90, 0xce, 0xa5, 0x54, 0x59, 0xd4, 0xd4, 0x2d, 0xcf, 0x87, 0xb6, 0xf0, 0xae,
-		0xde, 0x07, 0x4d, 0xfc, 0x98, 0xa4, 0x2a, 0x8b, 0x1a, 0x1f, 0xfa, 0x34, 0xe4, 0x5b, 0x76, 0x8f,
-		0x04, 0x54, 0x0c, 0x8e, 0x68, 0x0b, 0x49, 0xcd, 0xb1, 0x32, 0x1f, 0x84, 0x87, 0xca, 0x41, 0x6a,
-		0x39, 0xaf, 0xe6, 0x58, 0x19, 0x03, 0x39, 0x0b, 0x25, 0x7d, 0x77, 0xd7, 0x21, 0xc4, 0x05, 0x21,
-		0x16, 0xfc, 0x17, 0xfd, 0x62, 0x0a, 0x38, 0x77, 0x0d, 0x32, 0x82, 0x0f, 0xc4, 0x1f, 0x26, 0x9c,
-		0xd0, 0xba, 0x2c, 0xa3, 0x95, 0x38, 0x97, 0x55, 0x33, 0x96, 0xa8, 0x3c, 0x0d, 0x79, 0xd3, 0xd5,
-		0x82, 0xbd, 0xcd, 0xc4, 0x42, 0xe2, 0x5c, 0x46, 0xcd, 0x99, 0xae, 0xbf, 0x47, 0xa2, 0xfc, 0x78,
-		0x02, 0x8a, 0xd1, 0x5d, 0x5b, 0xb4, 0x0c, 0x99, 0xb6, 0xcd, 0x37, 0x59, 0xd8, 0x91, 0x81, 0x73,
-		0x23, 0x36, 0x7a, 0x17, 0x57, 0x39, 0xbc, 0xea, 0x63, 0xce, 0xfd, 0x86, 0x04, 0x19, 0x51, 0x8c,
-		0x8e, 0x41, 0xaa, 0xab, 0x7b, 0x7b, 0x94, 0xdc, 0xc4, 0x52, 0x42, 0x96, 0x54, 0xfa, 0x4d, 0xca,
-		0xdd, 0xae, 0xce, 0xf6, 0x89, 0x78, 0x39, 0xf9, 0x26, 0xf3, 0xda, 0xc6, 0x7a, 0x8b, 0x66, 0x16,
-		0xec, 0x4e, 0x07, 0x5b, 0x9e, 0x2b, 0xe6, 0x95, 0x97, 0x57, 0x79, 0x31, 0x7a, 0x04, 0xa6, 0x3c,
-		0x47, 0x37, 0xdb, 0x11, 0xd8, 0x14, 0x85, 0x95, 0x45, 0x85, 0x0f, 0x5c, 0x86, 0x13, 0x82, 0x6e,
-		0x0b, 0x7b, 0xba, 0xb1, 0x87, 0x5b, 0x01, 0x52, 0x9a, 0x66, 0x10, 0x8f, 0x73, 0x80, 0x65, 0x5e,
-		0x2f, 0x70, 0x95, 0xcf, 0x26, 0x60, 0x4a, 0xe4, 0x42, 0x5a, 0x3e, 0xb3, 0xd6, 0x00, 0x74, 0xcb,
-		0xb2, 0xbd, 0x30, 0xbb, 0x06, 0x45, 0x79, 0x00, 0x6f, 0xb1, 0xe2, 0x23, 0xa9, 0x21, 0x02, 0x73,
-		0x5f, 0x94, 0x00, 0x82, 0xaa, 0xa1, 0x7c, 0x9b, 0x87, 0x1c, 0xdf, 0x93, 0xa7, 0x07, 0x3b, 0x58,
-		0xfa, 0x0c, 0x58, 0xd1, 0x15, 0xb3, 0x4d, 0x93, 0x9c, 0xdb, 0x78, 0xd7, 0xb4, 0xf8, 0xee, 0x0c,
-		0xfb, 0x10, 0x49, 0xce, 0x54, 0xb0, 0x3d, 0xa9, 0x42, 0xc6, 0xc5, 0x1d, 0xdd, 0xf2, 0x4c, 0x83,
-		0xef, 0xb7, 0x5c, 0x3e, 0x54, 0xe7, 0x17, 0x1b, 0x1c, 0x5b, 0xf5, 0xe9, 0x28, 0xe7, 0x20, 0x23,
-		0x4a, 0x89, 0xe3, 0xb7, 0xbe, 0xb1, 0x5e, 0x93, 0x8f, 0xa0, 0x49, 0x48, 0x36, 0x6a, 0x4d, 0x59,
-		0x22, 0x41, 0x6c, 0x65, 0xb5, 0x5e, 0x69, 0xc8, 0x89, 0xa5, 0xff, 0x1f, 0xa6, 0x0d, 0xbb, 0xd3,
-		0xdf, 0xe0, 0x92, 0xdc, 0x97, 0x40, 0x74, 0xaf, 0x4a, 0xaf, 0x3d, 0xc6, 0x81, 0x76, 0xed, 0xb6,
-		0x6e, 0xed, 0x2e, 0xda, 0xce, 0x6e, 0x70, 0x2c, 0x86, 0xc4, 0x1a, 0x6e, 0xe8, 0x70, 0x4c, 0x77,
-		0xfb, 0xaf, 0x24, 0xe9, 0x87, 0x13, 0xc9, 0x95, 0xcd, 0xa5, 0x9f, 0x48, 0xcc, 0xad, 0x30, 0xc4,
-		0x4d, 0x31, 0x1c, 0x15, 0xef, 0xb4, 0xb1, 0x41, 0x3a, 0x0f, 0x1f, 0x4d, 0xc1, 0x94, 0xde, 0x31,
-		0x2d, 0xfb, 0x02, 0xfd, 0x97, 0x1f, 0xaa, 0x99, 0xa0, 0x1f, 0x73, 0x23, 0x4f, 0xdf, 0x94, 0x2f,
-		0x33, 0x05, 0x86, 0x46, 0xed, 0x61, 0xcf, 0xfe, 0xd9, 0x77, 0xfc, 0xd8, 0x44, 0x90, 0xfb, 0x2c,
-		0xaf, 0x81, 0x2c, 0xc2, 0x6e, 0x6c, 0x19, 0x36, 0x91, 0xb6, 0xd1, 0x34, 0xfe, 0x5c, 0xd0, 0x28,
-		0x71, 0xdc, 0x1a, 0x47, 0x2d, 0x3f, 0x0f, 0x19, 0x9f, 0xcc, 0xc1, 0xdb, 0x49, 0xb3, 0xff, 0x43,
-		0x10, 0xf1, 0x31, 0xca, 0x2f, 0x01, 0x30, 0x67, 0x87, 0xa5, 0x65, 0x0f, 0xc6, 0xff, 0xb2, 0xc0,
-		0xcf, 0x52, 0x1c, 0xa2, 0x85, 0xca, 0x2b, 0x50, 0x6c, 0xd9, 0x96, 0xa7, 0xd9, 0x1d, 0xd3, 0xc3,
-		0x9d, 0xae, 0xb7, 0x3f, 0x8a, 0xc8, 0x5f, 0x30, 0x22, 0x19, 0xb5, 0x40, 0xf0, 0x36, 0x04, 0x1a,
-		0xe9, 0x09, 0xdb, 0x59, 0x1b, 0xa7, 0x27, 0x7f, 0xe9, 0xf7, 0x84, 0xe2, 0x90, 0x9e, 0x2c, 0xd5,
-		0x7e, 0xe5, 0x73, 0xa7, 0xa4, 0xcf, 0x7e, 0xee, 0x94, 0xf4, 0x7b, 0x9f, 0x3b, 0x25, 0x7d, 0xe8,
-		0xf3, 0xa7, 0x8e, 0x7c, 0xf6, 0xf3, 0xa7, 0x8e, 0xfc, 0xf6, 0xe7, 0x4f, 0x1d, 0x79, 0xed, 0x91,
-		0x5d, 0xd3, 0xdb, 0xeb, 0x6d, 0x2f, 0x1a, 0x76, 0xe7, 0x82, 0x61, 0xbb, 0x1d, 0xdb, 0xe5, 0xff,
-		0x3d, 0xe6, 0xb6, 0x6e, 0x70, 0xf9, 0xf1, 0x6e, 0x33, 0x29, 0xd8, 0x4e, 0xb3, 0x1d, 0x35, 0xf8,
-		0xe3, 0x47, 0x60, 0x66, 0xd7, 0xde, 0xb5, 0xe9, 0xe7, 0x05, 0xf2, 0x17, 0x17, 0x90, 0xac, 0x5f,
-		0x3a, 0x86, 0x90, 0xac, 0xc3, 0x34, 0x07, 0xd6, 0xe8, 0xe1, 0x0e, 0x96, 0xc8, 0x42, 0x07, 0xee,
-		0xa2, 0xcc, 0xfe, 0xec, 0x1f, 0x50, 0x9f, 0x55, 0x9d, 0xe2, 0xa8, 0xa4, 0x8e, 0xe5, 0xba, 0xca,
-		0x2a, 0x1c, 0x8d, 0xd0, 0x63, 0x16, 0x04, 0x3b, 0x23, 0x28, 0xfe, 0x7b, 0x4e, 0x71, 0x3a, 0x44,
-		0xb1, 0xc1, 0x51, 0xcb, 0x55, 0x28, 0x1c, 0x86, 0xd6, 0x2f, 0x73, 0x5a, 0x79, 0x1c, 0x26, 0xb2,
-		0x02, 0x25, 0x4a, 0xc4, 0xe8, 0xb9, 0x9e, 0xdd, 0xa1, 0x73, 0x78, 0x30, 0x99, 0xff, 0xf0, 0x07,
-		0x4c, 0xa5, 0x17, 0x09, 0x5a, 0xd5, 0xc7, 0x2a, 0x97, 0x81, 0x9e, 0x67, 0x69, 0x61, 0xa3, 0x3d,
-		0x82, 0xc2, 0xaf, 0xf0, 0x8e, 0xf8, 0xf0, 0xe5, 0xeb, 0x30, 0x43, 0xfe, 0xa6, 0xd6, 0x33, 0xdc,
-		0x93, 0xd1, 0x5b, 0x2e, 0xb3, 0xff, 0xf9, 0x9b, 0x99, 0xd5, 0x98, 0xf6, 0x09, 0x84, 0xfa, 0x14,
-		0x9a, 0xc5, 0x5d, 0xec, 0x79, 0xd8, 0x71, 0x35, 0xbd, 0x1d, 0xd7, 0xbd, 0x50, 0xce, 0x7a, 0xf6,
-		0x07, 0xbe, 0x14, 0x9d, 0xc5, 0x15, 0x86, 0x59, 0x69, 0xb7, 0xcb, 0x5b, 0x70, 0x3c, 0x46, 0x2a,
-		0xc6, 0xa0, 0xf9, 0x11, 0x4e, 0x73, 0x66, 0x40, 0x32, 0x08, 0xd9, 0x4d, 0x10, 0xe5, 0xfe, 0x5c,
-		0x8e, 0x41, 0xf3, 0xa3, 0x9c, 0x26, 0xe2, 0xb8, 0x62, 0x4a, 0x09, 0xc5, 0x6b, 0x30, 0x75, 0x13,
-		0x3b, 0xdb, 0xb6, 0xcb, 0xf7, 0x09, 0xc6, 0x20, 0xf7, 0x31, 0x4e, 0xae, 0xc4, 0x11, 0xe9, 0xc6,
-		0x01, 0xa1, 0xf5, 0x2c, 0x64, 0x76, 0x74, 0x03, 0x8f, 0x41, 0xe2, 0x2e, 0x27, 0x31, 0x49, 0xe0,
-		0x09, 0x6a, 0x05, 0xf2, 0xbb, 0x36, 0x77, 0xa0, 0x46, 0xa3, 0x7f, 0x9c, 0xa3, 0xe7, 0x04, 0x0e,
-		0x27, 0xd1, 0xb5, 0xbb, 0xbd, 0x36, 0xf1, 0xae, 0x46, 0x93, 0xf8, 0x41, 0x41, 0x42, 0xe0, 0x70,
-		0x12, 0x87, 0x60, 0xeb, 0x9b, 0x82, 0x84, 0x1b, 0xe2, 0xe7, 0x4b, 0x90, 0xb3, 0xad, 0xf6, 0xbe,
-		0x6d, 0x8d, 0xd3, 0x89, 0x1f, 0xe2, 0x14, 0x80, 0xa3, 0x10, 0x02, 0xcf, 0x41, 0x76, 0xdc, 0x89,
-		0xf8, 0xd1, 0x2f, 0x89, 0xe5, 0x21, 0x66, 0x60, 0x05, 0x4a, 0x42, 0x41, 0x99, 0xb6, 0x35, 0x06,
-		0x89, 0x1f, 0xe3, 0x24, 0x8a, 0x21, 0x34, 0x3e, 0x0c, 0x0f, 0xbb, 0xde, 0x2e, 0x1e, 0x87, 0xc8,
-		0x8f, 0x8b, 0x61, 0x70, 0x14, 0xce, 0xca, 0x6d, 0x6c, 0x19, 0x7b, 0xe3, 0x51, 0xf8, 0x84, 0x60,
-		0xa5, 0xc0, 0x21, 0x24, 0xaa, 0x50, 0xe8, 0xe8, 0x8e, 0xbb, 0xa7, 0xb7, 0xc7, 0x9a, 0x8e, 0x4f,
-		0x72, 0x1a, 0x79, 0x1f, 0x89, 0x73, 0xa4, 0x67, 0x1d, 0x86, 0xcc, 0x4f, 0x08, 0x8e, 0x84, 0xd0,
-		0xf8, 0xd2, 0x73, 0x3d, 0xba, 0xa9, 0x72, 0x18, 0x6a, 0x3f, 0x29, 0x96, 0x1e, 0xc3, 0x5d, 0x0b,
-		0x53, 0x7c, 0x0e, 0xb2, 0xae, 0xf9, 0xc6, 0x58, 0x64, 0xfe, 0x89, 0x98, 0x69, 0x8a, 0x40, 0x90,
-		0x5f, 0x85, 0x13, 0xb1, 0x66, 0x62, 0x0c, 0x62, 0x3f, 0xc5, 0x89, 0x1d, 0x8b, 0x31, 0x15, 0x5c,
-		0x25, 0x1c, 0x96, 0xe4, 0x4f, 0x0b, 0x95, 0x80, 0xfb, 0x68, 0x6d, 0x92, 0x90, 0xd6, 0xd5, 0x77,
-		0x0e, 0xc7, 0xb5, 0x7f, 0x2a, 0xb8, 0xc6, 0x70, 0x23, 0x5c, 0x6b, 0xc2, 0x31, 0x4e, 0xf1, 0x70,
-		0xf3, 0xfa, 0x33, 0x42, 0xb1, 0x32, 0xec, 0xad, 0xe8, 0xec, 0x7e, 0x2d, 0xcc, 0xf9, 0xec, 0x14,
-		0xb1, 0x93, 0xab, 0x75, 0xf4, 0xee, 0x18, 0x94, 0x7f, 0x96, 0x53, 0x16, 0x1a, 0xdf, 0x0f, 0xbe,
-		0xdc, 0x35, 0xbd, 0x4b, 0x88, 0xbf, 0x02, 0xb3, 0x82, 0x78, 0xcf, 0x72, 0xb0, 0x61, 0xef, 0x5a,
-		0xe6, 0x1b, 0xb8, 0x35, 0x06, 0xe9, 0x7f, 0xd6, 0x37, 0x55, 0x5b, 0x21, 0x74, 0x42, 0xb9, 0x0e,
-		0xb2, 0xef, 0xab, 0x68, 0x66, 0xa7, 0x6b, 0x3b, 0xde, 0x08, 0x8a, 0x9f, 0x12, 0x33, 0xe5, 0xe3,
-		0xd5, 0x29, 0x5a, 0xb9, 0x06, 0xec, 0xa4, 0xd1, 0xb8, 0x22, 0xf9, 0x69, 0x4e, 0xa8, 0x10, 0x60,
-		0x71, 0xc5, 0x61, 0xd8, 0x9d, 0xae, 0xee, 0x8c, 0xa3, 0xff, 0x7e, 0x4e, 0x28, 0x0e, 0x8e, 0xc2,
-		0x15, 0x07, 0xf1, 0xd7, 0x88, 0xb5, 0x1f, 0x83, 0xc2, 0xcf, 0x0b, 0xc5, 0x21, 0x70, 0x38, 0x09,
-		0xe1, 0x30, 0x8c, 0x41, 0xe2, 0x9f, 0x0b, 0x12, 0x02, 0x87, 0x90, 0x78, 0x4f, 0x60, 0x68, 0x1d,
-		0xbc, 0x6b, 0xba, 0x1e, 0x3f, 0x2a, 0x78, 0x30, 0xa9, 0x5f, 0xf8, 0x52, 0xd4, 0x09, 0x53, 0x43,
-		0xa8, 0x44, 0x13, 0x71, 0xcf, 0x9e, 0x06, 0xf4, 0xa3, 0x3b, 0xf6, 0x19, 0xa1, 0x89, 0x42, 0x68,
-		0xa4, 0x6f, 0x21, 0x0f, 0x91, 0xb0, 0xdd, 0x20, 0x61, 0xec, 0x18, 0xe4, 0xfe, 0x45, 0x5f, 0xe7,
-		0x1a, 0x02, 0x97, 0xd0, 0x0c, 0xf9, 0x3f, 0x3d, 0xeb, 0x06, 0xde, 0x1f, 0x4b, 0x3a, 0x7f, 0xb1,
-		0xcf, 0xff, 0xd9, 0x62, 0x98, 0x4c, 0x87, 0x94, 0xfa, 0xfc, 0xa9, 0xd1, 0x11, 0xd0, 0x37, 0x7d,
-		0x99, 0x8f, 0x37, 0xea, 0x4e, 0x95, 0x57, 0x89, 0x90, 0x47, 0x9d, 0x9e, 0xd1, 0xc4, 0xbe, 0xf9,
-		0xcb, 0xbe, 0x9c, 0x47, 0x7c, 0x9e, 0xf2, 0x15, 0x28, 0x44, 0x1c, 0x9e, 0xd1, 0xa4, 0xbe, 0x85,
-		0x93, 0xca, 0x87, 0xfd, 0x9d, 0xf2, 0x53, 0x90, 0x22, 0xce, 0xcb, 0x68, 0xf4, 0x0f, 0x70, 0x74,
-		0x0a, 0x5e, 0x7e, 0x01, 0x32, 0xc2, 0x69, 0x19, 0x8d, 0xfa, 0xad, 0x1c, 0xd5, 0x47, 0x21, 0xe8,
-		0xc2, 0x61, 0x19, 0x8d, 0xfe, 0xf7, 0x05, 0xba, 0x40, 0x21, 0xe8, 0xe3, 0xb3, 0xf0, 0xdf, 0x7c,
-		0x7b, 0x8a, 0x1b, 0x1d, 0xc1, 0xbb, 0xe7, 0x60, 0x92, 0x7b, 0x2a, 0xa3, 0xb1, 0xbf, 0x8d, 0x37,
-		0x2e, 0x30, 0xca, 0x4f, 0xc3, 0xc4, 0x98, 0x0c, 0xff, 0x4e, 0x8e, 0xca, 0xe0, 0xcb, 0x55, 0xc8,
-		0x85, 0xbc, 0x93, 0xd1, 0xe8, 0xdf, 0xc5, 0xd1, 0xc3, 0x58, 0xa4, 0xeb, 0xdc, 0x3b, 0x19, 0x4d,
-		0xe0, 0x1f, 0x88, 0xae, 0x73, 0x0c, 0xc2, 0x36, 0xe1, 0x98, 0x8c, 0xc6, 0xfe, 0x90, 0xe0, 0xba,
-		0x40, 0x29, 0xbf, 0x04, 0x59, 0xdf, 0xd8, 0x8c, 0xc6, 0xff, 0x6e, 0x8e, 0x1f, 0xe0, 0x10, 0x0e,
-		0x84, 0x8c, 0xdd, 0x68, 0x12, 0xff, 0x50, 0x70, 0x20, 0x84, 0x45, 0x96, 0x51, 0xbf, 0x03, 0x33,
-		0x9a, 0xd2, 0xf7, 0x88, 0x65, 0xd4, 0xe7, 0xbf, 0x90, 0xd9, 0xa4, 0x3a, 0x7f, 0x34, 0x89, 0xef,
-		0x15, 0xb3, 0x49, 0xe1, 0x49, 0x37, 0xfa, 0x3d, 0x82, 0xd1, 0x34, 0xbe, 0x5f, 0x74, 0xa3, 0xcf,
-		0x21, 0x28, 0x6f, 0x02, 0x1a, 0xf4, 0x06, 0x46, 0xd3, 0xfb, 0x30, 0xa7, 0x37, 0x35, 0xe0, 0x0c,
-		0x94, 0xdf, 0x0b, 0xc7, 0xe2, 0x3d, 0x81, 0xd1, 0x54, 0x7f, 0xe0, 0xcb, 0x7d, 0xb1, 0x5b, 0xd8,
-		0x11, 0x28, 0x37, 0x03, 0x93, 0x12, 0xf6, 0x02, 0x46, 0x93, 0xfd, 0xc8, 0x97, 0xa3, 0x8a, 0x3b,
-		0xec, 0x04, 0x94, 0x2b, 0x00, 0x81, 0x01, 0x1e, 0x4d, 0xeb, 0x63, 0x9c, 0x56, 0x08, 0x89, 0x2c,
-		0x0d, 0x6e, 0x7f, 0x47, 0xe3, 0xdf, 0x15, 0x4b, 0x83, 0x63, 0x90, 0xa5, 0x21, 0x4c, 0xef, 0x68,
-		0xec, 0x8f, 0x8b, 0xa5, 0x21, 0x50, 0x88, 0x64, 0x87, 0xac, 0xdb, 0x68, 0x0a, 0x3f, 0x24, 0x24,
-		0x3b, 0x84, 0x55, 0x5e, 0x87, 0xa9, 0x01, 0x83, 0x38, 0x9a, 0xd4, 0x0f, 0x73, 0x52, 0x72, 0xbf,
-		0x3d, 0x0c, 0x1b, 0x2f, 0x6e, 0x0c, 0x47, 0x53, 0xfb, 0x91, 0x3e, 0xe3, 0xc5, 0x6d, 0x61, 0xf9,
-		0x39, 0xc8, 0x58, 0xbd, 0x76, 0x9b, 0x2c, 0x9e, 0x51, 0x29, 0xaf, 0xff, 0xf6, 0x15, 0xce, 0x1d,
-		0x81, 0x50, 0x7e, 0x0a, 0x26, 0x70, 0x67, 0x1b, 0xb7, 0x46, 0x61, 0xfe, 0xd1, 0x57, 0x84, 0xc2,
-		0x24, 0xd0, 0xe5, 0x97, 0x00, 0x58, 0x6a, 0x84, 0x1e, 0xd2, 0x18, 0x81, 0xfb, 0xc5, 0xaf, 0xf0,
-		0xc3, 0x97, 0x01, 0x4a, 0x40, 0x60, 0x9c, 0x4c, 0xdd, 0x97, 0xa2, 0x04, 0xe8, 0x8c, 0x3c, 0x0b,
-		0x93, 0xaf, 0xbb, 0xb6, 0xe5, 0xe9, 0x23, 0x33, 0x96, 0x7f, 0xcc, 0xb1, 0x05, 0x3c, 0x61, 0x58,
-		0xc7, 0x76, 0xb0, 0xa7, 0xef, 0xba, 0xa3, 0x70, 0xff, 0x84, 0xe3, 0xfa, 0x08, 0x04, 0xd9, 0xd0,
-		0x5d, 0x6f, 0x9c, 0x71, 0xff, 0x77, 0x81, 0x2c, 0x10, 0x48, 0xa7, 0xc9, 0xdf, 0x37, 0xf0, 0xc8,
-		0x0c, 0xe7, 0x9f, 0x8a, 0x4e, 0x73, 0xf8, 0xf2, 0x0b, 0x90, 0x25, 0x7f, 0xb2, 0x13, 0xd5, 0x23,
-		0x90, 0xff, 0x8c, 0x23, 0x07, 0x18, 0xa4, 0x65, 0xd7, 0x6b, 0x79, 0xe6, 0x68, 0x66, 0xff, 0x39,
-		0x9f, 0x69, 0x01, 0x5f, 0xae, 0x40, 0xce, 0xf5, 0x5a, 0xad, 0x1e, 0xf7, 0x4f, 0x47, 0xe5, 0x87,
-		0xbf, 0xe2, 0xa7, 0x2c, 0x7c, 0x1c, 0x32, 0xdb, 0xb7, 0x6e, 0x78, 0x5d, 0x9b, 0xee, 0xc6, 0x8d,
-		0xcc, 0x10, 0x73, 0x0a, 0x21, 0x94, 0x72, 0x15, 0xf2, 0x64, 0x2c, 0xe2, 0xa6, 0xca, 0xc8, 0xfc,
-		0x30, 0x67, 0x40, 0x04, 0x69, 0xe9, 0x1b, 0x87, 0x25, 0x77, 0xe3, 0xf7, 0x10, 0x60, 0xc5, 0x5e,
-		0xb1, 0xd9, 0xee, 0xc1, 0x6b, 0x0f, 0x0e, 0x66, 0x7f, 0xa3, 0x79, 0x5d, 0xfa, 0x17, 0xfc, 0x4f,
-		0x09, 0xee, 0x37, 0xec, 0x0e, 0xf6, 0xb6, 0x77, 0xbc, 0x0b, 0x86, 0xb3, 0xdf, 0xf5, 0xec, 0x0b,
-		0x37, 0x2f, 0x5e, 0xb8, 0x81, 0xf7, 0x5d, 0x9e, 0xf8, 0x45, 0xa2, 0x7a, 0x91, 0x55, 0x2f, 0xde,
-		0xbc, 0x38, 0x17, 0x9b, 0x22, 0x56, 0x5e, 0x81, 0xec, 0x26, 0xbd, 0xb9, 0xfa, 0x32, 0xde, 0x47,
-		0x73, 0x30, 0x89, 0x5b, 0x97, 0x9e, 0x7a, 0xea, 0xe2, 0xb3, 0x74, 0x2f, 0x3e, 0x7f, 0xf5, 0x88,
-		0x2a, 0x0a, 0xd0, 0x29, 0xc8, 0xba, 0xd8, 0xe8, 0x5e, 0x7a, 0xea, 0xf2, 0x8d, 0x8b, 0x74, 0x1f,
-		0x87, 0xd4, 0x06, 0x45, 0xe5, 0xcc, 0x17, 0xde, 0x9c, 0x97, 0xbe, 0xf0, 0x43, 0xf3, 0xd2, 0xd2,
-		0x04, 0x24, 0xdd, 0x5e, 0x67, 0x69, 0x6d, 0x68, 0x92, 0xfb, 0x89, 0xc8, 0x30, 0xc5, 0x38, 0xc4,
-		0x1f, 0x7a, 0xd7, 0xbc, 0x30, 0x38, 0x3a, 0x3f, 0xd9, 0xfd, 0xc9, 0x14, 0x9c, 0x8a, 0x19, 0x7c,
-		0xd7, 0xb1, 0xed, 0x9d, 0x43, 0x8f, 0x7e, 0x07, 0x26, 0x36, 0x09, 0x22, 0x9a, 0x81, 0x09, 0xcf,
-		0xf6, 0xf4, 0x36, 0x1d, 0x77, 0x52, 0x65, 0x1f, 0xa4, 0x94, 0x5d, 0x9e, 0x49, 0xb0, 0x52, 0x53,
-		0xdc, 0x9b, 0x69, 0x63, 0x7d, 0x87, 0x9d, 0x41, 0x4e, 0xd2, 0xed, 0xd1, 0x0c, 0x29, 0xa0, 0xc7,
-		0x8d, 0x67, 0x60, 0x42, 0xef, 0xb1, 0x9d, 0xbd, 0xe4, 0xb9, 0xbc, 0xca, 0x3e, 0x94, 0x55, 0x98,
-		0xe4, 0x29, 0x5c, 0x24, 0x43, 0xf2, 0x06, 0xde, 0x67, 0xfc, 0x55, 0xc9, 0x9f, 0xe8, 0x02, 0x4c,
-		0xd0, 0xde, 0xf3, 0xcb, 0x15, 0x27, 0x16, 0x07, 0xbb, 0xbf, 0x48, 0x7b, 0xa9, 0x32, 0x38, 0xe5,
-		0x1a, 0x64, 0x96, 0xed, 0x8e, 0x69, 0xd9, 0x51, 0x72, 0x59, 0x46, 0x8e, 0x76, 0xba, 0xdb, 0xf3,
-		0xf8, 0x66, 0x1b, 0xfb, 0x40, 0xc7, 0x20, 0xcd, 0x0e, 0xa5, 0xf3, 0xed, 0x49, 0xfe, 0xa5, 0x54,
-		0x61, 0x92, 0xd2, 0xde, 0xe8, 0xfa, 0x17, 0xc1, 0xa4, 0xd0, 0x45, 0x30, 0x4e, 0x3e, 0x11, 0xf4,
-		0x16, 0x41, 0xaa, 0xa5, 0x7b, 0x3a, 0x1f, 0x38, 0xfd, 0x5b, 0x79, 0x09, 0x32, 0x9c, 0x88, 0x8b,
-		0x9e, 0x80, 0xa4, 0xdd, 0x75, 0xf9, 0x06, 0xe3, 0xc9, 0xa1, 0x63, 0xd9, 0xe8, 0x2e, 0xa5, 0x7e,
-		0xe5, 0xad, 0xf9, 0x23, 0x2a, 0x81, 0x7e, 0xb7, 0x64, 0xe5, 0x7b, 0x12, 0x70, 0x6a, 0x60, 0xdf,
-		0x83, 0x6b, 0x8b, 0x61, 0x17, 0xd3, 0xcb, 0x90, 0x59, 0x16, 0x4a, 0x68, 0x16, 0x26, 0x5d, 0x6c,
-		0xd8, 0x56, 0xcb, 0xe5, 0x72, 0x21, 0x3e, 0x09, 0x93, 0x2d, 0xdd, 0xb2, 0x5d, 0x7e, 0x83, 0x82,
-		0x7d, 0x2c, 0x7d, 0x54, 0x3a, 0xdc, 0xda, 0x2f, 0x88, 0x96, 0xe8, 0xfa, 0xdf, 0x94, 0x5e, 0xbb,
-		0x38, 0x72, 0xf3, 0xf0, 0x86, 0x65, 0xdf, 0xb2, 0xfc, 0x41, 0x44, 0x36, 0x10, 0x4f, 0xf5, 0x6f,
-		0x20, 0xbe, 0x17, 0xb7, 0xdb, 0x2f, 0x13, 0xf8, 0x26, 0x41, 0xf5, 0xb9, 0xf2, 0xf1, 0xd4, 0x20,
-		0x57, 0x6e, 0x39, 0x7a, 0xb7, 0x8b, 0x1d, 0x77, 0x18, 0x57, 0xce, 0x40, 0x6e, 0x39, 0x74, 0x48,
-		0x60, 0x46, 0x5c, 0xd6, 0x91, 0xe8, 0x01, 0x02, 0xf6, 0xa1, 0x28, 0x00, 0x57, 0xda, 0xb6, 0xee,
-		0xc5, 0xc0, 0x24, 0x42, 0x30, 0x75, 0xcb, 0xbb, 0xfc, 0x64, 0x0c, 0x4c, 0x52, 0xc0, 0x9c, 0x81,
-		0xdc, 0xd6, 0x30, 0xa0, 0x54, 0x94, 0xd0, 0x13, 0x97, 0x62, 0x60, 0x26, 0xfa, 0x08, 0xc5, 0x02,
-		0x15, 0x04, 0xd0, 0x69, 0xc8, 0x2e, 0xd9, 0x76, 0x3b, 0x06, 0x24, 0x13, 0xa2, 0xd3, 0x08, 0x9d,
-		0x7f, 0x88, 0x00, 0x65, 0x43, 0x1d, 0x5a, 0xda, 0xf7, 0xb0, 0x1b, 0x03, 0x93, 0xe7, 0x30, 0x87,
-		0x17, 0x90, 0xf7, 0xf2, 0x79, 0x39, 0xac, 0x80, 0x88, 0xf9, 0xbc, 0x27, 0x01, 0xf9, 0xfe, 0x7c,
-		0x48, 0xc5, 0x32, 0xaa, 0x44, 0xc3, 0xea, 0x8e, 0xde, 0x11, 0x02, 0x32, 0xe5, 0xaf, 0x6b, 0x5a,
-		0x3f, 0x54, 0xc3, 0xce, 0x8d, 0x58, 0x7f, 0x73, 0x23, 0x24, 0x51, 0xf9, 0x4c, 0x12, 0x4a, 0x55,
-		0xdb, 0x72, 0xb1, 0xe5, 0xf6, 0xdc, 0x4d, 0xda, 0x05, 0xf4, 0x24, 0x4c, 0x6c, 0xb7, 0x6d, 0xe3,
-		0x06, 0xe5, 0x6d, 0xee, 0xd2, 0xa9, 0xc5, 0x81, 0xce, 0x2c, 0x2e, 0x91, 0x7a, 0x06, 0xae, 0x32,
-		0x60, 0xf4, 0x02, 0x64, 0xf0, 0x4d, 0xb3, 0x85, 0x2d, 0x03, 0x73, 0x4d, 0x7b, 0x3a, 0x06, 0xb1,
-		0xc6, 0x41, 0x38, 0xae, 0x8f, 0x82, 0xbe, 0x06, 0xb2, 0x37, 0xf5, 0xb6, 0xd9, 0xd2, 0x3d, 0xdb,
-		0xe1, 0x57, 0x8e, 0x94, 0x18, 0xfc, 0xeb, 0x02, 0x86, 0x13, 0x08, 0x90, 0x50, 0x19, 0x26, 0x6f,
-		0x62, 0x87, 0x9e, 0x6f, 0x61, 0x37, 0x81, 0x16, 0xe2, 0xf0, 0x19, 0x04, 0xc7, 0x16, 0x08, 0xe8,
-		0x29, 0x48, 0xe9, 0xdb, 0x86, 0x49, 0x8f, 0x3e, 0xe4, 0x2e, 0xdd, 0x1f, 0x83, 0x58, 0x59, 0xaa,
-		0xd6, 0x19, 0x16, 0x3d, 0xfd, 0x47, 0xc1, 0x49, 0xa7, 0xdd, 0x7d, 0xcb, 0xd8, 0x73, 0x6c, 0x6b,
-		0x9f, 0x1e, 0xf6, 0x89, 0xef, 0x74, 0x43, 0xc0, 0x88, 0x4e, 0xfb, 0x48, 0xa4, 0xd3, 0x3b, 0x58,
-		0xf7, 0x7a, 0x0e, 0xe6, 0xf7, 0xe0, 0xe3, 0x3a, 0x7d, 0x85, 0x41, 0x88, 0x4e, 0x73, 0x04, 0xa5,
-		0x0e, 0xb9, 0xd0, 0x3c, 0xb0, 0x13, 0xf1, 0xb7, 0xb5, 0x6d, 0xb2, 0x48, 0xf8, 0x82, 0xcf, 0x74,
-		0xf4, 0xdb, 0x74, 0xd1, 0xa0, 0xe3, 0x30, 0x49, 0x2a, 0x77, 0xf9, 0x29, 0xc9, 0xa4, 0x9a, 0xee,
-		0xe8, 0xb7, 0x57, 0x74, 0xf7, 0x5a, 0x2a, 0x93, 0x94, 0x53, 0xca, 0x27, 0x25, 0x28, 0x46, 0xa7,
-		0x06, 0x3d, 0x02, 0x88, 0x60, 0xe8, 0xbb, 0x58, 0xb3, 0x7a, 0x1d, 0x8d, 0x4e, 0xb2, 0xa0, 0x5b,
-		0xea, 0xe8, 0xb7, 0x2b, 0xbb, 0x78, 0xbd, 0xd7, 0xa1, 0x1d, 0x70, 0xd1, 0x1a, 0xc8, 0x02, 0x58,
-		0x08, 0xa0, 0x6f, 0x6e, 0x07, 0x6e, 0xe5, 0x73, 0x80, 0xa5, 0x0c, 0x31, 0x50, 0x1f, 0xfe, 0xaf,
-		0xf3, 0x92, 0x5a, 0x64, 0xf4, 0x7c, 0xc3, 0x10, 0x19, 0x4a, 0x32, 0x3a, 0x14, 0xe5, 0x25, 0x28,
-		0xf5, 0x49, 0x01, 0x52, 0xa0, 0xd0, 0xed, 0x6d, 0x6b, 0x37, 0xf0, 0x3e, 0xbd, 0x26, 0xc6, 0xcc,
-		0x63, 0x56, 0xcd, 0x75, 0x7b, 0xdb, 0x2f, 0xe3, 0x7d, 0xba, 0xfa, 0xca, 0x99, 0x5f, 0x20, 0x0e,
-		0xd4, 0x9b, 0xf3, 0x92, 0xf2, 0x08, 0x14, 0x22, 0x62, 0x40, 0xac, 0xb0, 0xde, 0xed, 0x72, 0xfd,
-		0x47, 0xfe, 0x0c, 0x01, 0xbf, 0x06, 0x79, 0xe2, 0x78, 0xe0, 0x16, 0x87, 0x7d, 0x08, 0x4a, 0x94,
-		0x15, 0x5a, 0x3f, 0xaf, 0x0b, 0xb4, 0x78, 0x4d, 0x30, 0x5c, 0x81, 0x42, 0x00, 0x17, 0xb0, 0x3d,
-		0x27, 0xa0, 0x56, 0x74, 0x57, 0xf9, 0x3e, 0x09, 0x4a, 0x7d, 0xb2, 0x81, 0x5e, 0x80, 0x6c, 0xd7,
-		0xc1, 0x86, 0xe9, 0xb2, 0x63, 0x44, 0x23, 0x58, 0x98, 0xa2, 0xec, 0x0b, 0x30, 0xd0, 0x32, 0x14,
-		0xc4, 0x91, 0x92, 0x16, 0x6e, 0xeb, 0xfb, 0xa3, 0x67, 0x81, 0x91, 0x10, 0x4f, 0xa4, 0x2c, 0x13,
-		0x24, 0xe5, 0x97, 0x25, 0x28, 0x44, 0x84, 0x0e, 0xb5, 0xe0, 0xfe, 0x9b, 0xb6, 0x87, 0xc3, 0xa9,
-		0x0e, 0x7e, 0x75, 0x68, 0x0f, 0x9b, 0xbb, 0x7b, 0x1e, 0xef, 0xea, 0xc9, 0x81, 0x76, 0x02, 0x43,
-		0x43, 0x1d, 0x12, 0x49, 0x9d, 0x23, 0x74, 0x82, 0x8c, 0x07, 0xbb, 0x63, 0x74, 0x95, 0x12, 0x41,
-		0x1b, 0x80, 0xba, 0xdb, 0x5e, 0x3f, 0xe9, 0xc4, 0xb8, 0xa4, 0x65, 0x82, 0x1c, 0x26, 0xa8, 0x34,
-		0x00, 0x82, 0x85, 0x8b, 0x2a, 0xe3, 0x0c, 0x22, 0x79, 0x50, 0x0f, 0xcb, 0x89, 0x59, 0x69, 0x69,
-		0xf3, 0x13, 0x9f, 0x3b, 0x35, 0xd4, 0xd0, 0xbc, 0x76, 0x69, 0x7c, 0x8f, 0x4a, 0xe8, 0x7e, 0xdf,
-		0x32, 0xfc, 0x65, 0x1a, 0x4e, 0x0f, 0x5a, 0x06, 0x5f, 0xc5, 0x1d, 0xd6, 0x38, 0x1c, 0x1c, 0xc5,
-		0x28, 0x9f, 0x96, 0x20, 0xef, 0xaf, 0xa4, 0x06, 0xf6, 0xd0, 0xf3, 0x00, 0x7e, 0x5b, 0xc2, 0xc5,
-		0xbc, 0xef, 0x20, 0x25, 0xac, 0x86, 0xe0, 0xd1, 0x33, 0x90, 0xe9, 0x3a, 0x76, 0xd7, 0x76, 0xf9,
-		0xc5, 0xd7, 0x51, 0xb8, 0x3e, 0x34, 0x7a, 0x14, 0x10, 0x0d, 0x08, 0xb4, 0x9b, 0xb6, 0x67, 0x5a,
-		0xbb, 0x5a, 0xd7, 0xbe, 0xc5, 0xdf, 0x13, 0x48, 0xaa, 0x32, 0xad, 0xb9, 0x4e, 0x2b, 0x36, 0x49,
-		0xb9, 0xf2, 0x29, 0x09, 0xb2, 0x3e, 0x15, 0xe2, 0x43, 0xea, 0xad, 0x96, 0x83, 0x5d, 0x97, 0xbb,
-		0x02, 0xe2, 0x13, 0x3d, 0x0f, 0x93, 0x5c, 0x29, 0xf8, 0xd7, 0xaa, 0xe3, 0xbc, 0x65, 0x11, 0x9d,
-		0x71, 0x7f, 0x39, 0xcd, 0x74, 0x06, 0x3a, 0x0d, 0xf9, 0x98, 0xde, 0xe4, 0x6e, 0x06, 0x1d, 0xa1,
-		0xcf, 0x15, 0xf1, 0x21, 0x68, 0x5d, 0xc7, 0xb4, 0x1d, 0xd3, 0xdb, 0xa7, 0xa6, 0x27, 0xa9, 0xca,
-		0xa2, 0x62, 0x93, 0x97, 0x2b, 0x6d, 0x28, 0x35, 0xcc, 0x4e, 0x97, 0x7a, 0x78, 0xbc, 0xeb, 0x97,
-		0x83, 0x0e, 0x4a, 0x63, 0x74, 0x70, 0x68, 0xd7, 0x12, 0x03, 0x5d, 0x3b, 0xff, 0x5b, 0x12, 0xb7,
-		0x0d, 0xf5, 0xe5, 0x2b, 0x6d, 0x7d, 0x17, 0x5d, 0x84, 0xa3, 0x4b, 0xab, 0x1b, 0xd5, 0x97, 0xb5,
-		0xfa, 0xb2, 0x76, 0x65, 0xb5, 0xb2, 0x12, 0x9c, 0xe2, 0x9d, 0x3b, 0x76, 0xe7, 0xee, 0x02, 0x0a,
-		0xc1, 0x6e, 0x59, 0xd4, 0xc5, 0x41, 0x17, 0x60, 0x26, 0x8a, 0x52, 0x59, 0x6a, 0xd4, 0xd6, 0x9b,
-		0xb2, 0x34, 0x77, 0xf4, 0xce, 0xdd, 0x85, 0xa9, 0x10, 0x46, 0x65, 0xdb, 0xc5, 0x96, 0x37, 0x88,
-		0x50, 0xdd, 0x58, 0x5b, 0xab, 0x37, 0xe5, 0xc4, 0x00, 0x42, 0xd5, 0xee, 0x74, 0x4c, 0x0f, 0x3d,
-		0x0c, 0x53, 0x51, 0x84, 0xf5, 0xfa, 0xaa, 0x9c, 0x9c, 0x43, 0x77, 0xee, 0x2e, 0x14, 0x43, 0xd0,
-		0xeb, 0x66, 0x7b, 0x2e, 0xf3, 0xc1, 0x1f, 0x39, 0x75, 0xe4, 0x13, 0xff, 0xf8, 0x94, 0xb4, 0xb4,
-		0xfa, 0xae, 0x2c, 0xbc, 0xef, 0x4f, 0xc0, 0x7c, 0xbf, 0xa7, 0xe4, 0x99, 0x1d, 0xec, 0x7a, 0x7a,
-		0xa7, 0x3b, 0xcc, 0x69, 0x7f, 0x0e, 0xb2, 0x4d, 0x01, 0x73, 0xe8, 0x58, 0xe6, 0xee, 0x21, 0x5d,
-		0xd5, 0xa2, 0xdf, 0x94, 0xf0, 0x55, 0x2f, 0x8d, 0xe9, 0xab, 0xfa, 0xe3, 0xb8, 0x27, 0x67, 0xf5,
-		0x77, 0x1b, 0x70, 0x5f, 0xc0, 0xc4, 0x6d, 0xc3, 0x24, 0x4a, 0x84, 0xad, 0x66, 0xc6, 0x16, 0xd9,
-		0x97, 0x59, 0x52, 0x4b, 0x94, 0xd1, 0xc1, 0x6a, 0x67, 0x6e, 0x44, 0x7a, 0x61, 0x6e, 0x84, 0x6f,
-		0x3c, 0x37, 0x5a, 0x43, 0x0e, 0x51, 0x87, 0xa3, 0x66, 0x58, 0xf9, 0x4f, 0x59, 0x98, 0x54, 0xf1,
-		0xfb, 0x7a, 0xd8, 0xf5, 0xd0, 0x13, 0x90, 0xc2, 0xc6, 0x9e, 0x3d, 0xb8, 0x32, 0xf9, 0x28, 0x17,
-		0x6b, 0xc6, 0x9e, 0xcd, 0x81, 0xaf, 0x1e, 0x51, 0x29, 0x30, 0xba, 0x0c, 0x13, 0x3b, 0xed, 0x9e,
-		0xbb, 0xc7, 0x15, 0xce, 0xa9, 0x41, 0xac, 0x2b, 0xa4, 0x3a, 0x40, 0x63, 0xe0, 0xa4, 0x31, 0xfa,
-		0x9c, 0x56, 0x72, 0x58, 0x63, 0xf4, 0x15, 0xad, 0xa0, 0x31, 0x02, 0x8c, 0xaa, 0x00, 0xa6, 0x65,
-		0x7a, 0x9a, 0xb1, 0xa7, 0x9b, 0x16, 0xf7, 0x5c, 0x95, 0x38, 0x54, 0xd3, 0xab, 0x12, 0x90, 0x00,
-		0x3f, 0x6b, 0x8a, 0x32, 0xd2, 0xe3, 0xf7, 0xf5, 0xb0, 0x23, 0xbc, 0xd7, 0x98, 0x1e, 0xbf, 0x87,
-		0x54, 0x87, 0x7a, 0x4c, 0xc1, 0x89, 0xb7, 0xcf, 0xae, 0x7a, 0x7b, 0xb7, 0xf9, 0x03, 0x26, 0x0b,
-		0x83, 0xa8, 0xf4, 0xa6, 0x77, 0xf3, 0x76, 0x80, 0x3c, 0x69, 0xb0, 0x12, 0xf4, 0x2c, 0xa4, 0x0d,
-		0xaa, 0x04, 0xe8, 0x05, 0xcc, 0xdc, 0xa5, 0xf9, 0x18, 0x64, 0x5a, 0x1f, 0xe0, 0x72, 0x04, 0xb4,
-		0x01, 0xc5, 0xb6, 0xe9, 0x7a, 0x9a, 0x6b, 0xe9, 0x5d, 0x77, 0xcf, 0xf6, 0x5c, 0xfa, 0x86, 0x58,
-		0xee, 0xd2, 0x43, 0x83, 0x24, 0x56, 0x4d, 0xd7, 0x6b, 0x08, 0xb0, 0x80, 0x52, 0xa1, 0x1d, 0x2e,
-		0x27, 0x04, 0xed, 0x9d, 0x1d, 0xec, 0xf8, 0x14, 0xe9, 0xdb, 0x63, 0xb1, 0x04, 0x37, 0x08, 0x9c,
-		0xc0, 0x0c, 0x11, 0xb4, 0xc3, 0xe5, 0xe8, 0xeb, 0x60, 0xba, 0x6d, 0xeb, 0x2d, 0x9f, 0x9e, 0x66,
-		0xec, 0xf5, 0xac, 0x1b, 0xb3, 0x45, 0x4a, 0xf5, 0x7c, 0x4c, 0x37, 0x6d, 0xbd, 0x25, 0x90, 0xab,
-		0x04, 0x34, 0xa0, 0x3c, 0xd5, 0xee, 0xaf, 0x43, 0x1a, 0xcc, 0xe8, 0xdd, 0x6e, 0x7b, 0xbf, 0x9f,
-		0x7c, 0x89, 0x92, 0x7f, 0x64, 0x90, 0x7c, 0x85, 0x40, 0x0f, 0xa1, 0x8f, 0xf4, 0x81, 0x4a, 0xb4,
-		0x05, 0x72, 0xd7, 0xc1, 0xf4, 0x64, 0x06, 0xb3, 0x62, 0x7a, 0x9b, 0xde, 0x91, 0xcc, 0x5d, 0x3a,
-		0x37, 0x48, 0x7c, 0x93, 0x41, 0x6e, 0x72, 0xc0, 0x80, 0x72, 0xa9, 0x1b, 0xad, 0x61, 0x64, 0x6d,
-		0x03, 0xd3, 0x3b, 0xdc, 0x9c, 0xec, 0xd4, 0x70, 0xb2, 0x14, 0x32, 0x96, 0x6c, 0xa4, 0x06, 0x5d,
-		0x81, 0x1c, 0x7b, 0xb7, 0x87, 0x38, 0x0f, 0x98, 0xde, 0xad, 0xcc, 0x5d, 0x3a, 0x13, 0xb3, 0x5c,
-		0x29, 0xd0, 0x75, 0xdb, 0xc3, 0x01, 0x31, 0xc0, 0x7e, 0x21, 0xda, 0x86, 0xa3, 0xf4, 0x9e, 0xe9,
-		0xbe, 0x16, 0x75, 0x11, 0x67, 0xa7, 0x29, 0xc5, 0x47, 0x07, 0x29, 0xd2, 0x47, 0x96, 0xf6, 0xaf,
-		0x87, 0x7d, 0xc5, 0x80, 0xf4, 0xf4, 0xcd, 0xc1, 0x5a, 0x22, 0x69, 0x3b, 0xa6, 0xa5, 0xb7, 0xcd,
-		0x37, 0x30, 0x8b, 0xa7, 0xe8, 0x13, 0x0b, 0xb1, 0x92, 0x76, 0x85, 0xc3, 0x51, 0x3b, 0x18, 0x92,
-		0xb4, 0x9d, 0x70, 0xf9, 0xd2, 0x24, 0xcf, 0x82, 0xf8, 0x77, 0x86, 0x27, 0xe5, 0x0c, 0xbb, 0x27,
-		0x7c, 0x2d, 0x95, 0x01, 0x39, 0xa7, 0x9c, 0x85, 0x5c, 0x48, 0x4f, 0x11, 0x23, 0xc5, 0xfd, 0x7c,
-		0x9e, 0x5b, 0x11, 0x9f, 0x4a, 0x11, 0xf2, 0x61, 0xd5, 0xa4, 0x7c, 0x48, 0x82, 0x5c, 0x48, 0xe9,
-		0x10, 0x4c, 0x11, 0x5c, 0x73, 0x4c, 0x11, 0x3a, 0x9f, 0x11, 0x81, 0x8e, 0xa8, 0x4f, 0xd0, 0x30,
-		0x2a, 0x4f, 0x0b, 0x79, 0x9c, 0x85, 0xe6, 0x21, 0xd7, 0xbd, 0xd4, 0xf5, 0x41, 0x92, 0x14, 0x04,
-		0xba, 0x97, 0xba, 0x02, 0xe0, 0x34, 0xe4, 0xc9, 0xd0, 0xb5, 0x70, 0x04, 0x9f, 0x55, 0x73, 0xa4,
-		0x8c, 0x83, 0x28, 0xbf, 0x91, 0x00, 0xb9, 0x5f, 0x99, 0xa1, 0x67, 0x20, 0x45, 0xb4, 0x38, 0x57,
-		0xd3, 0x73, 0x03, 0x31, 0x82, 0x6f, 0x35, 0x59, 0xb4, 0xf9, 0x21, 0x12, 0xeb, 0x50, 0x0c, 0x74,
-		0x82, 0x68, 0x30, 0xdd, 0xb4, 0x34, 0xb3, 0x25, 0xde, 0xa9, 0xa4, 0xdf, 0xf5, 0x16, 0x89, 0x66,
-		0x0d, 0x91, 0x13, 0xd1, 0x98, 0xed, 0x39, 0x20, 0x25, 0xd1, 0x97, 0x3e, 0x51, 0x4b, 0x46, 0x5f,
-		0x3e, 0x65, 0x25, 0xe2, 0x56, 0xb3, 0xd7, 0x6f, 0x4e, 0xc7, 0xc8, 0x93, 0x80, 0xd9, 0xea, 0xb6,
-		0x74, 0x0f, 0x73, 0x7f, 0x34, 0xec, 0x61, 0x3f, 0x04, 0x25, 0xbd, 0xdb, 0xd5, 0x5c, 0x4f, 0xf7,
-		0x30, 0x8f, 0x3d, 0x27, 0xa8, 0xcf, 0x5b, 0xd0, 0xbb, 0x5d, 0xfa, 0xce, 0x17, 0x8b, 0x3d, 0x1f,
-		0x84, 0x22, 0xd1, 0xf0, 0xa6, 0xde, 0x16, 0x81, 0x4d, 0x9a, 0x85, 0xa8, 0xbc, 0x94, 0x07, 0x47,
-		0x2d, 0xc8, 0x87, 0x95, 0xbb, 0x9f, 0x7a, 0x96, 0x82, 0xd4, 0x33, 0x29, 0xa3, 0x17, 0x4f, 0x18,
-		0x87, 0xc4, 0x65, 0x9d, 0x34, 0x27, 0xcb, 0x9c, 0x62, 0xfe, 0x45, 0x1c, 0x9d, 0xae, 0x63, 0xdf,
-		0x64, 0x97, 0xa9, 0x32, 0x2a, 0xfb, 0x50, 0x5e, 0x85, 0x62, 0xd4, 0x0e, 0xa0, 0x22, 0x24, 0xbc,
-		0xdb, 0xbc, 0x95, 0x84, 0x77, 0x1b, 0x5d, 0x0c, 0xbd, 0x90, 0x56, 0x8c, 0xb3, 0x7e, 0x1c, 0x3f,
-		0x78, 0xda, 0xeb, 0x5a, 0x2a, 0x93, 0x90, 0x93, 0x4a, 0x09, 0x0a, 0x11, 0x2b, 0xa1, 0x1c, 0x83,
-		0x99, 0x38, 0x9d, 0xaf, 0x98, 0x30, 0x13, 0xa7, 0xba, 0xd1, 0x65, 0xc8, 0xf8, 0x4a, 0x5f, 0x48,
-		0xd0, 0x40, 0xeb, 0x3e, 0x92, 0x0f, 0x4b, 0x64, 0x87, 0x4c, 0x04, 0xdd, 0xa1, 0x48, 0xf0, 0xa8,
-		0xa3, 0xdb, 0xbd, 0xaa, 0xbb, 0x7b, 0xca, 0x37, 0xc2, 0xec, 0x30, 0x7d, 0x1e, 0x62, 0x1c, 0x4b,
-		0x35, 0x08, 0xc6, 0x1d, 0x83, 0x34, 0x7f, 0x6d, 0x21, 0x41, 0x33, 0xa7, 0xfc, 0x8b, 0x30, 0x94,
-		0xe9, 0xf6, 0x24, 0x4b, 0xa8, 0xd2, 0x0f, 0x45, 0x83, 0x13, 0x43, 0x55, 0x7a, 0xb0, 0xa5, 0xc2,
-		0x73, 0xb0, 0x6c, 0x4b, 0xc5, 0x27, 0xc4, 0x3a, 0xcb, 0x3e, 0xe8, 0x2b, 0x9c, 0xd8, 0x6a, 0xf1,
-		0xe0, 0x26, 0xab, 0xf2, 0x2f, 0xe5, 0x23, 0x49, 0x38, 0x16, 0xaf, 0xd7, 0xd1, 0x02, 0xe4, 0x3b,
-		0xfa, 0x6d, 0xcd, 0x8b, 0xa6, 0x3e, 0xa0, 0xa3, 0xdf, 0x6e, 0xf2, 0xbc, 0x87, 0x0c, 0x49, 0xef,
-		0xb6, 0x4b, 0x2f, 0x72, 0xe5, 0x55, 0xf2, 0x27, 0xba, 0x0e, 0x53, 0x6d, 0xdb, 0xd0, 0xdb, 0x5a,
-		0x5b, 0x77, 0x3d, 0x8d, 0x9b, 0x7d, 0xb6, 0x9c, 0x1e, 0x18, 0xa6, 0xa7, 0xd9, 0x75, 0x2b, 0xd3,
-		0x23, 0x2a, 0x88, 0x2f, 0x84, 0x12, 0x25, 0xb2, 0xaa, 0xbb, 0x1e, 0x0f, 0x1f, 0x6a, 0x90, 0xeb,
-		0x98, 0xee, 0x36, 0xde, 0xd3, 0x6f, 0x9a, 0xb6, 0xc3, 0xd7, 0x55, 0x8c, 0xf4, 0xac, 0x05, 0x40,
-		0x9c, 0x54, 0x18, 0x2f, 0x34, 0x29, 0x13, 0x11, 0x69, 0x16, 0x9a, 0x25, 0x7d, 0x68, 0xcd, 0xf2,
-		0x38, 0xcc, 0x58, 0xf8, 0x36, 0xbd, 0x2b, 0xc8, 0x57, 0x2e, 0x93, 0x14, 0x76, 0xd5, 0x0f, 0x91,
-		0x3a, 0x7f, 0xad, 0xbb, 0x74, 0x57, 0xeb, 0x61, 0xf0, 0x03, 0x46, 0x4d, 0x44, 0xb3, 0x19, 0x0a,
-		0x5d, 0x12, 0xe5, 0x15, 0x56, 0xac, 0xdc, 0xa1, 0x93, 0x13, 0x67, 0x1d, 0x05, 0xeb, 0xa5, 0x80,
-		0xf5, 0x4d, 0x98, 0xe1, 0xf8, 0xad, 0x08, 0xf7, 0x07, 0xc2, 0xf3, 0xa8, 0xd3, 0x15, 0xe2, 0x3a,
-		0x12, 0xf8, 0xc3, 0x19, 0x9f, 0xbc, 0x47, 0xc6, 0x23, 0x48, 0x51, 0xb6, 0xa4, 0x98, 0xba, 0x21,
-		0x7f, 0xff, 0xdf, 0x36, 0x19, 0x1f, 0x48, 0xc2, 0xd4, 0x80, 0x63, 0xe1, 0x0f, 0x4c, 0x8a, 0x1d,
-		0x58, 0x22, 0x76, 0x60, 0xc9, 0x43, 0x0f, 0x8c, 0xcf, 0x76, 0x6a, 0xf4, 0x6c, 0x4f, 0xbc, 0x93,
-		0xb3, 0x9d, 0xbe, 0xc7, 0xd9, 0x7e, 0x57, 0xe7, 0xe1, 0x63, 0x12, 0xcc, 0x0d, 0x77, 0xc7, 0x62,
-		0x27, 0xe4, 0x11, 0x98, 0xf2, 0xbb, 0xe2, 0x93, 0x67, 0xea, 0x51, 0xf6, 0x2b, 0x38, 0xfd, 0xa1,
-		0x16, 0xef, 0x41, 0x28, 0xf6, 0x79, 0x8b, 0x4c, 0x98, 0x0b, 0x91, 0x0c, 0xa2, 0xf2, 0xad, 0x49,
-		0x98, 0x89, 0x73, 0xe8, 0x62, 0x56, 0xac, 0x0a, 0xd3, 0x2d, 0x6c, 0x98, 0xad, 0x7b, 0x5e, 0xb0,
-		0x53, 0x1c, 0xfd, 0xff, 0xad, 0xd7, 0x18, 0x39, 0xf9, 0x51, 0x80, 0x8c, 0x8a, 0xdd, 0x2e, 0x71,
-		0xd0, 0xd8, 0x6b, 0xcf, 0x06, 0xee, 0x7a, 0x41, 0xa6, 0x3d, 0x36, 0x6e, 0xe0, 0x20, 0x02, 0x8f,
-		0xc4, 0xcf, 0x3e, 0x1e, 0x7a, 0x92, 0xa7, 0x09, 0x86, 0x06, 0xfc, 0xcc, 0xfd, 0xf6, 0x51, 0x59,
-		0x9e, 0xe0, 0x69, 0x91, 0x27, 0x48, 0x0e, 0x8b, 0x7e, 0xb9, 0x33, 0xee, 0xe3, 0xf1, 0x44, 0xc1,
-		0x93, 0x3c, 0x51, 0x90, 0x1a, 0xd6, 0x1c, 0xf3, 0xd9, 0x83, 0xe6, 0x4c, 0x76, 0x91, 0x3b, 0x9c,
-		0x29, 0x48, 0x0f, 0x1b, 0x6a, 0xc8, 0xb9, 0x0e, 0x86, 0x1a, 0xa4, 0x0a, 0x9e, 0x16, 0xa9, 0x82,
-		0xc9, 0x61, 0x9d, 0xe6, 0xde, 0x64, 0xd0, 0x69, 0x96, 0x2b, 0x78, 0x31, 0x94, 0x2b, 0xc8, 0xf6,
-		0xef, 0x0c, 0x0e, 0xe4, 0x0a, 0x7c, 0x6c, 0x3f, 0x59, 0x50, 0xf6, 0x93, 0x05, 0xf9, 0xa1, 0x99,
-		0x06, 0xee, 0x06, 0xfa, 0xc8, 0x22, 0x5b, 0xb0, 0x39, 0x90, 0x2d, 0x60, 0xc1, 0xfd, 0xd9, 0x91,
-		0xd9, 0x02, 0x9f, 0x54, 0x5f, 0xba, 0x60, 0x73, 0x20, 0x5d, 0x50, 0x1c, 0x46, 0xb1, 0xcf, 0xe7,
-		0x0c, 0x28, 0x46, 0xf3, 0x05, 0x5f, 0x1f, 0x9f, 0x2f, 0x18, 0x1a, 0xd0, 0xc7, 0xf8, 0x97, 0x3e,
-		0xe9, 0x98, 0x84, 0xc1, 0x37, 0x0e, 0x49, 0x18, 0xc8, 0xc3, 0x02, 0xdb, 0x38, 0xef, 0xd2, 0x6f,
-		0x20, 0x2e, 0x63, 0x70, 0x3d, 0x26, 0x63, 0xc0, 0x42, 0xfb, 0x87, 0xc7, 0xc8, 0x18, 0xf8, 0xa4,
-		0x07, 0x52, 0x06, 0xd7, 0x63, 0x52, 0x06, 0x68, 0x38, 0xdd, 0x3e, 0xa7, 0x28, 0x4c, 0x37, 0x9a,
-		0x33, 0x58, 0x89, 0xe6, 0x0c, 0xa6, 0x0f, 0xf6, 0x45, 0x99, 0x69, 0xf7, 0xa9, 0x85, 0x93, 0x06,
-		0xc6, 0xb0, 0xa4, 0x01, 0x8b, 0xeb, 0x1f, 0x1b, 0x33, 0x69, 0xe0, 0xd3, 0x8e, 0xcd, 0x1a, 0x6c,
-		0x0e, 0x64, 0x0d, 0x8e, 0x0e, 0x13, 0xb8, 0x3e, 0x23, 0x13, 0x08, 0xdc, 0xd0, 0xb4, 0x01, 0x7b,
-		0x64, 0x8c, 0x3d, 0x2f, 0x06, 0x72, 0xee, 0x5a, 0x2a, 0x93, 0x93, 0xf3, 0xca, 0xc3, 0xc4, 0xad,
-		0xe9, 0xd3, 0x7b, 0x24, 0x88, 0xc0, 0x8e, 0x63, 0x3b, 0xe2, 0x58, 0x06, 0xfd, 0x50, 0xce, 0x41,
-		0x3e, 0xac, 0xe2, 0x0e, 0x48, 0x31, 0x94, 0xa0, 0x10, 0xd1, 0x6a, 0xca, 0x2f, 0x48, 0x90, 0x0f,
-		0xeb, 0xab, 0x48, 0x00, 0x9a, 0xe5, 0x01, 0x68, 0x28, 0xf1, 0x90, 0x88, 0x26, 0x1e, 0xe6, 0x21,
-		0x47, 0x82, 0xb0, 0xbe, 0x9c, 0x82, 0xde, 0xf5, 0x73, 0x0a, 0xe7, 0x61, 0x8a, 0xda, 0x50, 0x96,
-		0x9e, 0xe0, 0x76, 0x8a, 0xed, 0xcf, 0x94, 0x48, 0x05, 0x65, 0x06, 0xdf, 0x79, 0x7c, 0x0c, 0xa6,
-		0x43, 0xb0, 0x7e, 0x70, 0xc7, 0xc2, 0x6b, 0xd9, 0x87, 0xae, 0xf0, 0x28, 0xef, 0x97, 0x25, 0x98,
-		0x1a, 0x50, 0x97, 0xb1, 0x79, 0x03, 0xe9, 0x9d, 0xca, 0x1b, 0x24, 0xee, 0x3d, 0x6f, 0x10, 0x0e,
-		0x57, 0x93, 0xd1, 0x70, 0xf5, 0xaf, 0x24, 0x28, 0x44, 0xd4, 0x36, 0x99, 0x04, 0xc3, 0x6e, 0x89,
-		0x43, 0x3c, 0xf4, 0x6f, 0xe2, 0xa7, 0xb4, 0xed, 0x5d, 0x1e, 0x26, 0x92, 0x3f, 0x09, 0x94, 0x6f,
-		0x88, 0xb2, 0xdc, 0xcc, 0xf8, 0xb1, 0xe7, 0x44, 0xf8, 0x38, 0x1f, 0x3f, 0xe2, 0x96, 0x0e, 0x8e,
-		0xb8, 0xf9, 0x67, 0x77, 0x26, 0x43, 0x67, 0x77, 0xd0, 0xb3, 0x90, 0xa5, 0xbb, 0x00, 0x9a, 0xdd,
-		0x0d, 0x7e, 0x98, 0x62, 0xf8, 0xf1, 0x36, 0x97, 0xee, 0x1f, 0xb2, 0x33, 0x71, 0x81, 0x17, 0x92,
-		0x8d, 0x78, 0x21, 0xf7, 0x41, 0x96, 0x74, 0x9f, 0x3d, 0xee, 0x08, 0xfc, 0x30, 0xad, 0x28, 0x50,
-		0x7e, 0x32, 0x01, 0xa5, 0x3e, 0xab, 0x13, 0x3b, 0x78, 0x21, 0x95, 0x89, 0x50, 0x5a, 0x64, 0x3c,
-		0x86, 0x9c, 0x02, 0xd8, 0xd5, 0x5d, 0xed, 0x96, 0x6e, 0x79, 0xfc, 0x0d, 0xf7, 0xa4, 0x1a, 0x2a,
-		0x41, 0x73, 0x90, 0x21, 0x5f, 0x3d, 0x97, 0xbf, 0xe2, 0x9e, 0x54, 0xfd, 0x6f, 0x54, 0x87, 0x34,
-		0xbe, 0x49, 0x9f, 0x23, 0x61, 0x8f, 0xfa, 0x1c, 0x8f, 0x51, 0x4f, 0xa4, 0x7e, 0x69, 0x96, 0x4c,
-		0xf7, 0x1f, 0xbd, 0x35, 0x2f, 0x33, 0xf0, 0x47, 0xfd, 0xe7, 0x17, 0x54, 0x4e, 0x20, 0xca, 0x86,
-		0x4c, 0x1f, 0x1b, 0x68, 0xba, 0x30, 0x2f, 0x62, 0x7f, 0xc2, 0x54, 0xb6, 0x61, 0xa9, 0x16, 0x3a,
-		0xb8, 0xd3, 0xb5, 0xed, 0xb6, 0xc6, 0xd6, 0x79, 0x05, 0x8a, 0x51, 0x23, 0xcb, 0x5e, 0x5e, 0xf6,
-		0x74, 0xd3, 0xd2, 0x22, 0xbe, 0x71, 0x9e, 0x15, 0xb2, 0x75, 0x75, 0x2d, 0x95, 0x91, 0xe4, 0x04,
-		0x4f, 0xd7, 0xbc, 0x07, 0x8e, 0xc6, 0xda, 0x58, 0xf4, 0x0c, 0x64, 0x03, 0xfb, 0xcc, 0xb6, 0x9d,
-		0x0f, 0xca, 0xc3, 0x04, 0xc0, 0xca, 0x75, 0x38, 0x1a, 0x6b, 0x64, 0xd1, 0x0b, 0x90, 0x76, 0xb0,
-		0xdb, 0x6b, 0x7b, 0xfc, 0x59, 0xc4, 0x07, 0x47, 0x5b, 0xe7, 0x5e, 0xdb, 0x53, 0x39, 0x92, 0x72,
-		0x11, 0x4e, 0x0c, 0xb5, 0xb2, 0x41, 0x36, 0x45, 0x0a, 0x65, 0x53, 0x94, 0x9f, 0x96, 0x60, 0x6e,
-		0xb8, 0xe5, 0x44, 0x4b, 0x7d, 0x1d, 0x3a, 0x3f, 0xa6, 0xdd, 0x0d, 0xf5, 0x8a, 0x84, 0x1b, 0x0e,
-		0xde, 0xc1, 0x9e, 0xb1, 0xc7, 0x4c, 0x38, 0x53, 0x0a, 0x05, 0xb5, 0xc0, 0x4b, 0x29, 0x8e, 0xcb,
-		0xc0, 0x5e, 0xc7, 0x86, 0xa7, 0xb1, 0x49, 0x75, 0xf9, 0x4f, 0xed, 0x14, 0x58, 0x69, 0x83, 0x15,
-		0x2a, 0x8f, 0xc0, 0xf1, 0x21, 0xb6, 0x78, 0x30, 0x2e, 0x51, 0x5e, 0x23, 0xc0, 0xb1, 0x06, 0x16,
-		0xbd, 0x04, 0x69, 0xd7, 0xd3, 0xbd, 0x9e, 0xcb, 0x47, 0x76, 0x76, 0xa4, 0x6d, 0x6e, 0x50, 0x70,
-		0x95, 0xa3, 0x29, 0xcf, 0x01, 0x1a, 0xb4, 0xb4, 0x31, 0xb1, 0x95, 0x14, 0x17, 0x5b, 0x6d, 0xc3,
-		0xc9, 0x03, 0x6c, 0x2a, 0xaa, 0xf6, 0x75, 0xee, 0x91, 0xb1, 0x4c, 0x72, 0x5f, 0x07, 0xff, 0x24,
-		0x01, 0x47, 0x63, 0x4d, 0x6b, 0x68, 0x95, 0x4a, 0x6f, 0x77, 0x95, 0xbe, 0x00, 0xe0, 0xdd, 0xd6,
-		0xd8, 0x4c, 0x0b, 0x6d, 0x1f, 0x17, 0x4f, 0xdc, 0xc6, 0x06, 0x55, 0x58, 0x44, 0x30, 0xb2, 0x1e,
-		0xff, 0x8b, 0x04, 0xff, 0xa1, 0x78, 0xb6, 0x47, 0x2d, 0x81, 0xcb, 0x43, 0xbd, 0xb1, 0x6d, 0x46,
-		0x10, 0xf8, 0xb2, 0x62, 0x17, 0xbd, 0x06, 0xc7, 0xfb, 0x2c, 0x9a, 0x4f, 0x3b, 0x35, 0xb6, 0x61,
-		0x3b, 0x1a, 0x35, 0x6c, 0x82, 0x76, 0xd8, 0x2a, 0x4d, 0x44, 0xad, 0xd2, 0x6b, 0x00, 0x41, 0x60,
-		0x4b, 0xd6, 0x9b, 0x63, 0xf7, 0xac, 0x96, 0x38, 0x7c, 0x4a, 0x3f, 0xd0, 0x65, 0x98, 0x20, 0x92,
-		0x20, 0x58, 0x15, 0xa3, 0x30, 0xc8, 0x94, 0x86, 0x22, 0x63, 0x06, 0xae, 0xbc, 0x2e, 0xa4, 0x2d,
-		0x9c, 0x63, 0x1c, 0xd2, 0xc6, 0x8b, 0xd1, 0x36, 0x94, 0xe1, 0xe9, 0xca, 0xf8, 0xb6, 0xfe, 0x3f,
-		0x98, 0xa0, 0xd3, 0x1f, 0x7b, 0xf6, 0xfb, 0x1b, 0x00, 0x74, 0xcf, 0x73, 0xcc, 0xed, 0x5e, 0xd0,
-		0xc2, 0xc2, 0x10, 0xf9, 0xa9, 0x08, 0xc0, 0xa5, 0xfb, 0xb8, 0x20, 0xcd, 0x04, 0xb8, 0x21, 0x61,
-		0x0a, 0x51, 0x54, 0xd6, 0xa1, 0x18, 0xc5, 0x8d, 0x3f, 0xcc, 0x2e, 0x7e, 0x15, 0x20, 0x38, 0x6a,
-		0x1b, 0x18, 0x72, 0x7e, 0x5b, 0x88, 0x7e, 0x28, 0xdf, 0x94, 0x80, 0x7c, 0x58, 0xfa, 0xfe, 0x0e,
-		0x1a, 0x4b, 0xe5, 0x5b, 0x25, 0xc8, 0xf8, 0xe3, 0x8f, 0xa6, 0xf3, 0x23, 0xfb, 0x20, 0xc1, 0xb5,
-		0x06, 0x3f, 0x07, 0xcf, 0x76, 0x3d, 0x92, 0xfe, 0xae, 0xc7, 0xf3, 0xbe, 0x41, 0x18, 0x1a, 0xcc,
-		0x87, 0xb9, 0x2d, 0x8e, 0x27, 0x71, 0x03, 0xf5, 0xdc, 0x78, 0x67, 0xa0, 0x66, 0x60, 0x22, 0x7c,
-		0x7c, 0x89, 0x7d, 0x28, 0x38, 0x74, 0x82, 0x92, 0xad, 0xc6, 0xf0, 0x61, 0x29, 0xe9, 0xf0, 0x87,
-		0xa5, 0xfc, 0x66, 0x12, 0xe1, 0x66, 0xfe, 0x91, 0x04, 0x19, 0xb1, 0x2e, 0xd0, 0x4b, 0xe1, 0xf3,
-		0xbd, 0xe2, 0xb0, 0xe0, 0x70, 0xbd, 0xc4, 0x1b, 0x08, 0x1d, 0xef, 0x5d, 0x12, 0xfb, 0x8c, 0x66,
-		0x4b, 0xdb, 0x69, 0xeb, 0xbb, 0x7c, 0xbb, 0x68, 0xe8, 0xe9, 0x64, 0x76, 0x78, 0x88, 0x1f, 0xb8,
-		0xac, 0xb7, 0xc8, 0x07, 0xf7, 0x43, 0xfe, 0x42, 0x02, 0xb9, 0x7f, 0xdd, 0xbe, 0xfd, 0xfe, 0x0d,
-		0xda, 0xab, 0x64, 0x8c, 0xbd, 0x42, 0x17, 0x60, 0x3a, 0xf8, 0x61, 0x2e, 0xd7, 0xdc, 0xb5, 0xd8,
-		0xe1, 0x5f, 0x96, 0x54, 0x43, 0x7e, 0x55, 0x43, 0xd4, 0x0c, 0x8e, 0x7b, 0xe2, 0x5e, 0xc7, 0xfd,
-		0x81, 0x04, 0xe4, 0x42, 0x39, 0x3e, 0xf4, 0x54, 0x48, 0x29, 0x15, 0xe3, 0xac, 0x44, 0x08, 0x38,
-		0xf4, 0xb3, 0x3a, 0x11, 0x4e, 0x25, 0xee, 0x81, 0x53, 0xc3, 0xb2, 0xa9, 0x22, 0x69, 0x98, 0x3a,
-		0x74, 0xd2, 0x30, 0xfe, 0x00, 0xe1, 0xc4, 0x90, 0x03, 0x84, 0x7f, 0x4f, 0x82, 0x8c, 0x9f, 0x7c,
-		0x39, 0xec, 0x9e, 0xdc, 0x31, 0x48, 0x73, 0xdf, 0x8b, 0x6d, 0xca, 0xf1, 0xaf, 0xd8, 0xec, 0xe8,
-		0x1c, 0x64, 0xc4, 0x2b, 0xf3, 0xdc, 0xc2, 0xf9, 0xdf, 0xe7, 0xb7, 0x21, 0x17, 0xda, 0xd6, 0x44,
-		0x27, 0xe0, 0x68, 0xf5, 0x6a, 0xad, 0xfa, 0xb2, 0xd6, 0x7c, 0xa5, 0xff, 0x6d, 0xe1, 0x81, 0x2a,
-		0xb5, 0x46, 0xbf, 0x65, 0x09, 0x1d, 0x87, 0xe9, 0x68, 0x15, 0xab, 0x48, 0xcc, 0xa5, 0x3e, 0xf8,
-		0x23, 0xa7, 0x8e, 0x9c, 0xff, 0x0b, 0x09, 0xa6, 0x63, 0xbc, 0x5c, 0x74, 0x1a, 0xee, 0xdf, 0xb8,
-		0x72, 0xa5, 0xa6, 0x6a, 0x8d, 0xf5, 0xca, 0x66, 0xe3, 0xea, 0x46, 0x53, 0x53, 0x6b, 0x8d, 0xad,
-		0xd5, 0x66, 0xa8, 0xd1, 0x05, 0xb8, 0x2f, 0x1e, 0xa4, 0x52, 0xad, 0xd6, 0x36, 0x9b, 0xec, 0x71,
-		0xe3, 0x21, 0x10, 0x4b, 0x1b, 0x6a, 0x53, 0x4e, 0x0c, 0x27, 0xa1, 0xd6, 0xae, 0xd5, 0xaa, 0x4d,
-		0x39, 0x89, 0xce, 0xc2, 0x99, 0x83, 0x20, 0xb4, 0x2b, 0x1b, 0xea, 0x5a, 0xa5, 0x29, 0xa7, 0x46,
-		0x02, 0x36, 0x6a, 0xeb, 0xcb, 0x35, 0x55, 0x9e, 0xe0, 0xe3, 0x7e, 0x33, 0x01, 0xb3, 0xc3, 0x9c,
-		0x69, 0x42, 0xab, 0xb2, 0xb9, 0xb9, 0xfa, 0x6a, 0x40, 0xab, 0x7a, 0x75, 0x6b, 0xfd, 0xe5, 0x41,
-		0x16, 0x3c, 0x04, 0xca, 0x41, 0x80, 0x3e, 0x23, 0x1e, 0x84, 0xd3, 0x07, 0xc2, 0x71, 0x76, 0x8c,
-		0x00, 0x53, 0x6b, 0x4d, 0xf5, 0x55, 0x39, 0x89, 0x16, 0xe1, 0xfc, 0x48, 0x30, 0xbf, 0x4e, 0x4e,
-		0xa1, 0x0b, 0xf0, 0xc8, 0xc1, 0xf0, 0x8c, 0x41, 0x02, 0x41, 0xb0, 0xe8, 0x8e, 0x04, 0x47, 0x63,
-		0xbd, 0x72, 0x74, 0x06, 0xe6, 0x37, 0xd5, 0x8d, 0x6a, 0xad, 0xd1, 0xd0, 0x36, 0xd5, 0x8d, 0xcd,
-		0x8d, 0x46, 0x65, 0x55, 0x6b, 0x34, 0x2b, 0xcd, 0xad, 0x46, 0x88, 0x37, 0x0a, 0x9c, 0x1a, 0x06,
-		0xe4, 0xf3, 0xe5, 0x00, 0x18, 0x2e, 0x01, 0x42, 0x4e, 0xef, 0x4a, 0x70, 0x62, 0xa8, 0x17, 0x8e,
-		0xce, 0xc1, 0x03, 0xf4, 0x77, 0xca, 0x5e, 0xd5, 0xae, 0x6f, 0x34, 0xc3, 0xaf, 0x68, 0x0f, 0xf4,
-		0xea, 0x2c, 0x9c, 0x39, 0x10, 0xd2, 0xef, 0xda, 0x28, 0xc0, 0xbe, 0xfe, 0x7d, 0x8b, 0x04, 0xa5,
-		0x3e, 0x5d, 0x88, 0xee, 0x83, 0xd9, 0xb5, 0x7a, 0x63, 0xa9, 0x76, 0xb5, 0x72, 0xbd, 0xbe, 0xa1,
-		0xf6, 0xaf, 0xd9, 0x33, 0x30, 0x3f, 0x50, 0xbb, 0xbc, 0xb5, 0xb9, 0x5a, 0xaf, 0x56, 0x9a, 0x35,
-		0xda, 0xa8, 0x2c, 0x91, 0x81, 0x0d, 0x00, 0xad, 0xd6, 0x57, 0xae, 0x36, 0xb5, 0xea, 0x6a, 0xbd,
-		0xb6, 0xde, 0xd4, 0x2a, 0xcd, 0x66, 0x25, 0x58, 0xce, 0x4b, 0x2f, 0x0f, 0x3d, 0xfa, 0x7a, 0x71,
-		0xfc, 0xa3, 0xaf, 0xfc, 0x08, 0x67, 0x70, 0x5b, 0x2d, 0x01, 0xf3, 0x7e, 0x25, 0xcf, 0xa5, 0xf5,
-		0x1f, 0xf1, 0x9c, 0xf6, 0xb5, 0x3b, 0x07, 0x18, 0x7e, 0xe3, 0xf3, 0x05, 0x48, 0x56, 0xba, 0x5d,
-		0xa2, 0xf9, 0xe8, 0xb7, 0x61, 0xb7, 0xb9, 0x5e, 0xf5, 0xbf, 0x49, 0x9d, 0x6b, 0xef, 0x78, 0xb7,
-		0x74, 0xc7, 0xff, 0xe5, 0x35, 0xf1, 0xad, 0x3c, 0x0b, 0x59, 0x3f, 0x7a, 0xa0, 0x6f, 0x97, 0xfa,
-		0xf7, 0x90, 0x52, 0xe2, 0x9e, 0x11, 0xbf, 0xac, 0x91, 0x08, 0x2e, 0x6b, 0xa4, 0xbe, 0xf0, 0xe6,
-		0xbc, 0xb4, 0xb4, 0x3e, 0x94, 0x3b, 0x4f, 0x8e, 0xcf, 0x9d, 0x80, 0x01, 0x3e, 0x83, 0xbe, 0xf7,
-		0xfe, 0xd0, 0x6d, 0x60, 0xff, 0xc4, 0x69, 0x98, 0x3d, 0x31, 0xe7, 0xf1, 0x47, 0x9d, 0x71, 0x1d,
-		0xe3, 0x0c, 0xeb, 0xa8, 0x59, 0xb9, 0xd7, 0x43, 0xae, 0xcf, 0x42, 0x61, 0x53, 0x77, 0xbc, 0x06,
-		0xf6, 0xae, 0x62, 0xbd, 0x85, 0x9d, 0xe8, 0xdd, 0xdc, 0x82, 0xb8, 0x9b, 0x2b, 0xec, 0x59, 0x22,
-		0xb0, 0x67, 0x8a, 0x09, 0x29, 0xfa, 0x9c, 0xf0, 0xd0, 0x43, 0x26, 0xec, 0x50, 0x08, 0x3f, 0x64,
-		0x42, 0x3f, 0xd0, 0x53, 0xe2, 0xf6, 0x6d, 0x72, 0xc4, 0xed, 0x5b, 0x11, 0x39, 0xb1, 0x3b, 0xb8,
-		0x1d, 0x98, 0xe4, 0xde, 0x4c, 0xec, 0xee, 0xed, 0x3a, 0x94, 0xba, 0xba, 0xe3, 0xd1, 0x5f, 0x2b,
-		0xd9, 0xa3, 0xc3, 0xe0, 0x9e, 0x48, 0xdc, 0xf5, 0xa9, 0xc8, 0x70, 0x79, 0x33, 0x85, 0x6e, 0xb8,
-		0x50, 0xf9, 0x42, 0x0a, 0xd2, 0x9c, 0x1d, 0x2f, 0x46, 0x4f, 0xba, 0x45, 0x1c, 0xf3, 0x40, 0xfc,
-		0x83, 0x20, 0x97, 0x13, 0xf4, 0xd3, 0xd2, 0x0f, 0xf5, 0x9f, 0x2b, 0x5b, 0xca, 0x7d, 0xee, 0xad,
-		0xf9, 0x49, 0x9a, 0x29, 0xae, 0x2f, 0x07, 0x87, 0xcc, 0xde, 0x79, 0x2f, 0x68, 0x19, 0x0a, 0xa1,
-		0x1c, 0xb6, 0xd9, 0xe2, 0x1b, 0xff, 0x73, 0xc3, 0x3d, 0x45, 0xb1, 0xcd, 0xeb, 0xe7, 0xb7, 0xeb,
-		0x2d, 0x74, 0x0e, 0xe4, 0xd0, 0xce, 0x33, 0x0b, 0xcf, 0x59, 0xf2, 0xb6, 0xd8, 0xf6, 0xf7, 0x94,
-		0xe9, 0xc6, 0xeb, 0x49, 0xc8, 0xd2, 0x1f, 0xd0, 0x09, 0xed, 0xcf, 0x66, 0x48, 0x01, 0xad, 0x3c,
-		0x0b, 0xa5, 0xfe, 0x2d, 0x5c, 0xb6, 0x29, 0x5b, 0xbc, 0x19, 0xdd, 0xbe, 0x1d, 0xb6, 0xe1, 0x9b,
-		0x1d, 0xba, 0xe1, 0xfb, 0x20, 0x14, 0x83, 0xa4, 0x04, 0x85, 0x05, 0xe6, 0x69, 0xfb, 0xa5, 0x14,
-		0x2c, 0x9c, 0x5f, 0xc8, 0x45, 0xf2, 0x0b, 0xfe, 0xce, 0x00, 0xcf, 0xb6, 0x30, 0x98, 0x3c, 0xdb,
-		0x33, 0x26, 0x15, 0x3c, 0xa9, 0x42, 0x61, 0xcf, 0x40, 0x41, 0x5c, 0x52, 0x64, 0x70, 0x05, 0x0a,
-		0x97, 0x17, 0x85, 0x43, 0xf7, 0xa0, 0x8b, 0xf1, 0x7b, 0xd0, 0xb3, 0x90, 0x5a, 0xe6, 0x51, 0x71,
-		0x5f, 0x8e, 0xed, 0x33, 0x49, 0x48, 0xd1, 0x6d, 0xa5, 0x27, 0x23, 0x8e, 0x79, 0x9c, 0x48, 0x93,
-		0xf0, 0x00, 0xb7, 0xd6, 0xdc, 0xdd, 0x90, 0x5f, 0x3e, 0xec, 0x88, 0x89, 0x9f, 0xda, 0x48, 0x86,
-		0x53, 0x1b, 0x57, 0x20, 0xe3, 0xcb, 0x49, 0x6a, 0xa4, 0x9c, 0x94, 0x88, 0x9c, 0x10, 0x31, 0xe6,
-		0x05, 0xea, 0x24, 0x0f, 0x2f, 0xd0, 0x12, 0x64, 0x7d, 0x0d, 0xe3, 0x0b, 0xdc, 0x38, 0x32, 0x1b,
-		0xa0, 0xc5, 0x9f, 0xc5, 0x48, 0x0f, 0x39, 0x8b, 0x11, 0x16, 0x2c, 0xfe, 0xdb, 0x9b, 0x93, 0x74,
-		0x60, 0x81, 0x60, 0xb1, 0xdf, 0xdf, 0xbc, 0x0f, 0xb2, 0x41, 0x7c, 0xc5, 0x64, 0x2f, 0x28, 0x20,
-		0xb5, 0x41, 0xa4, 0xc6, 0x64, 0x2d, 0xf4, 0x23, 0xce, 0x43, 0xa2, 0x34, 0x18, 0x16, 0xa5, 0x29,
-		0xff, 0x56, 0x82, 0x34, 0x3f, 0x6e, 0x71, 0x40, 0x5a, 0x80, 0xcd, 0x43, 0x62, 0xd8, 0x3c, 0x24,
-		0xdf, 0xd6, 0x3c, 0x80, 0xdf, 0x4f, 0x71, 0xc8, 0xf4, 0xbe, 0xd8, 0xe4, 0x1c, 0xe9, 0x64, 0xc3,
-		0xdc, 0x15, 0xfb, 0x44, 0x01, 0x96, 0xf2, 0x96, 0x44, 0xcc, 0x2f, 0xaf, 0x1f, 0x0c, 0x3c, 0xa5,
-		0x43, 0x07, 0x9e, 0x87, 0x3b, 0x65, 0x13, 0x11, 0xa5, 0xe4, 0xbd, 0x89, 0x52, 0x64, 0xd2, 0x53,
-		0x7d, 0x93, 0xae, 0x7c, 0x5e, 0xe2, 0xbf, 0xdf, 0xec, 0x27, 0xff, 0xfe, 0x86, 0x66, 0xeb, 0x6b,
-		0xb9, 0x7c, 0xb5, 0x70, 0x4b, 0x1b, 0x98, 0xb6, 0x07, 0xe2, 0xee, 0x4d, 0x47, 0x7a, 0x1d, 0x4c,
-		0x1f, 0x12, 0x64, 0x1a, 0xc1, 0x34, 0xfe, 0x5c, 0x42, 0x9c, 0x4a, 0x0b, 0xc1, 0xff, 0x2d, 0x9c,
-		0xce, 0xe8, 0x1a, 0x9e, 0x18, 0x73, 0x0d, 0xa7, 0x87, 0xae, 0xe1, 0x9f, 0x4b, 0xd0, 0x77, 0x36,
-		0xd8, 0x19, 0x81, 0xbf, 0x0e, 0x1d, 0x7c, 0x12, 0xb2, 0x5d, 0xbb, 0xad, 0xb1, 0x1a, 0xf6, 0x18,
-		0x7f, 0xa6, 0x6b, 0xb7, 0xd5, 0x01, 0x51, 0x9b, 0x78, 0xa7, 0x14, 0x74, 0xfa, 0x1d, 0x98, 0x86,
-		0xc9, 0xfe, 0x55, 0xe5, 0x41, 0x9e, 0xf1, 0x82, 0x7b, 0x50, 0x17, 0x09, 0x13, 0xa8, 0x4f, 0x26,
-		0xf5, 0xfb, 0x7c, 0x7e, 0xbf, 0x19, 0xa8, 0xca, 0x01, 0x09, 0x4a, 0xe4, 0xa4, 0xdb, 0x89, 0xa1,
-		0x9a, 0x4b, 0x9c, 0xec, 0x51, 0x3e, 0x2c, 0x01, 0xac, 0x12, 0xe6, 0xd2, 0x11, 0x13, 0xe7, 0xc7,
-		0xa5, 0x9d, 0xd0, 0x22, 0x6d, 0xcf, 0x0f, 0x9d, 0x38, 0xde, 0x83, 0xbc, 0x1b, 0xee, 0xfa, 0x32,
-		0x14, 0x02, 0x01, 0x77, 0xb1, 0xe8, 0xce, 0xfc, 0x41, 0x17, 0x59, 0x1b, 0xd8, 0x53, 0xf3, 0x37,
-		0x43, 0x5f, 0xca, 0xbf, 0x93, 0x20, 0x4b, 0x7b, 0xb5, 0x86, 0x3d, 0x3d, 0x32, 0x91, 0xd2, 0xdb,
-		0x98, 0xc8, 0xfb, 0x01, 0x18, 0x1d, 0xd7, 0x7c, 0x03, 0x73, 0xf9, 0xca, 0xd2, 0x92, 0x86, 0xf9,
-		0x06, 0x46, 0x4f, 0xfb, 0x5c, 0x4f, 0x8e, 0xe0, 0xba, 0x48, 0xde, 0x72, 0xde, 0x1f, 0x87, 0x49,
-		0xab, 0xd7, 0xd1, 0xd8, 0x61, 0x52, 0x2a, 0xb4, 0x56, 0xaf, 0xd3, 0xbc, 0xed, 0x2a, 0x37, 0x60,
-		0xb2, 0x79, 0x9b, 0xbd, 0xdf, 0x73, 0x12, 0xb2, 0x8e, 0x6d, 0x73, 0x6f, 0x90, 0x39, 0xe2, 0x19,
-		0x52, 0x40, 0x9d, 0x9f, 0xb8, 0x9c, 0xff, 0x85, 0x71, 0xdd, 0x7e, 0xee, 0xf0, 0x9f, 0xff, 0x2d,
-		0x09, 0x0a, 0x91, 0x15, 0x85, 0x1e, 0x85, 0xe3, 0x8d, 0xfa, 0xca, 0x7a, 0x6d, 0x59, 0x5b, 0x6b,
-		0xac, 0xf4, 0x05, 0xd8, 0x73, 0xa5, 0x3b, 0x77, 0x17, 0x72, 0xfc, 0xaa, 0xea, 0x30, 0xe8, 0x4d,
-		0xb5, 0xc6, 0x22, 0x6d, 0x06, 0xbd, 0xe9, 0xe0, 0x9b, 0xb6, 0x87, 0x29, 0xf4, 0xe3, 0x70, 0x22,
-		0x06, 0xda, 0xbf, 0xb0, 0x3a, 0x75, 0xe7, 0xee, 0x42, 0x61, 0xd3, 0xc1, 0x4c, 0xd4, 0x28, 0xc6,
-		0x22, 0xcc, 0x0e, 0x62, 0xb0, 0xac, 0x86, 0xbc, 0x30, 0x27, 0xdf, 0xb9, 0xbb, 0x90, 0x17, 0xba,
-		0x83, 0xc0, 0xbf, 0xeb, 0x37, 0x56, 0x3f, 0x9a, 0x85, 0x13, 0xec, 0x0d, 0x2b, 0x8d, 0xc5, 0x80,
-		0xec, 0x83, 0x87, 0xa4, 0xf9, 0x70, 0xd5, 0xe8, 0x1f, 0x27, 0x50, 0x5e, 0x86, 0xe9, 0xba, 0xe5,
-		0x61, 0x67, 0x47, 0x0f, 0xff, 0xbc, 0x70, 0xec, 0x0f, 0xf6, 0x2e, 0x44, 0x5e, 0xd9, 0xe4, 0x11,
-		0x7c, 0xb8, 0x48, 0xf9, 0x26, 0x09, 0xe4, 0x86, 0xa1, 0xb7, 0x75, 0xe7, 0xed, 0x92, 0x42, 0x4f,
-		0x8b, 0x1f, 0xa5, 0xe0, 0x17, 0x44, 0x92, 0xe7, 0x8a, 0x97, 0x66, 0x17, 0xc3, 0x83, 0x5b, 0x64,
-		0x2d, 0x51, 0x1d, 0xcc, 0x7e, 0x8c, 0x82, 0xfc, 0x79, 0xfe, 0x15, 0x80, 0xa0, 0x02, 0x9d, 0x84,
-		0xe3, 0x8d, 0x6a, 0x65, 0xb5, 0xe2, 0xe7, 0x69, 0x1a, 0x9b, 0xb5, 0x2a, 0xfb, 0xe5, 0xfb, 0x23,
-		0xe8, 0x18, 0xa0, 0x70, 0xa5, 0xff, 0x3b, 0x73, 0x47, 0x61, 0x2a, 0x5c, 0xce, 0x7e, 0x86, 0x3c,
-		0x51, 0xbe, 0x0a, 0x25, 0xf6, 0x1b, 0xc9, 0xc4, 0x00, 0xe2, 0x96, 0x66, 0x5a, 0x68, 0xc4, 0x4f,
-		0x0e, 0xcf, 0xfe, 0xea, 0x7f, 0x61, 0x3f, 0x51, 0x51, 0x60, 0x88, 0x15, 0x82, 0x57, 0xb7, 0xca,
-		0x4d, 0x98, 0xa1, 0x37, 0xc2, 0xe9, 0xcf, 0xca, 0x68, 0xa6, 0xe0, 0xff, 0xe8, 0x37, 0x04, 0x09,
-		0xbd, 0xe4, 0xb9, 0xac, 0x3a, 0x1d, 0xa0, 0xfb, 0xb3, 0x57, 0x7e, 0x39, 0xf8, 0x51, 0x11, 0xbf,
-		0x83, 0x23, 0x29, 0xfe, 0x1a, 0xef, 0xa1, 0x78, 0x42, 0x58, 0x74, 0x71, 0x15, 0xa6, 0x74, 0xc3,
-		0xc0, 0xdd, 0x48, 0xff, 0x46, 0x3c, 0xdb, 0x26, 0x46, 0x2b, 0x73, 0xcc, 0xa0, 0x6b, 0x4f, 0x43,
-		0xda, 0xa5, 0x93, 0x32, 0x8a, 0x84, 0xe8, 0x0e, 0x07, 0x2f, 0xd7, 0xa0, 0xc8, 0xc4, 0xc0, 0x1f,
-		0xd1, 0x08, 0x02, 0xbf, 0xce, 0x09, 0xe4, 0x29, 0x9a, 0x18, 0x8d, 0x05, 0x53, 0x2d, 0x6c, 0xb4,
-		0x75, 0x07, 0x87, 0x46, 0x73, 0xf0, 0xd3, 0xc5, 0xff, 0xf2, 0x53, 0x8f, 0xfb, 0x7b, 0xe8, 0x21,
-		0xa1, 0x8b, 0x59, 0x2c, 0xaa, 0xcc, 0x69, 0x07, 0xe3, 0xc5, 0x50, 0x14, 0xed, 0xf1, 0x71, 0x1f,
-		0xdc, 0xd8, 0xbf, 0xe2, 0x8d, 0x9d, 0x8a, 0x93, 0xf0, 0x50, 0x4b, 0x05, 0x4e, 0x95, 0x55, 0x94,
-		0x97, 0xa0, 0xb0, 0x63, 0xb6, 0x43, 0xd3, 0x7d, 0x70, 0x2b, 0xff, 0xfa, 0x53, 0x8f, 0xb3, 0x85,
-		0x46, 0x90, 0x38, 0x6b, 0xee, 0xe9, 0x27, 0x53, 0x28, 0xf5, 0xe7, 0xc2, 0x5d, 0xf5, 0xb5, 0xd3,
-		0xaf, 0xd3, 0x57, 0xe4, 0x28, 0xf0, 0xb6, 0xee, 0x62, 0xa2, 0xb8, 0xb0, 0xa7, 0x5f, 0xbc, 0x60,
-		0xd8, 0xa6, 0x15, 0x24, 0x15, 0xa9, 0xc2, 0x22, 0xf5, 0x8b, 0xbc, 0x7e, 0x48, 0x4e, 0x6b, 0xb8,
-		0xa2, 0x9b, 0x1b, 0xfc, 0xd9, 0x1e, 0xa5, 0x0d, 0xa9, 0xaa, 0x6d, 0x5a, 0xc4, 0xe7, 0x6a, 0x61,
-		0xcb, 0xee, 0x88, 0xf3, 0x8a, 0xf4, 0x03, 0x5d, 0x85, 0xb4, 0xde, 0xb1, 0x7b, 0x16, 0x7f, 0xbf,
-		0x6d, 0xe9, 0x71, 0x62, 0x0b, 0x7f, 0xe7, 0xad, 0xf9, 0xa3, 0x8c, 0xac, 0xdb, 0xba, 0xb1, 0x68,
-		0xda, 0x17, 0x3a, 0xba, 0xb7, 0x47, 0x26, 0xf9, 0x37, 0x3f, 0xfd, 0x18, 0xf0, 0xf6, 0xea, 0x96,
-		0xf7, 0x89, 0x3f, 0xfc, 0x99, 0xf3, 0x92, 0xca, 0xf1, 0x59, 0xda, 0x51, 0xe9, 0xc2, 0xe4, 0x32,
-		0x36, 0x0e, 0x68, 0xb0, 0xde, 0xd7, 0xe0, 0x45, 0xde, 0xe0, 0xc9, 0xc1, 0x06, 0xd9, 0xef, 0x08,
-		0x2e, 0x63, 0x23, 0xd4, 0xec, 0x32, 0x36, 0xfa, 0x5a, 0x5c, 0x83, 0x4c, 0xdd, 0xf2, 0xd8, 0x2f,
-		0xaa, 0xbf, 0x00, 0x49, 0xd3, 0x62, 0xa1, 0x4b, 0x76, 0xe9, 0x91, 0x43, 0x0c, 0x45, 0x25, 0x78,
-		0xe5, 0xc4, 0xac, 0xa4, 0x34, 0x20, 0xb3, 0x8c, 0x0d, 0x46, 0xae, 0x0a, 0xc9, 0x16, 0x36, 0x38,
-		0xb9, 0x7b, 0xe8, 0x28, 0xc1, 0xa6, 0x4f, 0xa4, 0x2c, 0xff, 0xf6, 0xef, 0x9f, 0x3a, 0xf2, 0xfe,
-		0xcf, 0x9d, 0x3a, 0x32, 0x54, 0xac, 0x94, 0xd1, 0xbf, 0xc4, 0xe3, 0x4b, 0xd3, 0xff, 0x92, 0xe0,
-		0x44, 0xbf, 0x09, 0xd3, 0xad, 0xfd, 0x61, 0xef, 0x32, 0x5c, 0x86, 0x64, 0xc5, 0xda, 0x47, 0x27,
-		0xd8, 0x8b, 0xb3, 0x5a, 0xcf, 0x69, 0x8b, 0xa3, 0xa8, 0xe4, 0x7b, 0xcb, 0x69, 0x47, 0x8f, 0x3d,
-		0xf8, 0xaf, 0x87, 0x7d, 0xd7, 0x21, 0x9f, 0x64, 0xc8, 0x54, 0xac, 0x7d, 0xf1, 0x18, 0xc3, 0xa3,
-		0x63, 0x3e, 0xc6, 0xa0, 0x5b, 0xfb, 0xdd, 0xed, 0xc3, 0xbe, 0xc1, 0x70, 0xe7, 0x29, 0x78, 0x80,
-		0xf3, 0xc8, 0xf5, 0xf4, 0x1b, 0xa6, 0xb5, 0xeb, 0x2f, 0x28, 0xfe, 0xcd, 0x59, 0x71, 0x8c, 0xcf,
-		0x85, 0x28, 0x15, 0xcb, 0x6a, 0x70, 0x95, 0xcc, 0x1d, 0xf8, 0xa8, 0xc3, 0xdc, 0xc1, 0x19, 0xef,
-		0xb9, 0x11, 0x6b, 0xfb, 0xa0, 0x05, 0x3b, 0x64, 0x85, 0x0f, 0x9d, 0xde, 0x91, 0x2f, 0x9c, 0x8d,
-		0x4c, 0x78, 0x7f, 0x58, 0x82, 0xe2, 0x55, 0xd3, 0xf5, 0x6c, 0xc7, 0x34, 0xf4, 0x36, 0xdd, 0xec,
-		0x7f, 0x7e, 0xec, 0x08, 0x65, 0x29, 0x4b, 0x56, 0x01, 0x5f, 0xf8, 0x7b, 0x22, 0x48, 0x48, 0xdf,
-		0xd4, 0xdb, 0x2c, 0x3a, 0x08, 0xdb, 0x86, 0x7e, 0xb6, 0x87, 0xf6, 0xc0, 0xc3, 0x54, 0x18, 0x2e,
-		0x5d, 0x7b, 0xdf, 0x97, 0x80, 0x12, 0x0d, 0x6b, 0x5c, 0x7a, 0x68, 0x8d, 0x1e, 0x8b, 0xba, 0x06,
-		0x29, 0x47, 0xf7, 0xb8, 0xa3, 0xb4, 0x74, 0xf9, 0xd0, 0x8b, 0x90, 0xb5, 0x42, 0x69, 0xa0, 0xf7,
-		0x40, 0xa6, 0xa3, 0xdf, 0xd6, 0x28, 0xbd, 0xc4, 0xdb, 0xa2, 0x37, 0xd9, 0xd1, 0x6f, 0x93, 0xfe,
-		0xa1, 0x6f, 0x80, 0x12, 0x21, 0x69, 0xec, 0xe9, 0xd6, 0x2e, 0x66, 0x94, 0x93, 0x6f, 0x8b, 0x72,
-		0xa1, 0xa3, 0xdf, 0xae, 0x52, 0x6a, 0x84, 0x3e, 0xd7, 0x71, 0xbf, 0x24, 0xf1, 0x13, 0x60, 0x94,
-		0x31, 0x48, 0x07, 0xd9, 0xf0, 0xbf, 0x68, 0xa3, 0xe2, 0x60, 0xf5, 0xd9, 0x61, 0xbc, 0xef, 0x63,
-		0xeb, 0x52, 0x81, 0x74, 0xef, 0xb3, 0x6f, 0xcd, 0x4b, 0xac, 0xd5, 0x92, 0x31, 0xc0, 0xf6, 0x1c,
-		0x3b, 0xd9, 0xa6, 0xd1, 0xec, 0x7b, 0x62, 0x64, 0xa0, 0x5c, 0x10, 0x81, 0x32, 0x23, 0x08, 0x0c,
-		0x9b, 0xd4, 0xf3, 0x31, 0xfc, 0x99, 0x04, 0xb9, 0xe5, 0x90, 0x2f, 0x3b, 0x0b, 0x93, 0x1d, 0xdb,
-		0x32, 0x6f, 0x60, 0xc7, 0x3f, 0x19, 0xcf, 0x3e, 0xd1, 0x1c, 0x64, 0xd8, 0xaf, 0x54, 0x7a, 0xfb,
-		0x62, 0x47, 0x4c, 0x7c, 0x13, 0xac, 0x5b, 0x78, 0xdb, 0x35, 0x05, 0x9f, 0x55, 0xf1, 0x89, 0x1e,
-		0x06, 0xd9, 0xc5, 0x46, 0xcf, 0x31, 0xbd, 0x7d, 0xcd, 0xb0, 0x2d, 0x4f, 0x37, 0x3c, 0x7e, 0xa0,
-		0xaa, 0x24, 0xca, 0xab, 0xac, 0x98, 0x10, 0x69, 0x61, 0x4f, 0x37, 0xdb, 0xec, 0xc2, 0x78, 0x56,
-		0x15, 0x9f, 0x68, 0x25, 0x74, 0x44, 0x21, 0xed, 0xef, 0xa0, 0xc4, 0x72, 0x54, 0xfc, 0x26, 0x3e,
-		0x15, 0x66, 0x3a, 0xea, 0x23, 0xc1, 0x79, 0x06, 0x3e, 0xe6, 0x1d, 0xc8, 0x08, 0x30, 0xf4, 0x10,
-		0x94, 0xba, 0x8e, 0x4d, 0x3d, 0x93, 0xae, 0x69, 0x68, 0x3d, 0xc7, 0xe4, 0xe3, 0x2e, 0xf0, 0xe2,
-		0x4d, 0xd3, 0xd8, 0x72, 0x4c, 0xf4, 0x28, 0x20, 0xd7, 0x36, 0xe8, 0x65, 0x75, 0xdd, 0x6a, 0xb5,
-		0x89, 0xc2, 0x36, 0xd9, 0x79, 0xb8, 0xac, 0x2a, 0xb3, 0x9a, 0xab, 0xb4, 0x62, 0xcb, 0x31, 0x5d,
-		0xde, 0xce, 0xdd, 0xc9, 0xf0, 0xf9, 0xa7, 0x2a, 0xc8, 0x76, 0x17, 0x3b, 0x91, 0xac, 0x14, 0x5b,
-		0x3e, 0xb3, 0xbf, 0xf9, 0xe9, 0xc7, 0x66, 0xf8, 0x78, 0x78, 0x5e, 0x8a, 0xbd, 0x34, 0xa9, 0x96,
-		0x04, 0x86, 0x48, 0x57, 0xbd, 0x1a, 0x39, 0xbc, 0xdf, 0xdb, 0x0e, 0xde, 0x8d, 0x9a, 0x19, 0x90,
-		0x82, 0x8a, 0xb5, 0xbf, 0x34, 0xfb, 0x6b, 0x01, 0x69, 0x1e, 0xd3, 0x6e, 0xd2, 0xd3, 0x50, 0xe1,
-		0x83, 0xfc, 0x94, 0x0c, 0x3a, 0x06, 0xe9, 0xd7, 0x75, 0xb3, 0x2d, 0x7e, 0x81, 0x58, 0xe5, 0x5f,
-		0xa8, 0xec, 0x1f, 0x4e, 0x4d, 0xd1, 0x2c, 0x93, 0x32, 0x8c, 0xf5, 0x4b, 0xb6, 0xd5, 0x8a, 0x9e,
-		0x49, 0x45, 0x55, 0x48, 0x7b, 0xf6, 0x0d, 0x6c, 0xf1, 0x19, 0x3d, 0x9c, 0xf1, 0xe7, 0xa8, 0xe8,
-		0xeb, 0x40, 0x6e, 0xe1, 0x36, 0xde, 0x65, 0xe9, 0x8e, 0x3d, 0xdd, 0xc1, 0x2c, 0xef, 0x7e, 0x4f,
-		0xc6, 0xbf, 0xe4, 0x93, 0x6a, 0x50, 0x4a, 0x68, 0x33, 0x1a, 0xde, 0x4d, 0xfa, 0xf7, 0xce, 0x62,
-		0xc7, 0x18, 0x5a, 0x2a, 0x61, 0x75, 0x19, 0x09, 0x07, 0x1f, 0x06, 0xb9, 0x67, 0x6d, 0xdb, 0x16,
-		0xfd, 0xe1, 0x4e, 0x9e, 0x68, 0xcb, 0xb0, 0x0b, 0x1d, 0x7e, 0x39, 0xbf, 0xd0, 0xb1, 0x09, 0xc5,
-		0x00, 0x94, 0x2e, 0xe9, 0xec, 0x61, 0x97, 0x74, 0xc1, 0x27, 0x40, 0x40, 0xd0, 0x1a, 0x40, 0xa0,
-		0x34, 0xe8, 0x56, 0x40, 0x6e, 0xf8, 0x8c, 0x05, 0xea, 0x27, 0x3c, 0x98, 0x10, 0x01, 0x64, 0xc1,
-		0x74, 0xc7, 0xb4, 0x34, 0x17, 0xb7, 0x77, 0x34, 0xce, 0x39, 0x42, 0x37, 0x47, 0xd9, 0xff, 0xe2,
-		0x21, 0x66, 0xf3, 0x77, 0x3e, 0xfd, 0x58, 0x29, 0x70, 0x9d, 0x16, 0x1e, 0x5f, 0x7c, 0xf2, 0x69,
-		0x75, 0xaa, 0x63, 0x5a, 0x0d, 0xdc, 0xde, 0x59, 0xf6, 0x09, 0xa3, 0xe7, 0xe1, 0x64, 0xc0, 0x10,
-		0xdb, 0xd2, 0xf6, 0xec, 0x76, 0x4b, 0x73, 0xf0, 0x8e, 0x66, 0x50, 0xe7, 0x34, 0x4f, 0xd9, 0x78,
-		0xdc, 0x07, 0xd9, 0xb0, 0xae, 0xda, 0xed, 0x96, 0x8a, 0x77, 0xaa, 0xa4, 0x1a, 0x9d, 0x81, 0x80,
-		0x1b, 0x9a, 0xd9, 0x72, 0x67, 0x0b, 0x0b, 0xc9, 0x73, 0x29, 0x35, 0xef, 0x17, 0xd6, 0x5b, 0x6e,
-		0x39, 0xf3, 0xc1, 0x37, 0xe7, 0x8f, 0x7c, 0xe1, 0xcd, 0xf9, 0x23, 0xca, 0x15, 0xfa, 0xb2, 0x1c,
-		0x5f, 0x5a, 0xd8, 0x45, 0x97, 0x21, 0xab, 0x8b, 0x0f, 0xf6, 0x38, 0xe3, 0x01, 0x4b, 0x33, 0x00,
-		0x55, 0x3e, 0x29, 0x41, 0x7a, 0xf9, 0xfa, 0xa6, 0x6e, 0x3a, 0xa8, 0x46, 0x82, 0x37, 0x21, 0xab,
-		0xe3, 0xae, 0xf2, 0x40, 0xbc, 0xc5, 0x32, 0x5f, 0x1f, 0x96, 0xc2, 0xce, 0x2e, 0x9d, 0xfe, 0xcd,
-		0x4f, 0x3f, 0x76, 0x3f, 0x27, 0x73, 0xbd, 0x2f, 0x9b, 0x2d, 0xe8, 0xf5, 0x67, 0xb9, 0x43, 0x63,
-		0xbe, 0x06, 0x93, 0xac, 0xab, 0x2e, 0x7a, 0x09, 0x26, 0xba, 0xe4, 0x0f, 0x7e, 0x2a, 0xfc, 0xd4,
-		0x50, 0x99, 0xa7, 0xf0, 0x61, 0x09, 0x61, 0x78, 0xca, 0xb7, 0x27, 0x00, 0x96, 0xaf, 0x5f, 0x6f,
-		0x3a, 0x66, 0xb7, 0x8d, 0xbd, 0x77, 0x6a, 0xec, 0x5b, 0x70, 0x34, 0x94, 0xdd, 0x74, 0x8c, 0xc3,
-		0x8f, 0x7f, 0x3a, 0xc8, 0x73, 0x3a, 0x46, 0x2c, 0xd9, 0x96, 0xeb, 0xf9, 0x64, 0x93, 0x87, 0x27,
-		0xbb, 0xec, 0x7a, 0x83, 0x9c, 0x7d, 0x05, 0x72, 0x01, 0x33, 0x5c, 0x54, 0x87, 0x8c, 0xc7, 0xff,
-		0xe6, 0x0c, 0x56, 0x86, 0x33, 0x58, 0xa0, 0x85, 0x99, 0xec, 0xa3, 0x2b, 0x7f, 0x25, 0x01, 0x84,
-		0xd6, 0xc8, 0x57, 0xa7, 0x8c, 0x91, 0x10, 0x92, 0x2b, 0xe7, 0xe4, 0x3d, 0x87, 0x90, 0x8c, 0x40,
-		0x88, 0xa9, 0xdf, 0x99, 0x80, 0xe9, 0x2d, 0xb1, 0x7a, 0xbf, 0xfa, 0x79, 0xb0, 0x05, 0x93, 0xd8,
-		0xf2, 0x1c, 0xd3, 0xbf, 0xd5, 0xf0, 0xf8, 0xb0, 0x39, 0x8f, 0x19, 0x54, 0xcd, 0xf2, 0x9c, 0xfd,
-		0xb0, 0x04, 0x08, 0x5a, 0x21, 0x7e, 0xfc, 0x60, 0x12, 0x66, 0x87, 0xa1, 0xa2, 0xb3, 0x50, 0x32,
-		0x1c, 0x4c, 0x0b, 0xa2, 0x6f, 0x85, 0x16, 0x45, 0x31, 0x37, 0x3b, 0x2a, 0x10, 0xcf, 0x92, 0x08,
-		0x17, 0x01, 0xbd, 0x37, 0x57, 0xb2, 0x18, 0x50, 0xa0, 0x86, 0xa7, 0x09, 0x25, 0xf1, 0x9c, 0xcf,
-		0xb6, 0xde, 0xd6, 0x2d, 0x43, 0xb8, 0xdc, 0x87, 0xb2, 0xf9, 0xe2, 0x49, 0xa0, 0x25, 0x46, 0x02,
-		0xd5, 0x60, 0x52, 0x50, 0x4b, 0x1d, 0x9e, 0x9a, 0xc0, 0x45, 0x0f, 0x42, 0x3e, 0x6c, 0x18, 0xa8,
-		0x37, 0x92, 0xa2, 0x4f, 0x24, 0xe7, 0x42, 0xb6, 0x61, 0x94, 0xf5, 0x49, 0x1f, 0x68, 0x7d, 0xb8,
-		0xd3, 0xf7, 0x83, 0x49, 0x98, 0x52, 0x71, 0xeb, 0x6f, 0xff, 0xd4, 0x6c, 0x02, 0xb0, 0xe5, 0x4a,
-		0xb4, 0x29, 0x9f, 0x9d, 0x7b, 0x58, 0xf3, 0x59, 0x46, 0x64, 0xd9, 0xa5, 0x3f, 0x00, 0x3f, 0x38,
-		0x4b, 0xef, 0xfc, 0x0c, 0xfd, 0x6e, 0x02, 0xf2, 0xe1, 0x19, 0xfa, 0x3b, 0x69, 0xb8, 0xd0, 0x7a,
-		0xa0, 0xaa, 0xd8, 0x86, 0xfe, 0xc3, 0xc3, 0x54, 0xd5, 0x80, 0x34, 0x8f, 0xd0, 0x51, 0x5f, 0x4c,
-		0x42, 0x9a, 0x5f, 0x0e, 0xde, 0x18, 0xf0, 0x6f, 0x47, 0x3e, 0x16, 0x5d, 0x10, 0xef, 0x6d, 0xc7,
-		0xba, 0xb7, 0x0f, 0x42, 0x91, 0x04, 0xf6, 0x91, 0x1b, 0xc7, 0xd2, 0xb9, 0x02, 0x8d, 0xcf, 0x83,
-		0x03, 0x58, 0x68, 0x1e, 0x72, 0x04, 0x2c, 0xd0, 0xc5, 0x04, 0x06, 0x3a, 0xfa, 0xed, 0x1a, 0x2b,
-		0x41, 0x17, 0x01, 0xed, 0xf9, 0xd9, 0x16, 0x2d, 0x60, 0x84, 0x74, 0xae, 0x40, 0xd5, 0xc2, 0x54,
-		0x50, 0x2b, 0x50, 0xee, 0x07, 0x20, 0x3d, 0xd1, 0x58, 0xf6, 0x94, 0xff, 0x9c, 0x37, 0x29, 0x59,
-		0xa6, 0x19, 0xd4, 0x6f, 0x91, 0x98, 0xab, 0xdc, 0x97, 0x02, 0xe0, 0x91, 0x4a, 0x73, 0x8c, 0x85,
-		0xf1, 0xe7, 0x6f, 0xcd, 0xcf, 0xed, 0xeb, 0x9d, 0x76, 0x59, 0x89, 0xa1, 0xa3, 0xc4, 0x65, 0x25,
-		0x88, 0x03, 0x1d, 0x4d, 0x21, 0xa0, 0x3a, 0xc8, 0x37, 0xf0, 0xbe, 0xe6, 0xf0, 0x5f, 0xbc, 0xd7,
-		0x76, 0xb0, 0x78, 0xb3, 0xfd, 0xc4, 0x62, 0x4c, 0x2e, 0x7b, 0xb1, 0x6a, 0x9b, 0x16, 0xdf, 0x6a,
-		0x2d, 0xde, 0xc0, 0xfb, 0x2a, 0xc7, 0xbb, 0x82, 0x71, 0xf9, 0x01, 0xb2, 0x5a, 0xee, 0xfc, 0xe1,
-		0xcf, 0x9c, 0x3f, 0x19, 0xca, 0x79, 0xde, 0xf6, 0x93, 0x7b, 0x6c, 0x8a, 0x89, 0xf3, 0x8b, 0x02,
-		0x43, 0x14, 0xba, 0x65, 0x0e, 0xa1, 0x78, 0x41, 0x3a, 0x38, 0x0e, 0x09, 0xf0, 0x23, 0x71, 0x48,
-		0x68, 0x89, 0xbe, 0x18, 0xd8, 0x81, 0xc4, 0xa8, 0xd1, 0x84, 0xa5, 0x93, 0x23, 0xd1, 0x95, 0x7f,
-		0x44, 0xf9, 0x8f, 0x12, 0x9c, 0x18, 0x90, 0x66, 0xbf, 0xcb, 0x06, 0x20, 0x27, 0x54, 0x49, 0xa5,
-		0x42, 0x5c, 0x34, 0xba, 0xb7, 0xc5, 0x31, 0xe5, 0x0c, 0x18, 0x82, 0x77, 0xc6, 0xa0, 0x71, 0x4d,
-		0xf6, 0xab, 0x12, 0xcc, 0x84, 0x3b, 0xe0, 0x0f, 0xa5, 0x01, 0xf9, 0x70, 0xd3, 0x7c, 0x10, 0x0f,
-		0x8c, 0x33, 0x88, 0x70, 0xff, 0x23, 0x44, 0xd0, 0xf5, 0x40, 0x63, 0xb0, 0x94, 0xe2, 0xc5, 0xb1,
-		0x99, 0x22, 0x3a, 0x16, 0xab, 0x39, 0xd8, 0xdc, 0x7c, 0x51, 0x82, 0xd4, 0xa6, 0x6d, 0xb7, 0xd1,
-		0xfb, 0x60, 0xca, 0xb2, 0x3d, 0x8d, 0xac, 0x2c, 0xdc, 0xd2, 0x78, 0xfa, 0x80, 0x69, 0xe3, 0xda,
-		0x81, 0xbc, 0xfa, 0xa3, 0xb7, 0xe6, 0x07, 0x31, 0xe3, 0xf6, 0x46, 0x4a, 0x96, 0xed, 0x2d, 0x51,
-		0xa0, 0x26, 0xcb, 0x30, 0xec, 0x40, 0x21, 0xda, 0x1c, 0xd3, 0xd8, 0x95, 0x51, 0xcd, 0x15, 0x46,
-		0x36, 0x95, 0xdf, 0x0e, 0xb5, 0xc3, 0x7e, 0x1e, 0xe9, 0x4f, 0xc9, 0xcc, 0x7d, 0x03, 0xc8, 0xd7,
-		0xfb, 0xaf, 0xb1, 0x5e, 0x81, 0x49, 0x71, 0x6d, 0x55, 0x1a, 0xf7, 0x4a, 0x6c, 0x98, 0x9f, 0x1c,
-		0x99, 0xe6, 0x6c, 0x3f, 0x9b, 0x80, 0x13, 0x55, 0xdb, 0x72, 0x79, 0xb2, 0x87, 0xaf, 0x6a, 0x96,
-		0x60, 0xde, 0x47, 0x0f, 0x0f, 0x49, 0x45, 0xe5, 0x07, 0x13, 0x4e, 0xd7, 0xa1, 0x44, 0x4c, 0xac,
-		0x61, 0x5b, 0x6f, 0x33, 0xdf, 0x54, 0xb0, 0xdb, 0x2d, 0xde, 0xa3, 0x1b, 0x78, 0x9f, 0xd0, 0xb5,
-		0xf0, 0xad, 0x08, 0xdd, 0xe4, 0xbd, 0xd1, 0xb5, 0xf0, 0xad, 0x10, 0xdd, 0xe0, 0x6c, 0x53, 0x2a,
-		0x72, 0x53, 0xea, 0x32, 0x24, 0x89, 0x2a, 0x9c, 0x38, 0x84, 0xf2, 0x20, 0x08, 0x21, 0xb3, 0xd6,
-		0x80, 0x13, 0x3c, 0x5b, 0xe0, 0x6e, 0xec, 0x50, 0x8e, 0x62, 0x3a, 0xa0, 0x97, 0xf1, 0x7e, 0x4c,
-		0xea, 0x20, 0x3f, 0x56, 0xea, 0xe0, 0xfc, 0xcf, 0x4b, 0x00, 0x41, 0xde, 0x0c, 0x3d, 0x0a, 0xc7,
-		0x97, 0x36, 0xd6, 0x97, 0x83, 0x4b, 0x23, 0xa1, 0x23, 0x00, 0xe2, 0x34, 0x89, 0xdb, 0xc5, 0x86,
-		0xb9, 0x63, 0xe2, 0x16, 0x7a, 0x08, 0x66, 0xa2, 0xd0, 0xe4, 0xab, 0xb6, 0x2c, 0x4b, 0x73, 0xf9,
-		0x3b, 0x77, 0x17, 0x32, 0x2c, 0x4e, 0xc0, 0x2d, 0x74, 0x0e, 0x8e, 0x0e, 0xc2, 0xd5, 0xd7, 0x57,
-		0xe4, 0xc4, 0x5c, 0xe1, 0xce, 0xdd, 0x85, 0xac, 0x1f, 0x50, 0x20, 0x05, 0x50, 0x18, 0x92, 0xd3,
-		0x4b, 0xce, 0xc1, 0x9d, 0xbb, 0x0b, 0x69, 0xb6, 0x64, 0xf8, 0x6d, 0x93, 0xaf, 0x07, 0xa8, 0x5b,
-		0x3b, 0x8e, 0x6e, 0x50, 0xd5, 0x30, 0x07, 0xc7, 0xea, 0xeb, 0x57, 0xd4, 0x4a, 0xb5, 0x59, 0xdf,
-		0x58, 0xef, 0x3b, 0xb9, 0x10, 0xad, 0x5b, 0xde, 0xd8, 0x5a, 0x5a, 0xad, 0x69, 0x8d, 0xfa, 0xca,
-		0x3a, 0xbb, 0x1a, 0x16, 0xa9, 0x7b, 0xef, 0x7a, 0xb3, 0xbe, 0x56, 0x93, 0x13, 0x4b, 0x97, 0x87,
-		0x6e, 0xc9, 0xdd, 0x17, 0x59, 0x8c, 0x81, 0x39, 0x8a, 0x6c, 0xc6, 0xfd, 0x9f, 0x00, 0x00, 0x00,
-		0xff, 0xff, 0xc8, 0x95, 0xa3, 0x6d, 0x90, 0xa8, 0x00, 0x00,
+		// 13204 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x69, 0x90, 0x1c, 0xd7,
+		0x79, 0x18, 0x7a, 0x66, 0x76, 0x76, 0xe6, 0x9b, 0xab, 0xf7, 0xed, 0x02, 0x58, 0x2c, 0x48, 0x2c,
+		0x38, 0xe0, 0x01, 0x82, 0xe4, 0x82, 0x00, 0x49, 0x90, 0x58, 0x8a, 0xa2, 0xe7, 0x02, 0x30, 0xe0,
+		0xee, 0xec, 0xaa, 0x67, 0x16, 0x24, 0xe8, 0xa3, 0xd5, 0xdb, 0xf3, 0x76, 0xb7, 0x89, 0x99, 0xee,
+		0x61, 0x77, 0x0f, 0xb0, 0xcb, 0x54, 0xb9, 0x24, 0x5b, 0x76, 0x64, 0xca, 0x72, 0xe4, 0xd8, 0xb1,
+		0x69, 0xc9, 0x90, 0x64, 0x3b, 0xb6, 0x24, 0x5f, 0xf1, 0x21, 0x29, 0x96, 0x53, 0x4e, 0xe2, 0x54,
+		0xe2, 0xf8, 0x48, 0x1c, 0xd9, 0x55, 0x89, 0x5d, 0xae, 0x0a, 0x93, 0x48, 0xae, 0x88, 0xb6, 0xe5,
+		0x33, 0x72, 0xa2, 0x2a, 0x55, 0x4a, 0xa9, 0x77, 0xf5, 0x31, 0xd3, 0xb3, 0x33, 0x0b, 0x52, 0x8e,
+		0x62, 0xe7, 0x0f, 0xb0, 0xfd, 0xde, 0xf7, 0x7d, 0xef, 0xbd, 0xef, 0x7d, 0xef, 0xbb, 0xde, 0x31,
+		0xf0, 0xde, 0x77, 0xc2, 0xc9, 0x6d, 0xcb, 0xda, 0xee, 0xe0, 0xb3, 0x3d, 0xdb, 0x72, 0xad, 0xcd,
+		0xfe, 0xd6, 0xd9, 0x36, 0x76, 0x74, 0xdb, 0xe8, 0xb9, 0x96, 0xbd, 0x44, 0xcb, 0x50, 0x81, 0x41,
+		0x2c, 0x09, 0x88, 0xe2, 0x37, 0xc2, 0xcc, 0x25, 0xa3, 0x83, 0xab, 0x1e, 0x60, 0x13, 0xbb, 0xe8,
+		0x29, 0x48, 0x6c, 0x19, 0x1d, 0x3c, 0x2f, 0x9d, 0x8c, 0x9f, 0xce, 0x9c, 0xbf, 0x77, 0x69, 0x00,
+		0x69, 0x29, 0x8c, 0xb1, 0x4e, 0x8a, 0x15, 0x8a, 0x71, 0x26, 0x9b, 0x7a, 0xd7, 0x17, 0x7f, 0xf3,
+		0xab, 0x92, 0xfc, 0x6e, 0xf2, 0x6f, 0xf1, 0xb5, 0x29, 0x98, 0x8d, 0x80, 0x45, 0x08, 0x12, 0xa6,
+		0xd6, 0x25, 0xf4, 0xa5, 0xd3, 0x69, 0x85, 0xfe, 0x8d, 0xe6, 0x61, 0xba, 0xa7, 0xe9, 0x37, 0xb4,
+		0x6d, 0x3c, 0x1f, 0xa3, 0xc5, 0xe2, 0x13, 0x9d, 0x00, 0x68, 0xe3, 0x1e, 0x36, 0xdb, 0xd8, 0xd4,
+		0xf7, 0xe6, 0xe3, 0x27, 0xe3, 0xa7, 0xd3, 0x4a, 0xa0, 0x04, 0x3d, 0x04, 0x33, 0xbd, 0xfe, 0x66,
+		0xc7, 0xd0, 0xd5, 0x00, 0x18, 0x9c, 0x8c, 0x9f, 0x9e, 0x52, 0x64, 0x56, 0x51, 0xf5, 0x81, 0x1f,
+		0x80, 0xc2, 0x2d, 0xac, 0xdd, 0x08, 0x82, 0x66, 0x28, 0x68, 0x9e, 0x14, 0x07, 0x00, 0x2b, 0x90,
+		0xed, 0x62, 0xc7, 0xd1, 0xb6, 0xb1, 0xea, 0xee, 0xf5, 0xf0, 0x7c, 0x82, 0xf2, 0xe2, 0xe4, 0x10,
+		0x2f, 0x06, 0xf9, 0x90, 0xe1, 0x58, 0xad, 0xbd, 0x1e, 0x46, 0x25, 0x48, 0x63, 0xb3, 0xdf, 0x65,
+		0x14, 0xa6, 0x46, 0x70, 0xb3, 0x66, 0xf6, 0xbb, 0x83, 0x54, 0x52, 0x04, 0x8d, 0x93, 0x98, 0x76,
+		0xb0, 0x7d, 0xd3, 0xd0, 0xf1, 0x7c, 0x92, 0x12, 0x78, 0x60, 0x88, 0x40, 0x93, 0xd5, 0x0f, 0xd2,
+		0x10, 0x78, 0xa8, 0x02, 0x69, 0xbc, 0xeb, 0x62, 0xd3, 0x31, 0x2c, 0x73, 0x7e, 0x9a, 0x12, 0xb9,
+		0x2f, 0x62, 0x4e, 0x71, 0xa7, 0x3d, 0x48, 0xc2, 0xc7, 0x43, 0x17, 0x60, 0xda, 0xea, 0xb9, 0x86,
+		0x65, 0x3a, 0xf3, 0xa9, 0x93, 0xd2, 0xe9, 0xcc, 0xf9, 0xbb, 0x22, 0xc5, 0x62, 0x8d, 0xc1, 0x28,
+		0x02, 0x18, 0xd5, 0x41, 0x76, 0xac, 0xbe, 0xad, 0x63, 0x55, 0xb7, 0xda, 0x58, 0x35, 0xcc, 0x2d,
+		0x6b, 0x3e, 0x4d, 0x09, 0x2c, 0x0e, 0x0f, 0x84, 0x02, 0x56, 0xac, 0x36, 0xae, 0x9b, 0x5b, 0x96,
+		0x92, 0x77, 0x42, 0xdf, 0xe8, 0x08, 0x24, 0x9d, 0x3d, 0xd3, 0xd5, 0x76, 0xe7, 0xb3, 0x54, 0x42,
+		0xf8, 0x17, 0x3a, 0x0f, 0xd3, 0xb8, 0x6d, 0x90, 0xe6, 0xe6, 0xf3, 0x27, 0xa5, 0xd3, 0xf9, 0xf3,
+		0xf3, 0xc3, 0x3c, 0x66, 0xf5, 0x8a, 0x00, 0x2c, 0x7e, 0x26, 0x09, 0x85, 0x49, 0xc4, 0xf2, 0x69,
+		0x98, 0xda, 0x22, 0x9c, 0x99, 0x8f, 0x1d, 0x84, 0x6f, 0x0c, 0x27, 0xcc, 0xf8, 0xe4, 0x1d, 0x32,
+		0xbe, 0x04, 0x19, 0x13, 0x3b, 0x2e, 0x6e, 0x33, 0x29, 0x8a, 0x4f, 0x28, 0x87, 0xc0, 0x90, 0x86,
+		0xc5, 0x30, 0x71, 0x47, 0x62, 0xf8, 0x02, 0x14, 0xbc, 0x2e, 0xa9, 0xb6, 0x66, 0x6e, 0x0b, 0x79,
+		0x3e, 0x3b, 0xae, 0x27, 0x4b, 0x35, 0x81, 0xa7, 0x10, 0x34, 0x25, 0x8f, 0x43, 0xdf, 0xa8, 0x0a,
+		0x60, 0x99, 0xd8, 0xda, 0x52, 0xdb, 0x58, 0xef, 0xcc, 0xa7, 0x46, 0x70, 0x69, 0x8d, 0x80, 0x0c,
+		0x71, 0xc9, 0x62, 0xa5, 0x7a, 0x07, 0x5d, 0xf4, 0xc5, 0x73, 0x7a, 0x84, 0x74, 0xad, 0xb2, 0x85,
+		0x39, 0x24, 0xa1, 0x1b, 0x90, 0xb7, 0x31, 0x59, 0x2b, 0xb8, 0xcd, 0x47, 0x96, 0xa6, 0x9d, 0x58,
+		0x1a, 0x3b, 0x32, 0x85, 0xa3, 0xb1, 0x81, 0xe5, 0xec, 0xe0, 0x27, 0x3a, 0x05, 0x5e, 0x81, 0x4a,
+		0xc5, 0x0a, 0xa8, 0xe6, 0xca, 0x8a, 0xc2, 0x86, 0xd6, 0xc5, 0x0b, 0xaf, 0x40, 0x3e, 0xcc, 0x1e,
+		0x34, 0x07, 0x53, 0x8e, 0xab, 0xd9, 0x2e, 0x95, 0xc2, 0x29, 0x85, 0x7d, 0x20, 0x19, 0xe2, 0xd8,
+		0x6c, 0x53, 0xcd, 0x38, 0xa5, 0x90, 0x3f, 0xd1, 0x37, 0xf8, 0x03, 0x8e, 0xd3, 0x01, 0xdf, 0x3f,
+		0x3c, 0xa3, 0x21, 0xca, 0x83, 0xe3, 0x5e, 0x78, 0x12, 0x72, 0xa1, 0x01, 0x4c, 0xda, 0x74, 0xf1,
+		0xdf, 0x25, 0xe0, 0x70, 0x24, 0x6d, 0xf4, 0x02, 0xcc, 0xf5, 0x4d, 0xc3, 0x74, 0xb1, 0xdd, 0xb3,
+		0x31, 0x11, 0x59, 0xd6, 0xd6, 0xfc, 0x17, 0xa6, 0x47, 0x08, 0xdd, 0x46, 0x10, 0x9a, 0x51, 0x51,
+		0x66, 0xfb, 0xc3, 0x85, 0xe8, 0x3a, 0x64, 0x88, 0x7c, 0x68, 0xb6, 0x46, 0x09, 0xb2, 0xd5, 0x78,
+		0x7e, 0xb2, 0x21, 0x2f, 0x55, 0x7d, 0xcc, 0x72, 0xfc, 0xbd, 0x52, 0x4c, 0x09, 0xd2, 0x42, 0x4f,
+		0x42, 0x6a, 0x0b, 0x6b, 0x6e, 0xdf, 0xc6, 0xce, 0xfc, 0x79, 0xca, 0xca, 0xe3, 0xc3, 0x8b, 0x94,
+		0x01, 0x34, 0xb1, 0xab, 0x78, 0xc0, 0xa8, 0x0b, 0xd9, 0x9b, 0xd8, 0x36, 0xb6, 0x0c, 0x9d, 0x75,
+		0x2a, 0x4e, 0x95, 0xcf, 0x53, 0x13, 0x76, 0xea, 0x5a, 0x00, 0xb5, 0xe9, 0x6a, 0x2e, 0x5e, 0x86,
+		0x8d, 0xc6, 0xb5, 0x9a, 0x52, 0xbf, 0x54, 0xaf, 0x55, 0x59, 0x37, 0x43, 0xe4, 0x17, 0xbe, 0x5f,
+		0x82, 0x4c, 0x60, 0x24, 0x44, 0x1d, 0x9a, 0xfd, 0xee, 0x26, 0xb6, 0xf9, 0x7c, 0xf1, 0x2f, 0x74,
+		0x1c, 0xd2, 0x5b, 0xfd, 0x4e, 0x87, 0x09, 0x1d, 0xb3, 0xa5, 0x29, 0x52, 0x40, 0x04, 0x8e, 0xe8,
+		0x38, 0xae, 0x46, 0xa8, 0x8e, 0x23, 0x7f, 0xa3, 0x05, 0x48, 0x09, 0xa1, 0x9c, 0x9f, 0x3a, 0x29,
+		0x9d, 0x4e, 0x29, 0xde, 0x37, 0xab, 0xeb, 0x61, 0xcd, 0xc5, 0xed, 0xf9, 0xa4, 0xa8, 0x63, 0xdf,
+		0x57, 0x13, 0xa9, 0x84, 0x3c, 0x55, 0x7c, 0x1c, 0x66, 0x86, 0x86, 0x82, 0x0a, 0x90, 0xa9, 0xd6,
+		0x2a, 0x2b, 0x25, 0xa5, 0xd4, 0xaa, 0xaf, 0x35, 0xe4, 0x43, 0x28, 0x0f, 0x81, 0xd1, 0xc9, 0xd2,
+		0x99, 0x74, 0xea, 0x8d, 0x69, 0xf9, 0x5d, 0xef, 0x7a, 0xd7, 0xbb, 0x62, 0xc5, 0x5f, 0x4d, 0xc2,
+		0x5c, 0x94, 0x12, 0x8c, 0xd4, 0xc7, 0xfe, 0xa0, 0xe3, 0xa1, 0x41, 0x97, 0x60, 0xaa, 0xa3, 0x6d,
+		0xe2, 0xce, 0x7c, 0x82, 0x4e, 0xc2, 0x43, 0x13, 0xa9, 0xd9, 0xa5, 0x15, 0x82, 0xa2, 0x30, 0x4c,
+		0xf4, 0x76, 0xce, 0x9a, 0x29, 0x4a, 0xe1, 0xcc, 0x64, 0x14, 0x88, 0x72, 0xe4, 0x6c, 0x3c, 0x0e,
+		0x69, 0xf2, 0x3f, 0xe3, 0x7b, 0x92, 0xf1, 0x9d, 0x14, 0x50, 0xbe, 0x2f, 0x40, 0x8a, 0xea, 0xbd,
+		0x36, 0xf6, 0xe6, 0x44, 0x7c, 0x13, 0x4d, 0xd1, 0xc6, 0x5b, 0x5a, 0xbf, 0xe3, 0xaa, 0x37, 0xb5,
+		0x4e, 0x1f, 0x53, 0x0d, 0x96, 0x56, 0xb2, 0xbc, 0xf0, 0x1a, 0x29, 0x43, 0x8b, 0x90, 0x61, 0x6a,
+		0xd2, 0x30, 0xdb, 0x78, 0x97, 0x9a, 0xd0, 0x29, 0x85, 0x69, 0xce, 0x3a, 0x29, 0x21, 0xcd, 0xbf,
+		0xe4, 0x58, 0xa6, 0xd0, 0x35, 0xb4, 0x09, 0x52, 0x40, 0x9b, 0x7f, 0x72, 0xd0, 0x7a, 0xdf, 0x1d,
+		0x3d, 0xbc, 0x21, 0xe5, 0xf8, 0x00, 0x14, 0x28, 0xc4, 0x63, 0x7c, 0x29, 0x6b, 0x9d, 0xf9, 0x19,
+		0x2a, 0x06, 0x79, 0x56, 0xbc, 0xc6, 0x4b, 0x8b, 0x9f, 0x8e, 0x41, 0x82, 0x5a, 0x8a, 0x02, 0x64,
+		0x5a, 0xd7, 0xd7, 0x6b, 0x6a, 0x75, 0x6d, 0xa3, 0xbc, 0x52, 0x93, 0x25, 0x32, 0xf5, 0xb4, 0xe0,
+		0xd2, 0xca, 0x5a, 0xa9, 0x25, 0xc7, 0xbc, 0xef, 0x7a, 0xa3, 0x75, 0xe1, 0x71, 0x39, 0xee, 0x21,
+		0x6c, 0xb0, 0x82, 0x44, 0x10, 0xe0, 0xb1, 0xf3, 0xf2, 0x14, 0x92, 0x21, 0xcb, 0x08, 0xd4, 0x5f,
+		0xa8, 0x55, 0x2f, 0x3c, 0x2e, 0x27, 0xc3, 0x25, 0x8f, 0x9d, 0x97, 0xa7, 0x51, 0x0e, 0xd2, 0xb4,
+		0xa4, 0xbc, 0xb6, 0xb6, 0x22, 0xa7, 0x3c, 0x9a, 0xcd, 0x96, 0x52, 0x6f, 0x5c, 0x96, 0xd3, 0x1e,
+		0xcd, 0xcb, 0xca, 0xda, 0xc6, 0xba, 0x0c, 0x1e, 0x85, 0xd5, 0x5a, 0xb3, 0x59, 0xba, 0x5c, 0x93,
+		0x33, 0x1e, 0x44, 0xf9, 0x7a, 0xab, 0xd6, 0x94, 0xb3, 0xa1, 0x6e, 0x3d, 0x76, 0x5e, 0xce, 0x79,
+		0x4d, 0xd4, 0x1a, 0x1b, 0xab, 0x72, 0x1e, 0xcd, 0x40, 0x8e, 0x35, 0x21, 0x3a, 0x51, 0x18, 0x28,
+		0xba, 0xf0, 0xb8, 0x2c, 0xfb, 0x1d, 0x61, 0x54, 0x66, 0x42, 0x05, 0x17, 0x1e, 0x97, 0x51, 0xb1,
+		0x02, 0x53, 0x54, 0x0c, 0x11, 0x82, 0xfc, 0x4a, 0xa9, 0x5c, 0x5b, 0x51, 0xd7, 0xd6, 0xc9, 0xa2,
+		0x29, 0xad, 0xc8, 0x92, 0x5f, 0xa6, 0xd4, 0xd6, 0x6b, 0xa5, 0x56, 0xad, 0x2a, 0xc7, 0x83, 0x65,
+		0xef, 0xd8, 0xa8, 0x2b, 0xb5, 0xaa, 0x1c, 0x2b, 0xea, 0x30, 0x17, 0x65, 0x21, 0x23, 0x97, 0x50,
+		0x40, 0x16, 0x62, 0x23, 0x64, 0x81, 0xd2, 0x1a, 0x94, 0x85, 0xe2, 0xe7, 0x63, 0x30, 0x1b, 0xe1,
+		0x25, 0x44, 0x36, 0xf2, 0x2c, 0x4c, 0x31, 0x59, 0x66, 0x9a, 0xfa, 0xc1, 0x48, 0x77, 0x83, 0x4a,
+		0xf6, 0x90, 0xef, 0x44, 0xf1, 0x82, 0xfe, 0x66, 0x7c, 0x84, 0xbf, 0x49, 0x48, 0x0c, 0x09, 0xec,
+		0x37, 0x0f, 0x59, 0x73, 0xe6, 0xf0, 0x5c, 0x98, 0xc4, 0xe1, 0xa1, 0x65, 0x07, 0xb3, 0xea, 0x53,
+		0x11, 0x56, 0xfd, 0x69, 0x98, 0x19, 0x22, 0x34, 0xb1, 0x75, 0xfd, 0x76, 0x09, 0xe6, 0x47, 0x31,
+		0x67, 0x8c, 0x4a, 0x8c, 0x85, 0x54, 0xe2, 0xd3, 0x83, 0x1c, 0xbc, 0x67, 0xf4, 0x24, 0x0c, 0xcd,
+		0xf5, 0xc7, 0x24, 0x38, 0x12, 0x1d, 0x57, 0x44, 0xf6, 0xe1, 0xed, 0x90, 0xec, 0x62, 0x77, 0xc7,
+		0x12, 0x7e, 0xf2, 0xfd, 0x11, 0xde, 0x17, 0xa9, 0x1e, 0x9c, 0x6c, 0x8e, 0x15, 0x74, 0xdf, 0xe2,
+		0xa3, 0x82, 0x03, 0xd6, 0x9b, 0xa1, 0x9e, 0x7e, 0x57, 0x0c, 0x0e, 0x47, 0x12, 0x8f, 0xec, 0xe8,
+		0xdd, 0x00, 0x86, 0xd9, 0xeb, 0xbb, 0xcc, 0x17, 0x66, 0x9a, 0x38, 0x4d, 0x4b, 0xa8, 0xf2, 0x22,
+		0x5a, 0xb6, 0xef, 0x7a, 0xf5, 0xcc, 0x4a, 0x02, 0x2b, 0xa2, 0x00, 0x4f, 0xf9, 0x1d, 0x4d, 0xd0,
+		0x8e, 0x9e, 0x18, 0x31, 0xd2, 0x21, 0xc1, 0x7c, 0x14, 0x64, 0xbd, 0x63, 0x60, 0xd3, 0x55, 0x1d,
+		0xd7, 0xc6, 0x5a, 0xd7, 0x30, 0xb7, 0x99, 0xb5, 0x5d, 0x9e, 0xda, 0xd2, 0x3a, 0x0e, 0x56, 0x0a,
+		0xac, 0xba, 0x29, 0x6a, 0x09, 0x06, 0x15, 0x20, 0x3b, 0x80, 0x91, 0x0c, 0x61, 0xb0, 0x6a, 0x0f,
+		0xa3, 0xf8, 0x33, 0x69, 0xc8, 0x04, 0xa2, 0x30, 0x74, 0x0f, 0x64, 0x5f, 0xd2, 0x6e, 0x6a, 0xaa,
+		0x88, 0xac, 0x19, 0x27, 0x32, 0xa4, 0x6c, 0x9d, 0x47, 0xd7, 0x8f, 0xc2, 0x1c, 0x05, 0xb1, 0xfa,
+		0x2e, 0xb6, 0x55, 0xbd, 0xa3, 0x39, 0x0e, 0x65, 0x5a, 0x8a, 0x82, 0x22, 0x52, 0xb7, 0x46, 0xaa,
+		0x2a, 0xa2, 0x06, 0x3d, 0x01, 0xb3, 0x14, 0xa3, 0xdb, 0xef, 0xb8, 0x46, 0xaf, 0x83, 0x55, 0x12,
+		0xf9, 0x3b, 0xd4, 0xe4, 0x78, 0x3d, 0x9b, 0x21, 0x10, 0xab, 0x1c, 0x80, 0xf4, 0xc8, 0x41, 0x55,
+		0xb8, 0x9b, 0xa2, 0x6d, 0x63, 0x13, 0xdb, 0x9a, 0x8b, 0x55, 0xfc, 0x72, 0x5f, 0xeb, 0x38, 0xaa,
+		0x66, 0xb6, 0xd5, 0x1d, 0xcd, 0xd9, 0x99, 0x9f, 0x23, 0x04, 0xca, 0xb1, 0x79, 0x49, 0x39, 0x46,
+		0x00, 0x2f, 0x73, 0xb8, 0x1a, 0x05, 0x2b, 0x99, 0xed, 0x2b, 0x9a, 0xb3, 0x83, 0x96, 0xe1, 0x08,
+		0xa5, 0xe2, 0xb8, 0xb6, 0x61, 0x6e, 0xab, 0xfa, 0x0e, 0xd6, 0x6f, 0xa8, 0x7d, 0x77, 0xeb, 0xa9,
+		0xf9, 0xe3, 0xc1, 0xf6, 0x69, 0x0f, 0x9b, 0x14, 0xa6, 0x42, 0x40, 0x36, 0xdc, 0xad, 0xa7, 0x50,
+		0x13, 0xb2, 0x64, 0x32, 0xba, 0xc6, 0x2b, 0x58, 0xdd, 0xb2, 0x6c, 0x6a, 0x43, 0xf3, 0x11, 0xaa,
+		0x29, 0xc0, 0xc1, 0xa5, 0x35, 0x8e, 0xb0, 0x6a, 0xb5, 0xf1, 0xf2, 0x54, 0x73, 0xbd, 0x56, 0xab,
+		0x2a, 0x19, 0x41, 0xe5, 0x92, 0x65, 0x13, 0x81, 0xda, 0xb6, 0x3c, 0x06, 0x67, 0x98, 0x40, 0x6d,
+		0x5b, 0x82, 0xbd, 0x4f, 0xc0, 0xac, 0xae, 0xb3, 0x31, 0x1b, 0xba, 0xca, 0x23, 0x72, 0x67, 0x5e,
+		0x0e, 0x31, 0x4b, 0xd7, 0x2f, 0x33, 0x00, 0x2e, 0xe3, 0x0e, 0xba, 0x08, 0x87, 0x7d, 0x66, 0x05,
+		0x11, 0x67, 0x86, 0x46, 0x39, 0x88, 0xfa, 0x04, 0xcc, 0xf6, 0xf6, 0x86, 0x11, 0x51, 0xa8, 0xc5,
+		0xde, 0xde, 0x20, 0xda, 0x7d, 0x34, 0xcb, 0x62, 0x63, 0x9d, 0xba, 0x7a, 0x47, 0x83, 0xd0, 0x81,
+		0x0a, 0xb4, 0x04, 0xb2, 0xae, 0xab, 0xd8, 0xd4, 0x36, 0x3b, 0x58, 0xd5, 0x6c, 0x6c, 0x6a, 0xce,
+		0xfc, 0x22, 0x05, 0x4e, 0xb8, 0x76, 0x1f, 0x2b, 0x79, 0x5d, 0xaf, 0xd1, 0xca, 0x12, 0xad, 0x43,
+		0x67, 0x60, 0xc6, 0xda, 0x7c, 0x49, 0x67, 0x82, 0xa5, 0xf6, 0x6c, 0xbc, 0x65, 0xec, 0xce, 0xdf,
+		0x4b, 0xb9, 0x54, 0x20, 0x15, 0x54, 0xac, 0xd6, 0x69, 0x31, 0x7a, 0x10, 0x64, 0xdd, 0xd9, 0xd1,
+		0xec, 0x1e, 0xd5, 0xac, 0x4e, 0x4f, 0xd3, 0xf1, 0xfc, 0x7d, 0x0c, 0x94, 0x95, 0x37, 0x44, 0x31,
+		0x11, 0x6c, 0xe7, 0x96, 0xb1, 0xe5, 0x0a, 0x8a, 0x0f, 0x30, 0xc1, 0xa6, 0x65, 0x9c, 0xda, 0x69,
+		0x90, 0x7b, 0x3b, 0xbd, 0x70, 0xc3, 0xa7, 0x29, 0x58, 0xbe, 0xb7, 0xd3, 0x0b, 0xb6, 0x7b, 0x0a,
+		0x72, 0x04, 0xd2, 0x6f, 0xf4, 0x41, 0xe6, 0x7f, 0xf5, 0x76, 0x02, 0x2d, 0x3e, 0x0e, 0x47, 0x08,
+		0x50, 0x17, 0xbb, 0x5a, 0x5b, 0x73, 0xb5, 0x00, 0xf4, 0xc3, 0x14, 0x7a, 0xae, 0xb7, 0xd3, 0x5b,
+		0xe5, 0x95, 0xa1, 0x7e, 0xda, 0xfd, 0xcd, 0x3d, 0x4f, 0x3e, 0x1e, 0x61, 0xfd, 0x24, 0x65, 0x42,
+		0x42, 0xee, 0x38, 0xfc, 0xf8, 0x9a, 0x05, 0x5b, 0xc5, 0x65, 0xc8, 0x06, 0xe5, 0x1e, 0xa5, 0x81,
+		0x49, 0xbe, 0x2c, 0x11, 0x27, 0xa8, 0xb2, 0x56, 0x25, 0xee, 0xcb, 0x8b, 0x35, 0x39, 0x46, 0xdc,
+		0xa8, 0x95, 0x7a, 0xab, 0xa6, 0x2a, 0x1b, 0x8d, 0x56, 0x7d, 0xb5, 0x26, 0xc7, 0x03, 0x8e, 0xfd,
+		0xd5, 0x44, 0xea, 0x8c, 0xfc, 0xd0, 0xd5, 0x44, 0xea, 0x7e, 0xf9, 0x01, 0xca, 0x9e, 0x21, 0xa1,
+		0x2c, 0x7e, 0x29, 0x0e, 0xf9, 0x70, 0x58, 0x8e, 0xde, 0x06, 0x47, 0x45, 0xde, 0xcd, 0xc1, 0xae,
+		0x7a, 0xcb, 0xb0, 0xe9, 0x62, 0xed, 0x6a, 0xcc, 0x70, 0x7a, 0x42, 0x39, 0xc7, 0xa1, 0x9a, 0xd8,
+		0x7d, 0xde, 0xb0, 0xc9, 0x52, 0xec, 0x6a, 0x2e, 0x5a, 0x81, 0x45, 0xd3, 0x52, 0x1d, 0x57, 0x33,
+		0xdb, 0x9a, 0xdd, 0x56, 0xfd, 0xfc, 0xa7, 0xaa, 0xe9, 0x3a, 0x76, 0x1c, 0x8b, 0x19, 0x49, 0x8f,
+		0xca, 0x5d, 0xa6, 0xd5, 0xe4, 0xc0, 0xbe, 0xf5, 0x28, 0x71, 0xd0, 0x81, 0x35, 0x11, 0x1f, 0xb5,
+		0x26, 0x8e, 0x43, 0xba, 0xab, 0xf5, 0x54, 0x6c, 0xba, 0xf6, 0x1e, 0xf5, 0xdd, 0x53, 0x4a, 0xaa,
+		0xab, 0xf5, 0x6a, 0xe4, 0x1b, 0x5d, 0x83, 0xfb, 0x7d, 0x50, 0xb5, 0x83, 0xb7, 0x35, 0x7d, 0x4f,
+		0xa5, 0x8e, 0x3a, 0xcd, 0x11, 0xa9, 0xba, 0x65, 0x6e, 0x75, 0x0c, 0xdd, 0x75, 0xa8, 0xee, 0x60,
+		0xfa, 0xaf, 0xe8, 0x63, 0xac, 0x50, 0x84, 0xab, 0x8e, 0x65, 0x52, 0xff, 0xbc, 0x22, 0xa0, 0x43,
+		0x62, 0x93, 0xfd, 0xba, 0x10, 0x9b, 0xf0, 0xd4, 0x27, 0xe4, 0xa9, 0xab, 0x89, 0xd4, 0x94, 0x9c,
+		0xbc, 0x9a, 0x48, 0x25, 0xe5, 0xe9, 0xab, 0x89, 0x54, 0x4a, 0x4e, 0x5f, 0x4d, 0xa4, 0xd2, 0x32,
+		0x14, 0x6f, 0xe7, 0x20, 0x1b, 0x0c, 0x37, 0x48, 0xf4, 0xa6, 0x53, 0x83, 0x2b, 0x51, 0x95, 0x7c,
+		0x6a, 0xdf, 0xe0, 0x64, 0xa9, 0x42, 0x2c, 0xf1, 0x72, 0x92, 0xf9, 0xf6, 0x0a, 0xc3, 0x24, 0x5e,
+		0x10, 0x59, 0x64, 0x98, 0xf9, 0x52, 0x29, 0x85, 0x7f, 0xa1, 0xcb, 0x90, 0x7c, 0xc9, 0xa1, 0xb4,
+		0x93, 0x94, 0xf6, 0xbd, 0xfb, 0xd3, 0xbe, 0xda, 0xa4, 0xc4, 0xd3, 0x57, 0x9b, 0x6a, 0x63, 0x4d,
+		0x59, 0x2d, 0xad, 0x28, 0x1c, 0x1d, 0x1d, 0x83, 0x44, 0x47, 0x7b, 0x65, 0x2f, 0x6c, 0xb3, 0x69,
+		0x11, 0x5a, 0x82, 0x42, 0xdf, 0x64, 0xb1, 0x3a, 0x99, 0x63, 0x02, 0x55, 0x08, 0x42, 0xe5, 0xfd,
+		0xda, 0x15, 0x02, 0x3f, 0xa1, 0x5c, 0x1d, 0x83, 0xc4, 0x2d, 0xac, 0xdd, 0x08, 0x5b, 0x56, 0x5a,
+		0x84, 0x4e, 0x43, 0xb6, 0x8d, 0x37, 0xfb, 0xdb, 0xaa, 0x8d, 0xdb, 0x9a, 0xee, 0x86, 0xed, 0x49,
+		0x86, 0x56, 0x29, 0xb4, 0x06, 0x3d, 0x07, 0x69, 0x32, 0x47, 0x26, 0x9d, 0xe3, 0x19, 0xca, 0x82,
+		0x47, 0xf6, 0x67, 0x01, 0x9f, 0x62, 0x81, 0xa4, 0xf8, 0xf8, 0xe8, 0x0a, 0x4c, 0xbb, 0x9a, 0xbd,
+		0x8d, 0x5d, 0x67, 0x7e, 0xf6, 0x64, 0xfc, 0x74, 0x3e, 0x22, 0x47, 0x16, 0x41, 0xaa, 0x45, 0x51,
+		0x68, 0xa4, 0x2c, 0xd0, 0xd1, 0xf3, 0x20, 0xf3, 0x54, 0xac, 0xca, 0xc3, 0x5c, 0x67, 0x7e, 0x8e,
+		0x0a, 0xe0, 0xc3, 0xfb, 0x93, 0xe4, 0x99, 0xdc, 0x2a, 0x43, 0x52, 0x0a, 0x38, 0xf4, 0x1d, 0x5e,
+		0x17, 0x87, 0x0f, 0xb2, 0x2e, 0x36, 0xa0, 0xc0, 0xff, 0x56, 0x9d, 0x7e, 0xaf, 0x67, 0xd9, 0xee,
+		0xfc, 0x11, 0x8a, 0x3f, 0xa6, 0x43, 0x82, 0x18, 0xc3, 0x51, 0xf2, 0x5b, 0xa1, 0xef, 0xaf, 0xdd,
+		0x72, 0x5b, 0x78, 0x11, 0xf2, 0x61, 0x66, 0x04, 0x13, 0xe1, 0xf1, 0x09, 0x13, 0xe1, 0x24, 0x2c,
+		0x11, 0x81, 0x1a, 0x31, 0x4d, 0xec, 0x63, 0xe1, 0x07, 0x62, 0x90, 0x0f, 0x0f, 0x0c, 0x5d, 0x06,
+		0x24, 0x66, 0xcc, 0x30, 0x5d, 0xdb, 0x6a, 0xf7, 0x75, 0xdc, 0xe6, 0x0b, 0x76, 0x74, 0x3b, 0x33,
+		0x1c, 0xa7, 0xee, 0xa1, 0x04, 0x09, 0x05, 0x56, 0x41, 0x6c, 0x42, 0x42, 0x55, 0x7f, 0x7d, 0x9c,
+		0x85, 0x59, 0x41, 0x80, 0x10, 0xbb, 0xa5, 0xd9, 0x26, 0x71, 0x91, 0x99, 0xd3, 0x8e, 0x02, 0x55,
+		0xcf, 0xb3, 0x1a, 0x54, 0x02, 0x21, 0x2e, 0xaa, 0x8d, 0xbb, 0xd6, 0x4d, 0xdc, 0xe6, 0xe9, 0xa2,
+		0xd1, 0xcd, 0xe6, 0x39, 0x82, 0xc2, 0xe0, 0x8b, 0x67, 0x61, 0x8a, 0xaa, 0x1f, 0x04, 0xc0, 0x15,
+		0x90, 0x7c, 0x08, 0xa5, 0x20, 0x51, 0x59, 0x53, 0x88, 0x79, 0x94, 0x21, 0xcb, 0x4a, 0xd5, 0xf5,
+		0x7a, 0xad, 0x52, 0x93, 0x63, 0xc5, 0x27, 0x20, 0xc9, 0x74, 0x0a, 0x31, 0x9d, 0x9e, 0x56, 0x91,
+		0x0f, 0xf1, 0x4f, 0x4e, 0x43, 0x12, 0xb5, 0x1b, 0xab, 0xe5, 0x9a, 0x22, 0xc7, 0x8a, 0x1b, 0x50,
+		0x18, 0x58, 0x87, 0xe8, 0x30, 0xcc, 0x28, 0xb5, 0x56, 0xad, 0xd1, 0xaa, 0xaf, 0x35, 0xd4, 0x8d,
+		0xc6, 0x73, 0x8d, 0xb5, 0xe7, 0x1b, 0xf2, 0xa1, 0x70, 0xb1, 0xb0, 0xc3, 0x12, 0x9a, 0x03, 0xd9,
+		0x2f, 0x6e, 0xae, 0x6d, 0x28, 0xb4, 0x37, 0xdf, 0x1d, 0x03, 0x79, 0x70, 0x51, 0xa2, 0xa3, 0x30,
+		0xdb, 0x2a, 0x29, 0x97, 0x6b, 0x2d, 0x95, 0x25, 0x3c, 0x3c, 0xd2, 0x73, 0x20, 0x07, 0x2b, 0x2e,
+		0xd5, 0x69, 0x3e, 0x67, 0x11, 0x8e, 0x07, 0x4b, 0x6b, 0x2f, 0xb4, 0x6a, 0x8d, 0x26, 0x6d, 0xbc,
+		0xd4, 0xb8, 0x4c, 0x9c, 0x82, 0x01, 0x7a, 0x22, 0xc5, 0x12, 0x27, 0x5d, 0x0d, 0xd3, 0xab, 0xad,
+		0x54, 0xe5, 0xc4, 0x60, 0xf1, 0x5a, 0xa3, 0xb6, 0x76, 0x49, 0x9e, 0x1a, 0x6c, 0x9d, 0xa6, 0x5d,
+		0x92, 0x68, 0x01, 0x8e, 0x0c, 0x96, 0xaa, 0xb5, 0x46, 0x4b, 0xb9, 0x2e, 0x4f, 0x0f, 0x36, 0xdc,
+		0xac, 0x29, 0xd7, 0xea, 0x95, 0x9a, 0x9c, 0x42, 0x47, 0x00, 0x85, 0x7b, 0xd4, 0xba, 0xb2, 0x56,
+		0x95, 0xd3, 0x51, 0x16, 0x0b, 0xc9, 0xb3, 0xc5, 0x9f, 0x96, 0x20, 0x1b, 0x4c, 0x81, 0x84, 0x94,
+		0x8a, 0xf4, 0xf5, 0x66, 0x6c, 0x8b, 0xbf, 0x13, 0x83, 0x4c, 0x20, 0x17, 0x42, 0x82, 0x58, 0xad,
+		0xd3, 0xb1, 0x6e, 0xa9, 0x5a, 0xc7, 0xd0, 0x1c, 0x6e, 0x0f, 0x81, 0x16, 0x95, 0x48, 0xc9, 0xa4,
+		0xf6, 0x67, 0x72, 0xd7, 0x25, 0x79, 0xc7, 0xae, 0xcb, 0xf4, 0xd7, 0xa1, 0xeb, 0x32, 0x25, 0x27,
+		0x8b, 0xbf, 0x1f, 0x03, 0x79, 0x30, 0x3b, 0x32, 0xc0, 0x37, 0x69, 0x14, 0xdf, 0x82, 0xe3, 0x8b,
+		0x1d, 0x64, 0x7c, 0x83, 0x56, 0x3d, 0x3e, 0xd2, 0xaa, 0x47, 0x18, 0xab, 0xc4, 0xd7, 0xb3, 0xb1,
+		0x0a, 0x8a, 0xeb, 0x7f, 0x94, 0x20, 0x1f, 0x4e, 0xe6, 0x84, 0x38, 0x56, 0x3c, 0x08, 0xc7, 0xc2,
+		0x33, 0x72, 0xcf, 0xa8, 0x19, 0xf9, 0x1b, 0x19, 0xd7, 0x0f, 0xc5, 0x21, 0x17, 0xca, 0xfd, 0x4c,
+		0xda, 0xbb, 0x97, 0x61, 0xc6, 0x68, 0xe3, 0x6e, 0xcf, 0x72, 0xb1, 0xa9, 0xef, 0xa9, 0x1d, 0x7c,
+		0x13, 0x77, 0x28, 0x1b, 0xf2, 0x11, 0xbb, 0xab, 0xa1, 0x16, 0x96, 0xea, 0x3e, 0xde, 0x0a, 0x41,
+		0x5b, 0x9e, 0xad, 0x57, 0x6b, 0xab, 0xeb, 0x6b, 0xad, 0x5a, 0xa3, 0x72, 0x5d, 0x68, 0x72, 0x45,
+		0x36, 0x06, 0xc0, 0x42, 0x0c, 0x3f, 0xf5, 0xf5, 0x11, 0x74, 0xae, 0x83, 0x3c, 0x38, 0x1a, 0xa2,
+		0xd0, 0x23, 0xc6, 0x23, 0x1f, 0x42, 0xb3, 0x50, 0x68, 0xac, 0xa9, 0xcd, 0x7a, 0xb5, 0xa6, 0xd6,
+		0x2e, 0x5d, 0xaa, 0x55, 0x5a, 0x4d, 0xb6, 0xd1, 0xe0, 0x41, 0xb7, 0xe4, 0x58, 0x70, 0x6e, 0x3e,
+		0x18, 0x87, 0xd9, 0x88, 0x9e, 0xa0, 0x12, 0x4f, 0x11, 0xb2, 0xac, 0xe5, 0x23, 0x93, 0xf4, 0x7e,
+		0x89, 0x44, 0xf7, 0xeb, 0x9a, 0xed, 0xf2, 0x8c, 0xe2, 0x83, 0x40, 0xd8, 0x6b, 0xba, 0xc4, 0xbd,
+		0xb7, 0xf9, 0x06, 0x0e, 0x73, 0x41, 0x0a, 0x7e, 0x39, 0xdb, 0xc3, 0x79, 0x18, 0x50, 0xcf, 0x72,
+		0x0c, 0xd7, 0xb8, 0x89, 0x89, 0x0f, 0xc5, 0x81, 0xc9, 0xc2, 0x4d, 0x28, 0xb2, 0xa8, 0xa9, 0x9b,
+		0xae, 0x07, 0x6d, 0xe2, 0x6d, 0x6d, 0x00, 0x9a, 0x84, 0x1f, 0x71, 0x45, 0x16, 0x35, 0x1e, 0xf4,
+		0x3d, 0x90, 0x6d, 0x5b, 0xfd, 0xcd, 0x0e, 0xe6, 0x70, 0x44, 0x25, 0x4b, 0x4a, 0x86, 0x95, 0x79,
+		0x20, 0x3c, 0x6d, 0xe6, 0x6f, 0x33, 0x65, 0x95, 0x0c, 0x2b, 0x63, 0x20, 0x0f, 0x40, 0x41, 0xdb,
+		0xde, 0xb6, 0x09, 0x71, 0x41, 0x88, 0x25, 0x02, 0xf3, 0x5e, 0x31, 0x05, 0x5c, 0xb8, 0x0a, 0x29,
+		0xc1, 0x07, 0x12, 0xff, 0x12, 0x4e, 0xa8, 0x3d, 0x96, 0xdd, 0x8e, 0x9d, 0x4e, 0x2b, 0x29, 0x53,
+		0x54, 0xde, 0x03, 0x59, 0xc3, 0x51, 0xfd, 0x63, 0x10, 0xb1, 0x93, 0xb1, 0xd3, 0x29, 0x25, 0x63,
+		0x38, 0xde, 0xae, 0x68, 0xf1, 0x63, 0x00, 0xe0, 0x0b, 0x1b, 0xfa, 0x5e, 0x09, 0xf2, 0xcc, 0xc0,
+		0xf4, 0x6c, 0xec, 0x60, 0x53, 0x17, 0x61, 0xe1, 0x83, 0xfb, 0x88, 0x28, 0x53, 0x73, 0xeb, 0x1c,
+		0xa1, 0xfc, 0xec, 0x7b, 0x25, 0xe9, 0x35, 0x29, 0xf1, 0x9a, 0x24, 0xfd, 0xa8, 0x94, 0x43, 0xa9,
+		0xda, 0x0b, 0xeb, 0x2b, 0xf5, 0x4a, 0xbd, 0x35, 0xff, 0x9e, 0x69, 0xfa, 0x5d, 0x5f, 0xe5, 0xdf,
+		0x5f, 0x98, 0x0e, 0xd7, 0xbf, 0x31, 0xfd, 0x0b, 0x52, 0x3c, 0xf5, 0xc6, 0xb4, 0x92, 0xdb, 0x0a,
+		0xd2, 0x43, 0x9d, 0xe0, 0x09, 0x8a, 0xd8, 0xa8, 0x40, 0xd2, 0xef, 0x4d, 0x8d, 0x9f, 0x9b, 0x28,
+		0x3f, 0x48, 0x3b, 0x92, 0xa4, 0x1d, 0xc9, 0xa0, 0x64, 0x65, 0x65, 0xad, 0x59, 0xab, 0xd2, 0x6e,
+		0xa4, 0x51, 0x62, 0x6d, 0xbd, 0xd6, 0x98, 0xff, 0x82, 0x68, 0xd2, 0x3f, 0x6c, 0xf1, 0x9a, 0x04,
+		0x47, 0xc5, 0x2e, 0x2b, 0xb7, 0xb5, 0xd8, 0xd4, 0xad, 0xb6, 0xf0, 0x6e, 0xf3, 0xe7, 0xcf, 0xed,
+		0xd7, 0xb8, 0xc2, 0x51, 0x29, 0x4b, 0x6a, 0x1c, 0xb1, 0xfc, 0xc8, 0x10, 0x4b, 0x4a, 0x8d, 0x2a,
+		0xef, 0x4b, 0x06, 0x25, 0xd7, 0x4b, 0x95, 0xe7, 0x6a, 0x55, 0xbf, 0x37, 0x87, 0xed, 0x28, 0x2a,
+		0xe8, 0x5b, 0xa1, 0xd0, 0x77, 0xb7, 0x9e, 0x22, 0xb2, 0x61, 0xb4, 0xd9, 0xb6, 0x77, 0x62, 0xd4,
+		0x7e, 0xa9, 0xdf, 0xa3, 0x0d, 0x77, 0xeb, 0xa9, 0x6b, 0x1e, 0x06, 0x67, 0x0a, 0xeb, 0x4a, 0x1a,
+		0x25, 0x1a, 0x6b, 0x8d, 0x9a, 0xe8, 0x06, 0xdd, 0x22, 0xbe, 0xee, 0x77, 0x23, 0xdf, 0x0f, 0xa1,
+		0xa2, 0x6f, 0x05, 0x59, 0xa4, 0x87, 0x3c, 0x96, 0x4c, 0x8d, 0xda, 0xf2, 0xf5, 0x3b, 0xc0, 0x93,
+		0x4c, 0x1e, 0x33, 0xee, 0x0f, 0xf4, 0x60, 0x0e, 0x15, 0x56, 0x6a, 0x8d, 0xcb, 0xad, 0x2b, 0xea,
+		0xba, 0x52, 0xa3, 0x3b, 0x77, 0xf3, 0xef, 0x11, 0xcd, 0x17, 0xba, 0x61, 0x44, 0xf4, 0x6d, 0x12,
+		0x64, 0x98, 0x0b, 0xc4, 0x72, 0x52, 0x2c, 0xa9, 0x70, 0xff, 0x7e, 0x6d, 0x53, 0x0f, 0x88, 0x42,
+		0x97, 0x2f, 0xd2, 0x66, 0xe3, 0x42, 0x20, 0x8e, 0x22, 0xb4, 0x52, 0xbb, 0x5c, 0xaa, 0x5c, 0x57,
+		0xcb, 0xb5, 0x66, 0x8b, 0x68, 0xb2, 0x35, 0x85, 0xc9, 0x28, 0xa0, 0xa9, 0xd2, 0xca, 0xca, 0xda,
+		0xf3, 0x3e, 0x23, 0xe0, 0x25, 0x8f, 0x4c, 0xf1, 0x9b, 0x20, 0x17, 0x12, 0x77, 0xe2, 0x14, 0x53,
+		0x67, 0x9a, 0x8c, 0xa0, 0x59, 0x6b, 0x54, 0x82, 0x4e, 0x7c, 0x16, 0x3c, 0xf1, 0x96, 0x25, 0xf2,
+		0x25, 0x84, 0x5f, 0x8e, 0x11, 0x35, 0xca, 0x3b, 0xe0, 0xed, 0x25, 0xc6, 0x8b, 0x4f, 0x42, 0x4a,
+		0x88, 0x2f, 0x71, 0xcd, 0xa9, 0x87, 0x3d, 0x10, 0x18, 0xa4, 0x80, 0xca, 0xae, 0x2c, 0x91, 0x30,
+		0x88, 0xc9, 0xb4, 0x1c, 0x2b, 0x5e, 0x83, 0xc3, 0x91, 0xa2, 0x87, 0x4e, 0xc1, 0xa2, 0xd8, 0xbf,
+		0x64, 0x4e, 0xbf, 0x5a, 0x6b, 0x54, 0xd6, 0xaa, 0x24, 0x4c, 0xf2, 0x69, 0x02, 0x70, 0x19, 0x64,
+		0xbd, 0x14, 0xf2, 0x29, 0xc7, 0x8a, 0x75, 0xc8, 0x87, 0x05, 0x08, 0x1d, 0x87, 0xa3, 0x1b, 0xad,
+		0x4b, 0x4f, 0xa9, 0xd7, 0x4a, 0x2b, 0xf5, 0x6a, 0x69, 0x20, 0x20, 0x02, 0xe0, 0x52, 0x24, 0xc7,
+		0x48, 0x47, 0x89, 0x74, 0xc9, 0xf1, 0x62, 0x22, 0x25, 0xc9, 0x52, 0xb1, 0x09, 0x85, 0x01, 0x51,
+		0x40, 0x77, 0xc1, 0x3c, 0x8f, 0x50, 0xa2, 0x7a, 0x45, 0x39, 0x14, 0x12, 0x0e, 0x16, 0xab, 0x55,
+		0x6b, 0x2b, 0xf5, 0xd5, 0x7a, 0x8b, 0xf6, 0xef, 0x0a, 0x80, 0x3f, 0xc7, 0xc4, 0x66, 0x5d, 0x6d,
+		0xae, 0x35, 0xd4, 0x4b, 0x24, 0xd0, 0x6b, 0x05, 0x48, 0xa5, 0x81, 0xcd, 0xa9, 0x2c, 0x91, 0x78,
+		0x64, 0x78, 0xe2, 0xe5, 0xd8, 0x99, 0x24, 0xb1, 0x58, 0xef, 0x6b, 0x9c, 0x49, 0xa6, 0xde, 0xd7,
+		0x90, 0x3f, 0x40, 0xfe, 0xff, 0x40, 0x43, 0xfe, 0xde, 0xc6, 0xd5, 0x64, 0xea, 0x0b, 0xd3, 0xf2,
+		0x1b, 0xd3, 0xc5, 0x3f, 0x8f, 0x03, 0xf2, 0x25, 0xcb, 0xcb, 0x79, 0xbc, 0x00, 0x29, 0x2f, 0x89,
+		0xc2, 0xce, 0x6c, 0xbe, 0x6d, 0x1f, 0x81, 0x14, 0x68, 0x81, 0xa2, 0x81, 0xa4, 0x8a, 0x47, 0x8d,
+		0x44, 0xcc, 0x5d, 0xc3, 0x34, 0xba, 0xfd, 0xae, 0x2a, 0x32, 0x0b, 0x63, 0x23, 0x66, 0x8e, 0xc0,
+		0xbf, 0x29, 0x09, 0x6d, 0x37, 0x44, 0x62, 0x6a, 0x2c, 0x09, 0x86, 0xc0, 0xbf, 0x17, 0xbe, 0x2c,
+		0xc1, 0xfc, 0xa8, 0xce, 0xde, 0x51, 0xd2, 0xa3, 0x01, 0x73, 0xd6, 0x4d, 0x6c, 0xdb, 0x46, 0x9b,
+		0xee, 0x63, 0x78, 0xae, 0x50, 0x62, 0xbc, 0x2b, 0x34, 0x1b, 0x40, 0xbc, 0x24, 0xbc, 0xa2, 0x32,
+		0xb1, 0x58, 0xbb, 0x44, 0x59, 0x0b, 0x4a, 0x53, 0xe3, 0x29, 0xe5, 0x28, 0x8a, 0xa0, 0x71, 0x95,
+		0x08, 0x28, 0x89, 0x3e, 0x62, 0x72, 0xdc, 0xf7, 0xb7, 0x8a, 0x9f, 0x8a, 0x41, 0x3e, 0x7c, 0x2c,
+		0x12, 0x55, 0x21, 0xd5, 0xb1, 0xf8, 0x91, 0x23, 0x36, 0xdb, 0xa7, 0xc7, 0x9c, 0xa4, 0x5c, 0x5a,
+		0xe1, 0xf0, 0x8a, 0x87, 0xb9, 0xf0, 0xdb, 0x12, 0xa4, 0x44, 0x31, 0x3a, 0x02, 0x89, 0x9e, 0xe6,
+		0xee, 0x50, 0x72, 0x53, 0xe5, 0x98, 0x2c, 0x29, 0xf4, 0x9b, 0x94, 0x3b, 0x3d, 0x8d, 0x1d, 0xb7,
+		0xe2, 0xe5, 0xe4, 0x9b, 0xf8, 0x3c, 0x1d, 0xac, 0xb5, 0xe9, 0x0e, 0x9c, 0xd5, 0xed, 0x62, 0xd3,
+		0x75, 0x84, 0xcf, 0xc3, 0xcb, 0x2b, 0xbc, 0x18, 0x3d, 0x04, 0x33, 0xae, 0xad, 0x19, 0x9d, 0x10,
+		0x6c, 0x82, 0xc2, 0xca, 0xa2, 0xc2, 0x03, 0x5e, 0x86, 0x63, 0x82, 0x6e, 0x1b, 0xbb, 0x9a, 0xbe,
+		0x83, 0xdb, 0x3e, 0x52, 0x92, 0xee, 0xb4, 0x1f, 0xe5, 0x00, 0x55, 0x5e, 0x2f, 0x70, 0x07, 0x8e,
+		0x1e, 0x7f, 0x36, 0x06, 0x33, 0x62, 0x07, 0xb1, 0xed, 0xb1, 0x6e, 0x15, 0x40, 0x33, 0x4d, 0xcb,
+		0x0d, 0x32, 0x6f, 0xd8, 0xe9, 0x1b, 0xc2, 0x5b, 0x2a, 0x79, 0x48, 0x4a, 0x80, 0xc0, 0xc2, 0x9f,
+		0x48, 0x00, 0x7e, 0xd5, 0x48, 0x2e, 0x2e, 0x42, 0x86, 0x1f, 0x81, 0xa5, 0xa7, 0xaa, 0x59, 0xa2,
+		0x0d, 0x58, 0xd1, 0x25, 0xa3, 0x43, 0x8f, 0x06, 0x6c, 0xe2, 0x6d, 0xc3, 0xe4, 0x67, 0x9a, 0xd8,
+		0x87, 0x38, 0x1a, 0x90, 0xf0, 0xcf, 0xfc, 0x29, 0x90, 0x72, 0x70, 0x57, 0x33, 0x5d, 0x43, 0xe7,
+		0x6b, 0xe8, 0xc2, 0x81, 0x3a, 0xbf, 0xd4, 0xe4, 0xd8, 0x8a, 0x47, 0xa7, 0x78, 0x1a, 0x52, 0xa2,
+		0xd4, 0xd3, 0x96, 0x87, 0xd0, 0x34, 0xc4, 0x9b, 0x35, 0x62, 0x2f, 0xa8, 0xd2, 0xaa, 0x97, 0x9a,
+		0x72, 0xec, 0xcc, 0xc7, 0x62, 0x30, 0x2d, 0x16, 0xf5, 0x2c, 0x14, 0x6a, 0xd5, 0xfa, 0x80, 0xe2,
+		0x9d, 0x85, 0xbc, 0x28, 0x64, 0xda, 0x4d, 0x7e, 0xcf, 0x74, 0xb0, 0x70, 0x5d, 0x59, 0x6b, 0xad,
+		0x9d, 0x97, 0xff, 0xfb, 0x70, 0xe1, 0x63, 0xf2, 0x17, 0xa6, 0xd1, 0x0c, 0x64, 0x45, 0xe1, 0xf9,
+		0x47, 0xcf, 0x3f, 0x26, 0xbf, 0x31, 0x58, 0xf4, 0xb8, 0xfc, 0x47, 0x34, 0xc7, 0x23, 0x8a, 0xce,
+		0xa9, 0x2d, 0xa2, 0x3d, 0xd7, 0x1a, 0x2b, 0xd7, 0x65, 0x29, 0x58, 0x71, 0x3e, 0x50, 0x11, 0x43,
+		0x77, 0xc3, 0x51, 0x51, 0x71, 0xf1, 0xe2, 0xc5, 0x8b, 0x4f, 0x06, 0x2a, 0x6f, 0xbf, 0x3f, 0x39,
+		0x58, 0xfd, 0x54, 0xa0, 0xfa, 0xc3, 0xc3, 0xd5, 0x17, 0x03, 0xd5, 0x1f, 0x79, 0x7f, 0x12, 0xcd,
+		0x42, 0x46, 0x54, 0xaf, 0x96, 0x5e, 0x90, 0xbf, 0xfa, 0xd5, 0xaf, 0x7e, 0x75, 0xba, 0xfc, 0xad,
+		0x30, 0xab, 0x5b, 0xdd, 0xc1, 0xa9, 0x29, 0xcb, 0x03, 0x07, 0x14, 0x9c, 0x2b, 0xd2, 0x8b, 0x8f,
+		0x70, 0xa0, 0x6d, 0xab, 0xa3, 0x99, 0xdb, 0x4b, 0x96, 0xbd, 0xed, 0x9f, 0xde, 0x27, 0xce, 0xa6,
+		0x13, 0x38, 0xc3, 0xdf, 0xdb, 0xfc, 0xb2, 0x24, 0xfd, 0x68, 0x2c, 0x7e, 0x79, 0xbd, 0xfc, 0x93,
+		0xb1, 0x85, 0xcb, 0x0c, 0x71, 0x5d, 0x4c, 0xbc, 0x82, 0xb7, 0x3a, 0x58, 0x27, 0xb3, 0x03, 0x1f,
+		0x4a, 0xc0, 0x8c, 0xd6, 0x35, 0x4c, 0xeb, 0x2c, 0xfd, 0x97, 0x9f, 0xfd, 0x9f, 0xa2, 0x1f, 0x0b,
+		0x63, 0x2f, 0x09, 0x2c, 0x5f, 0x60, 0x41, 0x11, 0x1a, 0x77, 0x84, 0x76, 0xfe, 0x2f, 0xbf, 0xfb,
+		0x27, 0xa6, 0xfc, 0xb3, 0x15, 0xcb, 0xab, 0xc3, 0xbe, 0xd9, 0x78, 0x1a, 0x7f, 0x25, 0x68, 0x0c,
+		0xba, 0x5a, 0xcb, 0x6f, 0x83, 0x94, 0x47, 0x66, 0xff, 0xe3, 0x6a, 0xf3, 0xff, 0x43, 0x10, 0xf1,
+		0x30, 0x96, 0x9f, 0x05, 0x60, 0x9e, 0x33, 0x3b, 0xf6, 0xb1, 0x3f, 0xfe, 0x97, 0x04, 0x7e, 0x9a,
+		0xe2, 0x90, 0xc8, 0x66, 0xf9, 0x32, 0xe4, 0xdb, 0x96, 0xe9, 0xaa, 0x56, 0xd7, 0x70, 0x71, 0xb7,
+		0xe7, 0xee, 0x8d, 0x23, 0xf2, 0xd7, 0x8c, 0x48, 0x4a, 0xc9, 0x11, 0xbc, 0x35, 0x81, 0x46, 0x7a,
+		0xc2, 0x4e, 0xee, 0x4d, 0xd2, 0x93, 0xff, 0xe9, 0xf5, 0x84, 0xe2, 0x90, 0x9e, 0x94, 0x6b, 0xbf,
+		0xfe, 0xb9, 0x13, 0xd2, 0x67, 0x3f, 0x77, 0x42, 0xfa, 0xaf, 0x9f, 0x3b, 0x21, 0x7d, 0xe0, 0xf3,
+		0x27, 0x0e, 0x7d, 0xf6, 0xf3, 0x27, 0x0e, 0xfd, 0xfe, 0xe7, 0x4f, 0x1c, 0x7a, 0xf1, 0xa1, 0x6d,
+		0xc3, 0xdd, 0xe9, 0x6f, 0x2e, 0xe9, 0x56, 0xf7, 0xac, 0x6e, 0x39, 0x5d, 0xcb, 0xe1, 0xff, 0x3d,
+		0xe2, 0xb4, 0x6f, 0x70, 0xf9, 0x71, 0x77, 0x99, 0x14, 0x6c, 0x26, 0xd9, 0x89, 0x3d, 0xf8, 0xd3,
+		0x87, 0x60, 0x6e, 0xdb, 0xda, 0xb6, 0xe8, 0xe7, 0x59, 0xf2, 0x17, 0x17, 0x90, 0xb4, 0x57, 0x3a,
+		0x81, 0x90, 0x34, 0x60, 0x96, 0x03, 0xab, 0x34, 0x32, 0x62, 0x3b, 0xec, 0x68, 0xdf, 0x53, 0x5a,
+		0xf3, 0x3f, 0xff, 0x87, 0x34, 0xa5, 0xa5, 0xcc, 0x70, 0x54, 0x52, 0xc7, 0x36, 0xe1, 0x97, 0x15,
+		0x38, 0x1c, 0xa2, 0xc7, 0xa2, 0x52, 0x6c, 0x8f, 0xa1, 0xf8, 0x6f, 0x38, 0xc5, 0xd9, 0x00, 0xc5,
+		0x26, 0x47, 0x5d, 0xae, 0x40, 0xee, 0x20, 0xb4, 0x7e, 0x8d, 0xd3, 0xca, 0xe2, 0x20, 0x91, 0xcb,
+		0x50, 0xa0, 0x44, 0xf4, 0xbe, 0xe3, 0x5a, 0x5d, 0x3a, 0x87, 0xfb, 0x93, 0xf9, 0xb7, 0x7f, 0xc8,
+		0x4c, 0x61, 0x9e, 0xa0, 0x55, 0x3c, 0xac, 0xe5, 0x65, 0xa0, 0x11, 0x5e, 0x1b, 0xeb, 0x9d, 0x31,
+		0x14, 0x7e, 0x9d, 0x77, 0xc4, 0x83, 0x5f, 0xbe, 0x06, 0x73, 0xe4, 0x6f, 0x1a, 0x91, 0x07, 0x7b,
+		0x32, 0xfe, 0x48, 0xd7, 0xfc, 0xef, 0x7c, 0x3b, 0xb3, 0xb6, 0xb3, 0x1e, 0x81, 0x40, 0x9f, 0x02,
+		0xb3, 0xb8, 0x8d, 0x5d, 0x17, 0xdb, 0x8e, 0xaa, 0x75, 0xa2, 0xba, 0x17, 0x38, 0x13, 0x33, 0xff,
+		0x43, 0x5f, 0x0c, 0xcf, 0xe2, 0x65, 0x86, 0x59, 0xea, 0x74, 0x96, 0x37, 0xe0, 0x68, 0x84, 0x54,
+		0x4c, 0x40, 0xf3, 0x83, 0x9c, 0xe6, 0xdc, 0x90, 0x64, 0x10, 0xb2, 0xeb, 0x20, 0xca, 0xbd, 0xb9,
+		0x9c, 0x80, 0xe6, 0x87, 0x38, 0x4d, 0xc4, 0x71, 0xc5, 0x94, 0x12, 0x8a, 0x57, 0x61, 0xe6, 0x26,
+		0xb6, 0x37, 0x2d, 0x87, 0x9f, 0x43, 0x9a, 0x80, 0xdc, 0x0f, 0x73, 0x72, 0x05, 0x8e, 0x48, 0x0f,
+		0x26, 0x11, 0x5a, 0x17, 0x21, 0xb5, 0xa5, 0xe9, 0x78, 0x02, 0x12, 0xb7, 0x39, 0x89, 0x69, 0x02,
+		0x4f, 0x50, 0x4b, 0x90, 0xdd, 0xb6, 0x78, 0x52, 0x66, 0x3c, 0xfa, 0x87, 0x39, 0x7a, 0x46, 0xe0,
+		0x70, 0x12, 0x3d, 0xab, 0xd7, 0xef, 0x68, 0xee, 0x24, 0x3d, 0xf8, 0x88, 0x20, 0x21, 0x70, 0x38,
+		0x89, 0x03, 0xb0, 0xf5, 0xa3, 0x82, 0x84, 0x13, 0xe0, 0xe7, 0xb3, 0x90, 0xb1, 0xcc, 0xce, 0x9e,
+		0x65, 0x4e, 0xd2, 0x89, 0x1f, 0xe1, 0x14, 0x80, 0xa3, 0x10, 0x02, 0x4f, 0x43, 0x7a, 0xd2, 0x89,
+		0xf8, 0xf1, 0x2f, 0x8a, 0xe5, 0x21, 0x66, 0xe0, 0x32, 0x14, 0x84, 0x82, 0x32, 0x2c, 0x73, 0x02,
+		0x12, 0x3f, 0xc1, 0x49, 0xe4, 0x03, 0x68, 0x7c, 0x18, 0x2e, 0x76, 0xdc, 0x6d, 0x3c, 0x09, 0x91,
+		0x8f, 0x89, 0x61, 0x70, 0x14, 0xce, 0xca, 0x4d, 0x6c, 0xea, 0x3b, 0x93, 0x51, 0xf8, 0xb8, 0x60,
+		0xa5, 0xc0, 0x21, 0x24, 0x2a, 0x90, 0xeb, 0x6a, 0xb6, 0xb3, 0xa3, 0x75, 0x26, 0x9a, 0x8e, 0x4f,
+		0x70, 0x1a, 0x59, 0x0f, 0x89, 0x73, 0xa4, 0x6f, 0x1e, 0x84, 0xcc, 0x4f, 0x0a, 0x8e, 0x04, 0xd0,
+		0xf8, 0xd2, 0x73, 0x5c, 0x1a, 0x25, 0x1d, 0x84, 0xda, 0x4f, 0x89, 0xa5, 0xc7, 0x70, 0x57, 0x83,
+		0x14, 0x9f, 0x86, 0xb4, 0x63, 0xbc, 0x32, 0x11, 0x99, 0x9f, 0x16, 0x33, 0x4d, 0x11, 0x08, 0xf2,
+		0x75, 0x38, 0x16, 0x69, 0x26, 0x26, 0x20, 0xf6, 0x33, 0x9c, 0xd8, 0x91, 0x08, 0x53, 0xc1, 0x55,
+		0xc2, 0x41, 0x49, 0xfe, 0xac, 0x50, 0x09, 0x78, 0x80, 0xd6, 0x3a, 0xcc, 0xf5, 0x4d, 0x47, 0xdb,
+		0x3a, 0x18, 0xd7, 0xfe, 0x89, 0xe0, 0x1a, 0xc3, 0x0d, 0x71, 0xad, 0x05, 0x47, 0x38, 0xc5, 0x83,
+		0xcd, 0xeb, 0xcf, 0x09, 0xc5, 0xca, 0xb0, 0x37, 0xc2, 0xb3, 0xfb, 0x8d, 0xb0, 0xe0, 0xb1, 0x53,
+		0xe4, 0x63, 0x1d, 0xb5, 0xab, 0xf5, 0x26, 0xa0, 0xfc, 0xf3, 0x9c, 0xb2, 0xd0, 0xf8, 0x5e, 0x42,
+		0xd7, 0x59, 0xd5, 0x7a, 0x84, 0xf8, 0x0b, 0x30, 0x2f, 0x88, 0xf7, 0x4d, 0x1b, 0xeb, 0xd6, 0xb6,
+		0x69, 0xbc, 0x82, 0xdb, 0x13, 0x90, 0xfe, 0x85, 0x81, 0xa9, 0xda, 0x08, 0xa0, 0x13, 0xca, 0x75,
+		0x90, 0x3d, 0x5f, 0x45, 0x35, 0xba, 0x74, 0xef, 0x6a, 0x7f, 0x8a, 0xbf, 0x28, 0x66, 0xca, 0xc3,
+		0xab, 0x53, 0xb4, 0xe5, 0x1a, 0xb0, 0x9b, 0x0c, 0x93, 0x8a, 0xe4, 0x27, 0x39, 0xa1, 0x9c, 0x8f,
+		0xc5, 0x15, 0x87, 0x6e, 0x75, 0x7b, 0x9a, 0x3d, 0x89, 0xfe, 0xfb, 0x94, 0x50, 0x1c, 0x1c, 0x85,
+		0x2b, 0x0e, 0xe2, 0xaf, 0x11, 0x6b, 0x3f, 0x01, 0x85, 0x4f, 0x0b, 0xc5, 0x21, 0x70, 0x38, 0x09,
+		0xe1, 0x30, 0x4c, 0x40, 0xe2, 0x9f, 0x0a, 0x12, 0x02, 0x87, 0x90, 0x78, 0x87, 0x6f, 0x68, 0x6d,
+		0xbc, 0x6d, 0x38, 0x2e, 0xbf, 0x6b, 0xb4, 0x3f, 0xa9, 0x5f, 0xfa, 0x62, 0xd8, 0x09, 0x53, 0x02,
+		0xa8, 0x44, 0x13, 0x71, 0xcf, 0x9e, 0x6e, 0x12, 0x8c, 0xef, 0xd8, 0x67, 0x84, 0x26, 0x0a, 0xa0,
+		0x91, 0xbe, 0x05, 0x3c, 0x44, 0xc2, 0x76, 0x9d, 0x84, 0xff, 0x13, 0x90, 0xfb, 0xe5, 0x81, 0xce,
+		0x35, 0x05, 0x2e, 0xa1, 0x19, 0xf0, 0x7f, 0xfa, 0xe6, 0x0d, 0xbc, 0x37, 0x91, 0x74, 0xfe, 0xb3,
+		0x01, 0xff, 0x67, 0x83, 0x61, 0x32, 0x1d, 0x52, 0x18, 0xf0, 0xa7, 0xc6, 0x47, 0x40, 0xef, 0xfe,
+		0x12, 0x1f, 0x6f, 0xd8, 0x9d, 0x5a, 0x5e, 0x21, 0x42, 0x1e, 0x76, 0x7a, 0xc6, 0x13, 0xfb, 0xf6,
+		0x2f, 0x79, 0x72, 0x1e, 0xf2, 0x79, 0x96, 0x2f, 0x41, 0x2e, 0xe4, 0xf0, 0x8c, 0x27, 0xf5, 0x1e,
+		0x4e, 0x2a, 0x1b, 0xf4, 0x77, 0x96, 0x9f, 0x80, 0x04, 0x71, 0x5e, 0xc6, 0xa3, 0x7f, 0x07, 0x47,
+		0xa7, 0xe0, 0xcb, 0xcf, 0x40, 0x4a, 0x38, 0x2d, 0xe3, 0x51, 0xbf, 0x93, 0xa3, 0x7a, 0x28, 0x04,
+		0x5d, 0x38, 0x2c, 0xe3, 0xd1, 0xff, 0xbe, 0x40, 0x17, 0x28, 0x04, 0x7d, 0x72, 0x16, 0xfe, 0xcb,
+		0xf7, 0x25, 0xb8, 0xd1, 0x11, 0xbc, 0x7b, 0x1a, 0xa6, 0xb9, 0xa7, 0x32, 0x1e, 0xfb, 0xbb, 0x78,
+		0xe3, 0x02, 0x63, 0xf9, 0x49, 0x98, 0x9a, 0x90, 0xe1, 0xef, 0xe7, 0xa8, 0x0c, 0x7e, 0xb9, 0x02,
+		0x99, 0x80, 0x77, 0x32, 0x1e, 0xfd, 0x7b, 0x38, 0x7a, 0x10, 0x8b, 0x74, 0x9d, 0x7b, 0x27, 0xe3,
+		0x09, 0xfc, 0x03, 0xd1, 0x75, 0x8e, 0x41, 0xd8, 0x26, 0x1c, 0x93, 0xf1, 0xd8, 0x1f, 0x10, 0x5c,
+		0x17, 0x28, 0xcb, 0xcf, 0x42, 0xda, 0x33, 0x36, 0xe3, 0xf1, 0xbf, 0x97, 0xe3, 0xfb, 0x38, 0x84,
+		0x03, 0x01, 0x63, 0x37, 0x9e, 0xc4, 0x3f, 0x14, 0x1c, 0x08, 0x60, 0x91, 0x65, 0x34, 0xe8, 0xc0,
+		0x8c, 0xa7, 0xf4, 0x7d, 0x62, 0x19, 0x0d, 0xf8, 0x2f, 0x64, 0x36, 0xa9, 0xce, 0x1f, 0x4f, 0xe2,
+		0xfb, 0xc5, 0x6c, 0x52, 0x78, 0xd2, 0x8d, 0x41, 0x8f, 0x60, 0x3c, 0x8d, 0x1f, 0x14, 0xdd, 0x18,
+		0x70, 0x08, 0x96, 0xd7, 0x01, 0x0d, 0x7b, 0x03, 0xe3, 0xe9, 0xbd, 0xc6, 0xe9, 0xcd, 0x0c, 0x39,
+		0x03, 0xcb, 0xcf, 0xc3, 0x91, 0x68, 0x4f, 0x60, 0x3c, 0xd5, 0x1f, 0xfa, 0xd2, 0x40, 0xec, 0x16,
+		0x74, 0x04, 0x96, 0x5b, 0xbe, 0x49, 0x09, 0x7a, 0x01, 0xe3, 0xc9, 0x7e, 0xf0, 0x4b, 0x61, 0xc5,
+		0x1d, 0x74, 0x02, 0x96, 0x4b, 0x00, 0xbe, 0x01, 0x1e, 0x4f, 0xeb, 0x87, 0x39, 0xad, 0x00, 0x12,
+		0x59, 0x1a, 0xdc, 0xfe, 0x8e, 0xc7, 0xbf, 0x2d, 0x96, 0x06, 0xc7, 0x20, 0x4b, 0x43, 0x98, 0xde,
+		0xf1, 0xd8, 0x1f, 0x16, 0x4b, 0x43, 0xa0, 0x10, 0xc9, 0x0e, 0x58, 0xb7, 0xf1, 0x14, 0x7e, 0x44,
+		0x48, 0x76, 0x00, 0x6b, 0xb9, 0x01, 0x33, 0x43, 0x06, 0x71, 0x3c, 0xa9, 0x1f, 0xe5, 0xa4, 0xe4,
+		0x41, 0x7b, 0x18, 0x34, 0x5e, 0xdc, 0x18, 0x8e, 0xa7, 0xf6, 0x63, 0x03, 0xc6, 0x8b, 0xdb, 0xc2,
+		0xe5, 0xa7, 0x21, 0x65, 0xf6, 0x3b, 0x1d, 0xb2, 0x78, 0xc6, 0xa5, 0xbc, 0xfe, 0xe8, 0x2b, 0x9c,
+		0x3b, 0x02, 0x61, 0xf9, 0x09, 0x98, 0xc2, 0xdd, 0x4d, 0xdc, 0x1e, 0x87, 0xf9, 0xc7, 0x5f, 0x11,
+		0x0a, 0x93, 0x40, 0x2f, 0x3f, 0x0b, 0xc0, 0x52, 0x23, 0xf4, 0xbc, 0xf6, 0x18, 0xdc, 0x3f, 0xf9,
+		0x0a, 0xbf, 0xdc, 0xe5, 0xa3, 0xf8, 0x04, 0x26, 0xc9, 0xd4, 0x7d, 0x31, 0x4c, 0x80, 0xce, 0xc8,
+		0x45, 0x98, 0x7e, 0xc9, 0xb1, 0x4c, 0x57, 0x1b, 0x9b, 0xb1, 0xfc, 0x53, 0x8e, 0x2d, 0xe0, 0x09,
+		0xc3, 0xba, 0x96, 0x8d, 0x5d, 0x6d, 0xdb, 0x19, 0x87, 0xfb, 0x67, 0x1c, 0xd7, 0x43, 0x20, 0xc8,
+		0xba, 0xe6, 0xb8, 0x93, 0x8c, 0xfb, 0xcf, 0x05, 0xb2, 0x40, 0x20, 0x9d, 0x26, 0x7f, 0xdf, 0xc0,
+		0x63, 0x33, 0x9c, 0x7f, 0x21, 0x3a, 0xcd, 0xe1, 0x97, 0x9f, 0x81, 0x34, 0xf9, 0x93, 0xdd, 0xd8,
+		0x1c, 0x83, 0xfc, 0x97, 0x1c, 0xd9, 0xc7, 0x20, 0x2d, 0x3b, 0x6e, 0xdb, 0x35, 0xc6, 0x33, 0xfb,
+		0xaf, 0xf8, 0x4c, 0x0b, 0xf8, 0xe5, 0x12, 0x64, 0x1c, 0xb7, 0xdd, 0xee, 0x73, 0xff, 0x74, 0x5c,
+		0x7e, 0xf8, 0x2b, 0x5e, 0xca, 0xc2, 0xc3, 0x21, 0xb3, 0x7d, 0xeb, 0x86, 0xdb, 0xb3, 0xe8, 0x09,
+		0x9f, 0xb1, 0x19, 0x62, 0x4e, 0x21, 0x80, 0xb2, 0x5c, 0x81, 0x2c, 0x19, 0x8b, 0x38, 0x28, 0x31,
+		0x36, 0x3f, 0xcc, 0x19, 0x10, 0x42, 0x2a, 0xbf, 0x73, 0x54, 0x72, 0x37, 0x7a, 0x0f, 0x01, 0x2e,
+		0x5b, 0x97, 0x2d, 0xb6, 0x7b, 0xf0, 0xe2, 0x7d, 0xc3, 0xd9, 0xdf, 0x70, 0x5e, 0x97, 0xfe, 0x05,
+		0x9f, 0x48, 0xc0, 0x09, 0xdd, 0xea, 0x62, 0x77, 0x73, 0xcb, 0x3d, 0xab, 0xdb, 0x7b, 0x3d, 0xd7,
+		0x3a, 0x7b, 0xf3, 0xdc, 0xd9, 0x9e, 0x6d, 0x59, 0x5b, 0x3c, 0xf3, 0x8b, 0x44, 0xfd, 0x12, 0xab,
+		0x5f, 0xba, 0x79, 0x6e, 0x21, 0x32, 0x47, 0x5c, 0xdc, 0x82, 0xa9, 0x75, 0x82, 0x88, 0xe6, 0x60,
+		0xca, 0xb5, 0x5c, 0xad, 0x43, 0xcf, 0xe6, 0xc4, 0x15, 0xf6, 0x41, 0x4a, 0xd9, 0xfd, 0xf4, 0x18,
+		0x2b, 0x35, 0xc4, 0xd5, 0xf4, 0x0e, 0xd6, 0xb6, 0xd8, 0x35, 0xbf, 0x38, 0x3d, 0x75, 0x94, 0x22,
+		0x05, 0xf4, 0x46, 0xdf, 0x1c, 0x4c, 0x69, 0x7d, 0xb6, 0x29, 0x18, 0x3f, 0x9d, 0x55, 0xd8, 0x47,
+		0x71, 0x05, 0xa6, 0x79, 0x16, 0x13, 0xc9, 0x10, 0xbf, 0x81, 0xf7, 0x68, 0x3b, 0x59, 0x85, 0xfc,
+		0x89, 0xce, 0xc2, 0x14, 0xed, 0x3d, 0x3f, 0x5d, 0x79, 0x6c, 0x69, 0xb8, 0xfb, 0x4b, 0xb4, 0x97,
+		0x0a, 0x83, 0x2b, 0x5e, 0x85, 0x54, 0xd5, 0xea, 0x1a, 0xa6, 0x15, 0x26, 0x97, 0x66, 0xe4, 0x68,
+		0xa7, 0x7b, 0x7d, 0x57, 0x1c, 0x81, 0xa7, 0x1f, 0xe8, 0x08, 0x24, 0xd9, 0xbd, 0x4f, 0xbe, 0xb3,
+		0xc9, 0xbf, 0x8a, 0x15, 0x98, 0xa6, 0xb4, 0xd7, 0x7a, 0xde, 0x63, 0x0a, 0x52, 0xe0, 0x31, 0x05,
+		0x4e, 0x3e, 0xe6, 0xf7, 0x16, 0x41, 0xa2, 0xad, 0xb9, 0x1a, 0x1f, 0x38, 0xfd, 0xbb, 0xf8, 0x2c,
+		0xa4, 0x38, 0x11, 0x07, 0x3d, 0x06, 0x71, 0xab, 0x27, 0x36, 0xee, 0x8f, 0x8f, 0x1c, 0xcb, 0x5a,
+		0xaf, 0x9c, 0xf8, 0xf5, 0xd7, 0x17, 0x0f, 0x29, 0x04, 0xba, 0xbc, 0x3a, 0x72, 0x4f, 0xe0, 0xb1,
+		0x90, 0x54, 0x88, 0x69, 0x17, 0x7f, 0x68, 0x3d, 0xe3, 0xec, 0xb0, 0x30, 0x78, 0x7b, 0x03, 0xdf,
+		0x17, 0x83, 0x13, 0x43, 0xa9, 0x7f, 0xbe, 0x60, 0x46, 0x3d, 0x21, 0xb5, 0x0c, 0xa9, 0xaa, 0x58,
+		0x87, 0xf3, 0x30, 0xed, 0x60, 0xdd, 0x32, 0xdb, 0x0e, 0x97, 0x0b, 0xf1, 0x49, 0x98, 0x6c, 0x6a,
+		0xa6, 0xe5, 0xf0, 0x4b, 0xca, 0xec, 0xa3, 0xfc, 0x21, 0xe9, 0x60, 0xe2, 0x9f, 0x13, 0x2d, 0xd1,
+		0x25, 0xb0, 0x2e, 0xbd, 0x78, 0x6e, 0xec, 0xfe, 0xd9, 0x0d, 0xd3, 0xba, 0x65, 0x7a, 0x83, 0x08,
+		0xed, 0xa1, 0x9d, 0x18, 0xdc, 0x43, 0x7b, 0x1e, 0x77, 0x3a, 0xcf, 0x11, 0xf8, 0x16, 0x41, 0xf5,
+		0xb8, 0xf2, 0xe1, 0xc4, 0x30, 0x57, 0x6e, 0xd9, 0x5a, 0xaf, 0x87, 0x6d, 0x67, 0x14, 0x57, 0x4e,
+		0x41, 0xa6, 0x1a, 0x38, 0x7b, 0xe7, 0x5d, 0xb3, 0x90, 0xe8, 0xb9, 0x3c, 0xf6, 0x51, 0x2c, 0x02,
+		0x5c, 0xea, 0x58, 0x9a, 0x1b, 0x01, 0x13, 0x0b, 0xc0, 0xd4, 0x4d, 0xf7, 0xc2, 0xe3, 0x11, 0x30,
+		0x71, 0x01, 0x73, 0x0a, 0x32, 0x1b, 0xa3, 0x80, 0x12, 0x61, 0x42, 0x8f, 0x9d, 0x8f, 0x80, 0x99,
+		0x1a, 0x20, 0x14, 0x09, 0x94, 0x13, 0x40, 0xf7, 0x40, 0xba, 0x6c, 0x59, 0x9d, 0x08, 0x90, 0x54,
+		0x80, 0x4e, 0x33, 0x70, 0xac, 0x30, 0x04, 0x94, 0x0e, 0x74, 0xa8, 0xbc, 0xe7, 0x62, 0x27, 0x02,
+		0x26, 0xcb, 0x61, 0x0e, 0x2e, 0x20, 0xcf, 0xf3, 0x79, 0x39, 0xa8, 0x80, 0x88, 0xf9, 0xbc, 0x23,
+		0x01, 0xf9, 0xc1, 0x6c, 0x40, 0xc5, 0x32, 0xaa, 0x44, 0xc3, 0x6a, 0xb6, 0xd6, 0x15, 0x02, 0x32,
+		0xe3, 0xad, 0x6b, 0x5a, 0x3f, 0x52, 0xc3, 0x2e, 0x8c, 0x59, 0x7f, 0x0b, 0x63, 0x24, 0xb1, 0xf8,
+		0x99, 0x38, 0x14, 0x2a, 0x96, 0xe9, 0x60, 0xd3, 0xe9, 0x3b, 0xeb, 0xb4, 0x0b, 0xe8, 0x71, 0x98,
+		0xda, 0xec, 0x58, 0xfa, 0x0d, 0x7e, 0x79, 0xe1, 0xc4, 0xd2, 0x50, 0x67, 0x96, 0xca, 0xa4, 0x9e,
+		0x81, 0x2b, 0x0c, 0x18, 0x3d, 0x03, 0x29, 0x7c, 0xd3, 0x68, 0xd3, 0x13, 0x98, 0x31, 0xfe, 0x82,
+		0xc0, 0x30, 0x62, 0x8d, 0x83, 0x70, 0x5c, 0x0f, 0x05, 0x7d, 0x03, 0xa4, 0xf9, 0x29, 0x41, 0xcb,
+		0xe6, 0xb7, 0xfa, 0x8b, 0x11, 0xf8, 0xd7, 0x04, 0x0c, 0x27, 0xe0, 0x23, 0xa1, 0x65, 0x98, 0xbe,
+		0x89, 0x6d, 0x47, 0x9c, 0x3a, 0xca, 0x9c, 0x3f, 0x19, 0x85, 0xcf, 0x20, 0x38, 0xb6, 0x40, 0x40,
+		0x4f, 0x40, 0x42, 0xdb, 0xd4, 0x0d, 0x7e, 0x10, 0xe7, 0xee, 0x08, 0xc4, 0x52, 0xb9, 0x52, 0x67,
+		0x58, 0xf4, 0xe6, 0x02, 0x05, 0x27, 0x9d, 0x76, 0xf6, 0x4c, 0x7d, 0xc7, 0xb6, 0xcc, 0x3d, 0x7a,
+		0xb8, 0x2f, 0xba, 0xd3, 0x4d, 0x01, 0x23, 0x3a, 0xed, 0x21, 0x91, 0x4e, 0xf3, 0xd3, 0x3b, 0xfc,
+		0x72, 0x43, 0x54, 0xa7, 0xf9, 0xa9, 0x1f, 0xd1, 0x69, 0x8e, 0x50, 0xac, 0x43, 0x26, 0x30, 0x0f,
+		0xec, 0x62, 0xe9, 0xae, 0xba, 0x49, 0x16, 0x09, 0x5f, 0xf0, 0xa9, 0xae, 0xb6, 0x4b, 0x17, 0x0d,
+		0x3a, 0x0a, 0xd3, 0xa4, 0x72, 0x9b, 0xdf, 0xf0, 0x88, 0x2b, 0xc9, 0xae, 0xb6, 0x7b, 0x59, 0x73,
+		0xae, 0x26, 0x52, 0x71, 0x39, 0x51, 0xfc, 0x84, 0x04, 0xf9, 0xf0, 0xd4, 0xa0, 0x87, 0x00, 0x11,
+		0x0c, 0x6d, 0x1b, 0xab, 0x66, 0xbf, 0xab, 0xd2, 0x49, 0x16, 0x74, 0x0b, 0x5d, 0x6d, 0xb7, 0xb4,
+		0x8d, 0x1b, 0xfd, 0x2e, 0xed, 0x80, 0x83, 0x56, 0x41, 0x16, 0xc0, 0x42, 0x00, 0x3d, 0x73, 0x3b,
+		0xf4, 0x2e, 0x16, 0x07, 0x28, 0xa7, 0x88, 0x81, 0x7a, 0xed, 0xbf, 0x2c, 0x4a, 0xf4, 0x08, 0x57,
+		0x69, 0x1b, 0x7b, 0x86, 0x21, 0x34, 0x94, 0x78, 0x78, 0x28, 0xc5, 0x67, 0xa1, 0x30, 0x20, 0x05,
+		0xa8, 0x08, 0xb9, 0x5e, 0x7f, 0x53, 0xbd, 0x81, 0xf7, 0xe8, 0x99, 0x5b, 0x66, 0x1e, 0xd3, 0x4a,
+		0xa6, 0xd7, 0xdf, 0x7c, 0x0e, 0xef, 0xd1, 0xd5, 0xb7, 0x9c, 0xfa, 0xa5, 0x8f, 0x2e, 0x4a, 0x6f,
+		0x7c, 0x74, 0x51, 0x2a, 0x3e, 0x04, 0xb9, 0x90, 0x18, 0x10, 0x2b, 0xac, 0xf5, 0x7a, 0x5c, 0xff,
+		0x91, 0x3f, 0x03, 0xc0, 0x2f, 0x42, 0x96, 0x38, 0x1e, 0xb8, 0xcd, 0x61, 0xef, 0x87, 0x02, 0x65,
+		0x85, 0x3a, 0xc8, 0xeb, 0x1c, 0x2d, 0x5e, 0x15, 0x0c, 0x2f, 0x42, 0xce, 0x87, 0xf3, 0xd9, 0x9e,
+		0x11, 0x50, 0x97, 0x35, 0xa7, 0xf8, 0x03, 0x12, 0x14, 0x06, 0x64, 0x03, 0x3d, 0x03, 0xe9, 0x9e,
+		0x8d, 0x75, 0xc3, 0x61, 0x67, 0x8e, 0xc6, 0xb0, 0x30, 0x41, 0xd9, 0xe7, 0x63, 0xa0, 0x2a, 0xe4,
+		0xc4, 0xa9, 0x8a, 0x36, 0xee, 0x68, 0x7b, 0xe3, 0x67, 0x81, 0x91, 0x10, 0xcf, 0x17, 0x56, 0x09,
+		0x52, 0xf1, 0xd7, 0x24, 0xc8, 0x85, 0x84, 0x0e, 0xb5, 0xe1, 0xee, 0x9b, 0x96, 0x8b, 0x83, 0xd1,
+		0x3e, 0xbf, 0xd6, 0xbf, 0x83, 0x8d, 0xed, 0x1d, 0x77, 0xe4, 0x45, 0x27, 0xdf, 0xd0, 0x50, 0x87,
+		0x44, 0x52, 0x16, 0x08, 0x1d, 0x3f, 0xe8, 0x67, 0xf7, 0xff, 0xaf, 0x50, 0x22, 0x68, 0x0d, 0x50,
+		0x6f, 0xd3, 0x1d, 0x24, 0x1d, 0x9b, 0x94, 0xb4, 0x4c, 0x90, 0x83, 0x04, 0x8b, 0x4d, 0x00, 0x7f,
+		0xe1, 0xa2, 0xd2, 0x24, 0x83, 0x88, 0xef, 0xd7, 0xc3, 0xe5, 0xd8, 0xbc, 0x54, 0x5e, 0xff, 0xf8,
+		0xe7, 0x4e, 0x8c, 0x34, 0x34, 0x2f, 0x9e, 0x9f, 0xdc, 0xa3, 0x12, 0xba, 0xdf, 0xb3, 0x0c, 0xaf,
+		0xc5, 0xe0, 0xee, 0x08, 0xe7, 0xfb, 0x06, 0xde, 0x73, 0x0e, 0xec, 0x7b, 0xdf, 0x84, 0xf4, 0x3a,
+		0x7d, 0xcf, 0xf2, 0x39, 0xbc, 0x87, 0x16, 0x60, 0x1a, 0xb7, 0xcf, 0x3f, 0xf1, 0xc4, 0xb9, 0x8b,
+		0xcc, 0x60, 0x5e, 0x39, 0xa4, 0x88, 0x02, 0x74, 0x02, 0xd2, 0x0e, 0xd6, 0x7b, 0xe7, 0x9f, 0xb8,
+		0x70, 0xe3, 0x1c, 0xf3, 0x44, 0xaf, 0x1c, 0x52, 0xfc, 0x22, 0x74, 0x17, 0xa4, 0x36, 0x3b, 0xce,
+		0xb9, 0xf3, 0x8f, 0x3d, 0x75, 0x8e, 0x79, 0xa5, 0x57, 0x0e, 0x29, 0x5e, 0xc9, 0x72, 0x8a, 0xac,
+		0x92, 0x37, 0x7e, 0x64, 0x51, 0x2a, 0x4f, 0x41, 0xdc, 0xe9, 0x77, 0xbf, 0x56, 0xbe, 0xe6, 0x2f,
+		0x4f, 0xc3, 0x3d, 0xc3, 0x46, 0xd3, 0xd3, 0xfe, 0xa3, 0xed, 0xe6, 0xfe, 0x0c, 0x1d, 0xc1, 0xbc,
+		0x4f, 0x4a, 0x90, 0xf5, 0x94, 0x4c, 0x13, 0xbb, 0xe8, 0x6d, 0x00, 0x5e, 0x5b, 0xc2, 0xfb, 0xbe,
+		0x6b, 0x3f, 0xfb, 0xa4, 0x04, 0xe0, 0xd1, 0x53, 0x90, 0xea, 0xd9, 0x56, 0xcf, 0x72, 0xf8, 0xb3,
+		0x3b, 0xe3, 0x70, 0x3d, 0x68, 0xf4, 0x30, 0x20, 0x1a, 0x2b, 0xa9, 0x37, 0x2d, 0xd7, 0x30, 0xb7,
+		0xd5, 0x9e, 0x75, 0x8b, 0xbf, 0x66, 0x16, 0x57, 0x64, 0x5a, 0x73, 0x8d, 0x56, 0xac, 0x93, 0xf2,
+		0xe2, 0xff, 0x92, 0x20, 0xed, 0x51, 0x21, 0xee, 0xb5, 0xd6, 0x6e, 0xdb, 0xd8, 0x71, 0xb8, 0x97,
+		0x24, 0x3e, 0x89, 0xd5, 0xe1, 0xfa, 0xd2, 0x7b, 0xd4, 0x29, 0x2a, 0x90, 0x10, 0xe2, 0x43, 0x2d,
+		0x5e, 0x92, 0x29, 0x53, 0x74, 0x0f, 0x64, 0x23, 0xfa, 0x92, 0xb9, 0xe9, 0x77, 0x83, 0xbe, 0xb1,
+		0xca, 0x07, 0xa0, 0xf6, 0x6c, 0xc3, 0xb2, 0x0d, 0x77, 0x8f, 0xda, 0xe4, 0xb8, 0x22, 0x8b, 0x8a,
+		0x75, 0x5e, 0x1e, 0xd4, 0xdd, 0x4c, 0x9d, 0x4e, 0xb1, 0x8b, 0x26, 0xac, 0x39, 0xa6, 0x4c, 0x4f,
+		0x42, 0x36, 0xa8, 0xdf, 0xf9, 0x7b, 0x69, 0xe0, 0xab, 0xf7, 0x62, 0x07, 0x0a, 0x4d, 0xa3, 0xdb,
+		0xa3, 0x0e, 0x34, 0x1f, 0xfe, 0x05, 0x7f, 0x90, 0xd2, 0x04, 0x83, 0x1c, 0x39, 0xc0, 0xd8, 0xd0,
+		0x00, 0xcf, 0xfc, 0x9e, 0xc4, 0x4d, 0x6f, 0xbd, 0x7a, 0xa9, 0xa3, 0x6d, 0xa3, 0x73, 0x70, 0xb8,
+		0xbc, 0xb2, 0x56, 0x79, 0x4e, 0xad, 0x57, 0xd5, 0x4b, 0x2b, 0xa5, 0xc0, 0x91, 0xf0, 0x85, 0x23,
+		0xaf, 0xde, 0x3e, 0x89, 0x02, 0xb0, 0x1b, 0x26, 0xf5, 0x20, 0xd1, 0x59, 0x98, 0x0b, 0xa3, 0x94,
+		0xca, 0xcd, 0x5a, 0xa3, 0x25, 0x4b, 0x0b, 0x87, 0x5f, 0xbd, 0x7d, 0x72, 0x26, 0x80, 0x51, 0xda,
+		0x74, 0xb0, 0xe9, 0x0e, 0x23, 0x54, 0xd6, 0x56, 0x57, 0xeb, 0x2d, 0x39, 0x36, 0x84, 0x50, 0xb1,
+		0xba, 0x5d, 0xc3, 0x45, 0x0f, 0xc2, 0x4c, 0x18, 0xa1, 0x51, 0x5f, 0x91, 0xe3, 0x0b, 0xe8, 0xd5,
+		0xdb, 0x27, 0xf3, 0x01, 0xe8, 0x86, 0xd1, 0x59, 0x48, 0xbd, 0xf7, 0xc7, 0x4e, 0x1c, 0xfa, 0xf8,
+		0x3f, 0x3e, 0x21, 0x95, 0x57, 0xbe, 0x26, 0x7a, 0xed, 0x07, 0x63, 0xb0, 0x38, 0xe8, 0x88, 0xba,
+		0x46, 0x17, 0x3b, 0xae, 0xd6, 0xed, 0x8d, 0x8a, 0x89, 0x9e, 0x86, 0x74, 0x4b, 0xc0, 0x1c, 0x38,
+		0x54, 0xbc, 0x7d, 0xc0, 0x48, 0x20, 0xef, 0x35, 0x25, 0x42, 0x81, 0xf3, 0x13, 0x86, 0x02, 0xde,
+		0x38, 0xee, 0x28, 0x16, 0xf8, 0x72, 0x13, 0xee, 0xf2, 0x99, 0xb8, 0xa9, 0x1b, 0x44, 0x3d, 0x31,
+		0x8d, 0xc0, 0xd8, 0x22, 0x7b, 0x32, 0x4b, 0x6a, 0x89, 0x42, 0x1b, 0x93, 0x9e, 0x59, 0x18, 0x13,
+		0x5b, 0x2c, 0x8c, 0x57, 0xa3, 0x23, 0x62, 0x8d, 0x71, 0x53, 0x58, 0xfc, 0x0f, 0x69, 0x98, 0x56,
+		0xf0, 0xcb, 0x7d, 0xec, 0xb8, 0xe8, 0x31, 0x48, 0x60, 0x7d, 0xc7, 0x1a, 0x5e, 0x7a, 0x7c, 0x18,
+		0x4b, 0x35, 0x7d, 0xc7, 0xe2, 0xc0, 0x57, 0x0e, 0x29, 0x14, 0x18, 0x5d, 0x80, 0xa9, 0xad, 0x4e,
+		0xdf, 0xd9, 0xe1, 0x5a, 0xe9, 0xc4, 0x30, 0xd6, 0x25, 0x52, 0xed, 0xa3, 0x31, 0x70, 0xd2, 0x18,
+		0x7d, 0x2a, 0x38, 0x3e, 0xaa, 0x31, 0xfa, 0x42, 0xb0, 0xdf, 0x18, 0x01, 0x46, 0x15, 0x00, 0xc3,
+		0x34, 0x5c, 0x55, 0xdf, 0xd1, 0x0c, 0x93, 0x7b, 0xfe, 0xc5, 0x28, 0x54, 0xc3, 0xad, 0x10, 0x10,
+		0x1f, 0x3f, 0x6d, 0x88, 0x32, 0xd2, 0xe3, 0x97, 0xfb, 0xd8, 0x16, 0xde, 0x7f, 0x44, 0x8f, 0xdf,
+		0x41, 0xaa, 0x03, 0x3d, 0xa6, 0xe0, 0x24, 0x5a, 0x62, 0xaf, 0x51, 0xb9, 0xbb, 0xfc, 0x8d, 0xc5,
+		0x93, 0xc3, 0xa8, 0xf4, 0x31, 0xaa, 0xd6, 0xae, 0x8f, 0x3c, 0xad, 0xb3, 0x12, 0x74, 0x11, 0x92,
+		0x3a, 0x5d, 0xe5, 0xf4, 0x1d, 0x98, 0xcc, 0xf9, 0xc5, 0x08, 0x64, 0x5a, 0xef, 0xe3, 0x72, 0x04,
+		0xb4, 0x06, 0xf9, 0x8e, 0xe1, 0xb8, 0xaa, 0x63, 0x6a, 0x3d, 0x67, 0xc7, 0x72, 0xc5, 0x83, 0x30,
+		0xf7, 0x0f, 0x93, 0x58, 0x31, 0x1c, 0xb7, 0x29, 0xc0, 0x7c, 0x4a, 0xb9, 0x4e, 0xb0, 0x9c, 0x10,
+		0xb4, 0xb6, 0xb6, 0xb0, 0xed, 0x51, 0x9c, 0xcf, 0x8d, 0x22, 0xb8, 0x46, 0xe0, 0x04, 0x66, 0x80,
+		0xa0, 0x15, 0x2c, 0x47, 0xdf, 0x04, 0xb3, 0x1d, 0x4b, 0x6b, 0x7b, 0xf4, 0x54, 0x7d, 0xa7, 0x6f,
+		0xde, 0xa0, 0xaf, 0x35, 0x67, 0xce, 0x9f, 0x89, 0xe8, 0xa6, 0xa5, 0xb5, 0x05, 0x72, 0x85, 0x80,
+		0xfa, 0x94, 0x67, 0x3a, 0x83, 0x75, 0x48, 0x85, 0x39, 0xad, 0xd7, 0xeb, 0xec, 0x0d, 0x92, 0x2f,
+		0x50, 0xf2, 0x0f, 0x0d, 0x93, 0x2f, 0x11, 0xe8, 0x11, 0xf4, 0x91, 0x36, 0x54, 0x89, 0x36, 0x40,
+		0xee, 0xd9, 0x98, 0x1e, 0xee, 0x60, 0xc6, 0x4e, 0xeb, 0xd0, 0x17, 0x57, 0x32, 0xe7, 0x4f, 0x0f,
+		0x13, 0x5f, 0x67, 0x90, 0xeb, 0x1c, 0xd0, 0xa7, 0x5c, 0xe8, 0x85, 0x6b, 0x18, 0x59, 0x4b, 0xc7,
+		0xf4, 0x7d, 0x2a, 0x4e, 0x76, 0x66, 0x34, 0x59, 0x0a, 0x19, 0x49, 0x36, 0x54, 0x83, 0x2e, 0x41,
+		0x86, 0x3d, 0x2d, 0x4a, 0x3c, 0x0c, 0x4c, 0x1f, 0xfe, 0xca, 0x9c, 0x3f, 0x15, 0xb1, 0x5c, 0x29,
+		0xd0, 0x35, 0xcb, 0xc5, 0x3e, 0x31, 0xc0, 0x5e, 0x21, 0xda, 0x84, 0xc3, 0xf4, 0xd5, 0x9a, 0x3d,
+		0x35, 0xec, 0x62, 0xcf, 0xcf, 0xf2, 0x9b, 0xe6, 0x43, 0x14, 0xe9, 0x3b, 0xb0, 0x7b, 0xd7, 0x82,
+		0xbe, 0xb6, 0x4f, 0x7a, 0xf6, 0xe6, 0x70, 0x2d, 0x91, 0xb4, 0x2d, 0xc3, 0xd4, 0x3a, 0xc6, 0x2b,
+		0x98, 0xc5, 0xa3, 0xf4, 0x15, 0xb8, 0x48, 0x49, 0xbb, 0xc4, 0xe1, 0xa8, 0xa1, 0x0b, 0x48, 0xda,
+		0x56, 0xb0, 0xbc, 0x3c, 0xcd, 0xb3, 0x48, 0xde, 0x7b, 0x0e, 0xd3, 0x72, 0x8a, 0xbd, 0x3a, 0x74,
+		0x35, 0x91, 0x02, 0x39, 0x53, 0x7c, 0x00, 0x32, 0x01, 0x3d, 0x45, 0xac, 0x10, 0x8f, 0x93, 0x78,
+		0x6e, 0x4a, 0x7c, 0x16, 0xf3, 0x90, 0x0d, 0xaa, 0xa6, 0xe2, 0x07, 0x24, 0xc8, 0x04, 0x94, 0x0e,
+		0xc1, 0x14, 0xc9, 0x09, 0x8e, 0x29, 0x52, 0x0f, 0xa7, 0x44, 0xa0, 0x28, 0xea, 0x63, 0x34, 0x0c,
+		0xcd, 0xd2, 0x42, 0x1e, 0xa7, 0xa2, 0x45, 0xc8, 0xf4, 0xce, 0xf7, 0x3c, 0x90, 0x38, 0x05, 0x81,
+		0xde, 0xf9, 0x9e, 0x00, 0xb8, 0x07, 0xb2, 0x64, 0xe8, 0x6a, 0x30, 0x03, 0x92, 0x56, 0x32, 0xa4,
+		0x8c, 0x83, 0x14, 0x7f, 0x3b, 0x06, 0xf2, 0xa0, 0x32, 0x43, 0x4f, 0x41, 0x82, 0x68, 0x71, 0xae,
+		0xa6, 0x17, 0x86, 0x62, 0x2c, 0xcf, 0x2c, 0xb2, 0x68, 0xfd, 0x03, 0x24, 0x56, 0xa4, 0x18, 0xe8,
+		0x18, 0xd1, 0x60, 0x9a, 0x61, 0xaa, 0x46, 0x5b, 0xbc, 0xc1, 0x4f, 0xbf, 0xeb, 0x6d, 0xb4, 0x0a,
+		0xb2, 0x2e, 0x72, 0x4a, 0x2a, 0xb3, 0x3d, 0xfb, 0xa4, 0x74, 0x06, 0xd2, 0x4f, 0x4a, 0x41, 0x1f,
+		0xc8, 0x47, 0x5d, 0x0e, 0xf9, 0xde, 0xec, 0x81, 0xce, 0x7b, 0x22, 0xe4, 0x49, 0xc0, 0x6c, 0xf4,
+		0xda, 0x9a, 0x8b, 0x79, 0xfe, 0x3b, 0xe8, 0x86, 0xdf, 0x0f, 0x05, 0xad, 0xd7, 0x53, 0x1d, 0x57,
+		0x73, 0x71, 0xc8, 0xd9, 0xcc, 0x69, 0xbd, 0x1e, 0x7d, 0x8a, 0x98, 0xb9, 0x9b, 0xf7, 0x41, 0x9e,
+		0x68, 0x78, 0x43, 0xeb, 0x88, 0xc0, 0x30, 0xc9, 0x42, 0x7c, 0x5e, 0xca, 0x83, 0xcb, 0x36, 0x64,
+		0x83, 0xca, 0xdd, 0x4b, 0xdd, 0x4b, 0x7e, 0xea, 0x9e, 0x94, 0xd1, 0x5b, 0x3e, 0x8c, 0x43, 0xe2,
+		0x9e, 0x54, 0x92, 0x93, 0x65, 0xbe, 0x33, 0xff, 0x22, 0x9e, 0x4c, 0xcf, 0xb6, 0x6e, 0xb2, 0x3b,
+		0xde, 0x29, 0x85, 0x7d, 0x14, 0xaf, 0x43, 0x3e, 0x6c, 0x07, 0x50, 0x1e, 0x62, 0xee, 0x2e, 0x6f,
+		0x25, 0xe6, 0xee, 0xa2, 0x73, 0x81, 0x57, 0x9a, 0xf3, 0x51, 0xd6, 0x8f, 0xe3, 0xfb, 0xaf, 0x0f,
+		0xb3, 0x8b, 0x63, 0xc5, 0x02, 0xe4, 0x42, 0x56, 0xa2, 0x78, 0x04, 0xe6, 0xa2, 0x74, 0x7e, 0xd1,
+		0x80, 0xb9, 0x28, 0xd5, 0x8d, 0x2e, 0x40, 0xca, 0x53, 0xfa, 0x42, 0x82, 0x86, 0x5a, 0xf7, 0x90,
+		0x3c, 0x58, 0x22, 0x3b, 0x64, 0x22, 0xe8, 0x0e, 0x4f, 0x8c, 0x87, 0x26, 0xbd, 0xde, 0x15, 0xcd,
+		0xd9, 0x29, 0xbe, 0x13, 0xe6, 0x47, 0xe9, 0xf3, 0x00, 0xe3, 0x58, 0xaa, 0x46, 0x30, 0xee, 0x08,
+		0x24, 0xf9, 0x05, 0xdb, 0x18, 0xcd, 0x3c, 0xf3, 0x2f, 0xc2, 0x50, 0xa6, 0xdb, 0xe3, 0x2c, 0x21,
+		0x4d, 0x3f, 0x8a, 0x2a, 0x1c, 0x1b, 0xa9, 0xd2, 0xfd, 0x2d, 0x29, 0x9e, 0xc3, 0x66, 0x5b, 0x52,
+		0x1e, 0x21, 0xd6, 0x59, 0xf6, 0x41, 0x7f, 0x61, 0x00, 0x9b, 0x6d, 0x1e, 0x03, 0xa5, 0x15, 0xfe,
+		0x55, 0xfc, 0x60, 0x1c, 0x8e, 0x44, 0xeb, 0x75, 0x12, 0xc8, 0x74, 0xb5, 0x5d, 0xd5, 0x0d, 0xa7,
+		0x8e, 0xa0, 0xab, 0xed, 0xb6, 0x78, 0xde, 0x48, 0x86, 0xb8, 0xbb, 0xeb, 0xd0, 0x3b, 0x74, 0x59,
+		0x85, 0xfc, 0x89, 0xae, 0xc1, 0x4c, 0xc7, 0xd2, 0xb5, 0x8e, 0xda, 0xd1, 0x1c, 0x57, 0xe5, 0x66,
+		0x9f, 0x2d, 0xa7, 0x7b, 0x47, 0xe9, 0x69, 0x76, 0xd3, 0xcd, 0x70, 0x89, 0x0a, 0xe2, 0x0b, 0xa1,
+		0x40, 0x89, 0xac, 0x68, 0x8e, 0xcb, 0xe3, 0x83, 0x1a, 0x64, 0xba, 0x86, 0xb3, 0x89, 0x77, 0xb4,
+		0x9b, 0x86, 0x65, 0xf3, 0x75, 0x15, 0x21, 0x3d, 0xab, 0x3e, 0x10, 0x27, 0x15, 0xc4, 0x0b, 0x4c,
+		0xca, 0x54, 0x48, 0x9a, 0x85, 0x66, 0x49, 0x1e, 0x58, 0xb3, 0x3c, 0x0a, 0x73, 0x26, 0xde, 0x75,
+		0x55, 0x7f, 0xe5, 0x32, 0x49, 0x61, 0x2f, 0x10, 0x20, 0x52, 0xe7, 0xad, 0x75, 0x87, 0xee, 0x0a,
+		0x3e, 0x08, 0x5e, 0x5c, 0xa9, 0x8a, 0x90, 0x37, 0x45, 0xa1, 0x0b, 0xa2, 0xbc, 0xc4, 0x8a, 0x8b,
+		0xaf, 0xd2, 0xc9, 0x89, 0xb2, 0x8e, 0x82, 0xf5, 0x92, 0xcf, 0xfa, 0x16, 0xcc, 0x71, 0xfc, 0x76,
+		0x88, 0xfb, 0x43, 0x31, 0x7c, 0xd8, 0xe9, 0x0a, 0x70, 0x1d, 0x09, 0xfc, 0xd1, 0x8c, 0x8f, 0xdf,
+		0x21, 0xe3, 0x11, 0x24, 0x28, 0x5b, 0x12, 0x4c, 0xdd, 0x90, 0xbf, 0xff, 0x5f, 0x9b, 0x8c, 0xef,
+		0x88, 0xc3, 0xcc, 0x90, 0x63, 0xe1, 0x0d, 0x4c, 0x8a, 0x1c, 0x58, 0x2c, 0x72, 0x60, 0xf1, 0x03,
+		0x0f, 0x8c, 0xcf, 0x76, 0x62, 0xfc, 0x6c, 0x4f, 0xbd, 0x95, 0xb3, 0x9d, 0xbc, 0xc3, 0xd9, 0xfe,
+		0x9a, 0xce, 0xc3, 0x0f, 0x4b, 0xb0, 0x30, 0xda, 0x1d, 0x8b, 0x9c, 0x90, 0x87, 0x60, 0xc6, 0xeb,
+		0x8a, 0x47, 0x9e, 0xa9, 0x47, 0xd9, 0xab, 0xe0, 0xf4, 0x47, 0x5a, 0xbc, 0xfb, 0x20, 0x3f, 0xe0,
+		0x2d, 0x32, 0x61, 0xce, 0x85, 0x32, 0xb0, 0xc5, 0x5f, 0x88, 0xc3, 0x5c, 0x94, 0x43, 0x17, 0xb1,
+		0x62, 0x15, 0x98, 0x6d, 0x63, 0xdd, 0x68, 0xdf, 0xf1, 0x82, 0x9d, 0xe1, 0xe8, 0xff, 0x7f, 0xbd,
+		0x0e, 0xcb, 0x09, 0x3a, 0x03, 0x33, 0xce, 0x9e, 0xa9, 0x1b, 0xe6, 0xb6, 0xea, 0x5a, 0xc2, 0x37,
+		0x4a, 0xb3, 0x2d, 0x21, 0x5e, 0xd1, 0xb2, 0xb8, 0x77, 0xf4, 0xe3, 0x00, 0x29, 0x05, 0x3b, 0x3d,
+		0xe2, 0xcc, 0xb1, 0xdf, 0xb6, 0xd1, 0x71, 0xcf, 0xf5, 0x77, 0x35, 0x22, 0x63, 0x0c, 0x0e, 0x22,
+		0xf0, 0x48, 0xac, 0xed, 0xe1, 0xa1, 0xc7, 0x79, 0x4a, 0x61, 0x64, 0x72, 0x80, 0xb9, 0xea, 0x1e,
+		0x2a, 0xcb, 0x29, 0x3c, 0x29, 0x72, 0x0a, 0xf1, 0x51, 0x91, 0x32, 0x77, 0xdc, 0x3d, 0x3c, 0x9e,
+		0x54, 0x78, 0x9c, 0x27, 0x15, 0x12, 0xa3, 0x9a, 0x63, 0xfe, 0xbd, 0xdf, 0x9c, 0xc1, 0xee, 0xdb,
+		0x07, 0xb3, 0x0a, 0xc9, 0x51, 0x43, 0x0d, 0x38, 0xe2, 0xfe, 0x50, 0xfd, 0xb4, 0xc2, 0x93, 0x22,
+		0xad, 0x30, 0x3d, 0xaa, 0xd3, 0xdc, 0xf3, 0xf4, 0x3b, 0xcd, 0xf2, 0x0a, 0x6f, 0x0f, 0xe4, 0x15,
+		0xd2, 0x83, 0xbb, 0xb0, 0x43, 0x79, 0x05, 0x0f, 0xdb, 0x4b, 0x2c, 0x2c, 0x7b, 0x89, 0x85, 0xec,
+		0xc8, 0xac, 0x04, 0x77, 0x19, 0x3d, 0x64, 0x91, 0x59, 0x58, 0x1f, 0xca, 0x2c, 0xb0, 0x44, 0xc0,
+		0x03, 0x63, 0x33, 0x0b, 0x1e, 0xa9, 0x81, 0xd4, 0xc2, 0xfa, 0x50, 0x6a, 0x21, 0x3f, 0x8a, 0xe2,
+		0x80, 0x7f, 0xea, 0x53, 0x0c, 0xe7, 0x16, 0xbe, 0x39, 0x3a, 0xb7, 0x30, 0x32, 0xf8, 0x8f, 0xf0,
+		0x45, 0x3d, 0xd2, 0x11, 0xc9, 0x85, 0x77, 0x8e, 0x48, 0x2e, 0xc8, 0xa3, 0x82, 0xe0, 0x28, 0x4f,
+		0xd4, 0x6b, 0x20, 0x2a, 0xbb, 0x70, 0x2d, 0x22, 0xbb, 0xc0, 0xd2, 0x00, 0x0f, 0x4e, 0x90, 0x5d,
+		0xf0, 0x48, 0x0f, 0xa5, 0x17, 0xae, 0x45, 0xa4, 0x17, 0xd0, 0x68, 0xba, 0x03, 0x0e, 0x54, 0x90,
+		0x6e, 0x38, 0xbf, 0x70, 0x39, 0x9c, 0x5f, 0x98, 0xdd, 0xdf, 0x6f, 0x65, 0x6e, 0x80, 0x47, 0x2d,
+		0x98, 0x60, 0xd0, 0x47, 0x25, 0x18, 0x58, 0x0e, 0xe0, 0x91, 0x09, 0x13, 0x0c, 0x1e, 0xed, 0xc8,
+		0x0c, 0xc3, 0xfa, 0x50, 0x86, 0xe1, 0xf0, 0x28, 0x81, 0x1b, 0x30, 0x48, 0xbe, 0xc0, 0x8d, 0x4c,
+		0x31, 0xb0, 0xe7, 0x8d, 0xd9, 0xc3, 0xc6, 0x20, 0x67, 0xae, 0x26, 0x52, 0x19, 0x39, 0x5b, 0x7c,
+		0x90, 0xb8, 0x40, 0x03, 0x7a, 0x8f, 0x04, 0x1c, 0xd8, 0xb6, 0x2d, 0x5b, 0x1c, 0x81, 0xa1, 0x1f,
+		0xc5, 0xd3, 0x90, 0x0d, 0xaa, 0xb8, 0x7d, 0xd2, 0x11, 0x05, 0xc8, 0x85, 0xb4, 0x5a, 0xf1, 0x97,
+		0x24, 0xc8, 0x06, 0xf5, 0x55, 0x28, 0x58, 0x4d, 0xf3, 0x60, 0x35, 0x90, 0xa4, 0x88, 0x85, 0x93,
+		0x14, 0x8b, 0x90, 0x21, 0x01, 0xdb, 0x40, 0xfe, 0x41, 0xeb, 0x79, 0xf9, 0x87, 0x33, 0x30, 0x43,
+		0xed, 0x2d, 0x4b, 0x65, 0x70, 0xcb, 0xc0, 0xb6, 0x7c, 0x0a, 0xa4, 0x82, 0x32, 0x83, 0xef, 0xf2,
+		0x3e, 0x02, 0xb3, 0x01, 0x58, 0x2f, 0x10, 0x64, 0xa1, 0xb8, 0xec, 0x41, 0x97, 0x78, 0x44, 0xf8,
+		0x6b, 0x12, 0xcc, 0x0c, 0xa9, 0xcb, 0xc8, 0x1c, 0x83, 0xf4, 0x56, 0xe5, 0x18, 0x62, 0x77, 0x9e,
+		0x63, 0x08, 0x86, 0xb6, 0xf1, 0x70, 0x68, 0xfb, 0x65, 0x09, 0x72, 0x21, 0xb5, 0x4d, 0x26, 0x41,
+		0xb7, 0xda, 0xe2, 0xc0, 0x14, 0xfd, 0x9b, 0xf8, 0x34, 0x1d, 0x4b, 0xbc, 0x4b, 0x4b, 0xfe, 0x24,
+		0x50, 0x9e, 0x21, 0x4a, 0x73, 0x33, 0xe3, 0xc5, 0xa9, 0x53, 0xc1, 0xa3, 0x93, 0xfc, 0x38, 0x61,
+		0xd2, 0x3f, 0x4e, 0xe8, 0x9d, 0x93, 0x9a, 0x0e, 0x9c, 0x93, 0x42, 0x17, 0x21, 0x4d, 0x77, 0x0c,
+		0x54, 0xab, 0xe7, 0xff, 0x40, 0xdf, 0xe8, 0xa3, 0x84, 0x0e, 0xdd, 0x90, 0x64, 0xe7, 0x0f, 0x7d,
+		0x8f, 0x25, 0x1d, 0xf2, 0x58, 0xee, 0x82, 0x34, 0xe9, 0x3e, 0x7b, 0xe4, 0x1e, 0xf8, 0xd9, 0x5d,
+		0x51, 0x50, 0xfc, 0xa9, 0x18, 0x14, 0x06, 0xac, 0x4e, 0xe4, 0xe0, 0x85, 0x54, 0xc6, 0x02, 0x29,
+		0x94, 0xc9, 0x18, 0x72, 0x02, 0x60, 0x5b, 0x73, 0xd4, 0x5b, 0x9a, 0xe9, 0xf2, 0x1f, 0xa6, 0x8a,
+		0x2b, 0x81, 0x12, 0xb4, 0x00, 0x29, 0xf2, 0xd5, 0x77, 0xf8, 0x4f, 0x53, 0xc5, 0x15, 0xef, 0x1b,
+		0xd5, 0x21, 0x89, 0x6f, 0xd2, 0x57, 0x63, 0xd8, 0xbb, 0x84, 0x47, 0x23, 0xd4, 0x13, 0xa9, 0x2f,
+		0xcf, 0x93, 0xe9, 0xfe, 0xe3, 0xd7, 0x17, 0x65, 0x06, 0xfe, 0xb0, 0xf7, 0xda, 0x83, 0xc2, 0x09,
+		0x84, 0xd9, 0x90, 0x1a, 0x60, 0x03, 0x4d, 0x2d, 0x66, 0x45, 0x9e, 0x80, 0x30, 0x95, 0xed, 0x81,
+		0x2a, 0xb9, 0x2e, 0xee, 0xf6, 0x2c, 0xab, 0xa3, 0xb2, 0x75, 0x5e, 0x82, 0x7c, 0xd8, 0xc8, 0xb2,
+		0x1f, 0x92, 0x71, 0x35, 0xc3, 0x54, 0x43, 0x7e, 0x74, 0x96, 0x15, 0xb2, 0x75, 0x15, 0x7c, 0x13,
+		0xa8, 0xf8, 0x0e, 0x38, 0x1c, 0x69, 0x63, 0xd1, 0x53, 0x90, 0xf6, 0xed, 0x33, 0xdb, 0xc7, 0xde,
+		0x2f, 0x67, 0xe3, 0x03, 0x17, 0xaf, 0xc1, 0xe1, 0x48, 0x23, 0x8b, 0x9e, 0x81, 0xa4, 0x8d, 0x9d,
+		0x7e, 0xc7, 0xe5, 0x2f, 0xef, 0xdd, 0x37, 0xde, 0x3a, 0xf7, 0x3b, 0xae, 0xc2, 0x91, 0x8a, 0xe7,
+		0xe0, 0xd8, 0x48, 0x2b, 0xeb, 0x67, 0x5e, 0xa4, 0x40, 0xe6, 0xa5, 0xf8, 0xb3, 0x12, 0x2c, 0x8c,
+		0xb6, 0x9c, 0xa8, 0x3c, 0xd0, 0xa1, 0x33, 0x13, 0xda, 0xdd, 0x40, 0xaf, 0x48, 0x68, 0x62, 0xe3,
+		0x2d, 0xec, 0xea, 0x3b, 0xcc, 0x84, 0x33, 0xa5, 0x90, 0x53, 0x72, 0xbc, 0x94, 0xe2, 0x38, 0x0c,
+		0xec, 0x25, 0xac, 0xbb, 0x2a, 0x9b, 0x54, 0x87, 0xff, 0xe4, 0x68, 0x8e, 0x95, 0x36, 0x59, 0x61,
+		0xf1, 0x21, 0x38, 0x3a, 0xc2, 0x16, 0x0f, 0xc7, 0x30, 0xc5, 0x17, 0x09, 0x70, 0xa4, 0x81, 0x45,
+		0xcf, 0x42, 0xd2, 0x71, 0x35, 0xb7, 0xef, 0xf0, 0x91, 0x3d, 0x30, 0xd6, 0x36, 0x37, 0x29, 0xb8,
+		0xc2, 0xd1, 0x8a, 0x4f, 0x03, 0x1a, 0xb6, 0xb4, 0x11, 0x71, 0x98, 0x14, 0x15, 0x87, 0x6d, 0xc2,
+		0xf1, 0x7d, 0x6c, 0x2a, 0xaa, 0x0c, 0x74, 0xee, 0xa1, 0x89, 0x4c, 0xf2, 0x40, 0x07, 0xff, 0x2c,
+		0x06, 0x87, 0x23, 0x4d, 0x6b, 0x60, 0x95, 0x4a, 0x6f, 0x76, 0x95, 0x3e, 0x03, 0xe0, 0xee, 0xaa,
+		0x6c, 0xa6, 0x85, 0xb6, 0x8f, 0x8a, 0x27, 0x76, 0xb1, 0x4e, 0x15, 0x16, 0x11, 0x8c, 0xb4, 0xcb,
+		0xff, 0x72, 0x50, 0x2b, 0x18, 0xfb, 0xf6, 0xa9, 0x25, 0x70, 0x78, 0x58, 0x38, 0xb1, 0xcd, 0xf0,
+		0x83, 0x64, 0x56, 0xec, 0xa0, 0x17, 0xe1, 0xe8, 0x80, 0x45, 0xf3, 0x68, 0x27, 0x26, 0x36, 0x6c,
+		0x87, 0xc3, 0x86, 0x4d, 0xd0, 0x0e, 0x5a, 0xa5, 0xa9, 0xb0, 0x55, 0x7a, 0x11, 0xc0, 0x0f, 0x82,
+		0xc9, 0x7a, 0xb3, 0xad, 0xbe, 0xd9, 0x16, 0x07, 0x7d, 0xe9, 0x07, 0xba, 0x00, 0x53, 0x44, 0x12,
+		0x04, 0xab, 0x22, 0x14, 0x06, 0x99, 0xd2, 0x40, 0x14, 0xcd, 0xc0, 0x8b, 0x2f, 0x09, 0x69, 0x0b,
+		0xe6, 0x23, 0x47, 0xb4, 0xf1, 0xf6, 0x70, 0x1b, 0xc5, 0xd1, 0xa9, 0xcd, 0xe8, 0xb6, 0xfe, 0x1e,
+		0x4c, 0xd1, 0xe9, 0x8f, 0x3c, 0x67, 0xff, 0x2d, 0x00, 0x9a, 0xeb, 0xda, 0xc6, 0x66, 0xdf, 0x6f,
+		0xe1, 0xe4, 0x08, 0xf9, 0x29, 0x09, 0xc0, 0xf2, 0x5d, 0x5c, 0x90, 0xe6, 0x7c, 0xdc, 0x80, 0x30,
+		0x05, 0x28, 0x16, 0x1b, 0x90, 0x0f, 0xe3, 0x46, 0x5f, 0x1c, 0x18, 0x7e, 0x3b, 0xdf, 0x37, 0xe4,
+		0xfc, 0x72, 0x12, 0xfd, 0x28, 0xbe, 0x3b, 0x06, 0xd9, 0xa0, 0xf4, 0xfd, 0x1d, 0x34, 0x96, 0xc5,
+		0xef, 0x94, 0x20, 0xe5, 0x8d, 0x3f, 0x9c, 0xfa, 0x0f, 0xed, 0x99, 0xf8, 0x57, 0x48, 0xbc, 0x7c,
+		0x3d, 0xdb, 0x21, 0x89, 0x7b, 0x3b, 0x24, 0x6f, 0xf3, 0x0c, 0xc2, 0xc8, 0x60, 0x3e, 0xc8, 0x6d,
+		0x2e, 0x58, 0xc2, 0x40, 0x3d, 0x3d, 0xd9, 0xa1, 0xaa, 0x39, 0x98, 0x0a, 0x9e, 0x88, 0x62, 0x1f,
+		0x45, 0x27, 0x70, 0x5a, 0x95, 0xad, 0x46, 0x1f, 0x30, 0x16, 0x00, 0x1c, 0x3e, 0x07, 0x15, 0x1f,
+		0x7f, 0x0e, 0x2a, 0x31, 0x78, 0x0e, 0x8a, 0xf9, 0x00, 0xc5, 0x7f, 0x24, 0x41, 0x4a, 0xac, 0x12,
+		0xf4, 0x6c, 0xf0, 0x64, 0xb5, 0x38, 0xa6, 0x39, 0x5a, 0x4b, 0xf1, 0xc1, 0x07, 0x0e, 0x56, 0x97,
+		0xc5, 0x0e, 0xa5, 0xd1, 0x56, 0xb7, 0x3a, 0x9a, 0x78, 0x55, 0x76, 0xe4, 0xb9, 0x70, 0x76, 0xae,
+		0x88, 0x1f, 0x75, 0xad, 0xb7, 0xc9, 0x07, 0xf7, 0x4a, 0xfe, 0x5a, 0x02, 0x79, 0x70, 0x15, 0xbf,
+		0xf9, 0xfe, 0x0d, 0x5b, 0xaf, 0x78, 0x84, 0xf5, 0x42, 0x67, 0x61, 0xd6, 0xff, 0x51, 0x62, 0xc7,
+		0xd8, 0x36, 0xd9, 0xb1, 0x6b, 0x96, 0x8e, 0x43, 0x5e, 0x55, 0x53, 0xd4, 0x0c, 0x8f, 0x7b, 0xea,
+		0x4e, 0xc7, 0xfd, 0x1d, 0x31, 0xc8, 0x04, 0xb2, 0x83, 0xe8, 0x89, 0x80, 0x8a, 0xca, 0x47, 0xd9,
+		0x8c, 0x00, 0x70, 0xe0, 0x37, 0x43, 0x43, 0x9c, 0x8a, 0xdd, 0x01, 0xa7, 0x46, 0xe5, 0x61, 0x45,
+		0xba, 0x31, 0x71, 0xe0, 0x74, 0x63, 0xf4, 0xf9, 0xc4, 0xa9, 0x11, 0xe7, 0x13, 0xbf, 0x4d, 0x82,
+		0x94, 0x97, 0x8a, 0x39, 0xe8, 0x6e, 0xde, 0x11, 0x48, 0x72, 0x4f, 0x8c, 0x6d, 0xe7, 0xf1, 0xaf,
+		0xc8, 0xbc, 0xea, 0x02, 0xa4, 0xc4, 0x6f, 0x6f, 0x71, 0x7b, 0xe7, 0x7d, 0x9f, 0xd9, 0x84, 0x4c,
+		0x60, 0x43, 0x14, 0x1d, 0x83, 0xc3, 0x95, 0x2b, 0xb5, 0xca, 0x73, 0x6a, 0xeb, 0x85, 0xc1, 0x87,
+		0x6b, 0x87, 0xaa, 0x94, 0x1a, 0xfd, 0x66, 0xef, 0x07, 0x86, 0xab, 0x58, 0x45, 0x6c, 0x21, 0xf1,
+		0xde, 0x1f, 0x3b, 0x71, 0xe8, 0xcc, 0x5f, 0x4b, 0x30, 0x1b, 0xe1, 0xf3, 0xa2, 0x7b, 0xe0, 0xee,
+		0xb5, 0x4b, 0x97, 0x6a, 0x8a, 0xda, 0x6c, 0x94, 0xd6, 0x9b, 0x57, 0xd6, 0x5a, 0xaa, 0x52, 0x6b,
+		0x6e, 0xac, 0x04, 0x1f, 0x7e, 0x3d, 0x09, 0x77, 0x45, 0x83, 0x94, 0x2a, 0x95, 0xda, 0x7a, 0x8b,
+		0xfd, 0xa4, 0xc6, 0x08, 0x88, 0x32, 0x7d, 0x18, 0x76, 0x34, 0x09, 0xa5, 0x76, 0xb5, 0x56, 0x69,
+		0xc9, 0x71, 0xf4, 0x00, 0x9c, 0xda, 0x0f, 0x82, 0x3f, 0x47, 0x2b, 0x27, 0xc6, 0x02, 0x36, 0x6b,
+		0x8d, 0x6a, 0x4d, 0x91, 0xa7, 0xf8, 0xb8, 0x3f, 0x1a, 0x83, 0xf9, 0x51, 0xae, 0x35, 0xa1, 0x55,
+		0x5a, 0x5f, 0x5f, 0xb9, 0xee, 0xd3, 0xaa, 0x5c, 0xd9, 0x68, 0x3c, 0x37, 0xcc, 0x82, 0xfb, 0xa1,
+		0xb8, 0x1f, 0xa0, 0xc7, 0x88, 0xfb, 0xe0, 0x9e, 0x7d, 0xe1, 0x38, 0x3b, 0xc6, 0x80, 0x29, 0xb5,
+		0x96, 0x72, 0x5d, 0x8e, 0xa3, 0x25, 0x38, 0x33, 0x16, 0xcc, 0xab, 0x93, 0x13, 0xe8, 0x2c, 0x3c,
+		0xb4, 0x3f, 0x3c, 0x63, 0x90, 0x40, 0x10, 0x2c, 0x7a, 0x55, 0x82, 0xc3, 0x91, 0x3e, 0x3a, 0x3a,
+		0x05, 0x8b, 0xeb, 0xca, 0x5a, 0xa5, 0xd6, 0x6c, 0xaa, 0xeb, 0xca, 0xda, 0xfa, 0x5a, 0xb3, 0xb4,
+		0xa2, 0x36, 0x5b, 0xa5, 0xd6, 0x46, 0x33, 0xc0, 0x9b, 0x22, 0x9c, 0x18, 0x05, 0xe4, 0xf1, 0x65,
+		0x1f, 0x18, 0x2e, 0x01, 0x42, 0x4e, 0x6f, 0x4b, 0x70, 0x6c, 0xa4, 0x4f, 0x8e, 0x4e, 0xc3, 0xbd,
+		0xec, 0x6d, 0x64, 0xf5, 0xda, 0x5a, 0x2b, 0xf8, 0xdb, 0x2d, 0x43, 0xbd, 0x7a, 0x00, 0x4e, 0xed,
+		0x0b, 0xe9, 0x75, 0x6d, 0x1c, 0xe0, 0x40, 0xff, 0xde, 0x23, 0x41, 0x61, 0x40, 0x17, 0xd2, 0x27,
+		0x98, 0xeb, 0xcd, 0x72, 0xed, 0x4a, 0xe9, 0x5a, 0x7d, 0x4d, 0x19, 0x5c, 0xb3, 0xa7, 0x60, 0x71,
+		0xa8, 0xb6, 0xba, 0xb1, 0xbe, 0x52, 0xaf, 0x94, 0x5a, 0x35, 0xda, 0xa8, 0x2c, 0x91, 0x81, 0x0d,
+		0x01, 0xad, 0xd4, 0x2f, 0x5f, 0x69, 0xa9, 0x95, 0x95, 0x7a, 0xad, 0xd1, 0x52, 0x4b, 0xad, 0x56,
+		0xc9, 0x5f, 0xce, 0xe5, 0xe7, 0x46, 0x9e, 0x8a, 0x3d, 0x37, 0xf9, 0xa9, 0x58, 0x7e, 0xba, 0xd3,
+		0xbf, 0x27, 0x18, 0x83, 0x45, 0xaf, 0x92, 0x67, 0xd6, 0x06, 0x4f, 0x7f, 0xce, 0x7a, 0xda, 0x9d,
+		0x03, 0x8c, 0x3e, 0xef, 0xff, 0x0c, 0xc4, 0x4b, 0xbd, 0x1e, 0xd1, 0x7c, 0xf4, 0x5b, 0xb7, 0x3a,
+		0x5c, 0xaf, 0x7a, 0xdf, 0xa4, 0xce, 0xb1, 0xb6, 0xdc, 0x5b, 0x9a, 0xed, 0xfd, 0xac, 0xb4, 0xf8,
+		0x2e, 0x5e, 0x84, 0xb4, 0x17, 0x4b, 0xd0, 0x27, 0x66, 0xbd, 0x1b, 0x60, 0x09, 0x71, 0xc3, 0x8b,
+		0x5f, 0x93, 0x89, 0xf9, 0xd7, 0x64, 0x12, 0x6f, 0x7c, 0x74, 0x51, 0x2a, 0x37, 0x46, 0x72, 0xe7,
+		0xf1, 0xc9, 0xb9, 0xe3, 0x33, 0xc0, 0x63, 0xd0, 0xf7, 0xdf, 0x1d, 0xb8, 0x0d, 0xe1, 0x9d, 0x55,
+		0x0d, 0xb2, 0x27, 0xe2, 0xb8, 0xff, 0xb8, 0xd3, 0xb1, 0x13, 0x9c, 0x7e, 0x1d, 0x37, 0x2b, 0x77,
+		0x7a, 0x3c, 0xf6, 0x22, 0xe4, 0xd6, 0x35, 0xdb, 0x6d, 0x62, 0xf7, 0x0a, 0xd6, 0xda, 0xd8, 0x0e,
+		0xdf, 0x8a, 0xce, 0x89, 0x5b, 0xd1, 0xc2, 0x9e, 0xc5, 0x7c, 0x7b, 0x56, 0x34, 0x20, 0x41, 0x7f,
+		0x1f, 0x61, 0xe4, 0xf1, 0x14, 0xe6, 0x32, 0xf2, 0xe3, 0x29, 0xf4, 0x03, 0x3d, 0x21, 0xee, 0x3d,
+		0xc7, 0xc7, 0xdc, 0x7b, 0x16, 0x71, 0x14, 0xbb, 0xfd, 0xdc, 0x85, 0x69, 0xee, 0xcd, 0x44, 0xee,
+		0xfb, 0x36, 0xa0, 0xd0, 0xd3, 0x6c, 0x97, 0xfe, 0xdc, 0xe2, 0x0e, 0x1d, 0x06, 0xf7, 0x44, 0xa2,
+		0x2e, 0xae, 0x85, 0x86, 0xcb, 0x9b, 0xc9, 0xf5, 0x82, 0x85, 0xc5, 0x37, 0x12, 0x90, 0xe4, 0xec,
+		0x78, 0x7b, 0xf8, 0x8c, 0x5c, 0xc8, 0x4d, 0xf7, 0xc5, 0xdf, 0x0f, 0x79, 0x39, 0x41, 0x2f, 0x49,
+		0x7d, 0xff, 0xe0, 0x89, 0xb4, 0x72, 0xe6, 0x73, 0xaf, 0x2f, 0x4e, 0xd3, 0xbc, 0x71, 0xbd, 0xea,
+		0x1f, 0x4f, 0x7b, 0xeb, 0xbd, 0xa0, 0x2a, 0xe4, 0x02, 0x19, 0x6d, 0xa3, 0xcd, 0x8f, 0x0c, 0x2c,
+		0x8c, 0xf6, 0x14, 0xc5, 0x06, 0xb1, 0x97, 0xed, 0xae, 0xb7, 0xd1, 0x69, 0x90, 0x03, 0x7b, 0xd6,
+		0x2c, 0x58, 0x67, 0xa9, 0xdc, 0x7c, 0xc7, 0xdb, 0x8d, 0xa6, 0x5b, 0xb6, 0xc7, 0x21, 0x4d, 0x7f,
+		0x56, 0x34, 0xb0, 0xb3, 0x9b, 0x22, 0x05, 0xb4, 0xf2, 0x01, 0x28, 0x0c, 0x6e, 0xfe, 0xb2, 0xed,
+		0xdc, 0xfc, 0xcd, 0xf0, 0xc6, 0xef, 0xa8, 0xad, 0xe2, 0xf4, 0xc8, 0xad, 0xe2, 0xfb, 0x20, 0xef,
+		0xa7, 0x28, 0x28, 0x2c, 0x30, 0x4f, 0xdb, 0x2b, 0xa5, 0x60, 0xc1, 0x6c, 0x43, 0x26, 0x94, 0x6d,
+		0xf0, 0xf6, 0x09, 0x78, 0xee, 0x85, 0xc1, 0x64, 0xd9, 0x6e, 0x33, 0xa9, 0xe0, 0x29, 0x16, 0x0a,
+		0x7b, 0x0a, 0x72, 0xe2, 0x7a, 0x28, 0x83, 0xcb, 0x51, 0xb8, 0xac, 0x28, 0x1c, 0xb9, 0x7b, 0x9d,
+		0x8f, 0x3e, 0xe5, 0x30, 0x0f, 0x89, 0x2a, 0x8f, 0x91, 0x07, 0x32, 0x6e, 0x9f, 0x89, 0x43, 0x82,
+		0x6e, 0x32, 0x3d, 0x1e, 0x72, 0xcc, 0xa3, 0x44, 0x9a, 0x84, 0x07, 0xb8, 0xbd, 0xea, 0x6c, 0x07,
+		0xfc, 0xf2, 0x51, 0x87, 0x53, 0xbc, 0x44, 0x47, 0x3c, 0x98, 0xe8, 0xb8, 0x04, 0x29, 0x4f, 0x4e,
+		0x12, 0x63, 0xe5, 0xa4, 0x40, 0xe4, 0x84, 0x88, 0x31, 0x2f, 0x50, 0xa6, 0x79, 0x78, 0x81, 0xca,
+		0x90, 0xf6, 0x34, 0x8c, 0x27, 0x70, 0x93, 0xc8, 0xac, 0x8f, 0x16, 0x7d, 0x8a, 0x23, 0x39, 0xe2,
+		0x14, 0x47, 0x50, 0xb0, 0x54, 0xa6, 0x86, 0xa6, 0xe9, 0xc0, 0x7c, 0xc1, 0xaa, 0x53, 0x7d, 0x74,
+		0x17, 0xa4, 0xfd, 0xf8, 0x8a, 0xc9, 0x9e, 0x5f, 0x40, 0x6a, 0xfd, 0x48, 0x8d, 0xc9, 0x9a, 0x5f,
+		0x30, 0x2a, 0x4a, 0x83, 0x51, 0x51, 0x5a, 0xf1, 0x5f, 0x49, 0x90, 0xe4, 0x07, 0x35, 0xf6, 0x49,
+		0x12, 0xb0, 0x79, 0x88, 0x8d, 0x9a, 0x87, 0xf8, 0x9b, 0x9a, 0x07, 0xf0, 0xfa, 0x29, 0x8e, 0xa7,
+		0xde, 0x15, 0x99, 0xaa, 0x23, 0x9d, 0x6c, 0x1a, 0xdb, 0x62, 0xd7, 0xc8, 0xc7, 0x2a, 0xbe, 0x2e,
+		0x11, 0xf3, 0xcb, 0xeb, 0x87, 0x03, 0x4f, 0xe9, 0xc0, 0x81, 0xe7, 0xc1, 0xce, 0xe7, 0x84, 0x44,
+		0x29, 0x7e, 0x67, 0xa2, 0x14, 0x9a, 0xf4, 0xc4, 0xc0, 0xa4, 0x17, 0x3f, 0x2f, 0x41, 0x3e, 0x9c,
+		0x0a, 0xfc, 0xbf, 0x34, 0x5b, 0xdf, 0xc8, 0xe5, 0xab, 0x8d, 0xdb, 0xea, 0xd0, 0xb4, 0xdd, 0x1b,
+		0x75, 0x63, 0x3d, 0xd4, 0x6b, 0x7f, 0xfa, 0x90, 0x20, 0xd3, 0xf4, 0xa7, 0xf1, 0x53, 0x31, 0x71,
+		0x9e, 0x2d, 0x00, 0xff, 0xb7, 0x70, 0x3a, 0xc3, 0x6b, 0x78, 0x6a, 0xc2, 0x35, 0x9c, 0x1c, 0xb9,
+		0x86, 0x3f, 0x15, 0xa3, 0x2f, 0x9c, 0xb0, 0x13, 0x03, 0x7f, 0x13, 0x3a, 0xf8, 0x38, 0xa4, 0x7b,
+		0x56, 0x47, 0x65, 0x35, 0xec, 0x37, 0x13, 0x52, 0x3d, 0xab, 0xa3, 0x0c, 0x89, 0xda, 0xd4, 0x5b,
+		0xa5, 0xa0, 0x93, 0x6f, 0xc1, 0x34, 0x4c, 0x0f, 0xae, 0x2a, 0x17, 0xb2, 0x8c, 0x17, 0xdc, 0x83,
+		0x3a, 0x47, 0x98, 0x40, 0x7d, 0x32, 0x69, 0xd0, 0xe7, 0xf3, 0xfa, 0xcd, 0x40, 0x15, 0x0e, 0x48,
+		0x50, 0x42, 0x67, 0xe4, 0x8e, 0x8d, 0xd4, 0x5c, 0xe2, 0x9c, 0x4f, 0xf1, 0x35, 0x09, 0x60, 0x85,
+		0x30, 0x97, 0x8e, 0x98, 0x38, 0x3f, 0x0e, 0xed, 0x84, 0x1a, 0x6a, 0x7b, 0x71, 0xe4, 0xc4, 0xf1,
+		0x1e, 0x64, 0x9d, 0x60, 0xd7, 0xab, 0x90, 0xf3, 0x05, 0xdc, 0xc1, 0xa2, 0x3b, 0x8b, 0xfb, 0xdd,
+		0x93, 0x6d, 0x62, 0x57, 0xc9, 0xde, 0x0c, 0x7c, 0x15, 0xff, 0xb5, 0x04, 0x69, 0xda, 0xab, 0x55,
+		0xec, 0x6a, 0xa1, 0x89, 0x94, 0xde, 0xc4, 0x44, 0xde, 0x0d, 0xc0, 0xe8, 0x38, 0xc6, 0x2b, 0x98,
+		0xcb, 0x57, 0x9a, 0x96, 0x34, 0x8d, 0x57, 0x30, 0x7a, 0xd2, 0xe3, 0x7a, 0x7c, 0x0c, 0xd7, 0x45,
+		0x62, 0x99, 0xf3, 0xfe, 0x28, 0x4c, 0xd3, 0x9f, 0x09, 0xdb, 0x75, 0xf8, 0x51, 0x89, 0xa4, 0xd9,
+		0xef, 0xb6, 0x76, 0x9d, 0xe2, 0x0d, 0x98, 0x6e, 0xed, 0xb2, 0x97, 0x93, 0x8e, 0x43, 0xda, 0xb6,
+		0x2c, 0xee, 0x0d, 0x32, 0x47, 0x3c, 0x45, 0x0a, 0xa8, 0xf3, 0x13, 0xb5, 0x03, 0x70, 0x76, 0x52,
+		0xb7, 0x9f, 0x3b, 0xfc, 0x67, 0x7e, 0x4f, 0x82, 0x5c, 0x68, 0x45, 0xa1, 0x87, 0xe1, 0x68, 0xb3,
+		0x7e, 0xb9, 0x51, 0xab, 0xaa, 0xab, 0xcd, 0xcb, 0x03, 0x01, 0xf6, 0x42, 0xe1, 0xd5, 0xdb, 0x27,
+		0x33, 0xfc, 0x16, 0xeb, 0x28, 0xe8, 0x75, 0xa5, 0xc6, 0x22, 0x6d, 0x06, 0xbd, 0x6e, 0xe3, 0x9b,
+		0x96, 0x8b, 0x29, 0xf4, 0xa3, 0x70, 0x2c, 0x02, 0xda, 0xbb, 0xcb, 0x3a, 0xf3, 0xea, 0xed, 0x93,
+		0xb9, 0x75, 0x1b, 0x33, 0x51, 0xa3, 0x18, 0x4b, 0x30, 0x3f, 0x8c, 0xc1, 0xb2, 0x1a, 0xf2, 0xc9,
+		0x05, 0xf9, 0xd5, 0xdb, 0x27, 0xb3, 0x42, 0x77, 0x10, 0xf8, 0xaf, 0xf9, 0x65, 0xd6, 0x0f, 0xa5,
+		0xe1, 0x18, 0x7b, 0x40, 0x4b, 0x65, 0x31, 0x20, 0xfb, 0xe0, 0x21, 0x69, 0x36, 0x58, 0x35, 0xfe,
+		0x97, 0x11, 0x8a, 0xcf, 0xc1, 0x6c, 0xdd, 0x74, 0xb1, 0xbd, 0xa5, 0xe9, 0xd8, 0xff, 0xc5, 0x0f,
+		0x32, 0xb3, 0xf4, 0xa7, 0x06, 0x25, 0xff, 0x17, 0x33, 0xd0, 0xc9, 0xd0, 0x13, 0x9f, 0x3c, 0x82,
+		0x0f, 0x16, 0x15, 0xdf, 0x2d, 0x81, 0xdc, 0xd4, 0xb5, 0x8e, 0x66, 0xbf, 0x59, 0x52, 0xe8, 0x49,
+		0xf1, 0x8b, 0x18, 0xfc, 0x6a, 0x49, 0x9c, 0xfe, 0x56, 0x52, 0x70, 0x70, 0x4b, 0xac, 0x25, 0xaa,
+		0x83, 0xd9, 0x2f, 0x61, 0x90, 0x3f, 0xcf, 0xbc, 0x00, 0xe0, 0x57, 0xa0, 0xe3, 0x70, 0xb4, 0x59,
+		0x29, 0xad, 0x94, 0xbc, 0x3c, 0x4d, 0x73, 0xbd, 0x56, 0xa9, 0x5f, 0xaa, 0xd7, 0xaa, 0xf2, 0x21,
+		0x74, 0x04, 0x50, 0xb0, 0xd2, 0xfb, 0x75, 0xe3, 0xc3, 0x30, 0x13, 0x2c, 0x2f, 0x5f, 0x6f, 0xd5,
+		0x9a, 0x72, 0x6c, 0xf9, 0x0a, 0x14, 0xba, 0xf4, 0xf7, 0x2c, 0x89, 0x01, 0xc4, 0x6d, 0xd5, 0x30,
+		0xd1, 0x89, 0xfd, 0x7f, 0xf1, 0x72, 0xfe, 0x37, 0xfe, 0x13, 0xfb, 0x7d, 0x8c, 0x1c, 0x43, 0x2c,
+		0x11, 0xbc, 0xba, 0xb9, 0xdc, 0x82, 0x39, 0x7a, 0x59, 0x9c, 0xfe, 0x16, 0x90, 0x6a, 0x08, 0xfe,
+		0x8f, 0x7f, 0xc0, 0x90, 0xd0, 0x8b, 0x9f, 0x4e, 0x2b, 0xb3, 0x3e, 0xba, 0x37, 0x7b, 0xcb, 0xcf,
+		0xf9, 0xbf, 0x68, 0xe2, 0x75, 0x70, 0x2c, 0xc5, 0xdf, 0xe4, 0x3d, 0x14, 0xef, 0x17, 0x8b, 0x2e,
+		0xae, 0xc0, 0x8c, 0xa6, 0xeb, 0xb8, 0x17, 0xea, 0xdf, 0x98, 0x37, 0xe3, 0xc4, 0x68, 0x65, 0x8e,
+		0xe9, 0x77, 0xed, 0x49, 0x48, 0x3a, 0x74, 0x52, 0xc6, 0x91, 0x10, 0xdd, 0xe1, 0xe0, 0xcb, 0x35,
+		0xf1, 0xeb, 0x8a, 0xde, 0x88, 0xc6, 0x10, 0xf8, 0x2d, 0x4e, 0x20, 0x4b, 0xd1, 0xc4, 0x68, 0x4c,
+		0x98, 0x69, 0x63, 0xbd, 0xa3, 0xd9, 0x38, 0x30, 0x9a, 0xfd, 0xdf, 0x4d, 0xfe, 0x95, 0x5f, 0x7c,
+		0xd4, 0xdb, 0x51, 0x0f, 0x08, 0x5d, 0xc4, 0x62, 0x51, 0x64, 0x4e, 0xdb, 0x1f, 0x2f, 0x86, 0xbc,
+		0x68, 0x8f, 0x8f, 0x7b, 0xff, 0xc6, 0xfe, 0x39, 0x6f, 0xec, 0x44, 0x94, 0x84, 0x07, 0x5a, 0xca,
+		0x71, 0xaa, 0xac, 0x62, 0xb9, 0x0c, 0xb9, 0x2d, 0xa3, 0x13, 0x98, 0xee, 0xfd, 0x5b, 0xf9, 0x17,
+		0xbf, 0xf8, 0x28, 0x5b, 0x68, 0x04, 0x89, 0xb3, 0xe6, 0x8e, 0x7e, 0xaf, 0x85, 0x52, 0x7f, 0x3a,
+		0xd8, 0x55, 0x4f, 0x3b, 0xfd, 0x16, 0x7d, 0xbf, 0x8f, 0x02, 0x6f, 0x6a, 0x0e, 0x26, 0x8a, 0x0b,
+		0xbb, 0xda, 0xb9, 0xb3, 0xba, 0x65, 0x98, 0x7e, 0x52, 0x91, 0x2a, 0x2c, 0x52, 0xbf, 0xc4, 0xeb,
+		0x47, 0xe4, 0xb4, 0x46, 0x2b, 0xba, 0x85, 0xe1, 0xdf, 0x0c, 0x2a, 0x76, 0x20, 0x51, 0xb1, 0x0c,
+		0xfa, 0x2b, 0xf1, 0x6d, 0x6c, 0x5a, 0x5d, 0x71, 0x7a, 0x91, 0x7e, 0xa0, 0x2b, 0x90, 0xd4, 0xba,
+		0x56, 0xdf, 0xe4, 0x2f, 0xe7, 0x95, 0x1f, 0x25, 0xb6, 0xf0, 0x0f, 0x5e, 0x5f, 0x3c, 0xcc, 0xc8,
+		0x3a, 0xed, 0x1b, 0x4b, 0x86, 0x75, 0xb6, 0xab, 0xb9, 0x3b, 0x64, 0x92, 0x7f, 0xf7, 0x93, 0x8f,
+		0x00, 0x6f, 0xaf, 0x6e, 0xba, 0x1f, 0xff, 0xc2, 0xcf, 0x9d, 0x91, 0x14, 0x8e, 0xcf, 0xd2, 0x8e,
+		0xc5, 0x1e, 0x4c, 0x57, 0xb1, 0xbe, 0x4f, 0x83, 0xf5, 0x81, 0x06, 0xcf, 0xf1, 0x06, 0x8f, 0x0f,
+		0x37, 0xc8, 0x7e, 0x7d, 0xba, 0x8a, 0xf5, 0x40, 0xb3, 0x55, 0xac, 0x0f, 0xb4, 0xb8, 0x0a, 0xa9,
+		0xba, 0xe9, 0xd2, 0x2b, 0xfd, 0xe8, 0x19, 0x88, 0x1b, 0x26, 0x0b, 0x5d, 0xd2, 0xe5, 0x87, 0x0e,
+		0x30, 0x14, 0x85, 0xe0, 0x2d, 0xc7, 0xe6, 0xa5, 0x62, 0x13, 0x52, 0x55, 0xac, 0x33, 0x72, 0x15,
+		0x88, 0xb7, 0xb1, 0xce, 0xc9, 0xdd, 0x41, 0x47, 0x09, 0x36, 0x7d, 0x9c, 0xa6, 0xfa, 0xfb, 0xff,
+		0xed, 0xc4, 0xa1, 0x77, 0x7d, 0xee, 0xc4, 0xa1, 0x91, 0x62, 0x55, 0x1c, 0xff, 0x33, 0x40, 0x9e,
+		0x34, 0xfd, 0x6f, 0x09, 0x8e, 0x0d, 0x9a, 0x30, 0xcd, 0xdc, 0x1b, 0xf5, 0x64, 0xc3, 0x05, 0x88,
+		0x97, 0xcc, 0x3d, 0x74, 0x8c, 0x3d, 0x77, 0xab, 0xf6, 0xed, 0x8e, 0x38, 0x98, 0x4a, 0xbe, 0x37,
+		0xec, 0x4e, 0xf8, 0x10, 0x84, 0xf7, 0x6e, 0xdb, 0xf7, 0x1c, 0xf0, 0xb5, 0x86, 0x54, 0xc9, 0xdc,
+		0x13, 0xef, 0x34, 0x3c, 0x3c, 0xe1, 0x3b, 0x0d, 0x9a, 0xb9, 0xd7, 0xdb, 0x3c, 0xe8, 0xf3, 0x0c,
+		0x7f, 0xba, 0x0c, 0xf7, 0x72, 0x1e, 0x39, 0xae, 0x76, 0xc3, 0x30, 0xb7, 0xbd, 0x05, 0xc5, 0xbf,
+		0x39, 0x2b, 0x8e, 0xf0, 0xb9, 0x10, 0xa5, 0x62, 0x59, 0x0d, 0xaf, 0x92, 0x85, 0x7d, 0xdf, 0x7b,
+		0x58, 0xd8, 0x3f, 0xe3, 0xbd, 0x30, 0x66, 0x6d, 0xef, 0xb7, 0x60, 0x47, 0xac, 0xf0, 0x91, 0xd3,
+		0x3b, 0xf6, 0x6d, 0xb9, 0xb1, 0x09, 0xef, 0xd7, 0x24, 0xc8, 0x5f, 0x31, 0x1c, 0xd7, 0xb2, 0x0d,
+		0x5d, 0xeb, 0xd0, 0xcd, 0xfe, 0xb7, 0x4d, 0x1c, 0xa1, 0x94, 0xd3, 0x64, 0x15, 0xf0, 0x85, 0xbf,
+		0x23, 0x82, 0x84, 0xe4, 0x4d, 0xad, 0xc3, 0xa2, 0x83, 0xa0, 0x6d, 0x18, 0x64, 0x7b, 0x60, 0x0f,
+		0x3c, 0x48, 0x85, 0xe1, 0xd2, 0xb5, 0xf7, 0x03, 0x31, 0x28, 0xd0, 0xb0, 0xc6, 0xa1, 0x47, 0xd8,
+		0xe8, 0x21, 0xa9, 0xab, 0x90, 0xb0, 0x35, 0x97, 0x3b, 0x4a, 0xe5, 0x0b, 0x07, 0x5e, 0x84, 0xac,
+		0x15, 0x4a, 0x03, 0xbd, 0x03, 0x52, 0x5d, 0x6d, 0x57, 0xa5, 0xf4, 0x62, 0x6f, 0x8a, 0xde, 0x74,
+		0x57, 0xdb, 0x25, 0xfd, 0x43, 0xdf, 0x42, 0x7f, 0x1a, 0x53, 0xd5, 0x77, 0x34, 0x73, 0x1b, 0x33,
+		0xca, 0xf1, 0x37, 0x45, 0x39, 0xd7, 0xd5, 0x76, 0x2b, 0x94, 0x1a, 0xa1, 0xcf, 0x75, 0xdc, 0xaf,
+		0x4a, 0xfc, 0x3c, 0x18, 0x65, 0x0c, 0xd2, 0x40, 0xd6, 0xbd, 0x2f, 0xda, 0xa8, 0x38, 0x66, 0xfd,
+		0xc0, 0x28, 0xde, 0x0f, 0xb0, 0xb5, 0x9c, 0x23, 0xdd, 0xfb, 0xec, 0xeb, 0x8b, 0x12, 0x6b, 0xb5,
+		0xa0, 0x0f, 0xb1, 0x3d, 0xc3, 0xce, 0xb9, 0xa9, 0x34, 0xfb, 0x1e, 0x1b, 0x1b, 0x28, 0xe7, 0x44,
+		0xa0, 0xcc, 0x08, 0x02, 0xc3, 0x26, 0xf5, 0x7c, 0x0c, 0x7f, 0x29, 0x41, 0xa6, 0x1a, 0xf0, 0x65,
+		0xe7, 0x61, 0xba, 0x6b, 0x99, 0xc6, 0x0d, 0x6c, 0x7b, 0xe7, 0xe4, 0xd9, 0x27, 0x5a, 0x80, 0x14,
+		0xfb, 0xd9, 0x6d, 0x77, 0x4f, 0xec, 0x88, 0x89, 0x6f, 0x82, 0x75, 0x0b, 0x6f, 0x3a, 0x86, 0xe0,
+		0xb3, 0x22, 0x3e, 0xd1, 0x83, 0x20, 0x3b, 0x58, 0xef, 0xdb, 0x86, 0xbb, 0xa7, 0xea, 0x96, 0xe9,
+		0x6a, 0xba, 0xcb, 0x8f, 0xe2, 0x14, 0x44, 0x79, 0x85, 0x15, 0x13, 0x22, 0x6d, 0xec, 0x6a, 0x46,
+		0x87, 0x5d, 0x35, 0x4f, 0x2b, 0xe2, 0x13, 0x5d, 0x0e, 0x1c, 0x51, 0x48, 0x7a, 0x3b, 0x28, 0x91,
+		0x1c, 0x5d, 0xe5, 0x70, 0x54, 0x98, 0xe9, 0xa8, 0x0f, 0xf9, 0xe7, 0x19, 0xf8, 0x98, 0xb7, 0x20,
+		0x25, 0xc0, 0xd0, 0xfd, 0x50, 0xe8, 0xd9, 0x16, 0xf5, 0x4c, 0x7a, 0x86, 0xae, 0xf6, 0x6d, 0x83,
+		0x8f, 0x3b, 0xc7, 0x8b, 0xd7, 0x0d, 0x7d, 0xc3, 0x36, 0xd0, 0xc3, 0x80, 0x1c, 0x4b, 0xa7, 0xd7,
+		0xdc, 0x35, 0xb3, 0xdd, 0x21, 0x0a, 0xdb, 0x60, 0xa7, 0xe3, 0xd2, 0x8a, 0xcc, 0x6a, 0xae, 0xd0,
+		0x8a, 0x0d, 0xdb, 0x70, 0x78, 0x3b, 0xb7, 0xa7, 0x83, 0xa7, 0xa1, 0x2a, 0x20, 0x5b, 0x3d, 0x6c,
+		0x87, 0xb2, 0x52, 0x6c, 0xf9, 0xcc, 0xff, 0xee, 0x27, 0x1f, 0x99, 0xe3, 0xe3, 0xe1, 0x79, 0x29,
+		0xf6, 0xc6, 0xa7, 0x52, 0x10, 0x18, 0x22, 0x5d, 0x75, 0x3d, 0x74, 0x94, 0xbf, 0xbf, 0xe9, 0x3f,
+		0x4b, 0x35, 0x37, 0x24, 0x05, 0x25, 0x73, 0xaf, 0x3c, 0xff, 0x9b, 0x3e, 0x69, 0x1e, 0xd3, 0xae,
+		0xd3, 0x13, 0x50, 0xc1, 0x63, 0xfd, 0x94, 0x0c, 0x3a, 0x02, 0xc9, 0x97, 0x34, 0xa3, 0x83, 0xdb,
+		0xfc, 0x78, 0x1d, 0xff, 0x42, 0xcb, 0xde, 0x51, 0x55, 0xf6, 0x03, 0xb5, 0xc5, 0x51, 0xac, 0x2f,
+		0x5b, 0x66, 0x3b, 0x7c, 0x42, 0x15, 0x55, 0x20, 0xe9, 0x5a, 0x37, 0xb0, 0xc9, 0x67, 0xf4, 0x60,
+		0xc6, 0x9f, 0xa3, 0xa2, 0x6f, 0x02, 0xb9, 0x8d, 0x3b, 0x78, 0x9b, 0xa5, 0x3b, 0x76, 0x34, 0x1b,
+		0xb3, 0xbc, 0xfb, 0x1d, 0x19, 0xff, 0x82, 0x47, 0xaa, 0x49, 0x29, 0xa1, 0xf5, 0x70, 0x78, 0x37,
+		0xed, 0xdd, 0x42, 0x8b, 0x1c, 0x63, 0x60, 0xa9, 0x04, 0xd5, 0x65, 0x28, 0x1c, 0x7c, 0x10, 0xe4,
+		0xbe, 0xb9, 0x69, 0x99, 0xf4, 0xd7, 0x56, 0x79, 0xa2, 0x2d, 0xc5, 0xae, 0x77, 0x78, 0xe5, 0xfc,
+		0x7a, 0xc7, 0x3a, 0xe4, 0x7d, 0x50, 0xba, 0xa4, 0xd3, 0x07, 0x5d, 0xd2, 0x39, 0x8f, 0x00, 0x01,
+		0x41, 0xab, 0x00, 0xbe, 0xd2, 0xa0, 0x5b, 0x01, 0x99, 0xd1, 0x33, 0xe6, 0xab, 0x9f, 0xe0, 0x60,
+		0x02, 0x04, 0x90, 0x09, 0xb3, 0x5d, 0xc3, 0x54, 0x1d, 0xdc, 0xd9, 0x52, 0x39, 0xe7, 0x08, 0xdd,
+		0x0c, 0x65, 0xff, 0xdb, 0x0f, 0x30, 0x9b, 0x7f, 0xf0, 0xc9, 0x47, 0x0a, 0xbe, 0xeb, 0x74, 0xf2,
+		0xd1, 0xa5, 0xc7, 0x9f, 0x54, 0x66, 0xba, 0x86, 0xd9, 0xc4, 0x9d, 0xad, 0xaa, 0x47, 0x18, 0xbd,
+		0x0d, 0x8e, 0xfb, 0x0c, 0xb1, 0x4c, 0x75, 0xc7, 0xea, 0xb4, 0x55, 0x1b, 0x6f, 0xa9, 0x3a, 0x75,
+		0x4e, 0xb3, 0x94, 0x8d, 0x47, 0x3d, 0x90, 0x35, 0xf3, 0x8a, 0xd5, 0x69, 0x2b, 0x78, 0xab, 0x42,
+		0xaa, 0xd1, 0x29, 0xf0, 0xb9, 0xa1, 0x1a, 0x6d, 0x67, 0x3e, 0x77, 0x32, 0x7e, 0x3a, 0xa1, 0x64,
+		0xbd, 0xc2, 0x7a, 0xdb, 0x59, 0x4e, 0xbd, 0xf7, 0xa3, 0x8b, 0x87, 0xde, 0xf8, 0xe8, 0xe2, 0xa1,
+		0xe2, 0x25, 0xfa, 0x70, 0x1d, 0x5f, 0x5a, 0xd8, 0x41, 0x17, 0x20, 0xad, 0x89, 0x0f, 0xf6, 0x2c,
+		0xe6, 0x3e, 0x4b, 0xd3, 0x07, 0x2d, 0x7e, 0x42, 0x82, 0x64, 0xf5, 0xda, 0xba, 0x66, 0xd8, 0xa8,
+		0x46, 0x82, 0x37, 0x21, 0xab, 0x93, 0xae, 0x72, 0x5f, 0xbc, 0xc5, 0x32, 0x6f, 0x8c, 0x4a, 0x61,
+		0xa7, 0xcb, 0xf7, 0xfc, 0xee, 0x27, 0x1f, 0xb9, 0x9b, 0x93, 0xb9, 0x36, 0x90, 0xcd, 0x16, 0xf4,
+		0x06, 0xb3, 0xdc, 0x81, 0x31, 0x5f, 0x85, 0x69, 0xd6, 0x55, 0x07, 0x3d, 0x0b, 0x53, 0x3d, 0xf2,
+		0x07, 0x3f, 0x23, 0x7e, 0x62, 0xa4, 0xcc, 0x53, 0xf8, 0xa0, 0x84, 0x30, 0xbc, 0xe2, 0xfb, 0x62,
+		0x00, 0xd5, 0x6b, 0xd7, 0x5a, 0xb6, 0xd1, 0xeb, 0x60, 0xf7, 0xad, 0x1a, 0xfb, 0x06, 0x1c, 0x0e,
+		0x64, 0x37, 0x6d, 0xfd, 0xe0, 0xe3, 0x9f, 0xf5, 0xf3, 0x9c, 0xb6, 0x1e, 0x49, 0xb6, 0xed, 0xb8,
+		0x1e, 0xd9, 0xf8, 0xc1, 0xc9, 0x56, 0x1d, 0x77, 0x98, 0xb3, 0x2f, 0x40, 0xc6, 0x67, 0x86, 0x83,
+		0xea, 0x90, 0x72, 0xf9, 0xdf, 0x9c, 0xc1, 0xc5, 0xd1, 0x0c, 0x16, 0x68, 0x41, 0x26, 0x7b, 0xe8,
+		0xc5, 0x2f, 0x4b, 0x00, 0x81, 0x35, 0xf2, 0xf5, 0x29, 0x63, 0x24, 0x84, 0xe4, 0xca, 0x39, 0x7e,
+		0xc7, 0x21, 0x24, 0x23, 0x10, 0x60, 0xea, 0xfb, 0x63, 0x30, 0xbb, 0x21, 0x56, 0xef, 0xd7, 0x3f,
+		0x0f, 0x36, 0x60, 0x1a, 0x9b, 0xae, 0x6d, 0x78, 0x77, 0x1c, 0x1e, 0x1d, 0x35, 0xe7, 0x11, 0x83,
+		0xaa, 0x99, 0xae, 0xbd, 0x17, 0x94, 0x00, 0x41, 0x2b, 0xc0, 0x8f, 0x8f, 0xc4, 0x61, 0x7e, 0x14,
+		0x2a, 0x7a, 0x00, 0x0a, 0xba, 0x8d, 0x69, 0x41, 0xf8, 0x95, 0xd6, 0xbc, 0x28, 0xe6, 0x66, 0x47,
+		0x01, 0xe2, 0x59, 0x12, 0xe1, 0x22, 0xa0, 0x77, 0xe6, 0x4a, 0xe6, 0x7d, 0x0a, 0xd4, 0xf0, 0xb4,
+		0xa0, 0x20, 0x1e, 0x02, 0xda, 0xd4, 0x3a, 0x9a, 0xa9, 0x0b, 0x97, 0xfb, 0x40, 0x36, 0x5f, 0x3c,
+		0x26, 0x54, 0x66, 0x24, 0x50, 0x0d, 0xa6, 0x05, 0xb5, 0xc4, 0xc1, 0xa9, 0x09, 0x5c, 0x74, 0x1f,
+		0x64, 0x83, 0x86, 0x81, 0x7a, 0x23, 0x09, 0xfa, 0x54, 0x67, 0x26, 0x60, 0x1b, 0xc6, 0x59, 0x9f,
+		0xe4, 0xbe, 0xd6, 0x87, 0x3b, 0x7d, 0x1f, 0x89, 0xc3, 0x8c, 0x82, 0xdb, 0x7f, 0xfb, 0xa7, 0x66,
+		0x1d, 0x80, 0x2d, 0x57, 0xa2, 0x4d, 0xf9, 0xec, 0xdc, 0xc1, 0x9a, 0x4f, 0x33, 0x22, 0x55, 0xc7,
+		0x45, 0xf7, 0x44, 0xcd, 0xd2, 0x5b, 0x3f, 0x43, 0xff, 0x39, 0x06, 0xd9, 0xe0, 0x0c, 0xfd, 0x9d,
+		0x34, 0x5c, 0xa8, 0xe1, 0xab, 0x2a, 0xb6, 0xa1, 0xff, 0xe0, 0x28, 0x55, 0x35, 0x24, 0xcd, 0x63,
+		0x74, 0xd4, 0xa7, 0xa7, 0x21, 0xc9, 0xaf, 0x0a, 0xaf, 0x0d, 0xf9, 0xb7, 0x63, 0x9f, 0xe9, 0xce,
+		0x89, 0x97, 0xce, 0x23, 0xdd, 0xdb, 0xfb, 0x20, 0x4f, 0x02, 0xfb, 0xd0, 0xfd, 0x63, 0xe9, 0x74,
+		0x8e, 0xc6, 0xe7, 0xfe, 0x01, 0x2c, 0xb4, 0x08, 0x19, 0x02, 0xe6, 0xeb, 0x62, 0x02, 0x03, 0x5d,
+		0x6d, 0xb7, 0xc6, 0x4a, 0xd0, 0x39, 0x40, 0x3b, 0x5e, 0xb6, 0x45, 0xf5, 0x19, 0x21, 0x9d, 0xce,
+		0x51, 0xb5, 0x30, 0xe3, 0xd7, 0x0a, 0x94, 0xbb, 0x01, 0x48, 0x4f, 0x54, 0x96, 0x3d, 0xe5, 0xbf,
+		0x25, 0x4e, 0x4a, 0xaa, 0x34, 0x83, 0xfa, 0x1e, 0x89, 0xb9, 0xca, 0x03, 0x29, 0x00, 0x1e, 0xa9,
+		0xb4, 0x26, 0x58, 0x18, 0x7f, 0xf5, 0xfa, 0xe2, 0xc2, 0x9e, 0xd6, 0xed, 0x2c, 0x17, 0x23, 0xe8,
+		0x14, 0xa3, 0xb2, 0x12, 0xc4, 0x81, 0x0e, 0xa7, 0x10, 0x50, 0x1d, 0xe4, 0x1b, 0x78, 0x4f, 0xb5,
+		0x2d, 0x97, 0x29, 0x9b, 0x2d, 0x2c, 0x5e, 0xcb, 0x3f, 0xb6, 0x14, 0x91, 0xcb, 0x5e, 0xaa, 0x58,
+		0x86, 0xc9, 0xb7, 0x5a, 0xf3, 0x37, 0xf0, 0x9e, 0xc2, 0xf1, 0x2e, 0x61, 0x8c, 0x5e, 0x86, 0x63,
+		0xdb, 0x1d, 0x6b, 0x53, 0xeb, 0xa8, 0x1d, 0xe3, 0xe5, 0xbe, 0xd1, 0x56, 0xb9, 0x60, 0xa8, 0xba,
+		0xd6, 0x63, 0x57, 0x90, 0xee, 0x38, 0x9d, 0x72, 0x84, 0x11, 0x5e, 0xa1, 0x74, 0x9b, 0x8c, 0x6c,
+		0x45, 0xeb, 0xa1, 0x5b, 0x70, 0x97, 0x2f, 0xeb, 0x11, 0xad, 0xa6, 0xdf, 0x54, 0xab, 0xc7, 0x3c,
+		0xda, 0x43, 0x0d, 0xbf, 0x0c, 0xc7, 0x42, 0x72, 0xc5, 0x2e, 0x67, 0xa8, 0x54, 0x26, 0xd9, 0x15,
+		0xed, 0x3b, 0x1f, 0x6b, 0x50, 0x34, 0xe9, 0xdd, 0x0e, 0x85, 0x50, 0x45, 0x2f, 0x05, 0xd7, 0xb5,
+		0xbe, 0xd3, 0xb7, 0x4d, 0xb5, 0x63, 0x88, 0x67, 0x4a, 0xef, 0xbc, 0x39, 0x7f, 0xb1, 0x57, 0x08,
+		0xcd, 0x15, 0x42, 0x72, 0xf9, 0x5e, 0xa2, 0xf8, 0x5e, 0xfd, 0xc2, 0xcf, 0x9d, 0x39, 0x1e, 0x48,
+		0x5f, 0xef, 0x7a, 0x79, 0x5a, 0xb6, 0x5a, 0x49, 0x1c, 0x83, 0x7c, 0x9f, 0x22, 0xf0, 0x7c, 0x00,
+		0x04, 0x42, 0x3f, 0x69, 0xff, 0x90, 0xd2, 0xc7, 0x0f, 0x85, 0x94, 0x01, 0x6d, 0xfb, 0x76, 0xdf,
+		0xa4, 0xc7, 0xc6, 0x09, 0x66, 0x50, 0xd1, 0x70, 0x24, 0xaa, 0xc4, 0x0f, 0x15, 0xff, 0xbd, 0x04,
+		0xc7, 0x86, 0x14, 0x93, 0xd7, 0x65, 0x1d, 0x90, 0x1d, 0xa8, 0xa4, 0x0b, 0x5c, 0x3c, 0x6d, 0x7d,
+		0x67, 0x7a, 0x6e, 0xc6, 0x1e, 0xb2, 0xe9, 0x6f, 0x8d, 0x6f, 0xc2, 0x8d, 0xd2, 0x6f, 0x48, 0x30,
+		0x17, 0xec, 0x80, 0x37, 0x94, 0x26, 0x64, 0x83, 0x4d, 0xf3, 0x41, 0xdc, 0x3b, 0xc9, 0x20, 0x82,
+		0xfd, 0x0f, 0x11, 0x41, 0xd7, 0x7c, 0xe5, 0xcf, 0xb2, 0xc3, 0xe7, 0x26, 0x66, 0x8a, 0xe8, 0x58,
+		0xa4, 0x11, 0x60, 0x73, 0xf3, 0x27, 0x12, 0x24, 0xd6, 0x2d, 0xab, 0x83, 0x5e, 0x86, 0x19, 0xd3,
+		0x72, 0x55, 0xa2, 0x24, 0x71, 0x5b, 0xe5, 0x99, 0x20, 0x66, 0x58, 0x6b, 0xfb, 0xf2, 0xea, 0x8f,
+		0x5f, 0x5f, 0x1c, 0xc6, 0x8c, 0xda, 0xe6, 0x2a, 0x98, 0x96, 0x5b, 0xa6, 0x40, 0x2d, 0x96, 0x2c,
+		0xda, 0x82, 0x5c, 0xb8, 0x39, 0x66, 0x7c, 0x4b, 0xe3, 0x9a, 0xcb, 0x8d, 0x6d, 0x2a, 0xbb, 0x19,
+		0x68, 0x87, 0xbd, 0xe5, 0xff, 0x17, 0x64, 0xe6, 0xbe, 0x05, 0xe4, 0x6b, 0x83, 0xf7, 0x93, 0x2f,
+		0xc1, 0xb4, 0xb8, 0x8f, 0x2c, 0x4d, 0x7a, 0xd7, 0x39, 0xc8, 0x4f, 0x8e, 0x4c, 0xd3, 0xef, 0x9f,
+		0x8d, 0xc1, 0xb1, 0x8a, 0x65, 0x3a, 0x3c, 0x6f, 0xc7, 0x15, 0x34, 0xdb, 0x2b, 0xd8, 0x43, 0x0f,
+		0x8e, 0xc8, 0x2a, 0x66, 0x87, 0x73, 0x87, 0xd7, 0xa0, 0x40, 0xbc, 0x25, 0xdd, 0x32, 0xdf, 0x64,
+		0xea, 0x30, 0x67, 0x75, 0xda, 0xbc, 0x47, 0x37, 0xf0, 0x1e, 0xa1, 0x6b, 0xe2, 0x5b, 0x21, 0xba,
+		0xf1, 0x3b, 0xa3, 0x6b, 0xe2, 0x5b, 0x01, 0xba, 0xfe, 0x31, 0xb5, 0x44, 0xe8, 0xd2, 0xdb, 0x05,
+		0x88, 0x13, 0xab, 0x36, 0x75, 0x00, 0xe5, 0x41, 0x10, 0x02, 0x1e, 0x4a, 0x13, 0x8e, 0xf1, 0xc4,
+		0x8f, 0xb3, 0xb6, 0x45, 0x39, 0x8a, 0xe9, 0x80, 0x9e, 0xc3, 0x7b, 0x11, 0x59, 0xa0, 0xec, 0x64,
+		0x59, 0xa0, 0x5f, 0x91, 0x60, 0x96, 0x0a, 0x87, 0xf1, 0x0a, 0xa6, 0xb9, 0x45, 0x05, 0xeb, 0x96,
+		0xdd, 0x46, 0x79, 0x88, 0xf1, 0x33, 0x55, 0x09, 0x25, 0x66, 0xb4, 0xd1, 0x12, 0x4c, 0x59, 0xb7,
+		0x4c, 0x7e, 0x17, 0x60, 0x3f, 0x0f, 0x93, 0x81, 0x51, 0x97, 0xc7, 0x6a, 0xf7, 0x3b, 0x58, 0xd5,
+		0x74, 0xe6, 0xe5, 0xc6, 0xf9, 0x39, 0x0d, 0x5a, 0x5a, 0x62, 0x85, 0xe1, 0x0b, 0x8f, 0x89, 0x49,
+		0x5d, 0x43, 0x1f, 0xe7, 0xcc, 0xa7, 0x25, 0x00, 0x3f, 0x85, 0x8b, 0x1e, 0x86, 0xa3, 0xe5, 0xb5,
+		0x46, 0xd5, 0xbf, 0xbf, 0x14, 0x38, 0x8d, 0x22, 0x0e, 0x36, 0x39, 0x3d, 0xac, 0x1b, 0x5b, 0x06,
+		0x6e, 0xa3, 0xfb, 0x61, 0x2e, 0x0c, 0x4d, 0xbe, 0x6a, 0x55, 0x59, 0x5a, 0xc8, 0xbe, 0x7a, 0xfb,
+		0x64, 0x8a, 0x85, 0xac, 0xb8, 0x8d, 0x4e, 0xc3, 0xe1, 0x61, 0xb8, 0x7a, 0xe3, 0xb2, 0x1c, 0x5b,
+		0xc8, 0xbd, 0x7a, 0xfb, 0x64, 0xda, 0x8b, 0x6d, 0x51, 0x11, 0x50, 0x10, 0x92, 0xd3, 0x8b, 0x2f,
+		0xc0, 0xab, 0xb7, 0x4f, 0x26, 0xd9, 0x92, 0xe7, 0x17, 0x9f, 0xbe, 0x19, 0xa0, 0x6e, 0x6e, 0xd9,
+		0x9a, 0x4e, 0x55, 0xdb, 0x02, 0x1c, 0xa9, 0x37, 0x2e, 0x29, 0xa5, 0x4a, 0xab, 0xbe, 0xd6, 0x18,
+		0x38, 0x44, 0x13, 0xae, 0xab, 0xae, 0x6d, 0x94, 0x57, 0x6a, 0x6a, 0xb3, 0x7e, 0xb9, 0xc1, 0x6e,
+		0x29, 0x86, 0xea, 0x9e, 0x6f, 0xb4, 0xea, 0xab, 0x35, 0x39, 0x76, 0xe6, 0x1a, 0x00, 0xb5, 0xa4,
+		0xef, 0xe8, 0xe3, 0x3e, 0x3d, 0xa4, 0x53, 0xb9, 0xb2, 0xa1, 0x34, 0xd4, 0x77, 0x6c, 0xd4, 0x36,
+		0x06, 0x0f, 0xe9, 0x1c, 0x86, 0x99, 0x60, 0x65, 0xad, 0xd1, 0x52, 0xae, 0xcb, 0x12, 0x9a, 0x03,
+		0x39, 0x54, 0xfc, 0x42, 0xbd, 0x25, 0xc7, 0xca, 0x17, 0x46, 0xee, 0x3a, 0xdf, 0x15, 0x52, 0x52,
+		0xbe, 0x99, 0x0e, 0xed, 0x37, 0xff, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x95, 0xf1, 0x3f,
+		0x97, 0xb8, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2602,6 +2819,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.MaxValidatorPowerRatio.Equal(that1.MaxValidatorPowerRatio) {
+		return false
+	}
+	if !this.ValidatorChurnLimit.Equal(that1.ValidatorChurnLimit) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3546,6 +3775,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorChurnLimit.Size()
+		i -= size
+		if _, err := m.ValidatorChurnLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MaxValidatorPowerRatio.Size()
+		i -= size
+		if _, err := m.MaxValidatorPowerRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3913,6 +4182,55 @@ func (m *ValAddrsOfRotatedConsKeys) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -4294,6 +4612,14 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.KeyRotationFee.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxValidatorPowerRatio.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorChurnLimit.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -4409,6 +4735,30 @@ func (m *ValAddrsOfRotatedConsKeys) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7252,6 +7602,142 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorPowerRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorPowerRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorChurnLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorChurnLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -8115,6 +8601,171 @@ func (m *ValAddrsOfRotatedConsKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
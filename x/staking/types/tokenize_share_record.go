@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the module accounts holding the
+// tokenized delegations.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance.
+func NewTokenizeShareRecord(id uint64, owner, validator string) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner,
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     validator,
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the tokenized delegation.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return address.Module(ModuleName, []byte(r.ModuleAccount))
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the tokenized delegation.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", r.Validator, r.Id)
+}
+
+// ParseShareTokenDenom returns the validator address and the tokenize share record id of a share
+// token denom.
+func ParseShareTokenDenom(denom string) (validator string, id uint64, err error) {
+	i := strings.LastIndex(denom, "/")
+	if i <= 0 {
+		return "", 0, errorsmod.Wrapf(ErrTokenizeShareRecordNotExists, "invalid share token denom %s", denom)
+	}
+
+	id, err = strconv.ParseUint(denom[i+1:], 10, 64)
+	if err != nil {
+		return "", 0, errorsmod.Wrapf(ErrTokenizeShareRecordNotExists, "invalid share token denom %s", denom)
+	}
+
+	return denom[:i], id, nil
+}
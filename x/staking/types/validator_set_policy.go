@@ -0,0 +1,106 @@
+package types
+
+import "context"
+
+// ValidatorSetPolicy selects the members of the bonded validator set and their consensus power.
+// At each end block, it is called on the candidates, i.e. the non-jailed validators with a non-zero
+// power, in decreasing order of power until the set holds MaxValidators members.
+type ValidatorSetPolicy interface {
+	// SelectValidator returns the consensus power the candidate is bonded with, or false if the
+	// candidate is not admitted to the set. power is the consensus power of the candidate tokens and
+	// bonded holds the candidates admitted so far. The returned power must be positive.
+	SelectValidator(ctx context.Context, candidate Validator, power int64, bonded []Validator) (int64, bool, error)
+}
+
+var (
+	_ ValidatorSetPolicy = DefaultValidatorSetPolicy{}
+	_ ValidatorSetPolicy = CappedPowerValidatorSetPolicy{}
+	_ ValidatorSetPolicy = AllowlistValidatorSetPolicy{}
+	_ ValidatorSetPolicy = MultiValidatorSetPolicy{}
+)
+
+// DefaultValidatorSetPolicy bonds the MaxValidators candidates with the highest power, each with the
+// consensus power of its tokens.
+type DefaultValidatorSetPolicy struct{}
+
+// SelectValidator implements ValidatorSetPolicy.
+func (DefaultValidatorSetPolicy) SelectValidator(_ context.Context, _ Validator, power int64, _ []Validator) (int64, bool, error) {
+	return power, true, nil
+}
+
+// CappedPowerValidatorSetPolicy bonds the same validators as DefaultValidatorSetPolicy, but caps the
+// consensus power of each of them to MaxPower.
+//
+// Evidence of an infraction reports the capped power of the validator, which the staking keeper
+// translates back to the power of its tokens, so that capped validators are still slashed on their
+// tokens.
+type CappedPowerValidatorSetPolicy struct {
+	MaxPower int64
+}
+
+// NewCappedPowerValidatorSetPolicy returns a CappedPowerValidatorSetPolicy capping the consensus power
+// of the validators to maxPower.
+func NewCappedPowerValidatorSetPolicy(maxPower int64) CappedPowerValidatorSetPolicy {
+	return CappedPowerValidatorSetPolicy{MaxPower: maxPower}
+}
+
+// SelectValidator implements ValidatorSetPolicy.
+func (p CappedPowerValidatorSetPolicy) SelectValidator(_ context.Context, _ Validator, power int64, _ []Validator) (int64, bool, error) {
+	if p.MaxPower > 0 && power > p.MaxPower {
+		return p.MaxPower, true, nil
+	}
+
+	return power, true, nil
+}
+
+// AllowlistValidatorSetPolicy only bonds the validators of its allowlist, each with the consensus power
+// of its tokens. The other validators can still be created and receive delegations, but are never
+// bonded.
+type AllowlistValidatorSetPolicy struct {
+	operators map[string]struct{}
+}
+
+// NewAllowlistValidatorSetPolicy returns an AllowlistValidatorSetPolicy allowing the validators of the
+// given operator addresses.
+func NewAllowlistValidatorSetPolicy(operators ...string) AllowlistValidatorSetPolicy {
+	p := AllowlistValidatorSetPolicy{operators: make(map[string]struct{}, len(operators))}
+	for _, operator := range operators {
+		p.operators[operator] = struct{}{}
+	}
+
+	return p
+}
+
+// SelectValidator implements ValidatorSetPolicy.
+func (p AllowlistValidatorSetPolicy) SelectValidator(_ context.Context, candidate Validator, power int64, _ []Validator) (int64, bool, error) {
+	if _, ok := p.operators[candidate.GetOperator()]; !ok {
+		return 0, false, nil
+	}
+
+	return power, true, nil
+}
+
+// MultiValidatorSetPolicy combines several policies: a candidate is admitted if all the policies admit
+// it, and each policy is called with the power returned by the previous one.
+type MultiValidatorSetPolicy []ValidatorSetPolicy
+
+// NewMultiValidatorSetPolicy returns a MultiValidatorSetPolicy applying the given policies in order.
+func NewMultiValidatorSetPolicy(policies ...ValidatorSetPolicy) MultiValidatorSetPolicy {
+	return policies
+}
+
+// SelectValidator implements ValidatorSetPolicy.
+func (p MultiValidatorSetPolicy) SelectValidator(ctx context.Context, candidate Validator, power int64, bonded []Validator) (int64, bool, error) {
+	for _, policy := range p {
+		var (
+			ok  bool
+			err error
+		)
+		power, ok, err = policy.SelectValidator(ctx, candidate, power, bonded)
+		if err != nil || !ok {
+			return 0, false, err
+		}
+	}
+
+	return power, true, nil
+}
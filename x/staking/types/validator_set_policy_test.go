@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/staking/types"
+)
+
+func TestValidatorSetPolicies(t *testing.T) {
+	ctx := context.Background()
+	allowed := types.Validator{OperatorAddress: "allowed"}
+	other := types.Validator{OperatorAddress: "other"}
+
+	power, ok, err := types.DefaultValidatorSetPolicy{}.SelectValidator(ctx, other, 100, nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(100), power)
+
+	capped := types.NewCappedPowerValidatorSetPolicy(50)
+	power, ok, err = capped.SelectValidator(ctx, other, 100, nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(50), power)
+
+	power, _, err = capped.SelectValidator(ctx, other, 10, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), power)
+
+	allowlist := types.NewAllowlistValidatorSetPolicy(allowed.OperatorAddress)
+	_, ok, err = allowlist.SelectValidator(ctx, other, 100, nil)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	multi := types.NewMultiValidatorSetPolicy(allowlist, capped)
+	power, ok, err = multi.SelectValidator(ctx, allowed, 100, nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(50), power)
+
+	_, ok, err = multi.SelectValidator(ctx, other, 100, nil)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
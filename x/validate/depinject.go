@@ -58,6 +58,7 @@ type ModuleInputs struct {
 	ExtraTxValidators        []appmodulev2.TxValidator[transaction.Tx] `optional:"true"`
 	UnorderedTxManager       *unorderedtx.Manager                      `optional:"true"`
 	TxFeeChecker             ante.TxFeeChecker                         `optional:"true"`
+	FeeDenomPricer           ante.FeeDenomPricer                       `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -90,6 +91,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	feeTxValidator = ante.NewDeductFeeDecorator(in.AccountKeeper, in.BankKeeper, in.FeeGrantKeeper, in.TxFeeChecker)
 	feeTxValidator.SetMinGasPrices(minGasPrices) // set min gas price in deduct fee decorator
+	if in.FeeDenomPricer != nil {
+		feeTxValidator.SetFeeDenomPricer(in.FeeDenomPricer)
+	}
 
 	if in.UnorderedTxManager != nil {
 		unorderedTxValidator = ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, in.UnorderedTxManager, in.Environment, ante.DefaultSha256Cost)
@@ -144,6 +148,7 @@ func newAnteHandler(in ModuleInputs) (sdk.AnteHandler, error) {
 			BankKeeper:               in.BankKeeper,
 			SignModeHandler:          in.TxConfig.SignModeHandler(),
 			FeegrantKeeper:           in.FeeGrantKeeper,
+			FeeDenomPricer:           in.FeeDenomPricer,
 			SigGasConsumer:           ante.DefaultSigVerificationGasConsumer,
 			UnorderedTxManager:       in.UnorderedTxManager,
 			AccountAbstractionKeeper: in.AccountAbstractionKeeper,